@@ -42,7 +42,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
 	"github.com/matrixorigin/matrixone/pkg/sql/handler"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	aoeDriver "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	dConfig "github.com/matrixorigin/matrixone/pkg/vm/driver/config"
@@ -56,6 +58,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	process2 "github.com/matrixorigin/matrixone/pkg/vm/process2"
 )
 
 const (
@@ -202,6 +205,7 @@ func initAoe(configFilePath string) *aoeHandler {
 
 	//put the node info to the computation
 	compile.InitAddress(addr)
+	settings.InitAddress(addr)
 
 	//aoe: catalog
 	c = catalog.NewCatalog(a)
@@ -438,6 +442,8 @@ func main() {
 	proc := process.New(mheap.New(gm))
 	hp := handler.New(config.StorageEngine, proc)
 	srv.Register(hp.Process)
+	hp2 := compile2.NewHandler(config.StorageEngine, process2.New(mheap.New(gm)))
+	compile2.InitRemoteCmd(uint64(srv.Register(hp2.Process) - 1))
 
 	go func() {
 		if err := srv.Run(); err != nil {
@@ -0,0 +1,69 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// tae-check checks the segment files of a TAE dir and repairs them
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
+)
+
+var (
+	repairFlag  = flag.Bool("repair", false, "drop the dangling extents and rebuild the allocators")
+	keyFileFlag = flag.String("key-file", "", "read the master key of the encrypted segment files from the file")
+)
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Printf("Usage: %s [-repair] [-key-file file] dir\n", os.Args[0])
+		flag.PrintDefaults()
+		os.Exit(-1)
+	}
+	driver := segment.LocalDriver
+	if *keyFileFlag != "" {
+		key, err := os.ReadFile(*keyFileFlag)
+		if err != nil {
+			fmt.Printf("Read key file error: %v\n", err)
+			os.Exit(-1)
+		}
+		keys, err := segment.NewStaticKeyProvider(key)
+		if err != nil {
+			fmt.Printf("Invalid key: %v\n", err)
+			os.Exit(-1)
+		}
+		driver = segment.NewEncryptedDriver(driver, keys)
+	}
+	reports, err := segment.CheckWithDriver(flag.Arg(0), driver, *repairFlag)
+	if err != nil {
+		fmt.Printf("Check error: %v\n", err)
+		os.Exit(-1)
+	}
+	code := 0
+	for _, report := range reports {
+		fmt.Printf("%s: version %d, %d files, %d issues\n",
+			report.Segment, report.Version, report.Files, len(report.Issues))
+		for _, issue := range report.Issues {
+			fmt.Printf("  %s\n", issue.String())
+		}
+		if !report.OK() {
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
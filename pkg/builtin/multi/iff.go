@@ -0,0 +1,159 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/iff"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["iff"] = builtin.Iff
+	extend.MultiReturnTypes[builtin.Iff] = func(es []extend.Extend) types.T {
+		return es[1].ReturnType()
+	}
+	extend.MultiStrings[builtin.Iff] = func(es []extend.Extend) string {
+		return fmt.Sprintf("iff(%s, %s, %s)", es[0], es[1], es[2])
+	}
+	overload.OpTypes[builtin.Iff] = overload.Multi
+	overload.MultiOps[builtin.Iff] = []*overload.MultiOp{
+		{
+			Min:        3,
+			Max:        3,
+			Typ:        types.T_sel,
+			ReturnType: types.T_any,
+			Fn:         iffEval,
+		},
+	}
+}
+
+// iffEval evaluates iff(cond, x, y) over the input batch. cond is the sels
+// of the rows where it holds, so a null condition picks y. A row of the
+// result is null if the branch picked is null there
+func iffEval(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+	if len(vecs) != 3 {
+		return nil, errors.New("iff() takes 3 arguments")
+	}
+	cv, xv, yv := vecs[0], vecs[1], vecs[2]
+	if xv.Typ.Oid != yv.Typ.Oid {
+		return nil, fmt.Errorf("iff not yet implemented for %s and %s", xv.Typ, yv.Typ)
+	}
+	// the branches may both be constants, the input batch gives the rows
+	conds := make([]bool, batch.Length(proc.Reg.InputBatch))
+	for _, sel := range cv.Col.([]int64) {
+		conds[sel] = true
+	}
+	defer func() {
+		for _, vec := range vecs {
+			if vec.Ref == 0 {
+				process.Put(proc, vec)
+			}
+		}
+	}()
+	vec, err := iffCol(conds, xv, yv, proc)
+	if err != nil {
+		return nil, err
+	}
+	if nulls.Any(xv.Nsp) || nulls.Any(yv.Nsp) {
+		xstep, ystep := iffStep(xv), iffStep(yv)
+		for i, cond := range conds {
+			if cond && nulls.Contains(xv.Nsp, uint64(i*xstep)) ||
+				!cond && nulls.Contains(yv.Nsp, uint64(i*ystep)) {
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+	}
+	return vec, nil
+}
+
+func iffCol(conds []bool, xv, yv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	switch xv.Typ.Oid {
+	case types.T_int8:
+		return fixedIffEval(conds, xv, yv, iff.Int8Iff, proc)
+	case types.T_int16:
+		return fixedIffEval(conds, xv, yv, iff.Int16Iff, proc)
+	case types.T_int32:
+		return fixedIffEval(conds, xv, yv, iff.Int32Iff, proc)
+	case types.T_int64:
+		return fixedIffEval(conds, xv, yv, iff.Int64Iff, proc)
+	case types.T_uint8:
+		return fixedIffEval(conds, xv, yv, iff.Uint8Iff, proc)
+	case types.T_uint16:
+		return fixedIffEval(conds, xv, yv, iff.Uint16Iff, proc)
+	case types.T_uint32:
+		return fixedIffEval(conds, xv, yv, iff.Uint32Iff, proc)
+	case types.T_uint64:
+		return fixedIffEval(conds, xv, yv, iff.Uint64Iff, proc)
+	case types.T_float32:
+		return fixedIffEval(conds, xv, yv, iff.Float32Iff, proc)
+	case types.T_float64:
+		return fixedIffEval(conds, xv, yv, iff.Float64Iff, proc)
+	case types.T_decimal64:
+		return fixedIffEval(conds, xv, yv, iff.Decimal64Iff, proc)
+	case types.T_decimal128:
+		return fixedIffEval(conds, xv, yv, iff.Decimal128Iff, proc)
+	case types.T_date:
+		return fixedIffEval(conds, xv, yv, iff.DateIff, proc)
+	case types.T_datetime:
+		return fixedIffEval(conds, xv, yv, iff.DatetimeIff, proc)
+	case types.T_timestamp:
+		return fixedIffEval(conds, xv, yv, iff.TimestampIff, proc)
+	case types.T_char, types.T_varchar:
+		xs, ys := xv.Col.(*types.Bytes), yv.Col.(*types.Bytes)
+		col := &types.Bytes{
+			Data:    make([]byte, 0, len(conds)),
+			Offsets: make([]uint32, 0, len(conds)),
+			Lengths: make([]uint32, 0, len(conds)),
+		}
+		col = iff.StrIff(conds, xs, ys, col)
+		if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+			return nil, err
+		}
+		vec := vector.New(xv.Typ)
+		vec.Data = col.Data
+		vector.SetCol(vec, col)
+		return vec, nil
+	}
+	return nil, fmt.Errorf("iff not yet implemented for %s", xv.Typ)
+}
+
+func fixedIffEval[T any](conds []bool, xv, yv *vector.Vector, fn func([]bool, []T, []T, []T) []T, proc *process.Process) (*vector.Vector, error) {
+	sz := int(xv.Typ.Size)
+	vec, err := process.Get(proc, int64(sz*len(conds)), xv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	rs := vector.DecodeFixedCol[T](vec, sz)
+	vector.SetCol(vec, fn(conds, xv.Col.([]T), yv.Col.([]T), rs[:len(conds)]))
+	return vec, nil
+}
+
+// iffStep is 0 for a constant branch and 1 for a column
+func iffStep(vec *vector.Vector) int {
+	if vector.Length(vec) == 1 {
+		return 0
+	}
+	return 1
+}
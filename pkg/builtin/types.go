@@ -54,4 +54,5 @@ const (
 	Date
 	Bin
 	FindInSet
+	Iff
 )
@@ -15,14 +15,47 @@
 package compress
 
 import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
+	"zstd": Zstd,
 	"none": None,
 }
 
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+// initZstd creates the shared zstd encoder and decoder. EncodeAll and
+// DecodeAll are safe for concurrent use
+func initZstd() {
+	var err error
+	if zstdEncoder, err = zstd.NewWriter(nil); err != nil {
+		panic(err)
+	}
+	if zstdDecoder, err = zstd.NewReader(nil); err != nil {
+		panic(err)
+	}
+}
+
+// CompressBound returns the max size of src of size bytes compressed by typ
+func CompressBound(size, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(size)
+	case Zstd:
+		return size + size>>8 + 64
+	}
+	return size
+}
+
 func Compress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -31,6 +64,9 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		zstdOnce.Do(initZstd)
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
 	}
 	return nil, nil
 }
@@ -43,6 +79,9 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		zstdOnce.Do(initZstd)
+		return zstdDecoder.DecodeAll(src, dst[:0])
 	}
 	return nil, nil
 }
@@ -41,3 +41,20 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := []int64{200, 200, 0, 200, 10, 30, 20, 1111}
+	raw := encoding.EncodeInt64Slice(xs)
+	buf := make([]byte, CompressBound(len(raw), Zstd))
+	buf, err := Compress(raw, buf, Zstd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, len(raw))
+	if data, err = Decompress(buf, data, Zstd); err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(raw) {
+		t.Fatalf("dat: %v, %v is expected", data, raw)
+	}
+}
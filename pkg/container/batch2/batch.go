@@ -17,6 +17,7 @@ package batch
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -76,6 +77,40 @@ func Shuffle(bat *Batch, sels []int64, m *mheap.Mheap) error {
 	return nil
 }
 
+// Compact applies the selection of bat to its vectors
+func Compact(bat *Batch, m *mheap.Mheap) error {
+	if bat.Sels == nil {
+		return nil
+	}
+	for i, vec := range bat.Vecs {
+		if vec.Or {
+			var err error
+
+			if bat.Vecs[i], err = vector.Dup(vec, m); err != nil {
+				return err
+			}
+		}
+	}
+	Shrink(bat, bat.Sels)
+	bat.Sels = nil
+	return nil
+}
+
+// MaskUnselected zeroes zs[k] if the row i+k of bat is not selected
+func MaskUnselected(bat *Batch, zs []int64, i int) {
+	if bat.Sels == nil {
+		return
+	}
+	sels := bat.Sels[sort.Search(len(bat.Sels), func(j int) bool { return bat.Sels[j] >= int64(i) }):]
+	for k := range zs {
+		if len(sels) > 0 && sels[0] == int64(i+k) {
+			sels = sels[1:]
+			continue
+		}
+		zs[k] = 0
+	}
+}
+
 func Length(bat *Batch) int {
 	return len(bat.Zs)
 }
@@ -104,6 +139,8 @@ func Clean(bat *Batch, m *mheap.Mheap) {
 	}
 	bat.Vecs = nil
 	bat.Zs = nil
+	bat.Sels = nil
+	bat.Ht = nil
 }
 
 func (bat *Batch) String() string {
@@ -129,14 +166,28 @@ func (bat *Batch) Append(mp *mheap.Mheap, b *Batch) (*Batch, error) {
 		return bat, nil
 	}
 	flags := make([]uint8, vector.Length(b.Vecs[0]))
-	for i := range flags {
-		flags[i]++
+	cnt := len(flags)
+	if b.Sels != nil {
+		for _, sel := range b.Sels {
+			flags[sel]++
+		}
+		cnt = len(b.Sels)
+	} else {
+		for i := range flags {
+			flags[i]++
+		}
 	}
 	for i := range bat.Vecs {
-		if err := vector.UnionBatch(bat.Vecs[i], b.Vecs[i], 0, vector.Length(b.Vecs[i]), flags[:vector.Length(b.Vecs[i])], mp); err != nil {
+		if err := vector.UnionBatch(bat.Vecs[i], b.Vecs[i], 0, cnt, flags[:vector.Length(b.Vecs[i])], mp); err != nil {
 			return nil, err
 		}
 	}
+	if b.Sels != nil {
+		for _, sel := range b.Sels {
+			bat.Zs = append(bat.Zs, b.Zs[sel])
+		}
+		return bat, nil
+	}
 	bat.Zs = append(bat.Zs, b.Zs...)
 	return bat, nil
 }
@@ -54,6 +54,28 @@ func TestBatch(t *testing.T) {
 	Clean(bat1, mp)
 }
 
+func TestSels(t *testing.T) {
+	mp := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	bat0 := newBatch(t, []types.Type{{Oid: types.T_int8}}, mp)
+	bat1 := newBatch(t, []types.Type{{Oid: types.T_int8}}, mp)
+	bat1.Sels = []int64{1, 4, 5}
+	{
+		zs := []int64{1, 1, 1, 1}
+		MaskUnselected(bat1, zs, 2)
+		require.Equal(t, []int64{0, 0, 1, 1}, zs)
+	}
+	_, err := bat0.Append(mp, bat1)
+	require.NoError(t, err)
+	require.Equal(t, Rows+3, Length(bat0))
+	require.Equal(t, []int8{1, 4, 5}, bat0.Vecs[0].Col.([]int8)[Rows:])
+	require.NoError(t, Compact(bat1, mp))
+	require.Nil(t, bat1.Sels)
+	require.Equal(t, 3, Length(bat1))
+	require.Equal(t, []int8{1, 4, 5}, bat1.Vecs[0].Col.([]int8))
+	Clean(bat0, mp)
+	Clean(bat1, mp)
+}
+
 // create a new block based on the attribute information, flg indicates if the data is all duplicated
 func newBatch(t *testing.T, ts []types.Type, mp *mheap.Mheap) *Batch {
 	bat := New(len(ts))
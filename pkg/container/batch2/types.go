@@ -24,6 +24,7 @@ type Batch struct {
 	Ht   any              // anything
 	Cnt  int64            // reference count, default is 1
 	Zs   []int64          // ring
+	Sels []int64          // ascending rows selected but not shrunk yet, nil if all
 	Rs   []ring.Ring      // aggregation list
 	Vecs []*vector.Vector // columns
 }
@@ -0,0 +1,233 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashkey
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// MinKeyLen is the min length of a key, the shorter ones are padded with
+// zeros as the string hashtable requires
+const MinKeyLen = 16
+
+var zeros [MinKeyLen]byte
+
+// Encoder encodes the keys of the rows of a batch on some columns to the
+// byte strings hashed by the string hashtable. The keys of a chunk of rows
+// share an arena reused by the next chunk, the values of a char or varchar
+// column are length prefixed to keep the keys of different values apart
+type Encoder struct {
+	// Nulls indicates if a null is a value of the keys, as it is for a
+	// group by. Otherwise the rows of the keys with a null are masked
+	Nulls bool
+	// Keys are the keys encoded last, valid until the next Encode
+	Keys [][]byte
+
+	vecs   []*vector.Vector
+	scales []int32
+	arena  []byte
+	offs   []int
+
+	decimal64Slice  []types.Decimal64
+	decimal128Slice []types.Decimal128
+}
+
+// NewEncoder returns an encoder of chunks of n rows at most
+func NewEncoder(n int, nulls bool) *Encoder {
+	return &Encoder{
+		Nulls:           nulls,
+		Keys:            make([][]byte, n),
+		offs:            make([]int, n),
+		decimal64Slice:  make([]types.Decimal64, n),
+		decimal128Slice: make([]types.Decimal128, n),
+	}
+}
+
+// Reset removes the columns of the keys
+func (e *Encoder) Reset() {
+	e.vecs = e.vecs[:0]
+	e.scales = e.scales[:0]
+}
+
+// Add appends a column to the keys, a decimal is scaled up by scale digits
+func (e *Encoder) Add(vec *vector.Vector, scale int32) {
+	e.vecs = append(e.vecs, vec)
+	e.scales = append(e.scales, scale)
+}
+
+// Encode encodes the keys of the rows [i, i+n) to e.Keys[:n]. Unless
+// e.Nulls is set, zs[k] is set to 0 if the key of the row i+k has a null
+func (e *Encoder) Encode(i, n int, zs []int64) {
+	offs := e.offs[:n]
+	for k := range offs {
+		offs[k] = 0
+	}
+	flag := 0
+	if e.Nulls {
+		flag = 1
+	}
+	for _, vec := range e.vecs {
+		if !isVarlen(vec) {
+			w := flag + width(vec)
+			for k := range offs {
+				offs[k] += w
+			}
+			continue
+		}
+		vs := vec.Col.(*types.Bytes)
+		hasNull := nulls.Any(vec.Nsp)
+		for k := range offs {
+			if hasNull && vec.Nsp.Np.Contains(uint64(i+k)) {
+				offs[k] += flag + 4
+			} else {
+				offs[k] += flag + 4 + int(vs.Lengths[i+k])
+			}
+		}
+	}
+	size := 0
+	for _, l := range offs {
+		if l < MinKeyLen {
+			l = MinKeyLen
+		}
+		size += l
+	}
+	if cap(e.arena) < size {
+		e.arena = make([]byte, size)
+	}
+	arena := e.arena[:size]
+	off := 0
+	for k, l := range offs {
+		end := off + l
+		if l < MinKeyLen {
+			end = off + MinKeyLen
+			copy(arena[off+l:end], zeros[l:])
+		}
+		e.Keys[k] = arena[off:end:end]
+		offs[k] = off
+		off = end
+	}
+	for j, vec := range e.vecs {
+		if isVarlen(vec) {
+			e.encodeVarlen(vec, arena, offs, i, zs)
+			continue
+		}
+		data, base := e.fixedData(vec, e.scales[j], i, len(offs))
+		e.encodeFixed(vec, data, width(vec), base, arena, offs, i, zs)
+	}
+}
+
+func (e *Encoder) encodeFixed(vec *vector.Vector, data []byte, w, base int, arena []byte, offs []int, i int, zs []int64) {
+	hasNull := nulls.Any(vec.Nsp)
+	for k, p := range offs {
+		if hasNull && vec.Nsp.Np.Contains(uint64(i+k)) {
+			if !e.Nulls {
+				zs[k] = 0
+				offs[k] = p + w
+				continue
+			}
+			arena[p] = 1
+			copy(arena[p+1:p+1+w], zeros[:w])
+			offs[k] = p + 1 + w
+			continue
+		}
+		if e.Nulls {
+			arena[p] = 0
+			p++
+		}
+		copy(arena[p:p+w], data[(base+k)*w:(base+k+1)*w])
+		offs[k] = p + w
+	}
+}
+
+func (e *Encoder) encodeVarlen(vec *vector.Vector, arena []byte, offs []int, i int, zs []int64) {
+	vs := vec.Col.(*types.Bytes)
+	hasNull := nulls.Any(vec.Nsp)
+	for k, p := range offs {
+		if hasNull && vec.Nsp.Np.Contains(uint64(i+k)) {
+			if !e.Nulls {
+				zs[k] = 0
+				offs[k] = p + 4
+				continue
+			}
+			arena[p] = 1
+			binary.LittleEndian.PutUint32(arena[p+1:], 0)
+			offs[k] = p + 5
+			continue
+		}
+		if e.Nulls {
+			arena[p] = 0
+			p++
+		}
+		v := vs.Get(int64(i + k))
+		binary.LittleEndian.PutUint32(arena[p:], uint32(len(v)))
+		p += 4
+		offs[k] = p + copy(arena[p:], v)
+	}
+}
+
+// fixedData returns the bytes of the values of vec, the value of the row
+// i+k starts at (base+k)*width(vec)
+func (e *Encoder) fixedData(vec *vector.Vector, scale int32, i, n int) ([]byte, int) {
+	switch vec.Typ.Oid.FixedLength() {
+	case 1:
+		return bytesOf(vector.DecodeFixedCol[uint8](vec, 1), 1), i
+	case 2:
+		return bytesOf(vector.DecodeFixedCol[uint16](vec, 2), 2), i
+	case 4:
+		return bytesOf(vector.DecodeFixedCol[uint32](vec, 4), 4), i
+	case 8:
+		return bytesOf(vector.DecodeFixedCol[uint64](vec, 8), 8), i
+	case -8:
+		src := vector.DecodeFixedCol[types.Decimal64](vec, 8)
+		if scale == 0 {
+			return bytesOf(src, 8), i
+		}
+		vs := types.AlignDecimal64UsingScaleDiffBatch(src[i:i+n], e.decimal64Slice[:n], scale)
+		return bytesOf(vs, 8), 0
+	default:
+		src := vector.DecodeFixedCol[types.Decimal128](vec, 16)
+		if scale == 0 {
+			return bytesOf(src, 16), i
+		}
+		vs := e.decimal128Slice[:n]
+		types.AlignDecimal128UsingScaleDiffBatch(src[i:i+n], vs, scale)
+		return bytesOf(vs, 16), 0
+	}
+}
+
+func isVarlen(vec *vector.Vector) bool {
+	return vec.Typ.Oid == types.T_char || vec.Typ.Oid == types.T_varchar
+}
+
+// width returns the bytes of a value of a fixed length column
+func width(vec *vector.Vector) int {
+	w := vec.Typ.Oid.FixedLength()
+	if w < 0 {
+		return -w
+	}
+	return w
+}
+
+func bytesOf[T any](vs []T, sz int) []byte {
+	if len(vs) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), len(vs)*sz)
+}
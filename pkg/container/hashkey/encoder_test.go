@@ -0,0 +1,96 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashkey
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder(t *testing.T) {
+	e := NewEncoder(4, false)
+	e.Add(newStrVector([]string{"ab", "a", "", "x"}), 0)
+	e.Add(newStrVector([]string{"c", "bc", "abc", "y"}), 0)
+	e.Add(newInt64Vector([]int64{1, 1, 1, 1}, 3), 0)
+	zs := []int64{1, 1, 1, 1}
+	e.Encode(0, 4, zs)
+	require.NotEqual(t, e.Keys[0], e.Keys[1])
+	require.NotEqual(t, e.Keys[1], e.Keys[2])
+	require.Equal(t, []int64{1, 1, 1, 0}, zs)
+	for _, key := range e.Keys {
+		require.GreaterOrEqual(t, len(key), MinKeyLen)
+	}
+
+	e = NewEncoder(4, true)
+	e.Add(newStrVector([]string{"", "", "", "a"}, 1, 2), 0)
+	zs = []int64{1, 1, 1, 1}
+	e.Encode(0, 4, zs)
+	require.NotEqual(t, e.Keys[0], e.Keys[1])
+	require.Equal(t, e.Keys[1], e.Keys[2])
+	require.Equal(t, []int64{1, 1, 1, 1}, zs)
+	require.Equal(t, MinKeyLen, len(e.Keys[3]))
+	key := append([]byte{}, e.Keys[3]...)
+	e.Encode(3, 1, zs)
+	require.Equal(t, key, e.Keys[0])
+}
+
+func BenchmarkEncoder(b *testing.B) {
+	const rows = 256
+	vs := make([]string, rows)
+	ns := make([]int64, rows)
+	for i := range vs {
+		vs[i] = "key-" + strconv.Itoa(i)
+		ns[i] = int64(i)
+	}
+	e := NewEncoder(rows, false)
+	e.Add(newInt64Vector(ns), 0)
+	e.Add(newStrVector(vs), 0)
+	zs := make([]int64, rows)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Encode(0, rows, zs)
+	}
+}
+
+func newInt64Vector(vs []int64, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Data = encoding.EncodeInt64Slice(vs)
+	vec.Col = vs
+	for _, row := range nullRows {
+		nulls.Add(vec.Nsp, row)
+	}
+	return vec
+}
+
+func newStrVector(vs []string, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	col := vec.Col.(*types.Bytes)
+	for _, v := range vs {
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(v)))
+		col.Data = append(col.Data, v...)
+	}
+	for _, row := range nullRows {
+		nulls.Add(vec.Nsp, row)
+	}
+	return vec
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	csvload "github.com/matrixorigin/matrixone/pkg/sql/colexec2/load"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -1765,3 +1766,74 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 
 	return result, retErr
 }
+
+/*
+loadCSV loads the file by the parallel chunked reader of colexec2, which
+converts the fields into the vectors appended to the table directly.
+It returns false if the statement needs the column list, the assignments
+or the line prefix, which are left to LoadLoop.
+*/
+func (mce *MysqlCmdExecutor) loadCSV(load *tree.Load, tableHandler engine.Relation) (*LoadResult, bool, error) {
+	ses := mce.GetSession()
+	if len(load.ColumnList) != 0 || len(load.Assignments) != 0 || len(load.Fields.Terminated) != 1 {
+		return nil, false, nil
+	}
+	arg := &csvload.Argument{
+		File:        load.File,
+		Sep:         load.Fields.Terminated[0],
+		Quote:       load.Fields.EnclosedBy,
+		Escape:      load.Fields.EscapedBy,
+		IgnoreLines: load.IgnoredLines,
+		Workers:     runtime.NumCPU(),
+		BatchRows:   int(ses.Pu.SV.GetBatchSizeInLoadData()),
+		Policy:      csvload.Abort,
+		MaxErrors:   ses.LoadMaxErrors(),
+	}
+	if load.Lines != nil {
+		if load.Lines.StartingBy != "" || len(load.Lines.TerminatedBy) > 1 {
+			return nil, false, nil
+		}
+		if len(load.Lines.TerminatedBy) == 1 {
+			arg.Term = load.Lines.TerminatedBy[0]
+		}
+	}
+	//the bad fields of IGNORE are loaded as nulls up to the bad rows tolerated
+	if _, ok := load.DuplicateHandling.(*tree.DuplicateKeyIgnore); ok {
+		arg.Policy = csvload.SetNull
+	}
+	for _, def := range tableHandler.TableDefs(nil) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			if !csvload.Supported(attr.Attr.Type) {
+				return nil, false, nil
+			}
+			arg.Attrs = append(arg.Attrs, attr.Attr.Name)
+			arg.Types = append(arg.Types, attr.Attr.Type)
+		}
+	}
+	arg.Progress = func(p csvload.Progress) {
+		logutil.Debugf("load data %s: %v rows, %v bad rows, %v of %v bytes", load.File, p.Rows, p.Errors, p.Bytes, p.Size)
+	}
+
+	//KILL stops the load by the close reference
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	closeRef := NewCloseLoadData()
+	mce.loadDataClose = closeRef
+	go func() {
+		select {
+		case <-closeRef.stopLoadData:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	r, err := csvload.Run(ctx, arg, tableHandler, nil)
+	if err != nil {
+		return nil, true, err
+	}
+	return &LoadResult{
+		Records:  r.Rows,
+		Skipped:  r.Skipped,
+		Warnings: r.Warnings,
+	}, true, nil
+}
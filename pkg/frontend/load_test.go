@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -507,3 +508,71 @@ func Test_PrintThreadInfo(t *testing.T) {
 
 	})
 }
+
+func Test_loadCSV(t *testing.T) {
+	convey.Convey("loadCSV policies and escapes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		rel := mock_frontend.NewMockRelation(ctrl)
+		rel.EXPECT().TableDefs(nil).Return([]engine.TableDef{
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_int32, Size: 4},
+					Name: "a"}},
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_varchar, Size: 24},
+					Name: "b"}},
+		}).AnyTimes()
+		var bats []*batch.Batch
+		rel.EXPECT().Write(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+			func(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+				bats = append(bats, bat)
+				return nil
+			},
+		).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+		ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu, nil)
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		file := filepath.Join(t.TempDir(), "data.csv")
+		err = os.WriteFile(file, []byte("1,a\\,b\nx,c\n3,\\N\n"), 0644)
+		convey.So(err, convey.ShouldBeNil)
+		loadCSV := func(sql string) (*LoadResult, error) {
+			bats = nil
+			stmts, err := parsers.Parse(dialect.MYSQL, fmt.Sprintf(sql, file))
+			convey.So(err, convey.ShouldBeNil)
+			result, ok, err := mce.loadCSV(stmts[0].(*tree.Load), rel)
+			convey.So(ok, convey.ShouldBeTrue)
+			return result, err
+		}
+
+		// A bad row aborts the load by default
+		_, err = loadCSV("load data infile '%s' into table t fields terminated by ',' escaped by '\\\\'")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		// The bad fields of IGNORE are nulls up to the bad rows tolerated
+		result, err := loadCSV("load data infile '%s' ignore into table t fields terminated by ',' escaped by '\\\\'")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(result.Records, convey.ShouldEqual, 3)
+		convey.So(result.Warnings, convey.ShouldEqual, 1)
+		convey.So(bats, convey.ShouldHaveLength, 1)
+		convey.So(string(bats[0].Vecs[1].Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "a,b")
+		convey.So(nulls.Contains(bats[0].Vecs[0].Nsp, 1), convey.ShouldBeTrue)
+		convey.So(nulls.Contains(bats[0].Vecs[1].Nsp, 2), convey.ShouldBeTrue)
+
+		ses.loadMaxErrors = 0
+		_, err = loadCSV("load data infile '%s' ignore into table t fields terminated by ',' escaped by '\\\\'")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
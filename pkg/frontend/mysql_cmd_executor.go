@@ -27,14 +27,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -646,6 +649,31 @@ func (mce *MysqlCmdExecutor) handleTxIsolation() error {
 	return err
 }
 
+/*
+handle "SELECT @@mo_last_optimizer_trace"
+*/
+func (mce *MysqlCmdExecutor) handleLastOptimizerTrace() error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col.SetName("@@" + lastOptimizerTraceVar)
+	ses.Mrs.AddColumn(col)
+
+	var data = make([]interface{}, 1)
+	data[0] = ses.GetLastOptimizerTrace()
+	ses.Mrs.AddRow(data)
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 /*
 handle "SELECT @@xxx.yyyy"
 */
@@ -696,10 +724,6 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		return fmt.Errorf("load need FIELDS TERMINATED BY ")
 	}
 
-	if load.Fields != nil && load.Fields.EscapedBy != 0 {
-		return fmt.Errorf("EscapedBy field is unsupported now")
-	}
-
 	/*
 		check file
 	*/
@@ -751,10 +775,18 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	/*
 		execute load data
 	*/
-	result, err := mce.LoadLoop(load, dbHandler, tableHandler)
+	result, ok, err := mce.loadCSV(load, tableHandler)
 	if err != nil {
 		return err
 	}
+	if !ok {
+		if load.Fields.EscapedBy != 0 {
+			return fmt.Errorf("EscapedBy field is unsupported with the column list, the assignments or the line prefix now")
+		}
+		if result, err = mce.LoadLoop(load, dbHandler, tableHandler); err != nil {
+			return err
+		}
+	}
 
 	/*
 		response
@@ -842,9 +874,63 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	if sv != nil {
+		for _, assign := range sv.Assignments {
+			switch strings.ToLower(assign.Name) {
+			case showHiddenColumnsVar:
+				if ses.showHiddenColumns, err = getBoolVarValue(assign.Value); err != nil {
+					return err
+				}
+			case joinReorderVar:
+				var on bool
+				if on, err = getBoolVarValue(assign.Value); err != nil {
+					return err
+				}
+				ses.noJoinReorder = !on
+			case optimizerTraceVar:
+				if ses.traceOptimizer, err = getBoolVarValue(assign.Value); err != nil {
+					return err
+				}
+			case dopVar:
+				if ses.Settings().DOP, err = getUintVarValue(assign.Value); err != nil {
+					return err
+				}
+			case memoryLimitVar:
+				if ses.Settings().MemoryLimit, err = getBytesVarValue(assign.Value); err != nil {
+					return err
+				}
+			case batchSizeVar:
+				if ses.Settings().BatchSize, err = getUintVarValue(assign.Value); err != nil {
+					return err
+				}
+			case timeZoneVar:
+				if ses.Settings().TimeZone, err = settings.ParseTimeZone(getStringVarValue(assign.Value)); err != nil {
+					return err
+				}
+			case sqlModeVar:
+				if ses.Settings().SqlMode, err = settings.ParseSqlMode(getStringVarValue(assign.Value)); err != nil {
+					return err
+				}
+			case loadMaxErrorsVar:
+				var n int
+				if n, err = getUintVarValue(assign.Value); err != nil {
+					return err
+				}
+				ses.loadMaxErrors = int64(n)
+			case resourceGroupVar:
+				name := getStringVarValue(assign.Value)
+				if _, ok := compile2.GetResourceGroup(name); !ok && name != "" {
+					return errors.New(errno.UndefinedObject, fmt.Sprintf("unknown resource group %s", name))
+				}
+				ses.resourceGroup = name
+			}
+		}
+	}
 
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
@@ -881,7 +967,42 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	return err
 }
 
+/*
+handle show status
+*/
+func (mce *MysqlCmdExecutor) handleShowStatus(_ *tree.ShowStatus) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("Variable_name")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col2.SetName("Value")
+
+	ses.Mrs.AddColumn(col1)
+	ses.Mrs.AddColumn(col2)
+
+	stats := ses.GetLastQueryStats()
+	ses.Mrs.AddRow([]interface{}{"Last_query_peak_memory", stats.PeakMemory})
+	ses.Mrs.AddRow([]interface{}{"Last_query_spill_bytes", stats.SpillBytes})
+	ses.Mrs.AddRow([]interface{}{"Last_query_rows_examined", stats.RowsExamined})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
+	if _, ok := mce.GetSession().txnEngine(); ok {
+		return mce.handleAnalyzeTable(stmt)
+	}
 	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
 	// IMO, this approach is simple and future-proof
 	// Although this rewriting processing could have been handled in rewrite module,
@@ -902,6 +1023,97 @@ func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
 	return mce.doComQuery(sql)
 }
 
+// analyzeQuery runs the plan qry of stmt in txn with the runtime statistics
+// of its nodes collected for EXPLAIN ANALYZE, and returns the wall time of
+// the run
+func (mce *MysqlCmdExecutor) analyzeQuery(txn moengine.Txn, stmt tree.Statement, qry *plan2.Query) (time.Duration, error) {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	proc := mce.newTxnProcess(txn)
+	defer proc.Cancel()
+	execs, err := compile2.New(proto.GetDatabaseName(), tree.String(stmt, dialect.MYSQL), proto.GetUserName(), ses.Pu.StorageEngine, proc).SetConnectionID(proto.ConnectionID()).SetResourceGroup(ses.ResourceGroup()).Build()
+	if err != nil {
+		return 0, err
+	}
+	d, err := execs[0].Analyze(qry)
+	if err != nil {
+		return 0, err
+	}
+	ses.setLastTxnQueryStats(proc.Stats)
+	return d, nil
+}
+
+// explainQuery optimizes stmt and explains its plan. The tables are resolved
+// and costed by their statistics in the txn of the statement if the engine
+// is TAE, by the mock catalog otherwise, whose plans can not be run for
+// EXPLAIN ANALYZE
+func (mce *MysqlCmdExecutor) explainQuery(stmt tree.Statement, es *explain.ExplainOptions) (*explain.ExplainDataBuffer, error) {
+	ses := mce.GetSession()
+	if _, ok := ses.txnEngine(); ok {
+		txn, done, err := ses.statementTxn()
+		if err != nil {
+			return nil, err
+		}
+		var buffer *explain.ExplainDataBuffer
+		pn, err := plan2.BuildPlan(newTxnCompilerContext(ses, txn, ses.GetMysqlProtocol().GetDatabaseName()), stmt)
+		if err == nil {
+			buffer, err = mce.explainPlan(txn, stmt, pn.GetQuery(), es)
+		} else {
+			err = planError(err)
+		}
+		return buffer, done(err)
+	}
+
+	if es.Anzlyze {
+		return nil, errors.New(errno.FeatureNotSupported, "EXPLAIN ANALYZE is not supported by the storage engine")
+	}
+	//get query optimizer and execute Optimize
+	mockOptimizer := plan2.NewMockOptimizer()
+	mockOptimizer.SetJoinReorder(ses.JoinReorder())
+	mockOptimizer.SetTraceOptimizer(ses.TraceOptimizer())
+	mockOptimizer.SetSettings(ses.Settings())
+	qry, err := mockOptimizer.Optimize(stmt)
+	if trace := mockOptimizer.OptimizerTrace(); trace != nil {
+		ses.lastOptimizerTrace = trace.JSON()
+	}
+	if err != nil {
+		return nil, planError(err)
+	}
+	return mce.explainPlan(nil, stmt, qry, es)
+}
+
+// planError wraps the error of optimizing the query explained
+func planError(err error) error {
+	logutil.Errorf("build query plan and optimize failed, error: %v", err)
+	return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("build query plan and optimize failed:'%v'", err))
+}
+
+// explainPlan explains the plan qry of stmt, which is run in txn for the
+// runtime statistics of its nodes if es.Anzlyze
+func (mce *MysqlCmdExecutor) explainPlan(txn moengine.Txn, stmt tree.Statement, qry *plan2.Query, es *explain.ExplainOptions) (*explain.ExplainDataBuffer, error) {
+	var err error
+	// build explain data buffer
+	buffer := explain.NewExplainDataBuffer()
+	// generator query explain
+	explainQuery := explain.NewExplainQueryImpl(qry)
+	if es.Anzlyze {
+		// The query is run with the runtime statistics of its nodes collected
+		explainQuery.TotalTime, err = mce.analyzeQuery(txn, stmt, qry)
+		if err != nil {
+			logutil.Errorf("analyze query failed, error: %v", err)
+			return nil, errors.New(errno.DataException, fmt.Sprintf("analyze query failed:'%v'", err))
+		}
+		err = explainQuery.ExplainAnalyze(buffer, es)
+	} else {
+		err = explainQuery.ExplainPlan(buffer, es)
+	}
+	if err != nil {
+		logutil.Errorf("explain Query statement error: %v", err)
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("explain Query statement error:%v", err))
+	}
+	return buffer, nil
+}
+
 func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 	es := explain.NewExplainDefaultOptions()
 
@@ -929,6 +1141,8 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 				es.Format = explain.EXPLAIN_FORMAT_TEXT
 			} else if strings.EqualFold(v.Value, "JSON") {
 				es.Format = explain.EXPLAIN_FORMAT_JSON
+			} else if strings.EqualFold(v.Value, "DOT") {
+				es.Format = explain.EXPLAIN_FORMAT_DOT
 			} else {
 				return errors.New(errno.InvalidOptionValue, fmt.Sprintf("unrecognized value for EXPLAIN option \"%s\": \"%s\"", v.Name, v.Value))
 			}
@@ -937,23 +1151,13 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 		}
 	}
 
-	//get query optimizer and execute Optimize
-	mockOptimizer := plan2.NewMockOptimizer()
-	qry, err := mockOptimizer.Optimize(stmt.Statement)
-
+	buffer, err := mce.explainQuery(stmt.Statement, es)
 	if err != nil {
-		logutil.Errorf("build query plan and optimize failed, error: %v", err)
-		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("build query plan and optimize failed:'%v'", err))
+		return err
 	}
-
-	// build explain data buffer
-	buffer := explain.NewExplainDataBuffer()
-	// generator query explain
-	explainQuery := explain.NewExplainQueryImpl(qry)
-	err = explainQuery.ExplainPlan(buffer, es)
-	if err != nil {
-		logutil.Errorf("explain Query statement error: %v", err)
-		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("explain Query statement error:%v", err))
+	// The plan is sent to the client as the rows, and logged for debugging only
+	for _, line := range buffer.Lines {
+		logutil.Debugf("%s", line)
 	}
 
 	session := mce.GetSession()
@@ -1139,6 +1343,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	stats := process.NewStatistics(proc)
 
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
@@ -1205,6 +1410,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 								return err
 							}
 
+							//next statement
+							continue
+						} else if strings.ToLower(ve.Name) == lastOptimizerTraceVar {
+							err = mce.handleLastOptimizerTrace()
+							if err != nil {
+								return err
+							}
+
 							//next statement
 							continue
 						}
@@ -1219,7 +1432,8 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar:
+				*tree.Use, *tree.SetVar,
+				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
@@ -1279,9 +1493,27 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err = mce.handleExplainStmt(st); err != nil {
 				return err
 			}
-		case *tree.ExplainAnalyze:
+		case *tree.ShowStatus:
 			selfHandle = true
-			return errors.New(errno.FeatureNotSupported, "not support explain analyze statement now")
+			if err = mce.handleShowStatus(st); err != nil {
+				return err
+			}
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			if _, ok := ses.txnEngine(); ok {
+				selfHandle = true
+				if err = mce.handleTxnControl(st); err != nil {
+					return err
+				}
+			}
+		case *tree.CreateTable, *tree.CreateDatabase, *tree.Insert, *tree.Update, *tree.Delete:
+			// The DDL and the rows written by a txn are applied atomically
+			// once it commits
+			if _, ok := ses.txnEngine(); ok {
+				selfHandle = true
+				if err = mce.handleTxnStmt(st); err != nil {
+					return err
+				}
+			}
 		}
 
 		if selfHandle {
@@ -1291,6 +1523,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			return err
 		}
 
+		stats.Reset()
 		cmpBegin := time.Now()
 		if err = cw.Compile(ses, getDataFromPipeline); err != nil {
 			return err
@@ -1433,6 +1666,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				logutil.Infof("time of SendResponse %s", time.Since(echoTime).String())
 			}
 		}
+		ses.setLastQueryStats(stats)
 	}
 
 	return nil
@@ -1535,6 +1769,11 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 
 func (mce *MysqlCmdExecutor) Close() {
 	//logutil.Infof("close executor")
+	if ses := mce.GetSession(); ses != nil {
+		if err := ses.RollbackTxn(); err != nil {
+			logutil.Errorf("rollback txn of the session failed. error:%v", err)
+		}
+	}
 	if mce.loadDataClose != nil {
 		//logutil.Infof("close process load data")
 		mce.loadDataClose.Close()
@@ -17,8 +17,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	process2 "github.com/matrixorigin/matrixone/pkg/vm/process2"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
 )
@@ -639,6 +641,27 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleSetVar(nil)
 		convey.So(err, convey.ShouldBeNil)
 
+		proc := process.New(mheap.New(guestMmu))
+		stats := process.NewStatistics(proc)
+		stats.AddRowsExamined(10)
+		_, err = mheap.Alloc(proc.Mp, 1024)
+		convey.So(err, convey.ShouldBeNil)
+		ses.setLastQueryStats(stats)
+		convey.So(ses.GetLastQueryStats().RowsExamined, convey.ShouldEqual, 10)
+		convey.So(ses.GetLastQueryStats().PeakMemory, convey.ShouldBeGreaterThanOrEqualTo, 1024)
+		ses.Mrs = &MysqlResultSet{}
+		err = mce.handleShowStatus(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.Mrs.GetRowCount(), convey.ShouldEqual, 3)
+
+		proc2 := process2.New(mheap.New(guestMmu))
+		stats2 := process2.NewStatistics(proc2)
+		stats2.AddRowsExamined(5)
+		stats2.AddSpillBytes(20)
+		ses.setLastTxnQueryStats(stats2)
+		convey.So(ses.GetLastQueryStats().RowsExamined, convey.ShouldEqual, 5)
+		convey.So(ses.GetLastQueryStats().SpillBytes, convey.ShouldEqual, 20)
+
 		req := &Request{
 			cmd:  int(COM_FIELD_LIST),
 			data: []byte{'A', 0},
@@ -21,6 +21,7 @@ import (
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
 )
 
 type RoutineManager struct {
@@ -95,6 +96,10 @@ func (rm *RoutineManager) killStatement(id uint64) error {
 		logutil.Infof("will close the statement %d", id)
 		rt.notifyClose()
 	}
+	// The queries of the connection stop at their next check of the context
+	if n := compile2.KillConnection(uint32(id)); n > 0 {
+		logutil.Infof("killed %d queries of the connection %d", n, id)
+	}
 	return nil
 }
 
@@ -58,6 +58,9 @@ func nextConnectionID() uint32 {
 }
 
 func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl) *MOServer {
+	if err := loadResourceGroups(pu.SV.GetResourceGroups(), pu.SV.GetResourceGroupUsers()); err != nil {
+		logutil.Panicf("load resource groups failed with %+v", err)
+	}
 	encoder, decoder := NewSqlCodec()
 	rm := NewRoutineManager(pu, pdHook)
 	// TODO asyncFlushBatch
@@ -16,10 +16,14 @@ package frontend
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	process2 "github.com/matrixorigin/matrixone/pkg/vm/process2"
 )
 
 type Session struct {
@@ -46,6 +50,35 @@ type Session struct {
 
 	//tae txn
 	taeTxn moengine.Txn
+
+	//select * also projects the hidden columns
+	showHiddenColumns bool
+
+	//the inner joins are kept in the textual order
+	noJoinReorder bool
+
+	//the optimizer traces the statements, and the trace of the last one
+	traceOptimizer     bool
+	lastOptimizerTrace string
+
+	//the typed variables reaching the planner and the executor
+	settings *settings.Settings
+
+	//the bad rows tolerated by LOAD DATA IGNORE
+	loadMaxErrors int64
+
+	//the resource group of the queries, the one of the user if it is empty
+	resourceGroup string
+
+	//resource usage of the last statement
+	lastQueryStats QueryStats
+}
+
+// QueryStats is the resource usage of a statement
+type QueryStats struct {
+	PeakMemory   int64
+	SpillBytes   int64
+	RowsExamined int64
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit, taeTxn moengine.Txn) *Session {
@@ -60,7 +93,80 @@ func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempo
 			Fields:  &tree.Fields{},
 			Lines:   &tree.Lines{},
 		},
-		taeTxn: taeTxn,
+		taeTxn:        taeTxn,
+		loadMaxErrors: defaultLoadMaxErrors,
+	}
+}
+
+// ShowHiddenColumns implements compile.HiddenColumnsOption
+func (ses *Session) ShowHiddenColumns() bool {
+	return ses.showHiddenColumns
+}
+
+// JoinReorder returns true if the optimizer may reorder the inner joins
+func (ses *Session) JoinReorder() bool {
+	return !ses.noJoinReorder
+}
+
+// TraceOptimizer returns true if the optimizer traces the statements
+func (ses *Session) TraceOptimizer() bool {
+	return ses.traceOptimizer
+}
+
+// DOP returns the degree of parallelism of the scans, 0 if it is chosen by
+// the rows estimated
+func (ses *Session) DOP() int {
+	return ses.Settings().DOP
+}
+
+// Settings returns the settings of the session, which start as the default
+// ones of the node
+func (ses *Session) Settings() *settings.Settings {
+	if ses.settings == nil {
+		ses.settings = settings.Default()
+	}
+	return ses.settings
+}
+
+// LoadMaxErrors returns the number of the bad rows tolerated by LOAD DATA
+// IGNORE, whose load is aborted once they are exceeded
+func (ses *Session) LoadMaxErrors() int64 {
+	return ses.loadMaxErrors
+}
+
+// ResourceGroup returns the resource group of the queries of the session, the
+// one of its user if it is not set
+func (ses *Session) ResourceGroup() string {
+	if ses.resourceGroup != "" || ses.protocol == nil {
+		return ses.resourceGroup
+	}
+	return compile2.UserResourceGroup(ses.protocol.GetUserName())
+}
+
+// GetLastOptimizerTrace returns the JSON trace of the optimizer on the last
+// statement traced
+func (ses *Session) GetLastOptimizerTrace() string {
+	return ses.lastOptimizerTrace
+}
+
+func (ses *Session) GetLastQueryStats() QueryStats {
+	return ses.lastQueryStats
+}
+
+func (ses *Session) setLastQueryStats(stats *process.Statistics) {
+	ses.lastQueryStats = QueryStats{
+		PeakMemory:   stats.Mem.Peak(),
+		RowsExamined: stats.RowsExamined(),
+	}
+}
+
+// setLastTxnQueryStats records the resource usage of a statement run by
+// compile2 in a txn of TAE
+func (ses *Session) setLastTxnQueryStats(stats *process2.Statistics) {
+	ses.lastQueryStats = QueryStats{
+		PeakMemory:   stats.Mem.Peak(),
+		SpillBytes:   stats.SpillBytes(),
+		RowsExamined: stats.RowsExamined(),
 	}
 }
 
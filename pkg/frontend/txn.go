@@ -0,0 +1,248 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process2 "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// txnEngine returns the storage engine of the session if it runs the
+// statements in txns
+func (ses *Session) txnEngine() (moengine.TxnEngine, bool) {
+	if ses.Pu == nil {
+		return nil, false
+	}
+	eng, ok := ses.Pu.StorageEngine.(moengine.TxnEngine)
+	return eng, ok
+}
+
+// InActiveTxn returns true if the statements run in the txn started by BEGIN
+func (ses *Session) InActiveTxn() bool {
+	return ses.taeTxn != nil
+}
+
+// BeginTxn starts the txn of BEGIN, the active one is committed first
+func (ses *Session) BeginTxn() error {
+	eng, ok := ses.txnEngine()
+	if !ok {
+		return errorIsNotTaeEngine
+	}
+	if err := ses.CommitTxn(); err != nil {
+		return err
+	}
+	txn, err := eng.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	ses.taeTxn = txn
+	return nil
+}
+
+// CommitTxn commits the txn of BEGIN if any
+func (ses *Session) CommitTxn() error {
+	txn := ses.taeTxn
+	if txn == nil {
+		return nil
+	}
+	ses.taeTxn = nil
+	return txn.Commit()
+}
+
+// RollbackTxn rolls back the txn of BEGIN if any
+func (ses *Session) RollbackTxn() error {
+	txn := ses.taeTxn
+	if txn == nil {
+		return nil
+	}
+	ses.taeTxn = nil
+	return txn.Rollback()
+}
+
+// statementTxn returns the txn running a statement, which is the txn of
+// BEGIN if active or an autocommit one. done ends the statement with its
+// error, the autocommit txn is committed if it succeeds and rolled back
+// otherwise
+func (ses *Session) statementTxn() (txn moengine.Txn, done func(error) error, err error) {
+	if ses.taeTxn != nil {
+		return ses.taeTxn, func(err error) error { return err }, nil
+	}
+	eng, ok := ses.txnEngine()
+	if !ok {
+		return nil, nil, errorIsNotTaeEngine
+	}
+	if txn, err = eng.StartTxn(nil); err != nil {
+		return nil, nil, err
+	}
+	done = func(err error) error {
+		if err != nil {
+			if err2 := txn.Rollback(); err2 != nil {
+				logutil.Errorf("rollback txn %s failed. error:%v", txn.String(), err2)
+			}
+			return err
+		}
+		return txn.Commit()
+	}
+	return txn, done, nil
+}
+
+// txnCompilerContext resolves the tables of the statements of a session in
+// the txn running them, and tunes the optimizer by the variables of the
+// session
+type txnCompilerContext struct {
+	plan2.CompilerContext
+	ses *Session
+}
+
+func newTxnCompilerContext(ses *Session, txn moengine.Txn, dbName string) *txnCompilerContext {
+	return &txnCompilerContext{
+		CompilerContext: moengine.NewCompilerContext(txn, dbName),
+		ses:             ses,
+	}
+}
+
+// Stats implements plan2.Statistics by the statistics of the engine, which
+// the optimizer does not find through the CompilerContext embedded
+func (ctx *txnCompilerContext) Stats(obj *plan2.ObjectRef) *plan2.TableStats {
+	if stats, ok := ctx.CompilerContext.(plan2.Statistics); ok {
+		return stats.Stats(obj)
+	}
+	return nil
+}
+
+// JoinReorder implements plan2.OptimizerOptions
+func (ctx *txnCompilerContext) JoinReorder() bool {
+	return ctx.ses.JoinReorder()
+}
+
+// Settings implements plan2.SessionSettings
+func (ctx *txnCompilerContext) Settings() *settings.Settings {
+	return ctx.ses.Settings()
+}
+
+// OptimizerTraceEnabled implements plan2.OptimizerTracer
+func (ctx *txnCompilerContext) OptimizerTraceEnabled() bool {
+	return ctx.ses.TraceOptimizer()
+}
+
+// SetOptimizerTrace implements plan2.OptimizerTracer
+func (ctx *txnCompilerContext) SetOptimizerTrace(trace *plan2.OptimizerTrace) {
+	ctx.ses.lastOptimizerTrace = trace.JSON()
+}
+
+// handleTxnControl runs BEGIN, COMMIT and ROLLBACK on the txn of the session
+func (mce *MysqlCmdExecutor) handleTxnControl(stmt tree.Statement) (err error) {
+	ses := mce.GetSession()
+	switch stmt.(type) {
+	case *tree.BeginTransaction:
+		err = ses.BeginTxn()
+	case *tree.CommitTransaction:
+		err = ses.CommitTxn()
+	case *tree.RollbackTransaction:
+		err = ses.RollbackTxn()
+	}
+	if err != nil {
+		return err
+	}
+	return ses.GetMysqlProtocol().sendOKPacket(0, 0, 0, 0, "")
+}
+
+// handleAnalyzeTable collects the statistics of the table of stmt in the
+// txn of the statement, the plans built once it commits are costed by them
+func (mce *MysqlCmdExecutor) handleAnalyzeTable(stmt *tree.AnalyzeStmt) error {
+	ses := mce.GetSession()
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetMysqlProtocol().GetDatabaseName()
+	}
+	txn, done, err := ses.statementTxn()
+	if err != nil {
+		return err
+	}
+	err = moengine.Analyze(txn, dbName, string(stmt.Table.ObjectName))
+	if err = done(err); err != nil {
+		return err
+	}
+	return ses.GetMysqlProtocol().sendOKPacket(0, 0, 0, 0, "")
+}
+
+// handleTxnStmt plans stmt and runs it in the txn of the statement, then
+// sends the rows affected to the client
+func (mce *MysqlCmdExecutor) handleTxnStmt(stmt tree.Statement) error {
+	ses := mce.GetSession()
+	txn, done, err := ses.statementTxn()
+	if err != nil {
+		return err
+	}
+	rows, err := mce.runPlanInTxn(txn, stmt)
+	if err = done(err); err != nil {
+		return err
+	}
+	return ses.GetMysqlProtocol().sendOKPacket(rows, 0, 0, 0, "")
+}
+
+// runPlanInTxn plans stmt by the tables of txn and runs the plan by compile2
+// in txn, and returns the rows affected
+func (mce *MysqlCmdExecutor) runPlanInTxn(txn moengine.Txn, stmt tree.Statement) (uint64, error) {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	pn, err := plan2.BuildPlan(newTxnCompilerContext(ses, txn, proto.GetDatabaseName()), stmt)
+	if err != nil {
+		return 0, err
+	}
+	proc := mce.newTxnProcess(txn)
+	defer proc.Cancel()
+	execs, err := compile2.New(proto.GetDatabaseName(), tree.String(stmt, dialect.MYSQL), proto.GetUserName(), ses.Pu.StorageEngine, proc).SetConnectionID(proto.ConnectionID()).SetResourceGroup(ses.ResourceGroup()).Build()
+	if err != nil {
+		return 0, err
+	}
+	exec := execs[0]
+	if err = exec.Compile(ses, nil); err != nil {
+		return 0, err
+	}
+	if err = exec.CompilePlan(pn); err != nil {
+		return 0, err
+	}
+	if err = exec.Run(0); err != nil {
+		return 0, err
+	}
+	ses.setLastTxnQueryStats(proc.Stats)
+	return exec.GetAffectedRows(), nil
+}
+
+// newTxnProcess creates the process of a statement run by compile2 in txn.
+// Its operators spill the data exceeding the memory limit of the node to the
+// temporary dir of the system, and its resource usage is recorded in Stats
+func (mce *MysqlCmdExecutor) newTxnProcess(txn moengine.Txn) *process2.Process {
+	ses := mce.GetSession()
+	proc := process2.New(mheap.New(ses.GuestMmu))
+	proc.Id = mce.getNextProcessId()
+	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
+	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
+	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Spill = spill.New("")
+	proc.Settings = ses.Settings().Clone()
+	proc.Snapshot = txn.GetCtx()
+	process2.NewStatistics(proc)
+	return proc
+}
@@ -0,0 +1,356 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// newTxnTestSession returns the executor of a session on a TAE engine
+func newTxnTestSession(t *testing.T, ctrl *gomock.Controller) (*MysqlCmdExecutor, moengine.TxnEngine, func()) {
+	tae, err := db.Open(testutils.InitTestEnv("frontend", t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eng := moengine.NewEngine(tae)
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+	ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu, nil)
+
+	mce := NewMysqlCmdExecutor()
+	mce.PrepareSessionBeforeExecRequest(ses)
+	return mce, eng, func() {
+		mce.Close()
+		_ = tae.Close()
+	}
+}
+
+// relationNames returns the tables of database name committed in eng
+func relationNames(t *testing.T, eng moengine.TxnEngine, name string) []string {
+	txn, err := eng.StartTxn(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = txn.Commit()
+	}()
+	database, err := eng.Database(name, txn.GetCtx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return database.Relations(txn.GetCtx())
+}
+
+// writeRows appends rows mocked to the table name of database dbName in eng
+func writeRows(t *testing.T, eng moengine.TxnEngine, dbName, name string, rows uint64) {
+	txn, err := eng.StartTxn(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	database, err := eng.Database(dbName, txn.GetCtx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := database.Relation(name, txn.GetCtx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var typs []types.Type
+	for _, def := range rel.TableDefs(txn.GetCtx()) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			typs = append(typs, attr.Attr.Type)
+		}
+	}
+	if err = rel.Write(0, compute.MockBatch(typs, rows, 0, nil), txn.GetCtx()); err != nil {
+		t.Fatal(err)
+	}
+	if err = txn.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readRows returns the values of the columns b of t1 of database db1 in eng
+// indexed by the ones of a
+func readRows(t *testing.T, eng moengine.TxnEngine) map[int32]int32 {
+	txn, err := eng.StartTxn(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = txn.Commit()
+	}()
+	database, err := eng.Database("db1", txn.GetCtx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := database.Relation("t1", txn.GetCtx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := make(map[int32]int32)
+	rd := rel.NewReader(1, nil, nil, txn.GetCtx())[0]
+	for {
+		bat, err := rd.Read([]uint64{1, 1}, []string{"a", "b"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bat == nil {
+			return rows
+		}
+		as, bs := bat.Vecs[0].Col.([]int32), bat.Vecs[1].Col.([]int32)
+		for i := range as {
+			rows[as[i]] = bs[i]
+		}
+	}
+}
+
+// explainVerbose returns the lines of the verbose plan of sql in the session,
+// which is run for its runtime statistics if analyze
+func explainVerbose(mce *MysqlCmdExecutor, sql string, analyze bool) (string, error) {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	if err != nil {
+		return "", err
+	}
+	es := explain.NewExplainDefaultOptions()
+	es.Verbose = true
+	es.Anzlyze = analyze
+	buffer, err := mce.explainQuery(stmts[0], es)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(buffer.Lines, "\n"), nil
+}
+
+func Test_txnDDL(t *testing.T) {
+	convey.Convey("ddl in the txn of a session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mce, eng, closeFn := newTxnTestSession(t, ctrl)
+		defer closeFn()
+		ses := mce.GetSession()
+
+		err := mce.doComQuery("create database db1")
+		convey.So(err, convey.ShouldBeNil)
+		ses.protocol.SetDatabaseName("db1")
+
+		// The tables of a txn rolled back are not created
+		err = mce.doComQuery("begin; create table t1 (a int primary key, b varchar(10)); create table t2 (c bigint);")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.InActiveTxn(), convey.ShouldBeTrue)
+		convey.So(relationNames(t, eng, "db1"), convey.ShouldBeEmpty)
+		err = mce.doComQuery("rollback")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.InActiveTxn(), convey.ShouldBeFalse)
+		convey.So(relationNames(t, eng, "db1"), convey.ShouldBeEmpty)
+
+		err = mce.doComQuery("begin; create table t1 (a int primary key, b varchar(10)); create table t2 (c bigint); commit")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(relationNames(t, eng, "db1"), convey.ShouldHaveLength, 2)
+
+		// A statement out of a txn is committed by itself
+		err = mce.doComQuery("create table t3 (d int)")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(relationNames(t, eng, "db1"), convey.ShouldHaveLength, 3)
+		err = mce.doComQuery("create table t3 (d int)")
+		convey.So(err, convey.ShouldNotBeNil)
+		err = mce.doComQuery("create table if not exists t3 (d int)")
+		convey.So(err, convey.ShouldBeNil)
+
+		// The txn active is rolled back once the session closes
+		err = mce.doComQuery("begin; create table t4 (e int)")
+		convey.So(err, convey.ShouldBeNil)
+		mce.Close()
+		convey.So(ses.InActiveTxn(), convey.ShouldBeFalse)
+		convey.So(relationNames(t, eng, "db1"), convey.ShouldHaveLength, 3)
+	})
+}
+
+func Test_txnStatistics(t *testing.T) {
+	convey.Convey("plans costed by the statistics of the engine", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mce, eng, closeFn := newTxnTestSession(t, ctrl)
+		defer closeFn()
+		ses := mce.GetSession()
+
+		err := mce.doComQuery("create database db1")
+		convey.So(err, convey.ShouldBeNil)
+		ses.protocol.SetDatabaseName("db1")
+		err = mce.doComQuery("create table t1 (a int primary key, b int)")
+		convey.So(err, convey.ShouldBeNil)
+		writeRows(t, eng, "db1", "t1", 100)
+
+		// The rows of t1 are estimated by the rows committed, and the default
+		// selectivity before it is analyzed
+		plan, err := explainVerbose(mce, "select * from t1 where a = 5", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(plan, convey.ShouldContainSubstring, "rows=10 ")
+
+		err = mce.doComQuery("analyze table t1")
+		convey.So(err, convey.ShouldBeNil)
+		txn, err := eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		database, err := eng.Database("db1", txn.GetCtx())
+		convey.So(err, convey.ShouldBeNil)
+		rel, err := database.Relation("t1", txn.GetCtx())
+		convey.So(err, convey.ShouldBeNil)
+		ndv := rel.(interface{ CardinalNumber(string) int64 }).CardinalNumber("a")
+		convey.So(ndv, convey.ShouldBeGreaterThan, 0)
+		convey.So(txn.Commit(), convey.ShouldBeNil)
+
+		// The rows are estimated by the NDV of a once t1 is analyzed
+		plan, err = explainVerbose(mce, "select * from t1 where a = 5", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(plan, convey.ShouldContainSubstring, "rows=1 ")
+
+		_, err = explainVerbose(mce, "select * from t2", false)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_txnResourceGroup(t *testing.T) {
+	convey.Convey("resource groups of the sessions and the users", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		err := loadResourceGroups("etl:concurrency=1,timeout=1s", "alice=etl")
+		convey.So(err, convey.ShouldBeNil)
+		defer func() {
+			_ = compile2.DropResourceGroup("etl")
+		}()
+
+		mce, eng, closeFn := newTxnTestSession(t, ctrl)
+		defer closeFn()
+		ses := mce.GetSession()
+		convey.So(ses.ResourceGroup(), convey.ShouldEqual, compile2.DefaultResourceGroup)
+
+		// The statements of the session are admitted by the group set
+		err = mce.doComQuery("set mo_resource_group = 'etl'")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.ResourceGroup(), convey.ShouldEqual, "etl")
+		err = mce.doComQuery("create database db1")
+		convey.So(err, convey.ShouldBeNil)
+		ses.protocol.SetDatabaseName("db1")
+		err = mce.doComQuery("create table t1 (a int primary key, b int)")
+		convey.So(err, convey.ShouldBeNil)
+		err = mce.doComQuery("insert into t1 values (1, 10), (2, 20)")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(readRows(t, eng), convey.ShouldResemble, map[int32]int32{1: 10, 2: 20})
+
+		err = mce.doComQuery("set mo_resource_group = 'unknown'")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(ses.ResourceGroup(), convey.ShouldEqual, "etl")
+
+		// The group of the user is used once the one of the session is reset
+		err = mce.doComQuery("set mo_resource_group = ''")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.ResourceGroup(), convey.ShouldEqual, compile2.DefaultResourceGroup)
+		ses.protocol.SetUserName("alice")
+		convey.So(ses.ResourceGroup(), convey.ShouldEqual, "etl")
+	})
+}
+
+func Test_txnExplainAnalyze(t *testing.T) {
+	convey.Convey("explain analyze in the txn of a session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mce, eng, closeFn := newTxnTestSession(t, ctrl)
+		defer closeFn()
+		ses := mce.GetSession()
+
+		err := mce.doComQuery("create database db1")
+		convey.So(err, convey.ShouldBeNil)
+		ses.protocol.SetDatabaseName("db1")
+		err = mce.doComQuery("create table t1 (a int primary key, b int)")
+		convey.So(err, convey.ShouldBeNil)
+		writeRows(t, eng, "db1", "t1", 100)
+
+		// The rows read are the ones of t1 in the engine
+		plan, err := explainVerbose(mce, "select * from t1", true)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(plan, convey.ShouldContainSubstring, "actual rows=100 ")
+	})
+}
+
+func Test_txnDML(t *testing.T) {
+	convey.Convey("dml in the txn of a session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mce, eng, closeFn := newTxnTestSession(t, ctrl)
+		defer closeFn()
+		ses := mce.GetSession()
+
+		err := mce.doComQuery("create database db1")
+		convey.So(err, convey.ShouldBeNil)
+		ses.protocol.SetDatabaseName("db1")
+		err = mce.doComQuery("create table t1 (a int primary key, b int)")
+		convey.So(err, convey.ShouldBeNil)
+		err = mce.doComQuery("insert into t1 values (1, 10), (2, 20), (3, 30), (4, 40), (5, 50)")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(readRows(t, eng), convey.ShouldResemble, map[int32]int32{1: 10, 2: 20, 3: 30, 4: 40, 5: 50})
+
+		// The values set are evaluated on each row matching the filters
+		err = mce.doComQuery("update t1 set b = b + a * 2 where a > 2 and b is not null")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(readRows(t, eng), convey.ShouldResemble, map[int32]int32{1: 10, 2: 20, 3: 36, 4: 48, 5: 60})
+
+		err = mce.doComQuery("delete from t1 where b > 40 or a = 1 limit 1 offset 1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(readRows(t, eng), convey.ShouldHaveLength, 4)
+		err = mce.doComQuery("delete from t1 where b > 40")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(readRows(t, eng), convey.ShouldResemble, map[int32]int32{1: 10, 2: 20, 3: 36})
+
+		// The rows written by a txn rolled back are not applied
+		err = mce.doComQuery("begin; insert into t1 values (6, 60); update t1 set b = 0; delete from t1 where a in (1, 6);")
+		convey.So(err, convey.ShouldBeNil)
+		err = mce.doComQuery("rollback")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(readRows(t, eng), convey.ShouldResemble, map[int32]int32{1: 10, 2: 20, 3: 36})
+
+		err = mce.doComQuery("update t1 set b = null")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"os"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -31,6 +32,9 @@ import (
 
 	mo_config "github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -881,3 +885,159 @@ func FormatLineInBatch(bat *batch.Batch, rowIndex int) []string {
 	}
 	return res
 }
+
+// showHiddenColumnsVar is the session variable which makes select * also
+// project the hidden columns, e.g. the physical address of a row
+const showHiddenColumnsVar = "mo_show_hidden_columns"
+
+// joinReorderVar is the session variable which lets the optimizer reorder
+// the inner joins, it is on by default. Turning it off keeps the plans
+// stable
+const joinReorderVar = "mo_join_reorder"
+
+// optimizerTraceVar is the session variable which lets the optimizer trace
+// the rules it applies and the alternatives it weighs, it is off by default.
+// The trace of the last statement is read by lastOptimizerTraceVar
+const optimizerTraceVar = "mo_optimizer_trace"
+
+const lastOptimizerTraceVar = "mo_last_optimizer_trace"
+
+// dopVar is the session variable of the degree of parallelism of the scans,
+// 0 by default lets it be chosen by the rows estimated for each scan
+const dopVar = "mo_dop"
+
+// memoryLimitVar is the session variable of the bytes which a query may use,
+// 0 by default keeps the quota of the session
+const memoryLimitVar = "mo_memory_limit"
+
+// batchSizeVar is the session variable of the max rows of the batches merged
+// by the operators, 0 by default keeps the ones of the operators
+const batchSizeVar = "mo_batch_size"
+
+const (
+	timeZoneVar = "time_zone"
+	sqlModeVar  = "sql_mode"
+)
+
+// loadMaxErrorsVar is the session variable of the number of the bad rows
+// tolerated by LOAD DATA IGNORE, defaultLoadMaxErrors by default. The bad
+// rows of the other loads abort them
+const loadMaxErrorsVar = "mo_load_max_errors"
+
+// defaultLoadMaxErrors is the number of the bad rows tolerated by LOAD DATA
+// IGNORE by default, as many as the warnings kept by MySQL
+const defaultLoadMaxErrors = 1024
+
+// resourceGroupVar is the session variable of the resource group admitting
+// the queries of the session, the group of the user is used if it is empty
+const resourceGroupVar = "mo_resource_group"
+
+// loadResourceGroups registers the resource groups of the configuration of
+// the server and assigns the users to them. The groups are separated by
+// semicolons, and each one is its name followed by its options, as in
+// "etl:concurrency=4,memory=50,cpu=2,timeout=30s;adhoc:concurrency=16". The
+// users are pairs user=group separated by commas
+func loadResourceGroups(groups, users string) error {
+	for _, s := range strings.Split(groups, ";") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		rg, err := parseResourceGroup(s)
+		if err != nil {
+			return err
+		}
+		if err = compile2.CreateResourceGroup(rg, true); err != nil {
+			return err
+		}
+	}
+	for _, s := range strings.Split(users, ",") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid resource group of user '%s'", s)
+		}
+		if err := compile2.AssignResourceGroup(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseResourceGroup parses a resource group of the configuration of the
+// server, the options not given are 0
+func parseResourceGroup(s string) (compile2.ResourceGroup, error) {
+	var rg compile2.ResourceGroup
+
+	name, opts := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		name, opts = s[:i], s[i+1:]
+	}
+	rg.Name = strings.TrimSpace(name)
+	for _, opt := range strings.Split(opts, ",") {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 {
+			return rg, fmt.Errorf("invalid option '%s' of resource group %s", opt, rg.Name)
+		}
+		key, value := strings.ToLower(strings.TrimSpace(kv[0])), strings.TrimSpace(kv[1])
+		var err error
+		switch key {
+		case "concurrency":
+			rg.MaxConcurrency, err = strconv.Atoi(value)
+		case "memory":
+			rg.MemoryShare, err = strconv.Atoi(value)
+		case "cpu":
+			rg.CPUWeight, err = strconv.Atoi(value)
+		case "timeout":
+			rg.QueueTimeout, err = time.ParseDuration(value)
+		default:
+			return rg, fmt.Errorf("unknown option '%s' of resource group %s", key, rg.Name)
+		}
+		if err != nil {
+			return rg, fmt.Errorf("invalid value '%s' of option %s of resource group %s", value, key, rg.Name)
+		}
+	}
+	return rg, nil
+}
+
+// getBoolVarValue converts the value of a boolean session variable
+func getBoolVarValue(e tree.Expr) (bool, error) {
+	value := strings.ToLower(getStringVarValue(e))
+	switch value {
+	case "1", "on", "true":
+		return true, nil
+	case "0", "off", "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean variable value '%s'", value)
+}
+
+// getUintVarValue converts the value of a non-negative integer session
+// variable
+func getUintVarValue(e tree.Expr) (int, error) {
+	value := getStringVarValue(e)
+	n, err := strconv.ParseUint(value, 10, 31)
+	if err != nil {
+		return 0, fmt.Errorf("invalid non-negative integer variable value '%s'", value)
+	}
+	return int(n), nil
+}
+
+// getBytesVarValue converts the value of a session variable of bytes
+func getBytesVarValue(e tree.Expr) (int64, error) {
+	value := getStringVarValue(e)
+	n, err := strconv.ParseUint(value, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("invalid non-negative integer variable value '%s'", value)
+	}
+	return int64(n), nil
+}
+
+// getStringVarValue returns the value of a session variable unquoted
+func getStringVarValue(e tree.Expr) string {
+	return strings.Trim(tree.String(e, dialect.MYSQL), "'\"")
+}
@@ -15,6 +15,10 @@
 package frontend
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	cvey "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -144,3 +148,76 @@ func Test_makedebuginfo(t *testing.T) {
 			6, 3)
 	})
 }
+
+func Test_getBoolVarValue(t *testing.T) {
+	cvey.Convey("getBoolVarValue succ", t, func() {
+		stmts, err := parsers.Parse(dialect.MYSQL, "set mo_show_hidden_columns = on, mo_show_hidden_columns = 0, mo_show_hidden_columns = 'x'")
+		cvey.So(err, cvey.ShouldBeNil)
+		assigns := stmts[0].(*tree.SetVar).Assignments
+		cvey.So(len(assigns), cvey.ShouldEqual, 3)
+		v, err := getBoolVarValue(assigns[0].Value)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(v, cvey.ShouldBeTrue)
+		v, err = getBoolVarValue(assigns[1].Value)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(v, cvey.ShouldBeFalse)
+		_, err = getBoolVarValue(assigns[2].Value)
+		cvey.So(err, cvey.ShouldNotBeNil)
+	})
+}
+
+func Test_getUintVarValue(t *testing.T) {
+	cvey.Convey("getUintVarValue succ", t, func() {
+		stmts, err := parsers.Parse(dialect.MYSQL, "set mo_dop = 4, mo_dop = 0, mo_dop = -1, mo_dop = 'x'")
+		cvey.So(err, cvey.ShouldBeNil)
+		assigns := stmts[0].(*tree.SetVar).Assignments
+		cvey.So(len(assigns), cvey.ShouldEqual, 4)
+		v, err := getUintVarValue(assigns[0].Value)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(v, cvey.ShouldEqual, 4)
+		v, err = getUintVarValue(assigns[1].Value)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(v, cvey.ShouldEqual, 0)
+		_, err = getUintVarValue(assigns[2].Value)
+		cvey.So(err, cvey.ShouldNotBeNil)
+		_, err = getUintVarValue(assigns[3].Value)
+		cvey.So(err, cvey.ShouldNotBeNil)
+	})
+}
+
+func Test_getBytesVarValue(t *testing.T) {
+	cvey.Convey("getBytesVarValue succ", t, func() {
+		stmts, err := parsers.Parse(dialect.MYSQL, "set mo_memory_limit = 8589934592, mo_memory_limit = -1")
+		cvey.So(err, cvey.ShouldBeNil)
+		assigns := stmts[0].(*tree.SetVar).Assignments
+		v, err := getBytesVarValue(assigns[0].Value)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(v, cvey.ShouldEqual, int64(8<<30))
+		_, err = getBytesVarValue(assigns[1].Value)
+		cvey.So(err, cvey.ShouldNotBeNil)
+	})
+}
+
+func Test_loadResourceGroups(t *testing.T) {
+	cvey.Convey("loadResourceGroups succ", t, func() {
+		err := loadResourceGroups("etl:concurrency=4, memory=50,cpu=2,timeout=30s; adhoc", "alice=etl")
+		cvey.So(err, cvey.ShouldBeNil)
+		defer func() {
+			_ = compile2.DropResourceGroup("etl")
+			_ = compile2.DropResourceGroup("adhoc")
+		}()
+		rg, ok := compile2.GetResourceGroup("etl")
+		cvey.So(ok, cvey.ShouldBeTrue)
+		cvey.So(rg, cvey.ShouldResemble, compile2.ResourceGroup{Name: "etl", MaxConcurrency: 4, MemoryShare: 50, CPUWeight: 2, QueueTimeout: 30 * time.Second})
+		_, ok = compile2.GetResourceGroup("adhoc")
+		cvey.So(ok, cvey.ShouldBeTrue)
+		cvey.So(compile2.UserResourceGroup("alice"), cvey.ShouldEqual, "etl")
+		cvey.So(compile2.UserResourceGroup("bob"), cvey.ShouldEqual, compile2.DefaultResourceGroup)
+
+		cvey.So(loadResourceGroups("etl:concurrency=x", ""), cvey.ShouldNotBeNil)
+		cvey.So(loadResourceGroups("etl:queue=1", ""), cvey.ShouldNotBeNil)
+		cvey.So(loadResourceGroups("etl:memory=150", ""), cvey.ShouldNotBeNil)
+		cvey.So(loadResourceGroups("", "bob=unknown"), cvey.ShouldNotBeNil)
+		cvey.So(loadResourceGroups("", "bob"), cvey.ShouldNotBeNil)
+	})
+}
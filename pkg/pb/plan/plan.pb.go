@@ -381,6 +381,8 @@ const (
 	IndexDef_INVAILD IndexDef_IndexType = 0
 	IndexDef_ZONEMAP IndexDef_IndexType = 1
 	IndexDef_BSI     IndexDef_IndexType = 2
+	// A secondary index mapping the values of a column to its rows
+	IndexDef_SECONDARY IndexDef_IndexType = 3
 )
 
 // Enum value maps for IndexDef_IndexType.
@@ -389,11 +391,13 @@ var (
 		0: "INVAILD",
 		1: "ZONEMAP",
 		2: "BSI",
+		3: "SECONDARY",
 	}
 	IndexDef_IndexType_value = map[string]int32{
-		"INVAILD": 0,
-		"ZONEMAP": 1,
-		"BSI":     2,
+		"INVAILD":   0,
+		"ZONEMAP":   1,
+		"BSI":       2,
+		"SECONDARY": 3,
 	}
 )
 
@@ -479,7 +483,108 @@ func (x OrderBySpec_OrderByFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OrderBySpec_OrderByFlag.Descriptor instead.
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{21, 0}
+	return file_plan_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_UNBOUNDED_PRECEDING FrameBound_BoundType = 0
+	FrameBound_PRECEDING           FrameBound_BoundType = 1
+	FrameBound_CURRENT_ROW         FrameBound_BoundType = 2
+	FrameBound_FOLLOWING           FrameBound_BoundType = 3
+	FrameBound_UNBOUNDED_FOLLOWING FrameBound_BoundType = 4
+)
+
+// Enum value maps for FrameBound_BoundType.
+var (
+	FrameBound_BoundType_name = map[int32]string{
+		0: "UNBOUNDED_PRECEDING",
+		1: "PRECEDING",
+		2: "CURRENT_ROW",
+		3: "FOLLOWING",
+		4: "UNBOUNDED_FOLLOWING",
+	}
+	FrameBound_BoundType_value = map[string]int32{
+		"UNBOUNDED_PRECEDING": 0,
+		"PRECEDING":           1,
+		"CURRENT_ROW":         2,
+		"FOLLOWING":           3,
+		"UNBOUNDED_FOLLOWING": 4,
+	}
+)
+
+func (x FrameBound_BoundType) Enum() *FrameBound_BoundType {
+	p := new(FrameBound_BoundType)
+	*p = x
+	return p
+}
+
+func (x FrameBound_BoundType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameBound_BoundType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[6].Descriptor()
+}
+
+func (FrameBound_BoundType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[6]
+}
+
+func (x FrameBound_BoundType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameBound_BoundType.Descriptor instead.
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+// Enum value maps for FrameClause_FrameType.
+var (
+	FrameClause_FrameType_name = map[int32]string{
+		0: "ROWS",
+		1: "RANGE",
+	}
+	FrameClause_FrameType_value = map[string]int32{
+		"ROWS":  0,
+		"RANGE": 1,
+	}
+)
+
+func (x FrameClause_FrameType) Enum() *FrameClause_FrameType {
+	p := new(FrameClause_FrameType)
+	*p = x
+	return p
+}
+
+func (x FrameClause_FrameType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameClause_FrameType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[7].Descriptor()
+}
+
+func (FrameClause_FrameType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[7]
+}
+
+func (x FrameClause_FrameType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameClause_FrameType.Descriptor instead.
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{24, 0}
 }
 
 type Node_NodeType int32
@@ -492,6 +597,7 @@ const (
 	Node_FUNCTION_SCAN Node_NodeType = 3
 	Node_EXTERNAL_SCAN Node_NodeType = 4
 	Node_MATERIAL_SCAN Node_NodeType = 5
+	Node_CTE_SCAN      Node_NodeType = 6
 	// Proj, for convinience
 	Node_PROJECT Node_NodeType = 10
 	// External function call (UDF)
@@ -510,6 +616,8 @@ const (
 	Node_UNION_ALL Node_NodeType = 35
 	Node_UNIQUE    Node_NodeType = 36
 	Node_WINDOW    Node_NodeType = 37
+	Node_INTERSECT Node_NodeType = 38
+	Node_MINUS     Node_NodeType = 39
 	// Physical tuple mover
 	Node_BROADCAST Node_NodeType = 40
 	Node_SPLIT     Node_NodeType = 41
@@ -531,6 +639,7 @@ var (
 		3:  "FUNCTION_SCAN",
 		4:  "EXTERNAL_SCAN",
 		5:  "MATERIAL_SCAN",
+		6:  "CTE_SCAN",
 		10: "PROJECT",
 		11: "EXTERNAL_FUNCTION",
 		20: "MATERIAL",
@@ -545,6 +654,8 @@ var (
 		35: "UNION_ALL",
 		36: "UNIQUE",
 		37: "WINDOW",
+		38: "INTERSECT",
+		39: "MINUS",
 		40: "BROADCAST",
 		41: "SPLIT",
 		42: "GATHER",
@@ -560,6 +671,7 @@ var (
 		"FUNCTION_SCAN":     3,
 		"EXTERNAL_SCAN":     4,
 		"MATERIAL_SCAN":     5,
+		"CTE_SCAN":          6,
 		"PROJECT":           10,
 		"EXTERNAL_FUNCTION": 11,
 		"MATERIAL":          20,
@@ -574,6 +686,8 @@ var (
 		"UNION_ALL":         35,
 		"UNIQUE":            36,
 		"WINDOW":            37,
+		"INTERSECT":         38,
+		"MINUS":             39,
 		"BROADCAST":         40,
 		"SPLIT":             41,
 		"GATHER":            42,
@@ -595,11 +709,11 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[6].Descriptor()
+	return file_plan_proto_enumTypes[8].Descriptor()
 }
 
 func (Node_NodeType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[6]
+	return &file_plan_proto_enumTypes[8]
 }
 
 func (x Node_NodeType) Number() protoreflect.EnumNumber {
@@ -608,7 +722,7 @@ func (x Node_NodeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_NodeType.Descriptor instead.
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24, 0}
+	return file_plan_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type Node_JoinFlag int32
@@ -656,11 +770,11 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[7].Descriptor()
+	return file_plan_proto_enumTypes[9].Descriptor()
 }
 
 func (Node_JoinFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[7]
+	return &file_plan_proto_enumTypes[9]
 }
 
 func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
@@ -669,7 +783,7 @@ func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_JoinFlag.Descriptor instead.
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24, 1}
+	return file_plan_proto_rawDescGZIP(), []int{27, 1}
 }
 
 type Node_AggMode int32
@@ -705,11 +819,11 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[8].Descriptor()
+	return file_plan_proto_enumTypes[10].Descriptor()
 }
 
 func (Node_AggMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[8]
+	return &file_plan_proto_enumTypes[10]
 }
 
 func (x Node_AggMode) Number() protoreflect.EnumNumber {
@@ -718,7 +832,7 @@ func (x Node_AggMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_AggMode.Descriptor instead.
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24, 2}
+	return file_plan_proto_rawDescGZIP(), []int{27, 2}
 }
 
 type Query_StatementType int32
@@ -763,11 +877,11 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[9].Descriptor()
+	return file_plan_proto_enumTypes[11].Descriptor()
 }
 
 func (Query_StatementType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[9]
+	return &file_plan_proto_enumTypes[11]
 }
 
 func (x Query_StatementType) Number() protoreflect.EnumNumber {
@@ -776,7 +890,7 @@ func (x Query_StatementType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Query_StatementType.Descriptor instead.
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{25, 0}
+	return file_plan_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type TransationControl_TclType int32
@@ -812,11 +926,11 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[10].Descriptor()
+	return file_plan_proto_enumTypes[12].Descriptor()
 }
 
 func (TransationControl_TclType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[10]
+	return &file_plan_proto_enumTypes[12]
 }
 
 func (x TransationControl_TclType) Number() protoreflect.EnumNumber {
@@ -825,7 +939,7 @@ func (x TransationControl_TclType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationControl_TclType.Descriptor instead.
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26, 0}
+	return file_plan_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -861,11 +975,11 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[11].Descriptor()
+	return file_plan_proto_enumTypes[13].Descriptor()
 }
 
 func (TransationBegin_TransationMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[11]
+	return &file_plan_proto_enumTypes[13]
 }
 
 func (x TransationBegin_TransationMode) Number() protoreflect.EnumNumber {
@@ -874,7 +988,7 @@ func (x TransationBegin_TransationMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationBegin_TransationMode.Descriptor instead.
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{27, 0}
+	return file_plan_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -931,11 +1045,11 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[12].Descriptor()
+	return file_plan_proto_enumTypes[14].Descriptor()
 }
 
 func (DataDefinition_DdlType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[12]
+	return &file_plan_proto_enumTypes[14]
 }
 
 func (x DataDefinition_DdlType) Number() protoreflect.EnumNumber {
@@ -944,7 +1058,7 @@ func (x DataDefinition_DdlType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataDefinition_DdlType.Descriptor instead.
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{31, 0}
+	return file_plan_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type Type struct {
@@ -2309,6 +2423,112 @@ func (x *Cost) GetTotal() float64 {
 	return 0
 }
 
+// AnalyzeInfo is the runtime statistics of the operators of a node
+type AnalyzeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InputRows     int64 `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
+	OutputRows    int64 `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
+	InputBatches  int64 `protobuf:"varint,3,opt,name=input_batches,json=inputBatches,proto3" json:"input_batches,omitempty"`
+	OutputBatches int64 `protobuf:"varint,4,opt,name=output_batches,json=outputBatches,proto3" json:"output_batches,omitempty"`
+	// wall time in nanoseconds
+	TimeConsumed int64 `protobuf:"varint,5,opt,name=time_consumed,json=timeConsumed,proto3" json:"time_consumed,omitempty"`
+	MemoryPeak   int64 `protobuf:"varint,6,opt,name=memory_peak,json=memoryPeak,proto3" json:"memory_peak,omitempty"`
+	SpillBytes   int64 `protobuf:"varint,7,opt,name=spill_bytes,json=spillBytes,proto3" json:"spill_bytes,omitempty"`
+	// the number of the calls of the operators
+	Calls int64 `protobuf:"varint,8,opt,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *AnalyzeInfo) Reset() {
+	*x = AnalyzeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeInfo) ProtoMessage() {}
+
+func (x *AnalyzeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeInfo.ProtoReflect.Descriptor instead.
+func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnalyzeInfo) GetInputRows() int64 {
+	if x != nil {
+		return x.InputRows
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetOutputRows() int64 {
+	if x != nil {
+		return x.OutputRows
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetInputBatches() int64 {
+	if x != nil {
+		return x.InputBatches
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetOutputBatches() int64 {
+	if x != nil {
+		return x.OutputBatches
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetTimeConsumed() int64 {
+	if x != nil {
+		return x.TimeConsumed
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetMemoryPeak() int64 {
+	if x != nil {
+		return x.MemoryPeak
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetSpillBytes() int64 {
+	if x != nil {
+		return x.SpillBytes
+	}
+	return 0
+}
+
+func (x *AnalyzeInfo) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
 type ColData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2327,7 +2547,7 @@ type ColData struct {
 func (x *ColData) Reset() {
 	*x = ColData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[19]
+		mi := &file_plan_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2340,7 +2560,7 @@ func (x *ColData) String() string {
 func (*ColData) ProtoMessage() {}
 
 func (x *ColData) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[19]
+	mi := &file_plan_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,7 +2573,7 @@ func (x *ColData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColData.ProtoReflect.Descriptor instead.
 func (*ColData) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19}
+	return file_plan_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ColData) GetRowCount() int32 {
@@ -2424,7 +2644,7 @@ type RowsetData struct {
 func (x *RowsetData) Reset() {
 	*x = RowsetData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[20]
+		mi := &file_plan_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2437,7 +2657,7 @@ func (x *RowsetData) String() string {
 func (*RowsetData) ProtoMessage() {}
 
 func (x *RowsetData) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[20]
+	mi := &file_plan_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,7 +2670,7 @@ func (x *RowsetData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowsetData.ProtoReflect.Descriptor instead.
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{20}
+	return file_plan_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RowsetData) GetSchema() *TableDef {
@@ -2480,7 +2700,7 @@ type OrderBySpec struct {
 func (x *OrderBySpec) Reset() {
 	*x = OrderBySpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[21]
+		mi := &file_plan_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2493,7 +2713,7 @@ func (x *OrderBySpec) String() string {
 func (*OrderBySpec) ProtoMessage() {}
 
 func (x *OrderBySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[21]
+	mi := &file_plan_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2506,7 +2726,7 @@ func (x *OrderBySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderBySpec.ProtoReflect.Descriptor instead.
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{21}
+	return file_plan_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *OrderBySpec) GetOrderBy() *Expr {
@@ -2530,34 +2750,34 @@ func (x *OrderBySpec) GetOrderByFlags() OrderBySpec_OrderByFlag {
 	return OrderBySpec_ASC
 }
 
-type WindowSpec struct {
+// The bound of a window frame, offset is the number of rows or the range
+// of PRECEDING and FOLLOWING
+type FrameBound struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PartitionBy []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OderyBy     []*OrderBySpec `protobuf:"bytes,2,rep,name=odery_by,json=oderyBy,proto3" json:"odery_by,omitempty"`
-	Lead        int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
-	Lag         int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	Type   FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=FrameBound_BoundType" json:"type,omitempty"`
+	Offset *Expr                `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *WindowSpec) Reset() {
-	*x = WindowSpec{}
+func (x *FrameBound) Reset() {
+	*x = FrameBound{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[22]
+		mi := &file_plan_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WindowSpec) String() string {
+func (x *FrameBound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowSpec) ProtoMessage() {}
+func (*FrameBound) ProtoMessage() {}
 
-func (x *WindowSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[22]
+func (x *FrameBound) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,65 +2788,52 @@ func (x *WindowSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowSpec.ProtoReflect.Descriptor instead.
-func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use FrameBound.ProtoReflect.Descriptor instead.
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *WindowSpec) GetPartitionBy() []*Expr {
+func (x *FrameBound) GetType() FrameBound_BoundType {
 	if x != nil {
-		return x.PartitionBy
+		return x.Type
 	}
-	return nil
+	return FrameBound_UNBOUNDED_PRECEDING
 }
 
-func (x *WindowSpec) GetOderyBy() []*OrderBySpec {
+func (x *FrameBound) GetOffset() *Expr {
 	if x != nil {
-		return x.OderyBy
+		return x.Offset
 	}
 	return nil
 }
 
-func (x *WindowSpec) GetLead() int32 {
-	if x != nil {
-		return x.Lead
-	}
-	return 0
-}
-
-func (x *WindowSpec) GetLag() int32 {
-	if x != nil {
-		return x.Lag
-	}
-	return 0
-}
-
-type UpdateList struct {
+type FrameClause struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Columns []*Expr `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
-	Values  []*Expr `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Type  FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=FrameClause_FrameType" json:"type,omitempty"`
+	Start *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *UpdateList) Reset() {
-	*x = UpdateList{}
+func (x *FrameClause) Reset() {
+	*x = FrameClause{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[23]
+		mi := &file_plan_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateList) String() string {
+func (x *FrameClause) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateList) ProtoMessage() {}
+func (*FrameClause) ProtoMessage() {}
 
-func (x *UpdateList) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[23]
+func (x *FrameClause) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,55 +2844,210 @@ func (x *UpdateList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateList.ProtoReflect.Descriptor instead.
-func (*UpdateList) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use FrameClause.ProtoReflect.Descriptor instead.
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateList) GetColumns() []*Expr {
+func (x *FrameClause) GetType() FrameClause_FrameType {
 	if x != nil {
-		return x.Columns
+		return x.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (x *FrameClause) GetStart() *FrameBound {
+	if x != nil {
+		return x.Start
 	}
 	return nil
 }
 
-func (x *UpdateList) GetValues() []*Expr {
+func (x *FrameClause) GetEnd() *FrameBound {
 	if x != nil {
-		return x.Values
+		return x.End
 	}
 	return nil
 }
 
-type Node struct {
+type WindowSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeType     Node_NodeType  `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=Node_NodeType" json:"node_type,omitempty"`
-	NodeId       int32          `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Cost         *Cost          `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	ProjectList  []*Expr        `protobuf:"bytes,4,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
-	Children     []int32        `protobuf:"varint,5,rep,packed,name=children,proto3" json:"children,omitempty"`
-	JoinType     Node_JoinFlag  `protobuf:"varint,6,opt,name=join_type,json=joinType,proto3,enum=Node_JoinFlag" json:"join_type,omitempty"`
-	OnList       []*Expr        `protobuf:"bytes,7,rep,name=on_list,json=onList,proto3" json:"on_list,omitempty"`
-	WhereList    []*Expr        `protobuf:"bytes,8,rep,name=where_list,json=whereList,proto3" json:"where_list,omitempty"`
-	GroupBy      []*Expr        `protobuf:"bytes,9,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
-	GroupingSet  []*Expr        `protobuf:"bytes,10,rep,name=grouping_set,json=groupingSet,proto3" json:"grouping_set,omitempty"`
-	OrderBy      []*OrderBySpec `protobuf:"bytes,11,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	UpdateList   *UpdateList    `protobuf:"bytes,12,opt,name=update_list,json=updateList,proto3" json:"update_list,omitempty"`
-	WinSpec      *WindowSpec    `protobuf:"bytes,13,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
-	Limit        *Expr          `protobuf:"bytes,14,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset       *Expr          `protobuf:"bytes,15,opt,name=offset,proto3" json:"offset,omitempty"`
-	TableDef     *TableDef      `protobuf:"bytes,16,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	ObjRef       *ObjectRef     `protobuf:"bytes,17,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
-	RowsetData   *RowsetData    `protobuf:"bytes,18,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
-	ExtraOptions string         `protobuf:"bytes,19,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
-}
-
+	PartitionBy []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OderyBy     []*OrderBySpec `protobuf:"bytes,2,rep,name=odery_by,json=oderyBy,proto3" json:"odery_by,omitempty"`
+	Lead        int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
+	Lag         int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	// The window functions computed over the window
+	WindowFuncs []*Expr      `protobuf:"bytes,5,rep,name=window_funcs,json=windowFuncs,proto3" json:"window_funcs,omitempty"`
+	Frame       *FrameClause `protobuf:"bytes,6,opt,name=frame,proto3" json:"frame,omitempty"`
+}
+
+func (x *WindowSpec) Reset() {
+	*x = WindowSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowSpec) ProtoMessage() {}
+
+func (x *WindowSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowSpec.ProtoReflect.Descriptor instead.
+func (*WindowSpec) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WindowSpec) GetPartitionBy() []*Expr {
+	if x != nil {
+		return x.PartitionBy
+	}
+	return nil
+}
+
+func (x *WindowSpec) GetOderyBy() []*OrderBySpec {
+	if x != nil {
+		return x.OderyBy
+	}
+	return nil
+}
+
+func (x *WindowSpec) GetLead() int32 {
+	if x != nil {
+		return x.Lead
+	}
+	return 0
+}
+
+func (x *WindowSpec) GetLag() int32 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *WindowSpec) GetWindowFuncs() []*Expr {
+	if x != nil {
+		return x.WindowFuncs
+	}
+	return nil
+}
+
+func (x *WindowSpec) GetFrame() *FrameClause {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+type UpdateList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Columns []*Expr `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Values  []*Expr `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *UpdateList) Reset() {
+	*x = UpdateList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateList) ProtoMessage() {}
+
+func (x *UpdateList) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateList.ProtoReflect.Descriptor instead.
+func (*UpdateList) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateList) GetColumns() []*Expr {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *UpdateList) GetValues() []*Expr {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeType     Node_NodeType  `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=Node_NodeType" json:"node_type,omitempty"`
+	NodeId       int32          `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Cost         *Cost          `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	ProjectList  []*Expr        `protobuf:"bytes,4,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
+	Children     []int32        `protobuf:"varint,5,rep,packed,name=children,proto3" json:"children,omitempty"`
+	JoinType     Node_JoinFlag  `protobuf:"varint,6,opt,name=join_type,json=joinType,proto3,enum=Node_JoinFlag" json:"join_type,omitempty"`
+	OnList       []*Expr        `protobuf:"bytes,7,rep,name=on_list,json=onList,proto3" json:"on_list,omitempty"`
+	WhereList    []*Expr        `protobuf:"bytes,8,rep,name=where_list,json=whereList,proto3" json:"where_list,omitempty"`
+	GroupBy      []*Expr        `protobuf:"bytes,9,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	GroupingSet  []*Expr        `protobuf:"bytes,10,rep,name=grouping_set,json=groupingSet,proto3" json:"grouping_set,omitempty"`
+	OrderBy      []*OrderBySpec `protobuf:"bytes,11,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	UpdateList   *UpdateList    `protobuf:"bytes,12,opt,name=update_list,json=updateList,proto3" json:"update_list,omitempty"`
+	WinSpec      *WindowSpec    `protobuf:"bytes,13,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
+	Limit        *Expr          `protobuf:"bytes,14,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset       *Expr          `protobuf:"bytes,15,opt,name=offset,proto3" json:"offset,omitempty"`
+	TableDef     *TableDef      `protobuf:"bytes,16,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	ObjRef       *ObjectRef     `protobuf:"bytes,17,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
+	RowsetData   *RowsetData    `protobuf:"bytes,18,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
+	ExtraOptions string         `protobuf:"bytes,19,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	AnalyzeInfo  *AnalyzeInfo   `protobuf:"bytes,20,opt,name=analyze_info,json=analyzeInfo,proto3" json:"analyze_info,omitempty"`
+	// The root node of the step a CTE_SCAN reads
+	SourceStep int32 `protobuf:"varint,21,opt,name=source_step,json=sourceStep,proto3" json:"source_step,omitempty"`
+	// The blocks a TABLE_SCAN reads
+	PruneInfo *PruneInfo `protobuf:"bytes,22,opt,name=prune_info,json=pruneInfo,proto3" json:"prune_info,omitempty"`
+}
+
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[24]
+		mi := &file_plan_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2698,7 +3060,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[24]
+	mi := &file_plan_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2711,7 +3073,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24}
+	return file_plan_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Node) GetNodeType() Node_NodeType {
@@ -2847,6 +3209,27 @@ func (x *Node) GetExtraOptions() string {
 	return ""
 }
 
+func (x *Node) GetAnalyzeInfo() *AnalyzeInfo {
+	if x != nil {
+		return x.AnalyzeInfo
+	}
+	return nil
+}
+
+func (x *Node) GetSourceStep() int32 {
+	if x != nil {
+		return x.SourceStep
+	}
+	return 0
+}
+
+func (x *Node) GetPruneInfo() *PruneInfo {
+	if x != nil {
+		return x.PruneInfo
+	}
+	return nil
+}
+
 type Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2861,12 +3244,14 @@ type Query struct {
 	Nodes []*Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	// Bound Parameter for the query.
 	Params []*Expr `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	// The hints which are not honored.
+	Warnings []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
 func (x *Query) Reset() {
 	*x = Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[25]
+		mi := &file_plan_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2879,7 +3264,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[25]
+	mi := &file_plan_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,7 +3277,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{25}
+	return file_plan_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Query) GetStmtType() Query_StatementType {
@@ -2923,6 +3308,13 @@ func (x *Query) GetParams() []*Expr {
 	return nil
 }
 
+func (x *Query) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type TransationControl struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2940,7 +3332,7 @@ type TransationControl struct {
 func (x *TransationControl) Reset() {
 	*x = TransationControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[26]
+		mi := &file_plan_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2953,7 +3345,7 @@ func (x *TransationControl) String() string {
 func (*TransationControl) ProtoMessage() {}
 
 func (x *TransationControl) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[26]
+	mi := &file_plan_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,7 +3358,7 @@ func (x *TransationControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationControl.ProtoReflect.Descriptor instead.
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26}
+	return file_plan_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TransationControl) GetTclType() TransationControl_TclType {
@@ -3037,7 +3429,7 @@ type TransationBegin struct {
 func (x *TransationBegin) Reset() {
 	*x = TransationBegin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[27]
+		mi := &file_plan_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3050,7 +3442,7 @@ func (x *TransationBegin) String() string {
 func (*TransationBegin) ProtoMessage() {}
 
 func (x *TransationBegin) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[27]
+	mi := &file_plan_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3063,7 +3455,7 @@ func (x *TransationBegin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationBegin.ProtoReflect.Descriptor instead.
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{27}
+	return file_plan_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TransationBegin) GetMode() TransationBegin_TransationMode {
@@ -3084,7 +3476,7 @@ type TransationCommit struct {
 func (x *TransationCommit) Reset() {
 	*x = TransationCommit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[28]
+		mi := &file_plan_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3097,7 +3489,7 @@ func (x *TransationCommit) String() string {
 func (*TransationCommit) ProtoMessage() {}
 
 func (x *TransationCommit) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[28]
+	mi := &file_plan_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3110,7 +3502,7 @@ func (x *TransationCommit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationCommit.ProtoReflect.Descriptor instead.
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{28}
+	return file_plan_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TransationCommit) GetCompletionType() TransationCompletionType {
@@ -3131,7 +3523,7 @@ type TransationRollback struct {
 func (x *TransationRollback) Reset() {
 	*x = TransationRollback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[29]
+		mi := &file_plan_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3144,7 +3536,7 @@ func (x *TransationRollback) String() string {
 func (*TransationRollback) ProtoMessage() {}
 
 func (x *TransationRollback) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[29]
+	mi := &file_plan_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,7 +3549,7 @@ func (x *TransationRollback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationRollback.ProtoReflect.Descriptor instead.
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{29}
+	return file_plan_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TransationRollback) GetCompletionType() TransationCompletionType {
@@ -3182,7 +3574,7 @@ type Plan struct {
 func (x *Plan) Reset() {
 	*x = Plan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[30]
+		mi := &file_plan_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3195,7 +3587,7 @@ func (x *Plan) String() string {
 func (*Plan) ProtoMessage() {}
 
 func (x *Plan) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[30]
+	mi := &file_plan_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3208,7 +3600,7 @@ func (x *Plan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plan.ProtoReflect.Descriptor instead.
 func (*Plan) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{30}
+	return file_plan_proto_rawDescGZIP(), []int{33}
 }
 
 func (m *Plan) GetPlan() isPlan_Plan {
@@ -3285,7 +3677,7 @@ type DataDefinition struct {
 func (x *DataDefinition) Reset() {
 	*x = DataDefinition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[31]
+		mi := &file_plan_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3298,7 +3690,7 @@ func (x *DataDefinition) String() string {
 func (*DataDefinition) ProtoMessage() {}
 
 func (x *DataDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[31]
+	mi := &file_plan_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3311,7 +3703,7 @@ func (x *DataDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataDefinition.ProtoReflect.Descriptor instead.
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{31}
+	return file_plan_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DataDefinition) GetDdlType() DataDefinition_DdlType {
@@ -3474,7 +3866,7 @@ type CreateDatabase struct {
 func (x *CreateDatabase) Reset() {
 	*x = CreateDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[32]
+		mi := &file_plan_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3487,7 +3879,7 @@ func (x *CreateDatabase) String() string {
 func (*CreateDatabase) ProtoMessage() {}
 
 func (x *CreateDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[32]
+	mi := &file_plan_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3500,7 +3892,7 @@ func (x *CreateDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDatabase.ProtoReflect.Descriptor instead.
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{32}
+	return file_plan_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateDatabase) GetIfNotExists() bool {
@@ -3529,7 +3921,7 @@ type AlterDatabase struct {
 func (x *AlterDatabase) Reset() {
 	*x = AlterDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[33]
+		mi := &file_plan_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3542,7 +3934,7 @@ func (x *AlterDatabase) String() string {
 func (*AlterDatabase) ProtoMessage() {}
 
 func (x *AlterDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[33]
+	mi := &file_plan_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3555,7 +3947,7 @@ func (x *AlterDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterDatabase.ProtoReflect.Descriptor instead.
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{33}
+	return file_plan_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AlterDatabase) GetIfExists() bool {
@@ -3584,7 +3976,7 @@ type DropDatabase struct {
 func (x *DropDatabase) Reset() {
 	*x = DropDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[34]
+		mi := &file_plan_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3597,7 +3989,7 @@ func (x *DropDatabase) String() string {
 func (*DropDatabase) ProtoMessage() {}
 
 func (x *DropDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[34]
+	mi := &file_plan_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3610,7 +4002,7 @@ func (x *DropDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropDatabase.ProtoReflect.Descriptor instead.
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{34}
+	return file_plan_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DropDatabase) GetIfExists() bool {
@@ -3641,7 +4033,7 @@ type CreateTable struct {
 func (x *CreateTable) Reset() {
 	*x = CreateTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[35]
+		mi := &file_plan_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3654,7 +4046,7 @@ func (x *CreateTable) String() string {
 func (*CreateTable) ProtoMessage() {}
 
 func (x *CreateTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[35]
+	mi := &file_plan_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3667,7 +4059,7 @@ func (x *CreateTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTable.ProtoReflect.Descriptor instead.
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{35}
+	return file_plan_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateTable) GetIfNotExists() bool {
@@ -3710,7 +4102,7 @@ type AlterTable struct {
 func (x *AlterTable) Reset() {
 	*x = AlterTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[36]
+		mi := &file_plan_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3723,7 +4115,7 @@ func (x *AlterTable) String() string {
 func (*AlterTable) ProtoMessage() {}
 
 func (x *AlterTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[36]
+	mi := &file_plan_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +4128,7 @@ func (x *AlterTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterTable.ProtoReflect.Descriptor instead.
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{36}
+	return file_plan_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AlterTable) GetTable() string {
@@ -3765,7 +4157,7 @@ type DropTable struct {
 func (x *DropTable) Reset() {
 	*x = DropTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[37]
+		mi := &file_plan_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3778,7 +4170,7 @@ func (x *DropTable) String() string {
 func (*DropTable) ProtoMessage() {}
 
 func (x *DropTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[37]
+	mi := &file_plan_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3791,7 +4183,7 @@ func (x *DropTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropTable.ProtoReflect.Descriptor instead.
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{37}
+	return file_plan_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DropTable) GetIfExists() bool {
@@ -3820,7 +4212,7 @@ type CreateIndex struct {
 func (x *CreateIndex) Reset() {
 	*x = CreateIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[38]
+		mi := &file_plan_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3833,7 +4225,7 @@ func (x *CreateIndex) String() string {
 func (*CreateIndex) ProtoMessage() {}
 
 func (x *CreateIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[38]
+	mi := &file_plan_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3846,7 +4238,7 @@ func (x *CreateIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIndex.ProtoReflect.Descriptor instead.
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{38}
+	return file_plan_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CreateIndex) GetIfNotExists() bool {
@@ -3874,7 +4266,7 @@ type AlterIndex struct {
 func (x *AlterIndex) Reset() {
 	*x = AlterIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[39]
+		mi := &file_plan_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3887,7 +4279,7 @@ func (x *AlterIndex) String() string {
 func (*AlterIndex) ProtoMessage() {}
 
 func (x *AlterIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[39]
+	mi := &file_plan_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3900,7 +4292,7 @@ func (x *AlterIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterIndex.ProtoReflect.Descriptor instead.
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{39}
+	return file_plan_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AlterIndex) GetIndex() string {
@@ -3922,7 +4314,7 @@ type DropIndex struct {
 func (x *DropIndex) Reset() {
 	*x = DropIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[40]
+		mi := &file_plan_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3935,7 +4327,7 @@ func (x *DropIndex) String() string {
 func (*DropIndex) ProtoMessage() {}
 
 func (x *DropIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[40]
+	mi := &file_plan_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3948,7 +4340,7 @@ func (x *DropIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropIndex.ProtoReflect.Descriptor instead.
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{40}
+	return file_plan_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DropIndex) GetIfExists() bool {
@@ -3976,7 +4368,7 @@ type TruncateTable struct {
 func (x *TruncateTable) Reset() {
 	*x = TruncateTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[41]
+		mi := &file_plan_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3989,7 +4381,7 @@ func (x *TruncateTable) String() string {
 func (*TruncateTable) ProtoMessage() {}
 
 func (x *TruncateTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[41]
+	mi := &file_plan_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4002,7 +4394,7 @@ func (x *TruncateTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TruncateTable.ProtoReflect.Descriptor instead.
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{41}
+	return file_plan_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *TruncateTable) GetTable() string {
@@ -4012,6 +4404,277 @@ func (x *TruncateTable) GetTable() string {
 	return ""
 }
 
+// The id of a block of a table
+type BlockRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId uint64   `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	BlockId   uint64   `protobuf:"varint,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Rows      []uint32 `protobuf:"varint,3,rep,packed,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *BlockRef) Reset() {
+	*x = BlockRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRef) ProtoMessage() {}
+
+func (x *BlockRef) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRef.ProtoReflect.Descriptor instead.
+func (*BlockRef) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BlockRef) GetSegmentId() uint64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *BlockRef) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *BlockRef) GetRows() []uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// The pruning of the partitions and the blocks of a table scan
+type PruneInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sargable filters of the scan, comparisons of its columns to
+	// constants, which the zone maps of the blocks are checked against
+	Predicates []*Expr `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// The partitions left, empty if the table is not partitioned
+	Partitions []uint32 `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	// The blocks left in the order of their segments, if resolved
+	Blocks   []*BlockRef `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Resolved bool        `protobuf:"varint,4,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// The blocks are pruned at the execution, as the predicates are known
+	// once the plan is bound, such as the ones of the parameters
+	Deferred bool `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	// The predicates are restricted to the columns by the index hints
+	Restricted bool     `protobuf:"varint,6,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	Columns    []string `protobuf:"bytes,7,rep,name=columns,proto3" json:"columns,omitempty"`
+	// The secondary index of the equality predicate the blocks and the rows
+	// are looked up by, empty if the blocks are scanned
+	Index string `protobuf:"bytes,8,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *PruneInfo) Reset() {
+	*x = PruneInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneInfo) ProtoMessage() {}
+
+func (x *PruneInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneInfo.ProtoReflect.Descriptor instead.
+func (*PruneInfo) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PruneInfo) GetPredicates() []*Expr {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+func (x *PruneInfo) GetPartitions() []uint32 {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *PruneInfo) GetBlocks() []*BlockRef {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *PruneInfo) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *PruneInfo) GetDeferred() bool {
+	if x != nil {
+		return x.Deferred
+	}
+	return false
+}
+
+func (x *PruneInfo) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+func (x *PruneInfo) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *PruneInfo) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+// A part of a query cut at its exchanges, which runs on one node and sends
+// its rows to the exchange of its parent fragment
+type Fragment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the encoding of the fragment
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Id      int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The fragment receiving the rows, -1 for the root fragment
+	Parent int32 `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The exchange node of the parent fragment receiving the rows
+	ExchangeId int32 `protobuf:"varint,4,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	// The nodes of the fragment, whose ids are local to it. The exchanges
+	// receiving the rows of its children fragments are leaves of it
+	Query    *Query  `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	Children []int32 `protobuf:"varint,6,rep,packed,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *Fragment) Reset() {
+	*x = Fragment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fragment) ProtoMessage() {}
+
+func (x *Fragment) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fragment.ProtoReflect.Descriptor instead.
+func (*Fragment) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Fragment) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Fragment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Fragment) GetParent() int32 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
+func (x *Fragment) GetExchangeId() int32 {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return 0
+}
+
+func (x *Fragment) GetQuery() *Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *Fragment) GetChildren() []int32 {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 type TableDef_DefType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4027,7 +4690,7 @@ type TableDef_DefType struct {
 func (x *TableDef_DefType) Reset() {
 	*x = TableDef_DefType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[42]
+		mi := &file_plan_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4040,7 +4703,7 @@ func (x *TableDef_DefType) String() string {
 func (*TableDef_DefType) ProtoMessage() {}
 
 func (x *TableDef_DefType) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[42]
+	mi := &file_plan_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4245,331 +4908,421 @@ var file_plan_proto_rawDesc = []byte{
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
 	0x6b, 0x69, 0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6b, 0x69, 0x64,
-	0x78, 0x22, 0x92, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12, 0x25,
+	0x78, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12, 0x25,
 	0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x49, 0x6e,
 	0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65,
 	0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c,
 	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
-	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54,
 	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x49, 0x4c, 0x44, 0x10, 0x00,
 	0x12, 0x0b, 0x0a, 0x07, 0x5a, 0x4f, 0x4e, 0x45, 0x4d, 0x41, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a,
-	0x03, 0x42, 0x53, 0x49, 0x10, 0x02, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x32, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44,
-	0x65, 0x66, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xe8, 0x01,
-	0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
-	0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64,
-	0x65, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x65,
-	0x66, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
-	0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x02, 0x70, 0x6b,
-	0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12,
-	0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x22, 0x72, 0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
-	0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6e, 0x64, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6e, 0x64, 0x76,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb1, 0x01, 0x0a,
-	0x07, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x6f, 0x77,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33,
-	0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03,
-	0x69, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10,
-	0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03, 0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32,
-	0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x66,
-	0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x01, 0x73,
-	0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21,
-	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22,
-	0xfc, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x20, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46,
-	0x6c, 0x61, 0x67, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67,
-	0x73, 0x22, 0x5b, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53,
-	0x43, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52,
-	0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41,
-	0x53, 0x54, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08,
-	0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0x85,
-	0x01, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a,
-	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x64, 0x65, 0x72, 0x79,
-	0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x64, 0x65, 0x72, 0x79, 0x42, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x6c, 0x65, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x22, 0x4c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x22, 0xca, 0x09, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a,
-	0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28,
-	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1e, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x0a, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68,
-	0x65, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72,
-	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53,
-	0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69,
-	0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x1d, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26,
-	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65,
-	0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x66, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72,
-	0x6f, 0x77, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72,
-	0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff,
-	0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55,
-	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45,
-	0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11,
-	0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10,
-	0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15,
-	0x0a, 0x11, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41,
-	0x4c, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45,
-	0x5f, 0x43, 0x54, 0x45, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16,
-	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12,
-	0x07, 0x0a, 0x03, 0x41, 0x47, 0x47, 0x10, 0x1e, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e,
-	0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08,
-	0x0a, 0x04, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f,
-	0x4e, 0x10, 0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c,
-	0x10, 0x23, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a,
-	0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52,
-	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c,
-	0x49, 0x54, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2a,
-	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06,
-	0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x35,
-	0x22, 0x55, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05,
-	0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
-	0x41, 0x4e, 0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45,
-	0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05,
-	0x41, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x20, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10,
-	0x02, 0x22, 0xe5, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73,
-	0x74, 0x6d, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73,
-	0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x1d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x22, 0x57, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e,
-	0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09,
-	0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
-	0x35, 0x0a, 0x08, 0x74, 0x63, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74,
-	0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e,
-	0x12, 0x2b, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a,
-	0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x22, 0x2e, 0x0a, 0x07, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42,
-	0x45, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
-	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02,
-	0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x33,
-	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x22, 0x39, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x0d, 0x0a, 0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0e,
-	0x0a, 0x0a, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x56,
-	0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0x7b, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
-	0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x63, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x6c,
-	0x12, 0x23, 0x0a, 0x03, 0x64, 0x64, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x03, 0x64, 0x64, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x98, 0x06,
-	0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x32, 0x0a, 0x08, 0x64, 0x64, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x64, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x12, 0x37, 0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x72, 0x6f,
-	0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48,
-	0x00, 0x52, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x48, 0x00, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x31, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x48, 0x00, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x37, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
-	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63,
-	0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x07, 0x44, 0x64, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44,
-	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x54,
-	0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a,
-	0x0d, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02,
-	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45,
-	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e,
-	0x44, 0x45, 0x58, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x49,
-	0x4e, 0x44, 0x45, 0x58, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49,
-	0x4e, 0x44, 0x45, 0x58, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41,
-	0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x41, 0x6c,
-	0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x93, 0x01,
-	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a,
-	0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x03, 0x42, 0x53, 0x49, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44,
+	0x41, 0x52, 0x59, 0x10, 0x03, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65,
+	0x66, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xe8, 0x01, 0x0a,
+	0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f,
+	0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65,
+	0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x65, 0x66,
+	0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x02, 0x70, 0x6b, 0x12,
+	0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x30,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44,
+	0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x22, 0x72, 0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63,
+	0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6e, 0x64, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6e, 0x64, 0x76, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x96, 0x02, 0x0a, 0x0b,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x61, 0x6b, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x75, 0x6c,
+	0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
+	0x66, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0c, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x5b, 0x0a, 0x0b, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
+	0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0xc4, 0x01, 0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x6c, 0x0a, 0x09, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13,
+	0x55, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x45, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x43, 0x45, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49,
+	0x4e, 0x47, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x45,
+	0x44, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0x9d, 0x01,
+	0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x20, 0x0a, 0x09, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x57, 0x53,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x22, 0xd3, 0x01,
+	0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x64, 0x65, 0x72, 0x79, 0x5f,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x64, 0x65, 0x72, 0x79, 0x42, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c,
+	0x65, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x28, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x46, 0x75, 0x6e, 0x63, 0x73, 0x12,
+	0x22, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0xef, 0x0a, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x46, 0x6c, 0x61, 0x67, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
+	0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0a, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74,
+	0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
 	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x65, 0x66, 0x22, 0x4a, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x64, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22,
-	0x3e, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22,
-	0x47, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22,
-	0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x22, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65,
-	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09,
-	0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f,
+	0x44, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x0c, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x29, 0x0a,
+	0x0a, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70,
+	0x72, 0x75, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa7, 0x03, 0x0a, 0x08, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e,
+	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x45,
+	0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x54, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f,
+	0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a,
+	0x08, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52,
+	0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x54, 0x45, 0x10, 0x15, 0x12, 0x08,
+	0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b,
+	0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x47, 0x47, 0x10, 0x1e,
+	0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41,
+	0x4d, 0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x21,
+	0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55,
+	0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x23, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e,
+	0x49, 0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57,
+	0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x53, 0x45, 0x43, 0x54, 0x10,
+	0x26, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x4e, 0x55, 0x53, 0x10, 0x27, 0x12, 0x0d, 0x0a, 0x09,
+	0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x53,
+	0x50, 0x4c, 0x49, 0x54, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52,
+	0x10, 0x2a, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0a,
+	0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x35, 0x22, 0x55, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09,
+	0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x41, 0x4e, 0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47,
+	0x4c, 0x45, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x10, 0x12, 0x09,
+	0x0a, 0x05, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x20, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f,
+	0x50, 0x10, 0x02, 0x22, 0x81, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a,
+	0x09, 0x73, 0x74, 0x6d, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x57,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45,
+	0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
+	0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35, 0x0a,
+	0x08, 0x74, 0x63, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74, 0x63, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x2b,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x2e,
+	0x0a, 0x07, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x45, 0x47,
+	0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x08,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x39, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x56, 0x0a, 0x10,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7b,
+	0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x63, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x6c, 0x12, 0x23,
+	0x0a, 0x03, 0x64, 0x64, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03,
+	0x64, 0x64, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x98, 0x06, 0x0a, 0x0e,
+	0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
+	0x0a, 0x08, 0x64, 0x64, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x64, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0c, 0x64, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x48, 0x00, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x2e, 0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x48, 0x00, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a,
+	0x0e, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x07, 0x44, 0x64, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x54, 0x45, 0x52,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x05, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45,
+	0x58, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x44,
+	0x45, 0x58, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x44,
+	0x45, 0x58, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f,
 	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d,
-	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x2a, 0x21, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x4c, 0x7a, 0x34, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52,
-	0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61,
-	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
+	0x66, 0x22, 0x4a, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
+	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x3e, 0x0a,
+	0x09, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66,
+	0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d,
+	0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x22, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x72,
+	0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x54, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x58, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x66, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x09,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0a, 0x70, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x21, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa7, 0x01, 0x0a, 0x08,
+	0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x2a, 0x21, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x4c, 0x7a, 0x34, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70,
+	0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4584,8 +5337,8 @@ func file_plan_proto_rawDescGZIP() []byte {
 	return file_plan_proto_rawDescData
 }
 
-var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
-var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_plan_proto_goTypes = []interface{}{
 	(CompressType)(0),                   // 0: CompressType
 	(TransationCompletionType)(0),       // 1: TransationCompletionType
@@ -4593,137 +5346,157 @@ var file_plan_proto_goTypes = []interface{}{
 	(Function_FuncFlag)(0),              // 3: Function.FuncFlag
 	(IndexDef_IndexType)(0),             // 4: IndexDef.IndexType
 	(OrderBySpec_OrderByFlag)(0),        // 5: OrderBySpec.OrderByFlag
-	(Node_NodeType)(0),                  // 6: Node.NodeType
-	(Node_JoinFlag)(0),                  // 7: Node.JoinFlag
-	(Node_AggMode)(0),                   // 8: Node.AggMode
-	(Query_StatementType)(0),            // 9: Query.StatementType
-	(TransationControl_TclType)(0),      // 10: TransationControl.TclType
-	(TransationBegin_TransationMode)(0), // 11: TransationBegin.TransationMode
-	(DataDefinition_DdlType)(0),         // 12: DataDefinition.DdlType
-	(*Type)(nil),                        // 13: Type
-	(*Const)(nil),                       // 14: Const
-	(*ParamRef)(nil),                    // 15: ParamRef
-	(*VarRef)(nil),                      // 16: VarRef
-	(*ColRef)(nil),                      // 17: ColRef
-	(*CorrColRef)(nil),                  // 18: CorrColRef
-	(*ExprList)(nil),                    // 19: ExprList
-	(*SubQuery)(nil),                    // 20: SubQuery
-	(*ObjectRef)(nil),                   // 21: ObjectRef
-	(*Function)(nil),                    // 22: Function
-	(*Expr)(nil),                        // 23: Expr
-	(*DefaultExpr)(nil),                 // 24: DefaultExpr
-	(*ColDef)(nil),                      // 25: ColDef
-	(*IndexDef)(nil),                    // 26: IndexDef
-	(*PrimaryKeyDef)(nil),               // 27: PrimaryKeyDef
-	(*Property)(nil),                    // 28: Property
-	(*PropertiesDef)(nil),               // 29: PropertiesDef
-	(*TableDef)(nil),                    // 30: TableDef
-	(*Cost)(nil),                        // 31: Cost
-	(*ColData)(nil),                     // 32: ColData
-	(*RowsetData)(nil),                  // 33: RowsetData
-	(*OrderBySpec)(nil),                 // 34: OrderBySpec
-	(*WindowSpec)(nil),                  // 35: WindowSpec
-	(*UpdateList)(nil),                  // 36: UpdateList
-	(*Node)(nil),                        // 37: Node
-	(*Query)(nil),                       // 38: Query
-	(*TransationControl)(nil),           // 39: TransationControl
-	(*TransationBegin)(nil),             // 40: TransationBegin
-	(*TransationCommit)(nil),            // 41: TransationCommit
-	(*TransationRollback)(nil),          // 42: TransationRollback
-	(*Plan)(nil),                        // 43: Plan
-	(*DataDefinition)(nil),              // 44: DataDefinition
-	(*CreateDatabase)(nil),              // 45: CreateDatabase
-	(*AlterDatabase)(nil),               // 46: AlterDatabase
-	(*DropDatabase)(nil),                // 47: DropDatabase
-	(*CreateTable)(nil),                 // 48: CreateTable
-	(*AlterTable)(nil),                  // 49: AlterTable
-	(*DropTable)(nil),                   // 50: DropTable
-	(*CreateIndex)(nil),                 // 51: CreateIndex
-	(*AlterIndex)(nil),                  // 52: AlterIndex
-	(*DropIndex)(nil),                   // 53: DropIndex
-	(*TruncateTable)(nil),               // 54: TruncateTable
-	(*TableDef_DefType)(nil),            // 55: TableDef.DefType
+	(FrameBound_BoundType)(0),           // 6: FrameBound.BoundType
+	(FrameClause_FrameType)(0),          // 7: FrameClause.FrameType
+	(Node_NodeType)(0),                  // 8: Node.NodeType
+	(Node_JoinFlag)(0),                  // 9: Node.JoinFlag
+	(Node_AggMode)(0),                   // 10: Node.AggMode
+	(Query_StatementType)(0),            // 11: Query.StatementType
+	(TransationControl_TclType)(0),      // 12: TransationControl.TclType
+	(TransationBegin_TransationMode)(0), // 13: TransationBegin.TransationMode
+	(DataDefinition_DdlType)(0),         // 14: DataDefinition.DdlType
+	(*Type)(nil),                        // 15: Type
+	(*Const)(nil),                       // 16: Const
+	(*ParamRef)(nil),                    // 17: ParamRef
+	(*VarRef)(nil),                      // 18: VarRef
+	(*ColRef)(nil),                      // 19: ColRef
+	(*CorrColRef)(nil),                  // 20: CorrColRef
+	(*ExprList)(nil),                    // 21: ExprList
+	(*SubQuery)(nil),                    // 22: SubQuery
+	(*ObjectRef)(nil),                   // 23: ObjectRef
+	(*Function)(nil),                    // 24: Function
+	(*Expr)(nil),                        // 25: Expr
+	(*DefaultExpr)(nil),                 // 26: DefaultExpr
+	(*ColDef)(nil),                      // 27: ColDef
+	(*IndexDef)(nil),                    // 28: IndexDef
+	(*PrimaryKeyDef)(nil),               // 29: PrimaryKeyDef
+	(*Property)(nil),                    // 30: Property
+	(*PropertiesDef)(nil),               // 31: PropertiesDef
+	(*TableDef)(nil),                    // 32: TableDef
+	(*Cost)(nil),                        // 33: Cost
+	(*AnalyzeInfo)(nil),                 // 34: AnalyzeInfo
+	(*ColData)(nil),                     // 35: ColData
+	(*RowsetData)(nil),                  // 36: RowsetData
+	(*OrderBySpec)(nil),                 // 37: OrderBySpec
+	(*FrameBound)(nil),                  // 38: FrameBound
+	(*FrameClause)(nil),                 // 39: FrameClause
+	(*WindowSpec)(nil),                  // 40: WindowSpec
+	(*UpdateList)(nil),                  // 41: UpdateList
+	(*Node)(nil),                        // 42: Node
+	(*Query)(nil),                       // 43: Query
+	(*TransationControl)(nil),           // 44: TransationControl
+	(*TransationBegin)(nil),             // 45: TransationBegin
+	(*TransationCommit)(nil),            // 46: TransationCommit
+	(*TransationRollback)(nil),          // 47: TransationRollback
+	(*Plan)(nil),                        // 48: Plan
+	(*DataDefinition)(nil),              // 49: DataDefinition
+	(*CreateDatabase)(nil),              // 50: CreateDatabase
+	(*AlterDatabase)(nil),               // 51: AlterDatabase
+	(*DropDatabase)(nil),                // 52: DropDatabase
+	(*CreateTable)(nil),                 // 53: CreateTable
+	(*AlterTable)(nil),                  // 54: AlterTable
+	(*DropTable)(nil),                   // 55: DropTable
+	(*CreateIndex)(nil),                 // 56: CreateIndex
+	(*AlterIndex)(nil),                  // 57: AlterIndex
+	(*DropIndex)(nil),                   // 58: DropIndex
+	(*TruncateTable)(nil),               // 59: TruncateTable
+	(*BlockRef)(nil),                    // 60: BlockRef
+	(*PruneInfo)(nil),                   // 61: PruneInfo
+	(*Fragment)(nil),                    // 62: Fragment
+	(*TableDef_DefType)(nil),            // 63: TableDef.DefType
 }
 var file_plan_proto_depIdxs = []int32{
 	2,  // 0: Type.id:type_name -> Type.TypeId
-	23, // 1: ExprList.list:type_name -> Expr
-	21, // 2: Function.func:type_name -> ObjectRef
-	23, // 3: Function.args:type_name -> Expr
-	13, // 4: Expr.typ:type_name -> Type
-	14, // 5: Expr.c:type_name -> Const
-	15, // 6: Expr.p:type_name -> ParamRef
-	16, // 7: Expr.v:type_name -> VarRef
-	17, // 8: Expr.col:type_name -> ColRef
-	22, // 9: Expr.f:type_name -> Function
-	19, // 10: Expr.list:type_name -> ExprList
-	20, // 11: Expr.sub:type_name -> SubQuery
-	18, // 12: Expr.corr:type_name -> CorrColRef
-	23, // 13: DefaultExpr.value:type_name -> Expr
+	25, // 1: ExprList.list:type_name -> Expr
+	23, // 2: Function.func:type_name -> ObjectRef
+	25, // 3: Function.args:type_name -> Expr
+	15, // 4: Expr.typ:type_name -> Type
+	16, // 5: Expr.c:type_name -> Const
+	17, // 6: Expr.p:type_name -> ParamRef
+	18, // 7: Expr.v:type_name -> VarRef
+	19, // 8: Expr.col:type_name -> ColRef
+	24, // 9: Expr.f:type_name -> Function
+	21, // 10: Expr.list:type_name -> ExprList
+	22, // 11: Expr.sub:type_name -> SubQuery
+	20, // 12: Expr.corr:type_name -> CorrColRef
+	25, // 13: DefaultExpr.value:type_name -> Expr
 	0,  // 14: ColDef.alg:type_name -> CompressType
-	13, // 15: ColDef.typ:type_name -> Type
-	24, // 16: ColDef.default:type_name -> DefaultExpr
+	15, // 15: ColDef.typ:type_name -> Type
+	26, // 16: ColDef.default:type_name -> DefaultExpr
 	4,  // 17: IndexDef.typ:type_name -> IndexDef.IndexType
-	28, // 18: PropertiesDef.properties:type_name -> Property
-	25, // 19: TableDef.cols:type_name -> ColDef
-	55, // 20: TableDef.defs:type_name -> TableDef.DefType
-	30, // 21: RowsetData.schema:type_name -> TableDef
-	32, // 22: RowsetData.cols:type_name -> ColData
-	23, // 23: OrderBySpec.order_by:type_name -> Expr
+	30, // 18: PropertiesDef.properties:type_name -> Property
+	27, // 19: TableDef.cols:type_name -> ColDef
+	63, // 20: TableDef.defs:type_name -> TableDef.DefType
+	32, // 21: RowsetData.schema:type_name -> TableDef
+	35, // 22: RowsetData.cols:type_name -> ColData
+	25, // 23: OrderBySpec.order_by:type_name -> Expr
 	5,  // 24: OrderBySpec.order_by_flags:type_name -> OrderBySpec.OrderByFlag
-	23, // 25: WindowSpec.partition_by:type_name -> Expr
-	34, // 26: WindowSpec.odery_by:type_name -> OrderBySpec
-	23, // 27: UpdateList.columns:type_name -> Expr
-	23, // 28: UpdateList.values:type_name -> Expr
-	6,  // 29: Node.node_type:type_name -> Node.NodeType
-	31, // 30: Node.cost:type_name -> Cost
-	23, // 31: Node.project_list:type_name -> Expr
-	7,  // 32: Node.join_type:type_name -> Node.JoinFlag
-	23, // 33: Node.on_list:type_name -> Expr
-	23, // 34: Node.where_list:type_name -> Expr
-	23, // 35: Node.group_by:type_name -> Expr
-	23, // 36: Node.grouping_set:type_name -> Expr
-	34, // 37: Node.order_by:type_name -> OrderBySpec
-	36, // 38: Node.update_list:type_name -> UpdateList
-	35, // 39: Node.win_spec:type_name -> WindowSpec
-	23, // 40: Node.limit:type_name -> Expr
-	23, // 41: Node.offset:type_name -> Expr
-	30, // 42: Node.table_def:type_name -> TableDef
-	21, // 43: Node.obj_ref:type_name -> ObjectRef
-	33, // 44: Node.rowset_data:type_name -> RowsetData
-	9,  // 45: Query.stmt_type:type_name -> Query.StatementType
-	37, // 46: Query.nodes:type_name -> Node
-	23, // 47: Query.params:type_name -> Expr
-	10, // 48: TransationControl.tcl_type:type_name -> TransationControl.TclType
-	40, // 49: TransationControl.begin:type_name -> TransationBegin
-	41, // 50: TransationControl.commit:type_name -> TransationCommit
-	42, // 51: TransationControl.rollback:type_name -> TransationRollback
-	11, // 52: TransationBegin.mode:type_name -> TransationBegin.TransationMode
-	1,  // 53: TransationCommit.completion_type:type_name -> TransationCompletionType
-	1,  // 54: TransationRollback.completion_type:type_name -> TransationCompletionType
-	38, // 55: Plan.query:type_name -> Query
-	39, // 56: Plan.tcl:type_name -> TransationControl
-	44, // 57: Plan.ddl:type_name -> DataDefinition
-	12, // 58: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
-	45, // 59: DataDefinition.create_database:type_name -> CreateDatabase
-	46, // 60: DataDefinition.alter_database:type_name -> AlterDatabase
-	47, // 61: DataDefinition.drop_database:type_name -> DropDatabase
-	48, // 62: DataDefinition.create_table:type_name -> CreateTable
-	49, // 63: DataDefinition.alter_table:type_name -> AlterTable
-	50, // 64: DataDefinition.drop_table:type_name -> DropTable
-	51, // 65: DataDefinition.create_index:type_name -> CreateIndex
-	52, // 66: DataDefinition.alter_index:type_name -> AlterIndex
-	53, // 67: DataDefinition.drop_index:type_name -> DropIndex
-	54, // 68: DataDefinition.truncate_table:type_name -> TruncateTable
-	30, // 69: CreateTable.table_def:type_name -> TableDef
-	30, // 70: AlterTable.table_def:type_name -> TableDef
-	27, // 71: TableDef.DefType.pk:type_name -> PrimaryKeyDef
-	26, // 72: TableDef.DefType.idx:type_name -> IndexDef
-	29, // 73: TableDef.DefType.properties:type_name -> PropertiesDef
-	74, // [74:74] is the sub-list for method output_type
-	74, // [74:74] is the sub-list for method input_type
-	74, // [74:74] is the sub-list for extension type_name
-	74, // [74:74] is the sub-list for extension extendee
-	0,  // [0:74] is the sub-list for field type_name
+	6,  // 25: FrameBound.type:type_name -> FrameBound.BoundType
+	25, // 26: FrameBound.offset:type_name -> Expr
+	7,  // 27: FrameClause.type:type_name -> FrameClause.FrameType
+	38, // 28: FrameClause.start:type_name -> FrameBound
+	38, // 29: FrameClause.end:type_name -> FrameBound
+	25, // 30: WindowSpec.partition_by:type_name -> Expr
+	37, // 31: WindowSpec.odery_by:type_name -> OrderBySpec
+	25, // 32: WindowSpec.window_funcs:type_name -> Expr
+	39, // 33: WindowSpec.frame:type_name -> FrameClause
+	25, // 34: UpdateList.columns:type_name -> Expr
+	25, // 35: UpdateList.values:type_name -> Expr
+	8,  // 36: Node.node_type:type_name -> Node.NodeType
+	33, // 37: Node.cost:type_name -> Cost
+	25, // 38: Node.project_list:type_name -> Expr
+	9,  // 39: Node.join_type:type_name -> Node.JoinFlag
+	25, // 40: Node.on_list:type_name -> Expr
+	25, // 41: Node.where_list:type_name -> Expr
+	25, // 42: Node.group_by:type_name -> Expr
+	25, // 43: Node.grouping_set:type_name -> Expr
+	37, // 44: Node.order_by:type_name -> OrderBySpec
+	41, // 45: Node.update_list:type_name -> UpdateList
+	40, // 46: Node.win_spec:type_name -> WindowSpec
+	25, // 47: Node.limit:type_name -> Expr
+	25, // 48: Node.offset:type_name -> Expr
+	32, // 49: Node.table_def:type_name -> TableDef
+	23, // 50: Node.obj_ref:type_name -> ObjectRef
+	36, // 51: Node.rowset_data:type_name -> RowsetData
+	34, // 52: Node.analyze_info:type_name -> AnalyzeInfo
+	61, // 53: Node.prune_info:type_name -> PruneInfo
+	11, // 54: Query.stmt_type:type_name -> Query.StatementType
+	42, // 55: Query.nodes:type_name -> Node
+	25, // 56: Query.params:type_name -> Expr
+	12, // 57: TransationControl.tcl_type:type_name -> TransationControl.TclType
+	45, // 58: TransationControl.begin:type_name -> TransationBegin
+	46, // 59: TransationControl.commit:type_name -> TransationCommit
+	47, // 60: TransationControl.rollback:type_name -> TransationRollback
+	13, // 61: TransationBegin.mode:type_name -> TransationBegin.TransationMode
+	1,  // 62: TransationCommit.completion_type:type_name -> TransationCompletionType
+	1,  // 63: TransationRollback.completion_type:type_name -> TransationCompletionType
+	43, // 64: Plan.query:type_name -> Query
+	44, // 65: Plan.tcl:type_name -> TransationControl
+	49, // 66: Plan.ddl:type_name -> DataDefinition
+	14, // 67: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
+	50, // 68: DataDefinition.create_database:type_name -> CreateDatabase
+	51, // 69: DataDefinition.alter_database:type_name -> AlterDatabase
+	52, // 70: DataDefinition.drop_database:type_name -> DropDatabase
+	53, // 71: DataDefinition.create_table:type_name -> CreateTable
+	54, // 72: DataDefinition.alter_table:type_name -> AlterTable
+	55, // 73: DataDefinition.drop_table:type_name -> DropTable
+	56, // 74: DataDefinition.create_index:type_name -> CreateIndex
+	57, // 75: DataDefinition.alter_index:type_name -> AlterIndex
+	58, // 76: DataDefinition.drop_index:type_name -> DropIndex
+	59, // 77: DataDefinition.truncate_table:type_name -> TruncateTable
+	32, // 78: CreateTable.table_def:type_name -> TableDef
+	32, // 79: AlterTable.table_def:type_name -> TableDef
+	25, // 80: PruneInfo.predicates:type_name -> Expr
+	60, // 81: PruneInfo.blocks:type_name -> BlockRef
+	43, // 82: Fragment.query:type_name -> Query
+	29, // 83: TableDef.DefType.pk:type_name -> PrimaryKeyDef
+	28, // 84: TableDef.DefType.idx:type_name -> IndexDef
+	31, // 85: TableDef.DefType.properties:type_name -> PropertiesDef
+	86, // [86:86] is the sub-list for method output_type
+	86, // [86:86] is the sub-list for method input_type
+	86, // [86:86] is the sub-list for extension type_name
+	86, // [86:86] is the sub-list for extension extendee
+	0,  // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_plan_proto_init() }
@@ -4961,7 +5734,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColData); i {
+			switch v := v.(*AnalyzeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4973,7 +5746,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RowsetData); i {
+			switch v := v.(*ColData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4985,7 +5758,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderBySpec); i {
+			switch v := v.(*RowsetData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4997,7 +5770,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindowSpec); i {
+			switch v := v.(*OrderBySpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5009,7 +5782,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateList); i {
+			switch v := v.(*FrameBound); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5021,7 +5794,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+			switch v := v.(*FrameClause); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5033,7 +5806,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Query); i {
+			switch v := v.(*WindowSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5045,7 +5818,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationControl); i {
+			switch v := v.(*UpdateList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5057,7 +5830,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationBegin); i {
+			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5069,7 +5842,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationCommit); i {
+			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5081,7 +5854,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationRollback); i {
+			switch v := v.(*TransationControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5093,7 +5866,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Plan); i {
+			switch v := v.(*TransationBegin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5105,7 +5878,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataDefinition); i {
+			switch v := v.(*TransationCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5117,7 +5890,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabase); i {
+			switch v := v.(*TransationRollback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5129,7 +5902,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterDatabase); i {
+			switch v := v.(*Plan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5141,7 +5914,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropDatabase); i {
+			switch v := v.(*DataDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5153,7 +5926,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTable); i {
+			switch v := v.(*CreateDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5165,7 +5938,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterTable); i {
+			switch v := v.(*AlterDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5177,7 +5950,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropTable); i {
+			switch v := v.(*DropDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5189,7 +5962,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateIndex); i {
+			switch v := v.(*CreateTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5201,7 +5974,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterIndex); i {
+			switch v := v.(*AlterTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5213,7 +5986,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropIndex); i {
+			switch v := v.(*DropTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5225,7 +5998,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TruncateTable); i {
+			switch v := v.(*CreateIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5237,6 +6010,78 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TruncateTable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fragment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TableDef_DefType); i {
 			case 0:
 				return &v.state
@@ -5264,17 +6109,17 @@ func file_plan_proto_init() {
 		(*Expr_Sub)(nil),
 		(*Expr_Corr)(nil),
 	}
-	file_plan_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*TransationControl_Begin)(nil),
 		(*TransationControl_Commit)(nil),
 		(*TransationControl_Rollback)(nil),
 	}
-	file_plan_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*Plan_Query)(nil),
 		(*Plan_Tcl)(nil),
 		(*Plan_Ddl)(nil),
 	}
-	file_plan_proto_msgTypes[31].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*DataDefinition_CreateDatabase)(nil),
 		(*DataDefinition_AlterDatabase)(nil),
 		(*DataDefinition_DropDatabase)(nil),
@@ -5286,7 +6131,7 @@ func file_plan_proto_init() {
 		(*DataDefinition_DropIndex)(nil),
 		(*DataDefinition_TruncateTable)(nil),
 	}
-	file_plan_proto_msgTypes[42].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[48].OneofWrappers = []interface{}{
 		(*TableDef_DefType_Pk)(nil),
 		(*TableDef_DefType_Idx)(nil),
 		(*TableDef_DefType_Properties)(nil),
@@ -5296,8 +6141,8 @@ func file_plan_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plan_proto_rawDesc,
-			NumEnums:      13,
-			NumMessages:   43,
+			NumEnums:      15,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,227 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/in"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Prepare prepares the IN lists of e for the evaluations of the batches
+func Prepare(e Extend) error {
+	switch v := e.(type) {
+	case *InExtend:
+		return v.Prepare()
+	case *UnaryExtend:
+		return Prepare(v.E)
+	case *NullExtend:
+		return Prepare(v.E)
+	case *ParenExtend:
+		return Prepare(v.E)
+	case *BinaryExtend:
+		if err := Prepare(v.Left); err != nil {
+			return err
+		}
+		return Prepare(v.Right)
+	case *MultiExtend:
+		for _, arg := range v.Args {
+			if err := Prepare(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Prepare hashes the list once, an InExtend not prepared hashes it for
+// every batch
+func (e *InExtend) Prepare() error {
+	if e.set != nil {
+		return nil
+	}
+	set, err := e.newSet()
+	if err != nil {
+		return err
+	}
+	e.set = set
+	return nil
+}
+
+func (_ *InExtend) IsLogical() bool {
+	return true
+}
+
+func (_ *InExtend) IsConstant() bool {
+	return false
+}
+
+func (_ *InExtend) ReturnType() types.T {
+	return types.T_sel
+}
+
+func (e *InExtend) Attributes() []string {
+	return e.E.Attributes()
+}
+
+func (e *InExtend) ExtendAttributes() []*Attribute {
+	return e.E.ExtendAttributes()
+}
+
+func (e *InExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	set := e.set
+	if set == nil {
+		var err error
+
+		if set, err = e.newSet(); err != nil {
+			return nil, 0, err
+		}
+	}
+	vec, _, err := e.E.Eval(bat, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if vec.Ref == 0 {
+			process.Put(proc, vec)
+		}
+	}()
+	n := vector.Length(vec)
+	rvec, err := process.Get(proc, int64(n)*8, overload.SelsType)
+	if err != nil {
+		return nil, 0, err
+	}
+	rs := encoding.DecodeInt64Slice(rvec.Data)[:n]
+	if e.Not && nulls.Any(e.Vs.Nsp) { // x NOT IN (.., NULL) is never true
+		vector.SetCol(rvec, rs[:0])
+		return rvec, types.T_sel, nil
+	}
+	np := vec.Nsp.Np
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		rs = in.Int8In(vec.Col.([]int8), set.(map[int8]struct{}), np, e.Not, rs)
+	case types.T_int16:
+		rs = in.Int16In(vec.Col.([]int16), set.(map[int16]struct{}), np, e.Not, rs)
+	case types.T_int32:
+		rs = in.Int32In(vec.Col.([]int32), set.(map[int32]struct{}), np, e.Not, rs)
+	case types.T_int64:
+		rs = in.Int64In(vec.Col.([]int64), set.(map[int64]struct{}), np, e.Not, rs)
+	case types.T_uint8:
+		rs = in.Uint8In(vec.Col.([]uint8), set.(map[uint8]struct{}), np, e.Not, rs)
+	case types.T_uint16:
+		rs = in.Uint16In(vec.Col.([]uint16), set.(map[uint16]struct{}), np, e.Not, rs)
+	case types.T_uint32:
+		rs = in.Uint32In(vec.Col.([]uint32), set.(map[uint32]struct{}), np, e.Not, rs)
+	case types.T_uint64:
+		rs = in.Uint64In(vec.Col.([]uint64), set.(map[uint64]struct{}), np, e.Not, rs)
+	case types.T_float32:
+		rs = in.Float32In(vec.Col.([]float32), set.(map[float32]struct{}), np, e.Not, rs)
+	case types.T_float64:
+		rs = in.Float64In(vec.Col.([]float64), set.(map[float64]struct{}), np, e.Not, rs)
+	case types.T_decimal64:
+		rs = in.Decimal64In(vec.Col.([]types.Decimal64), set.(map[types.Decimal64]struct{}), np, e.Not, rs)
+	case types.T_decimal128:
+		rs = in.Decimal128In(vec.Col.([]types.Decimal128), set.(map[types.Decimal128]struct{}), np, e.Not, rs)
+	case types.T_date:
+		rs = in.DateIn(vec.Col.([]types.Date), set.(map[types.Date]struct{}), np, e.Not, rs)
+	case types.T_datetime:
+		rs = in.DatetimeIn(vec.Col.([]types.Datetime), set.(map[types.Datetime]struct{}), np, e.Not, rs)
+	case types.T_timestamp:
+		rs = in.TimestampIn(vec.Col.([]types.Timestamp), set.(map[types.Timestamp]struct{}), np, e.Not, rs)
+	case types.T_char, types.T_varchar:
+		rs = in.StrIn(vec.Col.(*types.Bytes), set.(map[string]struct{}), np, e.Not, rs)
+	default:
+		process.Put(proc, rvec)
+		return nil, 0, fmt.Errorf("'%s' not yet implemented for %s", e, vec.Typ)
+	}
+	vector.SetCol(rvec, rs)
+	return rvec, types.T_sel, nil
+}
+
+func (a *InExtend) Eq(e Extend) bool {
+	if b, ok := e.(*InExtend); ok {
+		return a.Not == b.Not && a.E.Eq(b.E) && a.Vs.String() == b.Vs.String()
+	}
+	return false
+}
+
+func (e *InExtend) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s not in %s", e.E, e.Vs)
+	}
+	return fmt.Sprintf("%s in %s", e.E, e.Vs)
+}
+
+func (e *InExtend) newSet() (interface{}, error) {
+	switch e.Vs.Typ.Oid {
+	case types.T_int8:
+		return newSet(e.Vs.Col.([]int8), e.Vs.Nsp), nil
+	case types.T_int16:
+		return newSet(e.Vs.Col.([]int16), e.Vs.Nsp), nil
+	case types.T_int32:
+		return newSet(e.Vs.Col.([]int32), e.Vs.Nsp), nil
+	case types.T_int64:
+		return newSet(e.Vs.Col.([]int64), e.Vs.Nsp), nil
+	case types.T_uint8:
+		return newSet(e.Vs.Col.([]uint8), e.Vs.Nsp), nil
+	case types.T_uint16:
+		return newSet(e.Vs.Col.([]uint16), e.Vs.Nsp), nil
+	case types.T_uint32:
+		return newSet(e.Vs.Col.([]uint32), e.Vs.Nsp), nil
+	case types.T_uint64:
+		return newSet(e.Vs.Col.([]uint64), e.Vs.Nsp), nil
+	case types.T_float32:
+		return newSet(e.Vs.Col.([]float32), e.Vs.Nsp), nil
+	case types.T_float64:
+		return newSet(e.Vs.Col.([]float64), e.Vs.Nsp), nil
+	case types.T_decimal64:
+		return newSet(e.Vs.Col.([]types.Decimal64), e.Vs.Nsp), nil
+	case types.T_decimal128:
+		return newSet(e.Vs.Col.([]types.Decimal128), e.Vs.Nsp), nil
+	case types.T_date:
+		return newSet(e.Vs.Col.([]types.Date), e.Vs.Nsp), nil
+	case types.T_datetime:
+		return newSet(e.Vs.Col.([]types.Datetime), e.Vs.Nsp), nil
+	case types.T_timestamp:
+		return newSet(e.Vs.Col.([]types.Timestamp), e.Vs.Nsp), nil
+	case types.T_char, types.T_varchar:
+		vs := e.Vs.Col.(*types.Bytes)
+		set := make(map[string]struct{}, len(vs.Lengths))
+		for i := range vs.Lengths {
+			if !nulls.Contains(e.Vs.Nsp, uint64(i)) {
+				set[string(vs.Get(int64(i)))] = struct{}{}
+			}
+		}
+		return set, nil
+	}
+	return nil, fmt.Errorf("'%s' not yet implemented for %s", e, e.Vs.Typ)
+}
+
+func newSet[T comparable](vs []T, nsp *nulls.Nulls) map[T]struct{} {
+	set := make(map[T]struct{}, len(vs))
+	for i, v := range vs {
+		if !nulls.Contains(nsp, uint64(i)) {
+			set[v] = struct{}{}
+		}
+	}
+	return set
+}
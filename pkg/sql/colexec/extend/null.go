@@ -0,0 +1,93 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *NullExtend) IsLogical() bool {
+	return true
+}
+
+func (_ *NullExtend) IsConstant() bool {
+	return false
+}
+
+func (_ *NullExtend) ReturnType() types.T {
+	return types.T_sel
+}
+
+func (e *NullExtend) Attributes() []string {
+	return e.E.Attributes()
+}
+
+func (e *NullExtend) ExtendAttributes() []*Attribute {
+	return e.E.ExtendAttributes()
+}
+
+func (e *NullExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	vec, _, err := e.E.Eval(bat, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if vec.Ref == 0 {
+			process.Put(proc, vec)
+		}
+	}()
+	n := vector.Length(vec)
+	if e.E.IsConstant() { // the constant is null or not for all the rows
+		n = len(bat.Zs)
+	}
+	rvec, err := process.Get(proc, int64(n)*8, overload.SelsType)
+	if err != nil {
+		return nil, 0, err
+	}
+	rs := encoding.DecodeInt64Slice(rvec.Data)[:0]
+	for i := 0; i < n; i++ {
+		row := uint64(i)
+		if e.E.IsConstant() {
+			row = 0
+		}
+		if nulls.Contains(vec.Nsp, row) != e.Not {
+			rs = append(rs, int64(i))
+		}
+	}
+	vector.SetCol(rvec, rs)
+	return rvec, types.T_sel, nil
+}
+
+func (a *NullExtend) Eq(e Extend) bool {
+	if b, ok := e.(*NullExtend); ok {
+		return a.Not == b.Not && a.E.Eq(b.E)
+	}
+	return false
+}
+
+func (e *NullExtend) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s is not null", e.E)
+	}
+	return fmt.Sprintf("%s is null", e.E)
+}
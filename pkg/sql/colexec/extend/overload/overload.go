@@ -35,14 +35,16 @@ var LogicalOps = map[int]uint8{
 }
 
 var NegOps = map[int]int{
-	Or:   And,
-	And:  Or,
-	EQ:   NE,
-	LT:   GE,
-	LE:   GT,
-	GT:   LE,
-	GE:   LT,
-	Like: NotLike,
+	Or:      And,
+	And:     Or,
+	EQ:      NE,
+	LT:      GE,
+	LE:      GT,
+	GT:      LE,
+	GE:      LT,
+	NE:      EQ,
+	Like:    NotLike,
+	NotLike: Like,
 }
 
 var OpTypes = map[int]int{
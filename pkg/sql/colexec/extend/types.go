@@ -53,6 +53,22 @@ type MultiExtend struct {
 	Args []Extend
 }
 
+// InExtend is E IN (Vs) or E NOT IN (Vs). Vs are the constants of the list
+// of the type of E, hashed once by Prepare
+type InExtend struct {
+	Not bool
+	E   Extend
+	Vs  *vector.Vector
+
+	set interface{}
+}
+
+// NullExtend is E IS NULL or E IS NOT NULL
+type NullExtend struct {
+	Not bool
+	E   Extend
+}
+
 type ParenExtend struct {
 	E Extend
 }
@@ -46,9 +46,11 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	newSeen := n.Seen + uint64(length)
 	if newSeen >= n.Limit { // limit - seen
 		batch.SetLength(bat, int(n.Limit-n.Seen))
+		n.Scan.Add(n.Limit - n.Seen)
 		n.Seen = newSeen
 		return true, nil
 	}
+	n.Scan.Add(uint64(length))
 	n.Seen = newSeen
 	return false, nil
 }
@@ -14,7 +14,12 @@
 
 package limit
 
+import "github.com/matrixorigin/matrixone/pkg/vm/engine"
+
 type Argument struct {
 	Seen  uint64 // seen is the number of tuples seen so far
 	Limit uint64
+	// Scan is the limit shared with the readers of the scan splits, the
+	// tuples returned are counted in it
+	Scan *engine.ScanLimit
 }
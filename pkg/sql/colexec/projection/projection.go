@@ -36,7 +36,13 @@ func String(arg interface{}, buf *bytes.Buffer) {
 	buf.WriteString(")")
 }
 
-func Prepare(_ *process.Process, _ interface{}) error {
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	for _, e := range n.Es {
+		if err := extend.Prepare(e); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
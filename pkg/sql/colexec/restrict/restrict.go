@@ -32,7 +32,7 @@ func String(arg interface{}, buf *bytes.Buffer) {
 func Prepare(_ *process.Process, arg interface{}) error {
 	n := arg.(*Argument)
 	n.Attrs = n.E.Attributes()
-	return nil
+	return extend.Prepare(n.E)
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
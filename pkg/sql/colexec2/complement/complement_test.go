@@ -17,6 +17,7 @@ package complement
 import (
 	"bytes"
 	"context"
+	"os"
 	"strconv"
 	"testing"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 	"github.com/stretchr/testify/require"
 )
 
@@ -200,6 +202,48 @@ func TestComplement(t *testing.T) {
 	}
 }
 
+func TestComplementWithSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []int32{0},
+		[][]Condition{
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+		})
+	dir := t.TempDir()
+	tc.proc.Spill = spill.New(dir)
+	tc.proc.Lim.Size = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, 2*Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, 2*Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	rows := 0
+	for {
+		if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+			require.NoError(t, err)
+			break
+		}
+		require.NotNil(t, tc.arg.ctr.spill)
+		bat := tc.proc.Reg.InputBatch
+		for _, v := range bat.Vecs[0].Col.([]int8) {
+			require.GreaterOrEqual(t, v, int8(Rows))
+		}
+		rows += len(bat.Zs)
+		batch.Clean(bat, tc.proc.Mp)
+	}
+	require.Equal(t, 2*Rows, rows)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
 func BenchmarkComplement(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
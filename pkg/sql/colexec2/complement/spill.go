@@ -0,0 +1,165 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package complement
+
+import (
+	"hash/maphash"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// exceeded returns true if the memory in use exceeds the limit and the
+// data can be spilled
+func exceeded(proc *process.Process) bool {
+	return proc.Spill != nil && proc.Lim.Size > 0 && mheap.InUse(proc.Mp) > proc.Lim.Size
+}
+
+// startSpill spills ctr.bat to the build partitions, the rest of the
+// build side and the probe side are spilled to the partitions too
+func (ctr *Container) startSpill(ap *Argument, proc *process.Process) error {
+	defer func() {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}()
+	sc := &spillContainer{
+		seed: maphash.MakeSeed(),
+		typs: make([]types.Type, len(ctr.bat.Vecs)),
+	}
+	for i, vec := range ctr.bat.Vecs {
+		sc.typs[i] = vec.Typ
+	}
+	ctr.spill = sc
+	var err error
+	if sc.build, err = proc.Spill.NewPartitions(SpillPartitions, sc.seed); err != nil {
+		return err
+	}
+	if sc.probe, err = proc.Spill.NewPartitions(SpillPartitions, sc.seed); err != nil {
+		return err
+	}
+	return ctr.spillBatch(sc.build, ctr.bat, ap.Conditions[1], proc)
+}
+
+// spillBatch writes the rows of bat to the partitions of their keys, the
+// rows of null keys to partition 0
+func (ctr *Container) spillBatch(ps *spill.Partitions, bat *batch.Batch, conds []Condition, proc *process.Process) error {
+	parts := ctr.spill.parts[:0]
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		ctr.fillKeys(bat, conds, i, n)
+		for k := 0; k < n; k++ {
+			p := 0
+			if ctr.zValues[k] != 0 {
+				p = ps.Partition(ctr.enc.Keys[k])
+			}
+			parts = append(parts, p)
+		}
+	}
+	ctr.spill.parts = parts
+	return ps.Write(bat, parts, proc.Mp)
+}
+
+func (ctr *Container) rewindSpill() error {
+	if err := ctr.spill.build.Rewind(); err != nil {
+		return err
+	}
+	return ctr.spill.probe.Rewind()
+}
+
+// nextBatch returns the next batch of the probe partition being joined,
+// loading the build partition first. It returns nil once all the
+// partitions are joined
+func (ctr *Container) nextBatch(ap *Argument, proc *process.Process) (*batch.Batch, error) {
+	sc := ctr.spill
+	for ; sc.idx < SpillPartitions; sc.idx++ {
+		if sc.probe.File(sc.idx).Size() == 0 {
+			continue
+		}
+		if !sc.loaded {
+			if err := ctr.loadPartition(ap, proc); err != nil {
+				return nil, err
+			}
+			sc.loaded = true
+		}
+		bat, err := sc.probe.File(sc.idx).Read(proc.Mp)
+		if err != nil || bat != nil {
+			return bat, err
+		}
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+		sc.loaded = false
+	}
+	return nil, nil
+}
+
+// loadPartition reads the build partition being joined into ctr.bat and
+// builds its hashtable
+func (ctr *Container) loadPartition(ap *Argument, proc *process.Process) error {
+	sc := ctr.spill
+	ctr.bat = batch.New(len(sc.typs))
+	for i, typ := range sc.typs {
+		ctr.bat.Vecs[i] = vector.New(typ)
+	}
+	f := sc.build.File(sc.idx)
+	for {
+		bat, err := f.Read(proc.Mp)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		ctr.bat, err = ctr.bat.Append(proc.Mp, bat)
+		batch.Clean(bat, proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+	ctr.rows = 0
+	ctr.sels = nil
+	ctr.strHashMap = &hashtable.StringHashMap{}
+	ctr.strHashMap.Init()
+	ctr.buildHashTable(ap)
+	return nil
+}
+
+// cleanSpill removes the partitions and frees the build partition loaded
+func (ctr *Container) cleanSpill(proc *process.Process) {
+	sc := ctr.spill
+	if sc == nil {
+		return
+	}
+	if sc.build != nil {
+		sc.build.Close()
+	}
+	if sc.probe != nil {
+		sc.probe.Close()
+	}
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	ctr.spill = nil
+}
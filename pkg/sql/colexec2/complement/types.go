@@ -15,19 +15,26 @@
 package complement
 
 import (
+	"hash/maphash"
+
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
 	Build = iota
 	Probe
+	Partition
 	End
 )
 
 const (
 	UnitLimit = 256
+	// SpillPartitions is the number of partitions both sides are spilled to
+	SpillPartitions = 16
 )
 
 var OneInt64s []int64
@@ -35,7 +42,7 @@ var OneInt64s []int64
 type Container struct {
 	state         int
 	rows          uint64
-	keys          [][]byte
+	enc           *hashkey.Encoder
 	values        []uint64
 	zValues       []int64
 	hashes        []uint64
@@ -48,8 +55,19 @@ type Container struct {
 
 	bat *batch.Batch
 
-	decimal64Slice  []types.Decimal64
-	decimal128Slice []types.Decimal128
+	spill *spillContainer
+}
+
+// spillContainer has the partitions both sides are spilled to once the
+// build side exceeds the memory limit. They are joined one by one
+type spillContainer struct {
+	idx    int          // partition being joined
+	loaded bool         // indicates if the build partition idx is loaded
+	typs   []types.Type // types of the build side
+	parts  []int
+	seed   maphash.Seed
+	build  *spill.Partitions
+	probe  *spill.Partitions
 }
 
 type Condition struct {
@@ -63,4 +81,5 @@ type Argument struct {
 	IsPreBuild bool // hashtable is pre-build
 	Result     []int32
 	Conditions [][]Condition
+	Idx        int // index of the AnalyzeInfo of the operator
 }
@@ -32,6 +32,9 @@ func Prepare(_ *process.Process, _ interface{}) error {
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	ap := arg.(*Argument)
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
 	reg := ap.Reg
 	bat := proc.Reg.InputBatch
 	if bat == nil {
@@ -46,6 +49,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if len(bat.Zs) == 0 {
 		return false, nil
 	}
+	anal.Input(bat)
 	vecs := ap.vecs[:0]
 	for i := range bat.Vecs {
 		if bat.Vecs[i].Or {
@@ -68,6 +72,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		process.FreeRegisters(proc)
 		return true, nil
 	case reg.Ch <- bat:
+		anal.Output(bat)
 		return false, nil
 	}
 }
@@ -25,4 +25,5 @@ type Argument struct {
 	Mmu  *guest.Mmu
 	vecs []*vector.Vector
 	Reg  *process.WaitRegister
+	Idx  int // index of the AnalyzeInfo of the operator
 }
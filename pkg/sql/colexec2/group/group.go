@@ -20,6 +20,7 @@ import (
 	"unsafe"
 
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
@@ -47,6 +48,10 @@ func String(arg interface{}, buf *bytes.Buffer) {
 		buf.WriteString(fmt.Sprintf("%v(%v)", aggregate.Names[agg.Op], agg.Pos))
 	}
 	buf.WriteString("])")
+	if st := ap.Stats; st.SpilledRows > 0 {
+		buf.WriteString(fmt.Sprintf("(spilled rows: %v, spilled bytes: %v, partitions: %v, levels: %v)",
+			st.SpilledRows, st.SpilledBytes, st.Partitions, st.Levels))
+	}
 }
 
 func Prepare(_ *process.Process, arg interface{}) error {
@@ -56,11 +61,28 @@ func Prepare(_ *process.Process, arg interface{}) error {
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
+	var end bool
+	var err error
+
 	ap := arg.(*Argument)
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	spilled := ap.Stats.SpilledBytes
 	if len(ap.Poses) == 0 {
-		return ap.ctr.process(ap, proc)
+		anal.Input(proc.Reg.InputBatch)
+		end, err = ap.ctr.process(ap, proc)
+	} else {
+		if ap.ctr.state == Build {
+			anal.Input(proc.Reg.InputBatch)
+		}
+		end, err = ap.ctr.processWithGroup(ap, proc)
 	}
-	return ap.ctr.processWithGroup(ap, proc)
+	if err == nil {
+		anal.Output(proc.Reg.InputBatch)
+	}
+	anal.Spill(ap.Stats.SpilledBytes - spilled)
+	return end, err
 }
 
 func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error) {
@@ -103,98 +125,98 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 }
 
 func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (bool, error) {
-	var err error
-
-	bat := proc.Reg.InputBatch
-	if bat == nil {
-		if ctr.bat != nil {
-			switch ctr.typ {
-			case H8:
-				ctr.bat.Ht = ctr.intHashMap
-			case H24:
-				ctr.bat.Ht = ctr.strHashMap
-			case H32:
-				ctr.bat.Ht = ctr.strHashMap
-			case H40:
-				ctr.bat.Ht = ctr.strHashMap
-			default:
-				ctr.bat.Ht = ctr.strHashMap
+	for {
+		switch ctr.state {
+		case Build:
+			bat := proc.Reg.InputBatch
+			if bat == nil {
+				if ctr.spill == nil {
+					ctr.state = End
+					ctr.emit(proc)
+					return true, nil
+				}
+				ctr.state = Partition
+				if err := ctr.finishSpill(ap); err != nil {
+					ctr.state = End
+					ctr.clean(proc)
+					return true, err
+				}
+				if ctr.bat != nil {
+					ctr.emit(proc)
+					return false, nil
+				}
+				continue
 			}
-			proc.Reg.InputBatch = ctr.bat
-			ctr.bat = nil
+			if len(bat.Zs) == 0 {
+				return false, nil
+			}
+			defer batch.Clean(bat, proc.Mp)
+			proc.Reg.InputBatch = &batch.Batch{}
+			if err := ctr.aggregate(bat, ap, proc); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return false, err
+			}
+			return false, nil
+		case Partition:
+			end, err := ctr.reaggregate(ap, proc)
+			if err != nil || end {
+				ctr.state = End
+				ctr.clean(proc)
+			}
+			return end, err
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
 		}
-		return true, nil
 	}
-	if len(bat.Zs) == 0 {
-		return false, nil
+}
+
+// emit sends the groups aggregated in memory to the next operator
+func (ctr *Container) emit(proc *process.Process) {
+	if ctr.bat == nil {
+		return
 	}
-	defer batch.Clean(bat, proc.Mp)
-	proc.Reg.InputBatch = &batch.Batch{}
+	switch ctr.typ {
+	case H8:
+		ctr.bat.Ht = ctr.intHashMap
+	default:
+		ctr.bat.Ht = ctr.strHashMap
+	}
+	proc.Reg.InputBatch = ctr.bat
+	ctr.bat = nil
+}
+
+// clean frees the groups aggregated in memory and removes the partitions
+// spilled
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	ctr.cleanSpill()
+}
+
+// aggregate adds the rows of bat to the groups in memory. Once the memory
+// limit is exceeded no group is added, the rows of the new groups are
+// spilled to be aggregated later
+func (ctr *Container) aggregate(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	var err error
+
 	if ctr.bat == nil {
-		size := 0
-		ctr.bat = batch.New(len(ap.Poses))
-		for i, pos := range ap.Poses {
-			vec := bat.Vecs[pos]
-			ctr.bat.Vecs[i] = vector.New(vec.Typ)
-			switch vec.Typ.Oid {
-			case types.T_int8, types.T_uint8:
-				size += 1 + 1
-			case types.T_int16, types.T_uint16:
-				size += 2 + 1
-			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
-				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
-				size += 8 + 1
-			case types.T_decimal128:
-				size += 16 + 1
-			case types.T_char, types.T_varchar:
-				if width := vec.Typ.Width; width > 0 {
-					size += int(width) + 1
-				} else {
-					size = 128
-				}
-			}
+		if err = ctr.init(bat, ap); err != nil {
+			return err
 		}
-		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
-		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, bat.Vecs[agg.Pos].Typ); err != nil {
-				return false, err
-			}
+	}
+	if ctr.spill != nil && ctr.spill.ps != nil {
+		if err = batch.Compact(bat, proc.Mp); err != nil {
+			return err
 		}
-		ctr.keyOffs = make([]uint32, UnitLimit)
-		ctr.zKeyOffs = make([]uint32, UnitLimit)
-		ctr.inserted = make([]uint8, UnitLimit)
-		ctr.zInserted = make([]uint8, UnitLimit)
-		ctr.hashes = make([]uint64, UnitLimit)
-		ctr.strHashStates = make([][3]uint64, UnitLimit)
-		ctr.values = make([]uint64, UnitLimit)
-		ctr.intHashMap = &hashtable.Int64HashMap{}
-		ctr.strHashMap = &hashtable.StringHashMap{}
-		switch {
-		case size <= 8:
-			ctr.typ = H8
-			ctr.h8.keys = make([]uint64, UnitLimit)
-			ctr.h8.zKeys = make([]uint64, UnitLimit)
-			ctr.intHashMap.Init()
-		case size <= 24:
-			ctr.typ = H24
-			ctr.h24.keys = make([][3]uint64, UnitLimit)
-			ctr.h24.zKeys = make([][3]uint64, UnitLimit)
-			ctr.strHashMap.Init()
-		case size <= 32:
-			ctr.typ = H32
-			ctr.h32.keys = make([][4]uint64, UnitLimit)
-			ctr.h32.zKeys = make([][4]uint64, UnitLimit)
-			ctr.strHashMap.Init()
-		case size <= 40:
-			ctr.typ = H40
-			ctr.h40.keys = make([][5]uint64, UnitLimit)
-			ctr.h40.zKeys = make([][5]uint64, UnitLimit)
-			ctr.strHashMap.Init()
-		default:
-			ctr.typ = HStr
-			ctr.hstr.keys = make([][]byte, UnitLimit)
-			ctr.strHashMap.Init()
+		if err = ctr.spillBatch(bat, ap, proc); err != nil {
+			return err
+		}
+		if len(bat.Zs) == 0 {
+			return nil
 		}
 	}
 	switch ctr.typ {
@@ -210,14 +232,99 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		err = ctr.processHStr(bat, ap, proc)
 	}
 	if err != nil {
-		batch.Clean(ctr.bat, proc.Mp)
-		ctr.bat = nil
-		return false, err
+		return err
 	}
-	return false, err
+	if (ctr.spill == nil || ctr.spill.ps == nil) && exceeded(proc) {
+		return ctr.startSpill(proc)
+	}
+	return nil
+}
+
+// init sets up the groups and the hashtable by the types of bat
+func (ctr *Container) init(bat *batch.Batch, ap *Argument) error {
+	var err error
+
+	ctr.rows = 0
+	size := 0
+	ctr.bat = batch.New(len(ap.Poses))
+	for i, pos := range ap.Poses {
+		vec := bat.Vecs[pos]
+		ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		switch vec.Typ.Oid {
+		case types.T_int8, types.T_uint8:
+			size += 1 + 1
+		case types.T_int16, types.T_uint16:
+			size += 2 + 1
+		case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
+			size += 4 + 1
+		case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			size += 8 + 1
+		case types.T_decimal128:
+			size += 16 + 1
+		case types.T_char, types.T_varchar:
+			// the strings are length prefixed in the keys of HStr
+			size = 128
+		}
+	}
+	ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
+	for i, agg := range ap.Aggs {
+		if ctr.bat.Rs[i], err = aggregate.New(agg.Op, bat.Vecs[agg.Pos].Typ); err != nil {
+			ctr.bat = nil
+			return err
+		}
+	}
+	ctr.keyOffs = make([]uint32, UnitLimit)
+	ctr.zKeyOffs = make([]uint32, UnitLimit)
+	ctr.inserted = make([]uint8, UnitLimit)
+	ctr.zInserted = make([]uint8, UnitLimit)
+	ctr.hashes = make([]uint64, UnitLimit)
+	ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ctr.values = make([]uint64, UnitLimit)
+	ctr.zValues = make([]int64, UnitLimit)
+	ctr.intHashMap = &hashtable.Int64HashMap{}
+	ctr.strHashMap = &hashtable.StringHashMap{}
+	switch {
+	case size <= 8:
+		ctr.typ = H8
+		ctr.h8.keys = make([]uint64, UnitLimit)
+		ctr.h8.zKeys = make([]uint64, UnitLimit)
+		ctr.intHashMap.Init()
+	case size <= 24:
+		ctr.typ = H24
+		ctr.h24.keys = make([][3]uint64, UnitLimit)
+		ctr.h24.zKeys = make([][3]uint64, UnitLimit)
+		ctr.strHashMap.Init()
+	case size <= 32:
+		ctr.typ = H32
+		ctr.h32.keys = make([][4]uint64, UnitLimit)
+		ctr.h32.zKeys = make([][4]uint64, UnitLimit)
+		ctr.strHashMap.Init()
+	case size <= 40:
+		ctr.typ = H40
+		ctr.h40.keys = make([][5]uint64, UnitLimit)
+		ctr.h40.zKeys = make([][5]uint64, UnitLimit)
+		ctr.strHashMap.Init()
+	default:
+		ctr.typ = HStr
+		ctr.hstr.enc = hashkey.NewEncoder(UnitLimit, true)
+		ctr.strHashMap.Init()
+	}
+	return nil
 }
 
 func (ctr *Container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if bat.Sels != nil {
+		for _, sel := range bat.Sels {
+			ctr.bat.Zs[0] += bat.Zs[sel]
+		}
+		for i, r := range ctr.bat.Rs {
+			vec := bat.Vecs[ap.Aggs[i].Pos]
+			for _, sel := range bat.Sels {
+				r.Fill(0, sel, bat.Zs[sel], vec)
+			}
+		}
+		return nil
+	}
 	for _, z := range bat.Zs {
 		ctr.bat.Zs[0] += z
 	}
@@ -234,29 +341,14 @@ func (ctr *Container) processH8(bat *batch.Batch, ap *Argument, proc *process.Pr
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		copy(ctr.keyOffs, ctr.zKeyOffs)
-		copy(ctr.h8.keys, ctr.h8.zKeys)
-		for _, pos := range ap.Poses {
-			vec := bat.Vecs[pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroup[uint8](ctr, vec, ctr.h8.keys, n, 1, i)
-			case 2:
-				fillGroup[uint16](ctr, vec, ctr.h8.keys, n, 2, i)
-			case 4:
-				fillGroup[uint32](ctr, vec, ctr.h8.keys, n, 4, i)
-			case 8:
-				fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
-			case -8:
-				fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
-			case -16:
-				fillGroup[types.Decimal128](ctr, vec, ctr.h8.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h8.keys, n, 8, i)
-			}
-		}
+		ctr.fillH8(bat, ap, i, n)
 		ctr.hashes[0] = 0
-		ctr.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+		if bat.Sels == nil {
+			ctr.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+		} else {
+			ctr.maskUnselected(bat, i, n)
+			ctr.intHashMap.InsertBatchWithRing(n, ctr.zValues, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+		}
 		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
 			return err
 		}
@@ -264,6 +356,28 @@ func (ctr *Container) processH8(bat *batch.Batch, ap *Argument, proc *process.Pr
 	return nil
 }
 
+func (ctr *Container) fillH8(bat *batch.Batch, ap *Argument, i, n int) {
+	copy(ctr.keyOffs, ctr.zKeyOffs)
+	copy(ctr.h8.keys, ctr.h8.zKeys)
+	for _, pos := range ap.Poses {
+		vec := bat.Vecs[pos]
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroup[uint8](ctr, vec, ctr.h8.keys, n, 1, i)
+		case 2:
+			fillGroup[uint16](ctr, vec, ctr.h8.keys, n, 2, i)
+		case 4:
+			fillGroup[uint32](ctr, vec, ctr.h8.keys, n, 4, i)
+		case 8:
+			fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
+		case -8:
+			fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
+		case -16:
+			fillGroup[types.Decimal128](ctr, vec, ctr.h8.keys, n, 16, i)
+		}
+	}
+}
+
 func (ctr *Container) processH24(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	count := len(bat.Zs)
 	for i := 0; i < count; i += UnitLimit {
@@ -271,28 +385,13 @@ func (ctr *Container) processH24(bat *batch.Batch, ap *Argument, proc *process.P
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		copy(ctr.keyOffs, ctr.zKeyOffs)
-		copy(ctr.h24.keys, ctr.h24.zKeys)
-		for _, pos := range ap.Poses {
-			vec := bat.Vecs[pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroup[uint8](ctr, vec, ctr.h24.keys, n, 1, i)
-			case 2:
-				fillGroup[uint16](ctr, vec, ctr.h24.keys, n, 2, i)
-			case 4:
-				fillGroup[uint32](ctr, vec, ctr.h24.keys, n, 4, i)
-			case 8:
-				fillGroup[uint64](ctr, vec, ctr.h24.keys, n, 8, i)
-			case -8:
-				fillGroup[types.Decimal64](ctr, vec, ctr.h24.keys, n, 8, i)
-			case -16:
-				fillGroup[types.Decimal128](ctr, vec, ctr.h24.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h24.keys, n, 24, i)
-			}
+		ctr.fillH24(bat, ap, i, n)
+		if bat.Sels == nil {
+			ctr.strHashMap.InsertString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
+		} else {
+			ctr.maskUnselected(bat, i, n)
+			ctr.strHashMap.InsertString24BatchWithRing(ctr.zValues, ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
 		}
-		ctr.strHashMap.InsertString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
 		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
 			return err
 		}
@@ -300,6 +399,28 @@ func (ctr *Container) processH24(bat *batch.Batch, ap *Argument, proc *process.P
 	return nil
 }
 
+func (ctr *Container) fillH24(bat *batch.Batch, ap *Argument, i, n int) {
+	copy(ctr.keyOffs, ctr.zKeyOffs)
+	copy(ctr.h24.keys, ctr.h24.zKeys)
+	for _, pos := range ap.Poses {
+		vec := bat.Vecs[pos]
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroup[uint8](ctr, vec, ctr.h24.keys, n, 1, i)
+		case 2:
+			fillGroup[uint16](ctr, vec, ctr.h24.keys, n, 2, i)
+		case 4:
+			fillGroup[uint32](ctr, vec, ctr.h24.keys, n, 4, i)
+		case 8:
+			fillGroup[uint64](ctr, vec, ctr.h24.keys, n, 8, i)
+		case -8:
+			fillGroup[types.Decimal64](ctr, vec, ctr.h24.keys, n, 8, i)
+		case -16:
+			fillGroup[types.Decimal128](ctr, vec, ctr.h24.keys, n, 16, i)
+		}
+	}
+}
+
 func (ctr *Container) processH32(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	count := len(bat.Zs)
 	for i := 0; i < count; i += UnitLimit {
@@ -307,28 +428,13 @@ func (ctr *Container) processH32(bat *batch.Batch, ap *Argument, proc *process.P
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		copy(ctr.keyOffs, ctr.zKeyOffs)
-		copy(ctr.h32.keys, ctr.h32.zKeys)
-		for _, pos := range ap.Poses {
-			vec := bat.Vecs[pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroup[uint8](ctr, vec, ctr.h32.keys, n, 1, i)
-			case 2:
-				fillGroup[uint16](ctr, vec, ctr.h32.keys, n, 2, i)
-			case 4:
-				fillGroup[uint32](ctr, vec, ctr.h32.keys, n, 4, i)
-			case 8:
-				fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
-			case -8:
-				fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
-			case -16:
-				fillGroup[types.Decimal128](ctr, vec, ctr.h32.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h32.keys, n, 32, i)
-			}
+		ctr.fillH32(bat, ap, i, n)
+		if bat.Sels == nil {
+			ctr.strHashMap.InsertString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
+		} else {
+			ctr.maskUnselected(bat, i, n)
+			ctr.strHashMap.InsertString32BatchWithRing(ctr.zValues, ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
 		}
-		ctr.strHashMap.InsertString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
 		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
 			return err
 		}
@@ -336,6 +442,28 @@ func (ctr *Container) processH32(bat *batch.Batch, ap *Argument, proc *process.P
 	return nil
 }
 
+func (ctr *Container) fillH32(bat *batch.Batch, ap *Argument, i, n int) {
+	copy(ctr.keyOffs, ctr.zKeyOffs)
+	copy(ctr.h32.keys, ctr.h32.zKeys)
+	for _, pos := range ap.Poses {
+		vec := bat.Vecs[pos]
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroup[uint8](ctr, vec, ctr.h32.keys, n, 1, i)
+		case 2:
+			fillGroup[uint16](ctr, vec, ctr.h32.keys, n, 2, i)
+		case 4:
+			fillGroup[uint32](ctr, vec, ctr.h32.keys, n, 4, i)
+		case 8:
+			fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
+		case -8:
+			fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
+		case -16:
+			fillGroup[types.Decimal128](ctr, vec, ctr.h32.keys, n, 16, i)
+		}
+	}
+}
+
 func (ctr *Container) processH40(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	count := len(bat.Zs)
 	for i := 0; i < count; i += UnitLimit {
@@ -343,28 +471,13 @@ func (ctr *Container) processH40(bat *batch.Batch, ap *Argument, proc *process.P
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		copy(ctr.keyOffs, ctr.zKeyOffs)
-		copy(ctr.h40.keys, ctr.h40.zKeys)
-		for _, pos := range ap.Poses {
-			vec := bat.Vecs[pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroup[uint8](ctr, vec, ctr.h40.keys, n, 1, i)
-			case 2:
-				fillGroup[uint16](ctr, vec, ctr.h40.keys, n, 2, i)
-			case 4:
-				fillGroup[uint32](ctr, vec, ctr.h40.keys, n, 4, i)
-			case 8:
-				fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
-			case -8:
-				fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
-			case -16:
-				fillGroup[types.Decimal128](ctr, vec, ctr.h40.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h40.keys, n, 40, i)
-			}
+		ctr.fillH40(bat, ap, i, n)
+		if bat.Sels == nil {
+			ctr.strHashMap.InsertString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
+		} else {
+			ctr.maskUnselected(bat, i, n)
+			ctr.strHashMap.InsertString40BatchWithRing(ctr.zValues, ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
 		}
-		ctr.strHashMap.InsertString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
 		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
 			return err
 		}
@@ -372,6 +485,28 @@ func (ctr *Container) processH40(bat *batch.Batch, ap *Argument, proc *process.P
 	return nil
 }
 
+func (ctr *Container) fillH40(bat *batch.Batch, ap *Argument, i, n int) {
+	copy(ctr.keyOffs, ctr.zKeyOffs)
+	copy(ctr.h40.keys, ctr.h40.zKeys)
+	for _, pos := range ap.Poses {
+		vec := bat.Vecs[pos]
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroup[uint8](ctr, vec, ctr.h40.keys, n, 1, i)
+		case 2:
+			fillGroup[uint16](ctr, vec, ctr.h40.keys, n, 2, i)
+		case 4:
+			fillGroup[uint32](ctr, vec, ctr.h40.keys, n, 4, i)
+		case 8:
+			fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
+		case -8:
+			fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
+		case -16:
+			fillGroup[types.Decimal128](ctr, vec, ctr.h40.keys, n, 16, i)
+		}
+	}
+}
+
 func (ctr *Container) processHStr(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	count := len(bat.Zs)
 	for i := 0; i < count; i += UnitLimit { // batch
@@ -379,61 +514,35 @@ func (ctr *Container) processHStr(bat *batch.Batch, ap *Argument, proc *process.
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		for _, pos := range ap.Poses {
-			vec := bat.Vecs[pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -16:
-				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
-						} else {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-
-			}
-		}
-		for k := 0; k < n; k++ {
-			if l := len(ctr.hstr.keys[k]); l < 16 {
-				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
-			}
+		ctr.fillHStr(bat, ap, i, n)
+		if bat.Sels == nil {
+			ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.enc.Keys[:n], ctr.values)
+		} else {
+			ctr.maskUnselected(bat, i, n)
+			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.hstr.enc.Keys[:n], ctr.values)
 		}
-		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
 		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
 			return err
 		}
-		for k := 0; k < n; k++ {
-			ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
-		}
 	}
 	return nil
 }
 
+func (ctr *Container) fillHStr(bat *batch.Batch, ap *Argument, i, n int) {
+	ctr.hstr.enc.Reset()
+	for _, pos := range ap.Poses {
+		ctr.hstr.enc.Add(bat.Vecs[pos], 0)
+	}
+	ctr.hstr.enc.Encode(i, n, nil)
+}
+
 func (ctr *Container) batchFill(i int, n int, bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	cnt := 0
 	copy(ctr.inserted[:n], ctr.zInserted[:n])
 	for k, v := range ctr.values[:n] {
+		if bat.Sels != nil && ctr.zValues[k] == 0 {
+			continue
+		}
 		if v > ctr.rows {
 			ctr.inserted[k] = 1
 			ctr.rows++
@@ -456,11 +565,28 @@ func (ctr *Container) batchFill(i int, n int, bat *batch.Batch, ap *Argument, pr
 		}
 	}
 	for j, r := range ctr.bat.Rs {
-		r.BatchFill(int64(i), ctr.inserted[:n], ctr.values, bat.Zs, bat.Vecs[ap.Aggs[j].Pos])
+		if bat.Sels == nil {
+			r.BatchFill(int64(i), ctr.inserted[:n], ctr.values, bat.Zs, bat.Vecs[ap.Aggs[j].Pos])
+			continue
+		}
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] != 0 {
+				r.Fill(int64(v)-1, int64(i+k), bat.Zs[i+k], bat.Vecs[ap.Aggs[j].Pos])
+			}
+		}
 	}
 	return nil
 }
 
+// maskUnselected sets ctr.zValues[k] to 0 if the row i+k of bat is not
+// selected and 1 otherwise
+func (ctr *Container) maskUnselected(bat *batch.Batch, i, n int) {
+	for k := range ctr.zValues[:n] {
+		ctr.zValues[k] = 1
+	}
+	batch.MaskUnselected(bat, ctr.zValues[:n], i)
+}
+
 func fillGroup[T1, T2 any](ctr *Container, vec *vector.Vector, keys []T2, n int, sz uint32, start int) {
 	vs := vector.DecodeFixedCol[T1](vec, int(sz))
 	if !nulls.Any(vec.Nsp) {
@@ -482,49 +608,3 @@ func fillGroup[T1, T2 any](ctr *Container, vec *vector.Vector, keys []T2, n int,
 		}
 	}
 }
-
-func fillStringGroup[T any](ctr *Container, vec *vector.Vector, keys []T, n int, sz uint32, start int) {
-	vs := vec.Col.(*types.Bytes)
-	vData := vs.Data
-	vOff := vs.Offsets
-	vLen := vs.Lengths
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			*(*int8)(unsafe.Add(unsafe.Pointer(&keys[i]), ctr.keyOffs[i])) = 0
-			copy(unsafe.Slice((*byte)(unsafe.Pointer(&keys[i])), sz)[ctr.keyOffs[i]+1:], vData[vOff[i+start]:vOff[i+start]+vLen[i+start]])
-			ctr.keyOffs[i] += vLen[i+start] + 1
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				*(*int8)(unsafe.Add(unsafe.Pointer(&keys[i]), ctr.keyOffs[i])) = 1
-				ctr.keyOffs[i]++
-			} else {
-				*(*int8)(unsafe.Add(unsafe.Pointer(&keys[i]), ctr.keyOffs[i])) = 0
-				copy(unsafe.Slice((*byte)(unsafe.Pointer(&keys[i])), sz)[ctr.keyOffs[i]+1:], vData[vOff[i+start]:vOff[i+start]+vLen[i+start]])
-				ctr.keyOffs[i] += vLen[i+start] + 1
-			}
-		}
-	}
-}
-
-func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
-	vs := vector.DecodeFixedCol[T](vec, sz)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			ctr.hstr.keys[i] = append(ctr.hstr.keys[i], byte(0))
-			ctr.hstr.keys[i] = append(ctr.hstr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.hstr.keys[i] = append(ctr.hstr.keys[i], byte(1))
-			} else {
-				ctr.hstr.keys[i] = append(ctr.hstr.keys[i], byte(0))
-				ctr.hstr.keys[i] = append(ctr.hstr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
-			}
-		}
-	}
-
-}
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 	"github.com/stretchr/testify/require"
 )
 
@@ -125,6 +126,51 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupWithSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int64}}, []int32{0}, []aggregate.Aggregate{{Op: aggregate.Count, Pos: 0}})
+	tc.proc.Spill = spill.New(t.TempDir())
+	tc.proc.Lim.Size = 1
+	Prepare(tc.proc, tc.arg)
+	// keys [0, 10), [5, 15) and [10, 20)
+	for _, start := range []int64{0, 5, 10} {
+		tc.proc.Reg.InputBatch = newInt64Batch(t, tc.proc, start, Rows)
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+	require.NotNil(t, tc.arg.ctr.spill)
+	groups := make(map[int64]int64)
+	for {
+		tc.proc.Reg.InputBatch = nil
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		for i, v := range bat.Vecs[0].Col.([]int64) {
+			_, dup := groups[v]
+			require.False(t, dup)
+			groups[v] = bat.Zs[i]
+		}
+		batch.Clean(bat, tc.proc.Mp)
+	}
+	require.Equal(t, 20, len(groups))
+	for v, z := range groups {
+		if v < 5 || v >= 15 {
+			require.Equal(t, int64(1), z)
+		} else {
+			require.Equal(t, int64(2), z)
+		}
+	}
+	require.Less(t, int64(0), tc.arg.Stats.SpilledRows)
+	require.Less(t, int64(0), tc.arg.Stats.Partitions)
+	require.Nil(t, tc.arg.ctr.spill)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -273,3 +319,20 @@ func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process,
 	}
 	return bat
 }
+
+// create a new block of an int64 column of the values [start, start+rows)
+func newInt64Batch(t *testing.T, proc *process.Process, start, rows int64) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int64})
+	data, err := mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	vec.Data = data
+	vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+	for i := range vs {
+		vs[i] = start + int64(i)
+	}
+	vec.Col = vs
+	bat.Vecs[0] = vec
+	return bat
+}
@@ -0,0 +1,212 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"hash/maphash"
+	"unsafe"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// exceeded returns true if the memory in use exceeds the limit and the
+// data can be spilled
+func exceeded(proc *process.Process) bool {
+	return proc.Spill != nil && proc.Lim.Size > 0 && mheap.InUse(proc.Mp) > proc.Lim.Size
+}
+
+// startSpill stops adding groups, the rows of the new groups are spilled
+// to the partitions of the next level from now on
+func (ctr *Container) startSpill(proc *process.Process) error {
+	if ctr.spill == nil {
+		ctr.spill = new(spillContainer)
+	}
+	ps, err := proc.Spill.NewPartitions(SpillPartitions, maphash.MakeSeed())
+	if err != nil {
+		return err
+	}
+	ctr.spill.ps = ps
+	return nil
+}
+
+// spillBatch spills the rows of bat of the groups not in memory and
+// shrinks bat to the rest
+func (ctr *Container) spillBatch(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	var err error
+
+	sc := ctr.spill
+	sc.parts = sc.parts[:0]
+	sc.sels = sc.sels[:0]
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.find(bat, ap, i, n)
+		for k, v := range ctr.values[:n] {
+			if v != 0 {
+				sc.parts = append(sc.parts, -1)
+				sc.sels = append(sc.sels, int64(i+k))
+			} else {
+				sc.parts = append(sc.parts, sc.ps.Partition(ctr.key(k)))
+			}
+		}
+	}
+	if len(sc.sels) == count {
+		return nil
+	}
+	if err = sc.ps.Write(bat, sc.parts, proc.Mp); err != nil {
+		return err
+	}
+	ap.Stats.SpilledRows += int64(count - len(sc.sels))
+	for i, vec := range bat.Vecs {
+		if vec.Or {
+			if bat.Vecs[i], err = vector.Dup(vec, proc.Mp); err != nil {
+				return err
+			}
+		}
+	}
+	batch.Shrink(bat, sc.sels)
+	return nil
+}
+
+// find fills the keys of the rows [i, i+n) of bat and looks up their
+// groups, ctr.values[k] is 0 if the group of row i+k is not in memory
+func (ctr *Container) find(bat *batch.Batch, ap *Argument, i, n int) {
+	switch ctr.typ {
+	case H8:
+		ctr.fillH8(bat, ap, i, n)
+		ctr.hashes[0] = 0
+		ctr.intHashMap.FindBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+	case H24:
+		ctr.fillH24(bat, ap, i, n)
+		ctr.strHashMap.FindString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
+	case H32:
+		ctr.fillH32(bat, ap, i, n)
+		ctr.strHashMap.FindString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
+	case H40:
+		ctr.fillH40(bat, ap, i, n)
+		ctr.strHashMap.FindString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
+	default:
+		ctr.fillHStr(bat, ap, i, n)
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.hstr.enc.Keys[:n], ctr.values)
+	}
+}
+
+// key returns the key filled of row k
+func (ctr *Container) key(k int) []byte {
+	switch ctr.typ {
+	case H8:
+		return unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h8.keys[k])), 8)
+	case H24:
+		return unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h24.keys[k])), 24)
+	case H32:
+		return unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h32.keys[k])), 32)
+	case H40:
+		return unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h40.keys[k])), 40)
+	default:
+		return ctr.hstr.enc.Keys[k]
+	}
+}
+
+// finishSpill adds the non-empty partitions spilled to the pending ones
+func (ctr *Container) finishSpill(ap *Argument) error {
+	var err error
+
+	sc := ctr.spill
+	if sc.ps == nil {
+		return nil
+	}
+	for i := 0; i < SpillPartitions; i++ {
+		f := sc.ps.File(i)
+		if err != nil || f.Size() == 0 {
+			f.Close()
+			continue
+		}
+		if err = f.Rewind(); err != nil {
+			f.Close()
+			continue
+		}
+		sc.pending = append(sc.pending, partition{level: sc.level + 1, f: f})
+		ap.Stats.Partitions++
+		ap.Stats.SpilledBytes += f.Size()
+	}
+	sc.ps = nil
+	if ap.Stats.Levels < sc.level+1 {
+		ap.Stats.Levels = sc.level + 1
+	}
+	return err
+}
+
+// reaggregate aggregates the pending partitions one by one, the groups of
+// a partition are sent once all its rows are aggregated. It returns true
+// if no partition is left
+func (ctr *Container) reaggregate(ap *Argument, proc *process.Process) (bool, error) {
+	sc := ctr.spill
+	for {
+		if sc.file == nil {
+			if len(sc.pending) == 0 {
+				proc.Reg.InputBatch = nil
+				return true, nil
+			}
+			p := sc.pending[len(sc.pending)-1]
+			sc.pending = sc.pending[:len(sc.pending)-1]
+			sc.file, sc.level = p.f, p.level
+		}
+		bat, err := sc.file.Read(proc.Mp)
+		if err != nil {
+			return false, err
+		}
+		if bat == nil {
+			sc.file.Close()
+			sc.file = nil
+			if err := ctr.finishSpill(ap); err != nil {
+				return false, err
+			}
+			if ctr.bat != nil {
+				ctr.emit(proc)
+				return false, nil
+			}
+			continue
+		}
+		err = ctr.aggregate(bat, ap, proc)
+		batch.Clean(bat, proc.Mp)
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+// cleanSpill removes the partitions spilled
+func (ctr *Container) cleanSpill() {
+	sc := ctr.spill
+	if sc == nil {
+		return
+	}
+	if sc.ps != nil {
+		sc.ps.Close()
+	}
+	if sc.file != nil {
+		sc.file.Close()
+	}
+	for _, p := range sc.pending {
+		p.f.Close()
+	}
+	ctr.spill = nil
+}
@@ -16,12 +16,23 @@ package group
 
 import (
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+const (
+	Build = iota
+	Partition
+	End
 )
 
 const (
 	UnitLimit = 256
+	// SpillPartitions is the number of partitions the rows of the new
+	// groups are spilled to once the memory limit is exceeded
+	SpillPartitions = 16
 )
 
 const (
@@ -34,6 +45,7 @@ const (
 
 type Container struct {
 	typ           int
+	state         int
 	rows          uint64
 	keyOffs       []uint32
 	zKeyOffs      []uint32
@@ -42,6 +54,7 @@ type Container struct {
 	hashes        []uint64
 	strHashStates [][3]uint64
 	values        []uint64
+	zValues       []int64 // 0 if the row is not selected
 	intHashMap    *hashtable.Int64HashMap
 	strHashMap    *hashtable.StringHashMap
 
@@ -62,13 +75,42 @@ type Container struct {
 		zKeys [][5]uint64
 	}
 	hstr struct {
-		keys [][]byte
+		enc *hashkey.Encoder
 	}
 	bat *batch.Batch
+
+	spill *spillContainer
+}
+
+// spillContainer has the partitions spilled. Each partition is aggregated
+// like the input, spilling again to the partitions of the next level if
+// it exceeds the memory limit too
+type spillContainer struct {
+	level   int               // level of the rows being aggregated
+	parts   []int             // partitions of the rows of bat spilled, -1 for the rows kept
+	sels    []int64           // rows of bat kept
+	ps      *spill.Partitions // partitions being spilled to
+	file    *spill.File       // partition being aggregated
+	pending []partition       // partitions left
+}
+
+type partition struct {
+	level int
+	f     *spill.File
+}
+
+// Stats are the counters of the spilling
+type Stats struct {
+	SpilledRows  int64
+	SpilledBytes int64
+	Partitions   int64 // non-empty partitions spilled
+	Levels       int   // max level of the partitions
 }
 
 type Argument struct {
 	Poses []int32 // group attributes
 	ctr   *Container
 	Aggs  []aggregate.Aggregate // aggregations
+	Stats Stats
+	Idx   int // index of the AnalyzeInfo of the operator
 }
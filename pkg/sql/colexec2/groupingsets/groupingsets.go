@@ -0,0 +1,143 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupingsets
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("grouping sets(")
+	for i, set := range ap.Sets {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v", set))
+	}
+	buf.WriteString(")")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.gids = make([]int64, len(ap.Sets))
+	ap.ctr.nulls = make([][]int32, len(ap.Sets))
+	for i, set := range ap.Sets {
+		for j, pos := range ap.Poses {
+			if !contains(set, pos) {
+				ap.ctr.gids[i] |= GroupingBit(len(ap.Poses), j)
+				ap.ctr.nulls[i] = append(ap.ctr.nulls[i], pos)
+			}
+		}
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	rbat, err := ap.ctr.expand(bat, ap, proc)
+	batch.Clean(bat, proc.Mp)
+	if err != nil {
+		proc.Reg.InputBatch = nil
+		return false, err
+	}
+	proc.Reg.InputBatch = rbat
+	anal.Output(rbat)
+	return false, nil
+}
+
+// GroupingBit returns the bit of the grouping id set if the key i of n
+// keys is not in the grouping set, the first key is the most significant
+// one like GROUPING_ID
+func GroupingBit(n, i int) int64 {
+	return 1 << (n - 1 - i)
+}
+
+// expand returns the rows of bat for each grouping set
+func (ctr *Container) expand(bat *batch.Batch, ap *Argument, proc *process.Process) (*batch.Batch, error) {
+	n := len(bat.Zs)
+	if len(ctr.flags) < n {
+		ctr.flags = make([]uint8, n)
+		for i := range ctr.flags {
+			ctr.flags[i] = 1
+		}
+	}
+	rbat := batch.New(len(bat.Vecs) + 1)
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	gvec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	rbat.Vecs[len(bat.Vecs)] = gvec
+	rbat.Zs = make([]int64, 0, n*len(ap.Sets))
+	for k := range ap.Sets {
+		for i, vec := range bat.Vecs {
+			if err := vector.UnionBatch(rbat.Vecs[i], vec, 0, n, ctr.flags[:n], proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		off := uint64(k * n)
+		for _, pos := range ctr.nulls[k] {
+			for j := 0; j < n; j++ {
+				nulls.Add(rbat.Vecs[pos].Nsp, off+uint64(j))
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs...)
+	}
+	data, err := mheap.Alloc(proc.Mp, int64(len(rbat.Zs))*8)
+	if err != nil {
+		batch.Clean(rbat, proc.Mp)
+		return nil, err
+	}
+	gids := encoding.DecodeInt64Slice(data)[:len(rbat.Zs)]
+	for k, gid := range ctr.gids {
+		for j := 0; j < n; j++ {
+			gids[k*n+j] = gid
+		}
+	}
+	gvec.Data = data
+	gvec.Col = gids
+	return rbat, nil
+}
+
+func contains(set []int32, pos int32) bool {
+	for _, p := range set {
+		if p == pos {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupingsets
+
+import (
+	"bytes"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+func TestGroupingSets(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	// rollup(0, 1)
+	arg := &Argument{
+		Poses: []int32{0, 1},
+		Sets:  [][]int32{{0, 1}, {0}, {}},
+	}
+	String(arg, new(bytes.Buffer))
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch(t, proc, 3, Rows)
+	ok, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.False(t, ok)
+	bat := proc.Reg.InputBatch
+	require.Equal(t, 3*Rows, len(bat.Zs))
+	require.Equal(t, 4, len(bat.Vecs))
+	gids := bat.Vecs[3].Col.([]int64)
+	for k, gid := range []int64{0, 1, 3} {
+		for j := 0; j < Rows; j++ {
+			row := uint64(k*Rows + j)
+			require.Equal(t, gid, gids[row])
+			require.False(t, nulls.Contains(bat.Vecs[2].Nsp, row))
+			require.Equal(t, k == 2, nulls.Contains(bat.Vecs[0].Nsp, row))
+			require.Equal(t, k >= 1, nulls.Contains(bat.Vecs[1].Nsp, row))
+		}
+	}
+	batch.Clean(bat, proc.Mp)
+	proc.Reg.InputBatch = nil
+	ok, err = Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+// create a new block of int64 columns of the values [0, rows)
+func newBatch(t *testing.T, proc *process.Process, cols int, rows int64) *batch.Batch {
+	bat := batch.New(cols)
+	bat.InitZsOne(int(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+		for j := range vs {
+			vs[j] = int64(j)
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
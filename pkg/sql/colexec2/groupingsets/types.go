@@ -0,0 +1,34 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupingsets
+
+type Container struct {
+	flags []uint8
+	gids  []int64   // grouping ids of the grouping sets
+	nulls [][]int32 // keys not in the grouping sets
+}
+
+// Argument of the operator replaying each batch once per grouping set. The
+// keys not in a grouping set are null in its rows, and the grouping id of
+// the set is appended as the last column. A group operator on all the keys
+// and the grouping id then aggregates every grouping set
+type Argument struct {
+	ctr *Container
+	// Poses are the keys of all the grouping sets
+	Poses []int32
+	// Sets are the grouping sets, each is a subset of Poses
+	Sets [][]int32
+	Idx  int // index of the AnalyzeInfo of the operator
+}
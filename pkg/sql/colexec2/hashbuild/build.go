@@ -0,0 +1,225 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"bytes"
+	"hash/maphash"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/runtimefilter"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString("hash build")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.enc = hashkey.NewEncoder(UnitLimit, false)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	ap.ctr.inserted = make([]uint8, UnitLimit)
+	ap.ctr.zInserted = make([]uint8, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	ap.ctr.seed = maphash.MakeSeed()
+	ap.ctr.bat = batch.New(len(ap.Typs))
+	for i, typ := range ap.Typs {
+		ap.ctr.bat.Vecs[i] = vector.New(typ)
+	}
+	return nil
+}
+
+// Call builds the hashtable of the build side and broadcasts it to the
+// probes once the build side is read
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	var err error
+
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ap.NeedSels {
+			ctr.build(ap)
+		}
+		anal.Output(ctr.bat)
+		ctr.broadcast(ap, proc)
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	defer batch.Clean(bat, proc.Mp)
+	proc.Reg.InputBatch = &batch.Batch{}
+	if ap.NeedSels {
+		ctr.bat, err = ctr.bat.Append(proc.Mp, bat)
+	} else {
+		err = ctr.insert(bat, ap, proc)
+	}
+	if err != nil {
+		if ctr.bat != nil {
+			batch.Clean(ctr.bat, proc.Mp)
+			ctr.bat = nil
+		}
+		ctr.broadcast(ap, proc)
+		return true, err
+	}
+	return false, nil
+}
+
+// addHash adds the hash of key k to the bloom filter
+func (ctr *Container) addHash(ap *Argument, k int) {
+	if len(ap.FilterRegs) == 0 || len(ctr.hashes) > runtimefilter.MaxKeys {
+		return
+	}
+	ctr.hashes = append(ctr.hashes, runtimefilter.Hash(ctr.seed, ctr.enc.Keys[k]))
+}
+
+// pushFilter sends the bloom filter of the keys to the scans, nil if the
+// build side is failed or of too many keys
+func (ctr *Container) pushFilter(ap *Argument, failed bool) {
+	var bf *runtimefilter.BloomFilter
+
+	if !failed && len(ctr.hashes) <= runtimefilter.MaxKeys {
+		bf = runtimefilter.NewBloomFilter(ctr.seed, ctr.hashes)
+	}
+	ctr.hashes = nil
+	for _, reg := range ap.FilterRegs {
+		select {
+		case <-reg.Ctx.Done():
+		case reg.Ch <- bf:
+		}
+	}
+}
+
+// broadcast sends the build side to the probes, referenced once by each.
+// The build side is nil if failed
+func (ctr *Container) broadcast(ap *Argument, proc *process.Process) {
+	bat := ctr.bat
+	ctr.bat = nil
+	ctr.pushFilter(ap, bat == nil)
+	if bat != nil {
+		if len(ap.Regs) == 0 {
+			batch.Clean(bat, proc.Mp)
+			return
+		}
+		bat.Ht = &HashMap{
+			Map:  ctr.strHashMap,
+			Sels: ctr.sels,
+		}
+		bat.Cnt = int64(len(ap.Regs))
+	}
+	for _, reg := range ap.Regs {
+		select {
+		case <-reg.Ctx.Done():
+			if bat != nil {
+				batch.Clean(bat, proc.Mp)
+			}
+		case reg.Ch <- bat:
+		}
+	}
+}
+
+// build builds the hashtable of all the rows of the build side
+func (ctr *Container) build(ap *Argument) {
+	count := len(ctr.bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		ctr.fillKeys(ctr.bat, ap.Conditions, i, n)
+		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] == 0 {
+				continue
+			}
+			if v > ctr.rows {
+				ctr.rows++
+				ctr.sels = append(ctr.sels, make([]int64, 0, 8))
+				ctr.addHash(ap, k)
+			}
+			ai := int64(v) - 1
+			ctr.sels[ai] = append(ctr.sels[ai], int64(i+k))
+		}
+	}
+}
+
+// insert inserts the keys of bat into the hashtable, keeping the first row
+// of each key and the number of rows of it in Zs
+func (ctr *Container) insert(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		ctr.fillKeys(bat, ap.Conditions, i, n)
+		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		cnt := 0
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] == 0 {
+				continue
+			}
+			if v > ctr.rows {
+				cnt++
+				ctr.rows++
+				ctr.inserted[k] = 1
+				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				ctr.addHash(ap, k)
+			}
+			ai := int64(v) - 1
+			ctr.bat.Zs[ai] += bat.Zs[i+k]
+		}
+		if cnt > 0 {
+			for j, vec := range ctr.bat.Vecs {
+				if err := vector.UnionBatch(vec, bat.Vecs[j], int64(i), cnt, ctr.inserted[:n], proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// fillKeys fills the keys of rows [i, i+n) of bat to ctr.enc.Keys
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, i, n int) {
+	ctr.enc.Reset()
+	for _, cond := range conds {
+		ctr.enc.Add(bat.Vecs[cond.Pos], cond.Scale)
+	}
+	ctr.enc.Encode(i, n, ctr.zValues)
+}
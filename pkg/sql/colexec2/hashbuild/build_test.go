@@ -0,0 +1,150 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/runtimefilter"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+func TestString(t *testing.T) {
+	String(&Argument{}, new(bytes.Buffer))
+}
+
+func TestBroadcast(t *testing.T) {
+	for _, needSels := range []bool{true, false} {
+		hm := host.New(1 << 30)
+		gm := guest.New(1<<30, hm)
+		proc := process.New(mheap.New(gm))
+		ctx, cancel := context.WithCancel(context.Background())
+		regs := []*process.WaitRegister{
+			{Ctx: ctx, Ch: make(chan *batch.Batch, 1)},
+			{Ctx: ctx, Ch: make(chan *batch.Batch, 1)},
+		}
+		arg := &Argument{
+			NeedSels:   needSels,
+			Typs:       []types.Type{{Oid: types.T_int8}},
+			Conditions: []Condition{{Pos: 0, Typ: types.Type{Oid: types.T_int8}}},
+			Regs:       regs,
+		}
+		require.NoError(t, Prepare(proc, arg))
+		for i := 0; i < 2; i++ {
+			proc.Reg.InputBatch = newBatch(t, proc, Rows)
+			ok, err := Call(proc, arg)
+			require.NoError(t, err)
+			require.False(t, ok)
+		}
+		proc.Reg.InputBatch = nil
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.True(t, ok)
+		for _, reg := range regs {
+			bat := <-reg.Ch
+			mp := bat.Ht.(*HashMap)
+			require.Equal(t, uint64(Rows), mp.Map.Cardinality())
+			if needSels {
+				require.Equal(t, 2*Rows, len(bat.Zs))
+				require.Equal(t, Rows, len(mp.Sels))
+				for _, sels := range mp.Sels {
+					require.Equal(t, 2, len(sels))
+				}
+			} else {
+				require.Equal(t, Rows, len(bat.Zs))
+				require.Nil(t, mp.Sels)
+				for _, z := range bat.Zs {
+					require.Equal(t, int64(2), z)
+				}
+			}
+			batch.Clean(bat, proc.Mp)
+		}
+		require.Equal(t, int64(0), mheap.Size(proc.Mp))
+		cancel()
+	}
+}
+
+func TestRuntimeFilter(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	freg := &runtimefilter.Register{Ctx: ctx, Ch: make(chan *runtimefilter.BloomFilter, 1)}
+	arg := &Argument{
+		Typs:       []types.Type{{Oid: types.T_int8}},
+		Conditions: []Condition{{Pos: 0, Typ: types.Type{Oid: types.T_int8}}},
+		FilterRegs: []*runtimefilter.Register{freg},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch(t, proc, Rows)
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+
+	// the keys 0, 1, ..., Rows-1 of the build side pass, most of the others
+	// are filtered out
+	farg := &runtimefilter.Argument{
+		Conditions: []runtimefilter.Condition{{Pos: 0, Typ: types.Type{Oid: types.T_int8}}},
+		Reg:        freg,
+	}
+	require.NoError(t, runtimefilter.Prepare(proc, farg))
+	proc.Reg.InputBatch = newBatch(t, proc, 100)
+	_, err = runtimefilter.Call(proc, farg)
+	require.NoError(t, err)
+	bat := proc.Reg.InputBatch
+	vs := bat.Vecs[0].Col.([]int8)
+	require.Equal(t, int64(100), farg.Stats.Rows)
+	require.Equal(t, int64(100-len(bat.Sels)), farg.Stats.FilteredRows)
+	require.Less(t, len(bat.Sels), 100)
+	for i := 0; i < Rows; i++ {
+		require.Equal(t, int8(i), vs[bat.Sels[i]])
+	}
+	batch.Clean(bat, proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+// create a new block of an int8 column of 0, 1, ...
+func newBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int8})
+	data, err := mheap.Alloc(proc.Mp, rows*1)
+	require.NoError(t, err)
+	vec.Data = data
+	vs := encoding.DecodeInt8Slice(vec.Data)[:rows]
+	for i := range vs {
+		vs[i] = int8(i)
+	}
+	vec.Col = vs
+	bat.Vecs[0] = vec
+	return bat
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"errors"
+	"hash/maphash"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/runtimefilter"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+const (
+	UnitLimit = 256
+)
+
+var OneInt64s []int64
+
+var (
+	// ErrBuildFailed is returned by the probes of a build side failed
+	ErrBuildFailed = errors.New("hash build failed")
+	// ErrNoSels is returned by a probe copying the columns other than the
+	// keys from a hashtable without the rows of each key
+	ErrNoSels = errors.New("hashtable built without the rows of each key")
+)
+
+// HashMap is the hashtable of the build side broadcast to the probes in
+// Batch.Ht. The batch is referenced once by each probe, the last one
+// cleaning it frees the build side
+type HashMap struct {
+	Map *hashtable.StringHashMap
+	// Sels are the rows of each key if all the rows are kept, nil if the
+	// rows are grouped by the keys
+	Sels [][]int64
+}
+
+type Container struct {
+	rows          uint64
+	enc           *hashkey.Encoder
+	values        []uint64
+	zValues       []int64
+	inserted      []uint8
+	zInserted     []uint8
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	sels [][]int64
+
+	seed   maphash.Seed
+	hashes []uint64 // hashes of the keys of the bloom filter
+
+	bat *batch.Batch
+}
+
+type Condition struct {
+	Pos   int32
+	Scale int32 // scale diff aligning the decimal key to the probe side
+	Typ   types.Type
+}
+
+type Argument struct {
+	ctr *Container
+	// NeedSels keeps all the rows of the build side, required by the probes
+	// copying the columns other than the keys
+	NeedSels   bool
+	Typs       []types.Type // types of the build side
+	Conditions []Condition
+	// Regs are the probe pipelines the hashtable is broadcast to
+	Regs []*process.WaitRegister
+	// FilterRegs receive the bloom filter of the keys, pushed to the scans
+	// of the probe sides of inner joins
+	FilterRegs []*runtimefilter.Register
+	Idx        int // index of the AnalyzeInfo of the operator
+}
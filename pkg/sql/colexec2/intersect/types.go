@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intersect
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+type Container struct {
+	state         int
+	rows          uint64
+	enc           *hashkey.Encoder
+	values        []uint64
+	inserted      []uint8
+	zInserted     []uint8
+	sent          []uint8 // sent[i] == 1: the rows of the i-th key are sent
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+}
+
+type Argument struct {
+	ctr *Container
+	Idx int // index of the AnalyzeInfo of the operator
+}
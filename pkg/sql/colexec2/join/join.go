@@ -16,13 +16,13 @@ package join
 
 import (
 	"bytes"
-	"unsafe"
 
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
 )
 
@@ -40,7 +40,7 @@ func String(_ interface{}, buf *bytes.Buffer) {
 func Prepare(proc *process.Process, arg interface{}) error {
 	ap := arg.(*Argument)
 	ap.ctr = new(Container)
-	ap.ctr.keys = make([][]byte, UnitLimit)
+	ap.ctr.enc = hashkey.NewEncoder(UnitLimit, false)
 	ap.ctr.values = make([]uint64, UnitLimit)
 	ap.ctr.zValues = make([]int64, UnitLimit)
 	ap.ctr.inserted = make([]uint8, UnitLimit)
@@ -81,24 +81,33 @@ func Prepare(proc *process.Process, arg interface{}) error {
 		}
 		ap.ctr.flg = flg
 	}
-	ap.ctr.decimal64Slice = make([]types.Decimal64, UnitLimit)
-	ap.ctr.decimal128Slice = make([]types.Decimal128, UnitLimit)
 	return nil
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	ap := arg.(*Argument)
 	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		switch ctr.state {
 		case Build:
-			if err := ctr.build(ap, proc); err != nil {
+			if err := ctr.build(ap, proc, &anal); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				return true, err
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Input(bat)
 			if bat == nil {
 				ctr.state = End
 				batch.Clean(ctr.bat, proc.Mp)
@@ -112,6 +121,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				proc.Reg.InputBatch = nil
 				return true, err
 			}
+			anal.Output(proc.Reg.InputBatch)
 			return false, nil
 		default:
 			proc.Reg.InputBatch = nil
@@ -120,18 +130,38 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
-func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+func (ctr *Container) build(ap *Argument, proc *process.Process, anal *process.Analyze) error {
 	if ap.IsPreBuild {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		anal.Input(bat)
+		if bat == nil {
+			return hashbuild.ErrBuildFailed
+		}
 		ctr.bat = bat
+		if mp, ok := bat.Ht.(*hashbuild.HashMap); ok {
+			if ctr.flg && mp.Sels == nil {
+				batch.Clean(ctr.bat, proc.Mp)
+				ctr.bat = nil
+				return hashbuild.ErrNoSels
+			}
+			// the rows of each key are kept
+			ctr.flg = mp.Sels != nil
+			ctr.strHashMap, ctr.sels = mp.Map, mp.Sels
+			return nil
+		}
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		return nil
 	}
 	if ctr.flg {
-		var err error
-
 		for {
-			bat := <-proc.Reg.MergeReceivers[1].Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[1])
+			if err != nil {
+				return err
+			}
+			anal.Input(bat)
 			if bat == nil {
 				break
 			}
@@ -158,52 +188,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				n = UnitLimit
 			}
 			copy(ctr.zValues[:n], OneInt64s[:n])
-			for _, cond := range ap.Conditions[1] {
-				vec := ctr.bat.Vecs[cond.Pos]
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[i] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
-			}
-			for k := 0; k < n; k++ {
-				if l := len(ctr.keys[k]); l < 16 {
-					ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
-			}
-			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
+			ctr.fillKeys(ctr.bat, ap.Conditions[1], i, n)
+			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
 			for k, v := range ctr.values[:n] {
 				if ctr.zValues[k] == 0 {
 					continue
@@ -214,14 +200,15 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				ai := int64(v) - 1
 				ctr.sels[ai] = append(ctr.sels[ai], int64(i+k))
 			}
-			for k := 0; k < n; k++ {
-				ctr.keys[k] = ctr.keys[k][:0]
-			}
 		}
 		return nil
 	}
 	for {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		anal.Input(bat)
 		if bat == nil {
 			return nil
 		}
@@ -241,52 +228,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				n = UnitLimit
 			}
 			copy(ctr.zValues[:n], OneInt64s[:n])
-			for _, cond := range ap.Conditions[1] {
-				vec := bat.Vecs[cond.Pos]
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[i] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
-			}
-			for k := 0; k < n; k++ {
-				if l := len(ctr.keys[k]); l < 16 {
-					ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
-			}
-			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
+			ctr.fillKeys(bat, ap.Conditions[1], i, n)
+			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
 			cnt := 0
 			copy(ctr.inserted[:n], ctr.zInserted[:n])
 			for k, v := range ctr.values[:n] {
@@ -312,14 +255,19 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 
 				}
 			}
-			for k := 0; k < n; k++ {
-				ctr.keys[k] = ctr.keys[k][:0]
-			}
 			batch.Clean(bat, proc.Mp)
 		}
 	}
 }
 
+// clean frees the build side once the join is aborted
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+}
+
 func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	defer batch.Clean(bat, proc.Mp)
 	rbat := batch.New(len(ap.Result))
@@ -337,55 +285,10 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 			n = UnitLimit
 		}
 		copy(ctr.zValues[:n], OneInt64s[:n])
-		for _, cond := range ap.Conditions[0] {
-			vec := bat.Vecs[cond.Pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				}
-			case -16:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-				}
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
-						} else {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-			}
-		}
-		for k := 0; k < n; k++ {
-			if l := len(ctr.keys[k]); l < 16 {
-				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-			}
-		}
-		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
-		for k := 0; k < n; k++ {
-			ctr.keys[k] = ctr.keys[k][:0]
-		}
+		// the rows not selected are skipped like the ones of null keys
+		batch.MaskUnselected(bat, ctr.zValues[:n], i)
+		ctr.fillKeys(bat, ap.Conditions[0], i, n)
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
 		for k := 0; k < n; k++ {
 			if ctr.zValues[k] == 0 {
 				continue
@@ -434,59 +337,11 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 	return nil
 }
 
-func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
-	vs := vector.DecodeFixedCol[T](vec, sz)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
-			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
-			}
-		}
-	}
-}
-
-func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
-	src := vector.DecodeFixedCol[types.Decimal64](vec, 8)
-	vs := types.AlignDecimal64UsingScaleDiffBatch(src[start:start+n], ctr.decimal64Slice[:n], scale)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
-			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
-			}
-		}
-	}
-}
-
-func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
-	src := vector.DecodeFixedCol[types.Decimal128](vec, 16)
-	vs := ctr.decimal128Slice[:n]
-	types.AlignDecimal128UsingScaleDiffBatch(src[start:start+n], vs, scale)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
-			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
-			}
-		}
+// fillKeys fills the keys of rows [i, i+n) of bat to ctr.enc.Keys
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, i, n int) {
+	ctr.enc.Reset()
+	for _, cond := range conds {
+		ctr.enc.Add(bat.Vecs[cond.Pos], cond.Scale)
 	}
+	ctr.enc.Encode(i, n, ctr.zValues)
 }
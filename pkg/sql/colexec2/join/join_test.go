@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -200,6 +201,97 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinCancel(t *testing.T) {
+	gm := guest.New(1<<30, host.New(1<<30))
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []ResultPos{{0, 0}, {1, 0}},
+		[][]Condition{
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+		})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Cancel()
+	end, err := Call(tc.proc, tc.arg)
+	require.True(t, end)
+	require.Equal(t, context.Canceled, err)
+	for len(tc.proc.Reg.MergeReceivers[1].Ch) > 0 {
+		batch.Clean(<-tc.proc.Reg.MergeReceivers[1].Ch, tc.proc.Mp)
+	}
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
+func TestJoinWithHashBuild(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	m := mheap.New(gm)
+	ts := []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}
+	cs := [][]Condition{
+		{
+			{0, 0, types.Type{Oid: types.T_int8}},
+		},
+		{
+			{0, 0, types.Type{Oid: types.T_int8}},
+		},
+	}
+	for _, needSels := range []bool{true, false} {
+		tcs := []joinTestCase{
+			newTestCase(m, []bool{false, false}, ts, []ResultPos{{0, 0}, {1, 1}}, cs),
+			newTestCase(m, []bool{false, false}, ts, []ResultPos{{0, 0}, {1, 1}}, cs),
+		}
+		bproc := process.New(m)
+		barg := &hashbuild.Argument{
+			NeedSels:   needSels,
+			Typs:       ts,
+			Conditions: []hashbuild.Condition{{Pos: 0, Typ: ts[0]}},
+		}
+		for _, tc := range tcs {
+			tc.arg.IsPreBuild = true
+			barg.Regs = append(barg.Regs, tc.proc.Reg.MergeReceivers[1])
+		}
+		require.NoError(t, hashbuild.Prepare(bproc, barg))
+		for i := 0; i < 2; i++ {
+			bproc.Reg.InputBatch = newBatch(t, []bool{false, false}, ts, bproc, Rows)
+			ok, err := hashbuild.Call(bproc, barg)
+			require.NoError(t, err)
+			require.False(t, ok)
+		}
+		bproc.Reg.InputBatch = nil
+		ok, err := hashbuild.Call(bproc, barg)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		for _, tc := range tcs {
+			require.NoError(t, Prepare(tc.proc, tc.arg))
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- nil
+			rows := 0
+			for {
+				if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+					if !needSels {
+						require.Equal(t, hashbuild.ErrNoSels, err)
+						batch.Clean(<-tc.proc.Reg.MergeReceivers[0].Ch, tc.proc.Mp)
+					} else {
+						require.NoError(t, err)
+					}
+					break
+				}
+				rows += len(tc.proc.Reg.InputBatch.Zs)
+				batch.Clean(tc.proc.Reg.InputBatch, tc.proc.Mp)
+			}
+			if needSels {
+				// each probe row matches the 2 build rows of its key
+				require.Equal(t, 2*Rows, rows)
+			}
+		}
+		// freed once all the probes are done
+		require.Equal(t, int64(0), mheap.Size(m))
+	}
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -16,6 +16,7 @@ package join
 
 import (
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
@@ -36,7 +37,7 @@ type Container struct {
 	flg           bool // incicates if addition columns need to be copied
 	state         int
 	rows          uint64
-	keys          [][]byte
+	enc           *hashkey.Encoder
 	values        []uint64
 	zValues       []int64
 	hashes        []uint64
@@ -50,9 +51,6 @@ type Container struct {
 	sels [][]int64
 
 	bat *batch.Batch
-
-	decimal64Slice  []types.Decimal64
-	decimal128Slice []types.Decimal128
 }
 
 type ResultPos struct {
@@ -71,4 +69,5 @@ type Argument struct {
 	IsPreBuild bool // hashtable is pre-build
 	Result     []ResultPos
 	Conditions [][]Condition
+	Idx        int // index of the AnalyzeInfo of the operator
 }
@@ -17,6 +17,7 @@ package left
 import (
 	"bytes"
 	"context"
+	"os"
 	"strconv"
 	"testing"
 
@@ -25,10 +26,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 	"github.com/stretchr/testify/require"
 )
 
@@ -200,6 +204,123 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinWithCond(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	ts := []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}
+	cs := [][]Condition{
+		{
+			{0, 0, types.Type{Oid: types.T_int8}},
+		},
+		{
+			{0, 0, types.Type{Oid: types.T_int8}},
+		},
+	}
+	// the pairs matched by the keys have a == b
+	for op, matched := range map[int]bool{overload.LT: false, overload.GE: true} {
+		tc := newTestCase(mheap.New(gm), []bool{false, false}, ts, []ResultPos{{0, 1}, {1, 1}}, cs)
+		tc.arg.Cond = &extend.BinaryExtend{
+			Op:    op,
+			Left:  &extend.Attribute{Name: "a", Type: types.T_int64},
+			Right: &extend.Attribute{Name: "b", Type: types.T_int64},
+		}
+		tc.arg.Attrs = [2][]string{{"k", "a"}, {"k", "b"}}
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		rows := 0
+		for {
+			if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+				require.NoError(t, err)
+				break
+			}
+			bat := tc.proc.Reg.InputBatch
+			for k := range bat.Zs {
+				require.Equal(t, !matched, nulls.Contains(bat.Vecs[1].Nsp, uint64(k)))
+			}
+			rows += len(bat.Zs)
+			batch.Clean(bat, tc.proc.Mp)
+		}
+		require.Equal(t, 2*Rows, rows)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func TestJoinWithSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	ts := []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}
+	cs := [][]Condition{
+		{
+			{0, 0, types.Type{Oid: types.T_int8}},
+		},
+		{
+			{0, 0, types.Type{Oid: types.T_int8}},
+		},
+	}
+	for _, flg := range []bool{false, true} {
+		tc := newTestCase(mheap.New(gm), []bool{flg, flg}, ts, []ResultPos{{0, 0}, {1, 1}}, cs)
+		dir := t.TempDir()
+		tc.proc.Spill = spill.New(dir)
+		tc.proc.Lim.Size = 1
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		rows, nullRows := 0, 0
+		for {
+			if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+				require.NoError(t, err)
+				break
+			}
+			require.NotNil(t, tc.arg.ctr.spill)
+			bat := tc.proc.Reg.InputBatch
+			for k := range bat.Zs {
+				if nulls.Contains(bat.Vecs[1].Nsp, uint64(k)) {
+					nullRows++
+				}
+			}
+			rows += len(bat.Zs)
+			batch.Clean(bat, tc.proc.Mp)
+		}
+		// each probe row matches the 2 build rows of its key but the null one
+		if flg {
+			require.Equal(t, 4*(Rows-1)+2, rows)
+			require.Equal(t, 2, nullRows)
+		} else {
+			require.Equal(t, 4*Rows, rows)
+			require.Equal(t, 0, nullRows)
+		}
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, files)
+	}
+}
+
+func TestCondNotLogical(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []ResultPos{{0, 0}, {1, 0}},
+		[][]Condition{
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+			{
+				{0, 0, types.Type{Oid: types.T_int8}},
+			},
+		})
+	tc.arg.Cond = &extend.Attribute{Name: "a", Type: types.T_int8}
+	tc.arg.Attrs = [2][]string{{"a"}, {"b"}}
+	require.Equal(t, ErrNotLogical, Prepare(tc.proc, tc.arg))
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -0,0 +1,204 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"errors"
+	"fmt"
+
+	obatch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	oprocess "github.com/matrixorigin/matrixone/pkg/vm/process"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+var (
+	ErrNotLogical   = errors.New("left join: residual condition is not logical")
+	ErrCondPreBuild = errors.New("left join: residual condition on a pre-built hashtable")
+)
+
+// prepareCond prepares the evaluation of the residual condition. All the
+// rows and columns of the build side are kept for it
+func (ctr *Container) prepareCond(ap *Argument, proc *process.Process) error {
+	if !ap.Cond.IsLogical() {
+		return ErrNotLogical
+	}
+	if ap.IsPreBuild {
+		return ErrCondPreBuild
+	}
+	ctr.flg = true
+	ctr.proc = oprocess.New(proc.Mp)
+	mp := make(map[string]ResultPos)
+	for rel, attrs := range ap.Attrs {
+		for pos, attr := range attrs {
+			mp[attr] = ResultPos{Rel: int32(rel), Pos: int32(pos)}
+		}
+	}
+	seen := make(map[string]struct{})
+	for _, attr := range ap.Cond.Attributes() {
+		if _, ok := seen[attr]; ok {
+			continue
+		}
+		seen[attr] = struct{}{}
+		rp, ok := mp[attr]
+		if !ok {
+			return fmt.Errorf("left join: unknown attribute '%s'", attr)
+		}
+		ctr.attrs = append(ctr.attrs, rp)
+	}
+	return nil
+}
+
+// probeWithCond emits the pairs matched by the keys and satisfying the
+// residual condition, or the probe row null-extended if none
+func (ctr *Container) probeWithCond(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := batch.New(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		ctr.fillKeys(bat, ap.Conditions[0], i, n)
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		ctr.is, ctr.js = ctr.is[:0], ctr.js[:0]
+		for k := 0; k < n; k++ {
+			if ctr.zValues[k] == 0 || ctr.values[k] == 0 {
+				continue
+			}
+			for _, sel := range ctr.sels[ctr.values[k]-1] {
+				ctr.is = append(ctr.is, int64(i+k))
+				ctr.js = append(ctr.js, sel)
+			}
+		}
+		passed, err := ctr.evalCond(bat, ap, proc)
+		if err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return err
+		}
+		p := 0
+		for k := 0; k < n; k++ {
+			joined := false
+			for ; p < len(ctr.is) && ctr.is[p] == int64(i+k); p++ {
+				if !passed[p] {
+					continue
+				}
+				joined = true
+				if err := ctr.unionPair(rbat, bat, ap, int64(i+k), ctr.js[p], proc); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return err
+				}
+				rbat.Zs = append(rbat.Zs, ctr.bat.Zs[ctr.js[p]])
+			}
+			if joined {
+				continue
+			}
+			if err := ctr.unionPair(rbat, bat, ap, int64(i+k), -1, proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+			rbat.Zs = append(rbat.Zs, bat.Zs[i+k])
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// unionPair appends row i of bat joined with row j of the build side, or
+// null-extended if j is negative
+func (ctr *Container) unionPair(rbat, bat *batch.Batch, ap *Argument, i, j int64, proc *process.Process) error {
+	for k, rp := range ap.Result {
+		var err error
+		switch {
+		case rp.Rel == 0:
+			err = vector.UnionOne(rbat.Vecs[k], bat.Vecs[rp.Pos], i, proc.Mp)
+		case j < 0:
+			err = vector.UnionNull(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], proc.Mp)
+		default:
+			err = vector.UnionOne(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], j, proc.Mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evalCond evaluates the residual condition on the pairs of ctr.is and
+// ctr.js. passed[p] is true if pair p satisfies it
+func (ctr *Container) evalCond(bat *batch.Batch, ap *Argument, proc *process.Process) ([]bool, error) {
+	passed := make([]bool, len(ctr.is))
+	if len(ctr.is) == 0 {
+		return passed, nil
+	}
+	cbat := &obatch.Batch{
+		Attrs: make([]string, len(ctr.attrs)),
+		Vecs:  make([]*vector.Vector, len(ctr.attrs)),
+		Zs:    make([]int64, len(ctr.is)),
+	}
+	defer func() {
+		for _, vec := range cbat.Vecs {
+			if vec != nil {
+				vector.Clean(vec, proc.Mp)
+			}
+		}
+	}()
+	for i, rp := range ctr.attrs {
+		src, sels := bat.Vecs[rp.Pos], ctr.is
+		if rp.Rel == 1 {
+			src, sels = ctr.bat.Vecs[rp.Pos], ctr.js
+		}
+		vec := vector.New(src.Typ)
+		cbat.Vecs[i] = vec
+		for _, sel := range sels {
+			if err := vector.UnionOne(vec, src, sel, proc.Mp); err != nil {
+				return nil, err
+			}
+		}
+		// keeps the vector from being reused or freed by the evaluator
+		vec.Ref = 2
+		cbat.Attrs[i] = ap.Attrs[rp.Rel][rp.Pos]
+	}
+	vec, _, err := ap.Cond.Eval(cbat, ctr.proc)
+	if err != nil {
+		return nil, err
+	}
+	for _, sel := range vec.Col.([]int64) {
+		passed[sel] = true
+	}
+	oprocess.Put(ctr.proc, vec)
+	return passed, nil
+}
+
+// freeRegisters frees the vectors kept by the evaluator
+func (ctr *Container) freeRegisters() {
+	if ctr.proc == nil {
+		return
+	}
+	for _, vec := range ctr.proc.Reg.Vecs {
+		vector.Clean(vec, ctr.proc.Mp)
+	}
+	ctr.proc.Reg.Vecs = ctr.proc.Reg.Vecs[:0]
+}
@@ -15,19 +15,28 @@
 package left
 
 import (
+	"hash/maphash"
+
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
 	Build = iota
 	Probe
+	Partition
 	End
 )
 
 const (
 	UnitLimit = 256
+	// SpillPartitions is the number of partitions both sides are spilled to
+	SpillPartitions = 16
 )
 
 var OneInt64s []int64
@@ -36,7 +45,7 @@ type Container struct {
 	flg           bool // incicates if addition columns need to be copied
 	state         int
 	rows          uint64
-	keys          [][]byte
+	enc           *hashkey.Encoder
 	values        []uint64
 	zValues       []int64
 	hashes        []uint64
@@ -51,8 +60,27 @@ type Container struct {
 
 	bat *batch.Batch
 
-	decimal64Slice  []types.Decimal64
-	decimal128Slice []types.Decimal128
+	// attrs are the columns the residual condition refers to
+	attrs []ResultPos
+	// is and js are the rows of the probe side and the build side of the
+	// pairs matched by the keys
+	is, js []int64
+	// proc evaluates the residual condition
+	proc *process.Process
+
+	spill *spillContainer
+}
+
+// spillContainer has the partitions both sides are spilled to once the
+// build side exceeds the memory limit. They are joined one by one
+type spillContainer struct {
+	idx    int          // partition being joined
+	loaded bool         // indicates if the build partition idx is loaded
+	typs   []types.Type // types of the build side
+	parts  []int
+	seed   maphash.Seed
+	build  *spill.Partitions
+	probe  *spill.Partitions
 }
 
 type ResultPos struct {
@@ -71,4 +99,11 @@ type Argument struct {
 	IsPreBuild bool // hashtable is pre-build
 	Result     []ResultPos
 	Conditions [][]Condition
+	// Cond is the optional residual condition on the pairs matched by the
+	// keys, a logical expression on the columns of the probe side named by
+	// Attrs[0] and the columns of the build side named by Attrs[1]. A probe
+	// row without any pair satisfying Cond is null-extended
+	Cond  extend.Extend
+	Attrs [2][]string
+	Idx   int // index of the AnalyzeInfo of the operator
 }
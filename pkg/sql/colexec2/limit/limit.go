@@ -33,11 +33,15 @@ func Prepare(_ *process.Process, _ interface{}) error {
 
 // returning only the first n tuples from its input
 func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	bat := proc.Reg.InputBatch
 	if bat == nil || len(bat.Zs) == 0 {
 		return false, nil
 	}
-	n := arg.(*Argument)
+	anal.Input(bat)
 	if n.Seen >= n.Limit {
 		proc.Reg.InputBatch = nil
 		batch.Clean(bat, proc.Mp)
@@ -48,8 +52,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if newSeen >= n.Limit { // limit - seen
 		batch.SetLength(bat, int(n.Limit-n.Seen))
 		n.Seen = newSeen
+		anal.Output(bat)
 		return true, nil
 	}
 	n.Seen = newSeen
+	anal.Output(bat)
 	return false, nil
 }
@@ -17,4 +17,5 @@ package limit
 type Argument struct {
 	Seen  uint64 // seen is the number of tuples seen so far
 	Limit uint64
+	Idx   int // index of the AnalyzeInfo of the operator
 }
@@ -0,0 +1,602 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package load
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Run loads the file of arg into rel in the transaction of snap. The file
+// is cut into chunks at the boundaries of the lines, which are parsed in
+// parallel, and the batches of the rows are appended by the caller. It
+// stops once ctx is canceled
+func Run(ctx context.Context, arg *Argument, rel Appender, snap engine.Snapshot) (*Result, error) {
+	if len(arg.Attrs) == 0 || len(arg.Attrs) != len(arg.Types) {
+		return nil, ErrNoColumn
+	}
+	for _, typ := range arg.Types {
+		if !Supported(typ) {
+			return nil, fmt.Errorf("%w: %s", ErrType, typ)
+		}
+	}
+	info, err := os.Stat(arg.File)
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	bounds := chunks(size, arg.Workers, arg.Quote != 0 || arg.Escape != 0)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ld := &loader{
+		arg: arg,
+		ch:  make(chan *batch.Batch, len(bounds)),
+	}
+	var wg sync.WaitGroup
+	for i := 0; i+1 < len(bounds); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := ld.parse(ctx, i == 0, bounds[i], bounds[i+1]); err != nil {
+				ld.fail(err)
+				cancel()
+			}
+		}(i)
+	}
+	go func() {
+		wg.Wait()
+		close(ld.ch)
+	}()
+
+	r := &Result{}
+	for bat := range ld.ch {
+		if ld.failed() {
+			continue
+		}
+		if err := rel.Write(arg.Ts, bat, snap); err != nil {
+			ld.fail(err)
+			cancel()
+			continue
+		}
+		r.Rows += uint64(vector.Length(bat.Vecs[0]))
+		if arg.Progress != nil {
+			arg.Progress(Progress{
+				Rows:   r.Rows,
+				Errors: atomic.LoadUint64(&ld.skipped) + atomic.LoadUint64(&ld.warnings),
+				Bytes:  atomic.LoadUint64(&ld.bytes),
+				Size:   uint64(size),
+			})
+		}
+	}
+	if err := ld.error(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.Skipped, r.Warnings = ld.skipped, ld.warnings
+	return r, nil
+}
+
+// loader is shared by the workers of a load
+type loader struct {
+	sync.Mutex
+	arg *Argument
+	err error
+	ch  chan *batch.Batch
+	// bytes parsed, and the bad rows skipped or loaded with nulls
+	bytes    uint64
+	skipped  uint64
+	warnings uint64
+}
+
+func (ld *loader) fail(err error) {
+	ld.Lock()
+	defer ld.Unlock()
+	if ld.err == nil {
+		ld.err = err
+	}
+}
+
+func (ld *loader) failed() bool {
+	return ld.error() != nil
+}
+
+func (ld *loader) error() error {
+	ld.Lock()
+	defer ld.Unlock()
+	return ld.err
+}
+
+// chunks returns the boundaries of the chunks of the file of size bytes
+// parsed by the workers, the file is one chunk if its lines may span the
+// terminators
+func chunks(size int64, workers int, spanning bool) []int64 {
+	if n := int((size + MinChunkSize - 1) / MinChunkSize); workers > n {
+		workers = n
+	}
+	if workers < 1 || spanning {
+		workers = 1
+	}
+	bounds := make([]int64, workers+1)
+	for i := range bounds {
+		bounds[i] = size * int64(i) / int64(workers)
+	}
+	return bounds
+}
+
+// parse sends the batches of the lines starting in [start, end) of the file,
+// the first chunk skips the lines ignored
+func (ld *loader) parse(ctx context.Context, first bool, start, end int64) error {
+	arg := ld.arg
+	f, err := os.Open(arg.File)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	term := arg.Term
+	if term == 0 {
+		term = '\n'
+	}
+	pos := start
+	if !first {
+		// The line going on at start is the one of the previous chunk
+		pos--
+	}
+	if _, err = f.Seek(pos, io.SeekStart); err != nil {
+		return err
+	}
+	rd := bufio.NewReaderSize(f, MinChunkSize)
+	if !first {
+		skip, err := rd.ReadSlice(term)
+		if err != nil && err != bufio.ErrBufferFull && err != io.EOF {
+			return err
+		}
+		for pos += int64(len(skip)); err == bufio.ErrBufferFull; pos += int64(len(skip)) {
+			skip, err = rd.ReadSlice(term)
+		}
+	}
+	b := newBuilder(arg)
+	ignore := uint64(0)
+	if first {
+		ignore = arg.IgnoreLines
+	}
+	for pos < end {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// A huge value makes a huge line, which is read as a whole
+		line, err := rd.ReadBytes(term)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		offset := pos
+		pos += int64(len(line))
+		fields, ok := splitFields(bytes.TrimSuffix(line, []byte{term}), arg.Sep, arg.Quote, arg.Escape)
+		for !ok && err == nil {
+			// The enclosed or escaped field goes on the next line
+			var next []byte
+			next, err = rd.ReadBytes(term)
+			if err != nil && err != io.EOF {
+				return err
+			}
+			pos += int64(len(next))
+			line = append(line, next...)
+			fields, ok = splitFields(bytes.TrimSuffix(line, []byte{term}), arg.Sep, arg.Quote, arg.Escape)
+		}
+		atomic.AddUint64(&ld.bytes, uint64(pos-offset))
+		if len(line) == 0 {
+			break
+		}
+		if ignore > 0 {
+			ignore--
+		} else if len(bytes.TrimSpace(line)) > 0 {
+			if err := ld.appendRow(b, fields, offset); err != nil {
+				return err
+			}
+			if b.rows >= b.batchRows {
+				if !ld.send(ctx, b.batch()) {
+					return ctx.Err()
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	if b.rows > 0 && !ld.send(ctx, b.batch()) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+func (ld *loader) send(ctx context.Context, bat *batch.Batch) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case ld.ch <- bat:
+		return true
+	}
+}
+
+// appendRow appends the row of the fields of the line at offset by the
+// policy of the load
+func (ld *loader) appendRow(b *builder, fields [][]byte, offset int64) error {
+	arg := ld.arg
+	bad := -1
+	if len(fields) != len(arg.Types) {
+		bad = len(fields)
+	}
+	for i := range b.vals {
+		b.vals[i] = nil
+		if i >= len(fields) {
+			continue
+		}
+		v, err := parseField(fields[i], arg.Types[i])
+		if err != nil {
+			if arg.Policy == Abort {
+				return fmt.Errorf("load: bad value '%s' of column %s in the line at offset %v: %v", fields[i], arg.Attrs[i], offset, err)
+			}
+			bad = i
+			continue
+		}
+		b.vals[i] = v
+	}
+	if bad >= 0 {
+		var n uint64
+		switch arg.Policy {
+		case Abort:
+			return fmt.Errorf("load: %v fields of the %v columns in the line at offset %v", len(fields), len(arg.Types), offset)
+		case SkipRow:
+			n = atomic.AddUint64(&ld.skipped, 1) + atomic.LoadUint64(&ld.warnings)
+		case SetNull:
+			n = atomic.AddUint64(&ld.warnings, 1) + atomic.LoadUint64(&ld.skipped)
+		}
+		if arg.MaxErrors >= 0 && n > uint64(arg.MaxErrors) {
+			return fmt.Errorf("%w: %v rows are bad, the line at offset %v at last", ErrTooManyErrors, n, offset)
+		}
+		if arg.Policy == SkipRow {
+			return nil
+		}
+	}
+	return b.append()
+}
+
+// splitFields splits line into its fields, it returns false if an enclosed
+// field is not closed or the terminator is escaped at the end of the line
+func splitFields(line []byte, sep, quote, escape byte) ([][]byte, bool) {
+	if escape != 0 {
+		return splitEscapedFields(line, sep, quote, escape)
+	}
+	var fields [][]byte
+	for {
+		if quote == 0 || len(line) == 0 || line[0] != quote {
+			i := bytes.IndexByte(line, sep)
+			if i < 0 {
+				return append(fields, line), true
+			}
+			fields = append(fields, line[:i])
+			line = line[i+1:]
+			continue
+		}
+		// The doubled quotes of an enclosed field are unquoted
+		var field []byte
+		i := 1
+		for {
+			j := bytes.IndexByte(line[i:], quote)
+			if j < 0 {
+				return nil, false
+			}
+			field = append(field, line[i:i+j]...)
+			i += j + 1
+			if i < len(line) && line[i] == quote {
+				field = append(field, quote)
+				i++
+				continue
+			}
+			break
+		}
+		fields = append(fields, field)
+		if i >= len(line) {
+			return fields, true
+		}
+		// The rest till the separator is dropped
+		j := bytes.IndexByte(line[i:], sep)
+		if j < 0 {
+			return fields, true
+		}
+		line = line[i+j+1:]
+	}
+}
+
+// splitEscapedFields splits line into the fields whose characters following
+// escape are unescaped. A field of escape and N is null, which is empty
+func splitEscapedFields(line []byte, sep, quote, escape byte) ([][]byte, bool) {
+	var fields [][]byte
+	field := []byte{}
+	// start is true at the first character of a field, and enclosed in an
+	// enclosed field
+	start, enclosed := true, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == escape:
+			if i+1 == len(line) {
+				return nil, false
+			}
+			i++
+			if start && !enclosed && line[i] == 'N' && (i+1 == len(line) || line[i+1] == sep) {
+				break
+			}
+			field = append(field, unescape(line[i]))
+		case enclosed && c == quote:
+			if i+1 < len(line) && line[i+1] == quote {
+				field = append(field, quote)
+				i++
+				break
+			}
+			enclosed = false
+			fields = append(fields, field)
+			// The rest till the separator is dropped
+			j := bytes.IndexByte(line[i+1:], sep)
+			if j < 0 {
+				return fields, true
+			}
+			i += j + 1
+			field, start = []byte{}, true
+			continue
+		case enclosed:
+			field = append(field, c)
+		case c == sep:
+			fields = append(fields, field)
+			field, start = []byte{}, true
+			continue
+		case c == quote && quote != 0 && start:
+			enclosed = true
+		default:
+			field = append(field, c)
+		}
+		start = false
+	}
+	if enclosed {
+		return nil, false
+	}
+	return append(fields, field), true
+}
+
+// unescape returns the character escaped by c
+func unescape(c byte) byte {
+	switch c {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 26
+	}
+	return c
+}
+
+// builder makes the batches of the rows parsed by a worker
+type builder struct {
+	arg       *Argument
+	vecs      []*vector.Vector
+	vals      []interface{}
+	rows      int
+	batchRows int
+}
+
+func newBuilder(arg *Argument) *builder {
+	b := &builder{
+		arg:       arg,
+		vals:      make([]interface{}, len(arg.Types)),
+		batchRows: arg.BatchRows,
+	}
+	if b.batchRows <= 0 {
+		b.batchRows = DefaultBatchRows
+	}
+	b.reset()
+	return b
+}
+
+func (b *builder) reset() {
+	b.vecs = make([]*vector.Vector, len(b.arg.Types))
+	for i, typ := range b.arg.Types {
+		b.vecs[i] = vector.New(typ)
+	}
+	b.rows = 0
+}
+
+// append appends the values of the row, nil ones are null
+func (b *builder) append() error {
+	for i, v := range b.vals {
+		vec := b.vecs[i]
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(b.rows))
+			v = zeroValue(vec.Typ)
+		}
+		if err := appendValue(vec, v); err != nil {
+			return err
+		}
+	}
+	b.rows++
+	return nil
+}
+
+// batch returns the batch of the rows appended and starts a new one
+func (b *builder) batch() *batch.Batch {
+	bat := batch.New(true, b.arg.Attrs)
+	bat.Vecs = b.vecs
+	b.reset()
+	return bat
+}
+
+// Supported returns true if the fields of typ can be loaded
+func Supported(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_char, types.T_varchar,
+		types.T_json, types.T_date, types.T_datetime:
+		return true
+	}
+	return false
+}
+
+// parseField converts the field to the value of typ, nil if it is null. The
+// spaces around the field are trimmed
+func parseField(field []byte, typ types.Type) (interface{}, error) {
+	s := string(bytes.TrimSpace(field))
+	if len(s) == 0 || s == NullField {
+		return nil, nil
+	}
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		bits := typ.Oid.TypeLen() * 8
+		v, err := strconv.ParseInt(s, 10, bits)
+		if err != nil {
+			// A number of a fraction is truncated
+			f, ferr := strconv.ParseFloat(s, 64)
+			if ferr != nil || f < -math.Exp2(float64(bits-1)) || f >= math.Exp2(float64(bits-1)) {
+				return nil, err
+			}
+			v = int64(f)
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			return int8(v), nil
+		case types.T_int16:
+			return int16(v), nil
+		case types.T_int32:
+			return int32(v), nil
+		}
+		return v, nil
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		bits := typ.Oid.TypeLen() * 8
+		v, err := strconv.ParseUint(s, 10, bits)
+		if err != nil {
+			f, ferr := strconv.ParseFloat(s, 64)
+			if ferr != nil || f < 0 || f >= math.Exp2(float64(bits)) {
+				return nil, err
+			}
+			v = uint64(f)
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			return uint8(v), nil
+		case types.T_uint16:
+			return uint16(v), nil
+		case types.T_uint32:
+			return uint32(v), nil
+		}
+		return v, nil
+	case types.T_float32:
+		v, err := strconv.ParseFloat(s, 32)
+		return float32(v), err
+	case types.T_float64:
+		return strconv.ParseFloat(s, 64)
+	case types.T_char, types.T_varchar, types.T_json:
+		return []byte(s), nil
+	case types.T_date:
+		return types.ParseDate(s)
+	case types.T_datetime:
+		return types.ParseDatetime(s)
+	}
+	return nil, ErrType
+}
+
+// zeroValue returns the value of typ set to the null rows
+func zeroValue(typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(0)
+	case types.T_int16:
+		return int16(0)
+	case types.T_int32:
+		return int32(0)
+	case types.T_int64:
+		return int64(0)
+	case types.T_uint8:
+		return uint8(0)
+	case types.T_uint16:
+		return uint16(0)
+	case types.T_uint32:
+		return uint32(0)
+	case types.T_uint64:
+		return uint64(0)
+	case types.T_float32:
+		return float32(0)
+	case types.T_float64:
+		return float64(0)
+	case types.T_date:
+		return types.Date(0)
+	case types.T_datetime:
+		return types.Datetime(0)
+	}
+	return []byte{}
+}
+
+func appendValue(vec *vector.Vector, v interface{}) error {
+	switch v := v.(type) {
+	case int8:
+		return vector.Append(vec, []int8{v})
+	case int16:
+		return vector.Append(vec, []int16{v})
+	case int32:
+		return vector.Append(vec, []int32{v})
+	case int64:
+		return vector.Append(vec, []int64{v})
+	case uint8:
+		return vector.Append(vec, []uint8{v})
+	case uint16:
+		return vector.Append(vec, []uint16{v})
+	case uint32:
+		return vector.Append(vec, []uint32{v})
+	case uint64:
+		return vector.Append(vec, []uint64{v})
+	case float32:
+		return vector.Append(vec, []float32{v})
+	case float64:
+		return vector.Append(vec, []float64{v})
+	case types.Date:
+		return vector.Append(vec, []types.Date{v})
+	case types.Datetime:
+		return vector.Append(vec, []types.Datetime{v})
+	case []byte:
+		return vector.Append(vec, [][]byte{v})
+	}
+	return ErrType
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package load
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+type appender struct {
+	sync.Mutex
+	bats []*batch.Batch
+}
+
+func (a *appender) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+	a.Lock()
+	defer a.Unlock()
+	a.bats = append(a.bats, bat)
+	return nil
+}
+
+var testTypes = []types.Type{
+	{Oid: types.T_int32, Size: 4},
+	{Oid: types.T_varchar, Size: 24},
+	{Oid: types.T_float64, Size: 8},
+}
+
+func writeFile(t *testing.T, data string) string {
+	name := filepath.Join(t.TempDir(), "data.csv")
+	require.NoError(t, os.WriteFile(name, []byte(data), 0644))
+	return name
+}
+
+func newArgument(file string) *Argument {
+	return &Argument{
+		File:      file,
+		Sep:       ',',
+		Attrs:     []string{"a", "b", "c"},
+		Types:     testTypes,
+		Workers:   4,
+		MaxErrors: -1,
+	}
+}
+
+func TestLoad(t *testing.T) {
+	var buf strings.Builder
+	buf.WriteString("a,b,c\n")
+	n := 200000
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&buf, "%d,name%d,%d.5\n", i, i, i)
+	}
+	arg := newArgument(writeFile(t, buf.String()))
+	arg.IgnoreLines = 1
+	var progress []Progress
+	arg.Progress = func(p Progress) {
+		progress = append(progress, p)
+	}
+	rel := &appender{}
+	r, err := Run(context.Background(), arg, rel, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(n), r.Rows)
+	// The lines are parsed by the workers once
+	sum := int64(0)
+	for _, bat := range rel.bats {
+		for _, v := range bat.Vecs[0].Col.([]int32) {
+			sum += int64(v)
+		}
+	}
+	require.Equal(t, int64(n)*int64(n-1)/2, sum)
+	last := progress[len(progress)-1]
+	require.Equal(t, uint64(n), last.Rows)
+	require.Equal(t, last.Size, last.Bytes)
+}
+
+func TestPolicies(t *testing.T) {
+	file := writeFile(t, "1,a,1.5\nx,b,2.5\n3,\\N,3.5\n4,d\n")
+	_, err := Run(context.Background(), newArgument(file), &appender{}, nil)
+	require.Error(t, err)
+
+	arg := newArgument(file)
+	arg.Policy = SkipRow
+	rel := &appender{}
+	r, err := Run(context.Background(), arg, rel, nil)
+	require.NoError(t, err)
+	require.Equal(t, Result{Rows: 2, Skipped: 2}, *r)
+	require.True(t, nulls.Contains(rel.bats[0].Vecs[1].Nsp, 1))
+
+	arg.Policy = SetNull
+	rel = &appender{}
+	r, err = Run(context.Background(), arg, rel, nil)
+	require.NoError(t, err)
+	require.Equal(t, Result{Rows: 4, Warnings: 2}, *r)
+	require.Equal(t, 4, vector.Length(rel.bats[0].Vecs[0]))
+	require.True(t, nulls.Contains(rel.bats[0].Vecs[0].Nsp, 1))
+	require.True(t, nulls.Contains(rel.bats[0].Vecs[2].Nsp, 3))
+
+	arg.MaxErrors = 1
+	_, err = Run(context.Background(), arg, &appender{}, nil)
+	require.True(t, errors.Is(err, ErrTooManyErrors))
+}
+
+func TestSplitFields(t *testing.T) {
+	fields, ok := splitFields([]byte(`1,"a,""b""",c`), ',', '"', 0)
+	require.True(t, ok)
+	require.Equal(t, [][]byte{[]byte("1"), []byte(`a,"b"`), []byte("c")}, fields)
+	_, ok = splitFields([]byte(`1,"a`), ',', '"', 0)
+	require.False(t, ok)
+
+	// An enclosed field spans lines
+	arg := newArgument(writeFile(t, "1,\"a\nb\",1.5\n2,c,2.5\n"))
+	arg.Quote = '"'
+	rel := &appender{}
+	r, err := Run(context.Background(), arg, rel, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), r.Rows)
+	require.Equal(t, "a\nb", string(rel.bats[0].Vecs[1].Col.(*types.Bytes).Get(0)))
+}
+
+func TestEscapedFields(t *testing.T) {
+	fields, ok := splitFields([]byte(`\N,a\,b\tc,"d\"e""f",g\N`), ',', '"', '\\')
+	require.True(t, ok)
+	require.Equal(t, [][]byte{{}, []byte("a,b\tc"), []byte(`d"e"f`), []byte("gN")}, fields)
+	_, ok = splitFields([]byte(`1,a\`), ',', '"', '\\')
+	require.False(t, ok)
+
+	// An escaped terminator is in the field, and an escaped N is null
+	arg := newArgument(writeFile(t, "1,a\\\nb,1.5\n\\N,c\\,d,2.5\n"))
+	arg.Escape = '\\'
+	rel := &appender{}
+	r, err := Run(context.Background(), arg, rel, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), r.Rows)
+	names := rel.bats[0].Vecs[1].Col.(*types.Bytes)
+	require.Equal(t, "a\nb", string(names.Get(0)))
+	require.Equal(t, "c,d", string(names.Get(1)))
+	require.True(t, nulls.Contains(rel.bats[0].Vecs[0].Nsp, 1))
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package load
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	// MinChunkSize is the bytes of the file parsed by a worker at least
+	MinChunkSize = 1 << 20
+	// DefaultBatchRows is the rows appended at a time by default
+	DefaultBatchRows = 1 << 16
+	// NullField is the field of a null value, the empty fields are null too
+	NullField = `\N`
+)
+
+// ErrorPolicy is what becomes of a row whose fields can not be converted to
+// the types of their columns
+type ErrorPolicy int
+
+const (
+	// Abort stops the load at the first bad row
+	Abort ErrorPolicy = iota
+	// SkipRow drops the bad rows
+	SkipRow
+	// SetNull loads the bad fields as nulls
+	SetNull
+)
+
+var (
+	ErrNoColumn = errors.New("load: no column is loaded")
+	ErrType     = errors.New("load: type of the column is not supported")
+	// ErrTooManyErrors is returned once the bad rows exceed the MaxErrors
+	ErrTooManyErrors = errors.New("load: too many bad rows")
+)
+
+// Appender is the relation the rows are appended to, such as an
+// engine.Relation
+type Appender interface {
+	Write(uint64, *batch.Batch, engine.Snapshot) error
+}
+
+// Argument of the LOAD DATA of a CSV file, whose fields are converted to the
+// types of the columns they are loaded to in order
+type Argument struct {
+	File string
+	// Sep is the separator of the fields, and Term the terminator of the
+	// lines, '\n' if 0
+	Sep  byte
+	Term byte
+	// Quote encloses the fields which may have the separator, the
+	// terminator or the quote doubled, no field is enclosed if 0
+	Quote byte
+	// Escape makes the character following it literal, or one of the
+	// special ones of MySQL such as \n, and a field of Escape and N null. No
+	// character is escaped if 0
+	Escape byte
+	// IgnoreLines is the number of the lines skipped at the beginning
+	IgnoreLines uint64
+	Attrs       []string
+	Types       []types.Type
+	// Workers is the number of the chunks of the file parsed in parallel,
+	// each of MinChunkSize bytes at least. A file of enclosed or escaped
+	// fields is parsed by one worker, as they may span lines
+	Workers int
+	// BatchRows is the number of the rows appended at a time,
+	// DefaultBatchRows if not positive
+	BatchRows int
+	Policy    ErrorPolicy
+	// MaxErrors is the number of the bad rows tolerated by the SkipRow and
+	// SetNull policies, unlimited if negative
+	MaxErrors int64
+	// Ts is the timestamp of the writes
+	Ts uint64
+	// Progress is called after each batch appended if not nil
+	Progress func(Progress)
+}
+
+// Progress of a load
+type Progress struct {
+	// Rows appended, and the bad ones of them or skipped
+	Rows   uint64
+	Errors uint64
+	// Bytes of the file parsed out of Size
+	Bytes uint64
+	Size  uint64
+}
+
+// Result of a load
+type Result struct {
+	Rows uint64
+	// Skipped is the number of the bad rows skipped, and Warnings the ones
+	// loaded with null fields
+	Skipped  uint64
+	Warnings uint64
+}
@@ -0,0 +1,271 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopjoin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	obatch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	process2 "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+var (
+	ErrNotLogical = errors.New("loop join: condition is not logical")
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf(" ⨝(%s) ", ap.Cond))
+}
+
+func Prepare(proc *process2.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	if !ap.Cond.IsLogical() {
+		return ErrNotLogical
+	}
+	ap.ctr = new(Container)
+	ap.ctr.proc = process.New(proc.Mp)
+	ap.ctr.is = make([]int64, 0, UnitLimit)
+	ap.ctr.js = make([]int64, 0, UnitLimit)
+	mp := make(map[string]ResultPos)
+	for rel, attrs := range ap.Attrs {
+		for pos, attr := range attrs {
+			mp[attr] = ResultPos{Rel: int32(rel), Pos: int32(pos)}
+		}
+	}
+	seen := make(map[string]struct{})
+	for _, attr := range ap.Cond.Attributes() {
+		if _, ok := seen[attr]; ok {
+			continue
+		}
+		seen[attr] = struct{}{}
+		rp, ok := mp[attr]
+		if !ok {
+			return fmt.Errorf("loop join: unknown attribute '%s'", attr)
+		}
+		ap.ctr.attrs = append(ap.ctr.attrs, rp)
+	}
+	return nil
+}
+
+func Call(proc *process2.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process2.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(proc, &anal); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat, err := process2.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Input(bat)
+			if bat == nil {
+				ctr.state = End
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+				}
+				ctr.freeRegisters()
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if ctr.bat == nil {
+				batch.Clean(bat, proc.Mp)
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				batch.Clean(ctr.bat, proc.Mp)
+				ctr.freeRegisters()
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Output(proc.Reg.InputBatch)
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(proc *process2.Process, anal *process2.Analyze) error {
+	for {
+		bat, err := process2.Receive(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		anal.Input(bat)
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.New(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			batch.Clean(bat, proc.Mp)
+			batch.Clean(ctr.bat, proc.Mp)
+			return err
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+	return nil
+}
+
+// probe evaluates the condition on the pairs of the rows of bat and the rows
+// of the build side, UnitLimit pairs at a time
+// clean frees the build side once the join is aborted
+func (ctr *Container) clean(proc *process2.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	ctr.freeRegisters()
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process2.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := batch.New(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	count, buildCount := len(bat.Zs), len(ctr.bat.Zs)
+	for i := 0; i < count; i++ {
+		for j := 0; j < buildCount; j++ {
+			ctr.is = append(ctr.is, int64(i))
+			ctr.js = append(ctr.js, int64(j))
+			if len(ctr.is) < UnitLimit && (i < count-1 || j < buildCount-1) {
+				continue
+			}
+			if err := process2.Canceled(proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+			if err := ctr.eval(bat, rbat, ap, proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// eval appends the candidate pairs satisfying the condition to rbat
+func (ctr *Container) eval(bat, rbat *batch.Batch, ap *Argument, proc *process2.Process) error {
+	defer func() {
+		ctr.is, ctr.js = ctr.is[:0], ctr.js[:0]
+	}()
+	cbat := &obatch.Batch{
+		Attrs: make([]string, len(ctr.attrs)),
+		Vecs:  make([]*vector.Vector, len(ctr.attrs)),
+	}
+	defer func() {
+		for _, vec := range cbat.Vecs {
+			if vec != nil {
+				vector.Clean(vec, proc.Mp)
+			}
+		}
+	}()
+	for i, rp := range ctr.attrs {
+		src, sels := bat.Vecs[rp.Pos], ctr.is
+		if rp.Rel == 1 {
+			src, sels = ctr.bat.Vecs[rp.Pos], ctr.js
+		}
+		vec := vector.New(src.Typ)
+		if err := union(vec, src, sels, proc.Mp); err != nil {
+			return err
+		}
+		// keeps the vector from being reused or freed by the evaluator
+		vec.Ref = 2
+		cbat.Attrs[i] = ap.Attrs[rp.Rel][rp.Pos]
+		cbat.Vecs[i] = vec
+	}
+	cbat.Zs = make([]int64, len(ctr.is))
+	vec, _, err := ap.Cond.Eval(cbat, ctr.proc)
+	if err != nil {
+		return err
+	}
+	defer process.Put(ctr.proc, vec)
+	sels := vec.Col.([]int64)
+	if len(sels) == 0 {
+		return nil
+	}
+	is, js := make([]int64, len(sels)), make([]int64, len(sels))
+	for k, sel := range sels {
+		is[k], js[k] = ctr.is[sel], ctr.js[sel]
+	}
+	for k, rp := range ap.Result {
+		if rp.Rel == 0 {
+			err = union(rbat.Vecs[k], bat.Vecs[rp.Pos], is, proc.Mp)
+		} else {
+			err = union(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], js, proc.Mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, j := range js {
+		rbat.Zs = append(rbat.Zs, ctr.bat.Zs[j])
+	}
+	return nil
+}
+
+// freeRegisters frees the vectors kept by the evaluator
+func (ctr *Container) freeRegisters() {
+	for _, vec := range ctr.proc.Reg.Vecs {
+		vector.Clean(vec, ctr.proc.Mp)
+	}
+	ctr.proc.Reg.Vecs = ctr.proc.Reg.Vecs[:0]
+}
+
+func union(v, w *vector.Vector, sels []int64, m *mheap.Mheap) error {
+	for _, sel := range sels {
+		if err := vector.UnionOne(v, w, sel, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopjoin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+type joinTestCase struct {
+	arg    *Argument
+	flgs   []bool // flgs[i] == true: nullable
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+func newTestCase(flgs []bool) joinTestCase {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 10),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	return joinTestCase{
+		flgs:   flgs,
+		proc:   proc,
+		cancel: cancel,
+		arg: &Argument{
+			Result: []ResultPos{{0, 0}, {1, 0}},
+			// a < b
+			Cond: &extend.BinaryExtend{
+				Op:    overload.LT,
+				Left:  &extend.Attribute{Name: "a", Type: types.T_int8},
+				Right: &extend.Attribute{Name: "b", Type: types.T_int8},
+			},
+			Attrs: [2][]string{{"a"}, {"b"}},
+		},
+	}
+}
+
+func TestString(t *testing.T) {
+	String(newTestCase([]bool{false}).arg, new(bytes.Buffer))
+}
+
+func TestJoin(t *testing.T) {
+	// the pairs of 0 <= a < b < Rows, without the null a or b of row 0
+	for i, expected := range []int{Rows * (Rows - 1) / 2, (Rows - 1) * (Rows - 2) / 2} {
+		tc := newTestCase([]bool{i == 1})
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[1].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		rows := 0
+		for {
+			if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+				require.NoError(t, err)
+				break
+			}
+			bat := tc.proc.Reg.InputBatch
+			as, bs := bat.Vecs[0].Col.([]int8), bat.Vecs[1].Col.([]int8)
+			for k := range bat.Zs {
+				require.Less(t, as[k], bs[k])
+			}
+			rows += len(bat.Zs)
+			batch.Clean(bat, tc.proc.Mp)
+		}
+		require.Equal(t, 2*expected, rows)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func TestNotLogical(t *testing.T) {
+	tc := newTestCase([]bool{false})
+	tc.arg.Cond = &extend.Attribute{Name: "a", Type: types.T_int8}
+	require.Equal(t, ErrNotLogical, Prepare(tc.proc, tc.arg))
+}
+
+// create a new block of an int8 column of 0, 1, ..., flgs[0] == true: row 0 is null
+func newBatch(t *testing.T, flgs []bool, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.New(1)
+	bat.Cnt = 1
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int8})
+	data, err := mheap.Alloc(proc.Mp, rows*1)
+	require.NoError(t, err)
+	vec.Data = data
+	vs := encoding.DecodeInt8Slice(vec.Data)[:rows]
+	for i := range vs {
+		vs[i] = int8(i)
+	}
+	if flgs[0] {
+		nulls.Add(vec.Nsp, uint64(0))
+	}
+	vec.Col = vs
+	bat.Vecs[0] = vec
+	return bat
+}
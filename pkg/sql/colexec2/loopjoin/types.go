@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopjoin
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	// UnitLimit is the max number of the candidate pairs evaluated at once
+	UnitLimit = 8192
+)
+
+type Container struct {
+	state int
+
+	// attrs are the columns of the candidate pairs the condition refers to
+	attrs []ResultPos
+	// is and js are the rows of the probe side and the build side of the
+	// candidate pairs
+	is, js []int64
+	// proc evaluates the condition
+	proc *process.Process
+
+	bat *batch.Batch
+}
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+// Argument of the block nested-loop join. Cond is a logical expression on
+// the columns of the probe side named by Attrs[0] and the columns of the
+// build side named by Attrs[1]. The names of both sides are distinct
+type Argument struct {
+	ctr    *Container
+	Result []ResultPos
+	Cond   extend.Extend
+	Attrs  [2][]string
+	Idx    int // index of the AnalyzeInfo of the operator
+}
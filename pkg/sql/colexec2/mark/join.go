@@ -0,0 +1,196 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"bytes"
+	"unsafe"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" mark join ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.enc = hashkey.NewEncoder(UnitLimit, false)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	for i, cond := range ap.Conditions[0] { // aligning the precision of decimal
+		switch cond.Typ.Oid {
+		case types.T_decimal64, types.T_decimal128:
+			typ := ap.Conditions[1][i].Typ
+			if typ.Scale > cond.Typ.Scale {
+				ap.Conditions[0][i].Scale = typ.Scale - cond.Typ.Scale
+			} else if typ.Scale < cond.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Typ.Scale - typ.Scale
+			}
+		}
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc, &anal); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				ctr.state = End
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Input(bat)
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Output(proc.Reg.InputBatch)
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// build hashes the keys of the build side. Only the keys are kept
+func (ctr *Container) build(ap *Argument, proc *process.Process, anal *process.Analyze) error {
+	ctr.empty = true
+	for {
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		anal.Input(bat)
+		if bat == nil {
+			return nil
+		}
+		count := len(bat.Zs)
+		if count > 0 {
+			ctr.empty = false
+		}
+		for i := 0; i < count; i += UnitLimit {
+			n := count - i
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			ctr.fillKeys(bat, ap.Conditions[1], i, n)
+			for _, z := range ctr.zValues[:n] {
+				if z == 0 {
+					ctr.hasNull = true
+				}
+			}
+			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := batch.New(len(ap.Result) + 1)
+	for i, pos := range ap.Result {
+		rbat.Vecs[i] = vector.New(bat.Vecs[pos].Typ)
+	}
+	count := len(bat.Zs)
+	data, err := mheap.Alloc(proc.Mp, int64(count))
+	if err != nil {
+		batch.Clean(rbat, proc.Mp)
+		return err
+	}
+	marks := unsafe.Slice((*bool)(unsafe.Pointer(&data[0])), count)
+	// vector.New has no bool vectors
+	mark := &vector.Vector{
+		Typ:  types.Type{Oid: types.T_bool, Size: 1},
+		Col:  marks,
+		Data: data,
+		Nsp:  &nulls.Nulls{},
+	}
+	rbat.Vecs[len(ap.Result)] = mark
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat, ap.Conditions[0], i, n)
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		for k := 0; k < n; k++ {
+			marks[i+k] = ctr.zValues[k] != 0 && ctr.values[k] != 0
+			if !marks[i+k] && !ctr.empty && (ctr.zValues[k] == 0 || ctr.hasNull) {
+				nulls.Add(mark.Nsp, uint64(i+k))
+			}
+			for j, pos := range ap.Result {
+				if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[pos], int64(i+k), proc.Mp); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return err
+				}
+			}
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// fillKeys fills the keys of rows [i, i+n) of bat to ctr.enc.Keys. The
+// zValues of the rows with a null key are 0
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, i, n int) {
+	copy(ctr.zValues[:n], OneInt64s[:n])
+	ctr.enc.Reset()
+	for _, cond := range conds {
+		ctr.enc.Add(bat.Vecs[cond.Pos], cond.Scale)
+	}
+	ctr.enc.Encode(i, n, ctr.zValues)
+}
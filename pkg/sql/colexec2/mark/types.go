@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+var OneInt64s []int64
+
+type Container struct {
+	state         int
+	enc           *hashkey.Encoder
+	values        []uint64
+	zValues       []int64
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	// empty is true if the build side has no rows
+	empty bool
+	// hasNull is true if any row of the build side has a null key
+	hasNull bool
+}
+
+type Condition struct {
+	Pos   int32
+	Scale int32
+	Typ   types.Type
+}
+
+// Argument of the mark join. The result is the columns of the probe side at
+// Result followed by the mark column of type bool: true if any row of the
+// build side is joined, null if none is joined but the probe key or any
+// key of the build side is null, and false otherwise
+type Argument struct {
+	ctr        *Container
+	Result     []int32
+	Conditions [][]Condition
+	Idx        int // index of the AnalyzeInfo of the operator
+}
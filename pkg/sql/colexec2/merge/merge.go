@@ -32,12 +32,18 @@ func Prepare(_ *process.Process, arg interface{}) error {
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		if len(proc.Reg.MergeReceivers) == 0 {
 			return true, nil
 		}
-		reg := proc.Reg.MergeReceivers[n.ctr.i]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[n.ctr.i])
+		if err != nil {
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
 		if bat == nil {
 			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:n.ctr.i], proc.Reg.MergeReceivers[n.ctr.i+1:]...)
 			if n.ctr.i >= len(proc.Reg.MergeReceivers) {
@@ -48,6 +54,8 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		if len(bat.Zs) == 0 {
 			continue
 		}
+		anal.Input(bat)
+		anal.Output(bat)
 		proc.Reg.InputBatch = bat
 		if n.ctr.i = n.ctr.i + 1; n.ctr.i >= len(proc.Reg.MergeReceivers) {
 			n.ctr.i = 0
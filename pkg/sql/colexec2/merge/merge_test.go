@@ -100,6 +100,21 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestMergeCancel(t *testing.T) {
+	tc := newTestCase(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.types, tc.proc, Rows)
+	end, err := Call(tc.proc, tc.arg)
+	require.False(t, end)
+	require.NoError(t, err)
+	batch.Clean(tc.proc.Reg.InputBatch, tc.proc.Mp)
+	tc.proc.Cancel()
+	end, err = Call(tc.proc, tc.arg)
+	require.True(t, end)
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func newTestCase(m *mheap.Mheap) mergeTestCase {
 	proc := process.New(m)
 	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
@@ -20,4 +20,5 @@ type Container struct {
 
 type Argument struct {
 	ctr *Container
+	Idx int // index of the AnalyzeInfo of the operator
 }
@@ -19,6 +19,7 @@ import (
 	"unsafe"
 
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -40,11 +41,18 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	ap := arg.(*Argument)
 	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		switch ctr.state {
 		case Build:
-			if err := ctr.build(proc); err != nil {
+			if err := ctr.build(proc, &anal); err != nil {
 				ctr.state = End
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+				}
 				return true, err
 			}
 			ctr.state = Eval
@@ -56,6 +64,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				}
 				ctr.bat.Rs = nil
 			}
+			anal.Output(ctr.bat)
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
 			return true, nil
@@ -66,22 +75,29 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
-func (ctr *Container) build(proc *process.Process) error {
+func (ctr *Container) build(proc *process.Process, anal *process.Analyze) error {
 	if len(proc.Reg.MergeReceivers) == 1 {
 		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				return nil
 			}
 			if len(bat.Zs) == 0 {
 				continue
 			}
+			anal.Input(bat)
 			ctr.bat = bat
 			return nil
 		}
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
 			continue
 		}
@@ -89,6 +105,7 @@ func (ctr *Container) build(proc *process.Process) error {
 			i--
 			continue
 		}
+		anal.Input(bat)
 		if err := ctr.process(bat, proc); err != nil {
 			return err
 		}
@@ -114,11 +131,8 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 			case types.T_decimal128:
 				size += 16 + 1
 			case types.T_char, types.T_varchar:
-				if width := vec.Typ.Width; width > 0 {
-					size += int(width) + 1
-				} else {
-					size = 128
-				}
+				// the strings are length prefixed in the keys of HStr
+				size = 128
 			}
 		}
 		ctr.keyOffs = make([]uint32, UnitLimit)
@@ -155,7 +169,7 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 			ctr.strHashMap.Init()
 		default:
 			ctr.typ = HStr
-			ctr.hstr.keys = make([][]byte, UnitLimit)
+			ctr.hstr.enc = hashkey.NewEncoder(UnitLimit, true)
 			ctr.strHashMap.Init()
 		}
 	}
@@ -223,8 +237,6 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 				fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h8.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h8.keys, n, 8, i)
 			}
 		}
 		ctr.hashes[0] = 0
@@ -269,8 +281,6 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 				fillGroup[types.Decimal64](ctr, vec, ctr.h24.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h24.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h24.keys, n, 24, i)
 			}
 		}
 		ctr.strHashMap.InsertString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
@@ -314,8 +324,6 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 				fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h32.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h32.keys, n, 32, i)
 			}
 		}
 		ctr.strHashMap.InsertString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
@@ -359,8 +367,6 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 				fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h40.keys, n, 16, i)
-			default:
-				fillStringGroup(ctr, vec, ctr.h40.keys, n, 40, i)
 			}
 		}
 		ctr.strHashMap.InsertString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
@@ -388,49 +394,12 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 		if n > UnitLimit {
 			n = UnitLimit
 		}
+		ctr.hstr.enc.Reset()
 		for _, vec := range bat.Vecs {
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -16:
-				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
-						} else {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-
-			}
-		}
-		for k := 0; k < n; k++ {
-			if l := len(ctr.hstr.keys[k]); l < 16 {
-				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
-			}
-		}
-		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
-		for k := 0; k < n; k++ {
-			ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
+			ctr.hstr.enc.Add(vec, 0)
 		}
+		ctr.hstr.enc.Encode(i, n, nil)
+		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.enc.Keys[:n], ctr.values)
 		if !flg {
 			if err := ctr.batchFill(i, n, bat, proc); err != nil {
 				return err
@@ -496,48 +465,3 @@ func fillGroup[T1, T2 any](ctr *Container, vec *vector.Vector, keys []T2, n int,
 		}
 	}
 }
-
-func fillStringGroup[T any](ctr *Container, vec *vector.Vector, keys []T, n int, sz uint32, start int) {
-	vs := vec.Col.(*types.Bytes)
-	vData := vs.Data
-	vOff := vs.Offsets
-	vLen := vs.Lengths
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			*(*int8)(unsafe.Add(unsafe.Pointer(&keys[i]), ctr.keyOffs[i])) = 0
-			copy(unsafe.Slice((*byte)(unsafe.Pointer(&keys[i])), sz)[ctr.keyOffs[i]+1:], vData[vOff[i+start]:vOff[i+start]+vLen[i+start]])
-			ctr.keyOffs[i] += vLen[i+start] + 1
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				*(*int8)(unsafe.Add(unsafe.Pointer(&keys[i]), ctr.keyOffs[i])) = 1
-				ctr.keyOffs[i]++
-			} else {
-				*(*int8)(unsafe.Add(unsafe.Pointer(&keys[i]), ctr.keyOffs[i])) = 0
-				copy(unsafe.Slice((*byte)(unsafe.Pointer(&keys[i])), sz)[ctr.keyOffs[i]+1:], vData[vOff[i+start]:vOff[i+start]+vLen[i+start]])
-				ctr.keyOffs[i] += vLen[i+start] + 1
-			}
-		}
-	}
-}
-
-func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
-	vs := vector.DecodeFixedCol[T](vec, sz)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
-	if !nulls.Any(vec.Nsp) {
-		for i := 0; i < n; i++ {
-			ctr.hstr.keys[i] = append(ctr.hstr.keys[i], byte(0))
-			ctr.hstr.keys[i] = append(ctr.hstr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
-		}
-	} else {
-		for i := 0; i < n; i++ {
-			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.hstr.keys[i] = append(ctr.hstr.keys[i], byte(1))
-			} else {
-				ctr.hstr.keys[i] = append(ctr.hstr.keys[i], byte(0))
-				ctr.hstr.keys[i] = append(ctr.hstr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
-			}
-		}
-	}
-}
@@ -139,6 +139,36 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestStrKeys(t *testing.T) {
+	// the groups whose concatenations or zero paddings collide are apart
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), nil, false, nil)
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newStrBatch(t, tc.proc, []string{"ab", "a", "a\x00"}, []string{"c", "bc", ""})
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newStrBatch(t, tc.proc, []string{"a", "a"}, []string{"bc", ""})
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	groups := make(map[string]int64)
+	for {
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		bat := tc.proc.Reg.InputBatch
+		if bat != nil {
+			vs0, vs1 := bat.Vecs[0].Col.(*types.Bytes), bat.Vecs[1].Col.(*types.Bytes)
+			for i, z := range bat.Zs {
+				groups[string(vs0.Get(int64(i)))+"|"+string(vs1.Get(int64(i)))] += z
+			}
+			batch.Clean(bat, tc.proc.Mp)
+		}
+		if ok {
+			break
+		}
+	}
+	require.Equal(t, map[string]int64{"ab|c": 1, "a|bc": 2, "a\x00|": 1, "a|": 1}, groups)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -197,6 +227,33 @@ func newTestCase(m *mheap.Mheap, flgs []bool, needEval bool, ts []types.Type) gr
 	}
 }
 
+// newStrBatch returns a batch of the varchar columns of cols
+func newStrBatch(t *testing.T, proc *process.Process, cols ...[]string) *batch.Batch {
+	bat := batch.New(len(cols))
+	bat.InitZsOne(len(cols[0]))
+	for i, vs := range cols {
+		size := 0
+		for _, v := range vs {
+			size += len(v)
+		}
+		data, err := mheap.Alloc(proc.Mp, int64(size))
+		require.NoError(t, err)
+		data = data[:0]
+		col := new(types.Bytes)
+		for _, v := range vs {
+			col.Offsets = append(col.Offsets, uint32(len(data)))
+			col.Lengths = append(col.Lengths, uint32(len(v)))
+			data = append(data, v...)
+		}
+		col.Data = data
+		vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24, Width: 2})
+		vec.Col = col
+		vec.Data = data
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
+
 // create a new block based on the type information, flgs[i] == ture: has null
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	bat := batch.New(len(ts))
@@ -16,6 +16,7 @@ package mergegroup
 
 import (
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 )
 
@@ -69,7 +70,7 @@ type Container struct {
 		zKeys [][5]uint64
 	}
 	hstr struct {
-		keys [][]byte
+		enc *hashkey.Encoder
 	}
 	bat *batch.Batch
 }
@@ -77,4 +78,5 @@ type Container struct {
 type Argument struct {
 	NeedEval bool // need to projection the aggregate column
 	ctr      *Container
+	Idx      int // index of the AnalyzeInfo of the operator
 }
@@ -35,9 +35,15 @@ func Prepare(_ *process.Process, arg interface{}) error {
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
 
 		// deal special case for bat
 		{
@@ -53,6 +59,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				continue
 			}
 		}
+		anal.Input(bat)
 
 		if n.ctr.seen >= n.Limit {
 			proc.Reg.InputBatch = nil
@@ -62,12 +69,14 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		newSeen := n.ctr.seen + uint64(len(bat.Zs))
 		if newSeen < n.Limit {
 			n.ctr.seen = newSeen
+			anal.Output(bat)
 			proc.Reg.InputBatch = bat
 			return false, nil
 		} else {
 			num := int(newSeen - n.Limit)
 			batch.SetLength(bat, len(bat.Zs)-num)
 			n.ctr.seen = newSeen
+			anal.Output(bat)
 			proc.Reg.InputBatch = bat
 			return false, nil
 		}
@@ -23,4 +23,5 @@ type Argument struct {
 	Limit uint64
 	// ctr stores the attributes needn't do Serialization work
 	ctr container
+	Idx int // index of the AnalyzeInfo of the operator
 }
@@ -35,9 +35,15 @@ func Prepare(_ *process.Process, arg interface{}) error {
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
 		// deal special case for bat
 		{
 			// 1. the last batch at this receiver
@@ -52,6 +58,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				continue
 			}
 		}
+		anal.Input(bat)
 
 		if n.ctr.seen > n.Offset {
 			batch.Clean(bat, proc.Mp)
@@ -63,6 +70,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			sels := newSels(int64(n.Offset-n.ctr.seen), int64(length)-int64(n.Offset-n.ctr.seen))
 			n.ctr.seen += uint64(length)
 			batch.Shrink(bat, sels)
+			anal.Output(bat)
 			proc.Reg.InputBatch = bat
 			return false, nil
 		}
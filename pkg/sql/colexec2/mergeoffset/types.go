@@ -23,4 +23,5 @@ type Argument struct {
 	Offset uint64
 	// ctr contains the attributes needn't do serialization work
 	ctr container
+	Idx int // index of the AnalyzeInfo of the operator
 }
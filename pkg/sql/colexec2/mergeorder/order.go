@@ -52,15 +52,44 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	ctr := n.ctr
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		switch ctr.state {
 		case Build:
-			if err := ctr.build(n, proc); err != nil {
+			if err := ctr.build(n, proc, &anal); err != nil {
 				ctr.state = End
+				ctr.cleanRuns(proc)
 				return true, err
 			}
 			ctr.state = Eval
+			if ctr.runs != nil {
+				if err := ctr.prepareMerge(proc); err != nil {
+					ctr.state = End
+					ctr.cleanRuns(proc)
+					return true, err
+				}
+				anal.Spill(ctr.runs.Size())
+				ctr.state = Merge
+			}
+		case Merge:
+			bat, err := ctr.runs.Merge(proc)
+			if err != nil {
+				ctr.state = End
+				ctr.cleanRuns(proc)
+				return true, err
+			}
+			if bat == nil {
+				ctr.state = End
+				ctr.cleanRuns(proc)
+				continue
+			}
+			anal.Output(bat)
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case Eval:
+			anal.Output(ctr.bat)
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
 			ctr.state = End
@@ -73,14 +102,16 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 }
 
-func (ctr *Container) build(n *Argument, proc *process.Process) error {
+func (ctr *Container) build(n *Argument, proc *process.Process, anal *process.Analyze) error {
 	for {
 		if len(proc.Reg.MergeReceivers) == 0 {
 			break
 		}
 		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-			reg := proc.Reg.MergeReceivers[i]
-			bat := <-reg.Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 				i--
@@ -90,14 +121,17 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				i--
 				continue
 			}
+			anal.Input(bat)
 			if ctr.bat == nil {
 				batch.Reorder(bat, ctr.poses)
 				ctr.bat = bat
-				for i, f := range n.Fs {
-					ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, f.Type == order.Descending)
-				}
-				for i := len(n.Fs); i < len(bat.Vecs); i++ {
-					ctr.cmps = append(ctr.cmps, compare.New(bat.Vecs[i].Typ.Oid, true))
+				if ctr.runs == nil { // not set up by the batches spilled
+					for i, f := range n.Fs {
+						ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, f.Type == order.Descending)
+					}
+					for i := len(n.Fs); i < len(bat.Vecs); i++ {
+						ctr.cmps = append(ctr.cmps, compare.New(bat.Vecs[i].Typ.Oid, true))
+					}
 				}
 			} else {
 				batch.Reorder(bat, ctr.poses)
@@ -108,6 +142,11 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				}
 				batch.Clean(bat, proc.Mp)
 			}
+			if exceeded(proc) {
+				if err := ctr.spillRun(proc); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
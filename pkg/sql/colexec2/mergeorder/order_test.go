@@ -17,6 +17,7 @@ package mergeorder
 import (
 	"bytes"
 	"context"
+	"os"
 	"testing"
 
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
@@ -28,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 	"github.com/stretchr/testify/require"
 )
 
@@ -103,6 +105,47 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderWithSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{true, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}},
+		[]order.Field{{Pos: 0, Type: order.Descending}, {Pos: 1, Type: order.Ascending}})
+	dir := t.TempDir()
+	tc.proc.Spill = spill.New(dir)
+	tc.proc.Lim.Size = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.ds, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.ds, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.ds, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	var as []int8
+	var bs []int64
+	for {
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		require.NotNil(t, tc.arg.ctr.runs)
+		bat := tc.proc.Reg.InputBatch
+		as = append(as, bat.Vecs[0].Col.([]int8)...)
+		bs = append(bs, bat.Vecs[1].Col.([]int64)...)
+		batch.Clean(bat, tc.proc.Mp)
+	}
+	require.Equal(t, 3*Rows, len(as))
+	for i := 1; i < len(as); i++ {
+		require.GreaterOrEqual(t, as[i-1], as[i])
+		if as[i-1] == as[i] {
+			require.LessOrEqual(t, bs[i-1], bs[i])
+		}
+	}
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
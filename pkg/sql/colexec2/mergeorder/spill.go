@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergeorder
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// exceeded returns true if the memory in use exceeds the limit and the
+// data can be spilled
+func exceeded(proc *process.Process) bool {
+	return proc.Spill != nil && proc.Lim.Size > 0 && mheap.InUse(proc.Mp) > proc.Lim.Size
+}
+
+// spillRun spills ctr.bat as a sorted run, the columns are reordered with
+// the ones sorted first
+func (ctr *Container) spillRun(proc *process.Process) error {
+	defer func() {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}()
+	if ctr.runs == nil {
+		poses := make([]int32, len(ctr.cmps))
+		for i := range poses {
+			poses[i] = int32(i)
+		}
+		ctr.runs = order.NewRuns(poses, ctr.cmps)
+	}
+	return ctr.runs.Spill(ctr.bat, proc)
+}
+
+// prepareMerge spills the rest as the last run and reads the first batch
+// of each run
+func (ctr *Container) prepareMerge(proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.spillRun(proc); err != nil {
+			return err
+		}
+	}
+	return ctr.runs.Rewind(proc)
+}
+
+// cleanRuns removes the runs
+func (ctr *Container) cleanRuns(proc *process.Process) {
+	if ctr.runs != nil {
+		ctr.runs.Clean(proc)
+		ctr.runs = nil
+	}
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+}
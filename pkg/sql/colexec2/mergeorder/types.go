@@ -23,6 +23,7 @@ import (
 const (
 	Build = iota
 	Eval
+	Merge
 	End
 )
 
@@ -32,9 +33,14 @@ type Container struct {
 	cmps  []compare.Compare // compare structures used to do sort work for attrs
 
 	bat *batch.Batch // bat store the result of merge-order
+
+	// runs are the sorted runs spilled once the memory limit is exceeded,
+	// merged by tree in the end
+	runs *order.Runs
 }
 
 type Argument struct {
 	Fs  []order.Field // Fields store the order information
 	ctr *Container    // ctr stores the attributes needn't do Serialization work
+	Idx int           // index of the AnalyzeInfo of the operator
 }
@@ -0,0 +1,196 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"bytes"
+
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("merge τ([")
+	for i, f := range n.Fs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.poses = make([]int32, len(n.Fs))
+	for i, f := range n.Fs {
+		n.ctr.poses[i] = f.Pos
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(n, proc, &anal); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			ctr.state = Eval
+		case Eval:
+			bat, err := ctr.merge(proc, &anal)
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			anal.Output(bat)
+			proc.Reg.InputBatch = bat
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// build reads the first batch of each receiver
+func (ctr *Container) build(n *Argument, proc *process.Process, anal *process.Analyze) error {
+	ctr.inputs = make([]*input, len(proc.Reg.MergeReceivers))
+	for i := range ctr.inputs {
+		ctr.inputs[i] = new(input)
+		if err := ctr.next(i, proc, anal); err != nil {
+			return err
+		}
+		if bat := ctr.inputs[i].bat; bat != nil && ctr.cmps == nil {
+			ctr.cmps = make([]compare.Compare, len(n.Fs))
+			for k, f := range n.Fs {
+				ctr.cmps[k] = compare.New(bat.Vecs[f.Pos].Typ.Oid, f.Type == order.Descending)
+			}
+		}
+	}
+	ctr.tree = order.NewLoserTree(len(ctr.inputs), ctr.less)
+	return nil
+}
+
+// merge returns the next batch merged from the inputs, nil once all are
+// merged
+func (ctr *Container) merge(proc *process.Process, anal *process.Analyze) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	if len(ctr.inputs) == 0 {
+		return nil, nil
+	}
+	for n := process.BatchRows(proc, BatchRows); rbat == nil || len(rbat.Zs) < n; {
+		i := ctr.tree.Winner()
+		in := ctr.inputs[i]
+		if in.bat == nil {
+			break
+		}
+		if rbat == nil {
+			rbat = batch.New(len(in.bat.Vecs))
+			for k, vec := range in.bat.Vecs {
+				rbat.Vecs[k] = vector.New(vec.Typ)
+			}
+		}
+		for k, vec := range in.bat.Vecs {
+			if err := vector.UnionOne(rbat.Vecs[k], vec, in.row, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, in.bat.Zs[in.row])
+		if in.row++; in.row == int64(len(in.bat.Zs)) {
+			if err := ctr.next(i, proc, anal); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		ctr.tree.Adjust(i)
+	}
+	return rbat, nil
+}
+
+// next reads the next non-empty batch of the i-th receiver, the input
+// has no batch once the receiver is closed
+func (ctr *Container) next(i int, proc *process.Process, anal *process.Analyze) error {
+	in := ctr.inputs[i]
+	if in.bat != nil {
+		batch.Clean(in.bat, proc.Mp)
+		in.bat = nil
+	}
+	in.row = 0
+	for {
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		anal.Input(bat)
+		in.bat = bat
+		return nil
+	}
+}
+
+// less returns true if the head of input i is before the one of input j,
+// the inputs merged are after all
+func (ctr *Container) less(i, j int) bool {
+	ii, ij := ctr.inputs[i], ctr.inputs[j]
+	if ii.bat == nil || ij.bat == nil {
+		return ij.bat == nil && ii.bat != nil
+	}
+	for k, cmp := range ctr.cmps {
+		pos := ctr.poses[k]
+		cmp.Set(0, ii.bat.Vecs[pos])
+		cmp.Set(1, ij.bat.Vecs[pos])
+		if r := cmp.Compare(0, 1, ii.row, ij.row); r != 0 {
+			return r < 0
+		}
+	}
+	return i < j
+}
+
+// clean frees the batches being merged once the merge is aborted
+func (ctr *Container) clean(proc *process.Process) {
+	for _, in := range ctr.inputs {
+		if in != nil && in.bat != nil {
+			batch.Clean(in.bat, proc.Mp)
+			in.bat = nil
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type mergeSortTestCase struct {
+	desc   bool
+	arg    *Argument
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+var (
+	tcs []mergeSortTestCase
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tcs = []mergeSortTestCase{
+		newTestCase(mheap.New(gm), false),
+		newTestCase(mheap.New(gm), true),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		Prepare(tc.proc, tc.arg)
+	}
+}
+
+func TestMergeSort(t *testing.T) {
+	for _, tc := range tcs {
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		first, second := []int64{0, 3, 6}, []int64{9, 12}
+		if tc.desc {
+			first, second = second, first
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.desc, tc.proc, first)
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.desc, tc.proc, second)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.desc, tc.proc, []int64{1, 4, 7, 10, 13})
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		tc.proc.Reg.MergeReceivers[2].Ch <- nil
+		var vs []int64
+		for {
+			ok, err := Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			if ok {
+				break
+			}
+			bat := tc.proc.Reg.InputBatch
+			vs = append(vs, bat.Vecs[0].Col.([]int64)...)
+			batch.Clean(bat, tc.proc.Mp)
+		}
+		expected := []int64{0, 1, 3, 4, 6, 7, 9, 10, 12, 13}
+		if tc.desc {
+			for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+				expected[i], expected[j] = expected[j], expected[i]
+			}
+		}
+		require.Equal(t, expected, vs)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func TestMergeSortCancel(t *testing.T) {
+	tc := newTestCase(mheap.New(guest.New(1<<30, host.New(1<<30))), false)
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.desc, tc.proc, []int64{0, 3, 6})
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.desc, tc.proc, []int64{1, 4})
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	tc.proc.Reg.MergeReceivers[2].Ch <- nil
+	tc.proc.Cancel()
+	for {
+		ok, err := Call(tc.proc, tc.arg)
+		if ok {
+			require.Equal(t, context.Canceled, err)
+			break
+		}
+		batch.Clean(tc.proc.Reg.InputBatch, tc.proc.Mp)
+	}
+	for i := 0; i < len(tc.proc.Reg.MergeReceivers); i++ { // simulating the end of a pipeline
+		for len(tc.proc.Reg.MergeReceivers[i].Ch) > 0 {
+			bat := <-tc.proc.Reg.MergeReceivers[i].Ch
+			if bat != nil {
+				batch.Clean(bat, tc.proc.Mp)
+			}
+		}
+	}
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
+func newTestCase(m *mheap.Mheap, desc bool) mergeSortTestCase {
+	proc := process.New(m)
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	for i := range proc.Reg.MergeReceivers {
+		proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 4),
+		}
+	}
+	typ := order.Ascending
+	if desc {
+		typ = order.Descending
+	}
+	return mergeSortTestCase{
+		desc: desc,
+		proc: proc,
+		arg: &Argument{
+			Fs: []order.Field{{Pos: 0, Type: typ}},
+		},
+		cancel: cancel,
+	}
+}
+
+// create a new batch of an int64 column of vs, in descending order if desc
+func newBatch(t *testing.T, desc bool, proc *process.Process, vs []int64) *batch.Batch {
+	rows := int64(len(vs))
+	bat := batch.New(1)
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int64})
+	data, err := mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	vec.Data = data
+	col := encoding.DecodeInt64Slice(vec.Data)[:rows]
+	for i, v := range vs {
+		if desc {
+			v = vs[len(vs)-1-i]
+		}
+		col[i] = v
+	}
+	vec.Col = col
+	bat.Vecs[0] = vec
+	return bat
+}
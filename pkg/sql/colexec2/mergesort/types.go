@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+)
+
+const (
+	Build = iota
+	Eval
+	End
+)
+
+const (
+	// BatchRows is the max rows of the batches merged, if the settings of
+	// the process set no batch size
+	BatchRows = 8192
+)
+
+type Container struct {
+	state int
+	poses []int32           // sorted list of attributes
+	cmps  []compare.Compare // compare structures used to merge the attrs
+
+	// inputs are the heads of the receivers, merged by tree
+	inputs []*input
+	tree   *order.LoserTree
+}
+
+// input is the batch being merged of a receiver
+type input struct {
+	bat *batch.Batch // nil if all the batches of the receiver are merged
+	row int64
+}
+
+// Argument merges the batches sorted by Fs of the receivers into one
+// sorted stream, the order is kept without sorting them again
+type Argument struct {
+	Fs  []order.Field // Fields store the order information
+	ctr *Container
+	Idx int // index of the AnalyzeInfo of the operator
+}
@@ -56,17 +56,28 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	ctr := n.ctr
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		switch ctr.state {
 		case Build:
-			if err := ctr.build(n, proc); err != nil {
+			if err := ctr.build(n, proc, &anal); err != nil {
 				ctr.state = End
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+				}
 				return true, err
 			}
 			ctr.state = Eval
 		case Eval:
 			ctr.state = End
-			return true, ctr.eval(n.Limit, proc)
+			if err := ctr.eval(n.Limit, proc); err != nil {
+				return true, err
+			}
+			anal.Output(proc.Reg.InputBatch)
+			return true, nil
 		default:
 			proc.Reg.InputBatch = nil
 			return true, nil
@@ -74,14 +85,16 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
-func (ctr *Container) build(n *Argument, proc *process.Process) error {
+func (ctr *Container) build(n *Argument, proc *process.Process, anal *process.Analyze) error {
 	for {
 		if len(proc.Reg.MergeReceivers) == 0 {
 			break
 		}
 		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-			reg := proc.Reg.MergeReceivers[i]
-			bat := <-reg.Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+			if err != nil {
+				return err
+			}
 			if bat == nil {
 				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 				i--
@@ -91,6 +104,7 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				i--
 				continue
 			}
+			anal.Input(bat)
 			if ctr.bat == nil {
 				batch.Reorder(bat, ctr.poses)
 				ctr.bat = batch.New(len(bat.Vecs))
@@ -40,6 +40,7 @@ type Argument struct {
 	Fs    []top.Field // Fs store the order information
 	Limit int64       // Limit store the number of mergeTop-operator
 	ctr   *Container  // ctr stores the attributes needn't do Serialization work
+	Idx   int         // index of the AnalyzeInfo of the operator
 }
 
 func (ctr *Container) compare(vi, vj int, i, j int64) int {
@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minus
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+func TestString(t *testing.T) {
+	String(&Argument{}, new(bytes.Buffer))
+}
+
+func TestMinus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proc := testutil.NewMergeProcess(ctx, 2)
+	arg := &Argument{}
+	require.NoError(t, Prepare(proc, arg))
+	// [0, 10) twice with a null except [5, 15) with a null
+	proc.Reg.MergeReceivers[0].Ch <- newBatch(t, proc, 0, Rows, true)
+	proc.Reg.MergeReceivers[0].Ch <- newBatch(t, proc, 0, Rows, true)
+	proc.Reg.MergeReceivers[0].Ch <- nil
+	proc.Reg.MergeReceivers[1].Ch <- newBatch(t, proc, 5, Rows, true)
+	proc.Reg.MergeReceivers[1].Ch <- nil
+	rows := countRows(t, proc, arg)
+	require.Equal(t, map[int64]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1}, rows)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+// create a new batch of an int64 column of start, start+1, ..., the first
+// row is null if hasNull
+func newBatch(t *testing.T, proc *process.Process, start, rows int64, hasNull bool) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int64})
+	data, err := mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	vec.Data = data
+	vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+	for i := range vs {
+		vs[i] = start + int64(i)
+	}
+	if hasNull {
+		nulls.Add(vec.Nsp, 0)
+	}
+	vec.Col = vs
+	bat.Vecs[0] = vec
+	return bat
+}
+
+// countRows calls the operator until the end and returns the count of each
+// value it outputs, the nulls are counted as -1
+func countRows(t *testing.T, proc *process.Process, arg *Argument) map[int64]int {
+	rows := make(map[int64]int)
+	for {
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := proc.Reg.InputBatch
+		vec := bat.Vecs[0]
+		for i, v := range vec.Col.([]int64) {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				v = -1
+			}
+			rows[v]++
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+	return rows
+}
@@ -32,12 +32,17 @@ func Prepare(_ *process.Process, _ interface{}) error {
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	bat := proc.Reg.InputBatch
 	if bat == nil || len(bat.Zs) == 0 {
 		return false, nil
 	}
-	n := arg.(*Argument)
+	anal.Input(bat)
 	if n.Seen > n.Offset {
+		anal.Output(bat)
 		return false, nil
 	}
 	length := len(bat.Zs)
@@ -46,6 +51,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		n.Seen += uint64(length)
 		batch.Shrink(bat, sels)
 		proc.Reg.InputBatch = bat
+		anal.Output(bat)
 		return false, nil
 	}
 	n.Seen += uint64(length)
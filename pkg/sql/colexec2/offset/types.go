@@ -17,4 +17,5 @@ package offset
 type Argument struct {
 	Seen   uint64 // seen is the number of tuples seen so far
 	Offset uint64
+	Idx    int // index of the AnalyzeInfo of the operator
 }
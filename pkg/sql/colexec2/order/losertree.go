@@ -0,0 +1,61 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package order
+
+// LoserTree selects the next of k sorted runs in log(k) comparisons.
+// tree[0] is the winner, tree[1:] are the losers of the inner nodes
+type LoserTree struct {
+	tree []int
+	less func(int, int) bool
+}
+
+// NewLoserTree returns the tree of k sorted runs, less(i, j) returns true
+// if the head of run i is before the one of run j
+func NewLoserTree(k int, less func(int, int) bool) *LoserTree {
+	lt := &LoserTree{
+		tree: make([]int, k),
+		less: less,
+	}
+	for i := range lt.tree {
+		lt.tree[i] = -1
+	}
+	for i := k - 1; i >= 0; i-- {
+		lt.Adjust(i)
+	}
+	return lt
+}
+
+func (lt *LoserTree) Winner() int {
+	return lt.tree[0]
+}
+
+// Adjust replays the matches from leaf i to the root once its head changes
+func (lt *LoserTree) Adjust(i int) {
+	winner := i
+	for p := (i + len(lt.tree)) / 2; p > 0; p /= 2 {
+		if lt.beats(lt.tree[p], winner) {
+			lt.tree[p], winner = winner, lt.tree[p]
+		}
+	}
+	lt.tree[0] = winner
+}
+
+// beats returns true if i beats j, -1 beats all while the tree is built
+func (lt *LoserTree) beats(i, j int) bool {
+	if i == -1 || j == -1 {
+		return i == -1
+	}
+	return lt.less(i, j)
+}
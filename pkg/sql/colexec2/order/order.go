@@ -17,7 +17,9 @@ package order
 import (
 	"bytes"
 
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/partition"
 	"github.com/matrixorigin/matrixone/pkg/sort"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
@@ -49,13 +51,148 @@ func Prepare(_ *process.Process, arg interface{}) error {
 	return nil
 }
 
+// Call sorts each batch if the data cannot be spilled. Otherwise the
+// batches are buffered and sorted as a whole, once the input ends the sorted
+// rows are sent in the batches of the calls returning false, the call
+// returning true has no batch
 func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
+	if spillable(proc) {
+		return n.ctr.sortWithSpill(proc, &anal)
+	}
 	bat := proc.Reg.InputBatch
 	if bat == nil || len(bat.Zs) == 0 {
 		return false, nil
 	}
-	n := arg.(*Argument)
-	return n.ctr.process(bat, proc)
+	anal.Input(bat)
+	end, err := n.ctr.process(bat, proc)
+	if err != nil {
+		return end, err
+	}
+	anal.Output(bat)
+	return end, nil
+}
+
+func (ctr *Container) sortWithSpill(proc *process.Process, anal *process.Analyze) (bool, error) {
+	for {
+		switch ctr.state {
+		case Build:
+			bat := proc.Reg.InputBatch
+			if bat != nil {
+				if len(bat.Zs) == 0 {
+					return false, nil
+				}
+				anal.Input(bat)
+				proc.Reg.InputBatch = &batch.Batch{}
+				if err := ctr.collect(bat, proc); err != nil {
+					ctr.state = End
+					ctr.clean(proc)
+					return true, err
+				}
+				return false, nil
+			}
+			if ctr.runs == nil {
+				ctr.state = End
+				if ctr.bat == nil {
+					continue
+				}
+				if _, err := ctr.process(ctr.bat, proc); err != nil {
+					ctr.clean(proc)
+					return true, err
+				}
+				anal.Output(ctr.bat)
+				proc.Reg.InputBatch, ctr.bat = ctr.bat, nil
+				return false, nil
+			}
+			if ctr.bat != nil {
+				if err := ctr.spillRun(proc); err != nil {
+					ctr.state = End
+					ctr.clean(proc)
+					return true, err
+				}
+			}
+			if err := ctr.runs.Rewind(proc); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return true, err
+			}
+			anal.Spill(ctr.runs.Size())
+			ctr.state = Merge
+		case Merge:
+			bat, err := ctr.runs.Merge(proc)
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return true, err
+			}
+			if bat == nil {
+				ctr.state = End
+				ctr.clean(proc)
+				continue
+			}
+			anal.Output(bat)
+			proc.Reg.InputBatch = bat
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// collect appends bat to ctr.bat, which is sorted and spilled as a run
+// once the memory limit is exceeded
+func (ctr *Container) collect(bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if ctr.bat == nil {
+		ctr.bat = batch.New(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	rbat, err := ctr.bat.Append(proc.Mp, bat)
+	if err != nil {
+		return err
+	}
+	ctr.bat = rbat
+	if exceeded(proc) {
+		return ctr.spillRun(proc)
+	}
+	return nil
+}
+
+// spillRun sorts ctr.bat and spills it as a run
+func (ctr *Container) spillRun(proc *process.Process) error {
+	defer func() {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}()
+	if _, err := ctr.process(ctr.bat, proc); err != nil {
+		return err
+	}
+	if ctr.runs == nil {
+		cmps := make([]compare.Compare, len(ctr.poses))
+		for i, pos := range ctr.poses {
+			cmps[i] = compare.New(ctr.bat.Vecs[pos].Typ.Oid, ctr.ds[i])
+		}
+		ctr.runs = NewRuns(ctr.poses, cmps)
+	}
+	return ctr.runs.Spill(ctr.bat, proc)
+}
+
+// clean frees the rows buffered and removes the runs
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.runs != nil {
+		ctr.runs.Clean(proc)
+		ctr.runs = nil
+	}
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
 }
 
 func (ctr *Container) process(bat *batch.Batch, proc *process.Process) (bool, error) {
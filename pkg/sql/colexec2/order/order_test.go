@@ -16,6 +16,7 @@ package order
 
 import (
 	"bytes"
+	"os"
 	"strconv"
 	"testing"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,6 +93,73 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderWithSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}},
+		[]Field{{Pos: 1, Type: Descending}, {Pos: 0, Type: Ascending}})
+	dir := t.TempDir()
+	tc.proc.Spill = spill.New(dir)
+	tc.proc.Lim.Size = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	for i := 0; i < 3; i++ {
+		tc.proc.Reg.InputBatch = newBatch(t, tc.types, tc.proc, Rows)
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Empty(t, tc.proc.Reg.InputBatch.Zs)
+	}
+	require.Equal(t, 3, tc.arg.ctr.runs.Len())
+	tc.proc.Reg.InputBatch = nil
+	var as []int8
+	var bs []int64
+	for {
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		as = append(as, bat.Vecs[0].Col.([]int8)...)
+		bs = append(bs, bat.Vecs[1].Col.([]int64)...)
+		batch.Clean(bat, tc.proc.Mp)
+	}
+	require.Nil(t, tc.proc.Reg.InputBatch)
+	// the rows of the 3 runs are merged, by the second column descending
+	// first
+	require.Equal(t, 3*Rows, len(bs))
+	for i := 1; i < len(bs); i++ {
+		require.GreaterOrEqual(t, bs[i-1], bs[i])
+		if bs[i-1] == bs[i] {
+			require.LessOrEqual(t, as[i-1], as[i])
+		}
+	}
+	require.Equal(t, int64(0), mheap.InUse(tc.proc.Mp))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestLoserTree(t *testing.T) {
+	runs := [][]int{{1, 4, 7}, {}, {2, 5, 8, 9}, {0, 3, 6}, {10}}
+	rows := make([]int, len(runs))
+	less := func(i, j int) bool {
+		if rows[i] == len(runs[i]) || rows[j] == len(runs[j]) {
+			return rows[j] == len(runs[j]) && rows[i] != len(runs[i])
+		}
+		return runs[i][rows[i]] < runs[j][rows[j]]
+	}
+	lt := NewLoserTree(len(runs), less)
+	for v := 0; v <= 10; v++ {
+		i := lt.Winner()
+		require.Equal(t, v, runs[i][rows[i]])
+		rows[i]++
+		lt.Adjust(i)
+	}
+	i := lt.Winner()
+	require.Equal(t, len(runs[i]), rows[i])
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
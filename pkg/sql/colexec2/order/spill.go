@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package order
+
+import (
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// spillable returns true if the data exceeding the memory limit can be
+// spilled
+func spillable(proc *process.Process) bool {
+	return proc.Spill != nil && proc.Lim.Size > 0
+}
+
+// exceeded returns true if the memory in use exceeds the limit and the
+// data can be spilled
+func exceeded(proc *process.Process) bool {
+	return spillable(proc) && mheap.InUse(proc.Mp) > proc.Lim.Size
+}
+
+// NewRuns returns the runs of the batches sorted on the columns poses,
+// cmps[i] compares the column poses[i]
+func NewRuns(poses []int32, cmps []compare.Compare) *Runs {
+	return &Runs{
+		poses: poses,
+		cmps:  cmps,
+	}
+}
+
+// Len returns the number of the runs
+func (rs *Runs) Len() int {
+	return len(rs.runs)
+}
+
+// Size returns the bytes spilled
+func (rs *Runs) Size() int64 {
+	var size int64
+	for _, r := range rs.runs {
+		size += r.f.Size()
+	}
+	return size
+}
+
+// Spill spills the sorted bat as a new run in batches of BatchRows rows
+func (rs *Runs) Spill(bat *batch.Batch, proc *process.Process) error {
+	f, err := proc.Spill.Create()
+	if err != nil {
+		return err
+	}
+	rs.runs = append(rs.runs, &run{f: f})
+	count := len(bat.Zs)
+	rows := process.BatchRows(proc, BatchRows)
+	for i := 0; i < count; i += rows {
+		n := count - i
+		if n > rows {
+			n = rows
+		}
+		if err := writeBatch(f, bat, i, n, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBatch writes rows [i, i+n) of bat to f
+func writeBatch(f *spill.File, bat *batch.Batch, i, n int, proc *process.Process) error {
+	rbat := batch.New(len(bat.Vecs))
+	defer batch.Clean(rbat, proc.Mp)
+	flags := make([]uint8, n)
+	for k := range flags {
+		flags[k] = 1
+	}
+	for k, vec := range bat.Vecs {
+		rbat.Vecs[k] = vector.New(vec.Typ)
+		if err := vector.UnionBatch(rbat.Vecs[k], vec, int64(i), n, flags, proc.Mp); err != nil {
+			return err
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs[i:i+n]...)
+	return f.Write(rbat)
+}
+
+// Rewind reads the first batch of each run, the runs are merged from now on
+func (rs *Runs) Rewind(proc *process.Process) error {
+	for _, r := range rs.runs {
+		if err := r.f.Rewind(); err != nil {
+			return err
+		}
+		if err := r.next(proc); err != nil {
+			return err
+		}
+	}
+	rs.tree = NewLoserTree(len(rs.runs), rs.less)
+	return nil
+}
+
+// Merge returns the next batch merged from the runs, nil once all are
+// merged
+func (rs *Runs) Merge(proc *process.Process) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	for n := process.BatchRows(proc, BatchRows); rbat == nil || len(rbat.Zs) < n; {
+		i := rs.tree.Winner()
+		r := rs.runs[i]
+		if r.bat == nil {
+			break
+		}
+		if rbat == nil {
+			rbat = batch.New(len(r.bat.Vecs))
+			for k, vec := range r.bat.Vecs {
+				rbat.Vecs[k] = vector.New(vec.Typ)
+			}
+		}
+		for k, vec := range r.bat.Vecs {
+			if err := vector.UnionOne(rbat.Vecs[k], vec, r.row, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, r.bat.Zs[r.row])
+		r.row++
+		if r.row == int64(len(r.bat.Zs)) {
+			if err := r.next(proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		rs.tree.Adjust(i)
+	}
+	return rbat, nil
+}
+
+// less returns true if the row of run i goes before the one of run j, a
+// run merged goes after all
+func (rs *Runs) less(i, j int) bool {
+	ri, rj := rs.runs[i], rs.runs[j]
+	if ri.bat == nil || rj.bat == nil {
+		return rj.bat == nil && ri.bat != nil
+	}
+	for k, cmp := range rs.cmps {
+		cmp.Set(0, ri.bat.Vecs[rs.poses[k]])
+		cmp.Set(1, rj.bat.Vecs[rs.poses[k]])
+		if r := cmp.Compare(0, 1, ri.row, rj.row); r != 0 {
+			return r < 0
+		}
+	}
+	return i < j
+}
+
+// next reads the next batch of the run
+func (r *run) next(proc *process.Process) error {
+	if r.bat != nil {
+		batch.Clean(r.bat, proc.Mp)
+	}
+	bat, err := r.f.Read(proc.Mp)
+	r.bat, r.row = bat, 0
+	return err
+}
+
+// Clean removes the runs
+func (rs *Runs) Clean(proc *process.Process) {
+	for _, r := range rs.runs {
+		if r.bat != nil {
+			batch.Clean(r.bat, proc.Mp)
+		}
+		r.f.Close()
+	}
+	rs.runs = nil
+}
@@ -14,7 +14,13 @@
 
 package order
 
-import "fmt"
+import (
+	"fmt"
+
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
 
 // Direction for ordering results.
 type Direction int8
@@ -26,9 +32,43 @@ const (
 	Descending
 )
 
+const (
+	Build = iota
+	Merge
+	End
+)
+
+const (
+	// BatchRows is the max rows of the batches of a run and of the batches
+	// merged from the runs, if the settings of the process set no batch size
+	BatchRows = 8192
+)
+
 type Container struct {
+	state int
 	ds    []bool  // ds[i] == true: the attrs[i] are in descending order
 	poses []int32 // sorted list of attributes
+
+	// bat is the rows buffered once the operator may spill, which are
+	// sorted as a run once they exceed the memory limit
+	bat  *batch.Batch
+	runs *Runs
+}
+
+// Runs are the sorted runs spilled once the memory limit is exceeded, they
+// are merged by a loser tree in the end
+type Runs struct {
+	poses []int32           // poses[i] is the column compared by cmps[i]
+	cmps  []compare.Compare // compare structures used to merge the runs
+	runs  []*run
+	tree  *LoserTree
+}
+
+// run is a sorted run spilled
+type run struct {
+	f   *spill.File
+	bat *batch.Batch // batch being merged, nil if the run is merged
+	row int64
 }
 
 type Field struct {
@@ -39,6 +79,7 @@ type Field struct {
 type Argument struct {
 	Fs  []Field
 	ctr *Container
+	Idx int // index of the AnalyzeInfo of the operator
 }
 
 var directionName = [...]string{
@@ -31,7 +31,11 @@ func Prepare(_ *process.Process, _ interface{}) error {
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	ap := arg.(*Argument)
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
 	if bat := proc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+		anal.Input(bat)
 		if err := ap.Func(ap.Data, bat); err != nil {
 			batch.Clean(bat, proc.Mp)
 			return true, err
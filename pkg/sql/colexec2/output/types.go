@@ -19,4 +19,5 @@ import batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 type Argument struct {
 	Data interface{}
 	Func func(interface{}, *batch.Batch) error
+	Idx  int // index of the AnalyzeInfo of the operator
 }
@@ -35,16 +35,27 @@ func Prepare(proc *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	ap := arg.(*Argument)
 	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		switch ctr.state {
 		case Build:
-			if err := ctr.build(ap, proc); err != nil {
+			if err := ctr.build(ap, proc, &anal); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				return true, err
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Input(bat)
 			if bat == nil {
 				ctr.state = End
 				batch.Clean(ctr.bat, proc.Mp)
@@ -58,6 +69,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				proc.Reg.InputBatch = nil
 				return true, err
 			}
+			anal.Output(proc.Reg.InputBatch)
 			return false, nil
 		default:
 			proc.Reg.InputBatch = nil
@@ -66,11 +78,13 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
-func (ctr *Container) build(ap *Argument, proc *process.Process) error {
-	var err error
-
+func (ctr *Container) build(ap *Argument, proc *process.Process, anal *process.Analyze) error {
 	for {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		anal.Input(bat)
 		if bat == nil {
 			break
 		}
@@ -93,6 +107,14 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 	return nil
 }
 
+// clean frees the build side once the join is aborted
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+}
+
 func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	defer batch.Clean(bat, proc.Mp)
 	rbat := batch.New(len(ap.Result))
@@ -37,4 +37,5 @@ type ResultPos struct {
 type Argument struct {
 	ctr    *Container
 	Result []ResultPos
+	Idx    int // index of the AnalyzeInfo of the operator
 }
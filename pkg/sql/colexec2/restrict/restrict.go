@@ -0,0 +1,178 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrict
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	obatch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	process2 "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+var (
+	ErrNotLogical = errors.New("restrict: condition is not logical")
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("σ(%s)", ap.E))
+}
+
+func Prepare(proc *process2.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	if _, ok := ap.E.(*extend.ValueExtend); !ok && !ap.E.IsLogical() {
+		return ErrNotLogical
+	}
+	ap.ctr = new(Container)
+	ap.ctr.proc = process.New(proc.Mp)
+	for _, attr := range ap.E.Attributes() {
+		if pos := attrPos(ap.Attrs, attr); pos < 0 {
+			return fmt.Errorf("restrict: unknown attribute '%s'", attr)
+		}
+	}
+	return extend.Prepare(ap.E)
+}
+
+// Call keeps the rows of the batch satisfying the condition
+func Call(proc *process2.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		ctr.freeRegisters()
+		return false, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	anal := process2.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	anal.Input(bat)
+	if e, ok := ap.E.(*extend.ValueExtend); ok {
+		if !isTrue(e.V) {
+			batch.Clean(bat, proc.Mp)
+			proc.Reg.InputBatch = &batch.Batch{}
+			return false, nil
+		}
+		anal.Output(bat)
+		return false, nil
+	}
+	if err := ctr.eval(bat, ap, proc); err != nil {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, err
+	}
+	anal.Output(proc.Reg.InputBatch)
+	return false, nil
+}
+
+// eval evaluates the condition on bat and shrinks it to the rows passed
+func (ctr *Container) eval(bat *batch.Batch, ap *Argument, proc *process2.Process) error {
+	cbat := &obatch.Batch{
+		Attrs: ap.Attrs,
+		Vecs:  bat.Vecs,
+		Zs:    bat.Zs,
+	}
+	refs := make([]uint64, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		// keeps the vector from being reused or freed by the evaluator
+		refs[i], vec.Ref = vec.Ref, 2
+	}
+	vec, _, err := ap.E.Eval(cbat, ctr.proc)
+	for i, vec := range bat.Vecs {
+		vec.Ref = refs[i]
+	}
+	if err != nil {
+		return err
+	}
+	defer process.Put(ctr.proc, vec)
+	sels := vec.Col.([]int64)
+	switch {
+	case len(sels) == 0:
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+		return nil
+	case len(sels) == len(bat.Zs):
+		proc.Reg.InputBatch = bat
+		return nil
+	}
+	bat.Sels = sels
+	if err := batch.Compact(bat, proc.Mp); err != nil {
+		return err
+	}
+	proc.Reg.InputBatch = bat
+	return nil
+}
+
+// freeRegisters frees the vectors kept by the evaluator
+func (ctr *Container) freeRegisters() {
+	if ctr == nil || ctr.proc == nil {
+		return
+	}
+	for _, vec := range ctr.proc.Reg.Vecs {
+		vector.Clean(vec, ctr.proc.Mp)
+	}
+	ctr.proc.Reg.Vecs = ctr.proc.Reg.Vecs[:0]
+}
+
+func attrPos(attrs []string, attr string) int {
+	for i, name := range attrs {
+		if name == attr {
+			return i
+		}
+	}
+	return -1
+}
+
+// isTrue returns true if the constant v is neither null nor zero
+func isTrue(v *vector.Vector) bool {
+	if nulls.Contains(v.Nsp, 0) {
+		return false
+	}
+	switch v.Typ.Oid {
+	case types.T_int8:
+		return v.Col.([]int8)[0] != 0
+	case types.T_int16:
+		return v.Col.([]int16)[0] != 0
+	case types.T_int32:
+		return v.Col.([]int32)[0] != 0
+	case types.T_int64:
+		return v.Col.([]int64)[0] != 0
+	case types.T_uint8:
+		return v.Col.([]uint8)[0] != 0
+	case types.T_uint16:
+		return v.Col.([]uint16)[0] != 0
+	case types.T_uint32:
+		return v.Col.([]uint32)[0] != 0
+	case types.T_uint64:
+		return v.Col.([]uint64)[0] != 0
+	case types.T_float32:
+		return v.Col.([]float32)[0] != 0
+	case types.T_float64:
+		return v.Col.([]float64)[0] != 0
+	case types.T_char, types.T_varchar:
+		return len(v.Col.(*types.Bytes).Data) != 0
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrict
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+type restrictTestCase struct {
+	arg  *Argument
+	proc *process.Process
+	// rows are the values of a of the rows kept
+	rows []int64
+}
+
+var (
+	tcs []restrictTestCase
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	attrs := []string{"a", "u", "d"}
+	a := &extend.Attribute{Name: "a", Type: types.T_int64}
+	u := &extend.Attribute{Name: "u", Type: types.T_uint64}
+	d := &extend.Attribute{Name: "d", Type: types.T_date}
+	tcs = []restrictTestCase{
+		{
+			proc: process.New(mheap.New(gm)),
+			arg: &Argument{
+				Attrs: attrs,
+				E:     &extend.BinaryExtend{Op: overload.GT, Left: a, Right: newValue(types.T_int64, []int64{5})},
+			},
+			rows: []int64{6, 7, 8, 9},
+		},
+		{
+			// the uint64 values above math.MaxInt64 are compared as they are
+			proc: process.New(mheap.New(gm)),
+			arg: &Argument{
+				Attrs: attrs,
+				E:     &extend.BinaryExtend{Op: overload.GE, Left: u, Right: newValue(types.T_uint64, []uint64{math.MaxUint64 - 2})},
+			},
+			rows: []int64{0, 1, 2},
+		},
+		{
+			proc: process.New(mheap.New(gm)),
+			arg: &Argument{
+				Attrs: attrs,
+				E: &extend.BinaryExtend{
+					Op:    overload.And,
+					Left:  &extend.NullExtend{Not: true, E: d},
+					Right: &extend.BinaryExtend{Op: overload.LT, Left: d, Right: newValue(types.T_date, []types.Date{5})},
+				},
+			},
+			rows: []int64{1, 3},
+		},
+		{
+			proc: process.New(mheap.New(gm)),
+			arg: &Argument{
+				Attrs: attrs,
+				E: &extend.BinaryExtend{
+					Op:    overload.Or,
+					Left:  &extend.NullExtend{E: d},
+					Right: &extend.InExtend{E: a, Vs: newValue(types.T_int64, []int64{3, 5}).V},
+				},
+			},
+			rows: []int64{0, 2, 3, 4, 5, 6, 8},
+		},
+		{
+			proc: process.New(mheap.New(gm)),
+			arg: &Argument{
+				Attrs: attrs,
+				E:     newValue(types.T_int64, []int64{0}),
+			},
+		},
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+	}
+	arg := &Argument{E: &extend.Attribute{Name: "a", Type: types.T_int64}}
+	require.Equal(t, ErrNotLogical, Prepare(tcs[0].proc, arg))
+}
+
+func TestRestrict(t *testing.T) {
+	for _, tc := range tcs {
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.InputBatch = newBatch(t, tc.proc, Rows)
+		_, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		bat := tc.proc.Reg.InputBatch
+		if len(tc.rows) == 0 {
+			require.Equal(t, 0, len(bat.Zs))
+		} else {
+			require.Equal(t, tc.rows, bat.Vecs[0].Col.([]int64))
+			require.Equal(t, len(tc.rows), len(bat.Zs))
+		}
+		batch.Clean(bat, tc.proc.Mp)
+		tc.proc.Reg.InputBatch = &batch.Batch{}
+		_, err = Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = nil
+		_, err = Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func newValue(oid types.T, vs interface{}) *extend.ValueExtend {
+	vec := vector.New(oid.ToType())
+	vec.Ref = 1
+	vec.Col = vs
+	return &extend.ValueExtend{V: vec}
+}
+
+// newBatch returns the batch of the columns a, u and d of rows rows. a is
+// the row, u is math.MaxUint64 minus the row, d is the row and null on the
+// even rows
+func newBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.New(3)
+	bat.InitZsOne(int(rows))
+
+	data, err := mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	as := encoding.DecodeInt64Slice(data)[:rows]
+	for i := range as {
+		as[i] = int64(i)
+	}
+	bat.Vecs[0] = vector.New(types.T_int64.ToType())
+	bat.Vecs[0].Data, bat.Vecs[0].Col = data, as
+
+	data, err = mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	us := encoding.DecodeUint64Slice(data)[:rows]
+	for i := range us {
+		us[i] = math.MaxUint64 - uint64(i)
+	}
+	bat.Vecs[1] = vector.New(types.T_uint64.ToType())
+	bat.Vecs[1].Data, bat.Vecs[1].Col = data, us
+
+	data, err = mheap.Alloc(proc.Mp, rows*4)
+	require.NoError(t, err)
+	ds := encoding.DecodeDateSlice(data)[:rows]
+	bat.Vecs[2] = vector.New(types.T_date.ToType())
+	for i := range ds {
+		ds[i] = types.Date(i)
+		if i%2 == 0 {
+			nulls.Add(bat.Vecs[2].Nsp, uint64(i))
+		}
+	}
+	bat.Vecs[2].Data, bat.Vecs[2].Col = data, ds
+	return bat
+}
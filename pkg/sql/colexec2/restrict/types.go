@@ -0,0 +1,34 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrict
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type Container struct {
+	// proc evaluates the condition
+	proc *process.Process
+}
+
+// Argument of the restrict. E is a logical expression, or a constant, on
+// the columns of the batches named by Attrs in order
+type Argument struct {
+	ctr   *Container
+	E     extend.Extend
+	Attrs []string
+	Idx   int // index of the AnalyzeInfo of the operator
+}
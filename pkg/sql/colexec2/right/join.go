@@ -0,0 +1,276 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	"bytes"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" ⟖ ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.enc = hashkey.NewEncoder(UnitLimit, false)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	for i, cond := range ap.Conditions[0] { // aligning the precision of decimal
+		switch cond.Typ.Oid {
+		case types.T_decimal64, types.T_decimal128:
+			typ := ap.Conditions[1][i].Typ
+			if typ.Scale > cond.Typ.Scale {
+				ap.Conditions[0][i].Scale = typ.Scale - cond.Typ.Scale
+			} else if typ.Scale < cond.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Typ.Scale - typ.Scale
+			}
+		}
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc, &anal); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Input(bat)
+			if bat == nil {
+				ctr.state = Fill
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if ctr.bat == nil {
+				batch.Clean(bat, proc.Mp)
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				batch.Clean(ctr.bat, proc.Mp)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Output(proc.Reg.InputBatch)
+			return false, nil
+		case Fill:
+			ctr.state = End
+			if ctr.bat == nil {
+				continue
+			}
+			err := ctr.fill(ap, proc)
+			batch.Clean(ctr.bat, proc.Mp)
+			if err != nil {
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			anal.Output(proc.Reg.InputBatch)
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// build keeps all the rows of the build side, which are emitted once
+// whether joined or not
+func (ctr *Container) build(ap *Argument, proc *process.Process, anal *process.Analyze) error {
+	for {
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[1])
+		if err != nil {
+			return err
+		}
+		anal.Input(bat)
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.New(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			batch.Clean(bat, proc.Mp)
+			batch.Clean(ctr.bat, proc.Mp)
+			return err
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+	if ctr.bat == nil {
+		return nil
+	}
+	count := len(ctr.bat.Zs)
+	ctr.matched = make([]bool, count)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(ctr.bat, ap.Conditions[1], i, n)
+		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] == 0 {
+				continue
+			}
+			if v > ctr.rows {
+				ctr.rows++
+				ctr.sels = append(ctr.sels, make([]int64, 0, 8))
+			}
+			ai := int64(v) - 1
+			ctr.sels[ai] = append(ctr.sels[ai], int64(i+k))
+		}
+	}
+	return nil
+}
+
+// clean frees the build side once the join is aborted
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := batch.New(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat, ap.Conditions[0], i, n)
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		for k := 0; k < n; k++ {
+			if ctr.zValues[k] == 0 || ctr.values[k] == 0 {
+				continue
+			}
+			for _, sel := range ctr.sels[ctr.values[k]-1] {
+				ctr.matched[sel] = true
+				for j, rp := range ap.Result {
+					if rp.Rel == 0 {
+						if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], int64(i+k), proc.Mp); err != nil {
+							batch.Clean(rbat, proc.Mp)
+							return err
+						}
+					} else {
+						if err := vector.UnionOne(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], sel, proc.Mp); err != nil {
+							batch.Clean(rbat, proc.Mp)
+							return err
+						}
+					}
+				}
+				rbat.Zs = append(rbat.Zs, ctr.bat.Zs[sel])
+			}
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// fill emits the rows of the build side not joined, with the columns of the
+// probe side null-extended
+func (ctr *Container) fill(ap *Argument, proc *process.Process) error {
+	rbat := batch.New(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(ap.Typs[rp.Pos])
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	for sel, ok := range ctr.matched {
+		if ok {
+			continue
+		}
+		for j, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := vector.UnionNull(rbat.Vecs[j], rbat.Vecs[j], proc.Mp); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return err
+				}
+			} else {
+				if err := vector.UnionOne(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], int64(sel), proc.Mp); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, ctr.bat.Zs[sel])
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// fillKeys fills the keys of rows [i, i+n) of bat to ctr.enc.Keys. The
+// zValues of the rows with a null key are 0
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, i, n int) {
+	copy(ctr.zValues[:n], OneInt64s[:n])
+	ctr.enc.Reset()
+	for _, cond := range conds {
+		ctr.enc.Add(bat.Vecs[cond.Pos], cond.Scale)
+	}
+	ctr.enc.Encode(i, n, ctr.zValues)
+}
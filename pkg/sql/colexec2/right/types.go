@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Build = iota
+	Probe
+	Fill // emits the unmatched rows of the build side
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+var OneInt64s []int64
+
+type Container struct {
+	state         int
+	rows          uint64
+	enc           *hashkey.Encoder
+	values        []uint64
+	zValues       []int64
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	sels [][]int64
+
+	// matched[i] is true if row i of bat is joined by any row of the probe side
+	matched []bool
+
+	bat *batch.Batch
+}
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+type Condition struct {
+	Pos   int32
+	Scale int32
+	Typ   types.Type
+}
+
+type Argument struct {
+	ctr        *Container
+	Result     []ResultPos
+	Conditions [][]Condition
+	// Typs are the types of the columns of the probe side, which are
+	// null-extended for the unmatched rows of the build side
+	Typs []types.Type
+	Idx  int // index of the AnalyzeInfo of the operator
+}
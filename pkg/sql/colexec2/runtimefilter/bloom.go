@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimefilter
+
+import "hash/maphash"
+
+// Hash returns the hash of key of a bloom filter seeded with seed
+func Hash(seed maphash.Seed, key []byte) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	h.Write(key)
+	return h.Sum64()
+}
+
+// NewBloomFilter creates a bloom filter of the hashes of keys seeded with
+// seed
+func NewBloomFilter(seed maphash.Seed, hashes []uint64) *BloomFilter {
+	nbits := len(hashes) * BitsPerKey
+	if nbits < 64 {
+		nbits = 64
+	}
+	bf := &BloomFilter{
+		seed:   seed,
+		hashes: Hashes,
+		bits:   make([]uint64, (nbits+63)/64),
+	}
+	for _, hash := range hashes {
+		bf.add(hash)
+	}
+	return bf
+}
+
+// MayContain returns false if key is not in the filter
+func (bf *BloomFilter) MayContain(key []byte) bool {
+	return bf.contains(Hash(bf.seed, key))
+}
+
+// add and contains use double hashing to derive the probe positions from
+// one 64-bit hash
+func (bf *BloomFilter) add(hash uint64) {
+	nbits := uint64(len(bf.bits)) * 64
+	delta := hash>>33 | hash<<31
+	for i := uint32(0); i < bf.hashes; i++ {
+		pos := hash % nbits
+		bf.bits[pos/64] |= 1 << (pos % 64)
+		hash += delta
+	}
+}
+
+func (bf *BloomFilter) contains(hash uint64) bool {
+	nbits := uint64(len(bf.bits)) * 64
+	delta := hash>>33 | hash<<31
+	for i := uint32(0); i < bf.hashes; i++ {
+		pos := hash % nbits
+		if bf.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+		hash += delta
+	}
+	return true
+}
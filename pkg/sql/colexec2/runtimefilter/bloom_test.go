@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimefilter
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	seed := maphash.MakeSeed()
+	key := func(i int) []byte {
+		buf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, uint64(i))
+		return buf
+	}
+	keys := 10000
+	hashes := make([]uint64, keys)
+	for i := range hashes {
+		hashes[i] = Hash(seed, key(i))
+	}
+	bf := NewBloomFilter(seed, hashes)
+	for i := 0; i < keys; i++ {
+		require.True(t, bf.MayContain(key(i)))
+	}
+	positives := 0
+	for i := keys; i < 2*keys; i++ {
+		if bf.MayContain(key(i)) {
+			positives++
+		}
+	}
+	require.Less(t, positives, keys/20)
+}
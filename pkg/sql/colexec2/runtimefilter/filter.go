@@ -0,0 +1,115 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimefilter
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("runtime filter(filtered rows: %v/%v)", ap.Stats.FilteredRows, ap.Stats.Rows))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.enc = hashkey.NewEncoder(UnitLimit, false)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	return nil
+}
+
+// Call skips the rows of the keys not in the filter of the build side, the
+// first batch waits for the filter. The rows kept are set as the selection
+// of the batch and not copied
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	if !ctr.ready {
+		select {
+		case <-ap.Reg.Ctx.Done():
+			batch.Clean(bat, proc.Mp)
+			proc.Reg.InputBatch = nil
+			return true, nil
+		case ctr.filter = <-ap.Reg.Ch:
+			ctr.ready = true
+		}
+	}
+	if ctr.filter == nil {
+		anal.Output(bat)
+		return false, nil
+	}
+	ctr.sels = ctr.sels[:0]
+	count := len(bat.Zs)
+	rows := count
+	if bat.Sels != nil {
+		rows = len(bat.Sels)
+	}
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		batch.MaskUnselected(bat, ctr.zValues[:n], i)
+		ctr.fillKeys(bat, ap.Conditions, i, n)
+		for k := 0; k < n; k++ {
+			// the rows of null keys are never joined
+			if ctr.zValues[k] != 0 && ctr.filter.MayContain(ctr.enc.Keys[k]) {
+				ctr.sels = append(ctr.sels, int64(i+k))
+			}
+		}
+	}
+	ap.Stats.Rows += int64(rows)
+	ap.Stats.FilteredRows += int64(rows - len(ctr.sels))
+	if len(ctr.sels) < rows {
+		// the selection is owned by the batch from now on
+		bat.Sels, ctr.sels = ctr.sels, nil
+	}
+	anal.Output(bat)
+	return false, nil
+}
+
+// fillKeys fills the keys of rows [i, i+n) of bat to ctr.enc.Keys
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, i, n int) {
+	ctr.enc.Reset()
+	for _, cond := range conds {
+		ctr.enc.Add(bat.Vecs[cond.Pos], cond.Scale)
+	}
+	ctr.enc.Encode(i, n, ctr.zValues)
+}
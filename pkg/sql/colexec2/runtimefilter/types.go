@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimefilter
+
+import (
+	"context"
+	"hash/maphash"
+
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	UnitLimit = 256
+	// BitsPerKey gives a false positive rate about 1%
+	BitsPerKey = 10
+	// Hashes = ln2 * BitsPerKey minimizes the false positive rate
+	Hashes = 7
+	// MaxKeys is the max number of keys of a bloom filter, the filter of
+	// more keys is not selective enough to pay off
+	MaxKeys = 1 << 22
+)
+
+var OneInt64s []int64
+
+// BloomFilter is a bloom filter on the keys of the build side of a join,
+// encoded as the keys of its hashtable
+type BloomFilter struct {
+	seed   maphash.Seed
+	hashes uint32
+	bits   []uint64
+}
+
+// Register receives the bloom filter of the build side, nil if there is
+// none
+type Register struct {
+	Ctx context.Context
+	Ch  chan *BloomFilter
+}
+
+// Stats are the rows the filter is applied to and the ones filtered out
+type Stats struct {
+	Rows         int64
+	FilteredRows int64
+}
+
+type Container struct {
+	ready  bool // indicates if the filter is received
+	filter *BloomFilter
+
+	sels    []int64
+	enc     *hashkey.Encoder
+	zValues []int64
+}
+
+type Condition struct {
+	Pos   int32
+	Scale int32
+	Typ   types.Type
+}
+
+// Argument of the filter pushed to the scan of the probe side of an inner
+// join. The rows of the keys not in the filter are skipped
+type Argument struct {
+	ctr        *Container
+	Conditions []Condition
+	Reg        *Register
+	Stats      Stats
+	Idx        int // index of the AnalyzeInfo of the operator
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffle
+
+import (
+	"bytes"
+	"fmt"
+	"hash/maphash"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("shuffle(%v) to %v", ap.Poses, len(ap.Regs)))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	if len(ap.Poses) > 0 {
+		if ap.Seed == (maphash.Seed{}) {
+			ap.Seed = maphash.MakeSeed()
+		}
+		ap.ctr.h.SetSeed(ap.Seed)
+		ap.ctr.enc = hashkey.NewEncoder(UnitLimit, true)
+		ap.ctr.sels = make([][]int64, len(ap.Regs))
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		for _, reg := range ap.Regs {
+			select {
+			case <-reg.Ctx.Done():
+			case reg.Ch <- nil:
+			}
+		}
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	if err := batch.Compact(bat, proc.Mp); err != nil {
+		batch.Clean(bat, proc.Mp)
+		return false, err
+	}
+	if len(ap.Poses) == 0 {
+		// The vectors of the reader are copied, as the connector does
+		for i, vec := range bat.Vecs {
+			if vec.Or {
+				var err error
+				if bat.Vecs[i], err = vector.Dup(vec, proc.Mp); err != nil {
+					batch.Clean(bat, proc.Mp)
+					return false, err
+				}
+			}
+		}
+		reg := ap.Regs[ctr.next]
+		ctr.next = (ctr.next + 1) % len(ap.Regs)
+		anal.Output(bat)
+		return send(reg, bat, proc), nil
+	}
+	defer batch.Clean(bat, proc.Mp)
+	for i := range ctr.sels {
+		ctr.sels[i] = ctr.sels[i][:0]
+	}
+	ctr.enc.Reset()
+	for _, pos := range ap.Poses {
+		ctr.enc.Add(bat.Vecs[pos], 0)
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.enc.Encode(i, n, nil)
+		for k, key := range ctr.enc.Keys[:n] {
+			ctr.h.Reset()
+			ctr.h.Write(key)
+			p := ctr.h.Sum64() % uint64(len(ap.Regs))
+			ctr.sels[p] = append(ctr.sels[p], int64(i+k))
+		}
+	}
+	for i, sels := range ctr.sels {
+		if len(sels) == 0 {
+			continue
+		}
+		rbat, err := shuffleRows(bat, sels, proc)
+		if err != nil {
+			return false, err
+		}
+		anal.Output(rbat)
+		if send(ap.Regs[i], rbat, proc) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// shuffleRows returns a batch of the rows sels of bat
+func shuffleRows(bat *batch.Batch, sels []int64, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.New(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.Union(rbat.Vecs[i], vec, sels, proc.Mp); err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return nil, err
+		}
+	}
+	rbat.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		rbat.Zs[i] = bat.Zs[sel]
+	}
+	return rbat, nil
+}
+
+// send sends bat to reg, and returns true if the pipeline of reg is canceled
+func send(reg *process.WaitRegister, bat *batch.Batch, proc *process.Process) bool {
+	select {
+	case <-reg.Ctx.Done():
+		batch.Clean(bat, proc.Mp)
+		return true
+	case reg.Ch <- bat:
+		return false
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffle
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShuffle(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	arg := &Argument{
+		Poses: []int32{0},
+		Regs:  newRegs(3),
+	}
+	String(arg, new(bytes.Buffer))
+	require.NoError(t, Prepare(proc, arg))
+	for _, rows := range [][2][]int64{
+		{{1, 2, 3, 4, 1, 2}, {0, 1, 2, 3, 4, 5}},
+		{{4, 3, 2, 1}, {6, 7, 8, 9}},
+	} {
+		proc.Reg.InputBatch = newBatch(t, proc, rows[:])
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+	proc.Reg.InputBatch = nil
+	ok, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The rows of the same keys are received by the same pipeline
+	owners := make(map[int64]int)
+	rows := 0
+	for i, reg := range arg.Regs {
+		for bat := <-reg.Ch; bat != nil; bat = <-reg.Ch {
+			for _, key := range bat.Vecs[0].Col.([]int64) {
+				if owner, ok := owners[key]; ok {
+					require.Equal(t, owner, i)
+				}
+				owners[key] = i
+			}
+			rows += len(bat.Zs)
+			batch.Clean(bat, proc.Mp)
+		}
+	}
+	require.Equal(t, 10, rows)
+	require.Equal(t, 4, len(owners))
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+
+	// Without keys, the batches are sent in turn
+	arg = &Argument{Regs: newRegs(2)}
+	require.NoError(t, Prepare(proc, arg))
+	for i := 0; i < 2; i++ {
+		proc.Reg.InputBatch = newBatch(t, proc, [][]int64{{1, 2}})
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+	for _, reg := range arg.Regs {
+		bat := <-reg.Ch
+		require.Equal(t, 2, len(bat.Zs))
+		batch.Clean(bat, proc.Mp)
+	}
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func newRegs(n int) []*process.WaitRegister {
+	regs := make([]*process.WaitRegister, n)
+	for i := range regs {
+		regs[i] = &process.WaitRegister{
+			Ctx: context.Background(),
+			Ch:  make(chan *batch.Batch, 8),
+		}
+	}
+	return regs
+}
+
+// create a new block of int64 columns of the values of cols
+func newBatch(t *testing.T, proc *process.Process, cols [][]int64) *batch.Batch {
+	rows := int64(len(cols[0]))
+	bat := batch.New(len(cols))
+	bat.InitZsOne(int(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+		copy(vs, cols[i])
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
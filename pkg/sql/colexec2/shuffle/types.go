@@ -0,0 +1,51 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffle
+
+import (
+	"hash/maphash"
+
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+const (
+	UnitLimit = 256
+)
+
+type Container struct {
+	// next is the register the next batch is sent to without keys
+	next int
+	enc  *hashkey.Encoder
+	h    maphash.Hash
+	sels [][]int64
+}
+
+// Argument of the local exchange sending the rows of its pipeline to the
+// pipelines of a parallel operator. The rows of the same keys are sent to
+// the same pipeline, and the batches are sent in turn if there is no key.
+// The pipelines receive a nil batch at the end
+type Argument struct {
+	ctr *Container
+	// Poses are the positions of the keys
+	Poses []int32
+	// Seed of the hashes of the keys, which is shared by the shuffles of
+	// the parallel pipelines sending to the same ones. A random one is used
+	// if it is not set
+	Seed maphash.Seed
+	// Regs are the registers of the pipelines
+	Regs []*process.WaitRegister
+	Idx  int // index of the AnalyzeInfo of the operator
+}
@@ -55,6 +55,9 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	ctr := n.ctr
+	anal := process.GetAnalyze(proc, n.Idx)
+	anal.Start()
+	defer anal.Stop()
 	for {
 		switch ctr.state {
 		case Build:
@@ -66,10 +69,15 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			if len(bat.Zs) == 0 {
 				return false, nil
 			}
+			anal.Input(bat)
 			return false, ctr.build(n, bat, proc)
 		case Eval:
 			ctr.state = End
-			return true, ctr.eval(n.Limit, proc)
+			if err := ctr.eval(n.Limit, proc); err != nil {
+				return true, err
+			}
+			anal.Output(proc.Reg.InputBatch)
+			return true, nil
 		default:
 			proc.Reg.InputBatch = nil
 			return true, nil
@@ -56,6 +56,7 @@ type Argument struct {
 	Limit int64
 	Fs    []Field
 	ctr   *Container
+	Idx   int // index of the AnalyzeInfo of the operator
 }
 
 var directionName = [...]string{
@@ -0,0 +1,133 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"bytes"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" union ")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.enc = hashkey.NewEncoder(UnitLimit, true)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.inserted = make([]uint8, UnitLimit)
+	ap.ctr.zInserted = make([]uint8, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	return nil
+}
+
+// Call reads the batches of the receivers one receiver after another and
+// sends the rows not seen before. Nulls are equal to each other
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		if ctr.i >= len(proc.Reg.MergeReceivers) {
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[ctr.i])
+		if err != nil {
+			ctr.i = len(proc.Reg.MergeReceivers)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+		anal.Input(bat)
+		if bat == nil {
+			ctr.i++
+			continue
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		rbat, err := ctr.insert(bat, proc)
+		if err != nil {
+			ctr.i = len(proc.Reg.MergeReceivers)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+		if len(rbat.Zs) == 0 {
+			batch.Clean(rbat, proc.Mp)
+			continue
+		}
+		anal.Output(rbat)
+		proc.Reg.InputBatch = rbat
+		return false, nil
+	}
+}
+
+// insert returns the rows of bat inserted into the hashtable
+func (ctr *Container) insert(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := batch.New(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat.Vecs, i, n)
+		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.enc.Keys[:n], ctr.values)
+		cnt := 0
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for k, v := range ctr.values[:n] {
+			if v > ctr.rows {
+				cnt++
+				ctr.rows++
+				ctr.inserted[k] = 1
+				rbat.Zs = append(rbat.Zs, 1)
+			}
+		}
+		if cnt == 0 {
+			continue
+		}
+		for j, vec := range rbat.Vecs {
+			if err := vector.UnionBatch(vec, bat.Vecs[j], int64(i), cnt, ctr.inserted[:n], proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+	}
+	return rbat, nil
+}
+
+// fillKeys encodes the rows [start, start+n) of all the columns as keys to
+// ctr.enc.Keys, the nulls are equal to each other
+func (ctr *Container) fillKeys(vecs []*vector.Vector, start, n int) {
+	ctr.enc.Reset()
+	for _, vec := range vecs {
+		ctr.enc.Add(vec, 0)
+	}
+	ctr.enc.Encode(start, n, nil)
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unionall
+
+import (
+	"bytes"
+
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" union all ")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	return nil
+}
+
+// Call passes the batches of the receivers through one receiver after
+// another, the rows of a branch are sent after the ones of the branch before
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		if ctr.i >= len(proc.Reg.MergeReceivers) {
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[ctr.i])
+		if err != nil {
+			ctr.i = len(proc.Reg.MergeReceivers)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+		anal.Input(bat)
+		if bat == nil {
+			ctr.i++
+			continue
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		anal.Output(bat)
+		proc.Reg.InputBatch = bat
+		return false, nil
+	}
+}
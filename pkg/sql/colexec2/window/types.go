@@ -0,0 +1,97 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"errors"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+)
+
+// The window functions
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	Count
+	Sum
+	Avg
+	Min
+	Max
+)
+
+// The types of the frames
+const (
+	Rows = iota
+	Range
+)
+
+// The types of the bounds of the frames, in the order of their positions
+const (
+	UnboundedPreceding = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+var (
+	ErrRangeOffset = errors.New("window: range frame of an offset is not supported")
+	ErrFrame       = errors.New("window: frame starts after its end")
+	ErrArgType     = errors.New("window: argument is not a number")
+)
+
+// Bound of a frame, Offset is the number of rows of Preceding and Following
+type Bound struct {
+	Type   int
+	Offset int64
+}
+
+// Frame of the rows a function is computed over for each row, the ranking
+// functions ignore it. The rows of a Range frame are the peers of the
+// current row, which are equal to it on all the order keys
+type Frame struct {
+	Type  int
+	Start Bound
+	End   Bound
+}
+
+// Func is a window function, integer arguments are computed as int64 and
+// floating ones as float64
+type Func struct {
+	Op int
+	// Pos is the position of the argument, -1 if there is none such as
+	// count(*) and the ranking functions
+	Pos   int32
+	Frame Frame
+}
+
+type Container struct {
+	bat *batch.Batch
+}
+
+// Argument of the operator computing the window functions of the same
+// window. The rows are sorted by the partition keys and then the order keys
+// already. The operator keeps all the rows until the end of its input, and
+// appends the results of Fs as the last columns in their order
+type Argument struct {
+	ctr *Container
+	// PartitionPoses are the positions of the partition keys
+	PartitionPoses []int32
+	// OrderPoses are the positions of the order keys
+	OrderPoses []int32
+	Fs         []Func
+	Idx        int // index of the AnalyzeInfo of the operator
+}
@@ -0,0 +1,414 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+var funcNames = [...]string{"row_number", "rank", "dense_rank", "count", "sum", "avg", "min", "max"}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("window(")
+	for i, f := range ap.Fs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%s(%v)", funcNames[f.Op], f.Pos))
+	}
+	buf.WriteString(fmt.Sprintf(" over (partition by %v order by %v))", ap.PartitionPoses, ap.OrderPoses))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	for _, f := range ap.Fs {
+		if f.Op < Count {
+			continue
+		}
+		if f.Frame.Type == Range && (f.Frame.Start.Type == Preceding || f.Frame.Start.Type == Following ||
+			f.Frame.End.Type == Preceding || f.Frame.End.Type == Following) {
+			return ErrRangeOffset
+		}
+		if f.Frame.Start.Type == UnboundedFollowing || f.Frame.End.Type == UnboundedPreceding ||
+			f.Frame.Start.Type > f.Frame.End.Type {
+			return ErrFrame
+		}
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	anal := process.GetAnalyze(proc, ap.Idx)
+	anal.Start()
+	defer anal.Stop()
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ctr.bat == nil {
+			return true, nil
+		}
+		if err := ctr.compute(ap, proc); err != nil {
+			batch.Clean(ctr.bat, proc.Mp)
+			ctr.bat = nil
+			return false, err
+		}
+		proc.Reg.InputBatch = ctr.bat
+		anal.Output(ctr.bat)
+		ctr.bat = nil
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	proc.Reg.InputBatch = &batch.Batch{}
+	if ctr.bat == nil {
+		ctr.bat = bat
+		return false, nil
+	}
+	defer batch.Clean(bat, proc.Mp)
+	var err error
+	if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// compute appends the results of the functions to the rows kept
+func (ctr *Container) compute(ap *Argument, proc *process.Process) error {
+	bat := ctr.bat
+	n := len(bat.Zs)
+	// The first row of the partition and of the peers of each row, and the
+	// row after them
+	partStart, partEnd := groups(bat, ap.PartitionPoses, nil, n)
+	peerStart, peerEnd := groups(bat, ap.OrderPoses, partStart, n)
+	for _, f := range ap.Fs {
+		if err := process.Canceled(proc); err != nil {
+			return err
+		}
+		var vec *vector.Vector
+		var err error
+		if f.Op < Count {
+			vec, err = rank(f.Op, partStart, peerStart, proc)
+		} else {
+			vec, err = aggregate(bat, f, partStart, partEnd, peerStart, peerEnd, proc)
+		}
+		if err != nil {
+			return err
+		}
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	return nil
+}
+
+// groups returns the first row of the group of each row and the row after
+// the group, the rows of a group are next to each other and equal on poses.
+// The groups are in the ones of within
+func groups(bat *batch.Batch, poses []int32, within []int, n int) ([]int, []int) {
+	start, end := make([]int, n), make([]int, n)
+	for i := 1; i < n; i++ {
+		start[i] = start[i-1]
+		if within != nil && within[i] != within[i-1] {
+			start[i] = i
+			continue
+		}
+		for _, pos := range poses {
+			if !equal(bat.Vecs[pos], i, i-1) {
+				start[i] = i
+				break
+			}
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		if i == n-1 || start[i+1] != start[i] {
+			end[i] = i + 1
+		} else {
+			end[i] = end[i+1]
+		}
+	}
+	return start, end
+}
+
+// rank returns the results of the ranking function op
+func rank(op int, partStart, peerStart []int, proc *process.Process) (*vector.Vector, error) {
+	vec, rs, err := newInt64Vector(len(partStart), proc)
+	if err != nil {
+		return nil, err
+	}
+	var dense int64
+	for i := range rs {
+		switch op {
+		case RowNumber:
+			rs[i] = int64(i - partStart[i] + 1)
+		case Rank:
+			rs[i] = int64(peerStart[i] - partStart[i] + 1)
+		case DenseRank:
+			if i == partStart[i] {
+				dense = 0
+			}
+			if i == peerStart[i] {
+				dense++
+			}
+			rs[i] = dense
+		}
+	}
+	return vec, nil
+}
+
+// aggregate returns the results of the aggregate function f over the frame
+// of each row
+func aggregate(bat *batch.Batch, f Func, partStart, partEnd, peerStart, peerEnd []int, proc *process.Process) (*vector.Vector, error) {
+	n := len(partStart)
+	var arg *vector.Vector
+	var ints []int64
+	var floats []float64
+	if f.Pos >= 0 {
+		arg = bat.Vecs[f.Pos]
+		var ok bool
+		if ints, floats, ok = numbers(arg); !ok && f.Op != Count {
+			return nil, ErrArgType
+		}
+	}
+	// The prefix counts and sums of the non-null arguments
+	cnts := make([]int64, n+1)
+	isums := make([]int64, n+1)
+	fsums := make([]float64, n+1)
+	for i := 0; i < n; i++ {
+		cnts[i+1], isums[i+1], fsums[i+1] = cnts[i], isums[i], fsums[i]
+		if arg != nil && nulls.Contains(arg.Nsp, uint64(i)) {
+			continue
+		}
+		cnts[i+1]++
+		switch {
+		case ints != nil:
+			isums[i+1] += ints[i]
+		case floats != nil:
+			fsums[i+1] += floats[i]
+		}
+	}
+
+	var vec *vector.Vector
+	var irs []int64
+	var frs []float64
+	var err error
+	if f.Op == Count || (f.Op != Avg && ints != nil) {
+		vec, irs, err = newInt64Vector(n, proc)
+	} else {
+		vec, frs, err = newFloat64Vector(n, proc)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		lo, hi := frame(f.Frame, i, partStart[i], partEnd[i], peerStart[i], peerEnd[i])
+		cnt := cnts[hi] - cnts[lo]
+		if f.Op == Count {
+			irs[i] = cnt
+			continue
+		}
+		if cnt == 0 {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		switch f.Op {
+		case Sum:
+			if irs != nil {
+				irs[i] = isums[hi] - isums[lo]
+			} else {
+				frs[i] = fsums[hi] - fsums[lo]
+			}
+		case Avg:
+			if ints != nil {
+				frs[i] = float64(isums[hi]-isums[lo]) / float64(cnt)
+			} else {
+				frs[i] = (fsums[hi] - fsums[lo]) / float64(cnt)
+			}
+		case Min, Max:
+			first := true
+			for j := lo; j < hi; j++ {
+				if nulls.Contains(arg.Nsp, uint64(j)) {
+					continue
+				}
+				if ints != nil {
+					if first || (f.Op == Min && ints[j] < irs[i]) || (f.Op == Max && ints[j] > irs[i]) {
+						irs[i] = ints[j]
+					}
+				} else {
+					if first || (f.Op == Min && floats[j] < frs[i]) || (f.Op == Max && floats[j] > frs[i]) {
+						frs[i] = floats[j]
+					}
+				}
+				first = false
+			}
+		}
+	}
+	return vec, nil
+}
+
+// frame returns the rows [lo, hi) of the frame of row i
+func frame(fr Frame, i, partStart, partEnd, peerStart, peerEnd int) (int, int) {
+	lo, hi := partStart, partEnd
+	switch fr.Start.Type {
+	case Preceding:
+		lo = i - int(fr.Start.Offset)
+	case CurrentRow:
+		lo = i
+		if fr.Type == Range {
+			lo = peerStart
+		}
+	case Following:
+		lo = i + int(fr.Start.Offset)
+	}
+	switch fr.End.Type {
+	case Preceding:
+		hi = i - int(fr.End.Offset) + 1
+	case CurrentRow:
+		hi = i + 1
+		if fr.Type == Range {
+			hi = peerEnd
+		}
+	case Following:
+		hi = i + int(fr.End.Offset) + 1
+	}
+	if lo < partStart {
+		lo = partStart
+	}
+	if hi > partEnd {
+		hi = partEnd
+	}
+	if hi < lo {
+		hi = lo
+	}
+	return lo, hi
+}
+
+func newInt64Vector(n int, proc *process.Process) (*vector.Vector, []int64, error) {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	data, err := mheap.Alloc(proc.Mp, int64(n)*8)
+	if err != nil {
+		return nil, nil, err
+	}
+	vs := encoding.DecodeInt64Slice(data)[:n]
+	for i := range vs {
+		vs[i] = 0
+	}
+	vec.Data = data
+	vec.Col = vs
+	return vec, vs, nil
+}
+
+func newFloat64Vector(n int, proc *process.Process) (*vector.Vector, []float64, error) {
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	data, err := mheap.Alloc(proc.Mp, int64(n)*8)
+	if err != nil {
+		return nil, nil, err
+	}
+	vs := encoding.DecodeFloat64Slice(data)[:n]
+	for i := range vs {
+		vs[i] = 0
+	}
+	vec.Data = data
+	vec.Col = vs
+	return vec, vs, nil
+}
+
+// numbers returns the values of vec as int64 or float64
+func numbers(vec *vector.Vector) ([]int64, []float64, bool) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return toInt64(vs), nil, true
+	case []int16:
+		return toInt64(vs), nil, true
+	case []int32:
+		return toInt64(vs), nil, true
+	case []int64:
+		return vs, nil, true
+	case []uint8:
+		return toInt64(vs), nil, true
+	case []uint16:
+		return toInt64(vs), nil, true
+	case []uint32:
+		return toInt64(vs), nil, true
+	case []uint64:
+		return toInt64(vs), nil, true
+	case []float32:
+		rs := make([]float64, len(vs))
+		for i, v := range vs {
+			rs[i] = float64(v)
+		}
+		return nil, rs, true
+	case []float64:
+		return nil, vs, true
+	}
+	return nil, nil, false
+}
+
+func toInt64[T int8 | int16 | int32 | uint8 | uint16 | uint32 | uint64](vs []T) []int64 {
+	rs := make([]int64, len(vs))
+	for i, v := range vs {
+		rs[i] = int64(v)
+	}
+	return rs
+}
+
+// equal returns true if the rows i and j of vec are equal, nulls are equal
+// to each other
+func equal(vec *vector.Vector, i, j int) bool {
+	ni, nj := nulls.Contains(vec.Nsp, uint64(i)), nulls.Contains(vec.Nsp, uint64(j))
+	if ni || nj {
+		return ni == nj
+	}
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return vs[i] == vs[j]
+	case []int16:
+		return vs[i] == vs[j]
+	case []int32:
+		return vs[i] == vs[j]
+	case []int64:
+		return vs[i] == vs[j]
+	case []uint8:
+		return vs[i] == vs[j]
+	case []uint16:
+		return vs[i] == vs[j]
+	case []uint32:
+		return vs[i] == vs[j]
+	case []uint64:
+		return vs[i] == vs[j]
+	case []float32:
+		return vs[i] == vs[j]
+	case []float64:
+		return vs[i] == vs[j]
+	case []types.Date:
+		return vs[i] == vs[j]
+	case []types.Datetime:
+		return vs[i] == vs[j]
+	case *types.Bytes:
+		return bytes.Equal(vs.Get(int64(i)), vs.Get(int64(j)))
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindow(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	unbounded := Frame{Type: Rows, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: UnboundedFollowing}}
+	arg := &Argument{
+		PartitionPoses: []int32{0},
+		OrderPoses:     []int32{1},
+		Fs: []Func{
+			{Op: RowNumber, Pos: -1},
+			{Op: Rank, Pos: -1},
+			{Op: DenseRank, Pos: -1},
+			{Op: Sum, Pos: 2, Frame: Frame{Type: Rows, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: CurrentRow}}},
+			{Op: Count, Pos: -1, Frame: Frame{Type: Range, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: CurrentRow}}},
+			{Op: Max, Pos: 2, Frame: Frame{Type: Rows, Start: Bound{Type: Preceding, Offset: 1}, End: Bound{Type: Following, Offset: 1}}},
+			{Op: Avg, Pos: 2, Frame: unbounded},
+		},
+	}
+	String(arg, new(bytes.Buffer))
+	require.NoError(t, Prepare(proc, arg))
+
+	// The partitions span the batches
+	for _, rows := range [][3][]int64{
+		{{0, 0, 0}, {1, 1, 2}, {1, 2, 3}},
+		{{1, 1}, {3, 4}, {4, 5}},
+	} {
+		proc.Reg.InputBatch = newBatch(t, proc, rows[:])
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+	proc.Reg.InputBatch = nil
+	ok, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	bat := proc.Reg.InputBatch
+	require.Equal(t, 5, len(bat.Zs))
+	require.Equal(t, 3+len(arg.Fs), len(bat.Vecs))
+	require.Equal(t, []int64{1, 2, 3, 1, 2}, bat.Vecs[3].Col.([]int64))
+	require.Equal(t, []int64{1, 1, 3, 1, 2}, bat.Vecs[4].Col.([]int64))
+	require.Equal(t, []int64{1, 1, 2, 1, 2}, bat.Vecs[5].Col.([]int64))
+	require.Equal(t, []int64{1, 3, 6, 4, 9}, bat.Vecs[6].Col.([]int64))
+	require.Equal(t, []int64{2, 2, 3, 1, 2}, bat.Vecs[7].Col.([]int64))
+	require.Equal(t, []int64{2, 3, 3, 5, 5}, bat.Vecs[8].Col.([]int64))
+	require.Equal(t, []float64{2, 2, 2, 4.5, 4.5}, bat.Vecs[9].Col.([]float64))
+	batch.Clean(bat, proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+
+	// The frames not supported
+	arg.Fs = []Func{{Op: Sum, Pos: 2, Frame: Frame{Type: Range, Start: Bound{Type: Preceding, Offset: 1}, End: Bound{Type: CurrentRow}}}}
+	require.Equal(t, ErrRangeOffset, Prepare(proc, arg))
+	arg.Fs = []Func{{Op: Sum, Pos: 2, Frame: Frame{Type: Rows, Start: Bound{Type: CurrentRow}, End: Bound{Type: Preceding, Offset: 1}}}}
+	require.Equal(t, ErrFrame, Prepare(proc, arg))
+}
+
+// create a new block of int64 columns of the values of cols
+func newBatch(t *testing.T, proc *process.Process, cols [][]int64) *batch.Batch {
+	rows := int64(len(cols[0]))
+	bat := batch.New(len(cols))
+	bat.InitZsOne(int(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+		copy(vs, cols[i])
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
@@ -33,8 +33,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -51,7 +49,11 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 	// do ast rewrite
 	e.stmt = rewrite.AstRewrite(e.stmt)
 
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
+	showHidden := false
+	if opt, ok := u.(HiddenColumnsOption); ok {
+		showHidden = opt.ShowHiddenColumns()
+	}
+	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).WithHiddenColumns(showHidden).BuildStatement(e.stmt)
 	if err != nil {
 		return err
 	}
@@ -367,7 +369,7 @@ func (e *Exec) compilePlanScope(s *plan.Scope) (*Scope, error) {
 			Arg: &merge.Argument{},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -546,7 +548,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeOrder(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -598,7 +600,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeDedup(),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -665,7 +667,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeLimit(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -711,7 +713,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeOffset(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -788,7 +790,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+			ss[i].Proc = process.NewWithStats(e.c.proc)
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -813,7 +815,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructBareTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -868,7 +870,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructResultProjection(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -991,7 +993,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructUntransform(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1038,7 +1040,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+			ss[i].Proc = process.NewWithStats(e.c.proc)
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -1054,7 +1056,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: &oplus.Argument{Typ: arg.Typ},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1093,7 +1095,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1180,7 +1182,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: &merge.Argument{},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1223,7 +1225,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeOrder(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1262,7 +1264,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeDedup(),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1301,7 +1303,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeLimit(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1340,7 +1342,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeOffset(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1508,7 +1510,7 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructCAQUntransform(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1631,7 +1633,7 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+			ss[i].Proc = process.NewWithStats(e.c.proc)
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -1662,7 +1664,7 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructBareTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
@@ -1751,7 +1753,7 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+			ss[i].Proc = process.NewWithStats(e.c.proc)
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -1782,7 +1784,7 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructCAQTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewWithStats(e.c.proc)
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
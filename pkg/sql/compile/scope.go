@@ -822,11 +822,18 @@ func (s *Scope) RunQ(e engine.Engine) error {
 						Limit: arg.Limit,
 					},
 				}
+				// the splits stop reading blocks once they have returned
+				// the tuples of the limit together
+				scan := engine.NewScanLimit(arg.Limit)
 				for i := range ss {
+					if r, ok := ss[i].DataSource.R.(engine.LimitedReader); ok {
+						r.SetLimit(scan)
+					}
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 						Op: vm.Limit,
 						Arg: &limit.Argument{
 							Limit: arg.Limit,
+							Scan:  scan,
 						},
 					})
 				}
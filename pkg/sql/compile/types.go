@@ -95,6 +95,12 @@ type Col struct {
 	Name string
 }
 
+// HiddenColumnsOption is implemented by the callers of Compile which may ask
+// select * to also project the hidden columns, e.g. the frontend session
+type HiddenColumnsOption interface {
+	ShowHiddenColumns() bool
+}
+
 // Exec stores all information related to the execution phase of a single sql.
 type Exec struct {
 	//err stores err information if error occurred during execution.
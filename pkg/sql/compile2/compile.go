@@ -15,17 +15,14 @@
 package compile2
 
 import (
+	"sync/atomic"
+
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
 )
 
-// InitAddress is used to set address of local node
-func InitAddress(addr string) {
-	Address = addr
-}
-
 // New is used to new an object of compile
 func New(db string, sql string, uid string,
 	e engine.Engine, proc *process.Process) *compile {
@@ -38,17 +35,35 @@ func New(db string, sql string, uid string,
 	}
 }
 
+// SetConnectionID sets the id of the connection issuing the statements,
+// whose queries are killed with it.
+func (c *compile) SetConnectionID(id uint32) *compile {
+	c.connId = id
+	return c
+}
+
+// SetResourceGroup sets the resource group of the session issuing the
+// statements, which overrides the one of the user if it is known.
+func (c *compile) SetResourceGroup(name string) *compile {
+	c.group = name
+	return c
+}
+
 // Build generates query execution list based on the result of sql parser.
 func (c *compile) Build() ([]*Exec, error) {
 	stmts, err := parsers.Parse(dialect.MYSQL, c.sql)
 	if err != nil {
 		return nil, err
 	}
+	g := c.resourceGroup()
+	c.setMemoryQuota(g)
 	es := make([]*Exec, len(stmts))
 	for i := range stmts {
 		es[i] = &Exec{
-			c:    c,
-			stmt: stmts[i],
+			c:     c,
+			id:    atomic.AddUint64(&queryId, 1),
+			group: g,
+			stmt:  stmts[i],
 		}
 	}
 	return es, nil
@@ -0,0 +1,207 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"sync"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// cteResult is the result of a materialized common table expression. It is
+// written once by the scope of its step and read by the scopes of its
+// scans, the last of which frees it.
+type cteResult struct {
+	sync.Mutex
+	// done is closed once the result is written.
+	done chan struct{}
+	err  error
+	bats []*batch.Batch
+	mp   *mheap.Mheap
+	// refs is the number of scans not finished.
+	refs int
+}
+
+func newCteResult(refs int) *cteResult {
+	return &cteResult{
+		done: make(chan struct{}),
+		refs: refs,
+	}
+}
+
+// compileQuery compiles the steps of the materialized common table
+// expressions of qry ahead of the last step, which reads them by its scans
+func (e *Exec) compileQuery(pn *plan.Plan, qry *plan.Query) (*Scope, error) {
+	if len(qry.Steps) == 0 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' has no step", pn))
+	}
+	refs := make(map[int32]int)
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_CTE_SCAN {
+			refs[node.SourceStep]++
+		}
+	}
+	ctes := make(map[int32]*cteResult)
+	var pre []*Scope
+	last := len(qry.Steps) - 1
+	for _, step := range qry.Steps[:last] {
+		node := qry.Nodes[step]
+		if node.NodeType != plan.Node_MATERIAL || len(node.Children) != 1 {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("step '%v' not support now", node.NodeType))
+		}
+		ctes[step] = newCteResult(refs[step])
+		body, err := e.compileNode(pn, qry, node.Children[0], ctes)
+		if err != nil {
+			return nil, err
+		}
+		pre = append(pre, &Scope{
+			Magic:     Material,
+			Plan:      pn,
+			Cte:       ctes[step],
+			PreScopes: []*Scope{body},
+		})
+	}
+	s, err := e.compileNode(pn, qry, qry.Steps[last], ctes)
+	if err != nil {
+		return nil, err
+	}
+	s.PreScopes = append(pre, s.PreScopes...)
+	return s, nil
+}
+
+// compileNode compiles the plan node id, whose rows are sent to the Reg of
+// its scope
+func (e *Exec) compileNode(pn *plan.Plan, qry *plan.Query, id int32, ctes map[int32]*cteResult) (*Scope, error) {
+	node := qry.Nodes[id]
+	switch node.NodeType {
+	case plan.Node_CTE_SCAN:
+		r, ok := ctes[node.SourceStep]
+		if !ok {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("cte '%s' is not materialized", node.TableDef.GetName()))
+		}
+		return &Scope{
+			Magic: CteScan,
+			Plan:  pn,
+			Cte:   r,
+		}, nil
+	case plan.Node_TABLE_SCAN:
+		return e.compileTableScan(pn, node)
+	case plan.Node_GATHER, plan.Node_BROADCAST, plan.Node_SPLIT:
+		return e.compileExchange(pn, node)
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("node '%v' not support now", node.NodeType))
+}
+
+// Materialize stores the batches received by the scope of a materialized
+// common table expression for its scans.
+func (s *Scope) Materialize(proc *process.Process) error {
+	r := s.Cte
+	var err error
+	for _, reg := range proc.Reg.MergeReceivers {
+		for {
+			var bat *batch.Batch
+			if bat, err = process.Receive(proc, reg); err != nil || bat == nil {
+				break
+			}
+			if len(bat.Zs) == 0 {
+				batch.Clean(bat, proc.Mp)
+				continue
+			}
+			r.bats = append(r.bats, bat)
+		}
+		if err != nil {
+			break
+		}
+	}
+	r.Lock()
+	r.err, r.mp = err, proc.Mp
+	if r.refs == 0 {
+		r.free()
+	}
+	r.Unlock()
+	close(r.done)
+	return err
+}
+
+// ScanCte sends copies of the batches of the materialized common table
+// expression to the Reg of the scope once they are stored.
+func (s *Scope) ScanCte(proc *process.Process) error {
+	r := s.Cte
+	select {
+	case <-s.Reg.Ctx.Done():
+		r.release()
+		return nil
+	case <-r.done:
+	}
+	defer r.release()
+	if r.err != nil {
+		return r.err
+	}
+	for _, bat := range r.bats {
+		cp, err := dupBatch(bat, proc.Mp)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-s.Reg.Ctx.Done():
+			batch.Clean(cp, proc.Mp)
+			return nil
+		case s.Reg.Ch <- cp:
+		}
+	}
+	select {
+	case <-s.Reg.Ctx.Done():
+	case s.Reg.Ch <- nil:
+	}
+	return nil
+}
+
+// release frees the result after the last scan.
+func (r *cteResult) release() {
+	r.Lock()
+	defer r.Unlock()
+	if r.refs--; r.refs == 0 && r.mp != nil {
+		r.free()
+	}
+}
+
+func (r *cteResult) free() {
+	for _, bat := range r.bats {
+		batch.Clean(bat, r.mp)
+	}
+	r.bats = nil
+}
+
+func dupBatch(bat *batch.Batch, mp *mheap.Mheap) (*batch.Batch, error) {
+	cp := batch.New(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		v, err := vector.Dup(vec, mp)
+		if err != nil {
+			batch.Clean(cp, mp)
+			return nil, err
+		}
+		cp.Vecs[i] = v
+	}
+	cp.Zs = append([]int64{}, bat.Zs...)
+	cp.Sels = append([]int64(nil), bat.Sels...)
+	return cp, nil
+}
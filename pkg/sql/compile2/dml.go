@@ -0,0 +1,661 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	obatch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// CompilePlan compiles the plan pn of the statement, which Run executes.
+// The rows of an INSERT, DELETE or UPDATE are written in the transaction of
+// the snapshot of the process, committed or rolled back by the caller, and
+// their number is returned by GetAffectedRows.
+func (e *Exec) CompilePlan(pn *plan.Plan) error {
+	s, err := e.compileScope(pn)
+	if err != nil {
+		return err
+	}
+	e.scope = s
+	return nil
+}
+
+// compileDML compiles the INSERT, DELETE or UPDATE node of the single step
+// of qry into a scope writing its table. The rows deleted and updated are
+// read by a table scan with their hidden keys, and the filters, the offset
+// and the limit of the scan are compiled into the instructions the scope
+// runs on them.
+func (e *Exec) compileDML(pn *plan.Plan, qry *plan.Query) (*Scope, error) {
+	if len(qry.Steps) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("%v of %v steps not support now", qry.StmtType, len(qry.Steps)))
+	}
+	node := qry.Nodes[qry.Steps[0]]
+	if node.ObjRef == nil || node.TableDef == nil || len(node.Children) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("%v node '%v' has no table", node.NodeType, node.NodeId))
+	}
+	child := qry.Nodes[node.Children[0]]
+	tgt := &Target{
+		SchemaName:   node.ObjRef.DbName,
+		RelationName: node.ObjRef.ObjName,
+		Attributes:   make([]string, len(node.TableDef.Cols)),
+	}
+	for i, col := range node.TableDef.Cols {
+		tgt.Attributes[i] = col.Name
+	}
+	s := &Scope{
+		Plan:   pn,
+		Idx:    int(node.NodeId),
+		Target: tgt,
+	}
+	switch node.NodeType {
+	case plan.Node_INSERT:
+		s.Magic = Insert
+		if child.NodeType == plan.Node_VALUE_SCAN {
+			tgt.Values = child.RowsetData
+			return s, nil
+		}
+		if len(child.ProjectList) != len(tgt.Attributes) {
+			return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("the %v columns inserted into '%s' have %v columns", len(child.ProjectList), tgt.RelationName, len(tgt.Attributes)))
+		}
+		pre, err := e.compileNode(pn, qry, child.NodeId, nil)
+		if err != nil {
+			return nil, err
+		}
+		s.PreScopes = []*Scope{pre}
+		return s, nil
+	case plan.Node_DELETE:
+		s.Magic = Delete
+	case plan.Node_UPDATE:
+		s.Magic = Update
+		if node.UpdateList == nil {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("update of '%s' sets no column", tgt.RelationName))
+		}
+	default:
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("node '%v' not support now", node.NodeType))
+	}
+	if child.NodeType != plan.Node_TABLE_SCAN {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("%v of the rows of '%v' not support now", qry.StmtType, child.NodeType))
+	}
+	rel, err := e.openTarget(tgt)
+	if err != nil {
+		return nil, err
+	}
+	defer rel.Close(e.c.proc.Snapshot)
+	r, ok := rel.(engine.HiddenKeyRelation)
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the rows of '%s' have no hidden key", tgt.RelationName))
+	}
+	typs, err := attributeTypes(rel, tgt.Attributes, e.c.proc.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	// The columns are read after the hidden keys for the filters and the
+	// values
+	tgt.Columns = append([]string{r.HiddenKey(e.c.proc.Snapshot)}, tgt.Attributes...)
+	tgt.builder = newExtendBuilder(tgt.Attributes, typs, e.c.proc.Mp)
+	if err = e.compileRowInstructions(s, child); err == nil && s.Magic == Update {
+		err = compileUpdateExtends(tgt, node.UpdateList, typs)
+	}
+	if err != nil {
+		tgt.builder.free()
+		return nil, err
+	}
+	s.PreScopes = []*Scope{e.compileBlockScan(pn, child, rel, tgt.Columns)}
+	return s, nil
+}
+
+// compileRowInstructions compiles the filters, the offset and the limit of
+// the scan node into the restrict, the offset and the limit run by the
+// Delete or Update scope s on the rows it receives.
+func (e *Exec) compileRowInstructions(s *Scope, node *plan.Node) error {
+	tgt := s.Target
+	cond, err := tgt.builder.buildCond(node.WhereList)
+	if err != nil {
+		return err
+	}
+	if cond != nil {
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op: vm.Restrict,
+			Arg: &restrict.Argument{
+				E:     cond,
+				Attrs: tgt.Columns,
+				Idx:   int(node.NodeId),
+			},
+		})
+	}
+	n, err := countValue(node.Offset, 0)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op:  vm.Offset,
+			Arg: &offset.Argument{Offset: uint64(n), Idx: int(node.NodeId)},
+		})
+	}
+	if n, err = countValue(node.Limit, -1); err != nil {
+		return err
+	}
+	if n >= 0 {
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op:  vm.Limit,
+			Arg: &limit.Argument{Limit: uint64(n), Idx: int(node.NodeId)},
+		})
+	}
+	return nil
+}
+
+// compileUpdateExtends compiles the values of the columns set by the Update
+// of tgt, typs are the types of its Attributes.
+func compileUpdateExtends(tgt *Target, list *plan.UpdateList, typs []types.Type) error {
+	for i, expr := range list.Columns {
+		col, ok := expr.Expr.(*plan.Expr_Col)
+		if !ok || i >= len(list.Values) || col.Col.ColPos < 0 || int(col.Col.ColPos) >= len(typs) {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("update of '%s' sets '%v'", tgt.RelationName, expr))
+		}
+		typ := typs[col.Col.ColPos]
+		ext, err := tgt.builder.build(list.Values[i], &typ)
+		if err != nil {
+			return err
+		}
+		switch typ.Oid {
+		case types.T_date, types.T_datetime, types.T_timestamp, types.T_decimal64, types.T_decimal128:
+			// The values of the other types are converted by castValue
+			if ext.ReturnType() != typ.Oid {
+				ext = castExtend(ext, typ)
+			}
+		}
+		tgt.UpdateAttributes = append(tgt.UpdateAttributes, col.Col.Name)
+		tgt.UpdateTypes = append(tgt.UpdateTypes, typ)
+		tgt.UpdateExtends = append(tgt.UpdateExtends, ext)
+	}
+	return nil
+}
+
+// attributeTypes returns the types of the columns attrs of rel.
+func attributeTypes(rel engine.Relation, attrs []string, snap engine.Snapshot) ([]types.Type, error) {
+	defs := make(map[string]types.Type)
+	for _, def := range rel.TableDefs(snap) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			defs[attr.Attr.Name] = attr.Attr.Type
+		}
+	}
+	typs := make([]types.Type, len(attrs))
+	for i, attr := range attrs {
+		typ, ok := defs[attr]
+		if !ok {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%s' not exist", attr))
+		}
+		typs[i] = typ
+	}
+	return typs, nil
+}
+
+// openTarget opens the table which tgt writes.
+func (e *Exec) openTarget(tgt *Target) (engine.Relation, error) {
+	db, err := e.c.e.Database(tgt.SchemaName, e.c.proc.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(tgt.RelationName, e.c.proc.Snapshot)
+}
+
+// countValue returns the constant count of the OFFSET or the LIMIT expr, or
+// def if it is nil.
+func countValue(expr *plan.Expr, def int64) (int64, error) {
+	if expr == nil {
+		return def, nil
+	}
+	if c, ok := expr.Expr.(*plan.Expr_C); ok && !c.C.Isnull {
+		if v, ok := c.C.Value.(*plan.Const_Ival); ok && v.Ival >= 0 {
+			return v.Ival, nil
+		}
+	}
+	return 0, errors.New(errno.InvalidOptionValue, fmt.Sprintf("count '%v' is not a constant integer", expr))
+}
+
+// runDML runs the Insert, Delete or Update scope of the statement, and sets
+// the number of the rows written as the affected rows.
+func (e *Exec) runDML(ts uint64) error {
+	s, proc := e.scope, e.c.proc
+	tgt := s.Target
+	rel, err := e.openTarget(tgt)
+	if err != nil {
+		return err
+	}
+	defer rel.Close(proc.Snapshot)
+	if s.Magic == Insert && len(s.PreScopes) == 0 {
+		bat, err := rowsetBatch(tgt.Values, tgt.Attributes, rel, proc.Snapshot, proc.Settings.Strict())
+		if err != nil {
+			return err
+		}
+		if err = rel.Write(ts, bat, proc.Snapshot); err != nil {
+			return err
+		}
+		e.setAffectedRows(uint64(vector.Length(bat.Vecs[0])))
+		return nil
+	}
+	if tgt.builder != nil {
+		defer tgt.builder.free()
+	}
+	if err = e.prepareInstructions(s.Instructions); err != nil {
+		return err
+	}
+	defer e.endInstructions(s.Instructions)
+	var write func(*batch.Batch) (uint64, error)
+	switch s.Magic {
+	case Insert:
+		write = func(bat *batch.Batch) (uint64, error) {
+			obat := obatch.New(true, tgt.Attributes)
+			obat.Vecs = bat.Vecs
+			return uint64(len(bat.Zs)), rel.Write(ts, obat, proc.Snapshot)
+		}
+	case Delete:
+		r := rel.(engine.HiddenKeyRelation)
+		write = func(bat *batch.Batch) (uint64, error) {
+			return uint64(len(bat.Zs)), r.DeleteByHiddenKeys(bat.Vecs[0], proc.Snapshot)
+		}
+	case Update:
+		r := rel.(engine.HiddenKeyRelation)
+		strict := proc.Settings.Strict()
+		write = func(bat *batch.Batch) (uint64, error) {
+			return uint64(len(bat.Zs)), e.updateRows(r, bat, strict)
+		}
+	}
+	anal := process.GetAnalyze(proc, s.Idx)
+	n, err := e.receiveRows(s.PreScopes[0], func(bat *batch.Batch) (uint64, bool, error) {
+		rbat, done, err := e.runInstructions(s.Instructions, bat)
+		if err != nil || rbat == nil {
+			return 0, done, err
+		}
+		defer batch.Clean(rbat, proc.Mp)
+		if len(rbat.Zs) == 0 {
+			return 0, done, nil
+		}
+		anal.Start()
+		defer anal.Stop()
+		anal.Input(rbat)
+		n, err := write(rbat)
+		return n, done, err
+	})
+	if err != nil {
+		return err
+	}
+	e.setAffectedRows(n)
+	return nil
+}
+
+// updateRows sets the columns of the rows of bat updated by the Update scope
+// to the values evaluated on them.
+func (e *Exec) updateRows(r engine.HiddenKeyRelation, bat *batch.Batch, strict bool) error {
+	tgt := e.scope.Target
+	proc := tgt.builder.proc
+	obat := &obatch.Batch{Vecs: bat.Vecs, Zs: bat.Zs}
+	vecs := make([]*vector.Vector, 0, len(tgt.UpdateExtends))
+	defer func() {
+		for _, vec := range vecs {
+			putVector(proc, vec, obat)
+		}
+	}()
+	for _, ext := range tgt.UpdateExtends {
+		vec, err := evalExtend(ext, tgt.Columns, obat, proc)
+		if err != nil {
+			return err
+		}
+		vecs = append(vecs, vec)
+	}
+	keys := bat.Vecs[0].Col.(*types.Bytes)
+	vals := make([]interface{}, len(vecs))
+	for row := range bat.Zs {
+		for i, vec := range vecs {
+			var err error
+
+			if vals[i], err = updateValue(vec, row, tgt.UpdateTypes[i], strict); err != nil {
+				return err
+			}
+		}
+		if err := r.UpdateByHiddenKey(keys.Get(int64(row)), tgt.UpdateAttributes, vals, e.c.proc.Snapshot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// receiveRows runs the scope pre, calls write with each batch of its rows,
+// which write cleans, and returns the number of the rows written. The scope
+// is stopped once write fails or needs no more rows.
+func (e *Exec) receiveRows(pre *Scope, write func(*batch.Batch) (uint64, bool, error)) (uint64, error) {
+	proc := e.c.proc
+	ctx, cancel := context.WithCancel(proc.Ctx)
+	defer cancel()
+	ch := make(chan *batch.Batch, 1)
+	pre.Reg = &process.WaitRegister{Ctx: ctx, Ch: ch}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- e.runScope(pre)
+	}()
+	var n uint64
+	var finished bool
+	var err, werr error
+	consume := func(bat *batch.Batch) {
+		if bat == nil {
+			return
+		}
+		if finished || werr != nil || len(bat.Zs) == 0 {
+			batch.Clean(bat, proc.Mp)
+			return
+		}
+		m, done, err := write(bat)
+		if err != nil {
+			werr = err
+			cancel()
+			return
+		}
+		n += m
+		if done {
+			finished = true
+			cancel()
+		}
+	}
+	for running := true; running; {
+		select {
+		case bat := <-ch:
+			consume(bat)
+		case err = <-errs:
+			running = false
+		}
+	}
+	// The rows sent before the end of the scope
+	for len(ch) > 0 {
+		consume(<-ch)
+	}
+	if werr != nil {
+		return 0, werr
+	}
+	if finished {
+		// The scope is stopped by the cancel
+		return n, nil
+	}
+	return n, err
+}
+
+// rowsetBatch returns the batch of the rows of the values of an INSERT with
+// all the columns attrs of rel, the ones not given are null but the primary
+// key. The values out of the ranges of the columns fail the INSERT if strict,
+// or are adjusted to them.
+func rowsetBatch(rows *plan.RowsetData, attrs []string, rel engine.Relation, snap engine.Snapshot, strict bool) (*obatch.Batch, error) {
+	defs := make(map[string]*engine.AttributeDef)
+	for _, def := range rel.TableDefs(snap) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			defs[attr.Attr.Name] = attr
+		}
+	}
+	cols := make(map[string]*plan.ColData)
+	for i, col := range rows.Schema.Cols {
+		cols[col.Name] = rows.Cols[i]
+	}
+	n := 0
+	if len(rows.Cols) > 0 {
+		n = int(rows.Cols[0].RowCount)
+	}
+	bat := obatch.New(true, attrs)
+	for i, attr := range attrs {
+		def, ok := defs[attr]
+		if !ok {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%s' not exist", attr))
+		}
+		vec := vector.New(def.Attr.Type)
+		col := cols[attr]
+		for row := 0; row < n; row++ {
+			v, null := rowsetValue(col, row, def.Attr.Type)
+			if null && def.Attr.Primary {
+				return nil, errors.New(errno.IntegrityConstraintViolation, fmt.Sprintf("primary key '%s' cannot be null", attr))
+			}
+			if null {
+				nulls.Add(vec.Nsp, uint64(row))
+			}
+			v, err := castValue(v, def.Attr.Type, strict)
+			if err != nil {
+				return nil, err
+			}
+			if err = appendValue(vec, v); err != nil {
+				return nil, err
+			}
+		}
+		bat.Vecs[i] = vec
+	}
+	return bat, nil
+}
+
+// rowsetValue returns the value of the row of col as an int64, a float64 or
+// a string, and whether it is null. A missing column is null.
+func rowsetValue(col *plan.ColData, row int, typ types.Type) (interface{}, bool) {
+	zero := interface{}(int64(0))
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		zero = ""
+	}
+	if col == nil || (row < len(col.Nulls) && col.Nulls[row]) {
+		return zero, true
+	}
+	switch {
+	case row < len(col.I32):
+		return int64(col.I32[row]), false
+	case row < len(col.I64):
+		return col.I64[row], false
+	case row < len(col.F32):
+		return float64(col.F32[row]), false
+	case row < len(col.F64):
+		return col.F64[row], false
+	case row < len(col.S):
+		return col.S[row], false
+	}
+	return zero, true
+}
+
+// constValue returns the value of the constant expr set to a column of the
+// type typ, a cast of the constant is done by castValue.
+func constValue(expr *plan.Expr, typ types.Type, strict bool) (interface{}, error) {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && strings.EqualFold(f.F.Func.GetObjName(), "cast") && len(f.F.Args) == 1 {
+		expr = f.F.Args[0]
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("update value '%v' not support now", expr))
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_Ival:
+		return castValue(v.Ival, typ, strict)
+	case *plan.Const_Dval:
+		return castValue(v.Dval, typ, strict)
+	case *plan.Const_Sval:
+		return castValue(v.Sval, typ, strict)
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("update value '%v' not support now", expr))
+}
+
+// updateValue returns the value of the row of vec set to a column of the
+// type typ. A value of another type is cast by castValue.
+func updateValue(vec *vector.Vector, row int, typ types.Type, strict bool) (interface{}, error) {
+	v := rowValue(vec, row)
+	if v == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "update value NULL not support now")
+	}
+	switch {
+	case vec.Typ.Oid != typ.Oid:
+	case typ.Oid == types.T_char, typ.Oid == types.T_varchar:
+		// The width is checked
+	default:
+		return v, nil
+	}
+	return castValue(scalarValue(v), typ, strict)
+}
+
+func exprType(expr *plan.Expr) types.Type {
+	typ := types.T(expr.Typ.GetId()).ToType()
+	typ.Width = expr.Typ.GetWidth()
+	typ.Precision = expr.Typ.GetPrecision()
+	return typ
+}
+
+// castValue converts the int64, float64 or string v, or a uint64 out of the
+// range of int64, to the Go value of the type typ. A value out of the range of typ or a string longer than its
+// width is an error if strict, or it is clamped or truncated.
+func castValue(v interface{}, typ types.Type, strict bool) (interface{}, error) {
+	switch v := v.(type) {
+	case uint64:
+		// Out of the range of int64
+		switch typ.Oid {
+		case types.T_uint64:
+			return v, nil
+		case types.T_float32, types.T_float64:
+			return castValue(float64(v), typ, strict)
+		}
+		if _, _, ok := intRange(typ.Oid); ok || typ.Oid == types.T_int64 {
+			if strict {
+				return nil, errors.New(errno.DataException, fmt.Sprintf("out of range value %v for %s", v, typ))
+			}
+			return castValue(int64(math.MaxInt64), typ, strict)
+		}
+	case int64:
+		if lo, hi, ok := intRange(typ.Oid); ok && (v < lo || v > hi) {
+			if strict {
+				return nil, errors.New(errno.DataException, fmt.Sprintf("out of range value %v for %s", v, typ))
+			}
+			if v < lo {
+				v = lo
+			} else {
+				v = hi
+			}
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			return int8(v), nil
+		case types.T_int16:
+			return int16(v), nil
+		case types.T_int32:
+			return int32(v), nil
+		case types.T_int64:
+			return v, nil
+		case types.T_uint8:
+			return uint8(v), nil
+		case types.T_uint16:
+			return uint16(v), nil
+		case types.T_uint32:
+			return uint32(v), nil
+		case types.T_uint64:
+			return uint64(v), nil
+		case types.T_float32:
+			return float32(v), nil
+		case types.T_float64:
+			return float64(v), nil
+		}
+	case float64:
+		switch typ.Oid {
+		case types.T_float32:
+			if math.Abs(v) > math.MaxFloat32 {
+				if strict {
+					return nil, errors.New(errno.DataException, fmt.Sprintf("out of range value %v for %s", v, typ))
+				}
+				v = math.Copysign(math.MaxFloat32, v)
+			}
+			return float32(v), nil
+		case types.T_float64:
+			return v, nil
+		}
+	case string:
+		switch typ.Oid {
+		case types.T_char, types.T_varchar:
+			if rs := []rune(v); typ.Width > 0 && len(rs) > int(typ.Width) {
+				if strict {
+					return nil, errors.New(errno.DataException, fmt.Sprintf("data too long for %s: '%s'", typ, v))
+				}
+				v = string(rs[:typ.Width])
+			}
+			return []byte(v), nil
+		case types.T_json:
+			return []byte(v), nil
+		}
+	}
+	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("can not cast '%v' as %s", v, typ))
+}
+
+// intRange returns the range of the integer type oid, which is false if it
+// is not an integer type or its range covers the int64 values.
+func intRange(oid types.T) (int64, int64, bool) {
+	switch oid {
+	case types.T_int8:
+		return math.MinInt8, math.MaxInt8, true
+	case types.T_int16:
+		return math.MinInt16, math.MaxInt16, true
+	case types.T_int32:
+		return math.MinInt32, math.MaxInt32, true
+	case types.T_uint8:
+		return 0, math.MaxUint8, true
+	case types.T_uint16:
+		return 0, math.MaxUint16, true
+	case types.T_uint32:
+		return 0, math.MaxUint32, true
+	case types.T_uint64:
+		return 0, math.MaxInt64, true
+	}
+	return 0, 0, false
+}
+
+// appendValue appends the value v of castValue to vec.
+func appendValue(vec *vector.Vector, v interface{}) error {
+	switch v := v.(type) {
+	case int8:
+		return vector.Append(vec, []int8{v})
+	case int16:
+		return vector.Append(vec, []int16{v})
+	case int32:
+		return vector.Append(vec, []int32{v})
+	case int64:
+		return vector.Append(vec, []int64{v})
+	case uint8:
+		return vector.Append(vec, []uint8{v})
+	case uint16:
+		return vector.Append(vec, []uint16{v})
+	case uint32:
+		return vector.Append(vec, []uint32{v})
+	case uint64:
+		return vector.Append(vec, []uint64{v})
+	case float32:
+		return vector.Append(vec, []float32{v})
+	case float64:
+		return vector.Append(vec, []float64{v})
+	case []byte:
+		return vector.Append(vec, [][]byte{v})
+	}
+	return errors.New(errno.DatatypeMismatch, fmt.Sprintf("can not append '%v' to %s", v, vec.Typ))
+}
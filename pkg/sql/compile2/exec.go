@@ -16,12 +16,16 @@ package compile2
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
 )
 
 // Compile is the entrance of the compute-layer, it compiles AST tree to scope list.
@@ -41,21 +45,91 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
 func (e *Exec) Run(ts uint64) (err error) {
+	q := e.register()
 	defer func() {
 		if e := recover(); e != nil {
 			err = moerr.NewPanicError(e)
 		}
+		err = q.unregister(err)
 	}()
+	release, err := e.admit()
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	switch e.scope.Magic {
 	case Merge:
 		return nil
 	case CreateDatabase:
 		return e.scope.CreateDatabase(ts, e.c.proc.Snapshot, e.c.e)
+	case CreateTable:
+		return e.scope.CreateTable(ts, e.c.proc.Snapshot, e.c.e)
+	case Material:
+		return e.scope.Materialize(e.c.proc)
+	case CteScan:
+		return e.scope.ScanCte(e.c.proc)
+	case TableScan:
+		return e.scope.ReadBlocks(e.c.e, e.c.proc)
+	case Remote:
+		return e.scope.RemoteRun(e.c.e, e.c.proc)
+	case Insert, Delete, Update:
+		return e.runDML(ts)
 	}
 	return nil
 }
 
+// Analyze runs the query qry locally with the runtime statistics of its
+// nodes collected, which are filled in the nodes once it ends. The rows are
+// discarded, and the wall time of the run is returned.
+func (e *Exec) Analyze(qry *plan.Query) (d time.Duration, err error) {
+	q := e.register()
+	defer func() {
+		if e := recover(); e != nil {
+			err = moerr.NewPanicError(e)
+		}
+		err = q.unregister(err)
+	}()
+	release, err := e.admit()
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	proc := e.c.proc
+	proc.AnalInfos = process.NewAnalyzeInfos(len(qry.Nodes))
+	// The query is not cut into fragments, whose nodes are numbered again
+	s, err := e.compileQuery(&plan.Plan{Plan: &plan.Plan_Query{Query: qry}}, qry)
+	if err != nil {
+		return 0, err
+	}
+	ch := make(chan *batch.Batch, 1)
+	s.Reg = &process.WaitRegister{Ctx: proc.Ctx, Ch: ch}
+	start := time.Now()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- e.runScope(s)
+	}()
+	for running := true; running; {
+		select {
+		case bat := <-ch:
+			if bat != nil {
+				batch.Clean(bat, proc.Mp)
+			}
+		case err = <-errs:
+			running = false
+		}
+	}
+	for len(ch) > 0 {
+		if bat := <-ch; bat != nil {
+			batch.Clean(bat, proc.Mp)
+		}
+	}
+	d = time.Since(start)
+	process.FillAnalyzeInfo(qry, proc.AnalInfos)
+	return d, err
+}
+
 func (e *Exec) compileScope(pn *plan.Plan) (*Scope, error) {
 	switch qry := pn.Plan.(type) {
 	case *plan.Plan_Ddl:
@@ -65,11 +139,35 @@ func (e *Exec) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: CreateDatabase,
 				Plan:  pn,
 			}, nil
+		case plan.DataDefinition_CREATE_TABLE:
+			return &Scope{
+				Magic: CreateTable,
+				Plan:  pn,
+			}, nil
+		}
+	case *plan.Plan_Query:
+		switch qry.Query.StmtType {
+		case plan.Query_INSERT, plan.Query_DELETE, plan.Query_UPDATE:
+			return e.compileDML(pn, qry.Query)
+		}
+		frags, err := plan2.FragmentQuery(qry.Query)
+		if err != nil {
+			return nil, err
 		}
+		e.frag, e.frags = frags[0], make(map[int32]*plan.Fragment)
+		for _, frag := range frags {
+			e.frags[frag.Id] = frag
+		}
+		return e.compileQuery(pn, e.frag.Query)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", pn))
 }
 
+// QueryId returns the id of the query, which Kill cancels while it runs.
+func (e *Exec) QueryId() uint64 {
+	return e.id
+}
+
 func (e *Exec) Statement() tree.Statement {
 	return e.stmt
 }
@@ -0,0 +1,503 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	obatch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	oprocess "github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var binaryOps = map[string]int{
+	"AND":  overload.And,
+	"OR":   overload.Or,
+	"=":    overload.EQ,
+	"<>":   overload.NE,
+	"!=":   overload.NE,
+	"<":    overload.LT,
+	"<=":   overload.LE,
+	">":    overload.GT,
+	">=":   overload.GE,
+	"LIKE": overload.Like,
+	"+":    overload.Plus,
+	"-":    overload.Minus,
+	"*":    overload.Mult,
+	"/":    overload.Div,
+	"DIV":  overload.IntegerDiv,
+	"%":    overload.Mod,
+}
+
+// extendBuilder builds the extends evaluated by the operators of colexec2
+// from the expressions of the plan on the columns of a table, the ColPos of
+// a column is its index in attrs and typs.
+type extendBuilder struct {
+	attrs []string
+	typs  []types.Type
+	// proc evaluates the constants of the IN lists, whose vectors vecs are
+	// allocated in its heap.
+	proc *oprocess.Process
+	vecs []*vector.Vector
+}
+
+func newExtendBuilder(attrs []string, typs []types.Type, mp *mheap.Mheap) *extendBuilder {
+	return &extendBuilder{
+		attrs: attrs,
+		typs:  typs,
+		proc:  oprocess.New(mp),
+	}
+}
+
+// free frees the vectors of the IN lists once the extends are not evaluated
+// any more.
+func (b *extendBuilder) free() {
+	for _, vec := range b.vecs {
+		vector.Clean(vec, b.proc.Mp)
+	}
+	b.vecs = nil
+	freeRegisters(b.proc)
+}
+
+// buildCond returns the conjunction of the filters, nil if there is none.
+func (b *extendBuilder) buildCond(filters []*plan.Expr) (extend.Extend, error) {
+	var cond extend.Extend
+	for _, filter := range filters {
+		e, err := b.build(filter, nil)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.(*extend.ValueExtend); !ok || len(filters) > 1 {
+			// A constant alone is restricted as it is
+			e = logical(e)
+		}
+		if cond == nil {
+			cond = e
+		} else {
+			cond = &extend.BinaryExtend{Op: overload.And, Left: cond, Right: e}
+		}
+	}
+	return cond, nil
+}
+
+// build returns the extend of expr. A cast of expr to a decimal type of no
+// scale casts it to the type hint if it is a decimal column beside it.
+func (b *extendBuilder) build(expr *plan.Expr, hint *types.Type) (extend.Extend, error) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		return b.buildConst(e.C, exprType(expr))
+	case *plan.Expr_Col:
+		pos := int(e.Col.ColPos)
+		if pos < 0 || pos >= len(b.attrs) {
+			return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("column '%s' not exist", e.Col.Name))
+		}
+		typ := b.typs[pos].Oid
+		if typ == types.T_char {
+			typ = types.T_varchar
+		}
+		return &extend.Attribute{Name: b.attrs[pos], Type: typ}, nil
+	case *plan.Expr_F:
+		return b.buildFunc(strings.ToUpper(e.F.Func.GetObjName()), expr, e.F.Args, hint)
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("expression '%v' not support now", expr))
+}
+
+func (b *extendBuilder) buildFunc(name string, expr *plan.Expr, args []*plan.Expr, hint *types.Type) (extend.Extend, error) {
+	switch name {
+	case "IN":
+		if list, ok := args[len(args)-1].Expr.(*plan.Expr_List); ok && len(args) == 2 {
+			return b.buildIn(args[0], list.List.List)
+		}
+	case "NOT":
+		if len(args) == 1 {
+			e, err := b.build(args[0], nil)
+			if err != nil {
+				return nil, err
+			}
+			return negate(e)
+		}
+	case "IFNULL":
+		// IS NULL
+		if len(args) == 1 {
+			e, err := b.build(args[0], nil)
+			if err != nil {
+				return nil, err
+			}
+			return &extend.NullExtend{E: e}, nil
+		}
+	case "UNARY_PLUS":
+		if len(args) == 1 {
+			return b.build(args[0], hint)
+		}
+	case "UNARY_MINUS":
+		if len(args) == 1 {
+			e, err := b.build(args[0], hint)
+			if err != nil {
+				return nil, err
+			}
+			return &extend.UnaryExtend{Op: overload.UnaryMinus, E: e}, nil
+		}
+	case "CAST":
+		if len(args) == 1 {
+			typ := exprType(expr)
+			if hint != nil && isDecimal(typ.Oid) && isDecimal(hint.Oid) && typ.Width == 0 {
+				typ = *hint
+			}
+			if c, ok := args[0].Expr.(*plan.Expr_C); ok {
+				return b.buildConst(c.C, typ)
+			}
+			e, err := b.build(args[0], nil)
+			if err != nil {
+				return nil, err
+			}
+			return castExtend(e, typ), nil
+		}
+	default:
+		op, ok := binaryOps[name]
+		if !ok || len(args) != 2 {
+			break
+		}
+		left, err := b.build(args[0], b.colType(args[1]))
+		if err != nil {
+			return nil, err
+		}
+		right, err := b.build(args[1], b.colType(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		if op == overload.And || op == overload.Or {
+			left, right = logical(left), logical(right)
+		}
+		return &extend.BinaryExtend{Op: op, Left: left, Right: right}, nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("function '%s' of %v arguments not support now", name, len(args)))
+}
+
+// buildIn builds the IN of a list of constants, which are cast to the type
+// of the left side here, the type of the column if it is one. The left side
+// of a smaller integer type is cast to int64 if a constant is out of its
+// range, the negative constants are never equal to a uint64.
+func (b *extendBuilder) buildIn(left *plan.Expr, list []*plan.Expr) (extend.Extend, error) {
+	e, err := b.build(left, nil)
+	if err != nil {
+		return nil, err
+	}
+	typ := e.ReturnType().ToType()
+	if t := b.colType(left); t != nil {
+		typ = *t
+	}
+	items := make([]*plan.Expr, 0, len(list))
+	for _, item := range list {
+		c, ok := item.Expr.(*plan.Expr_C)
+		if !ok {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("IN list item '%v' not support now", item))
+		}
+		if v, ok := c.C.Value.(*plan.Const_Ival); ok && !c.C.Isnull {
+			if lo, hi, ok := intRange(typ.Oid); ok && (v.Ival < lo || v.Ival > hi) {
+				if typ.Oid == types.T_uint64 {
+					continue
+				}
+				typ = types.T_int64.ToType()
+				e = castExtend(e, typ)
+			}
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("IN list of negative constants of '%v' not support now", left))
+	}
+	vs := vector.New(typ)
+	b.vecs = append(b.vecs, vs)
+	for _, item := range items {
+		c := item.Expr.(*plan.Expr_C).C
+		if c.Isnull {
+			if err := vector.UnionNull(vs, vs, b.proc.Mp); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		ve, err := b.buildConst(c, typ)
+		if err != nil {
+			return nil, err
+		}
+		vec, _, err := ve.Eval(&obatch.Batch{}, b.proc)
+		if err != nil {
+			return nil, err
+		}
+		err = vector.UnionOne(vs, vec, 0, b.proc.Mp)
+		if vec.Ref == 0 {
+			oprocess.Put(b.proc, vec)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &extend.InExtend{E: e, Vs: vs}, nil
+}
+
+// buildConst returns the constant c of the type typ, a null is an int64.
+// The decimals are parsed at the scale of typ, the other types are cast.
+func (b *extendBuilder) buildConst(c *plan.Const, typ types.Type) (extend.Extend, error) {
+	vec := vector.New(types.T_int64.ToType())
+	vec.Ref = 1
+	var str string
+	switch v := c.Value.(type) {
+	case *plan.Const_Ival:
+		vec.Col = []int64{v.Ival}
+		str = strconv.FormatInt(v.Ival, 10)
+	case *plan.Const_Dval:
+		vec.Typ = types.T_float64.ToType()
+		vec.Col = []float64{v.Dval}
+		str = strconv.FormatFloat(v.Dval, 'f', -1, 64)
+	case *plan.Const_Sval:
+		vec.Typ = types.T_varchar.ToType()
+		vec.Col = &types.Bytes{
+			Data:    []byte(v.Sval),
+			Offsets: []uint32{0},
+			Lengths: []uint32{uint32(len(v.Sval))},
+		}
+		str = v.Sval
+	default:
+		if !c.Isnull {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("constant '%v' not support now", c))
+		}
+		vec.Col = []int64{0}
+	}
+	e := &extend.ValueExtend{V: vec}
+	if c.Isnull {
+		nulls.Add(vec.Nsp, 0)
+		return e, nil
+	}
+	switch typ.Oid {
+	case types.T_any, types.T_bool, vec.Typ.Oid:
+		return e, nil
+	case types.T_decimal64:
+		v, err := types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+		if err != nil {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("can not cast '%s' as %s", str, typ))
+		}
+		vec.Typ, vec.Col = typ, []types.Decimal64{v}
+		return e, nil
+	case types.T_decimal128:
+		v, err := types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+		if err != nil {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("can not cast '%s' as %s", str, typ))
+		}
+		vec.Typ, vec.Col = typ, []types.Decimal128{v}
+		return e, nil
+	case types.T_char, types.T_varchar:
+		if vec.Typ.Oid == types.T_varchar {
+			return e, nil
+		}
+	}
+	return castExtend(e, typ), nil
+}
+
+// colType returns the type of the column of the table expr is, nil if it
+// is not a column.
+func (b *extendBuilder) colType(expr *plan.Expr) *types.Type {
+	if e, ok := expr.Expr.(*plan.Expr_Col); ok {
+		if pos := int(e.Col.ColPos); pos >= 0 && pos < len(b.typs) {
+			return &b.typs[pos]
+		}
+	}
+	return nil
+}
+
+func castExtend(e extend.Extend, typ types.Type) extend.Extend {
+	return &extend.BinaryExtend{
+		Op:    overload.Typecast,
+		Left:  e,
+		Right: &extend.ValueExtend{V: vector.New(typ)},
+	}
+}
+
+// logical returns e if it is logical, or e <> 0 which is true if e is
+// neither null nor zero.
+func logical(e extend.Extend) extend.Extend {
+	if e.IsLogical() {
+		return e
+	}
+	return &extend.BinaryExtend{Op: overload.NE, Left: e, Right: zeroExtend()}
+}
+
+// negate returns the extend true if e is false, both are not true if e is
+// null.
+func negate(e extend.Extend) (extend.Extend, error) {
+	switch v := e.(type) {
+	case *extend.InExtend:
+		return &extend.InExtend{Not: !v.Not, E: v.E, Vs: v.Vs}, nil
+	case *extend.NullExtend:
+		return &extend.NullExtend{Not: !v.Not, E: v.E}, nil
+	case *extend.BinaryExtend:
+		op, ok := overload.NegOps[v.Op]
+		switch {
+		case !ok:
+		case v.Op == overload.And || v.Op == overload.Or:
+			left, err := negate(v.Left)
+			if err != nil {
+				return nil, err
+			}
+			right, err := negate(v.Right)
+			if err != nil {
+				return nil, err
+			}
+			return &extend.BinaryExtend{Op: op, Left: left, Right: right}, nil
+		case v.Op == overload.Like:
+			// notLike has no overload yet
+		default:
+			return &extend.BinaryExtend{Op: op, Left: v.Left, Right: v.Right}, nil
+		}
+	}
+	if e.IsLogical() {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'not %s' not support now", e))
+	}
+	return &extend.BinaryExtend{Op: overload.EQ, Left: e, Right: zeroExtend()}, nil
+}
+
+func zeroExtend() extend.Extend {
+	vec := vector.New(types.T_int64.ToType())
+	vec.Ref = 1
+	vec.Col = []int64{0}
+	return &extend.ValueExtend{V: vec}
+}
+
+func isDecimal(oid types.T) bool {
+	return oid == types.T_decimal64 || oid == types.T_decimal128
+}
+
+// evalExtend evaluates e on the columns of bat named attrs. The vectors of
+// bat are kept from being reused or freed by the evaluator, the one returned
+// is put back to proc by putVector once used.
+func evalExtend(e extend.Extend, attrs []string, bat *obatch.Batch, proc *oprocess.Process) (*vector.Vector, error) {
+	refs := make([]uint64, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		refs[i], vec.Ref = vec.Ref, 2
+	}
+	defer func() {
+		for i, vec := range bat.Vecs {
+			vec.Ref = refs[i]
+		}
+	}()
+	bat.Attrs = attrs
+	vec, _, err := e.Eval(bat, proc)
+	return vec, err
+}
+
+// putVector puts vec evaluated by evalExtend back to proc unless it is a
+// column or a constant.
+func putVector(proc *oprocess.Process, vec *vector.Vector, bat *obatch.Batch) {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return
+		}
+	}
+	if vec.Ref == 0 {
+		oprocess.Put(proc, vec)
+	}
+}
+
+// freeRegisters frees the vectors kept by the evaluator proc.
+func freeRegisters(proc *oprocess.Process) {
+	for _, vec := range proc.Reg.Vecs {
+		vector.Clean(vec, proc.Mp)
+	}
+	proc.Reg.Vecs = proc.Reg.Vecs[:0]
+}
+
+// rowValue returns the Go value of the row of vec, nil if it is null. The
+// row of a constant is its only one.
+func rowValue(vec *vector.Vector, row int) interface{} {
+	if vector.Length(vec) == 1 {
+		row = 0
+	}
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil
+	}
+	switch col := vec.Col.(type) {
+	case []int8:
+		return col[row]
+	case []int16:
+		return col[row]
+	case []int32:
+		return col[row]
+	case []int64:
+		return col[row]
+	case []uint8:
+		return col[row]
+	case []uint16:
+		return col[row]
+	case []uint32:
+		return col[row]
+	case []uint64:
+		return col[row]
+	case []float32:
+		return col[row]
+	case []float64:
+		return col[row]
+	case []types.Decimal64:
+		return col[row]
+	case []types.Decimal128:
+		return col[row]
+	case []types.Date:
+		return col[row]
+	case []types.Datetime:
+		return col[row]
+	case []types.Timestamp:
+		return col[row]
+	case *types.Bytes:
+		return append([]byte{}, col.Get(int64(row))...)
+	}
+	return nil
+}
+
+// scalarValue returns v of rowValue as an int64, a float64 or a string for
+// castValue. A uint64 out of the range of int64 is kept as it is.
+func scalarValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v)
+		}
+	case float32:
+		return float64(v)
+	case []byte:
+		return string(v)
+	}
+	return v
+}
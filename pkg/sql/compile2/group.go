@@ -0,0 +1,288 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+)
+
+// DefaultResourceGroup is the group of the queries of the users and the
+// sessions assigned to no group, it runs them all at once.
+const DefaultResourceGroup = "default"
+
+// ResourceGroup is the resources shared by the queries of the users and the
+// sessions assigned to it.
+type ResourceGroup struct {
+	Name string
+	// MaxConcurrency, the number of the queries of the group running at a
+	// time, the others wait in the admission queue of the group. It is
+	// unlimited if not positive.
+	MaxConcurrency int
+	// MemoryShare, the percentage of the memory of the host which a query
+	// of the group may use at most, its memory quota is not lowered if it
+	// is not positive.
+	MemoryShare int
+	// CPUWeight, the weight of the group in the CPUs shared by the groups
+	// running queries, which caps the degree of parallelism of the scans.
+	// It is 1 if not positive.
+	CPUWeight int
+	// QueueTimeout, how long a query waits for its admission at most, it
+	// waits until it is killed if 0.
+	QueueTimeout time.Duration
+}
+
+// group is a resource group registered, the queries hold a slot of sem
+// while they run.
+type group struct {
+	ResourceGroup
+	sem     chan struct{}
+	running int32
+	queued  int32
+}
+
+// ResourceGroupStats is the state of the queries of a resource group.
+type ResourceGroupStats struct {
+	ResourceGroup
+	Running int
+	Queued  int
+}
+
+// groups is the registry of the resource groups indexed by their names, and
+// the groups of the users.
+var groups = struct {
+	sync.Mutex
+	m     map[string]*group
+	users map[string]string
+}{
+	m:     map[string]*group{DefaultResourceGroup: newGroup(ResourceGroup{Name: DefaultResourceGroup})},
+	users: make(map[string]string),
+}
+
+func newGroup(rg ResourceGroup) *group {
+	g := &group{ResourceGroup: rg}
+	if rg.MaxConcurrency > 0 {
+		g.sem = make(chan struct{}, rg.MaxConcurrency)
+	}
+	return g
+}
+
+// CreateResourceGroup registers the resource group rg. A group replaced keeps
+// limiting the queries built in it before.
+func CreateResourceGroup(rg ResourceGroup, replace bool) error {
+	if rg.Name == "" {
+		return errors.New(errno.InvalidOptionValue, "resource group needs a name")
+	}
+	if rg.MemoryShare > 100 {
+		return errors.New(errno.InvalidOptionValue, fmt.Sprintf("memory share %v%% of resource group %s is over 100%%", rg.MemoryShare, rg.Name))
+	}
+	groups.Lock()
+	defer groups.Unlock()
+	if _, ok := groups.m[rg.Name]; ok && !replace {
+		return errors.New(errno.DuplicateObject, fmt.Sprintf("resource group %s already exists", rg.Name))
+	}
+	groups.m[rg.Name] = newGroup(rg)
+	return nil
+}
+
+// DropResourceGroup removes the resource group name, whose users are moved to
+// the default group. The queries built in it keep being limited by it.
+func DropResourceGroup(name string) error {
+	if name == DefaultResourceGroup {
+		return errors.New(errno.InvalidOptionValue, "the default resource group can not be dropped")
+	}
+	groups.Lock()
+	defer groups.Unlock()
+	if _, ok := groups.m[name]; !ok {
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("unknown resource group %s", name))
+	}
+	delete(groups.m, name)
+	for user, g := range groups.users {
+		if g == name {
+			delete(groups.users, user)
+		}
+	}
+	return nil
+}
+
+// AssignResourceGroup assigns the user to the resource group name, or to the
+// default group if name is empty. The group of a session overrides it.
+func AssignResourceGroup(user, name string) error {
+	groups.Lock()
+	defer groups.Unlock()
+	if name == "" || name == DefaultResourceGroup {
+		delete(groups.users, user)
+		return nil
+	}
+	if _, ok := groups.m[name]; !ok {
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("unknown resource group %s", name))
+	}
+	groups.users[user] = name
+	return nil
+}
+
+// UserResourceGroup returns the resource group of the user, the default group
+// if it is assigned to none.
+func UserResourceGroup(user string) string {
+	groups.Lock()
+	defer groups.Unlock()
+	if name, ok := groups.users[user]; ok {
+		return name
+	}
+	return DefaultResourceGroup
+}
+
+// GetResourceGroup returns the resource group name, and false if it is
+// unknown.
+func GetResourceGroup(name string) (ResourceGroup, bool) {
+	groups.Lock()
+	defer groups.Unlock()
+	g, ok := groups.m[name]
+	if !ok {
+		return ResourceGroup{}, false
+	}
+	return g.ResourceGroup, true
+}
+
+// ResourceGroups returns the state of the resource groups ordered by their
+// names.
+func ResourceGroups() []ResourceGroupStats {
+	groups.Lock()
+	stats := make([]ResourceGroupStats, 0, len(groups.m))
+	for _, g := range groups.m {
+		stats = append(stats, ResourceGroupStats{
+			ResourceGroup: g.ResourceGroup,
+			Running:       int(atomic.LoadInt32(&g.running)),
+			Queued:        int(atomic.LoadInt32(&g.queued)),
+		})
+	}
+	groups.Unlock()
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
+// resourceGroup returns the group of the session if set and known, or the
+// one of the user.
+func (c *compile) resourceGroup() *group {
+	groups.Lock()
+	defer groups.Unlock()
+	if g, ok := groups.m[c.group]; ok {
+		return g
+	}
+	if g, ok := groups.m[groups.users[c.uid]]; ok {
+		return g
+	}
+	return groups.m[DefaultResourceGroup]
+}
+
+// setMemoryQuota lowers the memory quota of the queries of the compile to the
+// share of the memory of the host of group g, and to the memory limit of the
+// settings of the process.
+func (c *compile) setMemoryQuota(g *group) {
+	if c.proc == nil || c.proc.Mp == nil || c.proc.Mp.Gm == nil {
+		return
+	}
+	gm := c.proc.Mp.Gm
+	quota := gm.Limit
+	if g.MemoryShare > 0 {
+		if share := gm.Mmu.Limit() * int64(g.MemoryShare) / 100; share < quota {
+			quota = share
+		}
+	}
+	if limit := c.proc.Settings.MemoryLimit; limit > 0 && limit < quota {
+		quota = limit
+	}
+	if quota < gm.Limit {
+		c.proc.Mp.Gm = guest.New(quota, gm.Mmu)
+	}
+}
+
+// admit waits for a slot of the group of the query in its admission queue,
+// and returns the function releasing the slot once the query ends.
+func (e *Exec) admit() (func(), error) {
+	g := e.group
+	if g == nil {
+		return func() {}, nil
+	}
+	if g.sem != nil {
+		atomic.AddInt32(&g.queued, 1)
+		defer atomic.AddInt32(&g.queued, -1)
+		var done <-chan struct{}
+		if ctx := e.c.proc.Ctx; ctx != nil {
+			done = ctx.Done()
+		}
+		var timeout <-chan time.Time
+		if g.QueueTimeout > 0 {
+			t := time.NewTimer(g.QueueTimeout)
+			defer t.Stop()
+			timeout = t.C
+		}
+		select {
+		case g.sem <- struct{}{}:
+		case <-done:
+			return nil, e.c.proc.Ctx.Err()
+		case <-timeout:
+			return nil, errors.New(errno.InsufficientResources, fmt.Sprintf("query %v waited for resource group %s over %v", e.id, g.Name, g.QueueTimeout))
+		}
+	}
+	atomic.AddInt32(&g.running, 1)
+	return func() {
+		atomic.AddInt32(&g.running, -1)
+		if g.sem != nil {
+			<-g.sem
+		}
+	}, nil
+}
+
+// cpus returns the CPUs of group g, which are in proportion to its weight
+// among the ones of the groups running queries.
+func (g *group) cpus() int {
+	total := 0
+	groups.Lock()
+	registered := false
+	for _, other := range groups.m {
+		if other == g {
+			registered = true
+		}
+		if other == g || atomic.LoadInt32(&other.running) > 0 {
+			total += other.weight()
+		}
+	}
+	groups.Unlock()
+	if !registered {
+		// The group is dropped
+		total += g.weight()
+	}
+	n := runtime.NumCPU() * g.weight() / total
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+func (g *group) weight() int {
+	if g.CPUWeight > 0 {
+		return g.CPUWeight
+	}
+	return 1
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// The operators of colexec2 a scope runs on the batches it receives.
+var prepareFunc = map[int]func(*process.Process, interface{}) error{
+	vm.Restrict: restrict.Prepare,
+	vm.Offset:   offset.Prepare,
+	vm.Limit:    limit.Prepare,
+}
+
+var execFunc = map[int]func(*process.Process, interface{}) (bool, error){
+	vm.Restrict: restrict.Call,
+	vm.Offset:   offset.Call,
+	vm.Limit:    limit.Call,
+}
+
+// prepareInstructions prepares the arguments of the operators of ins.
+func (e *Exec) prepareInstructions(ins vm.Instructions) error {
+	for _, in := range ins {
+		if err := prepareFunc[in.Op](e.c.proc, in.Arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runInstructions runs the operators of ins on bat in order, and returns
+// the batch of the last one, nil if none is left. It is true once an
+// operator needs no more batches, such as a limit reached.
+func (e *Exec) runInstructions(ins vm.Instructions, bat *batch.Batch) (*batch.Batch, bool, error) {
+	proc := e.c.proc
+	proc.Reg.InputBatch = bat
+	defer func() {
+		proc.Reg.InputBatch = nil
+	}()
+	var end bool
+	for _, in := range ins {
+		ok, err := execFunc[in.Op](proc, in.Arg)
+		if err != nil {
+			return nil, true, err
+		}
+		end = end || ok
+		if proc.Reg.InputBatch == nil {
+			break
+		}
+	}
+	return proc.Reg.InputBatch, end, nil
+}
+
+// endInstructions ends the operators of ins, which free their resources.
+func (e *Exec) endInstructions(ins vm.Instructions) {
+	proc := e.c.proc
+	for _, in := range ins {
+		proc.Reg.InputBatch = nil
+		execFunc[in.Op](proc, in.Arg)
+	}
+}
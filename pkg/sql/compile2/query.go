@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// QueryInfo is the information of a query running.
+type QueryInfo struct {
+	Id uint64
+	// ConnectionID, the id of the connection which issued the query.
+	ConnectionID uint32
+	Sql          string
+	Start        time.Time
+}
+
+// query is a query registered while it runs, which is killed by canceling
+// the context of its process.
+type query struct {
+	QueryInfo
+	proc   *process.Process
+	killed int32
+}
+
+// queryId is the id of the last query built.
+var queryId uint64
+
+// queries is the registry of the queries running indexed by their ids.
+var queries = struct {
+	sync.Mutex
+	m map[uint64]*query
+}{m: make(map[uint64]*query)}
+
+// register adds the query of e to the registry until unregister is called.
+func (e *Exec) register() *query {
+	q := &query{
+		QueryInfo: QueryInfo{
+			Id:           e.id,
+			ConnectionID: e.c.connId,
+			Sql:          e.c.sql,
+			Start:        time.Now(),
+		},
+		proc: e.c.proc,
+	}
+	queries.Lock()
+	queries.m[q.Id] = q
+	queries.Unlock()
+	return q
+}
+
+// unregister removes the query from the registry, and returns err, which is
+// replaced by the error of the kill if the query is killed.
+func (q *query) unregister(err error) error {
+	queries.Lock()
+	delete(queries.m, q.Id)
+	queries.Unlock()
+	if atomic.LoadInt32(&q.killed) == 1 {
+		return errors.New(errno.OperatorIntervention, fmt.Sprintf("query %v is killed", q.Id))
+	}
+	return err
+}
+
+func (q *query) kill() {
+	atomic.StoreInt32(&q.killed, 1)
+	if q.proc.Cancel != nil {
+		q.proc.Cancel()
+	}
+}
+
+// Kill cancels the query id, whose operators and scans stop at their next
+// check of the context of the process.
+func Kill(id uint64) error {
+	queries.Lock()
+	defer queries.Unlock()
+	q, ok := queries.m[id]
+	if !ok {
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("unknown query id %v", id))
+	}
+	q.kill()
+	return nil
+}
+
+// KillConnection cancels the queries issued by the connection id, and
+// returns the number of them.
+func KillConnection(id uint32) int {
+	queries.Lock()
+	defer queries.Unlock()
+	n := 0
+	for _, q := range queries.m {
+		if q.ConnectionID == id {
+			q.kill()
+			n++
+		}
+	}
+	return n
+}
+
+// Queries returns the queries running ordered by their ids.
+func Queries() []QueryInfo {
+	queries.Lock()
+	infos := make([]QueryInfo, 0, len(queries.m))
+	for _, q := range queries.m {
+		infos = append(infos, q.QueryInfo)
+	}
+	queries.Unlock()
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Id < infos[j].Id })
+	return infos
+}
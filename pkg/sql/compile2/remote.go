@@ -0,0 +1,474 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// RemoteCmd is the command of the rpcserver which the Handler of the
+// fragments is registered as
+var RemoteCmd uint64
+
+// InitRemoteCmd is used to set the command of the Handler of local node
+func InitRemoteCmd(cmd uint64) {
+	RemoteCmd = cmd
+}
+
+const (
+	// HeartbeatInterval is the interval of the heartbeats a Handler sends
+	// while it runs a fragment.
+	HeartbeatInterval = time.Second
+	// RemoteTimeout is the time a Remote scope waits for a message, after
+	// which the node running its fragment is taken as lost.
+	RemoteTimeout = 10 * HeartbeatInterval
+)
+
+// The kinds of the messages a Handler sends back, in their Sid.
+const (
+	batchMessage = iota
+	endMessage
+	heartbeatMessage
+)
+
+// compileExchange compiles the exchange node of the fragment compiled into
+// a Remote scope, which receives the rows of the fragment below it.
+func (e *Exec) compileExchange(pn *plan.Plan, node *plan.Node) (*Scope, error) {
+	var child *plan.Fragment
+	for _, id := range e.frag.GetChildren() {
+		if frag := e.frags[id]; frag != nil && frag.ExchangeId == node.NodeId {
+			child = frag
+		}
+	}
+	if child == nil {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("exchange '%v' receives from no fragment", node.NodeId))
+	}
+	data, err := encodeFragments(e.frags, child)
+	if err != nil {
+		return nil, err
+	}
+	return &Scope{
+		Magic: Remote,
+		Plan:  pn,
+		Fragment: &RemoteFragment{
+			Addr: e.fragmentAddr(child),
+			Data: data,
+		},
+	}, nil
+}
+
+// fragmentAddr returns the address of the node running frag, one of the
+// nodes of the table it scans.
+func (e *Exec) fragmentAddr(frag *plan.Fragment) string {
+	snap := e.c.proc.Snapshot
+	for _, node := range frag.Query.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil {
+			continue
+		}
+		db, err := e.c.e.Database(node.ObjRef.DbName, snap)
+		if err != nil {
+			break
+		}
+		rel, err := db.Relation(node.ObjRef.ObjName, snap)
+		if err != nil {
+			break
+		}
+		nodes := rel.Nodes(snap)
+		rel.Close(snap)
+		if len(nodes) > 0 {
+			return nodes[int(frag.Id)%len(nodes)].Addr
+		}
+		break
+	}
+	return e.c.proc.Settings.Address
+}
+
+// RemoteRun sends the fragment of the scope to its node and sends the rows
+// received back to the Reg of the scope. The fragment is run by the scope
+// itself if the node is local, or if the node can not be reached. Once the
+// rows are streamed, the loss of the node fails the scope, as the rows sent
+// can not be taken back.
+func (s *Scope) RemoteRun(e engine.Engine, proc *process.Process) error {
+	if s.Fragment.Addr == proc.Settings.Address {
+		return runFragments(e, proc, s.Fragment.Data, s.Reg)
+	}
+	conn, err := s.connect()
+	if err != nil {
+		logutil.Warnf("run fragment locally, as node '%s' is unreachable: %v", s.Fragment.Addr, err)
+		return runFragments(e, proc, s.Fragment.Data, s.Reg)
+	}
+	defer conn.Close()
+	err = s.remoteRun(conn, proc)
+	select {
+	case <-s.Reg.Ctx.Done():
+	case s.Reg.Ch <- nil:
+	}
+	return err
+}
+
+// connect sends the fragment of the scope to its node, and returns the
+// connection its rows are received from.
+func (s *Scope) connect() (goetty.IOSession, error) {
+	encoder, decoder := rpcserver.NewCodec(1 << 30)
+	conn := goetty.NewIOSession(goetty.WithCodec(encoder, decoder), goetty.WithTimeout(RemoteTimeout, RemoteTimeout))
+	addr, err := net.ResolveTCPAddr("tcp", s.Fragment.Addr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Connect(fmt.Sprintf("%v:%v", addr.IP, addr.Port+100), time.Second*3); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.WriteAndFlush(&message.Message{Cmd: RemoteCmd, Data: s.Fragment.Data}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (s *Scope) remoteRun(conn goetty.IOSession, proc *process.Process) error {
+	for {
+		val, err := conn.Read()
+		if err != nil {
+			// The node is down, or silent beyond the heartbeats
+			return errors.New(errno.ConnectionException, fmt.Sprintf("node '%s' is lost: %v", s.Fragment.Addr, err))
+		}
+		msg := val.(*message.Message)
+		if len(msg.Code) > 0 {
+			return errors.New(errno.SystemError, string(msg.Code))
+		}
+		switch msg.Sid {
+		case endMessage:
+			return nil
+		case heartbeatMessage:
+			continue
+		}
+		bat, err := decodeBatch(msg.Data, proc.Mp)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-s.Reg.Ctx.Done():
+			batch.Clean(bat, proc.Mp)
+			return nil
+		case s.Reg.Ch <- bat:
+		}
+	}
+}
+
+// runFragments runs the first fragment of data, whose rows are sent to reg.
+func runFragments(e engine.Engine, proc *process.Process, data []byte, reg *process.WaitRegister) error {
+	frags, err := decodeFragments(data)
+	if err == nil {
+		exec := &Exec{
+			c:     &compile{e: e, proc: proc},
+			e:     e,
+			frag:  frags[0],
+			frags: make(map[int32]*plan.Fragment),
+		}
+		for _, frag := range frags {
+			exec.frags[frag.Id] = frag
+		}
+		var s *Scope
+		if s, err = exec.compileQuery(&plan.Plan{Plan: &plan.Plan_Query{Query: frags[0].Query}}, frags[0].Query); err == nil {
+			s.Reg = reg
+			if err = exec.runScope(s); err == nil {
+				return nil
+			}
+		}
+	}
+	// The scopes failed stop without telling the receiver
+	select {
+	case <-reg.Ctx.Done():
+	case reg.Ch <- nil:
+	}
+	return err
+}
+
+// runScope runs the scope s of a fragment, which sends its rows to its Reg
+// and ends them with a nil batch.
+func (e *Exec) runScope(s *Scope) error {
+	switch s.Magic {
+	case TableScan:
+		return s.ReadBlocks(e.c.e, e.c.proc)
+	case CteScan:
+		return s.ScanCte(e.c.proc)
+	case Remote:
+		return s.RemoteRun(e.c.e, e.c.proc)
+	case Merge:
+		return e.mergeRun(s)
+	}
+	return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("scope '%v' can not run remotely", s.Magic))
+}
+
+// mergeRun runs the scopes s receives from and sends their rows to the Reg
+// of s. The failure of one of them stops the others.
+func (e *Exec) mergeRun(s *Scope) error {
+	ctx, cancel := context.WithCancel(s.Reg.Ctx)
+	defer cancel()
+	ch := make(chan *batch.Batch, len(s.PreScopes))
+	errs := make(chan error, len(s.PreScopes))
+	for _, pre := range s.PreScopes {
+		pre.Reg = &process.WaitRegister{Ctx: ctx, Ch: ch}
+		go func(pre *Scope) {
+			err := e.runScope(pre)
+			if err != nil {
+				cancel()
+			}
+			errs <- err
+		}(pre)
+	}
+	for n := len(s.PreScopes); n > 0; {
+		var bat *batch.Batch
+		select {
+		case <-ctx.Done():
+			n = 0
+			continue
+		case bat = <-ch:
+		}
+		if bat == nil {
+			n--
+			continue
+		}
+		select {
+		case <-s.Reg.Ctx.Done():
+			batch.Clean(bat, e.c.proc.Mp)
+		case s.Reg.Ch <- bat:
+		}
+	}
+	var err error
+	for range s.PreScopes {
+		if perr := <-errs; perr != nil && err == nil {
+			err = perr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	select {
+	case <-s.Reg.Ctx.Done():
+	case s.Reg.Ch <- nil:
+	}
+	return nil
+}
+
+// Handler runs the fragments sent by the Remote scopes of the other nodes
+// and writes their rows back.
+type Handler struct {
+	engine engine.Engine
+	proc   *process.Process
+}
+
+func NewHandler(e engine.Engine, proc *process.Process) *Handler {
+	return &Handler{
+		engine: e,
+		proc:   proc,
+	}
+}
+
+func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) error {
+	proc := process.New(mheap.New(guest.New(hp.proc.Mp.Gm.Limit, hp.proc.Mp.Gm.Mmu)))
+	defer proc.Cancel()
+	reg := &process.WaitRegister{
+		Ctx: proc.Ctx,
+		Ch:  make(chan *batch.Batch, 1),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- runFragments(hp.engine, proc, val.(*message.Message).Data, reg)
+	}()
+	// The heartbeats tell the receiver the node is alive while no row is
+	// sent, such as while the rows are sorted
+	ticker := time.NewTicker(HeartbeatInterval)
+	defer ticker.Stop()
+	var werr error
+	for {
+		var bat *batch.Batch
+		select {
+		case <-ticker.C:
+			if werr = conn.WriteAndFlush(&message.Message{Sid: heartbeatMessage}); werr == nil {
+				continue
+			}
+			// The senders stop at the cancel, and bat is nil
+			proc.Cancel()
+		case bat = <-reg.Ch:
+		}
+		if bat == nil {
+			break
+		}
+		if werr == nil && len(bat.Zs) > 0 {
+			var buf bytes.Buffer
+			if werr = encodeBatch(bat, &buf); werr == nil {
+				werr = conn.WriteAndFlush(&message.Message{Sid: batchMessage, Data: buf.Bytes()})
+			}
+			if werr != nil {
+				// The senders stop at the cancel
+				proc.Cancel()
+			}
+		}
+		batch.Clean(bat, proc.Mp)
+		if werr != nil {
+			break
+		}
+	}
+	err := <-errCh
+	if werr != nil {
+		return werr
+	}
+	if err != nil {
+		conn.WriteAndFlush(&message.Message{Code: []byte(err.Error())})
+	}
+	return conn.WriteAndFlush(&message.Message{Sid: endMessage})
+}
+
+// encodeFragments encodes frag and the fragments below it, which the node
+// running frag receives from.
+func encodeFragments(frags map[int32]*plan.Fragment, frag *plan.Fragment) ([]byte, error) {
+	var buf bytes.Buffer
+	var add func(*plan.Fragment) error
+	add = func(frag *plan.Fragment) error {
+		data, err := plan2.EncodeFragment(frag)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+		for _, id := range frag.Children {
+			child, ok := frags[id]
+			if !ok {
+				return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("fragment '%v' does not exist", id))
+			}
+			if err := add(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := add(frag); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeFragments(data []byte) ([]*plan.Fragment, error) {
+	var frags []*plan.Fragment
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, errors.New(errno.DataException, "fragments are truncated")
+		}
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if uint32(len(data)) < n {
+			return nil, errors.New(errno.DataException, "fragments are truncated")
+		}
+		frag, err := plan2.DecodeFragment(data[:n])
+		if err != nil {
+			return nil, err
+		}
+		frags = append(frags, frag)
+		data = data[n:]
+	}
+	if len(frags) == 0 {
+		return nil, errors.New(errno.DataException, "no fragment is received")
+	}
+	return frags, nil
+}
+
+func encodeBatch(bat *batch.Batch, buf *bytes.Buffer) error {
+	// Zs
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Zs))))
+	if len(bat.Zs) > 0 {
+		buf.Write(encoding.EncodeInt64Slice(bat.Zs))
+	}
+	// Sels
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Sels))))
+	if len(bat.Sels) > 0 {
+		buf.Write(encoding.EncodeInt64Slice(bat.Sels))
+	}
+	// Vecs, each of which is in the wire format of Show after its length
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Vecs))))
+	for _, vec := range bat.Vecs {
+		data, err := vec.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+	}
+	return nil
+}
+
+// decodeBatch decodes the batch of data whose vectors are copied to mp.
+func decodeBatch(data []byte, mp *mheap.Mheap) (*batch.Batch, error) {
+	// Zs
+	zn := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	zs := make([]int64, zn)
+	if zn > 0 {
+		copy(zs, encoding.DecodeInt64Slice(data[:zn*8]))
+		data = data[zn*8:]
+	}
+	// Sels
+	var sels []int64
+	if sn := encoding.DecodeUint32(data[:4]); sn > 0 {
+		sels = make([]int64, sn)
+		copy(sels, encoding.DecodeInt64Slice(data[4:4+sn*8]))
+		data = data[4+sn*8:]
+	} else {
+		data = data[4:]
+	}
+	// Vecs
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	bat := batch.New(int(n))
+	bat.Zs, bat.Sels = zs, sels
+	for i := range bat.Vecs {
+		size := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		// The vector read refers to data, whose type comes first
+		vec := vector.New(encoding.DecodeType(data[:encoding.TypeSize]))
+		if err := vec.Read(data[:size]); err != nil {
+			batch.Clean(bat, mp)
+			return nil, err
+		}
+		var err error
+		if bat.Vecs[i], err = vector.Dup(vec, mp); err != nil {
+			batch.Clean(bat, mp)
+			return nil, err
+		}
+		data = data[size:]
+	}
+	return bat, nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// compileTableScan compiles the scan node into a Merge scope of TableScan
+// scopes, each of which reads a contiguous range of the blocks of the table.
+// The blocks are the ones resolved by the plan, or listed by the engine with
+// the pruning predicates if they are deferred to the runtime.
+func (e *Exec) compileTableScan(pn *plan.Plan, node *plan.Node) (*Scope, error) {
+	if node.ObjRef == nil || node.TableDef == nil {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("table scan '%v' has no table", node.NodeId))
+	}
+	attrs := make([]string, 0, len(node.ProjectList))
+	for _, expr := range node.ProjectList {
+		col, ok := expr.Expr.(*plan.Expr_Col)
+		if !ok || col.Col.ColPos < 0 || int(col.Col.ColPos) >= len(node.TableDef.Cols) {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("table scan of '%s' projects '%v'", node.TableDef.Name, expr))
+		}
+		attrs = append(attrs, node.TableDef.Cols[col.Col.ColPos].Name)
+	}
+	snap := e.c.proc.Snapshot
+	db, err := e.c.e.Database(node.ObjRef.DbName, snap)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(node.ObjRef.ObjName, snap)
+	if err != nil {
+		return nil, err
+	}
+	defer rel.Close(snap)
+	return e.compileBlockScan(pn, node, rel, attrs), nil
+}
+
+// compileBlockScan compiles the scan node of rel reading the columns attrs
+// into a Merge scope of TableScan scopes, or a TableScan scope reading all
+// the blocks if the engine does not list them. The rows of the index
+// predicate of the node are looked up in the index if the planner did not.
+func (e *Exec) compileBlockScan(pn *plan.Plan, node *plan.Node, rel engine.Relation, attrs []string) *Scope {
+	newScope := func(blocks []*plan.BlockRef) *Scope {
+		return &Scope{
+			Magic: TableScan,
+			Plan:  pn,
+			Idx:   int(node.NodeId),
+			DataSource: &Source{
+				SchemaName:   node.ObjRef.DbName,
+				RelationName: node.ObjRef.ObjName,
+				Attributes:   attrs,
+				Blocks:       blocks,
+			},
+		}
+	}
+	info := node.PruneInfo
+	var blocks []*plan.BlockRef
+	resolved := info != nil && info.Resolved
+	if resolved {
+		blocks = info.Blocks
+	} else if r, ok := rel.(engine.IndexRelation); ok {
+		if pred := plan2.IndexPredicate(node); pred != nil {
+			f := pred.Expr.(*plan.Expr_F).F
+			blocks, resolved = r.Lookup(f.Args[0].GetCol().GetName(), f.Args[1], e.c.proc.Snapshot)
+		}
+	}
+	if !resolved {
+		r, ok := rel.(engine.BlockRelation)
+		if !ok {
+			// The engine reads all the blocks
+			return newScope(nil)
+		}
+		_, blocks = r.Blocks(info.GetPredicates(), nil, e.c.proc.Snapshot)
+	}
+	s := &Scope{
+		Magic: Merge,
+		Plan:  pn,
+	}
+	for _, rng := range splitBlocks(blocks, e.scanDOP(node)) {
+		s.PreScopes = append(s.PreScopes, newScope(rng))
+	}
+	if len(s.PreScopes) == 0 {
+		// No block is left
+		s.PreScopes = append(s.PreScopes, newScope([]*plan.BlockRef{}))
+	}
+	return s
+}
+
+// scanDOP returns the number of the workers of the scan node. It is the one
+// of the settings of the process if set, or the number of the workers reading RowsPerWorker
+// rows estimated each, up to the number of the CPUs. Both are capped by the
+// CPUs of the resource group of the query.
+func (e *Exec) scanDOP(node *plan.Node) int {
+	cpus := runtime.NumCPU()
+	if e.group != nil {
+		cpus = e.group.cpus()
+	}
+	if dop := e.c.proc.Settings.DOP; dop > 0 {
+		if dop < cpus {
+			return dop
+		}
+		return cpus
+	}
+	n := cpus
+	if cost := node.Cost; cost != nil {
+		if workers := int(math.Ceil(cost.Card / RowsPerWorker)); workers < n {
+			n = workers
+		}
+	}
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// readBatch returns the next batch of rd, or nil at the end.
+func readBatch(rd engine.Reader, refCnts []uint64, attrs []string, proc *process.Process) (*batch.Batch, error) {
+	for {
+		old, err := rd.Read(refCnts, attrs)
+		if err != nil || old == nil {
+			return nil, err
+		}
+		if len(old.Vecs) == 0 {
+			continue
+		}
+		// The vectors read share the buffers of the reader
+		bat := batch.New(len(old.Vecs))
+		for i, vec := range old.Vecs {
+			if bat.Vecs[i], err = vector.Dup(vec, proc.Mp); err != nil {
+				batch.Clean(bat, proc.Mp)
+				return nil, err
+			}
+		}
+		bat.Zs = make([]int64, vector.Length(bat.Vecs[0]))
+		for i := range bat.Zs {
+			bat.Zs[i] = 1
+		}
+		proc.Stats.AddRowsExamined(int64(len(bat.Zs)))
+		return bat, nil
+	}
+}
+
+// splitBlocks splits blocks into n contiguous ranges at most. If there are
+// n segments at least, the ranges are cut at the boundaries of the segments
+// nearest to the even cuts, for the workers not to share a segment.
+// Otherwise the sizes of the ranges differ by one at most.
+func splitBlocks(blocks []*plan.BlockRef, n int) [][]*plan.BlockRef {
+	if n > len(blocks) {
+		n = len(blocks)
+	}
+	// bounds are the first blocks of the segments but the first one
+	var bounds []int
+	for i := 1; i < len(blocks); i++ {
+		if blocks[i].SegmentId != blocks[i-1].SegmentId {
+			bounds = append(bounds, i)
+		}
+	}
+	cuts := make([]int, 0, n+1)
+	cuts = append(cuts, 0)
+	if len(bounds) >= n-1 {
+		j := 0
+		for i := 1; i < n; i++ {
+			even := i * len(blocks) / n
+			// leave a bound for each of the cuts after
+			last := len(bounds) - (n - i)
+			for j < last && abs(bounds[j+1]-even) <= abs(bounds[j]-even) {
+				j++
+			}
+			cuts = append(cuts, bounds[j])
+			j++
+		}
+	} else {
+		for i := 1; i < n; i++ {
+			cuts = append(cuts, i*len(blocks)/n)
+		}
+	}
+	cuts = append(cuts, len(blocks))
+	rngs := make([][]*plan.BlockRef, 0, n)
+	for i := 0; i < n; i++ {
+		rngs = append(rngs, blocks[cuts[i]:cuts[i+1]])
+	}
+	return rngs
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// ReadBlocks sends the batches of the blocks of the data source to the Reg
+// of the scope.
+func (s *Scope) ReadBlocks(e engine.Engine, proc *process.Process) error {
+	src := s.DataSource
+	db, err := e.Database(src.SchemaName, proc.Snapshot)
+	if err != nil {
+		return err
+	}
+	rel, err := db.Relation(src.RelationName, proc.Snapshot)
+	if err != nil {
+		return err
+	}
+	defer rel.Close(proc.Snapshot)
+	var rd engine.Reader
+	if r, ok := rel.(engine.BlockRelation); ok && src.Blocks != nil {
+		rd = r.NewBlockReader(src.Blocks, proc.Snapshot)
+	} else {
+		rd = rel.NewReader(1, nil, nil, proc.Snapshot)[0]
+	}
+	if r, ok := rd.(engine.CancelableReader); ok && proc.Ctx != nil {
+		r.SetContext(proc.Ctx)
+	}
+	refCnts := make([]uint64, len(src.Attributes))
+	for i := range refCnts {
+		refCnts[i] = 1
+	}
+	anal := process.GetAnalyze(proc, s.Idx)
+	for {
+		if err := process.Canceled(proc); err != nil {
+			return err
+		}
+		anal.Start()
+		bat, err := readBatch(rd, refCnts, src.Attributes, proc)
+		anal.Output(bat)
+		anal.Stop()
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		select {
+		case <-s.Reg.Ctx.Done():
+			batch.Clean(bat, proc.Mp)
+			return nil
+		case s.Reg.Ch <- bat:
+		}
+	}
+	select {
+	case <-s.Reg.Ctx.Done():
+	case s.Reg.Ch <- nil:
+	}
+	return nil
+}
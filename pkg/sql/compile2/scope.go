@@ -15,6 +15,9 @@
 package compile2
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -22,3 +25,61 @@ func (s *Scope) CreateDatabase(ts uint64, snapshot engine.Snapshot, engine engin
 	dbName := s.Plan.GetDdl().GetCreateDatabase().GetDatabase()
 	return engine.Create(ts, dbName, 0, snapshot)
 }
+
+// CreateTable creates the table of the plan in the database of the plan, it
+// does nothing if the table exists and the plan is IF NOT EXISTS.
+func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, e engine.Engine) error {
+	qry := s.Plan.GetDdl().GetCreateTable()
+	db, err := e.Database(qry.GetDatabase(), snapshot)
+	if err != nil {
+		return err
+	}
+	tblName := qry.GetTableDef().GetName()
+	if qry.GetIfNotExists() {
+		for _, name := range db.Relations(snapshot) {
+			if name == tblName {
+				return nil
+			}
+		}
+	}
+	defs, err := planDefsToEngineDefs(qry.GetTableDef())
+	if err != nil {
+		return err
+	}
+	return db.Create(ts, tblName, defs, snapshot)
+}
+
+// planDefsToEngineDefs converts the columns and the primary key of the table
+// definition def of a plan into the ones of the engine.
+func planDefsToEngineDefs(def *plan.TableDef) ([]engine.TableDef, error) {
+	defs := make([]engine.TableDef, 0, len(def.Cols)+1)
+	for _, col := range def.Cols {
+		typ := types.T(col.Typ.GetId()).ToType()
+		typ.Width = col.Typ.GetWidth()
+		typ.Precision = col.Typ.GetPrecision()
+		attr := engine.Attribute{
+			Name:    col.Name,
+			Alg:     compress.T(col.Alg),
+			Type:    typ,
+			Primary: col.Primary,
+		}
+		if dflt := col.GetDefault(); dflt.GetExist() {
+			attr.Default.Exist = true
+			attr.Default.IsNull = dflt.GetIsNull()
+			if !attr.Default.IsNull {
+				v, err := constValue(dflt.GetValue(), typ, true)
+				if err != nil {
+					return nil, err
+				}
+				attr.Default.Value = v
+			}
+		}
+		defs = append(defs, &engine.AttributeDef{Attr: attr})
+	}
+	for _, d := range def.Defs {
+		if pk := d.GetPk(); pk != nil {
+			defs = append(defs, &engine.PrimaryIndexDef{Names: pk.Names})
+		}
+	}
+	return defs, nil
+}
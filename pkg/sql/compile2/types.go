@@ -18,7 +18,9 @@ import (
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
 )
@@ -26,10 +28,29 @@ import (
 const (
 	Merge = iota
 	CreateDatabase
+	// Material stores the result of a common table expression
+	Material
+	// CteScan reads the result of a materialized common table expression
+	CteScan
+	// TableScan reads a range of the blocks of a table
+	TableScan
+	// Remote receives the rows of a fragment of the query run by a node
+	Remote
+	// Insert appends the rows it receives or its values to a table
+	Insert
+	// Delete deletes the rows of a table matching the filters, whose hidden
+	// keys it receives with the columns
+	Delete
+	// Update sets the columns of the rows of a table matching the filters,
+	// whose hidden keys it receives with the columns
+	Update
+	// CreateTable creates a table in the transaction of the process
+	CreateTable
 )
 
-// Address is the ip:port of local node
-var Address string
+// RowsPerWorker is the number of the rows estimated for a worker of a scan
+// whose degree of parallelism is automatic.
+const RowsPerWorker = 1 << 16
 
 // Col is the information of attribute
 type Col struct {
@@ -37,6 +58,48 @@ type Col struct {
 	Name string
 }
 
+// Source is the table a TableScan scope reads.
+type Source struct {
+	SchemaName   string
+	RelationName string
+	// Attributes, the columns read.
+	Attributes []string
+	// Blocks, the blocks read, all the blocks of the table if nil.
+	Blocks []*plan.BlockRef
+}
+
+// Target is the table which an Insert, Delete or Update scope writes in the
+// transaction of the process.
+type Target struct {
+	SchemaName   string
+	RelationName string
+	// Attributes, the columns of the table, which the rows inserted have
+	// in order.
+	Attributes []string
+	// Values, the rows of an Insert without PreScopes.
+	Values *plan.RowsetData
+	// Columns, the hidden key and the Attributes, the columns of the rows
+	// a Delete or an Update receives.
+	Columns []string
+	// UpdateAttributes, the columns set by an Update to the UpdateExtends
+	// evaluated on the rows, of the types UpdateTypes.
+	UpdateAttributes []string
+	UpdateTypes      []types.Type
+	UpdateExtends    []extend.Extend
+	// builder, the builder of the extends of a Delete or an Update, which
+	// frees their constants once it is run.
+	builder *extendBuilder
+}
+
+// RemoteFragment is the fragment of the query which a Remote scope ships to
+// a node.
+type RemoteFragment struct {
+	// Addr, the ip:port of the node running the fragment.
+	Addr string
+	// Data, the encodings of the fragment and the ones below it.
+	Data []byte
+}
+
 // Scope is the output of the compile process.
 // Each sql will be compiled to one or more execution unit scopes.
 type Scope struct {
@@ -47,10 +110,36 @@ type Scope struct {
 	Magic int
 
 	Plan *plan.Plan
+
+	// PreScopes, the scopes the scope receives from, such as the ones of
+	// the materialized common table expressions.
+	PreScopes []*Scope
+	// Cte, the result of the common table expression which a Material
+	// scope writes and the CteScan scopes read.
+	Cte *cteResult
+	// DataSource, the table which a TableScan scope reads.
+	DataSource *Source
+	// Fragment, the fragment which a Remote scope receives from.
+	Fragment *RemoteFragment
+	// Target, the table which an Insert, Delete or Update scope writes,
+	// receiving the rows or the hidden keys from its PreScope if any.
+	Target *Target
+	// Instructions, the operators of colexec2 run on the batches an Insert,
+	// Delete or Update scope receives before they are written, such as the
+	// restrict, the offset and the limit of the rows deleted or updated.
+	Instructions vm.Instructions
+	// Reg, the receiver of the batches sent by the scope.
+	Reg *process.WaitRegister
+	// Idx, the index of the AnalyzeInfo of the node of the scope.
+	Idx int
 }
 
 // Exec stores all information related to the execution phase of a single sql.
 type Exec struct {
+	//id is the id of the query, which Kill cancels while it runs.
+	id uint64
+	//group is the resource group which admits the query and limits its resources.
+	group *group
 	//err stores err information if error occurred during execution.
 	//	err error
 	//resultCols stores the column information of result.
@@ -63,6 +152,9 @@ type Exec struct {
 	e engine.Engine
 	//stmt ast of a single sql
 	stmt tree.Statement
+	//frag is the fragment of the query compiled, and frags are the ones below it
+	frag  *plan.Fragment
+	frags map[int32]*plan.Fragment
 
 	u interface{}
 	//fill is a result writer runs a callback function.
@@ -82,4 +174,9 @@ type compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// connId is the id of the connection issuing the statements.
+	connId uint32
+	// group is the resource group of the session, the one of the user is
+	// used if it is empty.
+	group string
 }
@@ -57,6 +57,21 @@ func TestExplain(t *testing.T) {
 			input:  "explain (analyze true,verbose false,format json) select * from emp",
 			output: "explain (analyze true,verbose false,format json) select * from emp",
 		},
+		{
+			name:   "test07",
+			input:  "explain (format dot) select * from emp",
+			output: "explain (format dot) select * from emp",
+		},
+		{
+			name:   "test08",
+			input:  "explain format = dot select * from emp",
+			output: "explain (format dot) select * from emp",
+		},
+		{
+			name:   "test09",
+			input:  "explain format = json select * from emp",
+			output: "explain (format json) select * from emp",
+		},
 	}
 
 	for _, c := range cases {
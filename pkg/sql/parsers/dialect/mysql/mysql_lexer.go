@@ -46,6 +46,8 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+	// lastTyp is the type of the last token returned
+	lastTyp int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -55,7 +57,17 @@ func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
 }
 
 func (l *Lexer) Lex(lval *yySymType) int {
+	typ := l.lex(lval)
+	l.lastTyp = typ
+	return typ
+}
+
+func (l *Lexer) lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
+	// The hints not following SELECT are comments
+	for typ == OPTIMIZER_HINT && l.lastTyp != SELECT {
+		typ, str = l.scanner.Scan()
+	}
 	l.scanner.LastToken = str
 
 	switch typ {
@@ -22,425 +22,438 @@ import (
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const DISTINCTROW = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const VERBOSE = 57649
-const CONNECTION = 57650
-const LOAD = 57651
-const INFILE = 57652
-const TERMINATED = 57653
-const OPTIONALLY = 57654
-const ENCLOSED = 57655
-const ESCAPED = 57656
-const STARTING = 57657
-const LINES = 57658
-const DATABASES = 57659
-const TABLES = 57660
-const EXTENDED = 57661
-const FULL = 57662
-const PROCESSLIST = 57663
-const FIELDS = 57664
-const COLUMNS = 57665
-const OPEN = 57666
-const ERRORS = 57667
-const WARNINGS = 57668
-const INDEXES = 57669
-const NAMES = 57670
-const GLOBAL = 57671
-const SESSION = 57672
-const ISOLATION = 57673
-const LEVEL = 57674
-const READ = 57675
-const WRITE = 57676
-const ONLY = 57677
-const REPEATABLE = 57678
-const COMMITTED = 57679
-const UNCOMMITTED = 57680
-const SERIALIZABLE = 57681
-const LOCAL = 57682
-const EXCEPT = 57683
-const CURRENT_TIMESTAMP = 57684
-const DATABASE = 57685
-const CURRENT_TIME = 57686
-const LOCALTIME = 57687
-const LOCALTIMESTAMP = 57688
-const UTC_DATE = 57689
-const UTC_TIME = 57690
-const UTC_TIMESTAMP = 57691
-const REPLACE = 57692
-const CONVERT = 57693
-const SEPARATOR = 57694
-const CURRENT_DATE = 57695
-const CURRENT_USER = 57696
-const CURRENT_ROLE = 57697
-const SECOND_MICROSECOND = 57698
-const MINUTE_MICROSECOND = 57699
-const MINUTE_SECOND = 57700
-const HOUR_MICROSECOND = 57701
-const HOUR_SECOND = 57702
-const HOUR_MINUTE = 57703
-const DAY_MICROSECOND = 57704
-const DAY_SECOND = 57705
-const DAY_MINUTE = 57706
-const DAY_HOUR = 57707
-const YEAR_MONTH = 57708
-const SQL_TSI_HOUR = 57709
-const SQL_TSI_DAY = 57710
-const SQL_TSI_WEEK = 57711
-const SQL_TSI_MONTH = 57712
-const SQL_TSI_QUARTER = 57713
-const SQL_TSI_YEAR = 57714
-const SQL_TSI_SECOND = 57715
-const SQL_TSI_MINUTE = 57716
-const RECURSIVE = 57717
-const MATCH = 57718
-const AGAINST = 57719
-const BOOLEAN = 57720
-const LANGUAGE = 57721
-const WITH = 57722
-const QUERY = 57723
-const EXPANSION = 57724
-const ADDDATE = 57725
-const BIT_AND = 57726
-const BIT_OR = 57727
-const BIT_XOR = 57728
-const CAST = 57729
-const COUNT = 57730
-const APPROX_COUNT_DISTINCT = 57731
-const APPROX_PERCENTILE = 57732
-const CURDATE = 57733
-const CURTIME = 57734
-const DATE_ADD = 57735
-const DATE_SUB = 57736
-const EXTRACT = 57737
-const GROUP_CONCAT = 57738
-const MAX = 57739
-const MID = 57740
-const MIN = 57741
-const NOW = 57742
-const POSITION = 57743
-const SESSION_USER = 57744
-const STD = 57745
-const STDDEV = 57746
-const STDDEV_POP = 57747
-const STDDEV_SAMP = 57748
-const SUBDATE = 57749
-const SUBSTR = 57750
-const SUBSTRING = 57751
-const SUM = 57752
-const SYSDATE = 57753
-const SYSTEM_USER = 57754
-const TRANSLATE = 57755
-const TRIM = 57756
-const VARIANCE = 57757
-const VAR_POP = 57758
-const VAR_SAMP = 57759
-const AVG = 57760
-const ROW = 57761
-const OUTFILE = 57762
-const HEADER = 57763
-const MAX_FILE_SIZE = 57764
-const FORCE_QUOTE = 57765
-const UNUSED = 57766
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const DISTINCTROW = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const INNER = 57389
+const OUTER = 57390
+const CROSS = 57391
+const NATURAL = 57392
+const USE = 57393
+const FORCE = 57394
+const ON = 57395
+const USING = 57396
+const SUBQUERY_AS_EXPR = 57397
+const ID = 57398
+const AT_ID = 57399
+const AT_AT_ID = 57400
+const STRING = 57401
+const VALUE_ARG = 57402
+const LIST_ARG = 57403
+const COMMENT = 57404
+const COMMENT_KEYWORD = 57405
+const OPTIMIZER_HINT = 57406
+const INTEGRAL = 57407
+const HEX = 57408
+const HEXNUM = 57409
+const BIT_LITERAL = 57410
+const FLOAT = 57411
+const NULL = 57412
+const TRUE = 57413
+const FALSE = 57414
+const EMPTY_FROM_CLAUSE = 57415
+const LOWER_THAN_CHARSET = 57416
+const CHARSET = 57417
+const UNIQUE = 57418
+const KEY = 57419
+const OR = 57420
+const XOR = 57421
+const AND = 57422
+const NOT = 57423
+const BETWEEN = 57424
+const CASE = 57425
+const WHEN = 57426
+const THEN = 57427
+const ELSE = 57428
+const END = 57429
+const LE = 57430
+const GE = 57431
+const NE = 57432
+const NULL_SAFE_EQUAL = 57433
+const IS = 57434
+const LIKE = 57435
+const REGEXP = 57436
+const IN = 57437
+const ASSIGNMENT = 57438
+const SHIFT_LEFT = 57439
+const SHIFT_RIGHT = 57440
+const DIV = 57441
+const MOD = 57442
+const UNARY = 57443
+const COLLATE = 57444
+const BINARY = 57445
+const UNDERSCORE_BINARY = 57446
+const INTERVAL = 57447
+const BEGIN = 57448
+const START = 57449
+const TRANSACTION = 57450
+const COMMIT = 57451
+const ROLLBACK = 57452
+const WORK = 57453
+const CONSISTENT = 57454
+const SNAPSHOT = 57455
+const CHAIN = 57456
+const NO = 57457
+const RELEASE = 57458
+const BIT = 57459
+const TINYINT = 57460
+const SMALLINT = 57461
+const MEDIUMINT = 57462
+const INT = 57463
+const INTEGER = 57464
+const BIGINT = 57465
+const INTNUM = 57466
+const REAL = 57467
+const DOUBLE = 57468
+const FLOAT_TYPE = 57469
+const DECIMAL = 57470
+const NUMERIC = 57471
+const TIME = 57472
+const TIMESTAMP = 57473
+const DATETIME = 57474
+const YEAR = 57475
+const CHAR = 57476
+const VARCHAR = 57477
+const BOOL = 57478
+const CHARACTER = 57479
+const VARBINARY = 57480
+const NCHAR = 57481
+const TEXT = 57482
+const TINYTEXT = 57483
+const MEDIUMTEXT = 57484
+const LONGTEXT = 57485
+const BLOB = 57486
+const TINYBLOB = 57487
+const MEDIUMBLOB = 57488
+const LONGBLOB = 57489
+const JSON = 57490
+const ENUM = 57491
+const GEOMETRY = 57492
+const POINT = 57493
+const LINESTRING = 57494
+const POLYGON = 57495
+const GEOMETRYCOLLECTION = 57496
+const MULTIPOINT = 57497
+const MULTILINESTRING = 57498
+const MULTIPOLYGON = 57499
+const INT1 = 57500
+const INT2 = 57501
+const INT3 = 57502
+const INT4 = 57503
+const INT8 = 57504
+const CREATE = 57505
+const ALTER = 57506
+const DROP = 57507
+const RENAME = 57508
+const ANALYZE = 57509
+const ADD = 57510
+const SCHEMA = 57511
+const TABLE = 57512
+const INDEX = 57513
+const VIEW = 57514
+const TO = 57515
+const IGNORE = 57516
+const IF = 57517
+const PRIMARY = 57518
+const COLUMN = 57519
+const CONSTRAINT = 57520
+const SPATIAL = 57521
+const FULLTEXT = 57522
+const FOREIGN = 57523
+const KEY_BLOCK_SIZE = 57524
+const SHOW = 57525
+const DESCRIBE = 57526
+const EXPLAIN = 57527
+const DATE = 57528
+const ESCAPE = 57529
+const REPAIR = 57530
+const OPTIMIZE = 57531
+const TRUNCATE = 57532
+const MAXVALUE = 57533
+const PARTITION = 57534
+const REORGANIZE = 57535
+const LESS = 57536
+const THAN = 57537
+const PROCEDURE = 57538
+const TRIGGER = 57539
+const STATUS = 57540
+const VARIABLES = 57541
+const ROLE = 57542
+const PROXY = 57543
+const AVG_ROW_LENGTH = 57544
+const STORAGE = 57545
+const DISK = 57546
+const MEMORY = 57547
+const CHECKSUM = 57548
+const COMPRESSION = 57549
+const DATA = 57550
+const DIRECTORY = 57551
+const DELAY_KEY_WRITE = 57552
+const ENCRYPTION = 57553
+const ENGINE = 57554
+const MAX_ROWS = 57555
+const MIN_ROWS = 57556
+const PACK_KEYS = 57557
+const ROW_FORMAT = 57558
+const STATS_AUTO_RECALC = 57559
+const STATS_PERSISTENT = 57560
+const STATS_SAMPLE_PAGES = 57561
+const DYNAMIC = 57562
+const COMPRESSED = 57563
+const REDUNDANT = 57564
+const COMPACT = 57565
+const FIXED = 57566
+const COLUMN_FORMAT = 57567
+const AUTO_RANDOM = 57568
+const RESTRICT = 57569
+const CASCADE = 57570
+const ACTION = 57571
+const PARTIAL = 57572
+const SIMPLE = 57573
+const CHECK = 57574
+const ENFORCED = 57575
+const RANGE = 57576
+const LIST = 57577
+const ALGORITHM = 57578
+const LINEAR = 57579
+const PARTITIONS = 57580
+const SUBPARTITION = 57581
+const SUBPARTITIONS = 57582
+const TYPE = 57583
+const PROPERTIES = 57584
+const PARSER = 57585
+const VISIBLE = 57586
+const INVISIBLE = 57587
+const BTREE = 57588
+const HASH = 57589
+const RTREE = 57590
+const BSI = 57591
+const ZONEMAP = 57592
+const EXPIRE = 57593
+const ACCOUNT = 57594
+const UNLOCK = 57595
+const DAY = 57596
+const NEVER = 57597
+const SECOND = 57598
+const ASCII = 57599
+const COALESCE = 57600
+const COLLATION = 57601
+const HOUR = 57602
+const MICROSECOND = 57603
+const MINUTE = 57604
+const MONTH = 57605
+const QUARTER = 57606
+const REPEAT = 57607
+const REVERSE = 57608
+const ROW_COUNT = 57609
+const WEEK = 57610
+const REVOKE = 57611
+const FUNCTION = 57612
+const PRIVILEGES = 57613
+const TABLESPACE = 57614
+const EXECUTE = 57615
+const SUPER = 57616
+const GRANT = 57617
+const OPTION = 57618
+const REFERENCES = 57619
+const REPLICATION = 57620
+const SLAVE = 57621
+const CLIENT = 57622
+const USAGE = 57623
+const RELOAD = 57624
+const FILE = 57625
+const TEMPORARY = 57626
+const ROUTINE = 57627
+const EVENT = 57628
+const SHUTDOWN = 57629
+const NULLX = 57630
+const AUTO_INCREMENT = 57631
+const APPROXNUM = 57632
+const SIGNED = 57633
+const UNSIGNED = 57634
+const ZEROFILL = 57635
+const USER = 57636
+const IDENTIFIED = 57637
+const CIPHER = 57638
+const ISSUER = 57639
+const X509 = 57640
+const SUBJECT = 57641
+const SAN = 57642
+const REQUIRE = 57643
+const SSL = 57644
+const NONE = 57645
+const PASSWORD = 57646
+const MAX_QUERIES_PER_HOUR = 57647
+const MAX_UPDATES_PER_HOUR = 57648
+const MAX_CONNECTIONS_PER_HOUR = 57649
+const MAX_USER_CONNECTIONS = 57650
+const FORMAT = 57651
+const VERBOSE = 57652
+const CONNECTION = 57653
+const DOT = 57654
+const LOAD = 57655
+const INFILE = 57656
+const TERMINATED = 57657
+const OPTIONALLY = 57658
+const ENCLOSED = 57659
+const ESCAPED = 57660
+const STARTING = 57661
+const LINES = 57662
+const DATABASES = 57663
+const TABLES = 57664
+const EXTENDED = 57665
+const FULL = 57666
+const PROCESSLIST = 57667
+const FIELDS = 57668
+const COLUMNS = 57669
+const OPEN = 57670
+const ERRORS = 57671
+const WARNINGS = 57672
+const INDEXES = 57673
+const NAMES = 57674
+const GLOBAL = 57675
+const SESSION = 57676
+const ISOLATION = 57677
+const LEVEL = 57678
+const READ = 57679
+const WRITE = 57680
+const ONLY = 57681
+const REPEATABLE = 57682
+const COMMITTED = 57683
+const UNCOMMITTED = 57684
+const SERIALIZABLE = 57685
+const LOCAL = 57686
+const CURRENT_TIMESTAMP = 57687
+const DATABASE = 57688
+const CURRENT_TIME = 57689
+const LOCALTIME = 57690
+const LOCALTIMESTAMP = 57691
+const UTC_DATE = 57692
+const UTC_TIME = 57693
+const UTC_TIMESTAMP = 57694
+const REPLACE = 57695
+const CONVERT = 57696
+const SEPARATOR = 57697
+const CURRENT_DATE = 57698
+const CURRENT_USER = 57699
+const CURRENT_ROLE = 57700
+const SECOND_MICROSECOND = 57701
+const MINUTE_MICROSECOND = 57702
+const MINUTE_SECOND = 57703
+const HOUR_MICROSECOND = 57704
+const HOUR_SECOND = 57705
+const HOUR_MINUTE = 57706
+const DAY_MICROSECOND = 57707
+const DAY_SECOND = 57708
+const DAY_MINUTE = 57709
+const DAY_HOUR = 57710
+const YEAR_MONTH = 57711
+const SQL_TSI_HOUR = 57712
+const SQL_TSI_DAY = 57713
+const SQL_TSI_WEEK = 57714
+const SQL_TSI_MONTH = 57715
+const SQL_TSI_QUARTER = 57716
+const SQL_TSI_YEAR = 57717
+const SQL_TSI_SECOND = 57718
+const SQL_TSI_MINUTE = 57719
+const RECURSIVE = 57720
+const ROLLUP = 57721
+const CUBE = 57722
+const GROUPING = 57723
+const SETS = 57724
+const OVER = 57725
+const ROWS = 57726
+const PRECEDING = 57727
+const FOLLOWING = 57728
+const UNBOUNDED = 57729
+const CURRENT = 57730
+const MATCH = 57731
+const AGAINST = 57732
+const BOOLEAN = 57733
+const LANGUAGE = 57734
+const WITH = 57735
+const QUERY = 57736
+const EXPANSION = 57737
+const ADDDATE = 57738
+const BIT_AND = 57739
+const BIT_OR = 57740
+const BIT_XOR = 57741
+const CAST = 57742
+const COUNT = 57743
+const APPROX_COUNT_DISTINCT = 57744
+const APPROX_PERCENTILE = 57745
+const CURDATE = 57746
+const CURTIME = 57747
+const DATE_ADD = 57748
+const DATE_SUB = 57749
+const EXTRACT = 57750
+const GROUP_CONCAT = 57751
+const MAX = 57752
+const MID = 57753
+const MIN = 57754
+const NOW = 57755
+const POSITION = 57756
+const SESSION_USER = 57757
+const STD = 57758
+const STDDEV = 57759
+const STDDEV_POP = 57760
+const STDDEV_SAMP = 57761
+const SUBDATE = 57762
+const SUBSTR = 57763
+const SUBSTRING = 57764
+const SUM = 57765
+const SYSDATE = 57766
+const SYSTEM_USER = 57767
+const TRANSLATE = 57768
+const TRIM = 57769
+const VARIANCE = 57770
+const VAR_POP = 57771
+const VAR_SAMP = 57772
+const AVG = 57773
+const ROW = 57774
+const OUTFILE = 57775
+const HEADER = 57776
+const MAX_FILE_SIZE = 57777
+const FORCE_QUOTE = 57778
+const UNUSED = 57779
 
 var yyToknames = [...]string{
 	"$end",
@@ -448,6 +461,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -507,6 +522,7 @@ var yyToknames = [...]string{
 	"LIST_ARG",
 	"COMMENT",
 	"COMMENT_KEYWORD",
+	"OPTIMIZER_HINT",
 	"INTEGRAL",
 	"HEX",
 	"HEXNUM",
@@ -768,6 +784,7 @@ var yyToknames = [...]string{
 	"FORMAT",
 	"VERBOSE",
 	"CONNECTION",
+	"DOT",
 	"LOAD",
 	"INFILE",
 	"TERMINATED",
@@ -800,7 +817,6 @@ var yyToknames = [...]string{
 	"UNCOMMITTED",
 	"SERIALIZABLE",
 	"LOCAL",
-	"EXCEPT",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -835,6 +851,16 @@ var yyToknames = [...]string{
 	"SQL_TSI_SECOND",
 	"SQL_TSI_MINUTE",
 	"RECURSIVE",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
+	"OVER",
+	"ROWS",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"CURRENT",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -894,7 +920,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6299
+//line mysql_sql.y:6532
 
 //line yacctab:1
 var yyExca = [...]int{
@@ -902,897 +928,922 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 52,
-	17, 353,
-	-2, 334,
+	19, 354,
+	-2, 335,
 	-1, 57,
-	185, 495,
-	-2, 531,
+	188, 516,
+	-2, 552,
 	-1, 66,
-	212, 243,
-	213, 243,
-	-2, 263,
-	-1, 313,
-	58, 1287,
-	443, 1287,
+	215, 244,
+	216, 244,
+	-2, 264,
+	-1, 319,
+	60, 1331,
+	456, 1331,
 	-2, 92,
-	-1, 332,
-	58, 658,
-	443, 658,
-	-2, 493,
-	-1, 333,
-	58, 486,
-	443, 486,
-	-2, 494,
+	-1, 338,
+	60, 679,
+	456, 679,
+	-2, 514,
 	-1, 339,
-	17, 354,
-	-2, 317,
-	-1, 563,
-	17, 354,
-	-2, 317,
-	-1, 596,
-	54, 784,
-	-2, 1328,
-	-1, 597,
-	54, 785,
-	-2, 1329,
-	-1, 598,
-	54, 786,
-	-2, 1330,
-	-1, 600,
-	54, 793,
-	-2, 1333,
-	-1, 601,
-	54, 792,
-	-2, 1334,
-	-1, 607,
-	54, 867,
-	-2, 1232,
-	-1, 608,
-	54, 878,
-	-2, 1292,
-	-1, 609,
-	54, 880,
-	-2, 1302,
-	-1, 610,
-	54, 868,
-	-2, 1307,
+	60, 507,
+	456, 507,
+	-2, 515,
+	-1, 345,
+	19, 355,
+	-2, 318,
+	-1, 572,
+	19, 355,
+	-2, 318,
+	-1, 726,
+	56, 829,
+	-2, 1384,
+	-1, 727,
+	56, 830,
+	-2, 1383,
+	-1, 740,
+	56, 904,
+	-2, 1276,
+	-1, 741,
+	56, 905,
+	-2, 1351,
+	-1, 749,
+	56, 915,
+	-2, 1336,
+	-1, 751,
+	56, 917,
+	-2, 1346,
 	-1, 762,
-	1, 521,
-	56, 521,
-	442, 521,
-	-2, 528,
-	-1, 879,
-	17, 353,
-	-2, 716,
-	-1, 925,
-	119, 1006,
-	-2, 1004,
-	-1, 927,
-	119, 435,
-	-2, 1001,
-	-1, 928,
-	119, 436,
-	-2, 1002,
-	-1, 1122,
-	1, 522,
-	56, 522,
-	442, 522,
-	-2, 528,
-	-1, 1542,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 568,
-	-1, 1544,
-	246, 683,
-	-2, 664,
-	-1, 1662,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 569,
-	-1, 1690,
-	246, 683,
-	-2, 665,
-	-1, 2081,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2085,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2097,
-	55, 547,
-	56, 547,
-	-2, 528,
-	-1, 2100,
-	55, 548,
-	56, 548,
-	-2, 528,
+	56, 821,
+	-2, 1378,
+	-1, 763,
+	56, 822,
+	-2, 1379,
+	-1, 764,
+	56, 823,
+	-2, 1380,
+	-1, 774,
+	1, 542,
+	58, 542,
+	455, 542,
+	-2, 549,
+	-1, 858,
+	122, 1044,
+	-2, 1042,
+	-1, 860,
+	122, 456,
+	-2, 1039,
+	-1, 861,
+	122, 457,
+	-2, 1040,
+	-1, 1076,
+	19, 354,
+	-2, 737,
+	-1, 1141,
+	1, 543,
+	58, 543,
+	455, 543,
+	-2, 549,
+	-1, 1570,
+	78, 549,
+	118, 549,
+	151, 549,
+	154, 549,
+	-2, 589,
+	-1, 1572,
+	249, 704,
+	-2, 685,
+	-1, 1698,
+	78, 549,
+	118, 549,
+	151, 549,
+	154, 549,
+	-2, 590,
+	-1, 1726,
+	249, 704,
+	-2, 686,
+	-1, 2162,
+	57, 564,
+	58, 564,
+	-2, 549,
+	-1, 2166,
+	57, 564,
+	58, 564,
+	-2, 549,
+	-1, 2178,
+	57, 568,
+	58, 568,
+	-2, 549,
+	-1, 2181,
+	57, 569,
+	58, 569,
+	-2, 549,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17450
+const yyLast = 18713
 
 var yyAct = [...]int{
-	752, 1174, 2087, 2085, 2084, 2092, 2058, 613, 2032, 741,
-	1922, 611, 631, 1735, 2047, 2003, 1702, 1984, 1898, 1985,
-	1901, 550, 1658, 1875, 84, 516, 1536, 289, 1109, 1733,
-	814, 1830, 1734, 548, 1886, 87, 640, 52, 1725, 454,
-	84, 302, 1803, 293, 19, 1620, 1436, 389, 1724, 334,
-	334, 300, 1338, 1621, 1603, 1623, 504, 1432, 798, 1628,
-	1632, 584, 574, 52, 1691, 1460, 1420, 1469, 1314, 1448,
-	1441, 1589, 1437, 390, 1487, 83, 1115, 1486, 1374, 411,
-	693, 295, 520, 84, 907, 821, 735, 558, 922, 916,
-	925, 908, 622, 1175, 612, 917, 1238, 3, 1252, 791,
-	292, 12, 290, 6, 291, 5, 1308, 1666, 738, 754,
-	51, 400, 402, 1123, 736, 1189, 340, 52, 339, 1176,
-	1173, 577, 492, 710, 19, 795, 1091, 1082, 767, 768,
-	766, 851, 285, 456, 420, 282, 431, 559, 816, 382,
-	727, 306, 305, 442, 296, 80, 410, 1098, 541, 471,
-	1748, 304, 1654, 1535, 749, 910, 79, 309, 309, 525,
-	1950, 79, 408, 23, 39, 24, 79, 79, 23, 39,
-	24, 1094, 1290, 1421, 341, 527, 1309, 1939, 417, 1297,
-	502, 12, 359, 6, 523, 5, 401, 79, 406, 405,
-	396, 690, 398, 79, 687, 785, 352, 491, 780, 781,
-	77, 336, 515, 1300, 75, 514, 517, 518, 369, 75,
-	770, 1972, 2007, 1970, 75, 689, 517, 518, 404, 744,
-	1397, 486, 528, 1988, 1989, 482, 1831, 1832, 1833, 1834,
-	1828, 1537, 1424, 1910, 383, 75, 1425, 1913, 1426, 1751,
-	748, 75, 1449, 1450, 1451, 1452, 1277, 434, 397, 425,
-	1317, 1315, 1312, 1316, 1318, 1470, 1311, 1310, 1473, 477,
-	1317, 1315, 792, 1316, 1318, 1094, 1096, 370, 1802, 473,
-	1453, 1711, 1710, 484, 485, 1707, 1651, 483, 472, 1532,
-	1819, 1614, 84, 424, 728, 1615, 1611, 478, 1320, 1321,
-	1322, 1323, 1949, 1998, 2077, 84, 423, 1887, 1888, 1889,
-	1891, 1890, 1974, 354, 1809, 2093, 2012, 1472, 1920, 1921,
-	730, 1924, 403, 351, 350, 1969, 1987, 2019, 1442, 1445,
-	1924, 1947, 458, 52, 52, 402, 1900, 1797, 2068, 1766,
-	1765, 434, 338, 537, 346, 1788, 459, 480, 464, 1976,
-	1977, 1930, 513, 512, 2094, 2088, 438, 2059, 1754, 419,
-	1375, 505, 526, 1908, 1952, 1953, 1294, 1145, 1298, 475,
-	524, 1102, 468, 422, 407, 1792, 756, 1445, 507, 1533,
-	1612, 476, 479, 481, 393, 294, 702, 703, 497, 334,
-	374, 474, 1630, 1629, 729, 390, 390, 390, 503, 436,
-	435, 1143, 1142, 1336, 1141, 531, 529, 530, 783, 401,
-	784, 1140, 782, 506, 371, 508, 372, 2072, 2036, 805,
-	411, 1427, 463, 580, 1760, 2050, 1348, 1288, 349, 427,
-	428, 1287, 692, 553, 1860, 864, 1415, 1446, 345, 376,
-	375, 1276, 1439, 1270, 561, 1135, 1440, 1443, 707, 1107,
-	424, 84, 84, 84, 84, 1076, 833, 395, 695, 555,
-	1328, 437, 724, 711, 421, 52, 1413, 521, 1093, 706,
-	2054, 542, 688, 1178, 1177, 540, 52, 705, 334, 334,
-	424, 334, 543, 436, 435, 1446, 1414, 458, 494, 429,
-	353, 2045, 1461, 742, 509, 1934, 1975, 309, 1444, 334,
-	334, 459, 1899, 1951, 488, 725, 517, 518, 517, 518,
-	1317, 1315, 510, 1316, 1318, 334, 579, 334, 1092, 762,
-	84, 1272, 1147, 1421, 2051, 1080, 562, 564, 398, 563,
-	793, 1613, 1610, 426, 775, 1117, 334, 1515, 1306, 761,
-	536, 759, 1097, 1253, 470, 539, 547, 828, 334, 390,
-	1790, 334, 496, 519, 1789, 522, 1799, 78, 773, 1170,
-	1183, 366, 78, 1291, 1793, 1794, 806, 78, 78, 757,
-	1171, 763, 698, 567, 568, 569, 570, 571, 334, 334,
-	813, 84, 573, 411, 397, 746, 822, 309, 78, 743,
-	831, 776, 1798, 393, 78, 712, 713, 714, 715, 751,
-	511, 758, 755, 817, 723, 764, 765, 1253, 560, 1380,
-	373, 771, 830, 828, 747, 1593, 1588, 818, 1783, 731,
-	740, 2067, 777, 73, 1349, 309, 772, 750, 881, 544,
-	545, 546, 815, 1326, 1659, 1981, 2048, 2049, 745, 1861,
-	1863, 1864, 1865, 1862, 2008, 799, 829, 830, 828, 1511,
-	1871, 799, 2083, 1869, 1517, 769, 309, 829, 830, 828,
-	808, 1867, 2066, 879, 811, 794, 395, 2064, 760, 1328,
-	863, 862, 872, 873, 865, 866, 867, 868, 869, 870,
-	871, 864, 377, 804, 2029, 834, 1870, 309, 790, 1868,
-	2013, 807, 829, 830, 828, 1857, 809, 1866, 399, 789,
-	914, 914, 919, 801, 802, 803, 363, 460, 461, 462,
-	551, 1959, 810, 1906, 364, 460, 461, 462, 1605, 822,
-	880, 1905, 882, 883, 884, 885, 886, 927, 888, 819,
-	812, 1856, 1877, 890, 554, 1855, 402, 401, 891, 1854,
-	1853, 928, 1644, 1327, 1245, 858, 52, 872, 873, 865,
-	866, 867, 868, 869, 870, 871, 864, 905, 1243, 1244,
-	1242, 549, 460, 461, 462, 551, 552, 84, 84, 1850,
-	2097, 1844, 1841, 1840, 1606, 1806, 1186, 1749, 2065, 1643,
-	289, 1383, 1385, 1743, 1382, 1188, 897, 1137, 2075, 460,
-	461, 462, 551, 1742, 1997, 913, 334, 1090, 921, 817,
-	1078, 829, 830, 828, 1077, 1741, 1740, 829, 830, 828,
-	401, 1737, 1599, 818, 1110, 1111, 334, 1598, 1112, 1114,
-	920, 552, 398, 863, 862, 872, 873, 865, 866, 867,
-	868, 869, 870, 871, 864, 580, 2053, 84, 1597, 926,
-	1596, 1409, 1074, 1167, 1168, 696, 1980, 1075, 552, 829,
-	830, 828, 1087, 1876, 1126, 1127, 1128, 1941, 1928, 1129,
-	1106, 1184, 1185, 361, 1138, 362, 369, 829, 830, 828,
-	360, 358, 357, 365, 1355, 367, 368, 1927, 1858, 1851,
-	1124, 1847, 1101, 1846, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1845, 1105, 1804, 1247,
-	1248, 1785, 905, 1750, 309, 799, 799, 799, 1172, 1131,
-	1260, 1133, 769, 1134, 1160, 1132, 1130, 1339, 1163, 1657,
-	829, 830, 828, 1655, 1152, 1262, 1904, 1607, 579, 829,
-	830, 828, 1164, 1165, 1166, 1458, 1488, 1148, 1149, 1150,
-	1457, 1153, 1456, 1154, 460, 461, 462, 1144, 829, 830,
-	828, 1181, 1565, 1694, 1455, 1104, 1161, 1103, 901, 1499,
-	1496, 1497, 1498, 900, 1493, 899, 1492, 1491, 1489, 867,
-	868, 869, 870, 871, 864, 1179, 1180, 1240, 1182, 697,
-	1351, 1246, 1351, 2102, 1219, 1220, 1221, 1222, 1697, 1223,
-	1224, 1225, 1956, 1254, 1692, 2042, 1257, 2096, 2095, 1388,
-	1705, 1706, 1351, 1387, 1955, 1693, 862, 872, 873, 865,
-	866, 867, 868, 869, 870, 871, 864, 1255, 1275, 1935,
-	1490, 1100, 2078, 1256, 1258, 865, 866, 867, 868, 869,
-	870, 871, 864, 1261, 1884, 1263, 1264, 1821, 1553, 1698,
-	863, 862, 872, 873, 865, 866, 867, 868, 869, 870,
-	871, 864, 1820, 1572, 1576, 1578, 1580, 1582, 1583, 1585,
-	1645, 1499, 1496, 1497, 1498, 1642, 1567, 1568, 1569, 1570,
-	1551, 1552, 1573, 1641, 1554, 1619, 1555, 1556, 1557, 1558,
-	1559, 1560, 1561, 1562, 1563, 1564, 1571, 1278, 1542, 343,
-	424, 2074, 2073, 1524, 1575, 1577, 1579, 1581, 1584, 342,
-	1100, 2062, 1475, 711, 1474, 334, 1376, 1391, 334, 1100,
-	2061, 424, 1389, 334, 1704, 1386, 1438, 1384, 1303, 1360,
-	1826, 1357, 1566, 1350, 1293, 1494, 1495, 863, 862, 872,
-	873, 865, 866, 867, 868, 869, 870, 871, 864, 1335,
-	566, 1700, 829, 830, 828, 1259, 1333, 837, 838, 839,
-	840, 841, 842, 1814, 835, 694, 334, 1638, 2035, 2034,
-	1816, 1995, 826, 1699, 1701, 726, 84, 84, 565, 1523,
-	1344, 1816, 1990, 1514, 1265, 829, 830, 828, 1508, 829,
-	830, 828, 1325, 1543, 1282, 1305, 1094, 1283, 1156, 1978,
-	1285, 829, 830, 828, 1356, 829, 830, 828, 1079, 1295,
-	829, 830, 828, 1525, 52, 1280, 824, 398, 1507, 1301,
-	1302, 19, 755, 1281, 1506, 1707, 1292, 1341, 1342, 1289,
-	1329, 1505, 1967, 1966, 1330, 1504, 1331, 1695, 1304, 1347,
-	829, 830, 828, 1816, 1945, 468, 829, 830, 828, 1503,
-	1124, 1271, 1337, 829, 830, 828, 1324, 829, 830, 828,
-	1816, 1944, 487, 1369, 1502, 1334, 466, 1340, 1816, 1943,
-	465, 829, 830, 828, 466, 1343, 1372, 1373, 12, 1485,
-	6, 1250, 5, 1816, 1942, 1332, 829, 830, 828, 914,
-	1352, 1401, 914, 1353, 1354, 1404, 79, 1484, 879, 1933,
-	1932, 829, 830, 828, 1156, 822, 467, 334, 1882, 1883,
-	1108, 334, 334, 1882, 1881, 334, 1825, 1824, 1407, 829,
-	830, 828, 1823, 1822, 52, 1483, 572, 1574, 424, 2098,
-	1249, 1398, 1408, 1362, 1363, 1364, 1365, 1366, 1367, 1368,
-	84, 1435, 1816, 1815, 75, 1396, 538, 829, 830, 828,
-	468, 1403, 829, 830, 828, 1240, 1159, 1527, 1371, 1370,
-	1351, 1509, 1351, 1500, 1377, 1351, 1359, 1381, 84, 1480,
-	1379, 2044, 401, 2038, 1400, 1351, 1358, 1393, 1392, 2020,
-	799, 1402, 1159, 1279, 1399, 1405, 799, 1406, 1410, 2017,
-	1411, 1459, 2015, 1412, 1274, 1273, 1268, 1267, 1159, 1158,
-	1958, 1419, 1454, 1100, 1099, 700, 699, 1896, 1880, 1878,
-	1873, 1835, 1462, 1463, 1622, 1812, 1811, 1810, 1807, 1416,
-	1418, 1796, 1522, 1781, 1721, 1718, 1717, 1624, 575, 1633,
-	1636, 1464, 1465, 1601, 1594, 1466, 1241, 1519, 334, 1307,
-	1284, 1266, 1521, 1157, 1146, 1139, 906, 904, 1480, 1479,
-	84, 903, 902, 898, 852, 895, 893, 892, 1513, 1587,
-	444, 447, 448, 449, 445, 1482, 446, 450, 889, 75,
-	1510, 861, 860, 1808, 859, 1501, 694, 857, 856, 855,
-	1518, 854, 853, 850, 849, 52, 848, 847, 846, 845,
-	1512, 844, 1540, 843, 1516, 708, 691, 469, 1120, 1520,
-	1618, 1541, 1526, 2025, 1604, 444, 447, 448, 449, 445,
-	439, 446, 450, 2023, 1602, 1089, 1531, 1986, 1591, 1083,
-	1084, 444, 447, 448, 449, 445, 1319, 446, 450, 1586,
-	1550, 1590, 1155, 1590, 1592, 1086, 489, 1595, 303, 720,
-	718, 1088, 1600, 717, 721, 719, 1528, 716, 2082, 1269,
-	334, 334, 2000, 556, 84, 557, 1609, 1625, 1626, 1627,
-	722, 1125, 448, 449, 424, 1663, 1422, 1608, 1110, 1111,
-	1429, 493, 1529, 1118, 779, 1631, 1752, 1435, 1634, 1530,
-	1637, 413, 415, 416, 1073, 1428, 820, 452, 335, 1178,
-	1177, 1617, 495, 1639, 499, 500, 2039, 1963, 1961, 1915,
-	1914, 1912, 1838, 1836, 1647, 1652, 1656, 1616, 1539, 1726,
-	1728, 1708, 1726, 1726, 1538, 1712, 1478, 498, 1650, 1715,
-	1716, 1688, 424, 1477, 1660, 342, 1714, 1713, 343, 1346,
-	694, 1640, 1361, 1719, 2026, 1722, 1723, 1286, 342, 799,
-	2027, 2026, 2027, 281, 451, 355, 1, 501, 1727, 704,
-	433, 875, 701, 878, 432, 430, 74, 1251, 1648, 1649,
-	1729, 1730, 1190, 641, 2040, 909, 915, 876, 877, 874,
-	1731, 863, 862, 872, 873, 865, 866, 867, 868, 869,
-	870, 871, 864, 1874, 1999, 1756, 2031, 1957, 2002, 1739,
-	630, 614, 1907, 1423, 1827, 1909, 1829, 1299, 1746, 1745,
-	1296, 490, 1394, 1395, 653, 643, 894, 644, 1732, 863,
-	862, 872, 873, 865, 866, 867, 868, 869, 870, 871,
-	864, 686, 414, 642, 1738, 1471, 1784, 344, 84, 412,
-	356, 1801, 1534, 1709, 1759, 1635, 1720, 1187, 2091, 2081,
-	1604, 2057, 2037, 1923, 2076, 1968, 2018, 2011, 1919, 1753,
-	1757, 1758, 1728, 1761, 1762, 1763, 1764, 1708, 1744, 1767,
-	1768, 1769, 1770, 1771, 1772, 1773, 1774, 1775, 1776, 1777,
-	1778, 1779, 1780, 1786, 307, 1782, 786, 532, 1839, 1800,
-	380, 1805, 1897, 387, 709, 1447, 1313, 1116, 1095, 737,
-	1813, 308, 1948, 1879, 347, 1119, 348, 1122, 1121, 836,
-	1872, 1817, 1239, 896, 887, 582, 1378, 621, 615, 52,
-	1468, 458, 1467, 1703, 774, 26, 1837, 453, 827, 923,
-	86, 1136, 924, 1916, 1747, 459, 2004, 629, 424, 1852,
-	628, 424, 424, 424, 627, 626, 1390, 424, 443, 441,
-	440, 299, 298, 1345, 1476, 1842, 1843, 823, 825, 1983,
-	1818, 1848, 1849, 1982, 1937, 1938, 1917, 1885, 1646, 1653,
-	1893, 1894, 1895, 1795, 1892, 1859, 1903, 1791, 1787, 1902,
-	1929, 1662, 1661, 1689, 1690, 1696, 1549, 1545, 1547, 1918,
-	1548, 1911, 863, 862, 872, 873, 865, 866, 867, 868,
-	869, 870, 871, 864, 84, 1546, 1925, 1926, 1544, 1433,
-	1434, 424, 1431, 863, 862, 872, 873, 865, 866, 867,
-	868, 869, 870, 871, 864, 1430, 1085, 424, 1081, 911,
-	918, 418, 753, 81, 1931, 297, 1162, 576, 11, 18,
-	1940, 863, 862, 872, 873, 865, 866, 867, 868, 869,
-	870, 871, 864, 17, 16, 815, 1946, 47, 46, 45,
-	44, 15, 8, 1954, 43, 1962, 1960, 1964, 1965, 42,
-	41, 14, 13, 37, 36, 35, 1971, 1973, 34, 33,
-	32, 31, 30, 29, 28, 27, 9, 1979, 56, 2006,
-	55, 54, 53, 1991, 1992, 1993, 1994, 20, 2010, 21,
-	22, 2005, 1936, 62, 61, 60, 59, 58, 25, 10,
-	7, 4, 2014, 2, 2016, 0, 2009, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2021, 0, 0, 2024, 2022, 0, 0, 0, 2033, 0,
-	0, 0, 2028, 0, 0, 1996, 424, 0, 424, 0,
-	2030, 0, 0, 0, 0, 2041, 0, 2043, 0, 742,
-	0, 742, 2046, 0, 0, 0, 2006, 2056, 0, 0,
-	0, 0, 2052, 0, 0, 424, 0, 0, 2005, 0,
-	2060, 2055, 0, 0, 2063, 0, 0, 0, 742, 0,
-	0, 2033, 2069, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2079, 0, 0, 0, 0, 0, 0,
-	0, 2080, 0, 0, 0, 0, 0, 0, 2090, 0,
-	2089, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2101, 2100, 2099, 2090, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1041, 1027, 0, 989, 1043, 961, 977,
-	1051, 979, 980, 1014, 939, 998, 211, 975, 931, 964,
-	965, 933, 972, 934, 962, 991, 155, 960, 1030, 1001,
-	180, 1049, 182, 0, 0, 240, 195, 0, 2071, 994,
-	1032, 996, 1019, 988, 1015, 947, 1008, 1044, 976, 1012,
-	1045, 0, 0, 0, 0, 460, 461, 462, 0, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 1011, 1037,
-	974, 0, 0, 948, 1042, 995, 1013, 0, 932, 1009,
-	0, 937, 940, 1050, 1035, 969, 970, 0, 0, 0,
-	0, 0, 0, 0, 992, 997, 1016, 985, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 966, 0, 1005,
-	0, 0, 0, 942, 938, 0, 990, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 1039, 1040, 149, 275, 941, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	1061, 1062, 1063, 1064, 1065, 946, 0, 967, 1017, 0,
-	930, 1026, 1033, 987, 269, 1036, 984, 983, 1068, 0,
-	1067, 244, 1069, 1070, 179, 1031, 963, 973, 968, 971,
-	230, 213, 1038, 1004, 218, 228, 183, 255, 222, 260,
-	246, 268, 1020, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 1066, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 929, 264, 0, 209, 1028, 935,
-	945, 943, 981, 1006, 1007, 205, 280, 1022, 1025, 1023,
-	1052, 233, 1210, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 936, 0, 241, 262, 274, 265, 982, 954, 993,
-	273, 957, 955, 1021, 956, 1010, 1054, 199, 200, 201,
-	202, 978, 0, 142, 1002, 986, 1055, 1056, 1057, 1058,
-	1059, 1060, 959, 1034, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 953, 958, 952,
-	999, 1000, 1046, 1047, 1048, 1018, 944, 1029, 949, 951,
-	950, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1024, 1003, 124, 0, 181, 1053, 224, 160, 0, 0,
-	0, 0, 0, 1206, 0, 1203, 0, 0, 0, 1205,
-	1202, 1204, 1208, 1209, 0, 0, 0, 1207, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
-	649, 0, 0, 0, 1071, 1072, 277, 278, 279, 263,
-	211, 0, 0, 0, 0, 0, 623, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 665, 671, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 616, 0, 0, 583,
-	655, 654, 632, 0, 0, 0, 138, 633, 0, 638,
-	0, 634, 637, 635, 636, 0, 0, 657, 0, 0,
-	0, 0, 0, 581, 620, 0, 624, 0, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1213,
-	1214, 1215, 1216, 1217, 1218, 1211, 1212, 617, 618, 0,
-	0, 0, 0, 650, 0, 619, 0, 0, 652, 0,
-	639, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 647, 648, 149, 609,
-	645, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 663, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 646, 0, 230, 213, 674, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	661, 209, 673, 656, 658, 659, 662, 666, 667, 607,
-	610, 668, 670, 672, 675, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	608, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	651, 199, 200, 201, 202, 664, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 681, 660, 680, 682, 683, 679, 684, 685, 669,
-	625, 0, 677, 676, 678, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 78,
-	224, 160, 88, 585, 586, 587, 588, 589, 590, 591,
-	96, 592, 98, 99, 593, 101, 594, 103, 595, 105,
-	106, 107, 596, 597, 598, 599, 112, 600, 601, 602,
-	603, 117, 118, 119, 120, 604, 605, 606, 649, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 623, 0, 0, 0, 155, 800,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 665, 671, 0, 0, 0, 0, 0,
-	0, 796, 0, 0, 616, 0, 0, 583, 655, 654,
-	632, 0, 0, 0, 138, 633, 0, 638, 0, 634,
-	637, 635, 636, 0, 0, 657, 0, 0, 0, 0,
-	0, 581, 620, 0, 624, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 617, 618, 0, 0, 0,
-	0, 650, 0, 619, 0, 0, 797, 0, 639, 0,
+	681, 1296, 2168, 2166, 2165, 2173, 2139, 663, 2113, 647,
+	1992, 683, 2083, 1771, 2128, 1295, 2028, 1738, 2061, 1960,
+	2062, 559, 1661, 51, 84, 1963, 1937, 295, 693, 52,
+	525, 665, 1891, 1519, 1128, 1769, 306, 1878, 826, 661,
+	84, 308, 557, 1778, 1770, 1948, 87, 1761, 459, 340,
+	340, 1852, 1463, 299, 19, 52, 397, 1892, 1360, 1666,
+	83, 1631, 1760, 1667, 1459, 1669, 1678, 1674, 1727, 513,
+	583, 1487, 1401, 398, 660, 301, 597, 810, 1496, 419,
+	1336, 1475, 1468, 84, 1617, 1464, 1414, 662, 1533, 1134,
+	840, 1532, 1257, 1243, 833, 567, 855, 529, 672, 409,
+	858, 3, 346, 408, 410, 841, 849, 836, 766, 52,
+	803, 850, 1702, 1330, 298, 12, 616, 296, 6, 425,
+	297, 5, 778, 1142, 1264, 644, 807, 780, 779, 642,
+	1294, 641, 828, 288, 19, 1101, 1031, 310, 497, 1088,
+	1022, 436, 461, 418, 568, 390, 633, 1038, 476, 312,
+	80, 447, 1786, 1657, 311, 1518, 655, 291, 406, 1896,
+	843, 79, 302, 536, 2020, 550, 602, 1870, 1212, 79,
+	416, 23, 39, 24, 315, 315, 404, 1034, 1402, 1331,
+	2009, 79, 422, 23, 39, 24, 1897, 342, 1378, 347,
+	1897, 1988, 77, 1895, 1987, 12, 1895, 1689, 6, 1089,
+	1874, 5, 1968, 79, 511, 1219, 414, 413, 496, 75,
+	79, 345, 532, 1222, 684, 691, 2049, 75, 377, 537,
+	685, 782, 690, 650, 686, 689, 687, 688, 79, 75,
+	491, 684, 691, 1309, 487, 534, 412, 685, 1796, 690,
+	391, 686, 689, 687, 688, 792, 793, 360, 2087, 2047,
+	1876, 75, 594, 526, 527, 591, 524, 1405, 75, 523,
+	526, 527, 1975, 405, 1406, 1978, 1407, 2065, 2066, 1789,
+	1520, 367, 1879, 1880, 1881, 1882, 593, 439, 601, 610,
+	611, 654, 1476, 1477, 1478, 1479, 1199, 430, 84, 429,
+	1497, 482, 79, 1034, 23, 39, 24, 2019, 1500, 1036,
+	804, 84, 428, 1339, 1337, 1334, 1338, 1340, 378, 1333,
+	1332, 1851, 65, 1747, 1746, 478, 72, 1743, 409, 483,
+	1654, 52, 52, 410, 489, 490, 488, 1515, 463, 634,
+	411, 468, 477, 1868, 1643, 40, 469, 443, 1639, 2051,
+	75, 2075, 1499, 1642, 1858, 1339, 1337, 1688, 1338, 1340,
+	2046, 2158, 2174, 464, 362, 636, 2092, 2017, 1994, 2022,
+	2023, 2064, 604, 427, 359, 358, 1342, 1343, 1344, 1345,
+	603, 2099, 2149, 604, 1990, 1991, 502, 1994, 1846, 439,
+	1962, 603, 415, 486, 1220, 354, 2000, 340, 533, 1815,
+	1814, 480, 344, 398, 398, 398, 1949, 1950, 1951, 1953,
+	1952, 2053, 2054, 481, 484, 546, 485, 68, 69, 2175,
+	70, 71, 512, 479, 401, 522, 521, 2169, 419, 441,
+	440, 2140, 1803, 1426, 515, 1480, 517, 596, 424, 635,
+	1415, 514, 535, 1640, 570, 1973, 1216, 1164, 1042, 473,
+	562, 1472, 768, 613, 516, 429, 84, 84, 84, 84,
+	432, 433, 52, 1841, 1348, 1837, 1516, 630, 617, 300,
+	1676, 1675, 1358, 52, 57, 67, 76, 1160, 38, 357,
+	1162, 1161, 540, 340, 340, 429, 340, 592, 382, 353,
+	538, 539, 463, 795, 66, 64, 63, 403, 648, 796,
+	1350, 499, 518, 1159, 340, 340, 794, 379, 380, 2153,
+	434, 2117, 657, 526, 527, 767, 1506, 464, 2021, 631,
+	571, 573, 315, 1427, 340, 1210, 340, 374, 774, 84,
+	1871, 441, 440, 2052, 545, 1922, 1209, 384, 383, 1198,
+	1402, 361, 2131, 787, 605, 340, 526, 527, 773, 1192,
+	501, 1186, 1154, 1037, 475, 605, 1961, 340, 398, 1473,
+	340, 1091, 811, 1016, 1136, 775, 1797, 1798, 811, 805,
+	1213, 556, 785, 78, 1349, 818, 599, 572, 564, 345,
+	48, 78, 493, 1893, 1798, 1638, 49, 340, 340, 825,
+	84, 769, 419, 78, 1641, 834, 839, 838, 582, 442,
+	426, 817, 606, 1061, 847, 847, 852, 405, 569, 315,
+	854, 649, 829, 788, 612, 78, 783, 629, 618, 619,
+	620, 621, 78, 1396, 50, 834, 827, 409, 784, 776,
+	777, 860, 410, 646, 653, 652, 637, 830, 345, 1033,
+	78, 52, 2132, 553, 554, 555, 52, 401, 656, 651,
+	528, 315, 531, 1842, 1843, 770, 861, 576, 577, 578,
+	579, 580, 1394, 772, 781, 1339, 1337, 519, 1338, 1340,
+	1050, 1839, 530, 371, 1395, 1838, 789, 820, 1077, 549,
+	551, 372, 315, 1250, 1311, 1310, 1085, 1809, 2135, 806,
+	1032, 552, 1078, 816, 801, 1469, 1472, 1248, 1249, 1247,
+	409, 2126, 1018, 802, 78, 1076, 1488, 2004, 563, 1194,
+	853, 1030, 1166, 315, 1020, 846, 819, 431, 823, 1017,
+	403, 821, 1529, 1350, 1258, 824, 1420, 1258, 822, 813,
+	814, 815, 1431, 558, 1328, 831, 465, 466, 467, 560,
+	1228, 1923, 1925, 1926, 1927, 1924, 465, 466, 467, 560,
+	548, 1229, 859, 771, 2129, 2130, 520, 1692, 1049, 1047,
+	1015, 465, 466, 467, 560, 1079, 1080, 1081, 1082, 1986,
+	1013, 1027, 1318, 1047, 1848, 1014, 1048, 1049, 1047, 84,
+	84, 1847, 1083, 1621, 1531, 465, 466, 467, 1633, 1048,
+	1049, 1047, 295, 1616, 1691, 1422, 561, 73, 1041, 1156,
+	1048, 1049, 1047, 1832, 1473, 1110, 561, 1408, 340, 1466,
+	1302, 829, 2164, 1467, 1470, 1131, 1133, 1048, 1049, 1047,
+	1304, 561, 2145, 1092, 2109, 811, 811, 811, 340, 1093,
+	1090, 369, 2093, 370, 377, 2148, 830, 2033, 368, 366,
+	365, 373, 381, 375, 376, 1634, 1933, 1046, 1931, 1184,
+	1534, 1048, 1049, 1047, 1111, 1112, 1064, 1065, 1066, 1067,
+	1068, 1061, 1145, 1146, 1147, 1471, 1424, 2058, 1985, 1423,
+	1984, 1593, 407, 1545, 1542, 1543, 1544, 2147, 1539, 1939,
+	1538, 1537, 1535, 1932, 1045, 1930, 1148, 1917, 1916, 1157,
+	1048, 1049, 1047, 1048, 1049, 1047, 1966, 1143, 1062, 1063,
+	1064, 1065, 1066, 1067, 1068, 1061, 1898, 1110, 1048, 1049,
+	1047, 1929, 1919, 1150, 385, 1152, 1662, 1915, 1912, 1048,
+	1049, 1047, 1149, 1153, 781, 1151, 1906, 1903, 1902, 1048,
+	1049, 1047, 1855, 315, 1536, 1069, 1070, 1062, 1063, 1064,
+	1065, 1066, 1067, 1068, 1061, 1163, 1793, 1792, 1928, 1918,
+	1167, 1168, 1169, 1171, 1129, 1130, 1791, 1790, 1581, 1197,
+	1053, 1054, 1055, 1056, 1057, 1058, 1787, 1051, 1773, 1172,
+	1627, 1173, 1626, 1600, 1604, 1606, 1608, 1610, 1611, 1613,
+	1625, 1545, 1542, 1543, 1544, 1624, 1595, 1596, 1597, 1598,
+	1579, 1580, 1601, 1390, 1582, 421, 1583, 1584, 1585, 1586,
+	1587, 1588, 1589, 1590, 1591, 1592, 1599, 600, 1048, 1049,
+	1047, 465, 466, 467, 1603, 1605, 1607, 1609, 1612, 2088,
+	2074, 2057, 1938, 2011, 1998, 1997, 1920, 1200, 2178, 1913,
+	429, 1863, 1204, 1909, 1908, 1205, 2146, 1907, 1207, 1540,
+	1541, 1853, 1594, 617, 1834, 340, 1788, 1361, 340, 1660,
+	1072, 429, 1075, 340, 1048, 1049, 1047, 1223, 1224, 1225,
+	1226, 1227, 1658, 1635, 1215, 1485, 1073, 1074, 1071, 1484,
+	1060, 1059, 1069, 1070, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1061, 1060, 1059, 1069, 1070, 1062, 1063, 1064, 1065,
+	1066, 1067, 1068, 1061, 1483, 1482, 1106, 1105, 1259, 1260,
+	1104, 1044, 1263, 1693, 2043, 1043, 2156, 1298, 2042, 1231,
+	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
+	1242, 1429, 2183, 2005, 1252, 1253, 1048, 1049, 1047, 1305,
+	1436, 1202, 1946, 1429, 1435, 1886, 1567, 1230, 1885, 767,
+	1312, 1313, 1794, 1566, 2177, 2176, 1203, 1059, 1069, 1070,
+	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1061, 1307, 1048,
+	1049, 1047, 1040, 2159, 1694, 1355, 1048, 1049, 1047, 2155,
+	2154, 1251, 1685, 1214, 1211, 340, 349, 351, 350, 1684,
+	1245, 1040, 2143, 1665, 1217, 84, 84, 1570, 348, 1366,
+	1507, 839, 838, 84, 1040, 2142, 1371, 1372, 2116, 2115,
+	811, 847, 1502, 1382, 847, 1501, 811, 1385, 1447, 1565,
+	1347, 1439, 1327, 2077, 2076, 52, 1437, 834, 1564, 340,
+	1434, 1363, 1364, 340, 340, 1865, 2072, 340, 52, 575,
+	1388, 1299, 1048, 1049, 1047, 1351, 1297, 1433, 1300, 1430,
+	19, 1048, 1049, 1047, 1428, 345, 1326, 1602, 1306, 1357,
+	1308, 1865, 2067, 1379, 1563, 1389, 1175, 2055, 1352, 1301,
+	1353, 2041, 2040, 632, 1143, 574, 1367, 1417, 1370, 2134,
+	1421, 1562, 1409, 1429, 2026, 1346, 1377, 1048, 1049, 1047,
+	409, 1356, 1384, 1429, 2025, 1076, 1354, 1362, 1365, 2179,
+	1561, 1429, 1381, 1559, 1048, 1049, 1047, 1182, 1368, 1412,
+	1413, 12, 1187, 1374, 6, 1386, 1359, 5, 1391, 1387,
+	1383, 1392, 1380, 1048, 1049, 1047, 1048, 1049, 1047, 1865,
+	2015, 1440, 1865, 2014, 1865, 2013, 1443, 1444, 1445, 1865,
+	2012, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 2003, 2002,
+	429, 1180, 1730, 1446, 1944, 1945, 1411, 1571, 1397, 1399,
+	1393, 1410, 84, 1462, 2125, 1558, 1245, 1034, 1400, 1419,
+	1314, 1315, 1316, 1317, 1319, 1320, 1321, 1322, 1323, 1324,
+	1325, 1944, 1943, 1557, 1890, 1889, 1508, 1733, 1048, 1049,
+	1047, 1556, 1888, 1887, 1728, 1865, 1864, 1505, 1486, 1525,
+	1741, 1742, 1429, 1560, 598, 1729, 1048, 1049, 1047, 2123,
+	1528, 1429, 1523, 473, 1048, 1049, 1047, 1178, 1510, 340,
+	1060, 1059, 1069, 1070, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1061, 1255, 1048, 1049, 1047, 1481, 1429, 1442, 1734,
+	1489, 1490, 1530, 2119, 1254, 1429, 1441, 1019, 1550, 1491,
+	1492, 1551, 472, 1552, 1553, 1060, 1059, 1069, 1070, 1062,
+	1063, 1064, 1065, 1066, 1067, 1068, 1061, 1048, 1049, 1047,
+	1178, 1201, 1493, 1196, 1195, 1190, 1189, 84, 1178, 1177,
+	1040, 1039, 608, 607, 492, 1193, 1615, 1547, 471, 1509,
+	470, 1175, 1127, 581, 471, 79, 473, 547, 1555, 1554,
+	2100, 2097, 2095, 2032, 52, 1971, 1958, 1942, 1940, 1935,
+	1514, 1527, 1883, 1569, 1740, 1873, 1465, 1872, 1524, 1668,
+	1861, 1860, 1859, 1526, 1856, 1845, 84, 1550, 1830, 1568,
+	811, 1632, 1757, 340, 340, 1546, 1754, 84, 1753, 1670,
+	584, 1736, 1679, 75, 1511, 1682, 1629, 1622, 1548, 1246,
+	1630, 1329, 1262, 1664, 1206, 1188, 1176, 1165, 1619, 1158,
+	1126, 1125, 1124, 1735, 1737, 1123, 1122, 1121, 1120, 1614,
+	1618, 1578, 1618, 1655, 1620, 325, 1623, 324, 328, 320,
+	1119, 1628, 1118, 1117, 1116, 1690, 1115, 1114, 1113, 316,
+	1636, 1102, 429, 1699, 1637, 1109, 1108, 1107, 1103, 1099,
+	335, 1648, 1097, 1096, 1095, 1462, 1650, 1653, 1094, 1087,
+	1086, 75, 614, 595, 474, 1743, 1857, 1671, 1672, 1673,
+	1663, 1023, 1024, 1139, 2105, 2103, 2063, 1731, 1341, 1677,
+	1680, 1174, 1683, 1026, 494, 1029, 1028, 1762, 1764, 623,
+	1762, 1762, 2121, 626, 1768, 1744, 622, 624, 627, 2163,
+	429, 598, 625, 1191, 309, 2080, 1724, 1748, 1651, 1652,
+	1696, 1751, 1752, 565, 1750, 1749, 566, 1144, 1776, 1779,
+	628, 1403, 453, 454, 498, 1755, 1456, 1758, 1759, 1695,
+	449, 452, 453, 454, 450, 1763, 451, 455, 1060, 1059,
+	1069, 1070, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1061,
+	1129, 1130, 1765, 1766, 341, 1512, 1784, 1137, 791, 1801,
+	1285, 1767, 1513, 1455, 1800, 586, 588, 589, 1012, 832,
+	457, 1805, 500, 1775, 2120, 1060, 1059, 1069, 1070, 1062,
+	1063, 1064, 1065, 1066, 1067, 1068, 1061, 1311, 1310, 1799,
+	508, 509, 506, 507, 2037, 444, 348, 318, 317, 321,
+	504, 505, 2035, 1980, 1979, 323, 449, 452, 453, 454,
+	450, 1977, 451, 455, 84, 1795, 1900, 327, 449, 452,
+	453, 454, 450, 1884, 451, 455, 1632, 1659, 1647, 1808,
+	1644, 638, 1549, 1522, 1521, 503, 1646, 1504, 1764, 598,
+	1833, 1432, 1806, 1807, 1867, 1810, 1811, 1812, 1813, 1744,
+	1849, 1816, 1817, 1818, 1819, 1820, 1821, 1822, 1823, 1824,
+	1825, 1826, 1827, 1828, 1829, 1831, 1835, 349, 351, 350,
+	2107, 2106, 797, 1208, 287, 2106, 1854, 1901, 2107, 348,
+	456, 1281, 363, 1278, 1, 1862, 510, 1280, 1277, 1279,
+	1283, 1284, 438, 609, 437, 1282, 435, 74, 1894, 1934,
+	52, 1866, 1256, 1265, 695, 842, 848, 322, 326, 639,
+	463, 330, 640, 1936, 2079, 332, 333, 334, 2112, 2031,
+	336, 337, 2082, 682, 664, 1899, 1972, 429, 1404, 1875,
+	429, 429, 429, 1974, 1877, 464, 429, 1914, 1221, 1783,
+	1218, 495, 1779, 1375, 1376, 720, 698, 1098, 699, 590,
+	1904, 1905, 587, 697, 1982, 1774, 1910, 1911, 1969, 1970,
+	1498, 352, 1947, 585, 364, 1955, 1956, 1957, 1850, 1517,
+	1745, 1965, 1681, 1756, 1954, 1303, 2172, 1983, 2162, 2138,
+	1964, 2118, 1993, 1438, 1967, 1976, 1266, 1267, 1268, 1269,
+	1270, 1271, 1272, 1273, 1274, 1275, 1276, 1288, 1289, 1290,
+	1291, 1292, 1293, 1286, 1287, 2157, 84, 2045, 2098, 2091,
+	1989, 1802, 2006, 429, 313, 798, 541, 1995, 1996, 388,
+	1959, 395, 615, 1474, 1335, 1135, 1035, 643, 1416, 429,
+	1060, 1059, 1069, 1070, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1061, 827, 2029, 2001, 314, 2018, 1941, 2010, 1060,
+	1059, 1069, 1070, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1061, 355, 1138, 356, 2016, 1141, 1140, 1052, 1244, 1100,
+	2036, 2024, 2038, 2039, 1084, 659, 1418, 671, 2034, 1495,
+	1494, 1739, 786, 26, 458, 1183, 856, 86, 1894, 2044,
+	1155, 2048, 2050, 857, 1981, 1785, 2084, 1687, 1686, 1425,
+	2056, 1261, 1077, 2086, 680, 679, 678, 677, 2068, 2069,
+	2070, 2071, 2090, 448, 2085, 446, 1078, 445, 305, 304,
+	1869, 2027, 1777, 1503, 409, 1645, 1179, 1181, 2060, 1076,
+	2089, 1060, 1059, 1069, 1070, 1062, 1063, 1064, 1065, 1066,
+	1067, 1068, 1061, 2059, 2007, 2008, 1656, 1844, 2101, 2029,
+	1921, 2104, 2102, 1840, 1836, 1999, 1698, 2094, 2114, 2096,
+	2108, 2073, 1697, 1725, 2111, 1726, 429, 2110, 429, 1732,
+	1577, 1573, 1575, 1576, 1574, 2122, 1572, 2124, 1460, 648,
+	1461, 648, 1458, 1457, 1025, 1021, 844, 2086, 2137, 851,
+	423, 765, 2133, 81, 303, 1369, 429, 420, 2085, 2136,
+	835, 2141, 11, 18, 17, 2144, 16, 2152, 47, 648,
+	2127, 46, 2114, 2150, 45, 44, 15, 8, 43, 42,
+	41, 14, 13, 37, 2160, 36, 35, 34, 33, 32,
+	31, 30, 2161, 29, 28, 27, 9, 56, 55, 2171,
+	54, 2170, 53, 20, 21, 22, 62, 61, 60, 59,
+	58, 25, 2181, 2180, 2171, 10, 7, 4, 2, 0,
+	0, 979, 906, 926, 965, 2182, 925, 981, 895, 912,
+	989, 914, 916, 951, 872, 935, 211, 910, 864, 898,
+	899, 866, 907, 867, 896, 928, 156, 894, 968, 938,
+	180, 987, 182, 0, 0, 240, 195, 0, 0, 931,
+	970, 933, 957, 924, 952, 880, 945, 982, 911, 949,
+	983, 0, 0, 0, 0, 465, 466, 467, 0, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 948,
+	975, 909, 0, 0, 882, 980, 932, 950, 0, 865,
+	946, 0, 870, 873, 988, 973, 903, 904, 0, 0,
+	0, 0, 0, 0, 0, 929, 934, 954, 921, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 900, 0,
+	942, 0, 0, 0, 875, 871, 0, 927, 0, 129,
+	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
+	166, 150, 208, 977, 978, 149, 275, 874, 267, 133,
+	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
+	198, 999, 1000, 1001, 1002, 1003, 879, 0, 901, 955,
+	0, 863, 964, 971, 923, 269, 974, 920, 919, 1006,
+	0, 1005, 244, 1007, 1008, 179, 969, 897, 908, 902,
+	905, 230, 213, 976, 941, 218, 228, 183, 255, 222,
+	260, 246, 268, 958, 223, 125, 247, 152, 194, 136,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
+	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
+	237, 147, 127, 217, 253, 1004, 165, 225, 190, 128,
+	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 862, 264, 0, 209, 966,
+	868, 878, 876, 917, 943, 944, 205, 280, 960, 963,
+	961, 990, 233, 0, 0, 0, 0, 0, 173, 215,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 869, 0, 241, 262, 274, 265, 918, 888,
+	930, 273, 891, 889, 959, 890, 947, 992, 199, 200,
+	201, 202, 913, 0, 142, 153, 939, 922, 993, 994,
+	995, 996, 997, 998, 893, 972, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 887, 892,
+	886, 936, 937, 984, 985, 986, 956, 877, 967, 883,
+	885, 884, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 962, 281, 881, 915, 282, 953, 1010, 283, 284,
+	285, 286, 940, 124, 0, 181, 991, 224, 161, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	0, 704, 0, 0, 0, 1009, 1011, 277, 278, 279,
+	263, 211, 0, 0, 0, 0, 0, 673, 0, 0,
+	0, 156, 0, 0, 0, 180, 0, 182, 0, 0,
+	240, 195, 0, 0, 0, 0, 737, 745, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 666, 0, 2078,
+	694, 725, 724, 684, 691, 0, 0, 138, 0, 685,
+	0, 690, 0, 686, 689, 687, 688, 0, 0, 729,
+	0, 0, 0, 0, 0, 658, 670, 0, 674, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 667,
+	668, 0, 0, 0, 0, 705, 0, 669, 0, 0,
+	707, 0, 692, 0, 129, 245, 259, 139, 236, 272,
+	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
+	175, 130, 0, 227, 154, 166, 150, 208, 702, 703,
+	149, 751, 700, 267, 133, 134, 266, 207, 254, 258,
+	193, 187, 132, 256, 191, 186, 178, 158, 170, 220,
+	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 0, 0, 735, 0, 0, 0, 244, 0, 0,
+	179, 0, 0, 0, 701, 0, 230, 213, 748, 0,
+	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
+	125, 247, 152, 194, 136, 137, 148, 155, 157, 159,
+	160, 203, 204, 216, 235, 248, 249, 250, 151, 144,
+	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
+	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 264, 733, 209, 747, 728, 730, 731, 734, 738,
+	739, 740, 741, 742, 744, 746, 750, 233, 0, 0,
+	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	262, 274, 749, 0, 0, 0, 273, 0, 0, 0,
+	0, 0, 706, 199, 200, 201, 202, 736, 0, 142,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 167, 0, 169, 141, 214, 164, 271, 176,
+	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
+	261, 239, 188, 757, 732, 756, 758, 759, 755, 760,
+	761, 743, 676, 0, 753, 752, 754, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 281, 0, 0,
+	282, 0, 0, 283, 284, 285, 286, 0, 124, 0,
+	181, 78, 224, 161, 88, 713, 714, 715, 675, 716,
+	711, 712, 96, 708, 98, 99, 696, 101, 717, 103,
+	718, 105, 106, 107, 762, 763, 764, 721, 112, 727,
+	726, 719, 709, 117, 118, 119, 120, 722, 723, 710,
+	704, 0, 277, 278, 279, 263, 0, 0, 0, 0,
+	211, 0, 0, 0, 0, 0, 673, 0, 0, 0,
+	156, 0, 0, 0, 180, 0, 182, 0, 0, 240,
+	195, 0, 0, 0, 0, 737, 745, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 666, 0, 0, 694,
+	725, 724, 684, 691, 0, 0, 138, 0, 685, 0,
+	690, 0, 686, 689, 687, 688, 0, 0, 729, 0,
+	0, 0, 0, 0, 658, 670, 0, 674, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 667, 668,
+	0, 0, 0, 0, 705, 0, 669, 0, 0, 707,
+	0, 692, 0, 129, 245, 259, 139, 236, 272, 143,
+	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
+	130, 0, 227, 154, 166, 150, 208, 702, 703, 149,
+	751, 700, 267, 133, 134, 266, 207, 254, 258, 193,
+	187, 132, 256, 191, 186, 178, 158, 170, 220, 185,
+	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 0, 735, 0, 0, 0, 244, 0, 0, 179,
+	0, 0, 0, 701, 0, 230, 213, 748, 0, 218,
+	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
+	247, 152, 194, 136, 137, 148, 155, 157, 159, 160,
+	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
+	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
+	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	264, 733, 209, 747, 728, 730, 731, 734, 738, 739,
+	740, 741, 742, 744, 746, 750, 233, 0, 0, 0,
+	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
+	274, 749, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 706, 199, 200, 201, 202, 736, 0, 142, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 167, 0, 169, 141, 214, 164, 271, 176, 206,
+	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
+	239, 188, 757, 732, 756, 758, 759, 755, 760, 761,
+	743, 676, 0, 753, 752, 754, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1780, 1781, 1782, 282,
+	0, 0, 283, 284, 285, 286, 0, 124, 0, 181,
+	0, 224, 161, 88, 713, 714, 715, 675, 716, 711,
+	712, 96, 708, 98, 99, 696, 101, 717, 103, 718,
+	105, 106, 107, 762, 763, 764, 721, 112, 727, 726,
+	719, 709, 117, 118, 119, 120, 722, 723, 710, 0,
+	0, 277, 278, 279, 263, 79, 0, 704, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 211, 0, 0,
+	0, 0, 0, 673, 0, 0, 0, 156, 0, 0,
+	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	0, 0, 737, 745, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 666, 0, 0, 694, 725, 724, 684,
+	691, 0, 0, 138, 0, 685, 0, 690, 0, 686,
+	689, 687, 688, 0, 0, 729, 0, 0, 0, 0,
+	0, 658, 670, 0, 674, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 667, 668, 0, 0, 0,
+	0, 705, 0, 669, 0, 0, 707, 0, 692, 0,
 	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
 	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 647, 648, 149, 609, 645, 267,
+	154, 166, 150, 208, 702, 703, 149, 751, 700, 267,
 	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
+	191, 186, 178, 158, 170, 220, 185, 221, 171, 197,
 	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 663,
+	0, 0, 0, 0, 0, 0, 269, 0, 0, 735,
 	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	646, 0, 230, 213, 674, 0, 218, 228, 183, 255,
+	701, 0, 230, 213, 748, 0, 218, 228, 183, 255,
 	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
+	136, 137, 148, 155, 157, 159, 160, 203, 204, 216,
 	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
 	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
 	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 661, 209,
-	673, 656, 658, 659, 662, 666, 667, 607, 610, 668,
-	670, 672, 675, 233, 0, 0, 0, 0, 0, 173,
+	0, 0, 0, 0, 0, 163, 0, 264, 733, 209,
+	747, 728, 730, 731, 734, 738, 739, 740, 741, 742,
+	744, 746, 750, 233, 0, 0, 0, 0, 0, 173,
 	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 608, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 651, 199,
-	200, 201, 202, 664, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 681,
-	660, 680, 682, 683, 679, 684, 685, 669, 625, 0,
-	677, 676, 678, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	98, 99, 593, 101, 594, 103, 595, 105, 106, 107,
-	596, 597, 598, 599, 112, 600, 601, 602, 603, 117,
-	118, 119, 120, 604, 605, 606, 649, 0, 277, 278,
+	0, 0, 0, 0, 0, 241, 262, 274, 749, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 706, 199,
+	200, 201, 202, 736, 0, 142, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 167, 0,
+	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
+	184, 226, 270, 212, 231, 140, 261, 239, 188, 757,
+	732, 756, 758, 759, 755, 760, 761, 743, 676, 0,
+	753, 752, 754, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 281, 0, 0, 282, 0, 0, 283,
+	284, 285, 286, 0, 124, 0, 181, 78, 224, 161,
+	88, 713, 714, 715, 675, 716, 711, 712, 96, 708,
+	98, 99, 696, 101, 717, 103, 718, 105, 106, 107,
+	762, 763, 764, 721, 112, 727, 726, 719, 709, 117,
+	118, 119, 120, 722, 723, 710, 704, 0, 277, 278,
 	279, 263, 0, 0, 0, 0, 211, 0, 0, 0,
-	0, 0, 623, 0, 0, 0, 155, 2070, 0, 0,
+	0, 0, 673, 0, 0, 0, 156, 812, 0, 0,
 	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 665, 671, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 616, 0, 0, 583, 655, 654, 632, 0,
-	0, 0, 138, 633, 0, 638, 0, 634, 637, 635,
-	636, 0, 0, 657, 0, 0, 0, 0, 0, 581,
-	620, 0, 624, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 617, 618, 0, 0, 0, 0, 650,
-	0, 619, 0, 0, 652, 0, 639, 0, 129, 245,
+	0, 737, 745, 0, 0, 0, 0, 0, 0, 808,
+	0, 0, 666, 0, 0, 694, 725, 724, 684, 691,
+	0, 0, 138, 0, 685, 0, 690, 0, 686, 689,
+	687, 688, 0, 0, 729, 0, 0, 0, 0, 0,
+	658, 670, 0, 674, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 667, 668, 0, 0, 0, 0,
+	705, 0, 669, 0, 0, 809, 0, 692, 0, 129,
+	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
+	166, 150, 208, 702, 703, 149, 751, 700, 267, 133,
+	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 269, 0, 0, 735, 0,
+	0, 0, 244, 0, 0, 179, 0, 0, 0, 701,
+	0, 230, 213, 748, 0, 218, 228, 183, 255, 222,
+	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
+	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
+	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
+	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 264, 733, 209, 747,
+	728, 730, 731, 734, 738, 739, 740, 741, 742, 744,
+	746, 750, 233, 0, 0, 0, 0, 0, 173, 215,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 241, 262, 274, 749, 0, 0,
+	0, 273, 0, 0, 0, 0, 0, 706, 199, 200,
+	201, 202, 736, 0, 142, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 757, 732,
+	756, 758, 759, 755, 760, 761, 743, 676, 0, 753,
+	752, 754, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 281, 0, 0, 282, 0, 0, 283, 284,
+	285, 286, 0, 124, 0, 181, 0, 224, 161, 88,
+	713, 714, 715, 675, 716, 711, 712, 96, 708, 98,
+	99, 696, 101, 717, 103, 718, 105, 106, 107, 762,
+	763, 764, 721, 112, 727, 726, 719, 709, 117, 118,
+	119, 120, 722, 723, 710, 704, 0, 277, 278, 279,
+	263, 0, 0, 0, 0, 211, 0, 0, 0, 0,
+	0, 673, 0, 0, 0, 156, 2151, 0, 0, 180,
+	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	737, 745, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 666, 0, 0, 694, 725, 724, 684, 691, 0,
+	0, 138, 0, 685, 0, 690, 0, 686, 689, 687,
+	688, 0, 0, 729, 0, 0, 0, 0, 0, 658,
+	670, 0, 674, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 667, 668, 0, 0, 0, 0, 705,
+	0, 669, 0, 0, 707, 0, 692, 0, 129, 245,
 	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 647, 648, 149, 609, 645, 267, 133, 134,
+	257, 242, 192, 174, 175, 130, 0, 227, 154, 166,
+	150, 208, 702, 703, 149, 751, 700, 267, 133, 134,
 	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
+	178, 158, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 663, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 646, 0,
-	230, 213, 674, 0, 218, 228, 183, 255, 222, 260,
+	0, 0, 0, 0, 269, 0, 0, 735, 0, 0,
+	0, 244, 0, 0, 179, 0, 0, 0, 701, 0,
+	230, 213, 748, 0, 218, 228, 183, 255, 222, 260,
 	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
+	148, 155, 157, 159, 160, 203, 204, 216, 235, 248,
 	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
 	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
 	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 661, 209, 673, 656,
-	658, 659, 662, 666, 667, 607, 610, 668, 670, 672,
-	675, 233, 0, 0, 0, 0, 0, 173, 215, 0,
+	0, 0, 0, 163, 0, 264, 733, 209, 747, 728,
+	730, 731, 734, 738, 739, 740, 741, 742, 744, 746,
+	750, 233, 0, 0, 0, 0, 0, 173, 215, 0,
 	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 608, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 651, 199, 200, 201,
-	202, 664, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 681, 660, 680,
-	682, 683, 679, 684, 685, 669, 625, 0, 677, 676,
-	678, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 585,
-	586, 587, 588, 589, 590, 591, 96, 592, 98, 99,
-	593, 101, 594, 103, 595, 105, 106, 107, 596, 597,
-	598, 599, 112, 600, 601, 602, 603, 117, 118, 119,
-	120, 604, 605, 606, 649, 0, 277, 278, 279, 263,
+	0, 0, 0, 241, 262, 274, 749, 0, 0, 0,
+	273, 0, 0, 0, 0, 0, 706, 199, 200, 201,
+	202, 736, 0, 142, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 167, 0, 169, 141,
+	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
+	270, 212, 231, 140, 261, 239, 188, 757, 732, 756,
+	758, 759, 755, 760, 761, 743, 676, 0, 753, 752,
+	754, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 281, 0, 0, 282, 0, 0, 283, 284, 285,
+	286, 0, 124, 0, 181, 0, 224, 161, 88, 713,
+	714, 715, 675, 716, 711, 712, 96, 708, 98, 99,
+	696, 101, 717, 103, 718, 105, 106, 107, 762, 763,
+	764, 721, 112, 727, 726, 719, 709, 117, 118, 119,
+	120, 722, 723, 710, 704, 0, 277, 278, 279, 263,
 	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	623, 0, 0, 0, 155, 800, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 665,
-	671, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	616, 0, 0, 583, 655, 654, 632, 0, 0, 0,
-	138, 633, 0, 638, 0, 634, 637, 635, 636, 0,
-	0, 657, 0, 0, 0, 0, 0, 581, 620, 0,
-	624, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 617, 618, 0, 0, 0, 0, 650, 0, 619,
-	0, 0, 652, 0, 639, 0, 129, 245, 259, 139,
+	673, 0, 0, 0, 156, 812, 0, 0, 180, 0,
+	182, 0, 0, 240, 195, 0, 0, 0, 0, 737,
+	745, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	666, 0, 0, 694, 725, 724, 684, 691, 0, 0,
+	138, 0, 685, 0, 690, 0, 686, 689, 687, 688,
+	0, 0, 729, 0, 0, 0, 0, 0, 658, 670,
+	0, 674, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 667, 668, 0, 0, 0, 0, 705, 0,
+	669, 0, 0, 707, 0, 692, 0, 129, 245, 259,
+	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
+	242, 192, 174, 175, 130, 0, 227, 154, 166, 150,
+	208, 702, 703, 149, 751, 700, 267, 133, 134, 266,
+	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
+	158, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 269, 0, 0, 735, 0, 0, 0,
+	244, 0, 0, 179, 0, 0, 0, 701, 0, 230,
+	213, 748, 0, 218, 228, 183, 255, 222, 260, 246,
+	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
+	155, 157, 159, 160, 203, 204, 216, 235, 248, 249,
+	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
+	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
+	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 0, 264, 733, 209, 747, 728, 730,
+	731, 734, 738, 739, 740, 741, 742, 744, 746, 750,
+	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 241, 262, 274, 749, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 706, 199, 200, 201, 202,
+	736, 0, 142, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 167, 0, 169, 141, 214,
+	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
+	212, 231, 140, 261, 239, 188, 757, 732, 756, 758,
+	759, 755, 760, 761, 743, 676, 0, 753, 752, 754,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	281, 0, 0, 282, 0, 0, 283, 284, 285, 286,
+	0, 124, 0, 181, 0, 224, 161, 88, 713, 714,
+	715, 675, 716, 711, 712, 96, 708, 98, 99, 696,
+	101, 717, 103, 718, 105, 106, 107, 762, 763, 764,
+	721, 112, 727, 726, 719, 709, 117, 118, 119, 120,
+	722, 723, 710, 704, 0, 277, 278, 279, 263, 0,
+	0, 0, 0, 211, 0, 0, 0, 0, 0, 673,
+	0, 0, 0, 156, 0, 0, 0, 180, 0, 182,
+	0, 0, 240, 195, 0, 0, 0, 0, 737, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 666,
+	0, 0, 694, 725, 724, 684, 691, 0, 0, 138,
+	0, 685, 0, 690, 0, 686, 689, 687, 688, 0,
+	0, 729, 0, 0, 0, 0, 0, 658, 670, 0,
+	674, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 667, 668, 837, 0, 0, 0, 705, 0, 669,
+	0, 0, 707, 0, 692, 0, 129, 245, 259, 139,
 	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	647, 648, 149, 609, 645, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
+	192, 174, 175, 130, 0, 227, 154, 166, 150, 208,
+	702, 703, 149, 751, 700, 267, 133, 134, 266, 207,
+	254, 258, 193, 187, 132, 256, 191, 186, 178, 158,
 	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 663, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 646, 0, 230, 213,
-	674, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
+	0, 0, 269, 0, 0, 735, 0, 0, 0, 244,
+	0, 0, 179, 0, 0, 0, 701, 0, 230, 213,
+	748, 0, 218, 228, 183, 255, 222, 260, 246, 268,
+	0, 223, 125, 247, 152, 194, 136, 137, 148, 155,
+	157, 159, 160, 203, 204, 216, 235, 248, 249, 250,
 	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
 	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
 	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 661, 209, 673, 656, 658, 659,
-	662, 666, 667, 607, 610, 668, 670, 672, 675, 233,
+	0, 163, 0, 264, 733, 209, 747, 728, 730, 731,
+	734, 738, 739, 740, 741, 742, 744, 746, 750, 233,
 	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 608, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 651, 199, 200, 201, 202, 664,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
+	0, 241, 262, 274, 749, 0, 0, 0, 273, 0,
+	0, 0, 0, 0, 706, 199, 200, 201, 202, 736,
+	0, 142, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 167, 0, 169, 141, 214, 164,
+	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
+	231, 140, 261, 239, 188, 757, 732, 756, 758, 759,
+	755, 760, 761, 743, 676, 0, 753, 752, 754, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
+	0, 0, 282, 0, 0, 283, 284, 285, 286, 0,
+	124, 0, 181, 0, 224, 161, 88, 713, 714, 715,
+	675, 716, 711, 712, 96, 708, 98, 99, 696, 101,
+	717, 103, 718, 105, 106, 107, 762, 763, 764, 721,
+	112, 727, 726, 719, 709, 117, 118, 119, 120, 722,
+	723, 710, 704, 0, 277, 278, 279, 263, 0, 0,
+	0, 0, 211, 0, 0, 0, 0, 0, 673, 0,
+	0, 0, 156, 0, 0, 0, 180, 0, 182, 0,
+	0, 240, 195, 0, 0, 0, 0, 737, 745, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 666, 0,
+	0, 694, 725, 724, 684, 691, 0, 0, 138, 0,
+	685, 0, 690, 0, 686, 689, 687, 688, 0, 0,
+	729, 0, 0, 0, 0, 0, 658, 670, 0, 674,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	667, 668, 0, 0, 0, 0, 705, 0, 669, 0,
+	0, 707, 0, 692, 0, 129, 245, 259, 139, 236,
+	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
+	174, 175, 130, 0, 227, 154, 166, 150, 208, 702,
+	703, 149, 751, 700, 267, 133, 134, 266, 207, 254,
+	258, 193, 187, 132, 256, 191, 186, 178, 158, 170,
+	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 269, 0, 0, 735, 0, 0, 0, 244, 0,
+	0, 179, 0, 0, 0, 701, 0, 230, 213, 748,
+	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
+	223, 125, 247, 152, 194, 136, 137, 148, 155, 157,
+	159, 160, 203, 204, 216, 235, 248, 249, 250, 151,
+	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
+	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 264, 733, 209, 747, 728, 730, 731, 734,
+	738, 739, 740, 741, 742, 744, 746, 750, 233, 0,
+	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	241, 262, 274, 749, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 706, 199, 200, 201, 202, 736, 0,
+	142, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 162, 167, 0, 169, 141, 214, 164, 271,
 	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 681, 660, 680, 682, 683,
-	679, 684, 685, 669, 625, 0, 677, 676, 678, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 585, 586, 587,
-	588, 589, 590, 591, 96, 592, 98, 99, 593, 101,
-	594, 103, 595, 105, 106, 107, 596, 597, 598, 599,
-	112, 600, 601, 602, 603, 117, 118, 119, 120, 604,
-	605, 606, 649, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 623, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 665, 671, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 616, 0,
-	0, 583, 655, 654, 632, 0, 0, 0, 138, 633,
-	0, 638, 0, 634, 637, 635, 636, 0, 0, 657,
-	0, 0, 0, 0, 0, 581, 620, 0, 624, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 617,
-	618, 578, 0, 0, 0, 650, 0, 619, 0, 0,
-	652, 0, 639, 0, 129, 245, 259, 139, 236, 272,
+	140, 261, 239, 188, 757, 732, 756, 758, 759, 755,
+	760, 761, 743, 676, 0, 753, 752, 754, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 281, 0,
+	0, 282, 0, 0, 283, 284, 285, 286, 0, 124,
+	0, 181, 0, 224, 161, 88, 713, 714, 715, 675,
+	716, 711, 712, 96, 708, 98, 99, 696, 101, 717,
+	103, 718, 105, 106, 107, 762, 763, 764, 721, 112,
+	727, 726, 719, 709, 117, 118, 119, 120, 722, 723,
+	710, 704, 0, 277, 278, 279, 263, 0, 0, 0,
+	0, 211, 0, 0, 0, 0, 0, 673, 0, 0,
+	0, 156, 0, 0, 0, 180, 0, 182, 0, 0,
+	240, 195, 0, 0, 0, 0, 737, 745, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2030, 0, 0,
+	694, 725, 724, 684, 691, 0, 0, 138, 0, 685,
+	0, 690, 0, 686, 689, 687, 688, 0, 0, 729,
+	0, 0, 0, 0, 0, 658, 670, 0, 674, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 667,
+	668, 0, 0, 0, 0, 705, 0, 669, 0, 0,
+	707, 0, 692, 0, 129, 245, 259, 139, 236, 272,
 	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 647, 648,
-	149, 609, 645, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
+	175, 130, 0, 227, 154, 166, 150, 208, 702, 703,
+	149, 751, 700, 267, 133, 134, 266, 207, 254, 258,
+	193, 187, 132, 256, 191, 186, 178, 158, 170, 220,
 	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 663, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 646, 0, 230, 213, 674, 0,
+	269, 0, 0, 735, 0, 0, 0, 244, 0, 0,
+	179, 0, 0, 0, 701, 0, 230, 213, 748, 0,
 	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
+	125, 247, 152, 194, 136, 137, 148, 155, 157, 159,
+	160, 203, 204, 216, 235, 248, 249, 250, 151, 144,
 	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
 	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 661, 209, 673, 656, 658, 659, 662, 666,
-	667, 607, 610, 668, 670, 672, 675, 233, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 264, 733, 209, 747, 728, 730, 731, 734, 738,
+	739, 740, 741, 742, 744, 746, 750, 233, 0, 0,
 	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 608, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 651, 199, 200, 201, 202, 664, 0, 142,
+	262, 274, 749, 0, 0, 0, 273, 0, 0, 0,
+	0, 0, 706, 199, 200, 201, 202, 736, 0, 142,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 167, 0, 169, 141, 214, 164, 271, 176,
+	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
+	261, 239, 188, 757, 732, 756, 758, 759, 755, 760,
+	761, 743, 676, 0, 753, 752, 754, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 281, 0, 0,
+	282, 0, 0, 283, 284, 285, 286, 0, 124, 0,
+	181, 0, 224, 161, 88, 713, 714, 715, 675, 716,
+	711, 712, 96, 708, 98, 99, 696, 101, 717, 103,
+	718, 105, 106, 107, 762, 763, 764, 721, 112, 727,
+	726, 719, 709, 117, 118, 119, 120, 722, 723, 710,
+	704, 0, 277, 278, 279, 263, 0, 0, 0, 0,
+	211, 0, 0, 0, 0, 0, 673, 0, 0, 0,
+	156, 0, 0, 0, 180, 0, 182, 0, 0, 240,
+	195, 0, 0, 0, 0, 737, 745, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 666, 0, 0, 694,
+	725, 724, 684, 691, 0, 0, 138, 0, 685, 0,
+	690, 0, 686, 689, 687, 688, 0, 0, 729, 0,
+	0, 0, 0, 0, 0, 670, 0, 674, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 667, 668,
+	0, 0, 0, 0, 705, 0, 669, 0, 0, 707,
+	0, 692, 0, 129, 245, 259, 139, 236, 272, 143,
+	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
+	130, 0, 227, 154, 166, 150, 208, 702, 703, 149,
+	751, 700, 267, 133, 134, 266, 207, 254, 258, 193,
+	187, 132, 256, 191, 186, 178, 158, 170, 220, 185,
+	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 0, 735, 0, 0, 0, 244, 0, 0, 179,
+	0, 0, 0, 701, 0, 230, 213, 748, 0, 218,
+	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
+	247, 152, 194, 136, 137, 148, 155, 157, 159, 160,
+	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
+	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
+	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	264, 733, 209, 747, 728, 730, 731, 734, 738, 739,
+	740, 741, 742, 744, 746, 750, 233, 0, 0, 0,
+	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
+	274, 749, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 706, 199, 200, 201, 202, 736, 0, 142, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
+	162, 167, 0, 169, 141, 214, 164, 271, 176, 206,
 	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 681, 660, 680, 682, 683, 679, 684,
-	685, 669, 625, 0, 677, 676, 678, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 585, 586, 587, 588, 589,
-	590, 591, 96, 592, 98, 99, 593, 101, 594, 103,
-	595, 105, 106, 107, 596, 597, 598, 599, 112, 600,
-	601, 602, 603, 117, 118, 119, 120, 604, 605, 606,
-	649, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 623, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 665, 671, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 616, 0, 0, 583,
-	655, 654, 632, 0, 0, 0, 138, 633, 0, 638,
-	0, 634, 637, 635, 636, 0, 0, 657, 0, 0,
-	0, 0, 0, 581, 620, 0, 624, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 617, 618, 0,
-	0, 0, 0, 650, 0, 619, 0, 0, 652, 0,
-	639, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 647, 648, 149, 609,
-	645, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 663, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 646, 0, 230, 213, 674, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	661, 209, 673, 656, 658, 659, 662, 666, 667, 607,
-	610, 668, 670, 672, 675, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	608, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	651, 199, 200, 201, 202, 664, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 681, 660, 680, 682, 683, 679, 684, 685, 669,
-	625, 0, 677, 676, 678, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 585, 586, 587, 588, 589, 590, 591,
-	96, 592, 98, 99, 593, 101, 594, 103, 595, 105,
-	106, 107, 596, 597, 598, 599, 112, 600, 601, 602,
-	603, 117, 118, 119, 120, 604, 605, 606, 649, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 623, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 665, 671, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 616, 0, 0, 583, 655, 654,
-	632, 0, 0, 0, 138, 633, 0, 638, 0, 634,
-	637, 635, 636, 0, 0, 657, 0, 0, 0, 0,
-	0, 0, 620, 0, 624, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 617, 618, 0, 0, 0,
-	0, 650, 0, 619, 0, 0, 652, 0, 639, 0,
+	239, 188, 757, 732, 756, 758, 759, 755, 760, 761,
+	743, 676, 0, 753, 752, 754, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 281, 0, 0, 282,
+	0, 0, 283, 284, 285, 286, 0, 124, 0, 181,
+	0, 224, 161, 88, 713, 714, 715, 675, 716, 711,
+	712, 96, 708, 98, 99, 696, 101, 717, 103, 718,
+	105, 106, 107, 762, 763, 764, 721, 112, 727, 726,
+	719, 709, 117, 118, 119, 120, 722, 723, 710, 0,
+	0, 277, 278, 279, 263, 325, 0, 324, 328, 320,
+	0, 0, 0, 0, 0, 0, 0, 211, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	335, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 338, 0, 0, 339,
+	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
 	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 647, 648, 149, 609, 645, 267,
+	154, 166, 150, 208, 0, 0, 149, 275, 0, 267,
 	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 663,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	646, 0, 230, 213, 674, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
+	191, 186, 178, 158, 170, 220, 185, 221, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 318, 317, 321,
+	0, 0, 0, 0, 0, 323, 269, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 179, 327, 0, 0,
+	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
+	222, 319, 246, 268, 0, 343, 125, 247, 152, 194,
+	136, 137, 148, 155, 157, 159, 160, 203, 204, 216,
 	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
 	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
 	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 661, 209,
-	673, 656, 658, 659, 662, 666, 667, 607, 610, 668,
-	670, 672, 675, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 608, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 651, 199,
-	200, 201, 202, 664, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 681,
-	660, 680, 682, 683, 679, 684, 685, 669, 625, 0,
-	677, 676, 678, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	98, 99, 593, 101, 594, 103, 595, 105, 106, 107,
-	596, 597, 598, 599, 112, 600, 601, 602, 603, 117,
-	118, 119, 120, 604, 605, 606, 0, 0, 277, 278,
-	279, 263, 319, 0, 318, 322, 314, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 310, 0, 0, 0,
-	0, 0, 0, 0, 155, 0, 0, 329, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 0, 0, 333, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 0, 318, 322, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	329, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 312, 311, 315, 0, 0, 0, 0,
-	0, 317, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 321, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 313, 246, 268,
-	0, 337, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 312, 311, 315, 0,
-	0, 162, 0, 264, 317, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 321, 0, 0, 233,
-	0, 0, 0, 316, 320, 323, 215, 324, 325, 0,
-	732, 326, 327, 328, 0, 0, 330, 331, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 316, 320, 733, 0,
-	324, 734, 0, 0, 326, 327, 328, 0, 0, 330,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 0, 0, 277, 278, 279, 263, 319, 0,
-	318, 322, 314, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 310, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 329, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 333, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 312,
-	311, 315, 0, 0, 0, 0, 0, 317, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 321,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 313, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 316,
-	320, 323, 215, 324, 325, 0, 0, 326, 327, 328,
-	0, 0, 330, 331, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 0, 0,
-	277, 278, 279, 263, 79, 0, 23, 39, 24, 0,
-	0, 0, 0, 0, 0, 0, 211, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 0, 85, 0, 0, 0, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
-	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
-	0, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
-	202, 284, 286, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 264, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
+	0, 0, 0, 233, 0, 0, 0, 322, 326, 329,
+	215, 330, 331, 0, 0, 332, 333, 334, 0, 0,
+	336, 337, 0, 0, 0, 241, 262, 274, 265, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 0, 142, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 167, 0,
+	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
+	184, 226, 270, 212, 231, 140, 261, 239, 188, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 78, 224, 160, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 211, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
+	0, 0, 0, 281, 0, 0, 282, 0, 0, 283,
+	284, 285, 286, 0, 124, 0, 181, 0, 224, 161,
+	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 0, 0, 277, 278,
+	279, 263, 325, 0, 324, 328, 320, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 156, 0, 0, 335, 180, 0,
 	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 338, 0, 0, 339, 0, 0, 0,
 	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1442, 1445, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1446, 269, 0, 0, 0, 1439, 0, 1438, 244,
-	1440, 1443, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 1444, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 211, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 155, 379, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 85, 391, 392, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 395, 267, 133, 394, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 378, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 381, 199, 200, 201, 202, 0, 0, 142,
+	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
+	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
+	242, 192, 174, 175, 130, 0, 227, 154, 166, 150,
+	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
+	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
+	158, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 318, 317, 321, 0, 0, 0,
+	0, 0, 323, 269, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 179, 327, 0, 0, 0, 0, 230,
+	213, 0, 0, 218, 228, 183, 255, 222, 319, 246,
+	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
+	155, 157, 159, 160, 203, 204, 216, 235, 248, 249,
+	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
+	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
+	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 0, 264, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
+	233, 0, 0, 0, 322, 326, 329, 215, 330, 331,
+	0, 0, 332, 333, 334, 0, 0, 336, 337, 0,
+	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	0, 0, 142, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 167, 0, 169, 141, 214,
+	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
+	212, 231, 140, 261, 239, 188, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 388,
-	384, 385, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 386, 163, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	79, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
-	912, 85, 0, 0, 0, 0, 0, 0, 138, 0,
+	281, 0, 0, 282, 0, 0, 283, 284, 285, 286,
+	0, 124, 0, 181, 0, 224, 161, 88, 89, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 277, 278, 279, 263, 79,
+	0, 23, 39, 24, 0, 0, 0, 0, 0, 0,
+	0, 211, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 0, 0, 180, 0, 182, 0, 0,
+	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 294, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1800,335 +1851,345 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
 	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
+	175, 130, 0, 227, 154, 166, 150, 208, 0, 0,
 	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
+	193, 187, 132, 256, 191, 186, 178, 158, 170, 220,
 	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 293, 0, 0, 0, 0,
 	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
 	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
 	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
+	125, 247, 152, 194, 136, 137, 148, 155, 157, 159,
+	160, 203, 204, 216, 235, 248, 249, 250, 151, 144,
 	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
 	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
 	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
 	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
 	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
 	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 199, 200, 201, 202, 290, 292, 142,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 167, 0, 169, 141, 214, 164, 271, 176,
+	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
+	261, 239, 188, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 78, 224, 160, 88, 89, 90, 91, 92, 93,
+	0, 0, 0, 0, 0, 0, 0, 281, 0, 0,
+	282, 0, 0, 283, 284, 285, 286, 0, 124, 0,
+	181, 78, 224, 161, 88, 89, 90, 91, 92, 93,
 	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
 	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
 	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	0, 211, 277, 278, 279, 263, 832, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 829, 830, 828, 0, 0, 0, 0, 0, 0,
+	211, 0, 277, 278, 279, 263, 0, 0, 0, 0,
+	156, 0, 0, 0, 180, 0, 182, 0, 0, 240,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1469,
+	1472, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
 	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
+	130, 0, 227, 154, 166, 150, 208, 0, 0, 149,
 	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
+	187, 132, 256, 191, 186, 178, 158, 170, 220, 185,
 	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 1473, 269,
+	0, 0, 0, 1466, 0, 1465, 244, 1467, 1470, 179,
 	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
 	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
+	247, 152, 194, 136, 137, 148, 155, 157, 159, 160,
 	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
+	145, 168, 146, 126, 237, 147, 127, 217, 253, 1471,
 	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
 	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
 	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
 	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
 	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 0, 0, 142, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 167, 0, 169, 141, 214, 164, 271, 176, 206,
+	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
+	239, 188, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
+	0, 0, 0, 0, 0, 0, 281, 0, 0, 282,
+	0, 0, 283, 284, 285, 286, 0, 124, 0, 181,
+	0, 224, 161, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
 	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	0, 277, 278, 279, 263, 0, 0, 0, 0, 156,
+	387, 0, 0, 180, 0, 182, 0, 0, 240, 195,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 391,
-	392, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 399,
+	400, 0, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 395,
-	267, 133, 394, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 388, 384, 385, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 386, 163,
+	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
+	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
+	0, 227, 154, 166, 150, 208, 0, 0, 149, 275,
+	403, 267, 133, 402, 266, 207, 254, 258, 193, 187,
+	132, 256, 191, 186, 178, 158, 170, 220, 185, 221,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
+	183, 255, 222, 260, 246, 268, 386, 223, 125, 247,
+	152, 194, 136, 137, 148, 155, 157, 159, 160, 203,
+	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
+	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
+	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 264,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
+	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
+	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
+	389, 199, 200, 201, 202, 0, 0, 142, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
+	167, 0, 169, 141, 214, 164, 271, 176, 396, 392,
+	393, 177, 184, 226, 270, 212, 231, 140, 261, 239,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 281, 0, 0, 282, 0,
+	0, 283, 284, 285, 286, 0, 124, 0, 181, 0,
+	224, 161, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 0, 211,
+	277, 278, 279, 263, 1185, 0, 0, 0, 0, 156,
+	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 0, 0, 277,
-	278, 279, 263, 211, 0, 533, 0, 0, 0, 0,
-	0, 0, 0, 155, 534, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 0, 333, 0, 0, 0, 138,
+	1048, 1049, 1047, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
+	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
+	0, 227, 154, 166, 150, 208, 0, 0, 149, 275,
+	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
+	132, 256, 191, 186, 178, 158, 170, 220, 185, 221,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
+	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
+	152, 194, 136, 137, 148, 155, 157, 159, 160, 203,
+	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
+	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
+	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 264,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
+	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
+	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 0, 142, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
+	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
+	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 281, 0, 0, 282, 0,
+	0, 283, 284, 285, 286, 0, 124, 0, 181, 0,
+	224, 161, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 79, 0,
+	277, 278, 279, 263, 0, 0, 0, 0, 0, 0,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	156, 0, 0, 0, 180, 0, 182, 0, 0, 240,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 0, 845, 85,
+	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 535, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 0, 0, 277, 278, 279, 263, 211, 0, 788,
-	0, 0, 0, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 333,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
+	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
+	130, 0, 227, 154, 166, 150, 208, 0, 0, 149,
+	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
+	187, 132, 256, 191, 186, 178, 158, 170, 220, 185,
+	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
+	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
+	247, 152, 194, 136, 137, 148, 155, 157, 159, 160,
+	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
+	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
+	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
+	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 0, 0, 142, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 167, 0, 169, 141, 214, 164, 271, 176, 206,
+	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
+	239, 188, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 281, 0, 0, 282,
+	0, 0, 283, 284, 285, 286, 0, 124, 0, 181,
+	78, 224, 161, 88, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
+	0, 277, 278, 279, 263, 0, 0, 0, 0, 156,
+	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 787, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 399,
+	400, 0, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2001, 85, 655, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
+	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
+	0, 227, 154, 166, 150, 208, 0, 0, 149, 275,
+	403, 267, 133, 402, 266, 207, 254, 258, 193, 187,
+	132, 256, 191, 186, 178, 158, 170, 220, 185, 221,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
+	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
+	152, 194, 136, 137, 148, 155, 157, 159, 160, 203,
+	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
+	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
+	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 264,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
+	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
+	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 0, 142, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
+	167, 0, 169, 141, 214, 164, 271, 176, 396, 392,
+	393, 177, 184, 226, 270, 212, 231, 140, 261, 239,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 281, 0, 0, 282, 0,
+	0, 283, 284, 285, 286, 0, 124, 0, 181, 0,
+	224, 161, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 0, 0,
+	277, 278, 279, 263, 211, 0, 542, 0, 0, 0,
+	0, 0, 0, 0, 156, 543, 0, 0, 180, 0,
+	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 338, 0, 0, 339, 0, 0, 0,
+	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
 	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
+	242, 192, 174, 175, 130, 0, 227, 154, 166, 150,
 	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
 	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	158, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
 	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
 	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
 	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
+	155, 157, 159, 160, 203, 204, 216, 235, 248, 249,
 	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
 	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
 	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
+	0, 0, 163, 0, 264, 0, 209, 0, 0, 0,
 	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
 	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	0, 0, 0, 0, 544, 0, 199, 200, 201, 202,
+	0, 0, 142, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 167, 0, 169, 141, 214,
+	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
+	212, 231, 140, 261, 239, 188, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
+	281, 0, 0, 282, 0, 0, 283, 284, 285, 286,
+	0, 124, 0, 181, 0, 224, 161, 88, 89, 90,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 739, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 1417, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 1151, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
+	121, 122, 123, 0, 0, 277, 278, 279, 263, 211,
+	0, 800, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 739, 0, 0, 0, 138, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 338, 0,
+	0, 339, 0, 0, 0, 138, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
+	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
+	0, 227, 154, 166, 150, 208, 0, 0, 149, 275,
+	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
+	132, 256, 191, 186, 178, 158, 170, 220, 185, 221,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
+	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
+	152, 194, 136, 137, 148, 155, 157, 159, 160, 203,
+	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
+	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
+	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 264,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
+	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
+	265, 0, 0, 0, 273, 0, 0, 0, 0, 799,
+	0, 199, 200, 201, 202, 0, 0, 142, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
 	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
 	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	0, 0, 0, 0, 0, 281, 0, 0, 282, 0,
+	0, 283, 284, 285, 286, 0, 124, 0, 181, 0,
+	224, 161, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 211, 0,
+	277, 278, 279, 263, 0, 0, 0, 0, 156, 0,
+	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 655,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2081, 85, 725, 0,
+	0, 0, 0, 0, 138, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2136,288 +2197,244 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
 	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
+	227, 154, 166, 150, 208, 0, 0, 149, 275, 0,
 	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
+	256, 191, 186, 178, 158, 170, 220, 185, 221, 171,
 	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
 	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
 	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
 	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
+	194, 136, 137, 148, 155, 157, 159, 160, 203, 204,
 	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
 	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
 	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 264, 0,
 	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
 	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
 	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
 	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	199, 200, 201, 202, 0, 0, 142, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 167,
+	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
+	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	0, 0, 0, 0, 281, 0, 0, 282, 0, 0,
+	283, 284, 285, 286, 0, 124, 0, 181, 0, 224,
+	161, 88, 89, 90, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
 	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
+	278, 279, 263, 0, 0, 0, 0, 156, 0, 0,
 	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1736, 0, 0, 85, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 645,
 	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
+	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
+	154, 166, 150, 208, 0, 0, 149, 275, 0, 267,
+	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
+	191, 186, 178, 158, 170, 220, 185, 221, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
+	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
+	136, 137, 148, 155, 157, 159, 160, 203, 204, 216,
+	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
+	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
+	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 264, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 1398, 199,
+	200, 201, 202, 0, 0, 142, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 167, 0,
+	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
+	184, 226, 270, 212, 231, 140, 261, 239, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 281, 0, 0, 282, 0, 0, 283,
+	284, 285, 286, 0, 124, 0, 181, 0, 224, 161,
+	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 211, 0, 277, 278,
+	279, 263, 0, 0, 0, 0, 156, 1170, 0, 0,
+	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 645, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
 	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
 	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
 	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
 	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
 	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
 	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
 	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
 	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
 	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
 	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
+	0, 0, 0, 0, 163, 0, 264, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
 	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
 	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
 	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
+	201, 202, 0, 0, 142, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
+	0, 0, 281, 0, 0, 282, 0, 0, 283, 284,
+	285, 286, 0, 124, 0, 181, 0, 224, 161, 88,
 	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
+	263, 0, 0, 0, 0, 156, 0, 0, 0, 180,
 	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 739, 0, 0,
+	0, 0, 0, 0, 85, 725, 0, 0, 0, 0,
 	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1481, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 301, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
+	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
+	257, 242, 192, 174, 175, 130, 0, 227, 154, 166,
+	150, 208, 0, 0, 149, 275, 0, 267, 133, 134,
+	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
+	178, 158, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
+	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
+	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
+	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
+	148, 155, 157, 159, 160, 203, 204, 216, 235, 248,
+	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
+	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
+	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 264, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 173, 215, 0,
+	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
+	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
+	202, 0, 0, 142, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 167, 0, 169, 141,
+	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
+	270, 212, 231, 140, 261, 239, 188, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1169, 0,
+	0, 281, 0, 1772, 282, 0, 85, 283, 284, 285,
+	286, 0, 124, 138, 181, 0, 224, 161, 88, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 0, 0, 277, 278, 279, 263,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
+	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
+	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
+	154, 166, 150, 208, 0, 0, 149, 275, 0, 267,
+	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
+	191, 186, 178, 158, 170, 220, 185, 221, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
+	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
+	136, 137, 148, 155, 157, 159, 160, 203, 204, 216,
+	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
+	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
+	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 264, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 0, 142, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 167, 0,
 	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	184, 226, 270, 212, 231, 140, 261, 239, 188, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	0, 0, 0, 281, 0, 0, 282, 0, 0, 283,
+	284, 285, 286, 0, 124, 0, 181, 0, 224, 161,
+	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 211, 0, 277, 278,
+	279, 263, 0, 0, 0, 0, 156, 0, 0, 0,
+	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 333,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 645, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
 	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
 	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
 	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
 	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
 	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
 	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
 	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
 	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
 	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
 	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
+	0, 0, 0, 0, 163, 0, 264, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
 	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
 	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
 	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
+	201, 202, 0, 0, 142, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
+	0, 0, 281, 0, 0, 282, 0, 0, 283, 284,
+	285, 286, 0, 124, 0, 181, 0, 224, 161, 88,
 	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
+	263, 0, 0, 0, 0, 156, 0, 0, 0, 180,
 	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
@@ -2425,357 +2442,496 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1649, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
+	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
+	257, 242, 192, 174, 175, 130, 0, 227, 154, 166,
+	150, 208, 0, 0, 149, 275, 0, 267, 133, 134,
+	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
+	178, 158, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 1113, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 739, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 778, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 409, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 82, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
+	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
+	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
+	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
+	148, 155, 157, 159, 160, 203, 204, 216, 235, 248,
+	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
+	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
+	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 264, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 173, 215, 0,
+	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
+	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
+	202, 0, 0, 142, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 167, 0, 169, 141,
+	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
+	270, 212, 231, 140, 261, 239, 188, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 281, 0, 307, 282, 0, 85, 283, 284, 285,
+	286, 0, 124, 138, 181, 0, 224, 161, 88, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 0, 0, 277, 278, 279, 263,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
+	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
+	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
+	154, 166, 150, 208, 0, 0, 149, 275, 0, 267,
+	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
+	191, 186, 178, 158, 170, 220, 185, 221, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
+	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
+	136, 137, 148, 155, 157, 159, 160, 203, 204, 216,
+	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
+	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
+	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 264, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 0, 142, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 167, 0,
 	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	184, 226, 270, 212, 231, 140, 261, 239, 188, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 281, 0, 0, 282, 0, 0, 283,
+	284, 285, 286, 0, 124, 0, 181, 0, 224, 161,
+	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 211, 0, 277, 278,
+	279, 263, 0, 0, 0, 0, 156, 0, 0, 0,
+	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1373, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
 	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
 	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
 	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
 	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
 	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
 	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
 	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
 	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
 	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
 	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
+	0, 0, 0, 0, 163, 0, 264, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
 	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
 	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
 	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
+	201, 202, 0, 0, 142, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
+	0, 0, 281, 0, 0, 282, 0, 0, 283, 284,
+	285, 286, 0, 124, 0, 181, 0, 224, 161, 88,
 	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 0, 211, 277, 278, 279,
-	263, 455, 0, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
+	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
+	263, 0, 0, 0, 0, 156, 0, 0, 0, 180,
+	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 461, 462, 457, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 338, 0, 0, 339, 0, 0,
+	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
 	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
+	257, 242, 192, 174, 175, 130, 0, 227, 154, 166,
 	150, 208, 0, 0, 149, 275, 0, 267, 133, 134,
 	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
+	178, 158, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
 	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
 	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
 	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
+	148, 155, 157, 159, 160, 203, 204, 216, 235, 248,
 	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
 	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
 	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 0, 209, 0, 0,
+	0, 0, 0, 163, 0, 264, 0, 209, 0, 0,
 	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
 	0, 233, 0, 0, 0, 0, 0, 173, 215, 0,
 	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
 	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
-	202, 0, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 0, 0, 211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	202, 0, 0, 142, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 167, 0, 169, 141,
+	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
+	270, 212, 231, 140, 261, 239, 188, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 281, 0, 0, 282, 0, 85, 283, 284, 285,
+	286, 0, 124, 138, 181, 0, 224, 161, 88, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 0, 0, 277, 278, 279, 263,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 460, 461,
-	462, 457, 0, 0, 0, 138, 0, 0, 0, 0,
+	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
+	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
+	154, 166, 150, 208, 0, 0, 149, 275, 0, 267,
+	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
+	191, 186, 178, 158, 170, 220, 185, 221, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 0, 1132,
+	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
+	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
+	136, 137, 148, 155, 157, 159, 160, 203, 204, 216,
+	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
+	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
+	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 264, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 0, 142, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 167, 0,
+	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
+	184, 226, 270, 212, 231, 140, 261, 239, 188, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 278, 279, 263,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 281, 0, 0, 282, 0, 0, 283,
+	284, 285, 286, 0, 124, 0, 181, 0, 224, 161,
+	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 211, 0, 277, 278,
+	279, 263, 0, 0, 0, 0, 156, 0, 0, 0,
+	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 645, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
+	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
+	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
+	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
+	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
+	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
+	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
+	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 264, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 241, 262, 274, 790, 0, 0,
+	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
+	201, 202, 0, 0, 142, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 156, 0,
+	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 281, 0, 0, 282, 0, 85, 283, 284,
+	285, 286, 0, 124, 138, 181, 0, 224, 161, 88,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 277, 278, 279,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
 	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
+	227, 154, 166, 150, 208, 0, 0, 149, 275, 0,
 	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
+	256, 191, 186, 178, 158, 170, 220, 185, 221, 171,
 	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
 	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
 	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
 	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
+	194, 136, 137, 148, 155, 157, 159, 160, 203, 204,
 	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
 	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
 	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 264, 0,
 	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
 	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
 	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
 	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 211, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
+	199, 200, 201, 202, 0, 0, 142, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 167,
+	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
+	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 82,
+	156, 0, 0, 0, 180, 0, 182, 0, 0, 240,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 417, 281, 0, 0, 282, 0, 85,
+	283, 284, 285, 286, 0, 124, 138, 181, 0, 224,
+	161, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 277,
+	278, 279, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
+	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
+	130, 0, 227, 154, 166, 150, 208, 0, 0, 149,
+	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
+	187, 132, 256, 191, 186, 178, 158, 170, 220, 185,
+	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
+	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
+	247, 152, 194, 136, 137, 148, 155, 157, 159, 160,
+	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
+	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
+	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
+	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 0, 0, 142, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 167, 0, 169, 141, 214, 164, 271, 176, 206,
+	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
+	239, 188, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 156, 0, 0, 0, 180, 0, 182, 0,
 	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 460, 461, 462, 0, 0, 0, 0, 138, 0,
+	0, 0, 0, 0, 0, 0, 281, 0, 0, 282,
+	0, 85, 283, 284, 285, 286, 0, 124, 138, 181,
+	0, 224, 161, 88, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 277, 278, 279, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
+	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
+	174, 175, 130, 0, 227, 154, 166, 150, 208, 0,
+	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
+	258, 193, 187, 132, 256, 191, 186, 178, 158, 170,
+	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
+	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
+	223, 125, 247, 152, 194, 136, 137, 148, 155, 157,
+	159, 160, 203, 204, 216, 235, 248, 249, 250, 151,
+	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
+	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 264, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 0, 0, 0,
+	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
+	142, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 162, 167, 0, 169, 141, 214, 164, 271,
+	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
+	140, 261, 239, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 281, 0,
+	0, 282, 0, 0, 283, 284, 285, 286, 0, 124,
+	0, 181, 0, 224, 161, 88, 89, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 0, 211, 277, 278, 279, 263, 460, 0, 0,
+	0, 0, 156, 0, 0, 0, 180, 0, 182, 0,
+	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 465, 466, 467, 462, 0, 0, 0, 138, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
+	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
+	174, 175, 130, 0, 227, 154, 166, 150, 208, 0,
+	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
+	258, 193, 187, 132, 256, 191, 186, 178, 158, 170,
+	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
+	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
+	223, 125, 247, 152, 194, 136, 137, 148, 155, 157,
+	159, 160, 203, 204, 216, 235, 248, 249, 250, 151,
+	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
+	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 264, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
+	142, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 162, 167, 0, 169, 141, 214, 164, 271,
+	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
+	140, 261, 239, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 156, 0, 0, 0,
+	180, 0, 182, 0, 0, 240, 195, 0, 281, 0,
+	0, 282, 0, 0, 283, 284, 285, 286, 0, 124,
+	0, 181, 0, 224, 161, 465, 466, 467, 462, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 278, 279, 263, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
+	131, 257, 242, 192, 174, 175, 130, 0, 227, 154,
+	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
+	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
+	186, 178, 158, 170, 220, 185, 221, 171, 197, 196,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
+	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
+	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
+	137, 148, 155, 157, 159, 160, 203, 204, 216, 235,
+	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
+	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
+	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 264, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
+	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
+	201, 202, 0, 0, 142, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 167, 0, 169,
+	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
+	226, 270, 212, 231, 140, 261, 239, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	156, 0, 0, 0, 180, 0, 182, 0, 0, 240,
+	195, 0, 281, 0, 0, 282, 0, 0, 283, 284,
+	285, 286, 0, 124, 0, 181, 0, 224, 161, 465,
+	466, 467, 0, 0, 0, 0, 138, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	79, 0, 23, 39, 24, 0, 0, 0, 1686, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	65, 205, 280, 0, 72, 0, 0, 233, 0, 0,
-	0, 0, 1125, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 40, 0, 0, 0, 0, 75, 241,
-	262, 274, 265, 0, 0, 0, 273, 2086, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 1668, 0, 142,
+	0, 0, 0, 0, 0, 0, 0, 277, 278, 279,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
+	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
+	130, 0, 227, 154, 166, 150, 208, 0, 0, 149,
+	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
+	187, 132, 256, 191, 186, 178, 158, 170, 220, 185,
+	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
+	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
+	247, 152, 194, 136, 137, 148, 155, 157, 159, 160,
+	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
+	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
+	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
+	0, 0, 0, 0, 0, 1722, 0, 0, 163, 0,
+	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 1144, 173, 215, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
+	274, 265, 0, 0, 0, 273, 2167, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 1704, 0, 142, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
+	162, 167, 0, 169, 141, 214, 164, 271, 176, 206,
 	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 69, 0, 70, 71, 0,
-	1686, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 1686,
-	181, 0, 224, 160, 1125, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1125, 0, 0, 0, 0, 0, 0,
-	1755, 57, 67, 76, 0, 38, 0, 0, 0, 1668,
-	0, 0, 277, 278, 279, 263, 0, 0, 1672, 0,
-	0, 66, 64, 63, 0, 0, 0, 0, 1668, 1676,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1665,
-	0, 0, 0, 1667, 1669, 1671, 0, 1673, 1674, 1675,
-	1677, 1678, 1679, 1681, 1682, 1683, 1684, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1687,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 48, 0, 0,
-	0, 0, 0, 49, 0, 0, 0, 0, 0, 1685,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1672, 0, 0, 0, 0, 0, 1664, 0, 0, 0,
-	0, 1676, 0, 0, 0, 0, 0, 0, 0, 1672,
-	50, 1680, 0, 0, 0, 0, 0, 0, 1670, 0,
-	1676, 1665, 0, 0, 0, 1667, 1669, 1671, 0, 1673,
-	1674, 1675, 1677, 1678, 1679, 1681, 1682, 1683, 1684, 0,
-	1665, 0, 0, 0, 1667, 1669, 1671, 0, 1673, 1674,
-	1675, 1677, 1678, 1679, 1681, 1682, 1683, 1684, 0, 0,
-	0, 1687, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1687, 78, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1685, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1664, 0,
-	1685, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1680, 0, 0, 0, 1664, 0, 0,
-	1670, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1680, 0, 0, 0, 0, 0, 0, 1670,
+	239, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1722, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1722,
+	0, 0, 0, 0, 0, 0, 281, 1144, 0, 282,
+	0, 0, 283, 284, 285, 286, 0, 124, 0, 181,
+	0, 224, 161, 0, 0, 1144, 0, 0, 0, 0,
+	0, 0, 0, 1804, 0, 0, 0, 0, 0, 0,
+	0, 0, 1704, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1708, 0,
+	1704, 277, 278, 279, 263, 0, 0, 0, 0, 1712,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1701,
+	0, 0, 0, 1703, 1705, 1707, 0, 1709, 1710, 1711,
+	1713, 1714, 1715, 1717, 1718, 1719, 1720, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1723,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1721,
+	0, 0, 0, 0, 1708, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1712, 1700, 0, 0, 0,
+	0, 0, 1708, 0, 0, 0, 0, 0, 0, 0,
+	0, 1716, 0, 1712, 0, 1701, 0, 0, 1706, 1703,
+	1705, 1707, 0, 1709, 1710, 1711, 1713, 1714, 1715, 1717,
+	1718, 1719, 1720, 1701, 0, 0, 0, 1703, 1705, 1707,
+	0, 1709, 1710, 1711, 1713, 1714, 1715, 1717, 1718, 1719,
+	1720, 0, 0, 0, 0, 1723, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1723, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1721, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1700, 1721, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1716, 0, 0,
+	1700, 0, 0, 0, 1706, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1716, 0, 0, 0, 0,
+	0, 0, 1706,
 }
 
 var yyPact = [...]int{
-	16984, -1000, -297, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	284, -1000, -305, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 16520, 1791, -1000, 7921, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 272, 14207,
+	16862, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7474, 7027,
+	167, -1000, 1792, -1000, -1000, -1000, -1000, 168, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 483, -3, 369, 373,
+	395, 395, 8779, 1792, 1467, 202, 19, -1000, 16178, 918,
+	284, 219, 16862, -1000, 468, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 15211, 1612, -1000, 6408, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 191, 12703,
-	15629, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5972, 5536,
-	110, -1000, 1603, -1000, -1000, -1000, -1000, 120, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 519, -41, 279, 284,
-	300, 300, 7244, 1603, 1270, 181, 4, -1000, 14793, 1541,
-	16984, 143, 15629, -1000, 335, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2792,74 +2948,66 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14207, 16862, -41,
+	615, -1000, 161, 173, 195, 467, -1000, -1000, -1000, -1000,
+	16862, 1693, -1000, -1000, -1000, 1675, 17292, 202, -1000, 1417,
+	1419, -1000, -1000, 1538, -1000, 88, 44, 13, 102, -1000,
+	-1000, 189, -1000, -1000, -1000, -1000, -1000, 45, -1000, 33,
+	-1000, 28, -1000, -1000, -1000, -109, -1000, -1000, -1000, -1000,
+	-1000, 1411, 382, 1561, -153, 1625, 1683, 1467, 1747, 1708,
+	1700, 1698, 17, 239, 239, 256, 239, -1000, -1000, -1000,
+	-1000, -1000, -1000, 644, 200, -1000, -1000, -83, -123, 562,
+	-123, 25, -1000, -1000, -1000, -1000, -1000, -1000, 240, -1000,
+	-191, -1000, 349, -1000, 339, -1000, 10514, 188, 1420, 648,
+	-1000, 578, 16862, 16862, 16862, 578, 692, 667, 446, -1000,
+	-1000, -1000, 1611, 1614, 1683, 1467, -1000, 1792, 1792, 1197,
+	1161, 240, 240, 240, 240, 240, 1416, 16862, -1000, 1464,
+	1673, -1000, -1000, 220, 1537, -1000, 16862, 1617, -1000, 444,
+	929, 216, -1000, -1000, 161, 1405, -1000, 205, -1000, -1000,
+	-1000, -1000, 16862, 1536, 16862, 14207, 14207, 14207, 14207, -1000,
+	1583, 1576, -1000, 1584, 1580, 1607, 16862, -1000, -1000, -1000,
+	17646, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1195, 1792,
+	142, 1547, 13436, 15065, 16862, 13436, -1000, -1000, -1000, -1000,
+	-1000, -116, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 142, 13436, 13436, -50, -1000, -1000, -294, 1625,
+	5702, -1000, -1000, 5702, -1000, -1000, -1000, -1000, -1000, -1000,
+	253, 239, -1000, 13436, 659, 15065, 942, 16862, 16862, -1000,
+	-1000, 562, 562, -1000, 644, 644, -1000, -1000, -118, 1755,
+	6580, -90, 16862, 239, 15836, 1662, -110, 367, 351, 358,
+	-1000, -1000, 1796, -1000, -1000, 1336, 10949, 10079, 238, 13436,
+	3946, -1000, -1000, 578, 578, 578, 3946, 473, -1000, -1000,
+	-1000, -1000, -1000, -1000, 16862, -1000, -1000, 1625, -1000, -1000,
+	-1000, 1683, 1625, 1683, -1000, -1000, 13436, 15065, 16862, 16862,
+	18000, 16862, 1416, 1674, 16862, 5263, -1000, -1000, -1000, -1000,
+	-1000, -289, -1000, 9650, 16862, 16862, -1000, 1709, 5702, 2186,
+	-1000, 1677, 161, -1000, -1000, -1000, -1000, 161, 93, -1000,
+	-1000, -1000, -1000, 431, 16862, 1370, -1000, 612, 1548, 1560,
+	1548, -1000, -1000, -1000, -1000, 1573, -1000, 1572, -1000, -1000,
+	1464, -1000, -1000, 570, -1000, -1000, -1000, -1000, -1000, 33,
+	28, 1290, -1000, -13, 87, -1000, -1000, 1403, -1000, -1000,
+	-1000, 570, 1290, 248, 1033, 1029, -1000, 817, 5702, 858,
+	-1000, 956, -1000, -1000, -1000, -1000, 3507, 6580, 6580, 6580,
+	6580, -1000, -1000, 1535, 5702, 1534, 1533, -201, -1000, -1000,
+	-201, 429, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 751, -1000, 1532, 1528, 1527, 1526, 1523, 1515,
+	1522, 1028, 1025, 1024, 1521, 1520, 1519, 6580, 1515, 1515,
+	1512, 1511, 1510, 1508, 1507, 1506, 1504, 1492, 1491, 1490,
+	1489, 1486, 1485, 1484, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 12703, 15629, -76, 434, -1000, 160, 155, 150, 332,
-	-1000, -1000, -1000, -1000, 15629, 1460, -1000, -1000, -1000, 1544,
-	16048, 181, -1000, 1199, 1275, -1000, -1000, 1423, -1000, 91,
-	-7, -30, 73, -1000, -1000, 123, -1000, -1000, -1000, -1000,
-	-1000, 39, -1000, -13, -1000, -20, -1000, -1000, -1000, -114,
-	-1000, -1000, -1000, -1000, -1000, 1191, 307, 1465, -160, 1524,
-	1555, 1270, 1581, 1554, -4, 162, 162, 183, 162, -1000,
-	-1000, -1000, -1000, -1000, -1000, 491, 130, -1000, -1000, -133,
-	-129, 360, -129, 0, -1000, -1000, -1000, -1000, -1000, -1000,
-	163, -1000, -175, -1000, 268, -1000, 265, -1000, 8935, 119,
-	1271, 446, -1000, 372, 15629, 15629, 15629, 372, 722, 695,
-	330, -1000, -1000, -1000, 1503, 1505, 1555, 1270, -1000, 1603,
-	1603, 1102, 1074, 163, 163, 163, 163, 163, 1251, 15629,
-	-1000, 1354, 4244, -1000, -1000, -1000, -1000, -1000, 161, 1422,
-	-1000, 15629, 1444, -1000, 329, 770, 909, -1000, -1000, 160,
-	1330, -1000, 305, -1000, -1000, -1000, -1000, 15629, 1421, 15629,
-	12703, 12703, 12703, 12703, -1000, 1486, 1482, -1000, 1479, 1478,
-	1499, 15629, -1000, -1000, -1000, 16391, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1099, 1603, 100, 5619, 11867, 13539, 15629,
-	11867, -1000, -1000, -1000, -1000, -1000, -116, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 100, 11867, 11867,
-	-87, -1000, -1000, -283, 1524, 4672, -1000, -1000, 4672, -1000,
-	-1000, 180, 162, -1000, 11867, 450, 13539, 877, 15629, 15629,
-	-1000, -1000, 360, 360, -1000, 491, 491, -1000, -1000, -125,
-	1598, 5100, -123, 15629, 162, 14375, 1530, -153, 276, 269,
-	272, -1000, -1000, -163, -1000, -1000, 1170, 9359, 8511, 202,
-	11867, 2960, -1000, -1000, 372, 372, 372, 2960, 294, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15629, -1000, -1000, 1524, -1000,
-	-1000, -1000, 1555, 1524, 1555, -1000, -1000, 11867, 13539, 15629,
-	15629, 16734, 15629, 1251, 1543, 15629, 1141, -1000, -1000, 8093,
-	327, 4672, 1048, 1419, -1000, 1417, 1415, 1414, 1413, 1412,
-	1410, 1409, 1380, 1408, 1407, 1405, -1000, -1000, -1000, 1404,
-	-1000, -1000, 1403, 1380, 1400, 1398, 1397, -1000, -1000, -1000,
-	-1000, 1550, -1000, -1000, -1000, -1000, 2532, 5100, 5100, 5100,
-	5100, -1000, -1000, 1395, 4672, 1394, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 663,
-	-1000, 1383, 1382, 1381, 1380, 1379, 895, 893, 888, 1378,
-	1377, 1373, 5100, 1372, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1415, -1000, 917, 15407, 16862,
+	236, 1661, 1336, 1549, 1616, 1755, 1755, 1755, 562, 18000,
+	644, 16862, 644, -1000, -1000, 644, -1000, 420, 16862, 236,
+	1483, -1000, -1000, -1000, 363, 334, 338, 15065, 247, -1000,
+	-1000, 1336, -1000, -1000, -1000, 1481, 610, -1000, -1000, 6580,
+	-1000, 698, -1000, 3946, 3946, 3946, -1000, 12236, -1000, -1000,
+	1625, -1000, 1625, 1290, 1336, 1558, 1414, -1000, -1000, -1000,
+	-1000, -1000, 1480, 1401, -1000, 1274, -1000, -1000, 9209, 419,
+	1235, -1000, 1479, -1000, 1398, 1598, -1000, 417, 1408, -1000,
+	607, 1396, -1000, 1683, 698, -1000, 407, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -281, -1000, 7674,
-	15629, 15629, -1000, 1590, 4672, 2108, -1000, 1545, -1000, 160,
-	66, -1000, -1000, -1000, -1000, -1000, -1000, 326, 15629, 1133,
-	-1000, 426, 1448, 1464, 1448, -1000, -1000, -1000, -1000, 1480,
-	-1000, 1454, -1000, -1000, 1354, -1000, -1000, 401, -1000, -1000,
-	-1000, -1000, -1000, -13, -20, 1121, -1000, -43, 89, -1000,
-	-1000, 1328, -1000, -1000, -1000, 401, 1121, 174, 887, 885,
-	-1000, 832, 320, 1235, -1000, 779, 13957, 15629, 210, 1529,
-	1170, 1426, 1512, 1598, 1598, 1598, 360, 16734, 491, 15629,
-	491, -1000, -1000, 491, -1000, 316, 15629, 210, 1371, -1000,
-	-1000, -1000, 274, 264, 262, 13539, 170, -1000, -1000, 1170,
-	-1000, -1000, -1000, 1370, 423, -1000, -1000, 5100, -1000, 604,
-	-1000, 2960, 2960, 2960, -1000, 10613, -1000, -1000, 1524, -1000,
-	1524, 1121, 1170, 1461, 1229, -1000, -1000, -1000, -1000, -1000,
-	1369, 1323, -1000, 1598, 4244, -1000, 12703, -1000, 4672, 4672,
-	4672, -1000, 15629, 13121, -1000, 479, 5100, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4672, 1549, 1549, 1549, 4672, 443, 4672,
-	4672, -1000, 710, 2245, 1549, 1549, 1549, 1549, -1000, 1549,
-	1549, 1549, 5100, 5100, 5100, 5100, 5100, 5100, 5100, 5100,
-	5100, 5100, 5100, 5100, 1362, 651, 5100, 5100, 5100, 1074,
-	1254, 1206, -1000, -1000, -1000, -1000, -1000, 448, 604, 4672,
-	-1000, 2245, 4672, 4672, -1000, 1079, -1000, -1000, 4672, -1000,
-	-1000, -1000, 4672, 5100, 4672, -1000, 1549, 1109, -1000, 1367,
-	-1000, 1321, 1496, -1000, 314, 1176, -1000, 422, 1319, -1000,
-	1555, 604, -1000, 312, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2873,151 +3021,165 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -42, -1000, -1000, -1000, 16862, 1393, 1709, 16862,
+	5702, -1000, -1000, 5702, 1478, -1000, 5702, -1000, -1000, -1000,
+	-1000, 1790, 404, 393, 13436, -1000, 150, 13436, -1000, -1000,
+	16862, 246, 13436, 14, -130, 5702, 5702, 5702, 5702, 5702,
+	-1000, 657, 6580, -1000, -1000, -1000, -1000, -1000, -1000, 6580,
+	6580, 6580, 6580, 6580, 6580, 6580, 6580, 6580, 6580, 6580,
+	6580, 1473, 587, 6580, 6580, 6580, 1161, 1366, 1355, -1000,
+	-1000, -1000, -1000, -1000, 629, 698, 5702, 5702, -1000, 1476,
+	-1000, 16862, -1000, 1540, 5702, 5702, 1540, 5702, -1000, 1191,
+	-1000, -1000, 742, 5702, -1000, -1000, -1000, 5702, 6580, 5702,
+	-1000, -1000, -1000, 1695, 5702, 5702, 1695, 1695, 1695, 652,
+	1695, 1695, 1695, 1695, 1695, 1695, 1695, 5702, -1000, -1000,
+	-1000, 1464, 640, 1475, -231, -1000, -10, -1000, 1555, 103,
+	-1000, 1616, -1000, 336, -1000, -1000, -1000, -1000, 1755, -1000,
+	562, -1000, 562, 644, 16862, -1000, -1000, -231, 1181, -1000,
+	-1000, -1000, 329, 1336, 13436, 975, 238, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 16862, 16862, 284, -1000, 16862, 1755,
+	5263, -1000, 14207, -1000, -1000, 16862, 14636, -289, 4824, 153,
+	16862, -289, 16862, 16862, 4824, -1000, 16862, -1000, 2186, 915,
+	-1000, -1000, 1683, -1000, 698, 698, 16862, 698, 13436, 542,
+	554, -1000, 11807, 13436, -1000, -1000, 13436, 120, 1622, -1000,
+	-1000, -80, -68, 698, 698, -1000, 666, 680, -1000, 724,
+	-1000, 819, 1032, -1000, 736, 736, 478, 478, 478, 478,
+	478, 780, 780, -1000, -1000, -1000, 3507, 1473, 6580, 6580,
+	6580, 226, 1957, 1875, -1000, 5702, 626, -1000, 5702, 760,
+	802, -1000, 214, 391, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -79, -1000, -1000, 15629, 1307, 1590, 15629,
-	4672, -1000, -1000, 4672, 1366, -1000, 4672, -1000, -1000, -1000,
-	-1000, 1606, 302, 298, 11867, -1000, 156, 11867, -1000, -1000,
-	15629, 169, 11867, -9, -136, 4672, 4672, 15629, 4672, -1000,
-	-1000, -1000, 1354, 447, 1365, -221, -1000, -60, -1000, 1455,
-	28, -1000, 1512, -1000, 508, -1000, -1000, -1000, -1000, 1598,
-	-1000, 360, -1000, 360, 491, 15629, -1000, -1000, -221, 1073,
-	-1000, -1000, -1000, 263, 1170, 11867, 847, 202, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15629, 15629, 16984, -1000, 15629,
-	1596, -1000, 1164, 1399, -1000, 523, 457, -1000, 297, -1000,
-	-1000, 544, -1000, 1057, 915, 604, 4672, -1000, -1000, 4672,
-	4672, 841, 4672, 1055, 1300, 1290, -1000, 1053, -1000, 1601,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1176, 1224, 698, 1171, 709, 1758,
+	1169, -1000, -1000, 1152, -1000, 1066, 1148, 1856, 1143, 5702,
+	-1000, -1000, 1368, 1360, 5702, 5702, 5702, 5702, 1140, 5702,
+	5702, 5702, 5702, 5702, 5702, 5702, -1000, 1668, 1630, 8350,
+	-1000, -40, -1000, -1000, -1000, 342, -1000, 1023, 1022, 997,
+	993, 16862, -1000, -1000, -1000, -1000, -1000, 604, 604, 604,
+	1611, -1000, 1755, 1755, 562, -1000, 21, -14, -1000, 1290,
+	1137, -1000, -1000, -1000, -1000, 1134, -1000, 1752, -1000, 1320,
+	1705, -1000, 384, -1000, -1000, 1122, 1309, -1000, -289, -1000,
+	-1000, 1235, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1340, 1290, -1000, -1000, -1000, -1000, 13436, 1667,
+	236, -1000, 35, 269, -296, -62, 1746, 1745, -1000, 1334,
+	-1000, 226, 1957, 1296, -1000, 6580, 6580, 1332, 621, -1000,
+	5702, 685, 721, 721, 677, 1709, 1744, 16862, -1000, 5702,
+	-1000, 5702, 5702, -1000, -1000, -1000, 677, -1000, 6580, -1000,
+	1313, -1000, -1000, 1305, 1287, 1225, 1325, -1000, 1222, 1203,
+	1186, 1150, 1141, 1075, 1068, 202, 16862, 1119, 1280, -1000,
+	-1000, -1000, 829, 606, -1000, 16862, 703, 361, 239, 361,
+	693, 1471, -1000, -1000, -40, -1000, 907, 902, 894, 892,
+	32, -1000, -1000, -1000, -1000, -1000, 1470, 677, -1000, 716,
+	991, -1000, -1000, 1755, -1000, 21, -1000, 305, 312, 66,
+	1742, -1000, -1000, 1750, 1740, 14207, 13865, -1000, 4824, 1235,
+	-1000, -1000, 13436, 13436, -232, 27, 16862, -299, 990, -1000,
+	1739, 977, 844, -1000, -1000, 6580, -1000, -1000, -1000, -1000,
+	698, 5702, 1115, -1000, 1443, 1463, -1000, 1443, 1443, 1443,
+	322, 322, 1466, 1466, 1469, 1466, 1111, 1104, -54, 5702,
+	-1000, 698, 726, 1035, 1096, 1601, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1464,
+	18384, 8350, 1301, 5, -1000, -1000, -1000, 1443, -1000, 1463,
+	1443, 1443, 1443, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1462, 1460, -1000, 1443, 1456, 1443, 1443, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4672,
-	4672, 4672, 4672, 4672, 4672, 4672, 634, 894, -1000, 852,
-	852, 313, 313, 313, 313, 313, 910, 910, -1000, -1000,
-	-1000, 2532, 1362, 5100, 5100, 5100, 149, 1810, 1016, -1000,
-	4672, 512, -1000, 4672, 719, -1000, 1051, 761, 1049, -1000,
-	937, 1046, 1761, 1041, 4672, -281, 3816, 187, 15629, -281,
-	15629, 15629, 3816, -1000, 15629, -1000, 2108, 766, -1000, -1000,
-	1555, -1000, 604, 604, 15629, 604, 11867, 349, 369, -1000,
-	10195, 11867, -1000, -1000, 11867, 116, 1519, -1000, -1000, -101,
-	-92, 604, 604, 292, -1000, 1542, 1526, 6826, -1000, -77,
-	-1000, -1000, -1000, 190, -1000, 884, 872, 870, 865, 15629,
-	-1000, -1000, -1000, -1000, -1000, 393, 393, 393, 1503, -1000,
-	1598, 1598, 360, -1000, -11, -51, -1000, 1121, 1038, -1000,
-	-1000, -1000, -1000, 1036, -1000, 1589, 1580, 12703, 12285, -1000,
-	-1000, 4672, 1249, 1221, 1203, 810, 1287, -1000, -1000, -1000,
-	-1000, 4672, 1188, 1173, 1159, 1155, 1148, 1142, 1112, 1285,
-	-1000, 149, 1810, 559, -1000, 5100, 5100, 1107, 439, -1000,
-	4672, 558, 810, 640, -1000, 4672, -1000, -1000, 640, -1000,
-	5100, -1000, 1103, -1000, 1027, 1138, -1000, -281, -1000, -1000,
-	1109, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1281, 1121, -1000, -1000, -1000, -1000, 11867, 1536, 210,
-	-1000, -10, 185, -285, -97, 1578, 1572, 15629, 181, 15629,
-	1022, 1118, -1000, -1000, -1000, 912, 242, -1000, 15629, 529,
-	290, 162, 290, 528, 1360, -1000, -1000, -77, -1000, 765,
-	763, 742, 737, -50, -1000, -1000, -1000, -1000, -1000, 1359,
-	640, -1000, 648, 857, -1000, -1000, 1598, -1000, -11, -1000,
-	255, 252, 20, 1571, -1000, -1000, -1000, 4672, 4672, 1399,
-	-1000, -1000, 604, -1000, -1000, -1000, 1009, -1000, 1340, 1353,
-	-1000, 1340, 1340, 1340, 247, 247, 1355, 1355, 1356, 1355,
-	-1000, 1091, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5100, -1000, -1000, -1000, -1000, 604, 4672, 1007, 999,
-	713, 994, 1782, -1000, -1000, 3816, 1109, -1000, -1000, 11867,
-	11867, -224, -14, 15629, -287, 853, -1000, 1570, 849, 564,
-	-1000, 1354, 17124, 6826, 914, -34, -1000, -1000, -1000, 1340,
-	-1000, 1353, 1340, 1340, 1340, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1352, 1351, -1000, 1340, 1350, 1340,
-	1340, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15629, 15629,
-	-1000, 15629, 15629, 162, 4672, -1000, -1000, -1000, -1000, -1000,
-	-1000, 11449, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 736, -1000, -1000, -1000, 847, 604, 915, -1000,
-	-1000, -1000, 731, -1000, 730, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 718, -1000, -1000, 708, -1000, -1000, -1000,
-	604, -1000, -1000, -1000, 4672, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -123, -290, 702, -1000, 833, -91, -1000, -1000,
-	1533, 142, 17105, -1000, 393, 393, 299, 393, 393, 393,
-	393, 107, 106, 393, 393, 393, 393, 393, 393, 393,
-	393, 393, 393, 393, 393, 393, 393, 1349, -1000, -1000,
-	914, -1000, -1000, 538, 5100, -1000, -1000, 831, 648, 306,
-	336, 1347, -1000, 81, 505, 469, -1000, 15629, -1000, -39,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 828, 828, -1000,
-	-1000, 700, -1000, -1000, 1344, 1401, 49, 1343, -1000, 1342,
-	1341, 15629, 1087, 1267, -1000, 1340, 4672, 12, -1000, -1000,
-	986, 971, 1247, 1241, 1054, -103, -102, -1000, 1337, -1000,
-	-1000, 1567, 181, -1000, 1566, 17124, -1000, 698, 697, 393,
-	393, 696, 826, 813, 811, 393, 393, 694, 809, 16391,
-	665, 664, 660, 656, 808, 395, 622, 614, 611, 15629,
-	1336, 783, -1000, -1000, 1810, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 657, 1335, -1000, -1000,
-	1334, -1000, -1000, 1238, -1000, 1233, 968, 11449, 37, 37,
-	11449, 11449, 11449, 1333, 245, -1000, 11449, 1523, 860, -1000,
-	-1000, -1000, -1000, 646, -1000, 638, -1000, 165, -99, -102,
-	-1000, 1565, -93, 1564, 1563, 15629, 564, -1000, 60, -1000,
-	-1000, -1000, 640, 640, -1000, -1000, -1000, -1000, 807, 788,
+	-1000, -1000, -1000, -1000, -1000, -1000, 16862, 16862, -1000, 16862,
+	16862, 239, 5702, -1000, -1000, -1000, -1000, -1000, -1000, 13007,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	890, -1000, -1000, -1000, 975, -1000, 5702, 3060, 1705, -1000,
+	-1000, -1000, -1000, -1000, -1000, -90, -301, 888, -1000, 974,
+	-65, -1000, -1000, -1000, 698, -1000, -1000, -1000, 879, -1000,
+	878, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 869,
+	-1000, -1000, 868, -1000, -1000, -1000, 1074, 152, -1000, -1000,
+	1224, -1000, 5702, -1000, -1000, -1000, 1664, 213, 18366, -1000,
+	604, 604, 559, 604, 604, 604, 604, 164, 163, 604,
+	604, 604, 604, 604, 604, 604, 604, 604, 604, 604,
+	604, 604, 604, 1452, -1000, -1000, 1301, -1000, -1000, 720,
+	6580, -1000, -1000, 972, 716, 424, 422, 1449, -1000, 129,
+	691, 684, -1000, 16862, -1000, 1, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 969, 969, -1000, -1000, 854, -1000, -1000,
+	1448, 1542, 86, 1446, -1000, 1445, 1444, 16862, 963, 1318,
+	-1000, 1443, 5702, 62, -1000, -1000, 698, 110, -1000, 698,
+	1441, 1439, -199, -87, -60, -1000, 1436, -1000, -1000, 1735,
+	1070, 1067, 1315, 1307, -1000, -1000, 169, -206, -290, -216,
+	838, 202, -1000, 1728, 18384, -1000, 850, 849, 604, 604,
+	848, 965, 962, 961, 604, 604, 840, 957, 17646, 839,
+	810, 809, 871, 954, 494, 870, 807, 805, 16862, 1433,
+	950, -1000, -1000, 1957, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 801, 1432, -1000, -1000, 1431,
+	-1000, -1000, 1304, -1000, 1277, 1064, 13007, 133, 133, 13007,
+	13007, 13007, 1430, 296, -1000, 13007, 1653, 828, -1000, -1000,
+	3060, -194, 5702, 5702, 1429, 244, -74, -60, -1000, 1723,
+	-69, 1716, 1715, 16862, 844, -1000, -1000, -1000, 792, -1000,
+	790, 676, -1000, -209, -212, -1000, -1000, -1000, -1000, -1000,
+	123, -1000, -1000, -1000, 677, 677, -1000, -1000, -1000, -1000,
+	953, 952, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 124, 15629, 1224, -1000, 396, 953, 4672, -216,
-	11449, -1000, 787, -1000, -1000, 1208, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1193, 1185, 1168, 11449, -1000, -1000, -1000,
-	74, 96, -1000, -1000, 1523, 938, 926, 1326, 636, -97,
-	1562, -1000, 564, 1561, 564, 564, 1157, -1000, -1000, 63,
-	159, 157, -1000, 225, -1000, -1000, -1000, -1000, -1000, -1000,
-	121, 1123, -1000, 783, 776, -1000, 569, 1446, -1000, -21,
-	1106, -1000, -1000, -1000, -1000, -1000, 1095, -1000, -1000, 393,
-	724, 36, -1000, -1000, -1000, -1000, -1000, 1502, 9777, -121,
-	-1000, 574, -1000, 564, -1000, -1000, -1000, 15629, 53, 615,
-	5100, 1318, 5100, 1315, 67, 1305, -1000, -1000, -1000, -1000,
-	-1000, 245, -1000, -1000, 1442, 1432, 1611, -1000, -1000, -1000,
-	-1000, 96, 96, 96, 96, -16, 609, -1000, 877, -1000,
-	15629, -1000, 1093, -1000, -1000, -1000, 289, -1000, -1000, -1000,
-	-1000, 1299, 1560, -1000, 1588, 15629, 929, 15629, 1297, 392,
-	5100, -1000, -1000, 1613, -1000, 1604, 385, 385, -1000, -1000,
-	-1000, 771, -1000, 371, -1000, 11031, 15629, -1000, 141, 69,
-	-1000, 1044, -1000, 1035, 15629, 592, 712, -1000, -1000, -1000,
-	582, 85, -1000, 15629, 3388, -1000, 288, 1026, -1000, 721,
-	40, -1000, -1000, 956, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 604, 15629, -1000, 141, 1495, -1000, 577, -1000, -1000,
-	-1000, 16993, 137, -1000, -1000, 16993, 52, -1000, 135, -1000,
-	-1000, 932, -1000, 703, 1255, -1000, 52, 17124, 4672, -1000,
-	17124, 917, -1000,
+	-1000, -1000, -1000, -1000, 166, 16862, 1271, -1000, 605, 1055,
+	5702, -226, 13007, -1000, 951, -1000, -1000, 1262, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1257, 1255, 1252, 13007, -1000,
+	-1000, -1000, 107, 98, -1000, -1000, 1653, -1000, -1000, 1216,
+	1206, 6141, 1427, 759, -62, 1714, -1000, 844, 1706, 844,
+	844, 1194, -1000, -1000, 1040, 1036, 169, -1000, -1000, 95,
+	193, 160, -1000, 259, -1000, -1000, -1000, -1000, -1000, -1000,
+	180, 1189, -1000, 950, 949, -1000, 799, 1553, -1000, 20,
+	1184, -1000, -1000, -1000, -1000, -1000, 1158, -1000, -1000, 604,
+	948, 81, -1000, -1000, -1000, -1000, -1000, 1146, -1000, 698,
+	2621, 1603, 11378, -89, -1000, 947, -1000, 844, -1000, -1000,
+	-1000, 16862, -1000, -1000, -1000, 100, 754, 6580, 1426, 6580,
+	1425, 118, 1424, -1000, -1000, -1000, -1000, -1000, 296, -1000,
+	-1000, 1552, 1551, 1789, -1000, -1000, -1000, -1000, 98, 98,
+	98, 98, 23, 746, -1000, 942, -1000, 6141, -1000, -1000,
+	16862, -1000, 1131, -1000, -1000, -1000, 379, -1000, -1000, -1000,
+	-1000, 1367, 1686, -1000, 1564, 16862, 1331, 16862, 1288, 599,
+	6580, -1000, -1000, 1797, -1000, 1793, 500, 500, -1000, -1000,
+	-1000, -1000, 1202, -1000, 586, -1000, 12665, 16862, -1000, 212,
+	104, -1000, 1127, -1000, 1114, 16862, 744, 968, -1000, -1000,
+	-1000, 794, 126, -1000, 16862, 4385, -1000, 377, 1102, -1000,
+	1037, 94, -1000, -1000, 1095, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 698, 16862, -1000, 212, 1594, -1000, 734, -1000,
+	-1000, -1000, 18260, 206, -1000, -1000, 18260, 96, -1000, 197,
+	-1000, -1000, 1077, -1000, 959, 1223, -1000, 96, 18384, 5702,
+	-1000, 18384, 1054, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 97, 1983, 1981, 104, 102, 1980, 1979, 1978, 1977,
-	1976, 1975, 1974, 1973, 1970, 1969, 1967, 1962, 1961, 1960,
-	1958, 1956, 1955, 1954, 1953, 1952, 1951, 1950, 1949, 1948,
-	1945, 1944, 1943, 100, 1942, 1941, 1940, 1939, 1934, 1932,
-	132, 1931, 1930, 1929, 1928, 1927, 1924, 1923, 1909, 1908,
-	122, 43, 110, 613, 36, 200, 1907, 121, 1906, 81,
-	144, 1905, 1903, 28, 109, 1902, 118, 116, 87, 137,
-	95, 85, 62, 1901, 1900, 1899, 127, 1898, 1896, 1895,
-	1882, 57, 1880, 72, 51, 30, 1879, 77, 1878, 1875,
-	1860, 1858, 1857, 74, 1856, 59, 64, 1855, 1854, 1853,
-	1852, 1851, 33, 1850, 54, 1848, 1847, 1845, 1843, 1839,
-	1835, 1834, 14, 17, 19, 1833, 1829, 16, 2, 1828,
-	1827, 80, 1824, 1823, 1822, 174, 1821, 1820, 1819, 143,
-	1818, 119, 1815, 1814, 1810, 1807, 13, 1806, 42, 1804,
-	1803, 1802, 47, 1801, 1800, 90, 35, 65, 88, 1799,
-	1798, 1797, 133, 21, 108, 0, 138, 39, 1795, 135,
-	129, 1794, 82, 182, 130, 52, 1793, 46, 67, 1792,
-	1790, 1788, 61, 11, 1787, 94, 1786, 93, 78, 1785,
-	96, 1784, 120, 1, 91, 1783, 131, 1782, 1779, 113,
-	1778, 1777, 56, 107, 1776, 1775, 1774, 29, 1773, 32,
-	20, 1772, 151, 141, 1771, 1769, 1768, 114, 86, 76,
-	1767, 1766, 68, 1765, 106, 69, 123, 1764, 600, 1763,
-	99, 66, 18, 1762, 139, 1760, 234, 148, 125, 1757,
-	1756, 142, 1518, 140, 1754, 126, 9, 1729, 1728, 10,
-	1727, 25, 1726, 1725, 1724, 1723, 6, 1722, 1721, 1719,
-	3, 5, 1718, 4, 92, 1717, 45, 55, 53, 1716,
-	60, 1715, 1713, 1712, 1711, 1710, 159, 1709, 1707, 1705,
-	1704, 1703, 1702, 1701, 84, 1687, 1686, 1685, 1684, 58,
-	1683, 1682, 1681, 1680, 1679, 31, 1677, 1676, 22, 1675,
-	26, 1674, 1673, 1672, 12, 1671, 1670, 15, 1668, 1667,
-	7, 8, 1666, 1664, 48, 38, 34, 71, 70, 1663,
-	23, 1646, 89, 1645, 1643, 115, 1642, 98, 1637, 1636,
-	146, 162, 1635, 136, 1634, 1632, 1630, 1629, 1627, 1626,
-	1625, 128, 1624,
-}
-
-//line mysql_sql.y:6299
+	0, 101, 2188, 2187, 120, 117, 2186, 2185, 2181, 2180,
+	2179, 2178, 2177, 2176, 2175, 2174, 2173, 2172, 2170, 2168,
+	2167, 2166, 2165, 2164, 2163, 2161, 2160, 2159, 2158, 2157,
+	2156, 2155, 2153, 114, 2152, 2151, 2150, 2149, 2148, 2147,
+	157, 2146, 2145, 2144, 2141, 2138, 2136, 2134, 2133, 2132,
+	138, 53, 23, 787, 28, 192, 2130, 107, 2127, 2125,
+	75, 162, 2124, 2123, 34, 108, 2121, 158, 102, 95,
+	144, 111, 94, 70, 2120, 2119, 2116, 140, 2115, 2114,
+	2113, 2112, 64, 2110, 85, 36, 38, 2108, 91, 2106,
+	2104, 2103, 2102, 2101, 88, 2100, 67, 68, 2099, 2095,
+	2093, 2092, 2086, 42, 2085, 61, 2084, 2083, 2080, 2077,
+	2076, 2075, 2074, 14, 18, 20, 2073, 2058, 17, 2,
+	2057, 2056, 76, 2055, 2053, 2052, 2051, 43, 16, 2050,
+	2049, 189, 2048, 2047, 2045, 151, 2043, 233, 2037, 2036,
+	2035, 2034, 139, 2031, 2029, 2028, 32, 57, 2027, 13,
+	2026, 51, 2025, 2024, 2023, 56, 2020, 2017, 100, 46,
+	71, 96, 2016, 2015, 2014, 142, 21, 125, 0, 132,
+	48, 2013, 133, 127, 2012, 97, 271, 122, 58, 2011,
+	52, 78, 2010, 2009, 31, 74, 39, 2007, 87, 2006,
+	1, 86, 2005, 93, 2004, 130, 15, 105, 1999, 135,
+	1998, 1997, 123, 1996, 1995, 69, 112, 1993, 1992, 1991,
+	35, 1977, 44, 25, 1976, 137, 149, 1975, 1957, 1956,
+	129, 131, 89, 1955, 1954, 80, 1953, 113, 81, 116,
+	1952, 832, 1951, 110, 72, 19, 1950, 145, 1949, 240,
+	165, 126, 1946, 1945, 154, 1634, 146, 1944, 136, 9,
+	1941, 1940, 10, 1939, 30, 1938, 1937, 1935, 1912, 6,
+	1911, 1909, 1908, 3, 5, 1906, 4, 98, 1905, 59,
+	65, 63, 1903, 66, 1902, 1900, 1899, 1898, 1894, 235,
+	1893, 1891, 1890, 1885, 1883, 1882, 1879, 90, 1878, 1877,
+	1876, 1875, 77, 1874, 1873, 1871, 1870, 1869, 37, 1868,
+	1864, 22, 1863, 33, 1859, 1858, 1856, 11, 1854, 1853,
+	12, 1852, 1849, 7, 8, 1848, 1844, 62, 47, 45,
+	84, 82, 1843, 26, 1836, 106, 1835, 1834, 124, 1833,
+	92, 1832, 1827, 143, 170, 1826, 141, 1824, 1823, 1822,
+	166, 1816, 1814, 1812, 128, 1810,
+}
+
+//line mysql_sql.y:6532
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3166,6 +3328,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3191,6 +3368,11 @@ func (st *yySymType) groupByUnion() tree.GroupBy {
 	return v
 }
 
+func (st *yySymType) hintsUnion() tree.Hints {
+	v, _ := st.union.(tree.Hints)
+	return v
+}
+
 func (st *yySymType) identifierListUnion() tree.IdentifierList {
 	v, _ := st.union.(tree.IdentifierList)
 	return v
@@ -3601,6 +3783,11 @@ func (st *yySymType) whereUnion() *tree.Where {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) withClauseUnion() *tree.With {
 	v, _ := st.union.(*tree.With)
 	return v
@@ -3612,141 +3799,146 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 329, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 342, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 48, 303, 303, 302, 302, 301, 301, 300, 300,
-	300, 299, 299, 299, 298, 298, 297, 297, 295, 295,
-	296, 294, 293, 293, 291, 291, 289, 289, 290, 290,
-	284, 284, 287, 287, 285, 285, 285, 285, 288, 283,
-	283, 283, 282, 282, 47, 47, 47, 221, 221, 46,
-	46, 235, 235, 235, 235, 235, 233, 233, 233, 233,
-	232, 232, 231, 231, 236, 236, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 41,
-	41, 41, 41, 44, 45, 229, 229, 229, 229, 229,
-	230, 230, 230, 42, 43, 43, 220, 220, 225, 225,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 219, 219, 228, 228, 228, 227, 227, 226, 226,
-	35, 35, 35, 38, 37, 218, 218, 218, 218, 218,
-	218, 218, 218, 36, 36, 36, 36, 36, 36, 34,
-	34, 33, 217, 217, 216, 40, 40, 40, 40, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 158, 158,
-	158, 322, 322, 323, 324, 325, 325, 325, 49, 7,
-	32, 32, 266, 266, 169, 169, 170, 170, 168, 168,
-	168, 168, 168, 168, 269, 270, 165, 21, 21, 21,
-	21, 21, 21, 21, 21, 21, 21, 31, 330, 330,
-	330, 29, 30, 265, 265, 265, 28, 27, 26, 25,
-	25, 24, 23, 23, 162, 162, 164, 164, 160, 331,
-	331, 241, 241, 163, 163, 22, 22, 161, 161, 143,
-	159, 159, 159, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 273, 273, 273, 273,
-	273, 273, 311, 311, 311, 312, 75, 75, 70, 70,
-	274, 274, 184, 313, 313, 281, 281, 280, 280, 279,
-	279, 73, 73, 74, 74, 62, 62, 50, 50, 286,
-	286, 286, 286, 292, 292, 263, 263, 109, 109, 139,
-	139, 140, 140, 51, 51, 52, 52, 52, 52, 52,
-	52, 319, 319, 321, 321, 320, 72, 72, 68, 68,
-	69, 69, 69, 67, 67, 66, 65, 65, 64, 63,
-	63, 63, 54, 54, 53, 53, 53, 53, 53, 125,
-	125, 125, 55, 267, 267, 267, 272, 272, 122, 122,
-	123, 123, 121, 121, 56, 56, 57, 57, 57, 57,
-	120, 120, 119, 58, 58, 59, 59, 61, 61, 61,
-	61, 130, 130, 129, 129, 129, 129, 78, 78, 128,
-	127, 127, 127, 77, 77, 76, 76, 71, 71, 60,
-	60, 126, 332, 332, 124, 151, 151, 151, 157, 157,
-	150, 150, 150, 156, 156, 152, 152, 153, 153, 153,
-	3, 3, 3, 16, 16, 16, 16, 20, 20, 328,
-	328, 14, 214, 214, 213, 213, 215, 215, 215, 215,
-	209, 209, 210, 210, 210, 210, 211, 211, 211, 212,
-	212, 212, 212, 208, 208, 207, 205, 205, 205, 206,
-	206, 206, 206, 206, 206, 154, 154, 15, 202, 202,
-	203, 203, 203, 204, 204, 196, 196, 196, 196, 19,
-	200, 200, 201, 201, 201, 201, 201, 197, 197, 199,
-	199, 195, 195, 195, 195, 195, 18, 194, 194, 192,
-	192, 190, 190, 191, 191, 189, 189, 189, 193, 193,
-	17, 268, 268, 237, 237, 240, 240, 247, 247, 248,
-	248, 246, 246, 253, 253, 252, 252, 251, 251, 250,
-	250, 249, 249, 244, 244, 243, 243, 238, 238, 238,
-	238, 238, 239, 239, 242, 242, 245, 245, 100, 100,
-	101, 101, 101, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 309, 309, 310, 103, 103, 103, 107, 107,
-	107, 107, 107, 107, 102, 102, 102, 104, 104, 104,
-	85, 85, 84, 84, 79, 79, 80, 80, 81, 81,
-	82, 82, 83, 83, 83, 83, 83, 83, 223, 223,
-	307, 307, 308, 308, 304, 304, 304, 306, 306, 306,
-	306, 306, 305, 305, 86, 137, 137, 137, 155, 155,
-	155, 136, 136, 136, 99, 99, 98, 98, 96, 96,
-	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
-	96, 222, 222, 166, 166, 167, 167, 117, 115, 115,
-	116, 116, 116, 116, 113, 114, 112, 112, 112, 112,
-	112, 111, 111, 110, 110, 110, 198, 198, 108, 108,
-	106, 106, 106, 105, 105, 105, 254, 173, 173, 173,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
-	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
-	176, 176, 181, 181, 318, 318, 317, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 95, 95, 95, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 278, 278, 278, 132, 132, 132,
-	132, 132, 314, 314, 315, 315, 315, 315, 315, 315,
-	315, 315, 315, 315, 315, 315, 316, 316, 316, 316,
-	316, 316, 316, 316, 316, 316, 316, 316, 316, 316,
-	316, 316, 316, 134, 134, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 185, 185, 186,
-	186, 275, 275, 275, 275, 275, 275, 276, 276, 277,
-	277, 277, 277, 271, 271, 271, 271, 271, 271, 271,
-	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
-	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
-	271, 174, 174, 131, 131, 131, 187, 182, 182, 183,
-	183, 177, 177, 177, 177, 177, 179, 179, 179, 179,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 178,
-	178, 180, 180, 188, 188, 188, 188, 188, 188, 97,
-	97, 97, 97, 255, 171, 171, 171, 171, 171, 171,
-	171, 88, 88, 88, 88, 92, 92, 94, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 93, 93, 93, 93, 91, 91, 91, 91, 91,
-	89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
-	89, 89, 89, 89, 89, 90, 138, 138, 256, 256,
-	259, 259, 257, 257, 258, 260, 260, 260, 261, 261,
-	261, 262, 262, 262, 264, 264, 142, 142, 142, 147,
-	147, 141, 141, 148, 148, 149, 149, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 326, 326, 326, 327, 327,
+	1, 48, 316, 316, 315, 315, 314, 314, 313, 313,
+	313, 312, 312, 312, 311, 311, 310, 310, 308, 308,
+	309, 307, 306, 306, 304, 304, 302, 302, 303, 303,
+	297, 297, 300, 300, 298, 298, 298, 298, 301, 296,
+	296, 296, 295, 295, 47, 47, 47, 234, 234, 46,
+	46, 248, 248, 248, 248, 248, 246, 246, 246, 246,
+	245, 245, 244, 244, 249, 249, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 41,
+	41, 41, 41, 44, 45, 242, 242, 242, 242, 242,
+	243, 243, 243, 42, 43, 43, 233, 233, 238, 238,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 232, 232, 241, 241, 241, 240, 240, 239, 239,
+	35, 35, 35, 38, 37, 231, 231, 231, 231, 231,
+	231, 231, 231, 36, 36, 36, 36, 36, 36, 34,
+	34, 33, 230, 230, 229, 40, 40, 40, 40, 39,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 171,
+	171, 171, 335, 335, 336, 337, 338, 338, 338, 49,
+	7, 32, 32, 279, 279, 182, 182, 183, 183, 181,
+	181, 181, 181, 181, 181, 282, 283, 178, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 31, 343,
+	343, 343, 29, 30, 278, 278, 278, 28, 27, 26,
+	25, 25, 24, 23, 23, 175, 175, 177, 177, 173,
+	344, 344, 254, 254, 176, 176, 22, 22, 174, 174,
+	156, 172, 172, 172, 6, 8, 8, 8, 8, 8,
+	13, 12, 11, 10, 9, 5, 4, 286, 286, 286,
+	286, 286, 286, 324, 324, 324, 325, 76, 76, 71,
+	71, 287, 287, 197, 326, 326, 294, 294, 293, 293,
+	292, 292, 74, 74, 75, 75, 63, 63, 50, 50,
+	299, 299, 299, 299, 305, 305, 276, 276, 110, 110,
+	152, 152, 153, 153, 51, 51, 52, 52, 52, 52,
+	52, 52, 332, 332, 334, 334, 333, 73, 73, 69,
+	69, 70, 70, 70, 68, 68, 67, 66, 66, 65,
+	64, 64, 64, 54, 54, 53, 53, 53, 53, 53,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 55,
+	58, 58, 280, 280, 280, 285, 285, 123, 123, 124,
+	124, 129, 129, 125, 125, 127, 127, 127, 127, 126,
+	126, 128, 128, 122, 122, 56, 56, 57, 57, 57,
+	57, 121, 121, 120, 59, 59, 60, 60, 62, 62,
+	62, 62, 136, 136, 135, 135, 135, 135, 79, 79,
+	134, 133, 133, 133, 78, 78, 77, 77, 72, 72,
+	61, 61, 132, 345, 345, 130, 164, 164, 164, 170,
+	170, 163, 163, 163, 169, 169, 165, 165, 166, 166,
+	166, 3, 3, 3, 16, 16, 16, 16, 20, 20,
+	341, 341, 14, 227, 227, 226, 226, 228, 228, 228,
+	228, 222, 222, 223, 223, 223, 223, 224, 224, 224,
+	225, 225, 225, 225, 221, 221, 220, 218, 218, 218,
+	219, 219, 219, 219, 219, 219, 167, 167, 15, 215,
+	215, 216, 216, 216, 217, 217, 209, 209, 209, 209,
+	19, 213, 213, 214, 214, 214, 214, 214, 210, 210,
+	212, 212, 208, 208, 208, 208, 208, 18, 207, 207,
+	205, 205, 203, 203, 204, 204, 202, 202, 202, 206,
+	206, 17, 281, 281, 250, 250, 253, 253, 260, 260,
+	261, 261, 259, 259, 266, 266, 265, 265, 264, 264,
+	263, 263, 262, 262, 257, 257, 256, 256, 251, 251,
+	251, 251, 251, 252, 252, 255, 255, 258, 258, 101,
+	101, 102, 102, 102, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 322, 322, 323, 104, 104, 104, 108,
+	108, 108, 108, 108, 108, 103, 103, 103, 105, 105,
+	105, 86, 86, 85, 85, 80, 80, 81, 81, 82,
+	82, 83, 83, 84, 84, 84, 84, 84, 84, 236,
+	236, 320, 320, 321, 321, 317, 317, 317, 319, 319,
+	319, 319, 319, 318, 318, 87, 150, 150, 150, 168,
+	168, 168, 149, 149, 149, 100, 100, 99, 99, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 235, 235, 179, 179, 180, 180, 118, 116,
+	116, 117, 117, 117, 117, 114, 115, 113, 113, 113,
+	113, 113, 112, 112, 111, 111, 111, 211, 211, 109,
+	109, 107, 107, 107, 106, 106, 106, 267, 186, 186,
+	186, 186, 186, 186, 186, 186, 186, 186, 186, 186,
+	186, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 142, 142, 143, 144, 144, 145, 145, 145, 148,
+	148, 147, 147, 147, 146, 146, 146, 189, 189, 194,
+	194, 331, 331, 330, 88, 88, 88, 88, 88, 88,
+	88, 88, 88, 96, 96, 96, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 291, 291, 291, 138, 138, 138, 138, 138, 327,
+	327, 328, 328, 328, 328, 328, 328, 328, 328, 328,
+	328, 328, 328, 329, 329, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	140, 140, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 198, 198, 199, 199, 288, 288,
+	288, 288, 288, 288, 289, 289, 290, 290, 290, 290,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 187, 187,
+	137, 137, 137, 200, 195, 195, 196, 196, 190, 190,
+	190, 190, 190, 192, 192, 192, 192, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 191, 191, 193, 193,
+	201, 201, 201, 201, 201, 201, 98, 98, 98, 98,
+	268, 184, 184, 184, 184, 184, 184, 184, 184, 89,
+	89, 89, 89, 93, 93, 95, 95, 95, 95, 95,
+	95, 95, 95, 95, 95, 95, 95, 95, 95, 94,
+	94, 94, 94, 92, 92, 92, 92, 92, 90, 90,
+	90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
+	90, 90, 90, 91, 151, 151, 269, 269, 272, 272,
+	270, 270, 271, 273, 273, 273, 274, 274, 274, 275,
+	275, 275, 277, 277, 155, 155, 155, 160, 160, 154,
+	154, 161, 161, 162, 162, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 339, 339, 339, 340, 340, 340,
 }
 
 var yyR2 = [...]int{
@@ -3769,87 +3961,93 @@ var yyR2 = [...]int{
 	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
 	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
 	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
-	1, 1, 3, 2, 1, 1, 1, 1, 6, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 7, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 0, 2, 1,
-	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
-	4, 3, 0, 1, 2, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 8, 11, 0,
-	1, 6, 0, 2, 1, 2, 2, 2, 2, 2,
-	0, 1, 2, 2, 2, 2, 1, 3, 2, 2,
-	2, 2, 2, 1, 3, 2, 1, 3, 2, 0,
-	3, 3, 5, 5, 4, 1, 1, 4, 1, 3,
-	1, 3, 2, 1, 1, 0, 1, 1, 1, 11,
-	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
-	4, 0, 2, 2, 2, 2, 5, 1, 1, 0,
-	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
-	10, 0, 1, 0, 6, 0, 4, 0, 3, 1,
-	3, 4, 5, 0, 3, 1, 3, 2, 3, 1,
-	2, 0, 6, 0, 2, 0, 2, 4, 5, 4,
-	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
-	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
-	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	5, 4, 1, 3, 3, 0, 2, 2, 1, 1,
+	3, 4, 7, 5, 2, 3, 3, 4, 5, 1,
+	1, 1, 1, 3, 2, 1, 1, 1, 1, 6,
+	1, 7, 9, 0, 2, 0, 1, 1, 2, 2,
+	2, 1, 4, 2, 2, 3, 0, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
+	1, 1, 5, 5, 0, 1, 1, 2, 2, 3,
+	6, 7, 4, 7, 8, 0, 2, 0, 2, 2,
+	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
+	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
+	4, 4, 6, 4, 4, 6, 4, 2, 1, 5,
+	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
+	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
+	1, 1, 0, 4, 1, 3, 2, 1, 0, 8,
+	0, 4, 7, 4, 0, 2, 0, 2, 0, 2,
+	0, 4, 1, 3, 1, 1, 4, 3, 4, 5,
+	4, 5, 2, 3, 1, 3, 6, 0, 3, 0,
+	1, 2, 4, 4, 0, 1, 3, 1, 3, 2,
+	0, 1, 1, 3, 3, 1, 3, 3, 3, 3,
+	1, 2, 2, 1, 2, 2, 1, 2, 2, 8,
+	0, 1, 0, 1, 1, 1, 1, 0, 2, 0,
+	4, 0, 2, 1, 3, 1, 4, 4, 5, 1,
+	3, 1, 2, 0, 2, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 3, 1, 1, 4, 4,
+	4, 3, 2, 2, 2, 3, 2, 3, 0, 2,
+	1, 1, 2, 2, 0, 1, 2, 4, 1, 3,
+	1, 4, 3, 0, 1, 2, 0, 1, 2, 1,
+	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 8, 11,
+	0, 1, 6, 0, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 2, 2, 2, 2, 1, 3, 2,
+	2, 2, 2, 2, 1, 3, 2, 1, 3, 2,
+	0, 3, 3, 5, 5, 4, 1, 1, 4, 1,
+	3, 1, 3, 2, 1, 1, 0, 1, 1, 1,
+	11, 0, 2, 3, 2, 3, 1, 1, 1, 3,
+	3, 4, 0, 2, 2, 2, 2, 5, 1, 1,
+	0, 3, 0, 1, 1, 2, 4, 4, 4, 0,
+	1, 10, 0, 1, 0, 6, 0, 4, 0, 3,
+	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
+	1, 2, 0, 6, 0, 2, 0, 2, 4, 5,
+	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
+	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
+	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 0, 1, 1, 3, 1,
+	1, 2, 1, 7, 7, 7, 7, 8, 5, 0,
+	1, 0, 1, 1, 1, 1, 3, 3, 1, 1,
+	1, 1, 1, 0, 1, 3, 1, 3, 5, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
+	2, 2, 1, 1, 2, 2, 2, 2, 2, 1,
+	5, 6, 1, 2, 0, 1, 1, 2, 5, 0,
+	1, 1, 1, 2, 2, 3, 3, 1, 1, 2,
+	2, 2, 0, 1, 2, 2, 2, 0, 3, 0,
+	3, 1, 1, 1, 1, 1, 1, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 3, 5, 2, 2, 2, 2,
+	1, 1, 2, 5, 6, 6, 6, 2, 1, 1,
+	2, 0, 2, 5, 0, 3, 0, 2, 5, 1,
+	1, 2, 2, 2, 1, 2, 2, 0, 2, 0,
+	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
+	2, 2, 2, 0, 1, 1, 5, 4, 4, 5,
+	5, 5, 5, 4, 5, 5, 5, 5, 5, 5,
+	5, 1, 1, 1, 4, 4, 6, 8, 6, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 1, 7, 7, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 3, 5, 0, 1, 1, 2, 1, 2,
-	2, 1, 1, 2, 2, 2, 2, 2, 1, 5,
-	6, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 1, 2, 2, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 2, 2, 2, 0, 3, 0, 3,
-	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 5, 2, 2, 2, 2, 1,
-	1, 2, 5, 6, 6, 6, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 1, 5,
-	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
-	5, 5, 5, 5, 1, 1, 1, 4, 4, 6,
-	8, 6, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 4, 2, 2, 4, 6,
-	2, 2, 2, 4, 6, 4, 2, 0, 1, 2,
-	3, 1, 1, 1, 1, 1, 1, 0, 2, 1,
+	2, 2, 4, 2, 2, 4, 6, 2, 2, 2,
+	4, 6, 4, 2, 0, 1, 2, 3, 1, 1,
+	1, 1, 1, 1, 0, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 3,
+	0, 1, 1, 3, 0, 1, 1, 3, 3, 3,
+	3, 2, 1, 3, 4, 3, 1, 3, 4, 4,
+	5, 3, 4, 5, 6, 1, 0, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 1, 2, 2, 2, 2, 2, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 4, 1, 1, 3, 0, 1, 0, 3,
+	0, 3, 3, 0, 3, 5, 0, 3, 5, 0,
+	1, 1, 0, 1, 1, 2, 2, 0, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 3, 0, 1, 1, 3, 0, 1, 1,
-	3, 3, 3, 3, 2, 1, 3, 4, 3, 1,
-	3, 4, 4, 5, 3, 4, 5, 6, 1, 0,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 1, 2, 2, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 4, 4, 1, 1, 3, 0, 1,
-	0, 3, 0, 3, 3, 0, 3, 5, 0, 3,
-	5, 0, 1, 1, 0, 1, 1, 2, 2, 0,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3884,451 +4082,466 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -329, -2, -1, -3, -4, -5, -6, -39, -21,
+	-1000, -342, -2, -1, -3, -4, -5, -6, -39, -21,
 	-7, -49, -33, -34, -35, -41, -46, -47, -48, -51,
-	-16, -15, -14, 8, 10, -8, -158, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, -32, 181, 9,
-	49, -36, -37, -38, -42, -43, -44, -45, 283, 289,
-	326, -52, -54, -17, -18, -19, -20, 177, -9, -10,
-	-11, -12, -13, 199, 198, 26, 197, 178, 120, 121,
-	123, 124, 30, -53, -319, 54, 179, -55, 397, 6,
-	442, -62, 27, -84, -155, 57, -144, -146, 400, 401,
-	402, 403, 404, 405, 406, 407, 408, 409, 410, 411,
-	412, 413, 414, 415, 416, 417, 418, 419, 420, 421,
-	422, 423, 424, 425, 426, 427, 428, 429, 430, 431,
-	432, 433, 434, 435, 394, 216, 240, 243, 250, 120,
-	137, 131, 158, 150, 151, 128, 220, 221, 64, 123,
-	354, 340, 325, 126, 235, 237, 239, 242, 222, 146,
-	142, 234, 218, 140, 223, 28, 224, 163, 225, 226,
-	399, 336, 265, 358, 342, 247, 141, 337, 238, 339,
-	164, 168, 346, 289, 135, 136, 344, 348, 162, 196,
-	32, 396, 34, 208, 349, 166, 161, 157, 357, 251,
-	249, 160, 134, 156, 219, 38, 170, 169, 171, 319,
-	320, 321, 322, 227, 228, 277, 345, 153, 143, 269,
-	129, 18, 352, 203, 341, 290, 229, 244, 206, 252,
-	165, 167, 210, 215, 398, 248, 350, 139, 207, 236,
-	202, 353, 130, 283, 292, 230, 124, 241, 347, 356,
-	37, 305, 133, 127, 193, 121, 212, 217, 231, 232,
-	233, 254, 253, 245, 154, 209, 159, 132, 155, 122,
-	211, 355, 306, 441, 267, 308, 152, 149, 213, 186,
-	351, 343, 125, 312, 307, 147, 255, 438, 439, 440,
-	278, 11, -159, 19, 323, -40, 324, 181, 54, -155,
-	-5, -4, -33, -51, 184, -59, -60, -61, -124, -126,
-	-84, 54, -155, -232, -202, -231, -203, -234, -204, -154,
-	20, 178, 177, 211, 10, 179, 287, 185, 8, 6,
-	288, 197, 9, 289, 291, 292, 295, 296, 297, 31,
-	300, 301, 57, 60, -155, -232, -202, 215, 222, -66,
-	-67, -125, 15, 5, -268, 308, 214, -196, -194, 298,
-	194, 193, 76, 360, 183, -330, -265, 343, 342, -163,
-	341, 334, 336, 177, 185, 344, 32, 346, 347, 337,
-	308, 125, 122, -218, 80, 130, 129, -218, 214, 29,
-	-225, 318, -224, -226, 346, 347, 357, -219, 345, -142,
-	-155, 58, 59, 75, 151, 148, -67, -125, -66, -53,
-	-54, -52, -54, 308, 214, 185, 184, 360, -321, 392,
-	-320, -155, -267, 20, -272, 21, 22, -1, -73, 206,
-	-84, 119, -59, -136, -155, 325, 89, -40, -40, 324,
-	-322, -323, -324, -326, 181, 324, 323, 119, -84, 30,
-	-127, -128, -129, -130, 41, 45, 47, 42, 43, 44,
-	48, -332, 23, -151, -157, 23, -152, 60, -153, -146,
-	57, 58, 59, -52, -54, 51, 55, 11, 55, 54,
-	443, 58, 285, 299, 308, 286, 298, 186, 214, 299,
-	214, 334, 186, 290, 293, 294, 335, 51, 187, 51,
-	-282, 357, -50, 27, -69, 17, -55, -54, 16, 20,
-	21, -328, 184, 392, -192, 189, -192, 185, -192, -331,
-	11, 99, 213, 212, 338, 335, -241, 339, 340, -163,
-	-162, 97, -163, 184, 360, -266, 189, 350, 397, 128,
-	129, 130, -229, 20, 29, 317, -202, 214, 55, 89,
-	19, -227, 89, 100, -226, -226, -226, -227, -102, 29,
-	-153, 60, 116, -102, 29, 119, 30, 30, -68, -69,
-	-55, -54, -67, -66, -67, 56, 56, -266, -266, -266,
-	-266, -266, 55, -321, -72, 54, -56, -57, 107, -177,
-	-155, 81, -179, 57, -172, 401, 402, 403, 404, 405,
-	406, 407, 409, 412, 414, 416, 420, 421, 422, 423,
-	425, 426, 427, 428, 433, 434, 435, 277, 308, 147,
-	278, -173, -175, -300, -295, -171, 54, 105, 106, 113,
-	82, -174, -254, 24, 84, 368, -132, -133, -134, -135,
-	-296, -294, 60, 65, 69, 71, 72, 70, 67, 118,
-	-54, -314, -271, -277, -275, 148, 200, 144, 145, 8,
-	111, 318, 116, -278, 59, 58, 271, 75, 272, 273,
-	360, 268, 274, 189, 323, 43, 275, 276, 279, 367,
-	280, 44, 281, 270, 204, 282, 371, 370, 372, 364,
-	361, 359, 362, 363, 365, 366, -273, 33, -51, 54,
-	30, 54, -155, -121, 12, 119, 65, 60, -40, 56,
-	55, -325, 71, 72, -327, 162, 154, -155, 54, -217,
-	-216, -136, -60, -60, -60, -60, 41, 41, 41, 46,
-	41, 46, 41, -129, -155, -157, 56, -233, 184, 284,
-	210, -231, 211, 289, 292, -208, -207, -205, -154, 60,
-	-203, -236, -136, -154, 335, -233, -208, -207, 327, 437,
-	-50, -177, -155, -65, -64, -177, 186, -192, -208, 81,
-	-202, -153, -155, -84, -162, -162, -164, -331, -160, -331,
-	335, -121, -175, -241, -161, -155, -192, -208, 308, 24,
-	351, 352, 126, 129, 128, 358, -230, 317, 20, -202,
-	-224, -220, 60, 318, -207, -228, 51, 116, -279, -177,
-	29, -227, -227, -227, -228, 115, -155, -50, -68, -50,
-	-69, -208, -202, -155, -85, -84, -156, -153, -146, -320,
-	23, -71, -155, -120, 55, -119, 11, -150, 80, 78,
-	79, -155, 23, 119, -177, 96, -188, 89, 90, 91,
-	92, 93, 94, 54, 54, 54, 54, 54, 54, 54,
-	54, -186, 54, 54, 54, 54, 54, 54, -186, 54,
-	54, 54, 102, 101, 112, 105, 106, 107, 108, 109,
-	110, 111, 103, 104, 99, 81, 97, 98, 83, -54,
-	-177, -183, -175, -175, -175, -175, -254, -181, -177, 54,
-	60, 65, 54, 54, -276, 54, -185, -186, 54, 60,
-	60, 60, 54, 54, 54, -175, 54, -274, -184, -313,
-	436, -75, 56, -70, -155, -311, -312, -70, -74, -155,
-	-67, -177, -148, -149, -141, -145, -152, -153, -146, 266,
-	182, 20, 80, 23, 25, 271, 303, 83, 116, 16,
-	84, 148, 115, 273, 368, 272, 177, 47, 75, 370,
-	372, 371, 361, 359, 310, 314, 316, 313, 360, 334,
-	29, 10, 26, 198, 21, 22, 109, 179, 200, 87,
-	88, 201, 24, 199, 72, 19, 50, 11, 323, 13,
-	14, 274, 309, 189, 188, 99, 327, 185, 45, 8,
-	118, 27, 96, 311, 41, 77, 43, 97, 17, 362,
-	363, 31, 326, 393, 205, 111, 275, 276, 48, 81,
-	317, 70, 51, 78, 15, 46, 98, 180, 367, 44,
-	214, 315, 279, 281, 392, 280, 183, 6, 270, 369,
-	30, 197, 42, 184, 335, 86, 187, 71, 204, 144,
-	145, 5, 76, 9, 49, 52, 364, 365, 366, 33,
-	85, 12, 282, 397, 318, 328, 329, 330, 331, 332,
-	333, 172, 173, 174, 175, 176, 246, 192, 190, 194,
-	195, 436, 437, 19, -40, -323, 119, -71, -121, 55,
-	89, -77, -76, 51, 52, -78, 51, -76, 41, 41,
-	-72, -235, 107, 57, 55, -206, 309, 443, 58, 56,
-	55, -235, 187, 60, 60, 55, 18, 119, 55, -63,
-	25, 26, -84, 189, -84, -209, -210, 315, 24, -195,
-	52, -190, -191, -189, -193, 29, -121, -121, -121, -162,
-	-156, -164, -159, -164, -160, 119, -143, -155, -209, 54,
-	127, 130, 130, 129, -202, 187, 54, 89, -228, -228,
-	-228, 29, -154, -50, -50, 51, 55, 54, 56, 55,
-	-121, -57, -58, -59, -177, -177, -177, -155, -155, 107,
-	70, 81, -172, -182, -183, -177, -131, 21, 20, -131,
-	-131, -177, -131, 107, -183, -183, 56, -255, 65, -315,
-	-316, 373, 374, 375, 376, 377, 378, 379, 380, 381,
-	382, 383, 275, 270, 276, 274, 268, 282, 277, 278,
-	147, 390, 391, 384, 385, 386, 387, 388, 389, -131,
-	-131, -131, -131, -131, -131, -131, -173, -173, -173, -173,
-	-173, -173, -173, -173, -173, -173, -173, -173, -180, -187,
-	-254, 54, 99, 97, 98, 83, -175, -173, -173, 56,
-	55, -318, -317, 85, -177, -315, -182, -177, -182, 56,
-	-183, -182, -173, -182, -131, 55, 54, 56, 55, 33,
-	119, 55, 89, 56, 55, -68, 119, 325, -155, 56,
-	-67, -216, -177, -177, 54, -177, 11, 119, 119, -207,
-	16, 397, -154, -136, 187, -208, -283, 188, 367, -286,
-	339, -177, -177, -155, -64, -72, 81, 54, -214, 397,
-	317, 316, 312, -211, -212, 311, 313, 310, 314, 51,
-	260, 261, 262, 263, -189, -142, 115, 225, 151, -121,
-	-162, -162, -164, -155, -214, 56, 130, -208, -165, 60,
-	-220, -84, -84, -1, -155, -123, 13, 55, 119, 70,
-	56, 55, -177, -177, -177, 23, -183, 56, 56, 56,
-	56, 11, -177, -177, -177, -177, -177, -177, -177, -183,
-	-180, -175, -173, -173, -178, 201, 80, -177, -176, -317,
-	87, -177, 55, 52, 56, 11, 56, 56, 52, 56,
-	55, 56, -177, -184, -281, -280, -279, 33, -51, -70,
-	-274, -155, -312, -279, -155, -148, -145, -153, -146, 65,
-	-68, -71, -208, 107, 107, 57, -154, 318, -154, -208,
-	-221, 397, 27, -292, 333, 328, 330, 119, 23, 24,
-	-79, -80, -81, -86, -82, -136, -167, -83, 192, 190,
-	194, -308, 76, 195, 246, 77, 185, -213, -215, 319,
-	320, 321, 322, 80, -212, 60, 60, 60, 60, -84,
-	-147, 89, -147, -147, -121, -121, -162, -169, -170, -168,
-	266, -269, 318, 309, 56, 56, -122, 14, 16, -59,
-	-155, 107, -177, 56, 56, 56, -87, -93, 116, 148,
-	200, 147, 146, 144, 305, 306, 140, 141, 142, 139,
-	56, -177, 56, 56, 56, 56, 56, 56, 56, 56,
-	-178, 80, -175, -172, 56, 88, -177, 86, -87, -102,
-	-177, -102, -173, 56, 56, 55, -274, 56, -154, 16,
-	23, -209, 289, 184, -263, 438, -290, 328, 16, 16,
-	-51, -84, 56, 55, -88, -92, -89, -91, -90, -94,
-	-93, 148, 149, 116, 152, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 30, 200, 144, 145, 146,
-	147, 164, 131, 150, 395, 172, 132, 173, 133, 174,
-	134, 175, 135, 136, 176, 137, -83, -155, 77, -307,
-	-308, -192, -307, 77, 54, -215, 65, 65, 65, 65,
-	-212, 54, -102, -104, -153, 60, 116, 60, -121, -168,
-	267, 31, 118, 269, 29, 265, 16, -177, -183, 56,
-	-256, -258, 54, -257, 54, -256, -256, -256, -95, 136,
-	135, -95, -260, 54, -260, -261, 54, -260, 56, -172,
-	-177, 56, 56, 56, 19, 56, 56, -279, -154, -154,
-	-221, 290, -84, -109, 439, 60, 16, 60, -288, 60,
-	-72, -100, -101, -118, 303, 216, -193, 220, 64, 221,
-	325, 222, 185, 224, 225, 226, 196, 227, 228, 229,
-	318, 230, 231, 232, 233, 286, 5, 256, -81, -99,
-	-98, -96, 70, 81, 29, 303, -97, 64, 115, 239,
-	217, 240, -117, -166, 190, 76, 77, 291, -167, -262,
-	306, 305, -256, -257, -258, -256, -256, 54, 54, -256,
-	-259, 54, -256, -256, -304, -305, -155, -305, -155, -304,
-	-304, -192, -177, -197, -199, -136, 54, 65, -270, -165,
-	65, 65, 65, 65, -177, -284, -241, -139, 440, 65,
-	60, 330, 23, -237, 206, 55, -118, -147, -147, -142,
-	115, -147, -147, -147, -147, 223, 223, -147, -147, -147,
-	-147, -147, -147, -147, -147, -147, -147, -147, -147, -147,
-	-147, 54, -96, 70, -173, 60, -104, -105, 29, 238,
-	234, -106, 29, 218, 219, -108, 54, 246, 77, 77,
-	-84, -264, 307, -138, 60, -138, 65, 54, 52, 255,
-	54, 54, 54, -305, 56, 56, 55, -256, -177, 268,
-	56, 56, 56, 55, 56, 55, 56, -291, 333, -287,
-	-285, 328, 329, 330, 331, 54, 16, -51, 16, -118,
-	65, 65, -147, -147, 65, 60, 60, 60, -147, -147,
-	65, 60, -157, 65, 65, 65, 65, 29, 60, -107,
-	29, 234, 238, 235, 236, 237, 65, 29, 65, 29,
-	65, 29, -155, 54, -309, -310, 60, 65, 54, -198,
-	54, 56, 55, 56, 56, -197, -306, 260, 261, 262,
-	264, 263, -306, -197, -197, -197, 54, -223, -222, 247,
-	81, -200, -199, -63, 56, 65, 65, -293, 188, -289,
-	332, -285, 16, 330, 16, 16, -140, -155, -288, -238,
-	248, 249, -239, -245, 251, -102, -102, 60, 60, -103,
-	217, -85, 56, 55, 89, 56, -177, -111, -110, 393,
-	-197, 60, 56, 56, 56, 56, -197, 247, -201, 196,
-	64, 397, 258, 259, -63, 56, 56, -299, 54, 65,
-	-290, 16, -288, 16, -288, -288, 56, 55, -243, 252,
-	54, -241, 54, -241, 77, 261, 218, 219, 56, -310,
-	60, 56, -115, -116, -113, -114, 51, 337, 244, 245,
-	56, -200, -200, -200, -200, 56, -147, 60, 257, -303,
-	30, 56, -298, -297, -137, -294, -155, 333, 60, -288,
-	-155, -240, 253, 65, -173, 54, -173, 54, -242, 250,
-	54, -222, -114, 51, -113, 51, 10, 9, -117, 65,
-	-153, -302, -301, -300, 56, 55, 119, -247, 54, 16,
-	56, -236, 56, -236, 54, 89, -173, -112, 241, 242,
-	30, 129, -112, 55, 89, -297, -155, -248, -246, 206,
-	-239, 56, 56, -236, 65, 56, 70, 29, 243, -301,
-	29, -177, 119, 56, 55, 57, -244, 254, 56, -155,
-	-246, -249, 33, 65, -253, -250, 54, -118, 208, -253,
-	-118, -252, -251, 253, 209, 56, 55, 57, 54, -251,
-	-250, -183, 56,
+	-16, -15, -14, 10, 12, -8, -171, -22, -23, -24,
+	-25, -26, -27, -28, -29, -30, -31, -32, 184, 11,
+	51, -36, -37, -38, -42, -43, -44, -45, 286, 292,
+	330, -52, -54, -17, -18, -19, -20, 180, -9, -10,
+	-11, -12, -13, 202, 201, 28, 200, 181, 123, 124,
+	126, 127, 32, -53, -332, 56, 182, -55, 410, 8,
+	455, -63, 29, -85, -168, 59, -157, -159, 413, 414,
+	415, 416, 417, 418, 419, 420, 421, 422, 423, 424,
+	425, 426, 427, 428, 429, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 439, 440, 441, 442, 443, 444,
+	445, 446, 447, 448, 407, 219, 243, 246, 253, 123,
+	140, 134, 161, 153, 154, 131, 223, 224, 66, 126,
+	358, 344, 328, 129, 238, 240, 242, 245, 225, 149,
+	145, 237, 221, 329, 143, 226, 30, 227, 166, 228,
+	229, 412, 340, 268, 346, 250, 144, 341, 241, 343,
+	167, 171, 350, 292, 138, 139, 348, 352, 165, 199,
+	34, 409, 36, 211, 353, 169, 164, 160, 361, 254,
+	252, 163, 137, 159, 222, 40, 173, 172, 174, 322,
+	323, 324, 325, 230, 231, 280, 349, 156, 146, 272,
+	132, 20, 356, 206, 345, 293, 232, 247, 209, 255,
+	168, 170, 213, 218, 411, 251, 354, 142, 210, 239,
+	205, 357, 133, 286, 295, 233, 127, 244, 351, 360,
+	39, 308, 136, 130, 196, 124, 215, 220, 234, 235,
+	236, 257, 256, 248, 157, 212, 162, 135, 158, 125,
+	214, 359, 309, 454, 270, 311, 155, 152, 216, 189,
+	355, 347, 128, 315, 310, 150, 258, 451, 452, 453,
+	281, 396, 399, 402, 403, 404, 405, 13, -172, 21,
+	326, -40, 327, 184, 56, -168, -5, -4, -33, -51,
+	187, -60, -61, -62, -130, -132, -85, 56, -168, -245,
+	-215, -244, -216, -247, -217, -167, 22, 181, 180, 214,
+	12, 182, 290, 188, 10, 8, 291, 200, 11, 292,
+	294, 295, 298, 299, 300, 33, 303, 304, 59, 62,
+	-168, -245, -215, 218, 225, -67, -68, -131, 17, 5,
+	7, 6, -281, 311, 217, -209, -207, 301, 197, 196,
+	79, 363, 186, -343, -278, 347, 346, -176, 345, 338,
+	340, 180, 188, 348, 34, 350, 351, 341, 311, 128,
+	125, -231, 83, 133, 132, -231, 217, 31, -238, 321,
+	-237, -239, 350, 351, 361, -232, 349, -155, -168, 60,
+	61, 78, 154, 151, -68, -131, -67, -53, -54, -52,
+	-54, 311, 217, 188, 187, 363, -334, 395, -333, -168,
+	-58, 67, -1, -74, 209, -85, 122, -60, -149, -168,
+	328, 92, -40, -40, 327, -335, -336, -337, -339, 184,
+	327, 326, 122, -85, 32, -133, -134, -135, -136, 43,
+	47, 49, 44, 45, 46, 50, -345, 25, -164, -170,
+	25, -165, 62, -166, -159, 59, 60, 61, -52, -54,
+	53, 57, 13, 57, 56, 456, 60, 288, 302, 311,
+	289, 301, 189, 217, 302, 217, 338, 189, 293, 296,
+	297, 339, 53, 190, 53, -295, 361, -50, 29, -70,
+	19, -55, -54, 18, 22, 23, 22, 23, 22, 23,
+	-341, 187, 395, -205, 192, -205, 188, -205, -344, 13,
+	102, 216, 215, 342, 339, -254, 343, 344, -176, -175,
+	100, -176, 187, 363, -279, 192, 354, 410, 131, 132,
+	133, -242, 22, 31, 320, -215, 217, 57, 92, 21,
+	-240, 92, 103, -239, -239, -239, -240, -103, 31, -166,
+	62, 119, -103, 31, 122, 32, 32, -69, -70, -55,
+	-54, -68, -67, -68, 58, 58, -279, -279, -279, -279,
+	-279, 57, -334, -73, 56, -280, 22, -285, 23, 24,
+	-286, 35, -51, 56, 32, 56, -168, -122, 14, 122,
+	68, 62, -340, 165, 157, 329, -40, 58, 57, -338,
+	74, 75, -340, -168, 56, -230, -229, -149, -61, -61,
+	-61, -61, 43, 43, 43, 48, 43, 48, 43, -135,
+	-168, -170, 58, -246, 187, 287, 213, -244, 214, 292,
+	295, -221, -220, -218, -167, 62, -216, -249, -149, -167,
+	339, -246, -221, -220, 331, 450, -50, -190, 84, -192,
+	-185, -186, -188, -313, -308, -184, 56, 108, 109, 116,
+	85, -187, -267, 26, 87, 417, 371, -138, -139, -140,
+	-141, -168, -309, -307, 62, 68, 72, 74, 75, 73,
+	70, 63, 121, -54, 59, -327, 425, -284, -290, -288,
+	151, 203, 147, 148, 10, 114, 321, 119, 422, 441,
+	448, 419, 420, 414, 415, 416, 418, 427, 429, 440,
+	-291, 436, 446, 447, 61, 60, 439, 438, 274, 78,
+	275, 276, 363, 271, 277, 192, 326, 45, 278, 279,
+	280, 281, 282, 370, 283, 46, 284, 273, 207, 311,
+	285, 150, 374, 373, 375, 367, 364, 362, 365, 366,
+	368, 369, 433, 434, 435, -66, -65, -190, 189, -205,
+	-221, 84, -215, -166, -168, -85, -175, -175, -177, -344,
+	-173, -344, 339, -122, -188, -254, -174, -168, -205, -221,
+	311, 26, 355, 356, 129, 132, 131, 6, -243, 320,
+	22, -215, -237, -233, 62, 321, -220, -241, 53, 119,
+	-292, -190, 31, -240, -240, -240, -241, 118, -168, -50,
+	-69, -50, -70, -221, -215, -168, -86, -85, -169, -166,
+	-159, -333, 25, -72, -168, -56, -57, 110, -190, -168,
+	-287, -197, -326, 449, -76, 58, -71, -168, -324, -325,
+	-71, -75, -168, -68, -190, -161, -162, -154, -158, -165,
+	-166, -159, 269, 185, 22, 83, 25, 27, 274, 306,
+	86, 119, 18, 87, 151, 118, 276, 371, 275, 180,
+	49, 397, 78, 373, 375, 374, 364, 362, 313, 317,
+	319, 316, 363, 338, 31, 12, 28, 201, 23, 24,
+	112, 182, 203, 90, 91, 204, 6, 26, 202, 75,
+	21, 52, 13, 326, 15, 398, 16, 277, 312, 192,
+	191, 102, 331, 188, 47, 10, 7, 121, 29, 99,
+	314, 43, 80, 45, 100, 19, 365, 366, 33, 330,
+	406, 208, 114, 278, 279, 50, 84, 320, 73, 53,
+	81, 17, 48, 400, 101, 183, 370, 46, 217, 318,
+	282, 284, 395, 283, 186, 8, 273, 372, 32, 200,
+	44, 187, 339, 89, 190, 74, 207, 147, 148, 5,
+	79, 11, 51, 54, 367, 368, 369, 35, 88, 14,
+	285, 410, 321, 332, 333, 334, 335, 336, 337, 175,
+	176, 177, 178, 179, 249, 195, 193, 197, 198, 449,
+	401, 450, 21, -40, -40, -336, 122, -72, -122, 57,
+	92, -78, -77, 53, 54, -79, 53, -77, 43, 43,
+	-73, -248, 110, 59, 57, -219, 312, 456, 60, 58,
+	57, -248, 190, 62, 62, 57, 20, 83, 81, 82,
+	-190, 99, -201, 92, 93, 94, 95, 96, 97, 105,
+	104, 115, 108, 109, 110, 111, 112, 113, 114, 106,
+	107, 102, 84, 100, 101, 86, -54, -190, -196, -188,
+	-188, -188, -188, -267, -194, -190, 56, 56, -142, 400,
+	-142, 122, 62, 68, 56, 56, 56, 56, -289, 56,
+	-198, -199, 56, 56, 62, 62, 62, 56, 56, 56,
+	-188, -199, -199, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 57, -64, 27,
+	28, -85, 192, -85, -222, -223, 318, 26, -208, 54,
+	-203, -204, -202, -206, 31, -122, -122, -122, -175, -169,
+	-177, -172, -177, -173, 122, -156, -168, -222, 56, 130,
+	133, 133, 132, -215, 190, 56, 92, -241, -241, -241,
+	31, -167, -50, -50, 53, 57, 56, 58, 57, -121,
+	57, -120, 13, -163, -168, 25, 122, 57, 56, 58,
+	57, 35, 122, 57, 92, 58, 57, -69, 122, 328,
+	-168, 58, -68, -229, -190, -190, 56, -190, 13, 122,
+	122, -220, 18, 410, -167, -149, 190, -221, -296, 191,
+	370, -299, 343, -190, -190, -190, -190, -190, 73, 84,
+	-185, -186, -186, -186, -186, -186, -186, -186, -186, -186,
+	-186, -186, -186, -193, -200, -267, 56, 102, 100, 101,
+	86, -188, -186, -186, 58, 57, -331, -330, 88, -190,
+	-190, -143, 56, -168, -328, -329, 376, 377, 378, 379,
+	380, 381, 382, 383, 384, 385, 386, 278, 273, 279,
+	277, 271, 285, 280, 281, 150, 393, 394, 387, 388,
+	389, 390, 391, 392, -195, -196, -190, -195, -190, -328,
+	-195, 58, 58, -268, 68, -196, -195, -186, -195, -137,
+	23, 22, -196, -196, -137, -137, -137, -137, 110, -137,
+	-137, -137, -137, -137, -137, -137, -65, -73, 84, 56,
+	-227, 410, 320, 319, 315, -224, -225, 314, 316, 313,
+	317, 53, 263, 264, 265, 266, -202, -155, 118, 228,
+	154, -122, -175, -175, -177, -168, -227, 58, 133, -221,
+	-178, 62, -233, -85, -85, -1, -168, -122, -57, -59,
+	-60, -168, -168, 110, -197, -294, -293, -292, 35, -51,
+	-71, -287, -168, -325, -292, -168, -161, -158, -166, -159,
+	68, -69, -72, -221, 110, 110, 59, -167, 321, -167,
+	-221, -234, 410, 29, -305, 337, 332, 334, 73, -196,
+	-193, -188, -186, -186, -191, 204, 83, -190, -189, -330,
+	90, -190, 25, 57, 54, -144, 209, 122, 58, 57,
+	58, 13, 13, 58, 58, 58, 54, 58, 57, 58,
+	-190, 58, 58, -190, -190, -190, -196, 58, -190, -190,
+	-190, -190, -190, -190, -190, 25, 26, -80, -81, -82,
+	-87, -83, -149, -180, -84, 195, 193, 197, -321, 79,
+	198, 249, 80, 188, -226, -228, 322, 323, 324, 325,
+	83, -225, 62, 62, 62, 62, -85, -160, 92, -160,
+	-160, -122, -122, -175, -182, -183, -181, 269, -282, 321,
+	312, 58, 58, -124, 15, 57, 122, 58, 57, -287,
+	58, -167, 18, 25, -222, 292, 187, -276, 451, -303,
+	332, 18, 18, 58, -191, 83, -188, -185, 58, 91,
+	-190, 89, -88, -94, 119, 151, 203, 150, 149, 147,
+	308, 309, 143, 144, 145, 142, -88, -103, -68, 18,
+	-168, -190, -190, -190, -103, -186, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, -51, -85,
+	58, 57, -89, -93, -90, -92, -91, -95, -94, 151,
+	152, 119, 155, 157, 158, 159, 160, 161, 162, 163,
+	164, 165, 166, 32, 203, 147, 148, 149, 150, 167,
+	134, 153, 408, 175, 135, 176, 136, 177, 137, 178,
+	138, 139, 179, 140, -84, -168, 80, -320, -321, -205,
+	-320, 80, 56, -228, 68, 68, 68, 68, -225, 56,
+	-103, -105, -166, 62, 119, 62, -122, -181, 270, 33,
+	121, 272, 31, 268, 18, -123, 16, 18, -60, 110,
+	-292, -167, -167, -234, 293, -85, -110, 452, 62, 18,
+	62, -301, 62, -185, -190, 58, -269, -271, 56, -270,
+	56, -269, -269, -269, -96, 139, 138, -96, -273, 56,
+	-273, -274, 56, -273, 58, 58, -145, -148, 401, 251,
+	-196, 58, 21, 58, 58, 58, -73, -101, -102, -119,
+	306, 219, -206, 223, 66, 224, 328, 225, 188, 227,
+	228, 229, 199, 230, 231, 232, 321, 233, 234, 235,
+	236, 289, 5, 259, -82, -100, -99, -97, 73, 84,
+	31, 306, -98, 66, 118, 242, 220, 243, -118, -179,
+	193, 79, 80, 294, -180, -275, 309, 308, -269, -270,
+	-271, -269, -269, 56, 56, -269, -272, 56, -269, -269,
+	-317, -318, -168, -318, -168, -317, -317, -205, -190, -210,
+	-212, -149, 56, 68, -283, -178, -190, -125, -127, -190,
+	396, 397, 398, -297, -254, -152, 453, 68, 62, 334,
+	68, 68, 68, 68, 58, -147, 86, 404, 405, -184,
+	-190, 25, -250, 209, 57, -119, -160, -160, -155, 118,
+	-160, -160, -160, -160, 226, 226, -160, -160, -160, -160,
+	-160, -160, -160, -160, -160, -160, -160, -160, -160, -160,
+	56, -97, 73, -186, 62, -105, -106, 31, 241, 237,
+	-107, 31, 221, 222, -109, 56, 249, 80, 80, -85,
+	-277, 310, -151, 62, -151, 68, 56, 54, 258, 56,
+	56, 56, -318, 58, 58, 57, -269, -190, 271, -129,
+	57, 410, 56, 56, 399, -304, 337, -300, -298, 332,
+	333, 334, 335, 56, 18, 58, 58, 58, 57, 58,
+	57, -146, -147, 404, -184, 402, 449, 402, 58, -51,
+	18, -119, 68, 68, -160, -160, 68, 62, 62, 62,
+	-160, -160, 68, 62, -170, 68, 68, 68, 68, 31,
+	62, -108, 31, 237, 241, 238, 239, 240, 68, 31,
+	68, 31, 68, 31, -168, 56, -322, -323, 62, 68,
+	56, -211, 56, 58, 57, 58, 58, -210, -319, 263,
+	264, 265, 267, 266, -319, -210, -210, -210, 56, -236,
+	-235, 250, 84, -213, -212, -64, 58, -127, 396, -196,
+	-196, 56, -306, 191, -302, 336, -298, 18, 334, 18,
+	18, -153, -168, -301, 68, 68, 83, 403, 403, -251,
+	251, 252, -252, -258, 254, -103, -103, 62, 62, -104,
+	220, -86, 58, 57, 92, 58, -190, -112, -111, 406,
+	-210, 62, 58, 58, 58, 58, -210, 250, -214, 199,
+	66, 410, 261, 262, -64, 58, 58, -126, -128, -190,
+	56, -312, 56, 68, -303, 18, -301, 18, -301, -301,
+	58, 57, 58, 58, -146, -256, 255, 56, -254, 56,
+	-254, 80, 264, 221, 222, 58, -323, 62, 58, -116,
+	-117, -114, -115, 53, 341, 247, 248, 58, -213, -213,
+	-213, -213, 58, -160, 62, 260, 58, 57, 58, -316,
+	32, 58, -311, -310, -150, -307, -168, 337, 62, -301,
+	-168, -253, 256, 68, -186, 56, -186, 56, -255, 253,
+	56, -235, -115, 53, -114, 53, 12, 11, -118, 68,
+	-166, -128, -315, -314, -313, 58, 57, 122, -260, 56,
+	18, 58, -249, 58, -249, 56, 92, -186, -113, 244,
+	245, 32, 132, -113, 57, 92, -310, -168, -261, -259,
+	209, -252, 58, 58, -249, 68, 58, 73, 31, 246,
+	-314, 31, -190, 122, 58, 57, 59, -257, 257, 58,
+	-168, -259, -262, 35, 68, -266, -263, 56, -119, 211,
+	-266, -119, -265, -264, 256, 212, 58, 57, 59, 56,
+	-264, -263, -196, 58,
 }
 
 var yyDef = [...]int{
 	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	440, 441, 442, 0, 0, 273, 0, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 209, 0, 0,
+	461, 462, 463, 0, 0, 274, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 210, 0, 0,
 	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 333, -2, 443, 444, 445, 446, -2, 274, 275,
-	276, 277, 278, 198, 199, 200, -2, 0, 173, 0,
-	165, 165, 0, 353, 0, 0, 0, 364, 0, 373,
-	20, 311, 0, 316, 622, 658, 659, 660, 1308, 1309,
-	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
-	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
-	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
-	1340, 1341, 1342, 1343, 1151, 1152, 1153, 1154, 1155, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
-	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
-	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
-	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
-	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
-	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
-	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
-	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
-	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
-	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
-	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
-	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
-	1307, 0, 189, 0, 0, 193, 0, 0, 0, 270,
-	185, 186, 187, 188, 0, 0, 395, 396, 419, 422,
-	425, 0, 179, 0, 0, 80, 488, 82, 490, 0,
-	86, 88, 89, -2, 93, 94, 95, 96, 97, 98,
-	99, 0, 101, 1200, 103, 1261, 106, 107, 108, 0,
-	117, 118, -2, -2, 485, 0, 0, 1250, 62, -2,
-	0, 0, 0, 369, 449, 519, 519, 0, 519, 532,
-	496, 497, 498, 517, 518, 0, 0, 246, 247, 0,
-	263, 254, 263, 0, 238, 239, 240, 244, 245, 264,
-	212, 174, 175, 164, 0, 169, 0, 163, 0, 0,
-	133, 0, 138, 0, 1199, 1265, 1215, 0, 1233, 0,
-	158, 151, 152, 996, 1161, 0, 348, 0, 354, 353,
-	353, 0, 353, 212, 212, 212, 212, 212, 341, 0,
-	343, 346, 0, 374, 375, 376, 377, 3, 0, 0,
-	315, 0, 382, 190, 661, 0, 0, 194, 195, 0,
-	0, 201, 0, 204, 1344, 1345, 1346, 0, 0, 0,
-	0, 0, 0, 0, 410, 0, 0, 409, 0, 0,
-	0, 0, 423, 424, 426, 0, 428, 429, 435, 436,
-	437, 438, 439, 0, 353, 76, 0, 0, 0, 0,
-	0, 492, 87, 116, 90, 91, 0, 111, 113, 115,
-	114, 100, 112, 102, 104, 105, 110, 76, 0, 0,
-	0, 63, 336, 0, 317, 0, 366, 368, 0, 370,
-	371, 0, 519, 450, 0, 0, 0, 0, 0, 0,
-	259, 260, 254, 254, 248, 256, 0, 261, 262, 0,
-	382, 0, 0, 0, 519, 0, 0, 0, 0, 167,
-	0, 172, 123, 128, 126, 127, 129, 0, 0, 0,
-	0, 0, 156, 157, 0, 0, 0, 0, 145, 148,
-	614, 615, 616, 149, 150, 0, 997, 998, 317, 349,
-	365, 367, 348, -2, 0, 362, 363, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 390, 384, 386, 430,
-	28, 0, 895, 658, 899, 1309, 1310, 1311, 1312, 1313,
-	1314, 1315, 1317, 1320, 1322, 1324, -2, -2, -2, 1331,
-	-2, -2, 1335, 1336, 1341, 1342, 1343, -2, -2, -2,
-	-2, 908, 729, 730, 731, 732, 0, 0, 0, 0,
-	0, 739, 740, 0, 752, 0, 746, 747, 748, 749,
-	38, 39, 924, 925, 926, 927, 928, 929, 930, 862,
-	716, 0, 0, 847, 837, 0, 857, 875, 876, 0,
-	0, 0, 0, 0, 40, 41, 853, 854, 855, 856,
-	858, 859, 860, 861, 863, 864, 865, 866, 869, 870,
-	871, 872, 873, 874, 877, 879, 849, 850, 851, 852,
-	841, 842, 843, 844, 845, 846, 285, 303, 287, 0,
-	292, 0, 623, 353, 0, 0, 191, 0, 196, 0,
-	0, 203, 205, 206, 207, 1347, 1348, 271, 0, 382,
-	182, 0, 413, 407, 0, 400, 411, 412, 403, 0,
-	405, 0, 401, 402, 346, 427, 421, 0, 77, 78,
-	79, 81, 92, 0, 0, 70, 473, 479, 476, 486,
-	489, 0, 84, 491, 109, 0, 65, 0, 0, 0,
-	337, 350, 28, 355, 356, 359, 0, 0, 460, 0,
-	487, 511, -2, 382, 382, 382, 254, 0, 256, 0,
-	256, 251, 255, 0, 265, 267, 0, 460, 1292, 213,
-	176, 177, 0, 0, 171, 0, 0, 130, 131, 132,
-	139, 134, 136, 0, 0, 140, 153, 154, 155, 309,
-	310, 0, 0, 0, 144, 0, 159, 335, 317, 339,
-	317, 279, 280, 0, 282, 620, 283, 433, 434, 344,
-	0, 0, 417, 382, 0, 391, 0, 387, 0, 0,
-	0, 431, 0, 0, 894, 0, 0, 913, 914, 915,
-	916, 917, 918, 887, 883, 883, 883, 0, 883, 0,
-	0, 823, 0, 0, 883, 883, 883, 883, 824, 883,
-	883, 883, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, -2,
-	889, 0, 735, 736, 737, 738, 741, 0, 753, 0,
-	881, 0, 887, 887, 826, 0, 827, 838, 0, 830,
-	831, 832, 887, 0, 887, 836, 883, 286, 300, 0,
-	304, 0, 0, 296, 298, 291, 293, 0, 0, 313,
-	348, 383, 662, 0, 1003, -2, 1005, -2, -2, 1007,
-	1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
-	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027,
-	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037,
-	1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047,
-	1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057,
-	1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067,
-	1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
-	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087,
-	1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097,
-	1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107,
-	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127,
-	1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
-	1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147,
-	1148, 1149, 1150, 0, 197, 202, 0, 0, 353, 0,
-	0, 397, 414, 0, 0, 398, 0, 399, 404, 406,
-	420, 0, 71, 75, 0, 475, 0, 0, 478, 83,
-	0, 0, 0, 59, 319, 0, 0, 0, 0, 358,
-	360, 361, 346, 0, 0, 452, 461, 0, 520, 0,
-	0, 516, -2, 523, 0, 529, 237, 241, 242, 382,
-	257, 254, 258, 254, 256, 0, 266, 269, 452, 0,
-	178, 166, 168, 0, 125, 0, 0, 0, 141, 142,
-	143, 146, 147, 338, 340, 0, 0, 20, 347, 0,
-	380, 385, 392, 393, 891, 892, 893, 432, 29, 388,
-	896, 0, 898, 0, 888, 889, 0, 884, 885, 0,
-	0, 0, 0, 0, 0, 0, 839, 0, 923, 0,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 819, 820, 821, 822, 0,
-	0, 0, 0, 0, 0, 0, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 900, 911,
-	912, 0, 0, 0, 0, 0, 909, 904, 0, 733,
-	0, 750, 754, 0, 0, 882, 0, 889, 0, 848,
-	0, 0, 0, 0, 0, 303, 305, 0, 0, 303,
-	0, 0, 0, 312, 0, 284, 0, 0, 272, 208,
-	348, 183, 184, 415, 0, 408, 0, 0, 0, 474,
-	0, 0, 477, 85, 0, 67, 0, 60, 61, 323,
-	0, 351, 352, 29, 357, 0, 0, 624, 451, 0,
-	462, 463, 464, 465, 466, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 524, 999, 999, 999, 0, 249,
-	382, 382, 254, 268, 214, 0, 170, 124, 0, 226,
-	135, 281, 621, 0, 418, 378, 0, 0, 0, 897,
-	787, 0, 0, 0, 0, 0, 0, 776, 770, 771,
-	840, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	901, 909, 905, 0, 902, 0, 0, 890, 0, 755,
-	0, 0, 0, 0, 788, 0, 825, 828, 0, 833,
-	0, 835, 0, 301, 0, 306, 307, 303, 290, 297,
-	289, 299, 294, 295, 314, 663, 1004, 1001, 1002, 192,
-	181, 0, 69, 72, 73, 74, 480, 0, 481, 460,
-	66, 0, 0, 325, 48, 0, 0, 0, 0, 0,
-	0, 625, 626, 628, 629, 0, 0, 631, 685, 0,
-	640, 519, 640, 0, 0, 642, 643, 453, 454, 0,
-	0, 0, 0, 0, 468, 469, 470, 471, 472, 0,
-	0, 1000, 0, 0, 252, 250, 382, 210, 215, 216,
-	0, 220, 0, 0, 137, 345, 372, 0, 0, 394,
-	30, 389, 890, 772, 773, 774, 0, 757, 978, 982,
-	760, 978, 978, 978, 766, 766, 985, 985, 988, 985,
-	775, 0, 777, 778, 781, 779, 782, 783, 769, 886,
-	903, 0, 910, 906, 734, 742, 751, 0, 0, 0,
-	0, 0, 0, 780, 302, 0, 288, 416, 484, 0,
-	0, 67, 0, 0, 327, 0, 324, 0, 0, 0,
-	447, 346, -2, 0, -2, 991, 932, 933, 934, 978,
-	936, 982, 0, 978, 978, 964, 965, 966, 967, 968,
-	969, 970, 971, 972, 0, 0, 955, 978, 980, 978,
-	978, 975, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 630, 686, 652, 652,
-	641, 652, 652, 519, 0, 455, 456, 457, 458, 459,
-	467, 0, 525, 526, 617, 618, 619, 527, 253, 217,
-	218, 219, 0, 222, 223, 225, 0, 379, 381, 743,
-	758, 979, 0, 759, 0, 761, 762, 763, 764, 767,
-	768, 765, 951, 0, 952, 953, 0, 954, 791, 907,
-	756, 744, 745, 789, 0, 829, 834, 308, 482, 483,
-	64, 68, 50, 329, 0, 326, 0, 320, 322, 58,
-	0, 533, -2, 570, 999, 999, 0, 999, 999, 999,
-	999, 0, 0, 999, 999, 999, 999, 999, 999, 999,
-	999, 999, 999, 999, 999, 999, 999, 0, 627, 654,
-	-2, 666, 668, 0, 0, 671, 672, 0, 0, 0,
-	0, 708, 678, 0, 0, 921, 922, 0, 684, 994,
-	992, 993, 935, 960, 961, 962, 963, 0, 0, 956,
-	957, 0, 958, 959, 0, 644, 653, 0, 653, 0,
-	0, 652, 0, 0, 507, 978, 0, 0, 224, 211,
-	0, 0, 0, 0, 0, 44, 0, 318, 0, 328,
-	49, 0, 0, 530, 0, 528, 572, 0, 0, 999,
-	999, 0, 0, 0, 0, 999, 999, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 667, 669, 670, 673, 674, 675, 713, 714,
-	715, 676, 710, 711, 712, 677, 0, 0, 919, 920,
-	706, 931, 995, 0, 976, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 500, 0, 359, 0, 221,
-	984, 983, 986, 0, 989, 0, 790, 42, 46, 51,
-	52, 0, 0, 0, 0, 0, 0, 448, 566, 571,
-	573, 574, 0, 0, 577, 578, 579, 580, 0, 0,
-	583, 584, 585, 586, 587, 588, 589, 590, 591, 592,
-	608, 609, 610, 611, 612, 613, 593, 594, 595, 596,
-	597, 598, 605, 0, 0, 602, 0, 0, 0, 701,
-	0, 973, 0, 974, 981, 0, 645, 647, 648, 649,
-	650, 651, 646, 0, 0, 0, 0, 637, 639, 681,
-	0, 499, 508, 509, 359, 0, 0, 31, 0, 48,
-	0, 53, 0, 0, 0, 0, 0, 331, 321, 555,
-	0, 0, 561, 0, 567, 575, 576, 581, 582, 599,
-	0, 0, 601, 0, 0, 709, 0, 688, 702, 0,
-	0, 977, 500, 500, 500, 500, 0, 682, 501, 999,
-	0, 0, 505, 506, 510, 987, 990, 22, 0, 0,
-	45, 0, 54, 0, 56, 57, 330, 0, 535, 0,
-	0, 0, 0, 0, 564, 0, 606, 607, 600, 603,
-	604, 679, 687, 689, 690, 691, 0, 703, 704, 705,
-	707, 632, 633, 634, 635, 0, 0, 503, 0, 21,
-	0, 32, 0, 34, 36, 37, 655, 43, 47, 55,
-	332, 537, 0, 556, 0, 0, 0, 0, 0, 0,
-	0, 680, 692, 0, 693, 0, 0, 0, 636, 502,
-	504, 23, 24, 0, 33, 0, 0, 534, 0, 566,
-	557, 0, 559, 0, 0, 0, 0, 694, 696, 697,
-	0, 0, 695, 0, 0, 35, 656, 0, 539, 0,
-	553, 558, 560, 0, 565, 563, 698, 700, 699, 25,
-	26, 27, 0, 538, 0, 551, 536, 0, 562, 657,
-	540, -2, 0, 554, 541, -2, 0, 549, 0, 542,
-	550, 0, 545, 0, 0, 544, 0, -2, 0, 546,
-	-2, 0, 552,
+	0, 334, -2, 464, 465, 466, 467, -2, 275, 276,
+	277, 278, 279, 199, 200, 201, -2, 0, 173, 0,
+	165, 165, 0, 354, 0, 0, 0, 365, 0, 380,
+	20, 312, 0, 317, 643, 679, 680, 681, 1358, 1359,
+	1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369,
+	1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379,
+	1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389,
+	1390, 1391, 1392, 1393, 1195, 1196, 1197, 1198, 1199, 1200,
+	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
+	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
+	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
+	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
+	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
+	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
+	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
+	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
+	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300,
+	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
+	1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320,
+	1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330,
+	1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340,
+	1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
+	1351, 1352, 1353, 1354, 1355, 1356, 1357, 0, 189, 0,
+	0, 194, 0, 0, 0, 271, 185, 186, 187, 188,
+	0, 0, 416, 417, 440, 443, 446, 0, 179, 0,
+	0, 80, 509, 82, 511, 0, 86, 88, 89, -2,
+	93, 94, 95, 96, 97, 98, 99, 0, 101, 1244,
+	103, 1305, 106, 107, 108, 0, 117, 118, -2, -2,
+	506, 0, 0, 1294, 62, -2, 0, 0, 0, 370,
+	373, 376, 470, 540, 540, 0, 540, 553, 517, 518,
+	519, 538, 539, 0, 0, 247, 248, 0, 264, 255,
+	264, 0, 239, 240, 241, 245, 246, 265, 213, 174,
+	175, 164, 0, 169, 0, 163, 0, 0, 133, 0,
+	138, 0, 1243, 1309, 1259, 0, 1277, 0, 158, 151,
+	152, 1034, 1205, 0, 349, 0, 355, 354, 354, 0,
+	354, 213, 213, 213, 213, 213, 342, 0, 344, 347,
+	382, 381, 3, 0, 0, 316, 0, 403, 190, 682,
+	0, 0, 195, 196, 0, 0, 202, 0, 205, 1394,
+	1395, 1396, 0, 0, 0, 0, 0, 0, 0, 431,
+	0, 0, 430, 0, 0, 0, 0, 444, 445, 447,
+	0, 449, 450, 456, 457, 458, 459, 460, 0, 354,
+	76, 0, 0, 0, 0, 0, 513, 87, 116, 90,
+	91, 0, 111, 113, 115, 114, 100, 112, 102, 104,
+	105, 110, 76, 0, 0, 0, 63, 337, 0, 318,
+	0, 367, 369, 0, 371, 372, 374, 375, 377, 378,
+	0, 540, 471, 0, 0, 0, 0, 0, 0, 260,
+	261, 255, 255, 249, 257, 0, 262, 263, 0, 403,
+	0, 0, 0, 540, 0, 0, 0, 0, 167, 0,
+	172, 123, 128, 126, 127, 129, 0, 0, 0, 0,
+	0, 156, 157, 0, 0, 0, 0, 145, 148, 635,
+	636, 637, 149, 150, 0, 1035, 1036, 318, 350, 366,
+	368, 349, -2, 0, 363, 364, 0, 0, 0, 0,
+	0, 0, 343, 0, 0, 0, 383, 384, 385, 386,
+	286, 304, 288, 0, 293, 0, 644, 354, 0, 0,
+	191, 0, 0, 1397, 1398, 1399, 197, 0, 0, 204,
+	206, 207, 208, 272, 0, 403, 182, 0, 434, 428,
+	0, 421, 432, 433, 424, 0, 426, 0, 422, 423,
+	347, 448, 442, 0, 77, 78, 79, 81, 92, 0,
+	0, 70, 494, 500, 497, 507, 510, 0, 84, 512,
+	109, 0, 65, 0, 0, 0, 338, 351, 0, 932,
+	936, 945, 750, 751, 752, 753, 0, 0, 0, 0,
+	0, 760, 761, 0, 789, 1362, 0, 771, 768, 769,
+	771, 28, 38, 39, 961, 962, 963, 964, 965, 966,
+	967, 968, 899, 737, 679, 0, 1370, 0, 884, 874,
+	0, 894, 912, 913, 0, 0, 0, 0, 1367, 1386,
+	1393, 1364, 1365, 1359, 1360, 1361, 1363, 1372, 1374, 1385,
+	0, 1381, 1391, 1392, 40, 41, -2, -2, 890, 891,
+	892, 893, 895, 896, 897, 898, 900, 901, 902, 903,
+	-2, -2, 906, 907, 908, 909, 910, 911, 914, -2,
+	916, -2, 886, 887, 888, 889, 878, 879, 880, 881,
+	882, 883, -2, -2, -2, 356, 357, 360, 0, 0,
+	481, 0, 508, 532, -2, 403, 403, 403, 255, 0,
+	257, 0, 257, 252, 256, 0, 266, 268, 0, 481,
+	1336, 214, 176, 177, 0, 0, 171, 0, 0, 130,
+	131, 132, 139, 134, 136, 0, 0, 140, 153, 154,
+	155, 310, 311, 0, 0, 0, 144, 0, 159, 336,
+	318, 340, 318, 280, 281, 0, 283, 641, 284, 454,
+	455, 345, 0, 0, 438, 411, 405, 407, 451, 28,
+	287, 301, 0, 305, 0, 0, 297, 299, 292, 294,
+	0, 0, 314, 349, 404, 683, 0, 1041, -2, 1043,
+	-2, -2, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
+	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
+	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
+	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
+	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
+	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
+	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
+	1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132,
+	1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142,
+	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
+	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
+	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 0, 193, 198, 203, 0, 0, 354, 0,
+	0, 418, 435, 0, 0, 419, 0, 420, 425, 427,
+	441, 0, 71, 75, 0, 496, 0, 0, 499, 83,
+	0, 0, 0, 59, 320, 0, 0, 0, 0, 0,
+	931, 0, 0, 950, 951, 952, 953, 954, 955, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 926, 0, 756,
+	757, 758, 759, 762, 0, 790, 0, 0, 767, 0,
+	770, 0, 918, 0, 924, 924, 0, 924, 863, 0,
+	864, 875, 0, 0, 867, 868, 869, 924, 0, 924,
+	873, 860, 861, 920, 0, 0, 920, 920, 920, 920,
+	920, 920, 920, 920, 920, 920, 920, 0, 359, 361,
+	362, 347, 0, 0, 473, 482, 0, 541, 0, 0,
+	537, -2, 544, 0, 550, 238, 242, 243, 403, 258,
+	255, 259, 255, 257, 0, 267, 270, 473, 0, 178,
+	166, 168, 0, 125, 0, 0, 0, 141, 142, 143,
+	146, 147, 339, 341, 0, 0, 20, 348, 0, 403,
+	0, 412, 0, 408, 452, 0, 0, 304, 306, 0,
+	0, 304, 0, 0, 0, 313, 0, 285, 0, 0,
+	273, 209, 349, 183, 184, 436, 0, 429, 0, 0,
+	0, 495, 0, 0, 498, 85, 0, 67, 0, 60,
+	61, 324, 0, 352, 353, 928, 929, 930, 933, 0,
+	935, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 748, 749, 937, 948, 949, 0, 0, 0, 0,
+	0, 946, 941, 0, 754, 0, 787, 791, 0, 0,
+	0, 772, 774, 29, 919, 831, 832, 833, 834, 835,
+	836, 837, 838, 839, 840, 841, 842, 843, 844, 845,
+	846, 847, 848, 849, 850, 851, 852, 853, 854, 855,
+	856, 857, 858, 859, 0, 925, 926, 0, 926, 0,
+	0, 885, 876, 0, 960, 0, 0, 0, 0, 0,
+	921, 922, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 358, 0, 0, 645,
+	472, 0, 483, 484, 485, 486, 487, 0, 0, 0,
+	0, 0, 533, 534, 535, 536, 545, 1037, 1037, 1037,
+	0, 250, 403, 403, 255, 269, 215, 0, 170, 124,
+	0, 227, 135, 282, 642, 0, 439, 389, 406, 413,
+	414, 453, 29, 409, 302, 0, 307, 308, 304, 291,
+	298, 290, 300, 295, 296, 315, 684, 1042, 1039, 1040,
+	192, 181, 0, 69, 72, 73, 74, 501, 0, 502,
+	481, 66, 0, 0, 326, 48, 0, 0, 934, 0,
+	938, 946, 942, 0, 939, 0, 0, 927, 0, 792,
+	0, 0, 0, 0, 0, 354, 0, 0, 824, 0,
+	825, 0, 0, 862, 877, 865, 0, 870, 0, 872,
+	0, 807, 808, 0, 0, 0, 0, 813, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 646, 647,
+	649, 650, 0, 0, 652, 706, 0, 661, 540, 661,
+	0, 0, 663, 664, 474, 475, 0, 0, 0, 0,
+	0, 489, 490, 491, 492, 493, 0, 0, 1038, 0,
+	0, 253, 251, 403, 211, 216, 217, 0, 221, 0,
+	0, 137, 346, 387, 0, 0, 0, 303, 0, 289,
+	437, 505, 0, 0, 67, 0, 0, 328, 0, 325,
+	0, 0, 0, 923, 940, 0, 947, 943, 755, 763,
+	788, 0, 0, 794, 1016, 1020, 797, 1016, 1016, 1016,
+	803, 803, 1023, 1023, 1026, 1023, 0, 0, 776, 0,
+	30, 927, 0, 0, 0, 0, 806, 809, 810, 811,
+	812, 814, 815, 816, 817, 818, 819, 820, 468, 347,
+	-2, 0, -2, 1029, 970, 971, 972, 1016, 974, 1020,
+	0, 1016, 1016, 1002, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1010, 0, 0, 993, 1016, 1018, 1016, 1016, 1013,
+	975, 976, 977, 978, 979, 980, 981, 982, 983, 984,
+	985, 986, 987, 988, 651, 707, 673, 673, 662, 673,
+	673, 540, 0, 476, 477, 478, 479, 480, 488, 0,
+	546, 547, 638, 639, 640, 548, 254, 218, 219, 220,
+	0, 223, 224, 226, 0, 379, 0, 0, 415, 410,
+	309, 503, 504, 64, 68, 50, 330, 0, 327, 0,
+	321, 323, 58, 944, 793, 764, 795, 1017, 0, 796,
+	0, 798, 799, 800, 801, 804, 805, 802, 989, 0,
+	990, 991, 0, 992, 765, 766, 0, 0, 779, 780,
+	775, 826, 0, 828, 866, 871, 0, 554, -2, 591,
+	1037, 1037, 0, 1037, 1037, 1037, 1037, 0, 0, 1037,
+	1037, 1037, 1037, 1037, 1037, 1037, 1037, 1037, 1037, 1037,
+	1037, 1037, 1037, 0, 648, 675, -2, 687, 689, 0,
+	0, 692, 693, 0, 0, 0, 0, 729, 699, 0,
+	0, 958, 959, 0, 705, 1032, 1030, 1031, 973, 998,
+	999, 1000, 1001, 0, 0, 994, 995, 0, 996, 997,
+	0, 665, 674, 0, 674, 0, 0, 673, 0, 0,
+	528, 1016, 0, 0, 225, 212, 388, 391, 393, 395,
+	1352, 0, 0, 44, 0, 319, 0, 329, 49, 0,
+	0, 0, 0, 0, 773, 777, 0, 0, 0, 0,
+	0, 0, 551, 0, 549, 593, 0, 0, 1037, 1037,
+	0, 0, 0, 0, 1037, 1037, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 688, 690, 691, 694, 695, 696, 734, 735, 736,
+	697, 731, 732, 733, 698, 0, 0, 956, 957, 727,
+	969, 1033, 0, 1014, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 659, 521, 0, 360, 0, 222, 390,
+	0, 0, 0, 0, 0, 42, 46, 51, 52, 0,
+	0, 0, 0, 0, 0, 1022, 1021, 1024, 0, 1027,
+	0, 0, 784, 0, 0, 781, 782, 783, 827, 469,
+	587, 592, 594, 595, 0, 0, 598, 599, 600, 601,
+	0, 0, 604, 605, 606, 607, 608, 609, 610, 611,
+	612, 613, 629, 630, 631, 632, 633, 634, 614, 615,
+	616, 617, 618, 619, 626, 0, 0, 623, 0, 0,
+	0, 722, 0, 1011, 0, 1012, 1019, 0, 666, 668,
+	669, 670, 671, 672, 667, 0, 0, 0, 0, 658,
+	660, 702, 0, 520, 529, 530, 360, 394, 392, 0,
+	0, 0, 31, 0, 48, 0, 53, 0, 0, 0,
+	0, 0, 332, 322, 0, 0, 0, 785, 786, 576,
+	0, 0, 582, 0, 588, 596, 597, 602, 603, 620,
+	0, 0, 622, 0, 0, 730, 0, 709, 723, 0,
+	0, 1015, 521, 521, 521, 521, 0, 703, 522, 1037,
+	0, 0, 526, 527, 531, 396, 397, 0, 399, 401,
+	0, 22, 0, 0, 45, 0, 54, 0, 56, 57,
+	331, 0, 1025, 1028, 778, 556, 0, 0, 0, 0,
+	0, 585, 0, 627, 628, 621, 624, 625, 700, 708,
+	710, 711, 712, 0, 724, 725, 726, 728, 653, 654,
+	655, 656, 0, 0, 524, 0, 398, 0, 402, 21,
+	0, 32, 0, 34, 36, 37, 676, 43, 47, 55,
+	333, 558, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 701, 713, 0, 714, 0, 0, 0, 657, 523,
+	525, 400, 23, 24, 0, 33, 0, 0, 555, 0,
+	587, 578, 0, 580, 0, 0, 0, 0, 715, 717,
+	718, 0, 0, 716, 0, 0, 35, 677, 0, 560,
+	0, 574, 579, 581, 0, 586, 584, 719, 721, 720,
+	25, 26, 27, 0, 559, 0, 572, 557, 0, 583,
+	678, 561, -2, 0, 575, 562, -2, 0, 570, 0,
+	563, 571, 0, 566, 0, 0, 565, 0, -2, 0,
+	567, -2, 0, 573,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 442,
-	90, 89, 91, 3, 443, 3, 3, 3, 3, 3,
+	3, 3, 3, 85, 3, 3, 3, 113, 105, 3,
+	56, 58, 110, 108, 57, 109, 122, 111, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 455,
+	93, 92, 94, 3, 456, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 115, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 104, 3, 116,
 }
 
 var yyTok2 = [...]int{
@@ -4337,12 +4550,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	86, 87, 88, 89, 90, 91, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 106, 107, 112, 114, 117,
+	118, 119, 120, 121, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4394,7 +4607,10 @@ var yyTok3 = [...]int{
 	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
 	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
-	57765, 440, 57766, 441, 0,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4744,7 +4960,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:502
+//line mysql_sql.y:523
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4752,7 +4968,7 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:508
+//line mysql_sql.y:529
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -4761,7 +4977,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:531
+//line mysql_sql.y:552
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -4769,7 +4985,7 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:535
+//line mysql_sql.y:556
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -4777,7 +4993,7 @@ yydefault:
 	case 21:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:541
+//line mysql_sql.y:562
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4795,7 +5011,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:556
+//line mysql_sql.y:577
 		{
 			yyLOCAL = nil
 		}
@@ -4803,7 +5019,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:560
+//line mysql_sql.y:581
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
@@ -4811,7 +5027,7 @@ yydefault:
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:566
+//line mysql_sql.y:587
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -4819,7 +5035,7 @@ yydefault:
 	case 25:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:570
+//line mysql_sql.y:591
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -4827,7 +5043,7 @@ yydefault:
 	case 26:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:576
+//line mysql_sql.y:597
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4838,7 +5054,7 @@ yydefault:
 	case 27:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:583
+//line mysql_sql.y:604
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4849,7 +5065,7 @@ yydefault:
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:592
+//line mysql_sql.y:613
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
@@ -4857,7 +5073,7 @@ yydefault:
 	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:596
+//line mysql_sql.y:617
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
@@ -4865,7 +5081,7 @@ yydefault:
 	case 30:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:600
+//line mysql_sql.y:621
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
@@ -4873,7 +5089,7 @@ yydefault:
 	case 31:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:605
+//line mysql_sql.y:626
 		{
 			yyLOCAL = nil
 		}
@@ -4881,7 +5097,7 @@ yydefault:
 	case 32:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:609
+//line mysql_sql.y:630
 		{
 			yyLOCAL = nil
 		}
@@ -4889,7 +5105,7 @@ yydefault:
 	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:613
+//line mysql_sql.y:634
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
@@ -4897,7 +5113,7 @@ yydefault:
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:619
+//line mysql_sql.y:640
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4910,7 +5126,7 @@ yydefault:
 	case 35:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:649
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4923,7 +5139,7 @@ yydefault:
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:639
+//line mysql_sql.y:660
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
@@ -4931,7 +5147,7 @@ yydefault:
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:664
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -4939,7 +5155,7 @@ yydefault:
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:649
+//line mysql_sql.y:670
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -4947,7 +5163,7 @@ yydefault:
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:653
+//line mysql_sql.y:674
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -4955,7 +5171,7 @@ yydefault:
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:659
+//line mysql_sql.y:680
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4981,7 +5197,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:683
+//line mysql_sql.y:704
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -5003,7 +5219,7 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:702
+//line mysql_sql.y:723
 		{
 			yyLOCAL = 0
 		}
@@ -5011,7 +5227,7 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:706
+//line mysql_sql.y:727
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
@@ -5019,7 +5235,7 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:711
+//line mysql_sql.y:732
 		{
 			yyLOCAL = nil
 		}
@@ -5027,7 +5243,7 @@ yydefault:
 	case 45:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:715
+//line mysql_sql.y:736
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5037,32 +5253,32 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 46:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:744
 		{
 			yyVAL.str = ""
 		}
 	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:727
+//line mysql_sql.y:748
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 48:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:732
+//line mysql_sql.y:753
 		{
 			yyVAL.str = "\n"
 		}
 	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:736
+//line mysql_sql.y:757
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 50:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:762
 		{
 			yyLOCAL = nil
 		}
@@ -5070,7 +5286,7 @@ yydefault:
 	case 51:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:766
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5096,7 +5312,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:769
+//line mysql_sql.y:790
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
@@ -5104,7 +5320,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:773
+//line mysql_sql.y:794
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
@@ -5112,7 +5328,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:779
+//line mysql_sql.y:800
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
@@ -5122,7 +5338,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:785
+//line mysql_sql.y:806
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5144,7 +5360,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:803
+//line mysql_sql.y:824
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5165,7 +5381,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:820
+//line mysql_sql.y:841
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5186,7 +5402,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:843
+//line mysql_sql.y:864
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
@@ -5194,7 +5410,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:847
+//line mysql_sql.y:868
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
@@ -5202,7 +5418,7 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:851
+//line mysql_sql.y:872
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
@@ -5210,7 +5426,7 @@ yydefault:
 	case 62:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:856
+//line mysql_sql.y:877
 		{
 			yyLOCAL = false
 		}
@@ -5218,7 +5434,7 @@ yydefault:
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:860
+//line mysql_sql.y:881
 		{
 			yyLOCAL = true
 		}
@@ -5226,7 +5442,7 @@ yydefault:
 	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:866
+//line mysql_sql.y:887
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5240,7 +5456,7 @@ yydefault:
 	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:876
+//line mysql_sql.y:897
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5252,7 +5468,7 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:884
+//line mysql_sql.y:905
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5265,7 +5481,7 @@ yydefault:
 	case 67:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:894
+//line mysql_sql.y:915
 		{
 			yyLOCAL = false
 		}
@@ -5273,7 +5489,7 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:898
+//line mysql_sql.y:919
 		{
 			yyLOCAL = true
 		}
@@ -5281,7 +5497,7 @@ yydefault:
 	case 69:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:908
+//line mysql_sql.y:929
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5295,7 +5511,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:939
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5307,7 +5523,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:928
+//line mysql_sql.y:949
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5317,7 +5533,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:934
+//line mysql_sql.y:955
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
@@ -5327,7 +5543,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:940
+//line mysql_sql.y:961
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5338,7 +5554,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:947
+//line mysql_sql.y:968
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5350,7 +5566,7 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:955
+//line mysql_sql.y:976
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5361,7 +5577,7 @@ yydefault:
 	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:963
+//line mysql_sql.y:984
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
@@ -5369,7 +5585,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:967
+//line mysql_sql.y:988
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
@@ -5377,7 +5593,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:971
+//line mysql_sql.y:992
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
@@ -5385,7 +5601,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:975
+//line mysql_sql.y:996
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
@@ -5393,7 +5609,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:981
+//line mysql_sql.y:1002
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
@@ -5401,7 +5617,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:985
+//line mysql_sql.y:1006
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
@@ -5409,7 +5625,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:991
+//line mysql_sql.y:1012
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5420,7 +5636,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:998
+//line mysql_sql.y:1019
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5431,7 +5647,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1007
+//line mysql_sql.y:1028
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
@@ -5439,7 +5655,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1011
+//line mysql_sql.y:1032
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
@@ -5447,7 +5663,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1038
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
@@ -5455,7 +5671,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1042
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
@@ -5463,7 +5679,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1046
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
@@ -5471,7 +5687,7 @@ yydefault:
 	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1050
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
@@ -5479,7 +5695,7 @@ yydefault:
 	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1054
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
@@ -5487,7 +5703,7 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1058
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
@@ -5495,7 +5711,7 @@ yydefault:
 	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1062
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
@@ -5503,7 +5719,7 @@ yydefault:
 	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1066
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
@@ -5511,7 +5727,7 @@ yydefault:
 	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1070
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
@@ -5519,7 +5735,7 @@ yydefault:
 	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1074
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
@@ -5527,7 +5743,7 @@ yydefault:
 	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1078
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
@@ -5535,7 +5751,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1082
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
@@ -5543,7 +5759,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1086
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
@@ -5551,7 +5767,7 @@ yydefault:
 	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1090
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
@@ -5559,7 +5775,7 @@ yydefault:
 	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1094
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
@@ -5567,7 +5783,7 @@ yydefault:
 	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1098
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
@@ -5575,7 +5791,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1102
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
@@ -5583,7 +5799,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
@@ -5591,7 +5807,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1110
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
@@ -5599,7 +5815,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1114
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
@@ -5607,7 +5823,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1118
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
@@ -5615,7 +5831,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1122
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
@@ -5623,7 +5839,7 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1126
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
@@ -5631,7 +5847,7 @@ yydefault:
 	case 109:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1130
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
@@ -5639,7 +5855,7 @@ yydefault:
 	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1134
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
@@ -5647,7 +5863,7 @@ yydefault:
 	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
@@ -5655,7 +5871,7 @@ yydefault:
 	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1121
+//line mysql_sql.y:1142
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
@@ -5663,7 +5879,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1125
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
@@ -5671,7 +5887,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1129
+//line mysql_sql.y:1150
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
@@ -5679,7 +5895,7 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1133
+//line mysql_sql.y:1154
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
@@ -5687,7 +5903,7 @@ yydefault:
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1137
+//line mysql_sql.y:1158
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
@@ -5695,7 +5911,7 @@ yydefault:
 	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1141
+//line mysql_sql.y:1162
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
@@ -5703,7 +5919,7 @@ yydefault:
 	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1145
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
@@ -5711,7 +5927,7 @@ yydefault:
 	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1157
+//line mysql_sql.y:1178
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
@@ -5719,7 +5935,7 @@ yydefault:
 	case 124:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1184
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
@@ -5729,7 +5945,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1171
+//line mysql_sql.y:1192
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
@@ -5737,7 +5953,7 @@ yydefault:
 	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1175
+//line mysql_sql.y:1196
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
@@ -5745,7 +5961,7 @@ yydefault:
 	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1179
+//line mysql_sql.y:1200
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
@@ -5753,7 +5969,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1183
+//line mysql_sql.y:1204
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
@@ -5761,7 +5977,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1187
+//line mysql_sql.y:1208
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
@@ -5769,7 +5985,7 @@ yydefault:
 	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1193
+//line mysql_sql.y:1214
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
@@ -5777,7 +5993,7 @@ yydefault:
 	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1197
+//line mysql_sql.y:1218
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
@@ -5785,7 +6001,7 @@ yydefault:
 	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1201
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
@@ -5793,7 +6009,7 @@ yydefault:
 	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1207
+//line mysql_sql.y:1228
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
@@ -5801,7 +6017,7 @@ yydefault:
 	case 134:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1213
+//line mysql_sql.y:1234
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
@@ -5809,21 +6025,21 @@ yydefault:
 	case 135:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1217
+//line mysql_sql.y:1238
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
 	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1224
+//line mysql_sql.y:1245
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1230
+//line mysql_sql.y:1251
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
@@ -5831,7 +6047,7 @@ yydefault:
 	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1234
+//line mysql_sql.y:1255
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
@@ -5839,7 +6055,7 @@ yydefault:
 	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1240
+//line mysql_sql.y:1261
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5851,7 +6067,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1248
+//line mysql_sql.y:1269
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5864,7 +6080,7 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1278
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5876,7 +6092,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1286
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5888,7 +6104,7 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1294
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5915,7 +6131,7 @@ yydefault:
 	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1296
+//line mysql_sql.y:1317
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5926,7 +6142,7 @@ yydefault:
 	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1303
+//line mysql_sql.y:1324
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5937,7 +6153,7 @@ yydefault:
 	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1310
+//line mysql_sql.y:1331
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5949,7 +6165,7 @@ yydefault:
 	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1318
+//line mysql_sql.y:1339
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5960,7 +6176,7 @@ yydefault:
 	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1346
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5971,7 +6187,7 @@ yydefault:
 	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1353
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5982,7 +6198,7 @@ yydefault:
 	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1345
+//line mysql_sql.y:1366
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
@@ -5990,7 +6206,7 @@ yydefault:
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1349
+//line mysql_sql.y:1370
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
@@ -5998,33 +6214,33 @@ yydefault:
 	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1353
+//line mysql_sql.y:1374
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1359
+//line mysql_sql.y:1380
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1363
+//line mysql_sql.y:1384
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1391
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1381
+//line mysql_sql.y:1402
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
@@ -6032,7 +6248,7 @@ yydefault:
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1387
+//line mysql_sql.y:1408
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
@@ -6040,7 +6256,7 @@ yydefault:
 	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1413
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6048,7 +6264,7 @@ yydefault:
 	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1396
+//line mysql_sql.y:1417
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
@@ -6056,7 +6272,7 @@ yydefault:
 	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1400
+//line mysql_sql.y:1421
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
@@ -6064,7 +6280,7 @@ yydefault:
 	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1404
+//line mysql_sql.y:1425
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
@@ -6072,7 +6288,7 @@ yydefault:
 	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1408
+//line mysql_sql.y:1429
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
@@ -6080,7 +6296,7 @@ yydefault:
 	case 170:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1412
+//line mysql_sql.y:1433
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6088,7 +6304,7 @@ yydefault:
 	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1416
+//line mysql_sql.y:1437
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6096,7 +6312,7 @@ yydefault:
 	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1420
+//line mysql_sql.y:1441
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6104,7 +6320,7 @@ yydefault:
 	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1447
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6112,7 +6328,7 @@ yydefault:
 	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1451
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6120,7 +6336,7 @@ yydefault:
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1455
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6128,7 +6344,7 @@ yydefault:
 	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1459
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
@@ -6137,7 +6353,7 @@ yydefault:
 	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1443
+//line mysql_sql.y:1464
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
@@ -6146,7 +6362,7 @@ yydefault:
 	case 178:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1448
+//line mysql_sql.y:1469
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6154,7 +6370,7 @@ yydefault:
 	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1475
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
@@ -6162,7 +6378,7 @@ yydefault:
 	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1458
+//line mysql_sql.y:1479
 		{
 			yyLOCAL = &tree.Use{}
 		}
@@ -6170,7 +6386,7 @@ yydefault:
 	case 181:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1464
+//line mysql_sql.y:1485
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6184,7 +6400,7 @@ yydefault:
 	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1477
+//line mysql_sql.y:1498
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -6192,7 +6408,7 @@ yydefault:
 	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1481
+//line mysql_sql.y:1502
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -6200,7 +6416,7 @@ yydefault:
 	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1487
+//line mysql_sql.y:1508
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
@@ -6208,7 +6424,7 @@ yydefault:
 	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -6216,7 +6432,7 @@ yydefault:
 	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1523
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
@@ -6225,7 +6441,7 @@ yydefault:
 	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1528
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
@@ -6234,7 +6450,7 @@ yydefault:
 	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1512
+//line mysql_sql.y:1533
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
@@ -6242,23 +6458,34 @@ yydefault:
 	case 192:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1537
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
 	case 193:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1541
+		{
+			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
+			optionElem := tree.MakeOptionElem("format", yyDollar[4].str)
+			explainStmt.Options = tree.MakeOptions(optionElem)
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1520
+//line mysql_sql.y:1548
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1552
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6268,10 +6495,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1533
+//line mysql_sql.y:1561
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6280,10 +6507,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1569
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6294,76 +6521,76 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1551
+//line mysql_sql.y:1579
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1564
+//line mysql_sql.y:1592
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1568
+//line mysql_sql.y:1596
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1574
+//line mysql_sql.y:1602
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1580
+//line mysql_sql.y:1608
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 205:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1585
+//line mysql_sql.y:1613
 		{
 			yyVAL.str = "true"
 		}
-	case 206:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1586
+//line mysql_sql.y:1614
 		{
 			yyVAL.str = "false"
 		}
-	case 207:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1587
+//line mysql_sql.y:1615
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 208:
+	case 209:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1592
+//line mysql_sql.y:1620
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 211:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1602
+//line mysql_sql.y:1630
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6375,10 +6602,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 212:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1641
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6391,118 +6618,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1626
+//line mysql_sql.y:1654
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1630
+//line mysql_sql.y:1658
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1635
+//line mysql_sql.y:1663
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1639
+//line mysql_sql.y:1667
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1645
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1649
+//line mysql_sql.y:1677
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1655
+//line mysql_sql.y:1683
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1659
+//line mysql_sql.y:1687
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1663
+//line mysql_sql.y:1691
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 222:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1667
+//line mysql_sql.y:1695
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1671
+//line mysql_sql.y:1699
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1675
+//line mysql_sql.y:1703
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1681
+//line mysql_sql.y:1709
 		{
 			yyVAL.item = nil
 		}
-	case 225:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1686
+//line mysql_sql.y:1714
 		{
 			yyVAL.item = nil
 		}
-	case 237:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1707
+//line mysql_sql.y:1735
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6510,10 +6737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1721
+//line mysql_sql.y:1749
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6522,10 +6749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1759
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6534,58 +6761,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1740
+//line mysql_sql.y:1768
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1744
+//line mysql_sql.y:1772
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1748
+//line mysql_sql.y:1776
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 247:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1754
+//line mysql_sql.y:1782
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1760
+//line mysql_sql.y:1788
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1766
+//line mysql_sql.y:1794
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 250:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1772
+//line mysql_sql.y:1800
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6596,10 +6823,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 251:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1782
+//line mysql_sql.y:1810
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6610,18 +6837,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1794
+//line mysql_sql.y:1822
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 253:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1828
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6634,10 +6861,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 254:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1840
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6650,118 +6877,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 255:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1825
+//line mysql_sql.y:1853
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1829
+//line mysql_sql.y:1857
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 257:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1834
+//line mysql_sql.y:1862
 		{
 			yyVAL.str = ""
 		}
-	case 257:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1838
+//line mysql_sql.y:1866
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 258:
+	case 259:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1844
+//line mysql_sql.y:1872
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 264:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1857
+//line mysql_sql.y:1885
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1861
+//line mysql_sql.y:1889
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:1895
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1871
+//line mysql_sql.y:1899
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1877
+//line mysql_sql.y:1905
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1881
+//line mysql_sql.y:1909
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1890
+//line mysql_sql.y:1918
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1894
+//line mysql_sql.y:1922
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 273:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1898
+//line mysql_sql.y:1926
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1914
+//line mysql_sql.y:1942
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6769,10 +6996,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:1951
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6780,10 +7007,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 282:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:1960
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6792,26 +7019,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1942
+//line mysql_sql.y:1970
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1948
+//line mysql_sql.y:1976
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 285:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:1982
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6821,10 +7048,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1969
+//line mysql_sql.y:1997
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6832,10 +7059,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1978
+//line mysql_sql.y:2006
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6843,20 +7070,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1985
+//line mysql_sql.y:2013
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 289:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1991
+//line mysql_sql.y:2019
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6865,10 +7092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1999
+//line mysql_sql.y:2027
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6876,10 +7103,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2006
+//line mysql_sql.y:2034
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6887,10 +7114,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2013
+//line mysql_sql.y:2041
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6909,34 +7136,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 293:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2032
+//line mysql_sql.y:2060
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2036
+//line mysql_sql.y:2064
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2040
+//line mysql_sql.y:2068
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2046
+//line mysql_sql.y:2074
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6944,155 +7171,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2055
+//line mysql_sql.y:2083
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2059
+//line mysql_sql.y:2087
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2065
+//line mysql_sql.y:2093
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2069
+//line mysql_sql.y:2097
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 300:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2075
+//line mysql_sql.y:2103
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2079
+//line mysql_sql.y:2107
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2085
+//line mysql_sql.y:2113
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2090
+//line mysql_sql.y:2118
 		{
 		}
-	case 305:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2094
+//line mysql_sql.y:2122
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2101
+//line mysql_sql.y:2129
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2133
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2112
+//line mysql_sql.y:2140
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2117
+//line mysql_sql.y:2145
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2121
+//line mysql_sql.y:2149
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2127
+//line mysql_sql.y:2155
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2131
+//line mysql_sql.y:2159
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2137
+//line mysql_sql.y:2165
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2141
+//line mysql_sql.y:2169
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2146
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 319:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2150
+//line mysql_sql.y:2178
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7105,10 +7332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2163
+//line mysql_sql.y:2191
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7116,10 +7343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2170
+//line mysql_sql.y:2198
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7127,10 +7354,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 322:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2177
+//line mysql_sql.y:2205
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7149,10 +7376,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 323:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2195
+//line mysql_sql.y:2223
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7171,38 +7398,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2214
+//line mysql_sql.y:2242
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2220
+//line mysql_sql.y:2248
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2227
+//line mysql_sql.y:2255
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2231
+//line mysql_sql.y:2259
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7215,115 +7442,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2244
+//line mysql_sql.y:2272
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2248
+//line mysql_sql.y:2276
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2253
+//line mysql_sql.y:2281
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 331:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2257
+//line mysql_sql.y:2285
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2292
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2269
+//line mysql_sql.y:2297
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2276
+//line mysql_sql.y:2304
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 336:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2282
+//line mysql_sql.y:2310
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2286
+//line mysql_sql.y:2314
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 338:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2290
+//line mysql_sql.y:2318
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 339:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2294
+//line mysql_sql.y:2322
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2298
+//line mysql_sql.y:2326
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2302
+//line mysql_sql.y:2330
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2308
+//line mysql_sql.y:2336
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7331,10 +7558,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2315
+//line mysql_sql.y:2343
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7342,26 +7569,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2324
+//line mysql_sql.y:2352
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2328
+//line mysql_sql.y:2356
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2334
+//line mysql_sql.y:2362
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7369,162 +7596,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2342
+//line mysql_sql.y:2370
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2346
+//line mysql_sql.y:2374
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2351
+//line mysql_sql.y:2379
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2355
+//line mysql_sql.y:2383
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2361
+//line mysql_sql.y:2389
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2365
+//line mysql_sql.y:2393
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2369
+//line mysql_sql.y:2397
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2374
+//line mysql_sql.y:2402
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2378
+//line mysql_sql.y:2406
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2384
+//line mysql_sql.y:2412
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2390
+//line mysql_sql.y:2418
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2394
+//line mysql_sql.y:2422
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2400
+//line mysql_sql.y:2428
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2405
+//line mysql_sql.y:2433
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2409
+//line mysql_sql.y:2437
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2413
+//line mysql_sql.y:2441
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2420
+//line mysql_sql.y:2448
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2424
+//line mysql_sql.y:2452
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2430
+//line mysql_sql.y:2458
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2434
+//line mysql_sql.y:2462
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7535,10 +7762,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2444
+//line mysql_sql.y:2472
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7549,10 +7776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2454
+//line mysql_sql.y:2482
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7563,10 +7790,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2464
+//line mysql_sql.y:2492
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7577,10 +7804,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2476
+//line mysql_sql.y:2504
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7589,10 +7816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2484
+//line mysql_sql.y:2512
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7601,157 +7828,330 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2492
+//line mysql_sql.y:2520
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.UNION,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2528
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2536
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2544
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 376:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2552
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 377:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2560
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2568
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 379:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line mysql_sql.y:2578
+		{
+			yyLOCAL = &tree.SelectClause{
+				Hints:    yyDollar[2].hintsUnion(),
+				Distinct: yyDollar[3].boolValUnion(),
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				From:     yyDollar[5].fromUnion(),
+				Where:    yyDollar[6].whereUnion(),
+				GroupBy:  yyDollar[7].groupByUnion(),
+				Having:   yyDollar[8].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 380:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Hints
+//line mysql_sql.y:2591
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Hints
+//line mysql_sql.y:2595
+		{
+			yyLOCAL = tree.ParseHints(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 382:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2600
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2604
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2608
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 387:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Where
+//line mysql_sql.y:2617
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 388:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Where
+//line mysql_sql.y:2621
+		{
+			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 389:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:2626
 		{
-			yyLOCAL = &tree.UnionTypeRecord{
-				Type:     tree.UNION,
-				All:      false,
-				Distinct: true,
-			}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2502
+	case 390:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:2630
 		{
-			yyLOCAL = &tree.SelectClause{
-				Distinct: yyDollar[2].boolValUnion(),
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
+			if yyDollar[4].boolValUnion() {
+				yyLOCAL = tree.GroupBy{tree.NewGroupingSets(tree.Rollup, yyDollar[3].exprsUnion())}
+			} else {
+				yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 391:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2514
+//line mysql_sql.y:2639
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2518
+//line mysql_sql.y:2643
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL bool
-//line mysql_sql.y:2522
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2649
 		{
-			yyLOCAL = true
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL *tree.Where
-//line mysql_sql.y:2531
+	case 394:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2653
 		{
-			yyLOCAL = nil
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *tree.Where
-//line mysql_sql.y:2535
+	case 396:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2660
 		{
-			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = tree.NewGroupingSets(tree.Rollup, yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2540
+	case 397:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2664
 		{
-			yyLOCAL = nil
+			yyLOCAL = tree.NewGroupingSets(tree.Cube, yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 398:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2668
+		{
+			yyLOCAL = tree.NewGroupingSets(tree.GroupingSetsList, yyDollar[4].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 399:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2674
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2544
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:2678
 		{
-			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 402:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:2685
+		{
+			yyLOCAL = tree.NewTuple(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2549
+//line mysql_sql.y:2690
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2553
+//line mysql_sql.y:2694
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2559
+//line mysql_sql.y:2700
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2563
+//line mysql_sql.y:2704
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2569
+//line mysql_sql.y:2710
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2573
+//line mysql_sql.y:2714
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2577
+//line mysql_sql.y:2718
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2581
+//line mysql_sql.y:2722
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 411:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2587
+//line mysql_sql.y:2728
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7760,44 +8160,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2595
+//line mysql_sql.y:2736
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2601
+//line mysql_sql.y:2742
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2609
+//line mysql_sql.y:2750
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2613
+//line mysql_sql.y:2754
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 418:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2623
+//line mysql_sql.y:2764
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7807,10 +8207,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2773
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7820,10 +8220,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2783
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7833,10 +8233,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2792
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7845,15 +8245,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2661
+//line mysql_sql.y:2802
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 402:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2665
+//line mysql_sql.y:2806
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7861,130 +8261,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 403:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2816
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 404:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2820
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 405:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2683
+//line mysql_sql.y:2824
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 406:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2687
+//line mysql_sql.y:2828
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 407:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2693
+//line mysql_sql.y:2834
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2697
+//line mysql_sql.y:2838
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2844
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 410:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2709
+//line mysql_sql.y:2850
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 411:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2713
+//line mysql_sql.y:2854
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 412:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2717
+//line mysql_sql.y:2858
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 413:
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2723
+//line mysql_sql.y:2864
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2727
+//line mysql_sql.y:2868
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2733
+//line mysql_sql.y:2874
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2737
+//line mysql_sql.y:2878
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2743
+//line mysql_sql.y:2884
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2747
+//line mysql_sql.y:2888
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2753
+//line mysql_sql.y:2894
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2757
+//line mysql_sql.y:2898
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7995,28 +8395,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2770
+//line mysql_sql.y:2911
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2775
+//line mysql_sql.y:2916
 		{
 		}
-	case 423:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2776
+//line mysql_sql.y:2917
 		{
 		}
-	case 424:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2780
+//line mysql_sql.y:2921
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -8026,46 +8426,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2792
+//line mysql_sql.y:2933
 		{
 			yyVAL.str = ""
 		}
-	case 426:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2796
+//line mysql_sql.y:2937
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 427:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2800
+//line mysql_sql.y:2941
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 430:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2809
+//line mysql_sql.y:2950
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2813
+//line mysql_sql.y:2954
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:2958
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 447:
+	case 468:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2847
+//line mysql_sql.y:2988
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -8076,10 +8476,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 469:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2857
+//line mysql_sql.y:2998
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -8090,15 +8490,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2868
+//line mysql_sql.y:3009
 		{
 		}
-	case 451:
+	case 472:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2873
+//line mysql_sql.y:3014
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8108,189 +8508,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2883
+//line mysql_sql.y:3024
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2887
+//line mysql_sql.y:3028
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2893
+//line mysql_sql.y:3034
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2897
+//line mysql_sql.y:3038
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2903
+//line mysql_sql.y:3044
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2907
+//line mysql_sql.y:3048
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2911
+//line mysql_sql.y:3052
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2915
+//line mysql_sql.y:3056
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2921
+//line mysql_sql.y:3062
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2928
+//line mysql_sql.y:3069
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2933
+//line mysql_sql.y:3074
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2938
+//line mysql_sql.y:3079
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2943
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2949
+//line mysql_sql.y:3090
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2953
+//line mysql_sql.y:3094
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2957
+//line mysql_sql.y:3098
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2963
+//line mysql_sql.y:3104
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2967
+//line mysql_sql.y:3108
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2971
+//line mysql_sql.y:3112
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2975
+//line mysql_sql.y:3116
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2981
+//line mysql_sql.y:3122
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2985
+//line mysql_sql.y:3126
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2991
+//line mysql_sql.y:3132
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8302,42 +8702,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3004
+//line mysql_sql.y:3145
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3008
+//line mysql_sql.y:3149
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3012
+//line mysql_sql.y:3153
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3017
+//line mysql_sql.y:3158
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3021
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8345,20 +8745,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3028
+//line mysql_sql.y:3169
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3034
+//line mysql_sql.y:3175
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8367,10 +8767,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3042
+//line mysql_sql.y:3183
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8378,20 +8778,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3049
+//line mysql_sql.y:3190
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3061
+//line mysql_sql.y:3202
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8399,82 +8799,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3070
+//line mysql_sql.y:3211
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3074
+//line mysql_sql.y:3215
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3080
+//line mysql_sql.y:3221
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3084
+//line mysql_sql.y:3225
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3088
+//line mysql_sql.y:3229
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3097
+//line mysql_sql.y:3238
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3101
+//line mysql_sql.y:3242
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3105
+//line mysql_sql.y:3246
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3109
+//line mysql_sql.y:3250
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 520:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3115
+//line mysql_sql.y:3256
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8493,18 +8893,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3134
+//line mysql_sql.y:3275
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3138
+//line mysql_sql.y:3279
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8525,123 +8925,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3160
+//line mysql_sql.y:3301
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3164
+//line mysql_sql.y:3305
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3168
+//line mysql_sql.y:3309
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3172
+//line mysql_sql.y:3313
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3176
+//line mysql_sql.y:3317
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3182
+//line mysql_sql.y:3323
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3186
+//line mysql_sql.y:3327
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3192
+//line mysql_sql.y:3333
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3197
+//line mysql_sql.y:3338
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3202
+//line mysql_sql.y:3343
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3206
+//line mysql_sql.y:3347
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3210
+//line mysql_sql.y:3351
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3214
+//line mysql_sql.y:3355
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3218
+//line mysql_sql.y:3359
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3224
+//line mysql_sql.y:3365
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8650,98 +9050,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3238
+//line mysql_sql.y:3379
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3242
+//line mysql_sql.y:3383
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3247
+//line mysql_sql.y:3388
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3251
+//line mysql_sql.y:3392
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3257
+//line mysql_sql.y:3398
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3261
+//line mysql_sql.y:3402
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3267
+//line mysql_sql.y:3408
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3271
+//line mysql_sql.y:3412
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3275
+//line mysql_sql.y:3416
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3280
+//line mysql_sql.y:3421
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3284
+//line mysql_sql.y:3425
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 551:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3290
+//line mysql_sql.y:3431
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8753,34 +9153,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3302
+//line mysql_sql.y:3443
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3306
+//line mysql_sql.y:3447
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3311
+//line mysql_sql.y:3452
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 555:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3315
+//line mysql_sql.y:3456
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8790,18 +9190,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3325
+//line mysql_sql.y:3466
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3329
+//line mysql_sql.y:3470
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8810,42 +9210,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3338
+//line mysql_sql.y:3479
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3342
+//line mysql_sql.y:3483
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3348
+//line mysql_sql.y:3489
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3352
+//line mysql_sql.y:3493
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3358
+//line mysql_sql.y:3499
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8855,10 +9255,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 563:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3367
+//line mysql_sql.y:3508
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8868,42 +9268,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3377
+//line mysql_sql.y:3518
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3381
+//line mysql_sql.y:3522
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3387
+//line mysql_sql.y:3528
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3391
+//line mysql_sql.y:3532
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3397
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8911,10 +9311,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3404
+//line mysql_sql.y:3545
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8922,50 +9322,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3413
+//line mysql_sql.y:3554
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3417
+//line mysql_sql.y:3558
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3422
+//line mysql_sql.y:3563
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 573:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3426
+//line mysql_sql.y:3567
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3431
+//line mysql_sql.y:3572
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3435
+//line mysql_sql.y:3576
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8975,18 +9375,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3445
+//line mysql_sql.y:3586
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3449
+//line mysql_sql.y:3590
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8996,10 +9396,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3460
+//line mysql_sql.y:3601
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9008,10 +9408,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 579:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3468
+//line mysql_sql.y:3609
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9020,10 +9420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3476
+//line mysql_sql.y:3617
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9032,10 +9432,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 581:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3484
+//line mysql_sql.y:3625
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9044,10 +9444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 583:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3495
+//line mysql_sql.y:3636
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -9058,10 +9458,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 584:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3505
+//line mysql_sql.y:3646
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -9071,478 +9471,478 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3515
+//line mysql_sql.y:3656
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3519
+//line mysql_sql.y:3660
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3524
+//line mysql_sql.y:3665
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3528
+//line mysql_sql.y:3669
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3533
+//line mysql_sql.y:3674
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3537
+//line mysql_sql.y:3678
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3543
+//line mysql_sql.y:3684
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3547
+//line mysql_sql.y:3688
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3551
+//line mysql_sql.y:3692
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3557
+//line mysql_sql.y:3698
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3561
+//line mysql_sql.y:3702
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3565
+//line mysql_sql.y:3706
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3569
+//line mysql_sql.y:3710
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3573
+//line mysql_sql.y:3714
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3577
+//line mysql_sql.y:3718
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3581
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3585
+//line mysql_sql.y:3726
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3589
+//line mysql_sql.y:3730
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3593
+//line mysql_sql.y:3734
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3597
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3601
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3605
+//line mysql_sql.y:3746
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3609
+//line mysql_sql.y:3750
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3613
+//line mysql_sql.y:3754
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3617
+//line mysql_sql.y:3758
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3621
+//line mysql_sql.y:3762
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3625
+//line mysql_sql.y:3766
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3629
+//line mysql_sql.y:3770
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3633
+//line mysql_sql.y:3774
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3637
+//line mysql_sql.y:3778
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3641
+//line mysql_sql.y:3782
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3645
+//line mysql_sql.y:3786
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3649
+//line mysql_sql.y:3790
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3653
+//line mysql_sql.y:3794
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3657
+//line mysql_sql.y:3798
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 620:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3661
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 621:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3665
+//line mysql_sql.y:3806
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3669
+//line mysql_sql.y:3810
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3676
+//line mysql_sql.y:3817
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3680
+//line mysql_sql.y:3821
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3686
+//line mysql_sql.y:3827
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3691
+//line mysql_sql.y:3832
 		{
 			yyVAL.str = ""
 		}
-	case 606:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3695
+//line mysql_sql.y:3836
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 607:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3699
+//line mysql_sql.y:3840
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 608:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3705
+//line mysql_sql.y:3846
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3709
+//line mysql_sql.y:3850
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3713
+//line mysql_sql.y:3854
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3717
+//line mysql_sql.y:3858
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3721
+//line mysql_sql.y:3862
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3725
+//line mysql_sql.y:3866
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3741
+//line mysql_sql.y:3882
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3745
+//line mysql_sql.y:3886
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3754
+//line mysql_sql.y:3895
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3759
+//line mysql_sql.y:3900
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 645:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3765
+//line mysql_sql.y:3906
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3772
+//line mysql_sql.y:3913
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3776
+//line mysql_sql.y:3917
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3782
+//line mysql_sql.y:3923
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3786
+//line mysql_sql.y:3927
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3792
+//line mysql_sql.y:3933
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9553,18 +9953,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3802
+//line mysql_sql.y:3943
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 653:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3808
+//line mysql_sql.y:3949
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9574,10 +9974,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 654:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3817
+//line mysql_sql.y:3958
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9587,10 +9987,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 655:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3826
+//line mysql_sql.y:3967
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9614,10 +10014,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 656:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3849
+//line mysql_sql.y:3990
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9627,10 +10027,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 657:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3858
+//line mysql_sql.y:3999
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9641,10 +10041,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3868
+//line mysql_sql.y:4009
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9652,298 +10052,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3876
+//line mysql_sql.y:4017
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3882
+//line mysql_sql.y:4023
 		{
 			yyVAL.str = ""
 		}
-	case 641:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3886
+//line mysql_sql.y:4027
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 644:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3896
+//line mysql_sql.y:4037
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3902
+//line mysql_sql.y:4043
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3908
+//line mysql_sql.y:4049
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3922
+//line mysql_sql.y:4063
 		{
 			yyVAL.str = ""
 		}
-	case 654:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3929
+//line mysql_sql.y:4070
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3935
+//line mysql_sql.y:4076
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3939
+//line mysql_sql.y:4080
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3943
+//line mysql_sql.y:4084
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3954
+//line mysql_sql.y:4095
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3958
+//line mysql_sql.y:4099
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 684:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3962
+//line mysql_sql.y:4103
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 685:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3967
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3971
+//line mysql_sql.y:4112
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3977
+//line mysql_sql.y:4118
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3981
+//line mysql_sql.y:4122
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3987
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3991
+//line mysql_sql.y:4132
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3995
+//line mysql_sql.y:4136
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3999
+//line mysql_sql.y:4140
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4003
+//line mysql_sql.y:4144
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4007
+//line mysql_sql.y:4148
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4011
+//line mysql_sql.y:4152
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4015
+//line mysql_sql.y:4156
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4019
+//line mysql_sql.y:4160
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4023
+//line mysql_sql.y:4164
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4027
+//line mysql_sql.y:4168
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4031
+//line mysql_sql.y:4172
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 701:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4035
+//line mysql_sql.y:4176
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4045
+//line mysql_sql.y:4186
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4049
+//line mysql_sql.y:4190
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 704:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4054
+//line mysql_sql.y:4195
 		{
 			yyVAL.str = ""
 		}
-	case 684:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4058
+//line mysql_sql.y:4199
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 685:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4064
+//line mysql_sql.y:4205
 		{
 			yyVAL.str = ""
 		}
-	case 686:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4068
+//line mysql_sql.y:4209
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 687:
+	case 708:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4074
+//line mysql_sql.y:4215
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9954,10 +10354,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 709:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4085
+//line mysql_sql.y:4226
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9965,10 +10365,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4095
+//line mysql_sql.y:4236
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9976,10 +10376,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4102
+//line mysql_sql.y:4243
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9987,10 +10387,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4109
+//line mysql_sql.y:4250
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9998,10 +10398,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4116
+//line mysql_sql.y:4257
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -10009,339 +10409,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4125
+//line mysql_sql.y:4266
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4131
+//line mysql_sql.y:4272
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4137
+//line mysql_sql.y:4278
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4141
+//line mysql_sql.y:4282
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4145
+//line mysql_sql.y:4286
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4149
+//line mysql_sql.y:4290
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4153
+//line mysql_sql.y:4294
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 722:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4158
+//line mysql_sql.y:4299
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4165
+//line mysql_sql.y:4306
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4169
+//line mysql_sql.y:4310
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4173
+//line mysql_sql.y:4314
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 727:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4178
+//line mysql_sql.y:4319
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4182
+//line mysql_sql.y:4323
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4187
+//line mysql_sql.y:4328
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 730:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4191
+//line mysql_sql.y:4332
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4207
+//line mysql_sql.y:4348
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4213
+//line mysql_sql.y:4354
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4217
+//line mysql_sql.y:4358
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4221
+//line mysql_sql.y:4362
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4225
+//line mysql_sql.y:4366
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4229
+//line mysql_sql.y:4370
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4233
+//line mysql_sql.y:4374
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4237
+//line mysql_sql.y:4378
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4241
+//line mysql_sql.y:4382
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4245
+//line mysql_sql.y:4386
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4390
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4398
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4402
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4267
+//line mysql_sql.y:4408
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4271
+//line mysql_sql.y:4412
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4275
+//line mysql_sql.y:4416
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4279
+//line mysql_sql.y:4420
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 755:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4283
+//line mysql_sql.y:4424
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4287
+//line mysql_sql.y:4428
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4291
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4295
+//line mysql_sql.y:4436
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4299
+//line mysql_sql.y:4440
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 760:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4444
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4448
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4311
+//line mysql_sql.y:4452
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 763:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4316
+//line mysql_sql.y:4457
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10350,26 +10750,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 764:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4324
+//line mysql_sql.y:4465
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 765:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4469
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 766:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4332
+//line mysql_sql.y:4473
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -10379,90 +10779,212 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 767:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4482
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4341
+//line mysql_sql.y:4487
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4491
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4495
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4501
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4505
+		{
+			yyLOCAL = yyDollar[2].windowSpecUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4511
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[2].exprsUnion(), yyDollar[3].orderByUnion(), yyDollar[4].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4516
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4520
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4525
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4529
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[2].frameBoundUnion(), tree.NewFrameBound(tree.CurrentRow, nil))
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4533
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[3].frameBoundUnion(), yyDollar[5].frameBoundUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4539
+		{
+			yyLOCAL = tree.FrameRows
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4543
+		{
+			yyLOCAL = tree.FrameRange
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4549
+		{
+			yyLOCAL = tree.NewFrameBound(tree.UnboundedPreceding, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4553
 		{
-			yyLOCAL = yyDollar[1].funcExprUnion()
+			yyLOCAL = tree.NewFrameBound(tree.CurrentRow, nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL tree.Expr
-//line mysql_sql.y:4345
+	case 783:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4557
 		{
-			yyLOCAL = yyDollar[1].funcExprUnion()
+			yyLOCAL = tree.NewFrameBound(tree.Preceding, yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL tree.Expr
-//line mysql_sql.y:4349
+	case 785:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4564
 		{
-			yyLOCAL = yyDollar[1].funcExprUnion()
+			yyLOCAL = tree.NewFrameBound(tree.UnboundedFollowing, nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL tree.Expr
-//line mysql_sql.y:4353
+	case 786:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4568
 		{
-			yyLOCAL = yyDollar[1].funcExprUnion()
+			yyLOCAL = tree.NewFrameBound(tree.Following, yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 787:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4358
+//line mysql_sql.y:4573
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4362
+//line mysql_sql.y:4577
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 789:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4367
+//line mysql_sql.y:4582
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4371
+//line mysql_sql.y:4586
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4377
+//line mysql_sql.y:4592
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4381
+//line mysql_sql.y:4596
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 793:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4387
+//line mysql_sql.y:4602
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10470,10 +10992,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4397
+//line mysql_sql.y:4612
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10487,10 +11009,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4410
+//line mysql_sql.y:4625
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10504,10 +11026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4423
+//line mysql_sql.y:4638
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10520,10 +11042,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4435
+//line mysql_sql.y:4650
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10538,10 +11060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4449
+//line mysql_sql.y:4664
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10557,10 +11079,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4464
+//line mysql_sql.y:4679
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10576,10 +11098,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 801:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4479
+//line mysql_sql.y:4694
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10593,10 +11115,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4492
+//line mysql_sql.y:4707
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10611,15 +11133,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 803:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4507
+//line mysql_sql.y:4722
 		{
 		}
-	case 769:
+	case 806:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4513
+//line mysql_sql.y:4728
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10629,10 +11151,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 807:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4737
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10641,10 +11163,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 808:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4745
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10653,10 +11175,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 809:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4753
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10666,10 +11188,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 810:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4547
+//line mysql_sql.y:4762
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10679,10 +11201,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 811:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4556
+//line mysql_sql.y:4771
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10692,10 +11214,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 812:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4565
+//line mysql_sql.y:4780
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10705,10 +11227,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 813:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4789
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10718,10 +11240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 814:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4798
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10731,10 +11253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 815:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4807
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10744,10 +11266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 816:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4816
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10757,10 +11279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 817:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4825
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10770,10 +11292,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 818:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4619
+//line mysql_sql.y:4834
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10783,10 +11305,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 819:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4628
+//line mysql_sql.y:4843
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10796,10 +11318,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 820:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4852
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10809,10 +11331,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4653
+//line mysql_sql.y:4868
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10821,10 +11343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 825:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4661
+//line mysql_sql.y:4876
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10833,10 +11355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 826:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4669
+//line mysql_sql.y:4884
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10845,10 +11367,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 827:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4677
+//line mysql_sql.y:4892
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10857,10 +11379,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 828:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4685
+//line mysql_sql.y:4900
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -10870,16 +11392,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4700
+//line mysql_sql.y:4915
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 823:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4736
+//line mysql_sql.y:4951
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10892,10 +11414,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4748
+//line mysql_sql.y:4963
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10908,10 +11430,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 862:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4762
+//line mysql_sql.y:4977
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10920,10 +11442,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4770
+//line mysql_sql.y:4985
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10931,10 +11453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 864:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4777
+//line mysql_sql.y:4992
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10947,10 +11469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 865:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4789
+//line mysql_sql.y:5004
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10959,10 +11481,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 866:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4797
+//line mysql_sql.y:5012
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10974,10 +11496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4808
+//line mysql_sql.y:5023
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10987,10 +11509,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4817
+//line mysql_sql.y:5032
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -11000,10 +11522,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4826
+//line mysql_sql.y:5041
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -11013,10 +11535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 870:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4835
+//line mysql_sql.y:5050
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -11025,10 +11547,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 871:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4843
+//line mysql_sql.y:5058
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -11039,10 +11561,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 872:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4853
+//line mysql_sql.y:5068
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -11051,10 +11573,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4861
+//line mysql_sql.y:5076
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -11063,52 +11585,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4870
+//line mysql_sql.y:5085
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4874
+//line mysql_sql.y:5089
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4880
+//line mysql_sql.y:5095
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4884
+//line mysql_sql.y:5099
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4897
+//line mysql_sql.y:5112
 		{
 		}
-	case 848:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4899
+//line mysql_sql.y:5114
 		{
 		}
-	case 881:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4939
+//line mysql_sql.y:5154
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
@@ -11118,10 +11640,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4948
+//line mysql_sql.y:5163
 		{
 			name := tree.SetUnresolvedName("interval")
 			ival := util.GetUint64(yyDollar[2].item)
@@ -11134,314 +11656,314 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4961
+//line mysql_sql.y:5176
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4965
+//line mysql_sql.y:5180
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4969
+//line mysql_sql.y:5184
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4975
+//line mysql_sql.y:5190
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 924:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4980
+//line mysql_sql.y:5195
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4984
+//line mysql_sql.y:5199
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4990
+//line mysql_sql.y:5205
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4994
+//line mysql_sql.y:5209
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5001
+//line mysql_sql.y:5216
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5005
+//line mysql_sql.y:5220
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5009
+//line mysql_sql.y:5224
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5013
+//line mysql_sql.y:5228
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5017
+//line mysql_sql.y:5232
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5023
+//line mysql_sql.y:5238
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 934:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5027
+//line mysql_sql.y:5242
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 935:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5031
+//line mysql_sql.y:5246
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 937:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5038
+//line mysql_sql.y:5253
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 938:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5042
+//line mysql_sql.y:5257
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 939:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5046
+//line mysql_sql.y:5261
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 940:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5050
+//line mysql_sql.y:5265
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5054
+//line mysql_sql.y:5269
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 942:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5058
+//line mysql_sql.y:5273
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 943:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5062
+//line mysql_sql.y:5277
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 944:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5066
+//line mysql_sql.y:5281
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5072
+//line mysql_sql.y:5287
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 947:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5076
+//line mysql_sql.y:5291
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5082
+//line mysql_sql.y:5297
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5086
+//line mysql_sql.y:5301
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5093
+//line mysql_sql.y:5308
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5097
+//line mysql_sql.y:5312
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5101
+//line mysql_sql.y:5316
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5105
+//line mysql_sql.y:5320
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5109
+//line mysql_sql.y:5324
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5113
+//line mysql_sql.y:5328
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5120
+//line mysql_sql.y:5335
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5124
+//line mysql_sql.y:5339
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5128
+//line mysql_sql.y:5343
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5132
+//line mysql_sql.y:5347
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5138
+//line mysql_sql.y:5353
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -11455,96 +11977,106 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5153
+//line mysql_sql.y:5368
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5157
+//line mysql_sql.y:5372
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5162
+//line mysql_sql.y:5377
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5167
+//line mysql_sql.y:5382
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5171
+//line mysql_sql.y:5386
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5175
+//line mysql_sql.y:5390
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5179
+//line mysql_sql.y:5394
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 968:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5399
+		{
+			// The scanner names the markers :v1, :v2 and so on
+			offset, _ := strconv.Atoi(yyDollar[1].str[2:])
+			yyLOCAL = tree.NewParamExpr(offset)
+		}
+		yyVAL.union = yyLOCAL
+	case 969:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5189
+//line mysql_sql.y:5409
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5200
+//line mysql_sql.y:5420
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5205
+//line mysql_sql.y:5425
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5211
+//line mysql_sql.y:5431
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11557,10 +12089,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5223
+//line mysql_sql.y:5443
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11573,10 +12105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5235
+//line mysql_sql.y:5455
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11589,10 +12121,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5247
+//line mysql_sql.y:5467
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11606,10 +12138,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5260
+//line mysql_sql.y:5480
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11623,10 +12155,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5273
+//line mysql_sql.y:5493
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11640,10 +12172,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5286
+//line mysql_sql.y:5506
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11657,10 +12189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5299
+//line mysql_sql.y:5519
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11674,10 +12206,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5312
+//line mysql_sql.y:5532
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11691,10 +12223,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5325
+//line mysql_sql.y:5545
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11708,10 +12240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5338
+//line mysql_sql.y:5558
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11725,10 +12257,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5351
+//line mysql_sql.y:5571
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11742,10 +12274,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5364
+//line mysql_sql.y:5584
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11759,10 +12291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5377
+//line mysql_sql.y:5597
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11776,10 +12308,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5392
+//line mysql_sql.y:5612
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11803,10 +12335,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5415
+//line mysql_sql.y:5635
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11843,10 +12375,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5452
+//line mysql_sql.y:5672
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11883,10 +12415,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 992:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5500
+//line mysql_sql.y:5720
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11902,10 +12434,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5517
+//line mysql_sql.y:5737
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11918,10 +12450,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5529
+//line mysql_sql.y:5749
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11937,10 +12469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 995:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5544
+//line mysql_sql.y:5764
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -11961,10 +12493,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 996:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5564
+//line mysql_sql.y:5784
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11980,10 +12512,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 997:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5579
+//line mysql_sql.y:5799
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11998,10 +12530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5595
+//line mysql_sql.y:5815
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12015,10 +12547,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5608
+//line mysql_sql.y:5828
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12032,10 +12564,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5621
+//line mysql_sql.y:5841
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12049,10 +12581,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1001:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5634
+//line mysql_sql.y:5854
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12066,10 +12598,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5647
+//line mysql_sql.y:5867
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12082,10 +12614,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5659
+//line mysql_sql.y:5879
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12098,10 +12630,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5671
+//line mysql_sql.y:5891
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12114,10 +12646,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5683
+//line mysql_sql.y:5903
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12130,10 +12662,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5695
+//line mysql_sql.y:5915
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12146,10 +12678,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5707
+//line mysql_sql.y:5927
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12162,10 +12694,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5719
+//line mysql_sql.y:5939
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12178,10 +12710,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5731
+//line mysql_sql.y:5951
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12194,10 +12726,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5743
+//line mysql_sql.y:5963
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12210,10 +12742,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1011:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5755
+//line mysql_sql.y:5975
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12227,10 +12759,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1012:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5768
+//line mysql_sql.y:5988
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12244,10 +12776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5783
+//line mysql_sql.y:6003
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12260,75 +12792,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5806
+//line mysql_sql.y:6026
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1015:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5811
+//line mysql_sql.y:6031
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1016:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5817
+//line mysql_sql.y:6037
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1018:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5824
+//line mysql_sql.y:6044
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5828
+//line mysql_sql.y:6048
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1020:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5833
+//line mysql_sql.y:6053
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5837
+//line mysql_sql.y:6057
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1022:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5843
+//line mysql_sql.y:6063
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1023:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5849
+//line mysql_sql.y:6069
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12336,10 +12868,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1024:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5856
+//line mysql_sql.y:6076
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12347,10 +12879,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1025:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5863
+//line mysql_sql.y:6083
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12358,10 +12890,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1026:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5872
+//line mysql_sql.y:6092
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12369,10 +12901,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1027:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5879
+//line mysql_sql.y:6099
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12380,10 +12912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1028:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5886
+//line mysql_sql.y:6106
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12391,52 +12923,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1029:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5895
+//line mysql_sql.y:6115
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5899
+//line mysql_sql.y:6119
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5903
+//line mysql_sql.y:6123
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5909
+//line mysql_sql.y:6129
 		{
 		}
-	case 995:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5911
+//line mysql_sql.y:6131
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1037:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5921
+//line mysql_sql.y:6141
 		{
 			yyVAL.str = ""
 		}
-	case 1000:
+	case 1038:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5925
+//line mysql_sql.y:6145
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -82,6 +82,7 @@ import (
     selectStatement tree.SelectStatement
     selectExprs tree.SelectExprs
     selectExpr tree.SelectExpr
+    hints tree.Hints
 
     insert *tree.Insert
     createOption tree.CreateOption
@@ -162,10 +163,15 @@ import (
     withClause *tree.With
     cte *tree.CTE
     cteList []*tree.CTE
+
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameType tree.FrameType
 }
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION EXCEPT INTERSECT
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
@@ -175,7 +181,7 @@ import (
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
-%token <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD
+%token <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD OPTIMIZER_HINT
 %token <item> INTEGRAL HEX HEXNUM BIT_LITERAL FLOAT
 %token <str> NULL TRUE FALSE
 %left EMPTY_FROM_CLAUSE
@@ -253,7 +259,7 @@ import (
 %token <str> MAX_QUERIES_PER_HOUR MAX_UPDATES_PER_HOUR MAX_CONNECTIONS_PER_HOUR MAX_USER_CONNECTIONS
 
 // Explain
-%token <str> FORMAT VERBOSE CONNECTION
+%token <str> FORMAT VERBOSE CONNECTION DOT
 
 // Load
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES
@@ -263,7 +269,7 @@ import (
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -282,6 +288,12 @@ import (
 // With
 %token <str> RECURSIVE
 
+// Group by
+%token <str> ROLLUP CUBE GROUPING SETS
+
+// Window
+%token <str> OVER ROWS PRECEDING FOLLOWING UNBOUNDED CURRENT
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
@@ -320,6 +332,7 @@ import (
 %type <selectStatement> simple_select select_with_parens simple_select_clause
 %type <selectExprs> select_expression_list
 %type <selectExpr> select_expression
+%type <hints> hints_opt
 %type <tableExprs> table_references
 %type <tableExpr> table_reference table_factor join_table into_table_name
 %type <direction> asc_desc_opt
@@ -353,6 +366,9 @@ import (
 %type <from> from_clause from_opt
 %type <where> where_expression_opt having_opt
 %type <groupBy> group_by_opt
+%type <exprs> group_by_list grouping_set_list
+%type <expr> group_by_item grouping_set
+%type <boolVal> with_rollup_opt
 %type <aliasedTableExpr> aliased_table_name
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> derived_table
@@ -362,6 +378,11 @@ import (
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <windowSpec> over_opt window_spec
+%type <exprs> window_partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameBound> frame_bound frame_start
+%type <frameType> frame_type
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values force_quote_opt force_quote_list
@@ -1516,6 +1537,13 @@ explain_stmt:
     {
         $$ = tree.NewExplainFor($4, uint64($7.(int64)))
     }
+|   explain_sym FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", $4)
+        explainStmt.Options = tree.MakeOptions(optionElem)
+        $$ = explainStmt
+    }
 |   explain_sym explainable_stmt
     {
         $$ = tree.NewExplainStmt($2, "text")
@@ -2496,20 +2524,78 @@ union_op:
             Distinct: true,
         }
     }
+|   INTERSECT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   INTERSECT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   INTERSECT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: true,
+        }
+    }
+|   EXCEPT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   EXCEPT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   EXCEPT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: true,
+        }
+    }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT hints_opt distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Hints: $2,
+            Distinct: $3,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
         }
     }
 
+hints_opt:
+    {
+        $$ = nil
+    }
+|   OPTIMIZER_HINT
+    {
+        $$ = tree.ParseHints($1)
+    }
+
 distinct_opt:
     {
         $$ = false
@@ -2540,9 +2626,64 @@ group_by_opt:
     {
         $$ = nil
     }
-|   GROUP BY expression_list
+|   GROUP BY group_by_list with_rollup_opt
     {
-        $$ = tree.GroupBy($3)
+        if $4 {
+            $$ = tree.GroupBy{tree.NewGroupingSets(tree.Rollup, $3)}
+        } else {
+            $$ = tree.GroupBy($3)
+        }
+    }
+
+with_rollup_opt:
+    {
+        $$ = false
+    }
+|   WITH ROLLUP
+    {
+        $$ = true
+    }
+
+group_by_list:
+    group_by_item
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   group_by_list ',' group_by_item
+    {
+        $$ = append($1, $3)
+    }
+
+group_by_item:
+    expression
+|   ROLLUP '(' expression_list ')'
+    {
+        $$ = tree.NewGroupingSets(tree.Rollup, $3)
+    }
+|   CUBE '(' expression_list ')'
+    {
+        $$ = tree.NewGroupingSets(tree.Cube, $3)
+    }
+|   GROUPING SETS '(' grouping_set_list ')'
+    {
+        $$ = tree.NewGroupingSets(tree.GroupingSetsList, $4)
+    }
+
+grouping_set_list:
+    grouping_set
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   grouping_set_list ',' grouping_set
+    {
+        $$ = append($1, $3)
+    }
+
+grouping_set:
+    expression
+|   '(' ')'
+    {
+        $$ = tree.NewTuple(nil)
     }
 
 where_expression_opt:
@@ -4337,8 +4478,9 @@ simple_expr:
             Exprs: tree.Exprs{$3, es},
         }
     }
-|   function_call_generic
+|   function_call_generic over_opt
     {
+        $1.WindowSpec = $2
         $$ = $1
     }
 |   function_call_keyword
@@ -4349,11 +4491,84 @@ simple_expr:
     {
         $$ = $1
     }
-|   function_call_aggregate
+|   function_call_aggregate over_opt
     {
+        $1.WindowSpec = $2
         $$ = $1
     }
 
+over_opt:
+    {
+        $$ = nil
+    }
+|   OVER window_spec
+    {
+        $$ = $2
+    }
+
+window_spec:
+    '(' window_partition_by_opt order_by_opt frame_clause_opt ')'
+    {
+        $$ = tree.NewWindowSpec($2, $3, $4)
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+frame_clause_opt:
+    {
+        $$ = nil
+    }
+|   frame_type frame_start
+    {
+        $$ = tree.NewFrameClause($1, $2, tree.NewFrameBound(tree.CurrentRow, nil))
+    }
+|   frame_type BETWEEN frame_bound AND frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $3, $5)
+    }
+
+frame_type:
+    ROWS
+    {
+        $$ = tree.FrameRows
+    }
+|   RANGE
+    {
+        $$ = tree.FrameRange
+    }
+
+frame_start:
+    UNBOUNDED PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.UnboundedPreceding, nil)
+    }
+|   CURRENT ROW
+    {
+        $$ = tree.NewFrameBound(tree.CurrentRow, nil)
+    }
+|   literal PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.Preceding, $1)
+    }
+
+frame_bound:
+    frame_start
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.UnboundedFollowing, nil)
+    }
+|   literal FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.Following, $1)
+    }
+
 else_opt:
 	{
 		$$ = nil
@@ -5180,9 +5395,14 @@ literal:
 		ival := util.GetUint64($1)
 		$$ = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 	}
+|   VALUE_ARG
+    {
+        // The scanner names the markers :v1, :v2 and so on
+        offset, _ := strconv.Atoi($1[2:])
+        $$ = tree.NewParamExpr(offset)
+    }
 // |   HEX
 // |   BIT_LITERAL
-// |   VALUE_ARG
 
 column_type:
     numeric_type unsigned_opt zero_fill_opt
@@ -5958,6 +6178,7 @@ reserved_keyword:
 |   COALESCE
 |   CREATE
 |   CROSS
+|   CUBE
 |   CHARSET
 |   CURRENT_DATE
 |   CURRENT_ROLE
@@ -5982,6 +6203,7 @@ reserved_keyword:
 |   ELSE
 |   END
 |   ESCAPE
+|   EXCEPT
 |   EXISTS
 |   EXPLAIN
 |   FALSE
@@ -5990,6 +6212,7 @@ reserved_keyword:
 |   FROM
 |   FORMAT
 |   GROUP
+|   GROUPING
 |   HAVING
 |   HOUR
 |   IDENTIFIED
@@ -6000,6 +6223,7 @@ reserved_keyword:
 |   INDEX
 |   INNER
 |   INSERT
+|   INTERSECT
 |   INTERVAL
 |   INTO
 |   IS
@@ -6026,6 +6250,7 @@ reserved_keyword:
 |   OR
 |   ORDER
 |   OUTER
+|   OVER
 |   REGEXP
 |   RENAME
 |   REPLACE
@@ -6082,6 +6307,7 @@ reserved_keyword:
 |   FULLTEXT
 |   FOREIGN
 |	ROW
+|   ROWS
 |   OUTFILE
 
 non_reserved_keyword:
@@ -6114,6 +6340,7 @@ non_reserved_keyword:
 |   DECIMAL
 |   DYNAMIC
 |   DISK
+|   DOT
 |   DOUBLE
 |   DIRECTORY
 |   DUPLICATE
@@ -6124,7 +6351,6 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
-|   EXCEPT
 |   ERRORS
 |   ENFORCED
 |   FLOAT_TYPE
@@ -6242,6 +6468,12 @@ non_reserved_keyword:
 |   MAX_FILE_SIZE
 |   FORCE_QUOTE
 |   QUARTER
+|   ROLLUP
+|   SETS
+|   PRECEDING
+|   FOLLOWING
+|   UNBOUNDED
+|   CURRENT
 
 not_keyword:
     ADDDATE
@@ -6289,6 +6521,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   DOT
 
 
 //mo_keywords:
@@ -56,6 +56,18 @@ var (
 		input  string
 		output string
 	}{{
+		input:  "select /*+ JOIN_ORDER(t2, t1) hash_join(t1) INDEX(t1 idx_a, idx_b) */ a from t1, t2",
+		output: "select /*+ JOIN_ORDER(t2 t1) HASH_JOIN(t1) INDEX(t1 idx_a idx_b) */ a from t1, t2",
+	}, {
+		input:  "select /*+ JOIN_FIXED_ORDER() NO_INDEX(t */ distinct a from t",
+		output: "select /*+ JOIN_FIXED_ORDER() NO_INDEX(t */ distinct a from t",
+	}, {
+		input:  "select a /*+ HASH_JOIN(t) */ from t where a in (select /*+ NO_HASH_JOIN(s) */ b from s)",
+		output: "select a from t where a in (select /*+ NO_HASH_JOIN(s) */ b from s)",
+	}, {
+		input:  "select a from t where b = ? and c > ?",
+		output: "select a from t where b = ? and c > ?",
+	}, {
 		input:  "CREATE VIEW v AS SELECT * FROM t WHERE t.id = f(t.name);",
 		output: "create view v as select * from t where t.id = f(t.name)",
 	}, {
@@ -235,6 +247,34 @@ var (
 	}, {
 		input:  "select userID,count(score) from t1 where userID>2 group by userID having count(score)>1",
 		output: "select userid, count(score) from t1 where userid > 2 group by userid having count(score) > 1",
+	}, {
+		input:  "select a, b, sum(c) from t group by a, b with rollup",
+		output: "select a, b, sum(c) from t group by rollup(a, b)",
+	}, {
+		input:  "select a, b, sum(c) from t group by ROLLUP(a, b)",
+		output: "select a, b, sum(c) from t group by rollup(a, b)",
+	}, {
+		input:  "select a, b, c, sum(d) from t group by a, cube(b, c) having sum(d) > 1",
+		output: "select a, b, c, sum(d) from t group by a, cube(b, c) having sum(d) > 1",
+	}, {
+		input:  "select a, b, sum(c) from t group by grouping sets((a, b), a, ())",
+		output: "select a, b, sum(c) from t group by grouping sets((a, b), a, ())",
+	}, {
+		input: "select rollup, sets from t group by rollup, sets",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c desc) from t",
+		output: "select a, row_number() over (partition by b order by c desc) from t",
+	}, {
+		input:  "select sum(a) OVER (PARTITION BY b, c ORDER BY d ROWS BETWEEN 2 PRECEDING AND CURRENT ROW) from t",
+		output: "select sum(a) over (partition by b, c order by d rows between 2 preceding and current row) from t",
+	}, {
+		input:  "select avg(a) over (order by b range unbounded preceding), count(*) over () from t",
+		output: "select avg(a) over (order by b range between unbounded preceding and current row), count(*) over () from t",
+	}, {
+		input:  "select max(a) over (rows between current row and unbounded following) from t",
+		output: "select max(a) over (rows between current row and unbounded following) from t",
+	}, {
+		input: "select preceding, following, unbounded, current from t",
 	}, {
 		input:  "SELECT product, SUM(profit),AVG(profit) FROM t2 where product<>'TV' GROUP BY product order by product asc",
 		output: "select product, sum(profit), avg(profit) from t2 where product != TV group by product order by product asc",
@@ -673,6 +713,18 @@ var (
 		input: "select * from t union all select c from t1",
 	}, {
 		input: "select * from t union distinct select c from t1",
+	}, {
+		input: "select * from t intersect select c from t1",
+	}, {
+		input: "select * from t intersect all select c from t1",
+	}, {
+		input: "select * from t except select c from t1",
+	}, {
+		input: "select * from t except distinct select c from t1",
+	}, {
+		input: "select a from t union select b from t1 except select c from t2",
+	}, {
+		input: "select a from t union (select b from t1 intersect select c from t2)",
 	}, {
 		input: "select * from (select a from t) as t1",
 	}, {
@@ -123,6 +123,8 @@ func (s *Scanner) Scan() (int, string) {
 			case s.cur() == '!' && s.dialectType == dialect.MYSQL:
 				// TODO: ExtractMysqlComment
 				return s.scanMySQLSpecificComment()
+			case s.cur() == '+':
+				return s.scanOptimizerHint()
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
@@ -402,6 +404,18 @@ func (s *Scanner) scanCommentTypeBlock() (int, string) {
 	return COMMENT, s.buf[start:s.Pos]
 }
 
+// scanOptimizerHint scans a '/*+' delimited comment of optimizer hints and
+// returns the text between the delimiters; assumes the '/*' has already been
+// scanned
+func (s *Scanner) scanOptimizerHint() (int, string) {
+	start := s.Pos + 1
+	id, str := s.scanCommentTypeBlock()
+	if id == LEX_ERROR {
+		return id, str
+	}
+	return OPTIMIZER_HINT, s.buf[start : s.Pos-2]
+}
+
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
 func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 	start := s.Pos - 3
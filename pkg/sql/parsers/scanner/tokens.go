@@ -168,6 +168,7 @@ func initTokens(dialectType dialect.DialectType) {
 		SHUTDOWN = MYSQL_SHUTDOWN
 		REVOKE = MYSQL_REVOKE
 		EXCEPT = MYSQL_EXCEPT
+		INTERSECT = MYSQL_INTERSECT
 		LOCAL = MYSQL_LOCAL
 		ASSIGNMENT = MYSQL_ASSIGNMENT
 		NO = MYSQL_NO
@@ -271,6 +272,7 @@ func initTokens(dialectType dialect.DialectType) {
 		LIST_ARG = MYSQL_LIST_ARG
 		COMMENT = MYSQL_COMMENT
 		COMMENT_KEYWORD = MYSQL_COMMENT_KEYWORD
+		OPTIMIZER_HINT = MYSQL_OPTIMIZER_HINT
 		BIT_LITERAL = MYSQL_BIT_LITERAL
 		NULL = MYSQL_NULL
 		TRUE = MYSQL_TRUE
@@ -433,6 +435,17 @@ func initTokens(dialectType dialect.DialectType) {
 		WITH = MYSQL_WITH
 		QUERY = MYSQL_QUERY
 		EXPANSION = MYSQL_EXPANSION
+		ROLLUP = MYSQL_ROLLUP
+		CUBE = MYSQL_CUBE
+		GROUPING = MYSQL_GROUPING
+		SETS = MYSQL_SETS
+		OVER = MYSQL_OVER
+		ROWS = MYSQL_ROWS
+		PRECEDING = MYSQL_PRECEDING
+		FOLLOWING = MYSQL_FOLLOWING
+		UNBOUNDED = MYSQL_UNBOUNDED
+		CURRENT = MYSQL_CURRENT
+		DOT = MYSQL_DOT
 		UNUSED = MYSQL_UNUSED
 
 	case dialect.POSTGRESQL:
@@ -518,6 +531,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cross":                    CROSS,
+		"cube":                     CUBE,
+		"current":                  CURRENT,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
@@ -544,6 +559,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"distinctrow":              UNUSED,
 		"disk":                     DISK,
 		"div":                      DIV,
+		"dot":                      DOT,
 		"directory":                DIRECTORY,
 		"double":                   DOUBLE,
 		"drop":                     DROP,
@@ -586,6 +602,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"fields":                   FIELDS,
 		"file":                     FILE,
 		"fixed":                    FIXED,
+		"following":                FOLLOWING,
 		"generated":                UNUSED,
 		"geometry":                 GEOMETRY,
 		"geometrycollection":       GEOMETRYCOLLECTION,
@@ -594,6 +611,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"grant":                    GRANT,
 		"group":                    GROUP,
 		"group_concat":             GROUP_CONCAT,
+		"grouping":                 GROUPING,
 		"having":                   HAVING,
 		"hash":                     HASH,
 		"high_priority":            UNUSED,
@@ -609,6 +627,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"inner":                    INNER,
 		"insensitive":              UNUSED,
 		"insert":                   INSERT,
+		"intersect":                INTERSECT,
 		"int":                      INT,
 		"int1":                     INT1,
 		"int2":                     INT2,
@@ -700,6 +719,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  OUTFILE,
+		"over":                     OVER,
 		"header":                   HEADER,
 		"max_file_size":            MAX_FILE_SIZE,
 		"force_quote":              FORCE_QUOTE,
@@ -711,6 +731,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"pack_keys":                PACK_KEYS,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
+		"preceding":                PRECEDING,
 		"precision":                UNUSED,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
@@ -747,8 +768,10 @@ func initTokens(dialectType dialect.DialectType) {
 		"rlike":                    REGEXP,
 		"rollback":                 ROLLBACK,
 		"role":                     ROLE,
+		"rollup":                   ROLLUP,
 		"routine":                  ROUTINE,
 		"row":                      ROW,
+		"rows":                     ROWS,
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
@@ -761,6 +784,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"serializable":             SERIALIZABLE,
 		"session":                  SESSION,
 		"set":                      SET,
+		"sets":                     SETS,
 		"share":                    SHARE,
 		"show":                     SHOW,
 		"shutdown":                 SHUTDOWN,
@@ -812,6 +836,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
 		"uncommitted":              UNCOMMITTED,
+		"unbounded":                UNBOUNDED,
 		"undo":                     UNUSED,
 		"union":                    UNION,
 		"unique":                   UNIQUE,
@@ -1025,6 +1050,7 @@ var (
 	USAGE                    int
 	REVOKE                   int
 	EXCEPT                   int
+	INTERSECT                int
 	LOCAL                    int
 	ASSIGNMENT               int
 	RELEASE                  int
@@ -1128,6 +1154,7 @@ var (
 	LIST_ARG                 int
 	COMMENT                  int
 	COMMENT_KEYWORD          int
+	OPTIMIZER_HINT           int
 	BIT_LITERAL              int
 	NULL                     int
 	TRUE                     int
@@ -1290,6 +1317,17 @@ var (
 	WITH                     int
 	QUERY                    int
 	EXPANSION                int
+	ROLLUP                   int
+	CUBE                     int
+	GROUPING                 int
+	SETS                     int
+	OVER                     int
+	ROWS                     int
+	PRECEDING                int
+	FOLLOWING                int
+	UNBOUNDED                int
+	CURRENT                  int
+	DOT                      int
 	CURRENT_USER             int
 	CURRENT_ROLE             int
 	MONTH                    int
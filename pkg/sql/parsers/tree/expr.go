@@ -528,6 +528,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//the window of a window function, nil if it is not one.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -540,6 +543,119 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	}
 	node.Exprs.Format(ctx)
 	ctx.WriteByte(')')
+	if node.WindowSpec != nil {
+		ctx.WriteString(" over ")
+		node.WindowSpec.Format(ctx)
+	}
+}
+
+//the OVER clause of a window function
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	//the whole partition, or the rows up to the current one with OrderBy if it is nil.
+	Frame *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	ctx.WriteByte('(')
+	sep := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		sep = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(sep)
+		node.OrderBy.Format(ctx)
+		sep = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(sep)
+		node.Frame.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewWindowSpec(partitionBy Exprs, orderBy OrderBy, frame *FrameClause) *WindowSpec {
+	return &WindowSpec{
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		Frame:       frame,
+	}
+}
+
+type FrameType int
+
+const (
+	FrameRows FrameType = iota
+	FrameRange
+)
+
+//the frame of a window, the rows from Start to End.
+type FrameClause struct {
+	Type  FrameType
+	Start *FrameBound
+	End   *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	if node.Type == FrameRange {
+		ctx.WriteString("range between ")
+	} else {
+		ctx.WriteString("rows between ")
+	}
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+func NewFrameClause(typ FrameType, start, end *FrameBound) *FrameClause {
+	return &FrameClause{
+		Type:  typ,
+		Start: start,
+		End:   end,
+	}
+}
+
+type FrameBoundType int
+
+const (
+	UnboundedPreceding FrameBoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+//a bound of a frame, Expr is the offset of PRECEDING and FOLLOWING.
+type FrameBound struct {
+	Type FrameBoundType
+	Expr Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case UnboundedPreceding:
+		ctx.WriteString("unbounded preceding")
+	case Preceding:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" preceding")
+	case CurrentRow:
+		ctx.WriteString("current row")
+	case Following:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" following")
+	case UnboundedFollowing:
+		ctx.WriteString("unbounded following")
+	}
+}
+
+func NewFrameBound(typ FrameBoundType, e Expr) *FrameBound {
+	return &FrameBound{
+		Type: typ,
+		Expr: e,
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -791,6 +907,23 @@ func NewMaxValue() *MaxValue {
 	return &MaxValue{}
 }
 
+//the parameter marker '?' of a prepared statement.
+type ParamExpr struct {
+	exprImpl
+	//the position of the marker in the statement, from 1
+	Offset int
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	ctx.WriteByte('?')
+}
+
+func NewParamExpr(offset int) *ParamExpr {
+	return &ParamExpr{
+		Offset: offset,
+	}
+}
+
 type TypeExpr interface {
 	Expr
 }
@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Hint is an optimizer hint like JOIN_ORDER(t1, t2) or INDEX(t idx1, idx2).
+// A hint which is not well formed has no name and the text from where the
+// error is in Args.
+type Hint struct {
+	Name string
+	Args []string
+}
+
+func (node *Hint) Format(ctx *FmtCtx) {
+	if node.Name == "" {
+		ctx.WriteString(strings.Join(node.Args, " "))
+		return
+	}
+	ctx.WriteString(node.Name)
+	ctx.WriteByte('(')
+	ctx.WriteString(strings.Join(node.Args, " "))
+	ctx.WriteByte(')')
+}
+
+// Hints are the optimizer hints in a /*+ ... */ comment after SELECT.
+type Hints []*Hint
+
+func (node Hints) Format(ctx *FmtCtx) {
+	ctx.WriteString("/*+ ")
+	for i, h := range node {
+		if i > 0 {
+			ctx.WriteByte(' ')
+		}
+		h.Format(ctx)
+	}
+	ctx.WriteString(" */")
+}
+
+// ParseHints parses the text of a hint comment without the delimiters. The
+// arguments of a hint are separated by the commas or the spaces, and the
+// names are upper cased.
+func ParseHints(text string) Hints {
+	var hints Hints
+	s := strings.TrimSpace(text)
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if i < 0 {
+			i = len(s)
+		}
+		name, rest := s[:i], strings.TrimLeftFunc(s[i:], unicode.IsSpace)
+		if name == "" || !strings.HasPrefix(rest, "(") {
+			return append(hints, &Hint{Args: []string{s}})
+		}
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			return append(hints, &Hint{Args: []string{s}})
+		}
+		hints = append(hints, &Hint{
+			Name: strings.ToUpper(name),
+			Args: strings.FieldsFunc(rest[1:end], func(r rune) bool {
+				return r == ',' || unicode.IsSpace(r)
+			}),
+		})
+		s = strings.TrimSpace(rest[end+1:])
+	}
+	return hints
+}
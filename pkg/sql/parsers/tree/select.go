@@ -163,6 +163,7 @@ func (node *ParenSelect) Format(ctx *FmtCtx) {
 // SelectClause represents a SELECT statement.
 type SelectClause struct {
 	SelectStatement
+	Hints    Hints
 	Distinct bool
 	Exprs    SelectExprs
 	From     *From
@@ -173,6 +174,10 @@ type SelectClause struct {
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if len(node.Hints) > 0 {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
@@ -255,6 +260,53 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+type GroupingSetsType int
+
+const (
+	GroupingSetsList GroupingSetsType = iota // GROUPING SETS (...)
+	Rollup                                   // ROLLUP (...) or WITH ROLLUP
+	Cube                                     // CUBE (...)
+)
+
+// GroupingSets is a GROUPING SETS, ROLLUP or CUBE element of GROUP BY.
+// The elements of Exprs are the grouping sets of GROUPING SETS and the
+// columns rolled up of ROLLUP and CUBE. A Tuple is a list of columns, the
+// empty one is the grand total
+type GroupingSets struct {
+	exprImpl
+	Type  GroupingSetsType
+	Exprs Exprs
+}
+
+func (node *GroupingSets) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case Rollup:
+		ctx.WriteString("rollup(")
+	case Cube:
+		ctx.WriteString("cube(")
+	default:
+		ctx.WriteString("grouping sets(")
+	}
+	for i, e := range node.Exprs {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		if t, ok := e.(*Tuple); ok && len(t.Exprs) == 0 {
+			ctx.WriteString("()")
+			continue
+		}
+		e.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewGroupingSets(typ GroupingSetsType, exprs Exprs) *GroupingSets {
+	return &GroupingSets{
+		Type:  typ,
+		Exprs: exprs,
+	}
+}
+
 const (
 	JOIN_TYPE_FULL          = "FULL"
 	JOIN_TYPE_LEFT          = "LEFT"
@@ -32,6 +32,13 @@ func New(db string, sql string, e engine.Engine) *build {
 	}
 }
 
+// WithHiddenColumns makes select * also project the hidden columns of the
+// relations which support them
+func (b *build) WithHiddenColumns(show bool) *build {
+	b.showHidden = show
+	return b
+}
+
 func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
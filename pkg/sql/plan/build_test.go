@@ -16,11 +16,15 @@ package plan
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
 	"log"
+	"reflect"
 	"testing"
 )
 
@@ -59,6 +63,9 @@ var querys = []string{
 	"select * from t1 where spID>2 AND userID <2 || userID >=2 OR userID < 2 limit 3;",
 	"select * from t1 where (spID >2  or spID <= 2) && score <> 1 AND userID/2>2;",
 	"select * from t1 where spID >2  || spID <= 2 && score !=1 limit 3;",
+	"select * from t1 where spID in (1, 3, 5) and userID not in (2, 4);",
+	"select userID, case when score > 1 then spID else userID end as s from t1;",
+	"select case userID when 1 then 'a' when 2 then 'b' else 'c' end from t1;",
 
 	`select
 		sum(lo_revenue) as revenue
@@ -189,6 +196,50 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+// the constants of an IN on a decimal column are cast at its scale
+func TestBuildDecimalIn(t *testing.T) {
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	db, _ := e.Database("test", nil)
+	attrs := []engine.TableDef{
+		&engine.AttributeDef{
+			Attr: engine.Attribute{
+				Name: "d",
+				Type: types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2},
+			}},
+	}
+	if err := db.Create(0, "t", attrs, nil); err != nil {
+		t.Fatal(err)
+	}
+	query := "select d from t where d in (1.5, 2.25)"
+	stmts, err := parsers.Parse(dialect.MYSQL, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qry, err := New("test", query, e).BuildStatement(stmts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var in *extend.InExtend
+	for s := qry.(*Query).Scope; s != nil && in == nil; {
+		if rel, ok := s.Op.(*Relation); ok {
+			in, _ = rel.Cond.(*extend.InExtend)
+		}
+		if len(s.Children) == 0 {
+			break
+		}
+		s = s.Children[0]
+	}
+	if in == nil {
+		t.Fatalf("no IN condition in the plan of '%s'", query)
+	}
+	if in.Vs.Typ.Scale != 2 {
+		t.Fatalf("the scale of the constants is %v", in.Vs.Typ.Scale)
+	}
+	if vs := in.Vs.Col.([]types.Decimal64); !reflect.DeepEqual(vs, []types.Decimal64{150, 225}) {
+		t.Fatalf("the constants are %v", vs)
+	}
+}
+
 func processQuery(query string, e engine.Engine) {
 	stmts, err := parsers.Parse(dialect.MYSQL, query)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/builtin"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -145,6 +146,38 @@ func (b *build) buildBetween(e *tree.RangeCond, qry *Query, fn func(tree.Expr, *
 	}, nil
 }
 
+// buildCase builds CASE as nested iffs, so that the WHENs are tried in order
+func (b *build) buildCase(e *tree.CaseExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	if e.Else == nil {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' without else is not support now", e))
+	}
+	ext, err := fn(e.Else, qry)
+	if err != nil {
+		return nil, err
+	}
+	var expr extend.Extend
+	if e.Expr != nil {
+		if expr, err = fn(e.Expr, qry); err != nil {
+			return nil, err
+		}
+	}
+	for i := len(e.Whens) - 1; i >= 0; i-- {
+		cond, err := fn(e.Whens[i].Cond, qry)
+		if err != nil {
+			return nil, err
+		}
+		if expr != nil {
+			cond = &extend.BinaryExtend{Op: overload.EQ, Left: expr, Right: cond}
+		}
+		val, err := fn(e.Whens[i].Val, qry)
+		if err != nil {
+			return nil, err
+		}
+		ext = &extend.MultiExtend{Op: builtin.Iff, Args: []extend.Extend{cond, val, ext}}
+	}
+	return ext, nil
+}
+
 func (b *build) buildCast(e *tree.CastExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	left, err := fn(e.Expr, qry)
 	if err != nil {
@@ -402,10 +435,42 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.IN, tree.NOT_IN:
+		return b.buildIn(e, qry, fn)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
 
+// buildIn builds the IN of a list of constants, which are cast to the type
+// of the left side here. The constants compared with a decimal column are
+// cast at the scale of the column
+func (b *build) buildIn(e *tree.ComparisonExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	tuple, ok := e.Right.(*tree.Tuple)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
+	}
+	left, err := fn(e.Left, qry)
+	if err != nil {
+		return nil, err
+	}
+	typ := left.ReturnType().ToType()
+	switch typ.Oid {
+	case types.T_decimal64, types.T_decimal128:
+		attr, ok := left.(*extend.Attribute)
+		if !ok { // the scale is known for the columns only
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
+		}
+		typ = qry.Scope.Result.AttrsMap[attr.Name].Type
+	case types.T_any:
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
+	}
+	vec := vector.New(typ)
+	if err := buildConstantVector(vec, left.String(), tuple.Exprs); err != nil {
+		return nil, err
+	}
+	return &extend.InExtend{Not: e.Op == tree.NOT_IN, E: left, Vs: vec}, nil
+}
+
 func (b *build) buildAttribute(e *tree.UnresolvedName, qry *Query) (extend.Extend, error) {
 	if e.Star {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
@@ -886,6 +951,8 @@ func pruneExtend(e extend.Extend) extend.Extend {
 		for i, arg := range v.Args {
 			v.Args[i] = pruneExtend(arg)
 		}
+	case *extend.InExtend:
+		v.E = pruneExtend(v.E)
 	}
 	return e
 }
@@ -361,6 +361,17 @@ func (b *build) getSchemaInfo(schema string, name string) ([]string, map[string]
 			attrs = append(attrs, v.Attr.Name)
 		}
 	}
+	if hr, ok := r.(engine.HiddenRelation); ok && b.showHidden && !b.isModify {
+		for _, attr := range hr.HiddenAttributes(nil) {
+			if _, ok := attrsMap[attr.Name]; !ok {
+				attrsMap[attr.Name] = &Attribute{
+					Name: attr.Name,
+					Type: attr.Type,
+				}
+				attrs = append(attrs, attr.Name)
+			}
+		}
+	}
 	if b.isModify {
 		priKeys, _ := r.GetPriKeyOrHideKey(nil)
 		if priKeys == nil {
@@ -112,361 +112,12 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 
 	// insert values for columns
 	for i, vec := range bat.Vecs {
-		switch vec.Typ.Oid {
-		case types.T_int8:
-			vs := make([]int8, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(int8)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_int16:
-			vs := make([]int16, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(int16)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_int32:
-			vs := make([]int32, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(int32)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_int64:
-			vs := make([]int64, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(int64)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_uint8:
-			vs := make([]uint8, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(uint8)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_uint16:
-			vs := make([]uint16, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(uint16)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_uint32:
-			vs := make([]uint32, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(uint32)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_uint64:
-			vs := make([]uint64, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(uint64)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_float32:
-			vs := make([]float32, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(float32), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(float32)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_float64:
-			vs := make([]float64, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(float64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(float64)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_char, types.T_varchar:
-			vs := make([][]byte, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(string), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = []byte(vv.(string))
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_date:
-			vs := make([]types.Date, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(types.Date), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(types.Date)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_datetime:
-			vs := make([]types.Datetime, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(types.Datetime), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(types.Datetime)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_timestamp:
-			vs := make([]types.Timestamp, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(types.Timestamp), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(types.Timestamp)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_decimal64:
-			vs := make([]types.Decimal64, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(types.Decimal64), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(types.Decimal64)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		case types.T_decimal128:
-			vs := make([]types.Decimal128, len(rows.Rows))
-			{
-				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
-					if err != nil {
-						return err
-					}
-					if v == nil {
-						nulls.Add(vec.Nsp, uint64(j))
-					} else {
-						if vv, err := rangeCheck(v.(types.Decimal128), vec.Typ, bat.Attrs[i], j+1); err != nil {
-							return err
-						} else {
-							vs[j] = vv.(types.Decimal128)
-						}
-					}
-				}
-			}
-			if err := vector.Append(vec, vs); err != nil {
-				return err
-			}
-		default:
-			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
+		exprs := make([]tree.Expr, len(rows.Rows))
+		for j, row := range rows.Rows {
+			exprs[j] = row[i]
+		}
+		if err := buildConstantVector(vec, bat.Attrs[i], exprs); err != nil {
+			return err
 		}
 	}
 	// insert Null for other columns
@@ -645,3 +296,365 @@ func rewriteInsertRows(noInsertTarget bool, finalInsertTargets []string, relatio
 	}
 	return rows, finalInsertTargets, nil
 }
+
+// buildConstantVector appends the constants of exprs to vec, name is the
+// column reported by the range checks
+func buildConstantVector(vec *vector.Vector, name string, exprs []tree.Expr) error {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		vs := make([]int8, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(int64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(int8)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_int16:
+		vs := make([]int16, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(int64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(int16)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_int32:
+		vs := make([]int32, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(int64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(int32)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_int64:
+		vs := make([]int64, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(int64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(int64)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_uint8:
+		vs := make([]uint8, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(uint64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(uint8)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_uint16:
+		vs := make([]uint16, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(uint64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(uint16)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_uint32:
+		vs := make([]uint32, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(uint64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(uint32)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_uint64:
+		vs := make([]uint64, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(uint64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(uint64)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_float32:
+		vs := make([]float32, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(float32), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(float32)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_float64:
+		vs := make([]float64, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(float64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(float64)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_char, types.T_varchar:
+		vs := make([][]byte, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(string), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = []byte(vv.(string))
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_date:
+		vs := make([]types.Date, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(types.Date), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(types.Date)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_datetime:
+		vs := make([]types.Datetime, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(types.Datetime), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(types.Datetime)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_timestamp:
+		vs := make([]types.Timestamp, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(types.Timestamp), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(types.Timestamp)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_decimal64:
+		vs := make([]types.Decimal64, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(types.Decimal64), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(types.Decimal64)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	case types.T_decimal128:
+		vs := make([]types.Decimal128, len(exprs))
+		{
+			for j, expr := range exprs {
+				v, err := buildConstant(vec.Typ, expr)
+				if err != nil {
+					return err
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				} else {
+					if vv, err := rangeCheck(v.(types.Decimal128), vec.Typ, name, j+1); err != nil {
+						return err
+					} else {
+						vs[j] = vv.(types.Decimal128)
+					}
+				}
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return err
+		}
+	default:
+		return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
+	}
+	return nil
+}
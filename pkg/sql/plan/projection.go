@@ -101,6 +101,8 @@ func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, err
 		return b.buildCast(e, qry, b.buildProjectionExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildProjectionExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildProjectionExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
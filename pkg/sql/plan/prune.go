@@ -59,6 +59,11 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 			return nil, err
 		}
 		return n, nil
+	case *extend.InExtend:
+		if n.E, err = b.pruneExtend(n.E, false); err != nil {
+			return nil, err
+		}
+		return n, nil
 	case *extend.BinaryExtend:
 		if n.Left, err = b.pruneExtend(n.Left, false); err != nil {
 			return nil, err
@@ -174,6 +179,8 @@ func logicInverse(e extend.Extend) extend.Extend {
 	switch v := e.(type) {
 	case *extend.ParenExtend:
 		return &extend.ParenExtend{E: logicInverse(v.E)}
+	case *extend.InExtend:
+		return &extend.InExtend{Not: !v.Not, E: v.E, Vs: v.Vs}
 	case *extend.BinaryExtend:
 		return splitNotBinary(v)
 	case *extend.UnaryExtend:
@@ -357,6 +357,9 @@ func pushDownProjectionExtend(e extend.Extend, qry *Query) extend.Extend {
 		return v
 	case *extend.ValueExtend:
 		return v
+	case *extend.InExtend:
+		v.E = pushDownProjectionExtend(v.E, qry)
+		return v
 	case *extend.BinaryExtend:
 		v.Left = pushDownProjectionExtend(v.Left, qry)
 		v.Right = pushDownProjectionExtend(v.Right, qry)
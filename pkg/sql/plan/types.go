@@ -302,11 +302,12 @@ type Update struct {
 }
 
 type build struct {
-	flg      bool // use for having clause
-	isModify bool
-	db       string // name of schema
-	sql      string
-	e        engine.Engine
+	flg        bool // use for having clause
+	isModify   bool
+	showHidden bool   // unfold the hidden columns in select *
+	db         string // name of schema
+	sql        string
+	e          engine.Engine
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
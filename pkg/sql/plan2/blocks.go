@@ -0,0 +1,147 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import "github.com/matrixorigin/matrixone/pkg/pb/plan"
+
+// indexSelectivity is the max selectivity of an equality predicate on an
+// indexed column for the scan to look up the rows by the index
+const indexSelectivity = 0.1
+
+// resolveBlocks sets the pruning predicates of the table scans of query and
+// the partitions and the blocks they read, which are listed by ctx if it is
+// a BlockPruner. The rows of a scan with a selective equality predicate on
+// an indexed column are looked up by the index if ctx is an IndexLookup.
+// The scans with parameters in the filters are deferred to the binds. The
+// predicates of the scans restricted by the index hints are on the columns
+// of the restrictions only
+func resolveBlocks(ctx CompilerContext, query *Query) {
+	pruner, _ := ctx.(BlockPruner)
+	lookup, _ := ctx.(IndexLookup)
+	stats, _ := ctx.(Statistics)
+	for _, node := range query.GetNodes() {
+		if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil || node.TableDef == nil {
+			continue
+		}
+		info := &plan.PruneInfo{
+			Predicates: SargablePredicates(node),
+			Restricted: node.PruneInfo.GetRestricted(),
+			Columns:    node.PruneInfo.GetColumns(),
+		}
+		if info.Restricted {
+			cols := make(map[string]bool, len(info.Columns))
+			for _, name := range info.Columns {
+				cols[name] = true
+			}
+			preds := info.Predicates[:0]
+			for _, e := range info.Predicates {
+				if cols[e.Expr.(*plan.Expr_F).F.Args[0].GetCol().GetName()] {
+					preds = append(preds, e)
+				}
+			}
+			info.Predicates = preds
+		}
+		node.PruneInfo = info
+		var ts *TableStats
+		if stats != nil {
+			ts = stats.Stats(node.ObjRef)
+		}
+		info.Index = chooseIndex(node.TableDef, info.Predicates, ts)
+		for _, e := range node.WhereList {
+			walkExpr(e, func(e *Expr) {
+				if isParam(e) {
+					info.Deferred = true
+				}
+			})
+		}
+		if info.Deferred {
+			continue
+		}
+		if pruner != nil {
+			info.Partitions, info.Blocks, info.Resolved = pruner.Blocks(node.ObjRef, node.TableDef, info.Predicates)
+		}
+		if e := IndexPredicate(node); e != nil && lookup != nil {
+			f := e.Expr.(*plan.Expr_F).F
+			if blocks, ok := lookup.Lookup(node.ObjRef, f.Args[0].GetCol().GetName(), f.Args[1]); ok {
+				info.Blocks, info.Resolved = blocks, true
+			}
+		}
+	}
+}
+
+// chooseIndex returns the secondary index of def of the most selective
+// equality predicate in preds on a column other than the primary key, or
+// empty if none is selective enough by the statistics ts
+func chooseIndex(def *TableDef, preds []*Expr, ts *TableStats) string {
+	indexes := secondaryIndexes(def)
+	if len(indexes) == 0 {
+		return ""
+	}
+	var index string
+	best := indexSelectivity
+	for _, e := range preds {
+		f := e.Expr.(*plan.Expr_F).F
+		if f.Func.GetObjName() != "=" {
+			continue
+		}
+		col := f.Args[0].GetCol()
+		name, ok := indexes[col.GetName()]
+		if !ok || def.Cols[col.ColPos].Primary {
+			continue
+		}
+		var cs *ColumnStats
+		if ts != nil {
+			cs = ts.Columns[col.GetName()]
+		}
+		if sel := eqSelectivity(cs); sel <= best {
+			index, best = name, sel
+		}
+	}
+	return index
+}
+
+// secondaryIndexes returns the names of the secondary indexes of def on a
+// single column by the columns
+func secondaryIndexes(def *TableDef) map[string]string {
+	indexes := make(map[string]string)
+	for _, d := range def.Defs {
+		if idx := d.GetIdx(); idx != nil && idx.Typ == plan.IndexDef_SECONDARY && len(idx.ColNames) == 1 {
+			indexes[idx.ColNames[0]] = idx.Name
+		}
+	}
+	return indexes
+}
+
+// IndexPredicate returns the equality predicate of the scan node on the
+// column of the secondary index chosen for it, or nil if the scan does not
+// use an index
+func IndexPredicate(node *Node) *Expr {
+	info := node.PruneInfo
+	if info.GetIndex() == "" || node.TableDef == nil {
+		return nil
+	}
+	for col, name := range secondaryIndexes(node.TableDef) {
+		if name != info.Index {
+			continue
+		}
+		for _, e := range info.Predicates {
+			f := e.Expr.(*plan.Expr_F).F
+			if f.Func.GetObjName() == "=" && f.Args[0].GetCol().GetName() == col {
+				return e
+			}
+		}
+	}
+	return nil
+}
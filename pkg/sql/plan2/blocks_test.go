@@ -0,0 +1,134 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+)
+
+// blockCompilerContext lists a block for each predicate
+type blockCompilerContext struct {
+	CompilerContext
+}
+
+func (b *blockCompilerContext) Blocks(obj *ObjectRef, def *TableDef, preds []*Expr) ([]uint32, []*plan.BlockRef, bool) {
+	var blocks []*plan.BlockRef
+	for i := range preds {
+		blocks = append(blocks, &plan.BlockRef{SegmentId: uint64(obj.Obj), BlockId: uint64(i)})
+	}
+	return []uint32{0}, blocks, true
+}
+
+func TestResolveBlocks(t *testing.T) {
+	ctx := &blockCompilerContext{CompilerContext: NewMockOptimizer().CurrentContext()}
+	sql := "SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE N_NATIONKEY < 6 AND N_REGIONKEY > 1"
+	query := buildQuery(t, ctx, sql)
+	nation := scanOf(query, "nation")
+	info := nation.PruneInfo
+	if info == nil || !info.Resolved || info.Deferred {
+		t.Fatalf("the blocks of nation should be resolved, %v", info)
+	}
+	if len(info.Predicates) != 2 || len(info.Blocks) != 2 || len(info.Partitions) != 1 {
+		t.Fatalf("the blocks should be listed by the predicates, %v", info)
+	}
+	if info := scanOf(query, "region").PruneInfo; info == nil || !info.Resolved || len(info.Blocks) != 0 {
+		t.Fatalf("the blocks of region should be resolved without predicates, %v", info)
+	}
+
+	// Not listed by the engine
+	query = buildWithStats(t, sql, false)
+	if info := scanOf(query, "nation").PruneInfo; info == nil || info.Resolved || len(info.Predicates) != 2 {
+		t.Fatalf("the predicates should be kept for the runtime, %v", info)
+	}
+}
+
+func TestDeferBlocks(t *testing.T) {
+	ctx := &blockCompilerContext{CompilerContext: NewMockOptimizer().CurrentContext()}
+	stmt, err := mysql.ParseOne("SELECT N_NAME FROM NATION WHERE N_NATIONKEY = ? AND N_REGIONKEY > 1")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	p, err := Prepare(ctx, stmt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	info := scanOf(p.Plan.GetQuery(), "nation").PruneInfo
+	if info == nil || !info.Deferred || info.Resolved || len(info.Blocks) != 0 {
+		t.Fatalf("the blocks should be deferred to the binds, %v", info)
+	}
+
+	pn, err := p.Bind(ctx, []*Const{{Value: &plan.Const_Ival{Ival: 3}}})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	info = scanOf(pn.GetQuery(), "nation").PruneInfo
+	if !info.Resolved || info.Deferred || len(info.Predicates) != 2 || len(info.Blocks) != 2 {
+		t.Fatalf("the blocks should be resolved with the values bound, %v", info)
+	}
+	if info := scanOf(p.Plan.GetQuery(), "nation").PruneInfo; !info.Deferred || info.Resolved {
+		t.Fatalf("the prepared plan should not be modified, %v", info)
+	}
+}
+
+// indexCompilerContext has a secondary index on n_name of nation and looks
+// up a row of a block for each value
+type indexCompilerContext struct {
+	blockCompilerContext
+}
+
+func (c *indexCompilerContext) Resolve(name string) (*ObjectRef, *TableDef) {
+	obj, def := c.CompilerContext.Resolve(name)
+	if def == nil || def.Name != "nation" {
+		return obj, def
+	}
+	idx := &plan.IndexDef{
+		Typ:      plan.IndexDef_SECONDARY,
+		Name:     "n_name_idx",
+		ColNames: []string{"n_name"},
+	}
+	defs := append([]*plan.TableDef_DefType{}, def.Defs...)
+	defs = append(defs, &plan.TableDef_DefType{Def: &plan.TableDef_DefType_Idx{Idx: idx}})
+	return obj, &TableDef{Name: def.Name, Cols: def.Cols, Defs: defs}
+}
+
+func (c *indexCompilerContext) Lookup(obj *ObjectRef, col string, key *Expr) ([]*plan.BlockRef, bool) {
+	if col != "n_name" || key.GetC() == nil {
+		return nil, false
+	}
+	return []*plan.BlockRef{{SegmentId: uint64(obj.Obj), BlockId: 7, Rows: []uint32{3}}}, true
+}
+
+func TestIndexLookup(t *testing.T) {
+	ctx := &indexCompilerContext{blockCompilerContext{CompilerContext: NewMockOptimizer().CurrentContext()}}
+	query := buildQuery(t, ctx, "SELECT N_NATIONKEY FROM NATION WHERE N_NAME = 'CHINA' AND N_REGIONKEY > 1")
+	nation := scanOf(query, "nation")
+	info := nation.PruneInfo
+	if info == nil || info.Index != "n_name_idx" || IndexPredicate(nation) == nil {
+		t.Fatalf("the index should be chosen for the equality predicate, %v", info)
+	}
+	if !info.Resolved || len(info.Blocks) != 1 || info.Blocks[0].BlockId != 7 || len(info.Blocks[0].Rows) != 1 {
+		t.Fatalf("the rows should be looked up by the index, %v", info)
+	}
+
+	// Not an equality predicate
+	query = buildQuery(t, ctx, "SELECT N_NATIONKEY FROM NATION WHERE N_NAME > 'CHINA' AND N_REGIONKEY > 1")
+	nation = scanOf(query, "nation")
+	if info := nation.PruneInfo; info == nil || info.Index != "" || IndexPredicate(nation) != nil || len(info.Blocks) != 2 {
+		t.Fatalf("the index should not be used for a range predicate, %v", info)
+	}
+}
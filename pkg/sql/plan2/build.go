@@ -28,6 +28,9 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*plan.Plan, error) {
 	runBuildSelect := func(stmt *tree.Select) (*plan.Plan, error) {
 		query, selectCtx := newQueryAndSelectCtx(plan.Query_SELECT)
 		err := buildSelect(stmt, ctx, query, selectCtx)
+		if err == nil {
+			optimizeQuery(ctx, query, selectCtx.hints)
+		}
 		return &plan.Plan{
 			Plan: &plan.Plan_Query{
 				Query: query,
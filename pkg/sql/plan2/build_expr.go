@@ -35,6 +35,13 @@ func splitAndBuildExpr(stmt tree.Expr, ctx CompilerContext, query *Query, select
 		if err != nil {
 			return nil, err
 		}
+		if b, ok := boolConst(expr); ok {
+			if b {
+				continue
+			}
+			// One false conjunct is the whole condition
+			return []*plan.Expr{expr}, nil
+		}
 		exprs = append(exprs, expr)
 	}
 
@@ -46,6 +53,19 @@ func buildExpr(stmt tree.Expr, ctx CompilerContext, query *Query, selectCtx *Sel
 	switch astExpr := stmt.(type) {
 	case *tree.NumVal:
 		return buildNumVal(astExpr.Value)
+	case *tree.ParamExpr:
+		//typed by the other arguments of its function, or by the value bound
+		return &plan.Expr{
+			Expr: &plan.Expr_P{
+				P: &plan.ParamRef{
+					Pos: int32(astExpr.Offset - 1),
+				},
+			},
+			Typ: &plan.Type{
+				Id:       plan.Type_ANY,
+				Nullable: true,
+			},
+		}, nil
 	case *tree.ParenExpr:
 		return buildExpr(astExpr.Expr, ctx, query, selectCtx)
 	case *tree.OrExpr:
@@ -150,7 +170,7 @@ func buildCast(astExpr *tree.CastExpr, ctx CompilerContext, query *Query, select
 	if err != nil {
 		return nil, err
 	}
-	return &plan.Expr{
+	return foldExpr(&plan.Expr{
 		Expr: &plan.Expr_F{
 			F: &plan.Function{
 				Func: getFunctionObjRef("CAST"),
@@ -158,7 +178,7 @@ func buildCast(astExpr *tree.CastExpr, ctx CompilerContext, query *Query, select
 			},
 		},
 		Typ: typ,
-	}, nil
+	}), nil
 }
 
 func buildCase(astExpr *tree.CaseExpr, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Expr, error) {
@@ -277,7 +297,7 @@ func buildRangeCond(expr *tree.RangeCond, ctx CompilerContext, query *Query, sel
 			return nil, err
 		}
 		funObjRef := getFunctionObjRef("OR")
-		return &plan.Expr{
+		return foldExpr(&plan.Expr{
 			Expr: &plan.Expr_F{
 				F: &plan.Function{
 					Func: funObjRef,
@@ -287,7 +307,7 @@ func buildRangeCond(expr *tree.RangeCond, ctx CompilerContext, query *Query, sel
 			Typ: &plan.Type{
 				Id: plan.Type_BOOL,
 			},
-		}, nil
+		}), nil
 	} else {
 		left, err := getFunctionExprByNameAndExprs(">=", []tree.Expr{expr.Left, expr.From}, ctx, query, selectCtx)
 		if err != nil {
@@ -298,7 +318,7 @@ func buildRangeCond(expr *tree.RangeCond, ctx CompilerContext, query *Query, sel
 			return nil, err
 		}
 		funObjRef := getFunctionObjRef("AND")
-		return &plan.Expr{
+		return foldExpr(&plan.Expr{
 			Expr: &plan.Expr_F{
 				F: &plan.Function{
 					Func: funObjRef,
@@ -308,7 +328,7 @@ func buildRangeCond(expr *tree.RangeCond, ctx CompilerContext, query *Query, sel
 			Typ: &plan.Type{
 				Id: plan.Type_BOOL,
 			},
-		}, nil
+		}), nil
 	}
 }
 
@@ -319,6 +339,20 @@ func buildFunctionExpr(expr *tree.FuncExpr, ctx CompilerContext, query *Query, s
 	}
 	funcName := strings.ToUpper(funcReference.Parts[0])
 
+	if expr.WindowSpec != nil {
+		return buildWindowFunc(expr, funcName, ctx, query, selectCtx)
+	}
+	if sig, ok := BuiltinFunctionsMap[funcName]; ok && sig.Flag == plan.Function_WIN {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("window function '%v' should have a window", funcName))
+	}
+	return buildFunctionCall(expr, funcName, ctx, query, selectCtx)
+}
+
+// buildFunctionCall builds the call of the function funcName, the window
+// of expr is not built
+func buildFunctionCall(expr *tree.FuncExpr, funcName string, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Expr, error) {
+	funcReference := expr.Func.FunctionReference.(*tree.UnresolvedName)
+
 	//todo confirm: change count(*) to count(1)  but count(*) funcReference.Star is false why?
 	if funcName == "COUNT" && funcReference.Star {
 		funObjRef := getFunctionObjRef(funcName)
@@ -81,6 +81,7 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, selectCt
 			subQueryIsCorrelated: false,
 			subQueryParentId:     subQueryParentId,
 			cteTables:            selectCtx.cteTables,
+			hints:                selectCtx.hints,
 		}
 		err := buildSelect(tbl, ctx, query, newCtx)
 		if err != nil {
@@ -107,7 +108,13 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, selectCt
 				TableDef: tableDef,
 			}
 			if isCte {
-				node.NodeType = plan.Node_MATERIAL_SCAN
+				node.NodeType = plan.Node_CTE_SCAN
+				node.SourceStep = getCteStep(query, tableDef)
+				//the scans of a cte may have their own aliases
+				node.TableDef = &plan.TableDef{
+					Name: tableDef.Name,
+					Cols: tableDef.Cols,
+				}
 			} else {
 				node.NodeType = plan.Node_TABLE_SCAN
 			}
@@ -131,6 +138,9 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, selectCt
 			}
 			if !isDerivedTable {
 				fillTableScanProjectList(query, "")
+				if node := query.Nodes[len(query.Nodes)-1]; node.TableDef != nil {
+					addSelectTable(selectCtx, node.TableDef.Name, node.NodeId)
+				}
 			}
 			return false, nil
 		}
@@ -148,6 +158,7 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, selectCt
 			fillTableScanProjectList(query, alias)
 			query.Nodes[len(query.Nodes)-1].TableDef.Name = alias
 		}
+		addSelectTable(selectCtx, alias, query.Nodes[len(query.Nodes)-1].NodeId)
 		return isDerivedTable, nil
 	case *tree.StatementSource:
 		// log.Printf("StatementSource")
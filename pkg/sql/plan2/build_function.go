@@ -68,13 +68,15 @@ func getFunctionExprByNameAndExprs(name string, exprs []tree.Expr, ctx CompilerC
 		args = append(args, expr)
 	}
 
+	inferParamTypes(name, args)
+
 	//Convert input parameter types if necessary
 	returnType, err := covertArgsTypeAndGetReturnType(functionSig, args)
 	if err != nil {
 		return nil, err
 	}
 
-	return &plan.Expr{
+	return foldExpr(&plan.Expr{
 		Expr: &plan.Expr_F{
 			F: &plan.Function{
 				Func: getFunctionObjRef(name),
@@ -82,7 +84,7 @@ func getFunctionExprByNameAndExprs(name string, exprs []tree.Expr, ctx CompilerC
 			},
 		},
 		Typ: returnType,
-	}, nil
+	}), nil
 }
 
 func covertArgsTypeAndGetReturnType(fun *FunctionSig, args []*plan.Expr) (*plan.Type, error) {
@@ -186,6 +188,64 @@ func covertArgsTypeAndGetReturnType(fun *FunctionSig, args []*plan.Expr) (*plan.
 	}
 }
 
+// functions whose parameters are typed as their other arguments
+var paramTypedFunctions = map[string]bool{
+	"=":    true,
+	"<>":   true,
+	"<":    true,
+	"<=":   true,
+	">":    true,
+	">=":   true,
+	"LIKE": true,
+	"IN":   true,
+	"+":    true,
+	"-":    true,
+	"*":    true,
+	"/":    true,
+	"%":    true,
+}
+
+// inferParamTypes types the parameters in args as the first other argument
+func inferParamTypes(name string, args []*plan.Expr) {
+	if !paramTypedFunctions[name] {
+		return
+	}
+	var typ *plan.Type
+	for _, arg := range args {
+		if !isParam(arg) && arg.Typ != nil && arg.Typ.Id != plan.Type_ANY && arg.Typ.Id != plan.Type_TUPLE {
+			typ = arg.Typ
+			break
+		}
+	}
+	if typ == nil {
+		return
+	}
+	setType := func(arg *plan.Expr) {
+		if isParam(arg) && arg.Typ.Id == plan.Type_ANY {
+			arg.Typ = &plan.Type{
+				Id:        typ.Id,
+				Nullable:  true,
+				Width:     typ.Width,
+				Precision: typ.Precision,
+			}
+		}
+	}
+	for _, arg := range args {
+		setType(arg)
+		//the list of IN
+		if list, ok := arg.Expr.(*plan.Expr_List); ok {
+			for _, item := range list.List.List {
+				setType(item)
+			}
+		}
+	}
+}
+
+func isParam(expr *plan.Expr) bool {
+	_, ok := expr.Expr.(*plan.Expr_P)
+	return ok
+}
+
 func appendCastExpr(expr *plan.Expr, toType plan.Type_TypeId) (*plan.Expr, error) {
 	//todo check and cast constant expr in buildding
 	return &plan.Expr{
@@ -16,6 +16,7 @@ package plan2
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -43,6 +44,11 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx
 		}
 	case *tree.ParenSelect:
 		return buildSelect(selectClause.Select, ctx, query, selectCtx)
+	case *tree.UnionClause:
+		projections, err = buildUnionClause(selectClause, ctx, query, selectCtx)
+		if err != nil {
+			return err
+		}
 	default:
 		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
 	}
@@ -57,29 +63,24 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx
 			Children: []int32{rootId},
 		}
 
+		// The window functions of the projection may be referred to
+		selectCtx.windowScope = windowInOrderBy
 		orderBys := make([]*plan.OrderBySpec, 0, len(stmt.OrderBy))
 		for _, order := range stmt.OrderBy {
-			orderBy := &plan.OrderBySpec{}
-			expr, err := buildExpr(order.Expr, ctx, query, selectCtx)
+			orderBy, err := buildOrderBy(order, ctx, query, selectCtx)
 			if err != nil {
 				return err
 			}
-			orderBy.OrderBy = expr
-
-			switch order.Direction {
-			case tree.DefaultDirection:
-				orderBy.OrderByFlags = plan.OrderBySpec_INTERNAL
-			case tree.Ascending:
-				orderBy.OrderByFlags = plan.OrderBySpec_ASC
-			case tree.Descending:
-				orderBy.OrderByFlags = plan.OrderBySpec_DESC
-			}
 			orderBys = append(orderBys, orderBy)
 		}
+		selectCtx.windowScope = windowNotAllowed
 		node.OrderBy = orderBys
 
-		query.Nodes = append(query.Nodes, node)
-		rootId++
+		// The rows sorted for the windows may be sorted so already
+		if !orderSorted(deliveredOrder(query, rootId), orderBys) {
+			query.Nodes = append(query.Nodes, node)
+			rootId++
+		}
 	}
 
 	// Build LIMIT clause
@@ -112,6 +113,26 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx
 	return nil
 }
 
+// buildOrderBy builds a key of ORDER BY
+func buildOrderBy(order *tree.Order, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.OrderBySpec, error) {
+	orderBy := &plan.OrderBySpec{}
+	expr, err := buildExpr(order.Expr, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	orderBy.OrderBy = expr
+
+	switch order.Direction {
+	case tree.DefaultDirection:
+		orderBy.OrderByFlags = plan.OrderBySpec_INTERNAL
+	case tree.Ascending:
+		orderBy.OrderByFlags = plan.OrderBySpec_ASC
+	case tree.Descending:
+		orderBy.OrderByFlags = plan.OrderBySpec_DESC
+	}
+	return orderBy, nil
+}
+
 func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, selectCtx *SelectContext) error {
 	if withExpr == nil {
 		return nil
@@ -119,11 +140,17 @@ func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, selectCtx
 
 	var err error
 	for _, cte := range withExpr.CTEs {
+		//the aliases of the cte body are not seen by the query using it
+		cteCtx := &SelectContext{
+			columnAlias: make(map[string]*plan.Expr),
+			cteTables:   selectCtx.cteTables,
+			hints:       selectCtx.hints,
+		}
 		switch stmt := cte.Stmt.(type) {
 		case *tree.Select:
-			err = buildSelect(stmt, ctx, query, selectCtx)
+			err = buildSelect(stmt, ctx, query, cteCtx)
 		case *tree.ParenSelect:
-			err = buildSelect(stmt.Select, ctx, query, selectCtx)
+			err = buildSelect(stmt.Select, ctx, query, cteCtx)
 		default:
 			err = errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
 		}
@@ -177,12 +204,13 @@ func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, selectCtx
 
 		//set cte table to selectCtx
 		selectCtx.cteTables[strings.ToLower(alias)] = tableDef
+		node.TableDef = tableDef
 		//append node
 		appendQueryNode(query, node, false)
 
-		//set cte table node_id to step
+		//set cte table node_id to step, which computes the cte body instead of its own step
 		cteNodeId := query.Nodes[len(query.Nodes)-1].NodeId
-		query.Steps = append(query.Steps, cteNodeId)
+		query.Steps[len(query.Steps)-1] = cteNodeId
 	}
 
 	return nil
@@ -194,6 +222,7 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 	if err != nil {
 		return nil, err
 	}
+	resolveHints(stmt.Hints, query, selectCtx)
 
 	node := query.Nodes[len(query.Nodes)-1]
 
@@ -207,8 +236,8 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 	}
 
 	//projection
-	//todo get windowspec
 	var projectionList []*plan.Expr
+	selectCtx.windowScope = windowInProjection
 	for _, selectExpr := range stmt.Exprs {
 		expr, err := buildExpr(selectExpr.Expr, ctx, query, selectCtx)
 		if err != nil {
@@ -229,6 +258,7 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 			projectionList = append(projectionList, expr)
 		}
 	}
+	selectCtx.windowScope = windowNotAllowed
 
 	//Agg (group by && having)
 	if stmt.GroupBy != nil || stmt.Having != nil {
@@ -238,15 +268,12 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 
 		//group_by
 		if stmt.GroupBy != nil {
-			exprs := make([]*plan.Expr, 0, len(stmt.GroupBy))
-			for _, groupByExpr := range stmt.GroupBy {
-				expr, err := buildExpr(groupByExpr, ctx, query, selectCtx)
-				if err != nil {
-					return nil, err
-				}
-				exprs = append(exprs, expr)
+			exprs, groupingSets, err := buildGroupBy(stmt.GroupBy, ctx, query, selectCtx)
+			if err != nil {
+				return nil, err
 			}
 			aggNode.GroupBy = exprs
+			aggNode.GroupingSet = groupingSets
 		}
 
 		//having
@@ -260,6 +287,9 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		appendQueryNode(query, aggNode, false)
 	}
 
+	//window
+	planWindows(query, selectCtx)
+
 	//distinct
 	if stmt.Distinct {
 		if stmt.GroupBy != nil {
@@ -274,3 +304,145 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 
 	return projectionList, nil
 }
+
+// buildGroupBy builds the keys of GROUP BY. ROLLUP, CUBE and GROUPING SETS
+// are expanded to the grouping sets, each is an Expr_List of the keys it
+// groups by. There is no grouping set without them
+func buildGroupBy(groupBy tree.GroupBy, ctx CompilerContext, query *Query, selectCtx *SelectContext) ([]*plan.Expr, []*plan.Expr, error) {
+	var keys []*plan.Expr
+
+	keyIdx := make(map[string]int)
+	addKeys := func(e tree.Expr) ([]int, error) {
+		var exprs tree.Exprs
+
+		switch e := e.(type) {
+		case *tree.GroupingSets:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport nested grouping sets: %s", tree.String(e, dialect.MYSQL)))
+		case *tree.Tuple:
+			exprs = e.Exprs
+		default:
+			exprs = tree.Exprs{e}
+		}
+		set := make([]int, 0, len(exprs))
+		for _, expr := range exprs {
+			name := tree.String(expr, dialect.MYSQL)
+			idx, ok := keyIdx[name]
+			if !ok {
+				key, err := buildExpr(expr, ctx, query, selectCtx)
+				if err != nil {
+					return nil, err
+				}
+				idx = len(keys)
+				keyIdx[name] = idx
+				keys = append(keys, key)
+			}
+			set = unionGroupingSet(set, []int{idx})
+		}
+		return set, nil
+	}
+
+	sets := [][]int{{}}
+	hasSets := false
+	for _, expr := range groupBy {
+		gs, ok := expr.(*tree.GroupingSets)
+		if !ok {
+			set, err := addKeys(expr)
+			if err != nil {
+				return nil, nil, err
+			}
+			for i := range sets {
+				sets[i] = unionGroupingSet(sets[i], set)
+			}
+			continue
+		}
+		hasSets = true
+		elems := make([][]int, len(gs.Exprs))
+		for i, e := range gs.Exprs {
+			set, err := addKeys(e)
+			if err != nil {
+				return nil, nil, err
+			}
+			elems[i] = set
+		}
+		gsets, err := expandGroupingSets(gs.Type, elems)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(sets)*len(gsets) > MaxGroupingSets {
+			return nil, nil, errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("too many grouping sets, the limit is %v", MaxGroupingSets))
+		}
+		product := make([][]int, 0, len(sets)*len(gsets))
+		for _, set := range sets {
+			for _, gset := range gsets {
+				product = append(product, unionGroupingSet(set, gset))
+			}
+		}
+		sets = product
+	}
+	if !hasSets {
+		return keys, nil, nil
+	}
+	groupingSets := make([]*plan.Expr, len(sets))
+	for i, set := range sets {
+		list := make([]*plan.Expr, len(set))
+		for j, idx := range set {
+			list[j] = keys[idx]
+		}
+		groupingSets[i] = &plan.Expr{
+			Expr: &plan.Expr_List{
+				List: &plan.ExprList{List: list},
+			},
+		}
+	}
+	return keys, groupingSets, nil
+}
+
+// expandGroupingSets returns the grouping sets of ROLLUP, CUBE or GROUPING
+// SETS of the elements elems
+func expandGroupingSets(typ tree.GroupingSetsType, elems [][]int) ([][]int, error) {
+	switch typ {
+	case tree.Rollup:
+		sets := make([][]int, 0, len(elems)+1)
+		for i := len(elems); i >= 0; i-- {
+			var set []int
+			for _, elem := range elems[:i] {
+				set = unionGroupingSet(set, elem)
+			}
+			sets = append(sets, set)
+		}
+		return sets, nil
+	case tree.Cube:
+		n := len(elems)
+		if n > MaxCubeColumns {
+			return nil, errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("too many columns of cube, the limit is %v", MaxCubeColumns))
+		}
+		sets := make([][]int, 0, 1<<n)
+		for mask := 1<<n - 1; mask >= 0; mask-- {
+			var set []int
+			for i, elem := range elems {
+				if mask&(1<<(n-1-i)) != 0 {
+					set = unionGroupingSet(set, elem)
+				}
+			}
+			sets = append(sets, set)
+		}
+		return sets, nil
+	default:
+		return elems, nil
+	}
+}
+
+// unionGroupingSet returns the sorted union of the keys of a and b
+func unionGroupingSet(a, b []int) []int {
+	set := append([]int{}, a...)
+	for _, idx := range b {
+		i := sort.SearchInts(set, idx)
+		if i < len(set) && set[i] == idx {
+			continue
+		}
+		set = append(set, 0)
+		copy(set[i+1:], set[i:])
+		set[i] = idx
+	}
+	return set
+}
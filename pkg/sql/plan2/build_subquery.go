@@ -32,10 +32,10 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, s
 		subQueryIsCorrelated: false,
 		subQueryParentId:     subQueryParentId,
 		cteTables:            selectCtx.cteTables,
+		hints:                selectCtx.hints,
 	}
 
 	expr := &plan.SubQuery{
-		NodeId:       nodeId,
 		IsCorrelated: false,
 		IsScalar:     false,
 	}
@@ -53,13 +53,8 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, s
 	}
 	expr.IsCorrelated = newCtx.subQueryIsCorrelated
 
-	//move subquery node to top
-	for i := len(query.Nodes) - 1; i >= 0; i-- {
-		if query.Nodes[i].NodeId == nodeId {
-			query.Nodes = append(query.Nodes[i+1:], query.Nodes[:i+1]...)
-			break
-		}
-	}
+	moveSubQueryNodes(query, int32(nowLength-1))
+	expr.NodeId = query.Steps[len(query.Steps)-1]
 
 	returnExpr := &plan.Expr{
 		Expr: &plan.Expr_Sub{
@@ -84,3 +79,55 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, s
 	}
 	return returnExpr, nil
 }
+
+// moveSubQueryNodes moves the node cur, which the nodes of a subquery are
+// built after, behind them as the last node to build on. The ids of the
+// nodes are kept their indexes, the references to them are renumbered
+func moveSubQueryNodes(query *Query, cur int32) {
+	last := int32(len(query.Nodes) - 1)
+	if cur == last {
+		return
+	}
+	renumber := func(id int32) int32 {
+		switch {
+		case id == cur:
+			return last
+		case id > cur && id <= last:
+			return id - 1
+		}
+		return id
+	}
+	node := query.Nodes[cur]
+	query.Nodes = append(query.Nodes[:cur], query.Nodes[cur+1:]...)
+	query.Nodes = append(query.Nodes, node)
+
+	renumberExpr := func(e *plan.Expr) {
+		walkExpr(e, func(e *plan.Expr) {
+			switch ex := e.Expr.(type) {
+			case *plan.Expr_Sub:
+				ex.Sub.NodeId = renumber(ex.Sub.NodeId)
+			case *plan.Expr_Corr:
+				ex.Corr.NodeId = renumber(ex.Corr.NodeId)
+			}
+		})
+	}
+	for _, node := range query.Nodes {
+		node.NodeId = renumber(node.NodeId)
+		for i, child := range node.Children {
+			node.Children[i] = renumber(child)
+		}
+		for _, list := range nodeExprLists(node) {
+			for _, e := range list {
+				renumberExpr(e)
+			}
+		}
+		renumberExpr(node.Limit)
+		renumberExpr(node.Offset)
+		if node.NodeType == plan.Node_CTE_SCAN {
+			node.SourceStep = renumber(node.SourceStep)
+		}
+	}
+	for i, id := range query.Steps {
+		query.Steps[i] = renumber(id)
+	}
+}
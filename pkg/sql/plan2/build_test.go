@@ -266,12 +266,15 @@ func TestNodeTree(t *testing.T) {
 		},
 		// unrelated subquery
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION)": {
-			root: 0,
+			root: 2,
 			nodeType: map[int]plan.Node_NodeType{
-				0: plan.Node_TABLE_SCAN, //nodeid = 1  here is the subquery
-				1: plan.Node_TABLE_SCAN, //nodeid = 0, here is SELECT * FROM NATION where N_REGIONKEY > [subquery]
+				0: plan.Node_TABLE_SCAN, //here is the subquery
+				1: plan.Node_TABLE_SCAN, //here is SELECT * FROM NATION where N_REGIONKEY > [subquery]
+				2: plan.Node_JOIN,       //the subquery unnested
+			},
+			children: map[int][]int32{
+				2: {1, 0},
 			},
-			children: map[int][]int32{},
 		},
 		// related subquery
 		`SELECT * FROM NATION where N_REGIONKEY > 
@@ -279,14 +282,14 @@ func TestNodeTree(t *testing.T) {
 		order by N_NATIONKEY`: {
 			root: 3,
 			nodeType: map[int]plan.Node_NodeType{
-				0: plan.Node_TABLE_SCAN, //nodeid = 1  subquery node，so,wo pop it to top
-				1: plan.Node_AGG,        //nodeid = 2  subquery node，so,wo pop it to top
-				2: plan.Node_TABLE_SCAN, //nodeid = 0
-				3: plan.Node_SORT,       //nodeid = 3
+				0: plan.Node_TABLE_SCAN, //subquery node，so,wo pop it to top
+				1: plan.Node_AGG,        //subquery node，so,wo pop it to top
+				2: plan.Node_TABLE_SCAN,
+				3: plan.Node_SORT,
 			},
 			children: map[int][]int32{
-				1: {1}, //nodeid = 2, have children(NodeId=1, position=0)
-				3: {2}, //nodeid = 3, have children(NodeId=0, position=2)
+				1: {0},
+				3: {2},
 			},
 		},
 	}
@@ -361,6 +364,50 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestGroupingSets(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// map[sql string]the number of keys of each grouping set
+	checkList := map[string][]int{
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY N_NAME, N_REGIONKEY WITH ROLLUP":                        {2, 1, 0},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY ROLLUP(N_NAME, N_REGIONKEY)":                            {2, 1, 0},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY CUBE(N_NAME, N_REGIONKEY)":                              {2, 1, 1, 0},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY GROUPING SETS((N_NAME, N_REGIONKEY), N_NAME, ())":       {2, 1, 0},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY N_NAME, ROLLUP(N_REGIONKEY)":                            {2, 1},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY N_NAME, ROLLUP(N_NAME, N_REGIONKEY)":                    {2, 1, 1},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY ROLLUP(N_NAME), CUBE(N_REGIONKEY)":                      {2, 1, 1, 0},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY ROLLUP((N_NAME, N_REGIONKEY), N_NATIONKEY)":             {3, 2, 0},
+		"SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY GROUPING SETS(N_NAME, N_REGIONKEY) HAVING count(*) > 1": {1, 1},
+	}
+	for sql, sizes := range checkList {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		query := logicPlan.GetQuery()
+		node := query.Nodes[query.Steps[len(query.Steps)-1]]
+		for node.NodeType != plan.Node_AGG {
+			node = query.Nodes[node.Children[0]]
+		}
+		if len(node.GroupingSet) != len(sizes) {
+			t.Fatalf("%v: %v grouping sets, expected %v", sql, len(node.GroupingSet), len(sizes))
+		}
+		for i, set := range node.GroupingSet {
+			if n := len(set.GetList().GetList()); n != sizes[i] {
+				t.Fatalf("%v: grouping set %v has %v keys, expected %v", sql, i, n, sizes[i])
+			}
+		}
+	}
+
+	// should error
+	sqls := []string{
+		"SELECT N_NAME, count(*) FROM NATION GROUP BY CUBE(N_NAME) WITH ROLLUP",                                         // nested grouping sets
+		"SELECT N_NAME, count(*) FROM NATION GROUP BY CUBE(N_NAME), CUBE(N_REGIONKEY), GROUPING SETS(column_not_exist)", // column not exist
+		"SELECT count(*) FROM NATION GROUP BY CUBE(N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME, N_NAME)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 //test jion table plan building
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
@@ -391,6 +438,49 @@ func TestJoinTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+// test set operation plan building
+func TestSetOperation(t *testing.T) {
+	mock := NewMockOptimizer()
+	//should pass
+	sqls := []string{
+		"SELECT N_NAME FROM NATION UNION SELECT R_NAME FROM REGION",
+		"SELECT N_NAME FROM NATION UNION ALL SELECT R_NAME FROM REGION ORDER BY N_NAME LIMIT 10",
+		"SELECT N_NAME, N_REGIONKEY FROM NATION INTERSECT SELECT R_NAME, R_REGIONKEY FROM REGION",
+		"SELECT N_REGIONKEY FROM NATION EXCEPT SELECT R_REGIONKEY FROM REGION WHERE R_NAME = 'ASIA'",
+		"SELECT N_NAME FROM NATION UNION SELECT R_NAME FROM REGION EXCEPT (SELECT C_NAME FROM CUSTOMER INTERSECT SELECT S_NAME FROM SUPPLIER)",
+		"SELECT a FROM (SELECT N_REGIONKEY a FROM NATION UNION SELECT R_REGIONKEY FROM REGION) t WHERE a > 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the customer branch casts c_custkey to the type of o_orderkey
+	logicPlan, err := runOneStmt(mock, t, "SELECT O_ORDERKEY FROM ORDERS UNION SELECT C_CUSTKEY FROM CUSTOMER")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	query := logicPlan.GetQuery()
+	node := query.Nodes[query.Steps[len(query.Steps)-1]]
+	if node.NodeType != plan.Node_UNION || len(node.Children) != 2 {
+		t.Fatalf("unexpected root node %v", node.NodeType)
+	}
+	for _, child := range node.Children {
+		if typ := query.Nodes[child].ProjectList[0].Typ.Id; typ != plan.Type_INT64 {
+			t.Fatalf("branch %v has column type %v, expected %v", child, typ, plan.Type_INT64)
+		}
+	}
+	if typ := node.ProjectList[0].Typ.Id; typ != plan.Type_INT64 {
+		t.Fatalf("column type %v, expected %v", typ, plan.Type_INT64)
+	}
+
+	// should error
+	sqls = []string{
+		"SELECT N_NAME, N_REGIONKEY FROM NATION UNION SELECT R_NAME FROM REGION", //column length not match
+		"SELECT N_NAME FROM NATION UNION SELECT O_ORDERDATE FROM ORDERS",         //type mismatch
+		"SELECT N_NAME FROM NATION INTERSECT ALL SELECT R_NAME FROM REGION",      //intersect all
+		"SELECT N_NAME FROM NATION EXCEPT SELECT column_not_exist FROM REGION",   //column not exist
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 //test derived table plan building
 func TestDerivedTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
@@ -0,0 +1,147 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildUnionClause builds the branches of a set operation and a node with
+// both as children. The type of each column is bound to the higher type of
+// the branches, the branch of a lower type casts the column
+func buildUnionClause(stmt *tree.UnionClause, ctx CompilerContext, query *Query, selectCtx *SelectContext) ([]*plan.Expr, error) {
+	var nodeType plan.Node_NodeType
+
+	switch stmt.Type {
+	case tree.UNION:
+		if stmt.All {
+			nodeType = plan.Node_UNION_ALL
+		} else {
+			nodeType = plan.Node_UNION
+		}
+	case tree.INTERSECT:
+		nodeType = plan.Node_INTERSECT
+	case tree.EXCEPT:
+		nodeType = plan.Node_MINUS
+	}
+	if stmt.All && nodeType != plan.Node_UNION_ALL {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport set operation: %s all", stmt.Type))
+	}
+
+	left, err := buildUnionBranch(stmt.Left, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	right, err := buildUnionBranch(stmt.Right, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	if len(left.ProjectList) != len(right.ProjectList) {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("the used SELECT statements have a different number of columns: %s", tree.String(stmt, dialect.MYSQL)))
+	}
+
+	projections := make([]*plan.Expr, len(left.ProjectList))
+	for i, col := range left.ProjectList {
+		typ, err := getUnionColumnType(col.Typ, right.ProjectList[i].Typ)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range []*plan.Node{left, right} {
+			if expr := node.ProjectList[i]; expr.Typ.GetId() != typ.GetId() {
+				castExpr, err := appendCastExpr(expr, typ.GetId())
+				if err != nil {
+					return nil, err
+				}
+				castExpr.Alias = expr.Alias
+				node.ProjectList[i] = castExpr
+			}
+		}
+		projections[i] = &plan.Expr{
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					Name:   col.Alias,
+					RelPos: 0,
+					ColPos: int32(i),
+				},
+			},
+			Alias: col.Alias,
+			Typ:   typ,
+		}
+	}
+
+	node := &plan.Node{
+		NodeType:    nodeType,
+		Children:    []int32{left.NodeId, right.NodeId},
+		ProjectList: projections,
+	}
+	appendQueryNode(query, node, false)
+	return projections, nil
+}
+
+// buildUnionBranch builds a branch of a set operation with its own context
+// and returns the root node of the branch
+func buildUnionBranch(stmt tree.SelectStatement, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Node, error) {
+	newCtx := &SelectContext{
+		columnAlias:      make(map[string]*plan.Expr),
+		subQueryParentId: selectCtx.subQueryParentId,
+		cteTables:        selectCtx.cteTables,
+		hints:            selectCtx.hints,
+	}
+	err := buildSelect(&tree.Select{Select: stmt}, ctx, query, newCtx)
+	if err != nil {
+		return nil, err
+	}
+	query.Steps = query.Steps[:len(query.Steps)-1]
+	return query.Nodes[len(query.Nodes)-1], nil
+}
+
+// getUnionColumnType returns the type a column of both branches is bound to
+func getUnionColumnType(left, right *plan.Type) (*plan.Type, error) {
+	if left.GetId() == right.GetId() {
+		if right.GetWidth() > left.GetWidth() {
+			return right, nil
+		}
+		return left, nil
+	}
+	if isStringType(left.GetId()) && isStringType(right.GetId()) {
+		width := left.Width
+		if right.Width > width {
+			width = right.Width
+		}
+		return &plan.Type{
+			Id:       plan.Type_VARCHAR,
+			Nullable: left.GetNullable() || right.GetNullable(),
+			Width:    width,
+		}, nil
+	}
+	highType, ok := CastLowTypeToHighTypeMap[left.GetId()][right.GetId()]
+	if !ok {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("type mismatch in set operation: %v and %v", left.GetId(), right.GetId()))
+	}
+	return &plan.Type{
+		Id:       highType,
+		Nullable: left.GetNullable() || right.GetNullable(),
+	}, nil
+}
+
+func isStringType(id plan.Type_TypeId) bool {
+	return id == plan.Type_CHAR || id == plan.Type_VARCHAR
+}
@@ -130,6 +130,14 @@ func fillTableScanProjectList(query *Query, alias string) {
 	node.ProjectList = exprs
 }
 
+//addSelectTable records the table of the from clause which the hints refer to by alias
+func addSelectTable(selectCtx *SelectContext, alias string, nodeId int32) {
+	if selectCtx.tables == nil {
+		selectCtx.tables = make(map[string]int32)
+	}
+	selectCtx.tables[strings.ToLower(alias)] = nodeId
+}
+
 func fillJoinProjectList(node *plan.Node, leftNode *plan.Node, rightNode *plan.Node) {
 	exprs := make([]*plan.Expr, 0, len(leftNode.ProjectList)+len(rightNode.ProjectList))
 	for idx, expr := range leftNode.ProjectList {
@@ -361,6 +369,17 @@ func getResolveTable(tblName string, ctx CompilerContext, selectCtx *SelectConte
 	return nil, nil, false
 }
 
+//getCteStep get the material node of the cte table, which is the root of its step
+func getCteStep(query *Query, tableDef *plan.TableDef) int32 {
+	for _, id := range query.Steps {
+		node := query.Nodes[id]
+		if node.NodeType == plan.Node_MATERIAL && node.TableDef == tableDef {
+			return id
+		}
+	}
+	return -1
+}
+
 //getLastTableDef get insert/update/delete tableDef
 func getLastTableDef(query *Query) (*plan.ObjectRef, *plan.TableDef) {
 	node := query.Nodes[query.Steps[len(query.Steps)-1]]
@@ -380,6 +399,7 @@ func newQueryAndSelectCtx(typ plan.Query_StatementType) (*Query, *SelectContext)
 	selectCtx := &SelectContext{
 		columnAlias: make(map[string]*plan.Expr),
 		cteTables:   make(map[string]*plan.TableDef),
+		hints:       &planHints{},
 	}
 	query := &Query{
 		StmtType: typ,
@@ -0,0 +1,166 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"google.golang.org/protobuf/proto"
+)
+
+// inlineCTEs inlines the common table expressions scanned once, and the
+// ones cheaper to compute for each of their scans than to materialize.
+// The scans of an inlined one are replaced by the projections of copies of
+// its body, whose filters are pushed down as the ones of derived tables.
+// The others are materialized once by their steps and read by the scans
+func (o *optimizer) inlineCTEs() {
+	// The later ones may scan the earlier ones in their bodies, which are
+	// copied before the earlier ones count their scans
+	for i := len(o.query.Steps) - 1; i >= 0; i-- {
+		id := o.query.Steps[i]
+		node, ok := o.nodes[id]
+		if !ok || node.NodeType != plan.Node_MATERIAL || len(node.Children) != 1 {
+			continue
+		}
+		var scans []*Node
+		for _, scan := range o.query.Nodes {
+			if scan.NodeType == plan.Node_CTE_SCAN && scan.SourceStep == id {
+				scans = append(scans, scan)
+			}
+		}
+		body := node.Children[0]
+		if len(scans) > 1 {
+			if !o.copyable(body) {
+				o.trace.add(TraceInlineCTE, id, fmt.Sprintf("materialized for %d scans, the body has subqueries or correlated columns", len(scans)))
+				continue
+			}
+			inlined, materialized := o.inlineCosts(id, len(scans))
+			alts := choose([]*TraceAlternative{
+				{Plan: "inline", Cost: inlined, Chosen: inlined <= materialized},
+				{Plan: "materialize", Cost: materialized, Chosen: inlined > materialized},
+			}, "costs more")
+			o.trace.add(TraceInlineCTE, id, fmt.Sprintf("%d scans", len(scans)), alts...)
+			if inlined > materialized {
+				continue
+			}
+		} else if len(scans) == 1 {
+			o.trace.add(TraceInlineCTE, id, "inlined into its only scan")
+		}
+		o.query.Steps = append(o.query.Steps[:i], o.query.Steps[i+1:]...)
+		node.Children = nil
+		for j, scan := range scans {
+			child := body
+			if j > 0 {
+				child = o.copyTree(body)
+			}
+			scan.NodeType = plan.Node_PROJECT
+			scan.Children = []int32{child}
+			scan.SourceStep = 0
+			scan.ObjRef, scan.TableDef = nil, nil
+		}
+	}
+}
+
+// inlineCosts returns the costs of computing the body of the common table
+// expression of the material node id for each of its n scans, and of
+// computing it once, writing its rows and reading them n times
+func (o *optimizer) inlineCosts(id int32, n int) (float64, float64) {
+	// The estimates do not rewrite the nodes
+	est := &optimizer{
+		ctx:           o.ctx,
+		query:         o.query,
+		nodes:         o.nodes,
+		stats:         o.stats,
+		rels:          make(map[int32]*relStats),
+		steps:         make(map[int32]bool),
+		pinned:        make(map[int32]bool),
+		reordered:     make(map[int32]bool),
+		noJoinReorder: true,
+	}
+	for _, node := range o.query.Nodes {
+		est.pinned[node.NodeId] = true
+	}
+	for _, step := range o.query.Steps {
+		est.steps[step] = true
+	}
+	body := o.nodes[id].Children[0]
+	rel := est.visit(body)
+	total := o.nodes[body].Cost.GetTotal()
+	for visited := range est.rels {
+		if node, ok := o.nodes[visited]; ok {
+			node.Cost = nil
+			node.ExtraOptions = ""
+		}
+	}
+	return float64(n) * total, total + float64(n+1)*rel.card
+}
+
+// copyable returns true if the nodes below id have no subqueries and no
+// correlated columns, which are bound to the nodes of the body
+func (o *optimizer) copyable(id int32) bool {
+	node, ok := o.nodes[id]
+	if !ok {
+		return false
+	}
+	for _, list := range nodeExprLists(node) {
+		for _, e := range list {
+			if hasSubquery(e) || hasCorrelated(e) {
+				return false
+			}
+		}
+	}
+	for _, child := range node.Children {
+		if !o.copyable(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// copyTree copies the nodes below id and returns the id of the copy
+func (o *optimizer) copyTree(id int32) int32 {
+	node := proto.Clone(o.nodes[id]).(*Node)
+	for i, child := range node.Children {
+		node.Children[i] = o.copyTree(child)
+	}
+	node.NodeId = int32(len(o.query.Nodes))
+	o.query.Nodes = append(o.query.Nodes, node)
+	o.nodes[node.NodeId] = node
+	return node.NodeId
+}
+
+// estimateCteScan estimates a scan of a materialized common table
+// expression by the estimate of its step
+func (o *optimizer) estimateCteScan(node *Node) *relStats {
+	src := o.visit(node.SourceStep)
+	rel := &relStats{card: src.card, analyzed: src.analyzed}
+	for _, e := range node.WhereList {
+		rel.card *= selectivity(e, src.cols)
+	}
+	rel.cols = projectCols(node, src.cols)
+	rel.capNdv()
+	cost := &Cost{}
+	if c := o.nodes[node.SourceStep].GetCost(); c != nil {
+		cost = c
+	}
+	node.Cost = &Cost{
+		Card:    rel.card,
+		Ndv:     rel.ndv(),
+		Rowsize: cost.Rowsize,
+		Total:   src.card,
+	}
+	return rel
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func nodesOf(query *Query, typ plan.Node_NodeType) []*Node {
+	var nodes []*Node
+	for _, node := range query.Nodes {
+		if node.NodeType == typ {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+func TestInlineCTE(t *testing.T) {
+	// Scanned once
+	sql := "WITH T AS (SELECT N_NATIONKEY AS K, N_NAME FROM NATION) SELECT N_NAME FROM T WHERE K > 10"
+	query := buildWithStats(t, sql, true)
+	if scans := nodesOf(query, plan.Node_CTE_SCAN); len(scans) != 0 || len(query.Steps) != 1 {
+		t.Fatalf("the cte should be inlined, %v %v", scans, query.Steps)
+	}
+	if nation := scanOf(query, "nation"); len(nation.WhereList) != 1 {
+		t.Fatalf("the filter should be pushed down into the cte, %v", nation.WhereList)
+	}
+
+	// Cheaper to scan the small table twice
+	sql = "WITH T AS (SELECT N_NATIONKEY AS K FROM NATION) SELECT A.K FROM T A, T B WHERE A.K = B.K"
+	query = buildWithStats(t, sql, true)
+	if scans := nodesOf(query, plan.Node_CTE_SCAN); len(scans) != 0 || len(query.Steps) != 1 {
+		t.Fatalf("the cte should be inlined, %v %v", scans, query.Steps)
+	}
+	scans := 0
+	for _, node := range query.Nodes {
+		if scanName(query, node.NodeId) == "nation" {
+			scans++
+		}
+	}
+	if scans != 2 {
+		t.Fatalf("the body of the cte should be copied for each of its scans, %v", query)
+	}
+	for i, node := range query.Nodes {
+		if node.NodeId != int32(i) {
+			t.Fatalf("node %d has the id %d", i, node.NodeId)
+		}
+	}
+}
+
+func TestMaterializeCTE(t *testing.T) {
+	sql := `WITH REVENUE AS (SELECT L_SUPPKEY AS SUPPLIER_NO, SUM(L_EXTENDEDPRICE) AS TOTAL FROM LINEITEM GROUP BY L_SUPPKEY)
+		SELECT S_NAME FROM SUPPLIER, REVENUE WHERE S_SUPPKEY = SUPPLIER_NO AND TOTAL = (SELECT MAX(TOTAL) FROM REVENUE)`
+	query := buildWithStats(t, sql, true)
+	scans := nodesOf(query, plan.Node_CTE_SCAN)
+	if len(scans) != 2 || len(query.Steps) != 2 {
+		t.Fatalf("the cte should be materialized, %v %v", scans, query.Steps)
+	}
+	material := query.Nodes[query.Steps[0]]
+	if material.NodeType != plan.Node_MATERIAL {
+		t.Fatalf("the first step should materialize the cte but now is %v", material.NodeType)
+	}
+	for _, scan := range scans {
+		if scan.SourceStep != material.NodeId {
+			t.Fatalf("the scan should read step %d but now reads %d", material.NodeId, scan.SourceStep)
+		}
+		if scan.Cost == nil || scan.Cost.Card != material.Cost.Card {
+			t.Fatalf("the scan should be estimated by the cte, %v %v", scan.Cost, material.Cost)
+		}
+	}
+}
@@ -60,7 +60,7 @@ func describeExpr(expr *plan.Expr, options *ExplainOptions) (string, error) {
 		case *plan.Expr_V:
 			panic("unimplement Expr_V")
 		case *plan.Expr_P:
-			panic("unimplement Expr_P")
+			result += "$" + strconv.FormatInt(int64(expr.Expr.(*plan.Expr_P).P.Pos)+1, 10)
 		case *plan.Expr_List:
 			exprlist := expr.Expr.(*plan.Expr_List)
 			if exprlist.List.List != nil {
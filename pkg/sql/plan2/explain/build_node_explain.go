@@ -18,7 +18,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var _ NodeDescribe = &NodeDescribeImpl{}
@@ -51,6 +54,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 		pname = "External Scan"
 	case plan.Node_MATERIAL_SCAN:
 		pname = "Material Scan"
+	case plan.Node_CTE_SCAN:
+		pname = "CTE Scan"
 	case plan.Node_PROJECT:
 		pname = "Project"
 	case plan.Node_EXTERNAL_FUNCTION:
@@ -79,6 +84,10 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 		pname = "Unique"
 	case plan.Node_WINDOW:
 		pname = "Window"
+	case plan.Node_INTERSECT:
+		pname = "Intersect"
+	case plan.Node_MINUS:
+		pname = "Minus"
 	case plan.Node_BROADCAST:
 		pname = "Broadcast"
 	case plan.Node_SPLIT:
@@ -98,7 +107,7 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 	}
 
 	// Get Node's operator object info ,such as table, view
-	if options.Format == EXPLAIN_FORMAT_TEXT {
+	if options.Format == EXPLAIN_FORMAT_TEXT || options.Format == EXPLAIN_FORMAT_DOT {
 		result += pname
 		switch ndesc.Node.NodeType {
 		case plan.Node_VALUE_SCAN:
@@ -111,6 +120,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_MATERIAL_SCAN:
 			fallthrough
+		case plan.Node_CTE_SCAN:
+			fallthrough
 		case plan.Node_INSERT:
 			fallthrough
 		case plan.Node_UPDATE:
@@ -150,6 +161,10 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_WINDOW:
 			fallthrough
+		case plan.Node_INTERSECT:
+			fallthrough
+		case plan.Node_MINUS:
+			fallthrough
 		case plan.Node_BROADCAST:
 			fallthrough
 		case plan.Node_SPLIT:
@@ -167,17 +182,51 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 
 	// Get Costs info of Node
 	if options.Format == EXPLAIN_FORMAT_TEXT {
-		result += " (cost=%.2f..%.2f rows=%.0f width=%f)"
+		// The estimates of the optimizer are explained under VERBOSE
+		if options.Verbose && ndesc.Node.Cost != nil {
+			costDescImpl := &CostDescribeImpl{
+				Cost: ndesc.Node.Cost,
+			}
+			costInfo, err := costDescImpl.GetDescription(options)
+			if err != nil {
+				return result, err
+			}
+			result += " " + costInfo
+		} else {
+			result += " (cost=%.2f..%.2f rows=%.0f width=%f)"
+		}
+		// The runtime statistics next to the estimates
+		if options.Anzlyze && ndesc.Node.AnalyzeInfo != nil {
+			analyzeDescImpl := &AnalyzeInfoDescribeImpl{
+				AnalyzeInfo: ndesc.Node.AnalyzeInfo,
+			}
+			result += " " + analyzeDescImpl.GetActual()
+		}
 	} else if options.Format == EXPLAIN_FORMAT_JSON {
 		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format json")
 	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+		// The estimated rows are on the edges of the graph
+		if options.Anzlyze && ndesc.Node.AnalyzeInfo != nil {
+			analyzeDescImpl := &AnalyzeInfoDescribeImpl{
+				AnalyzeInfo: ndesc.Node.AnalyzeInfo,
+			}
+			result += " " + analyzeDescImpl.GetActual()
+		}
 	}
 	return result, nil
 }
 
 func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string, error) {
 	lines := make([]string, 0)
+	// Get Access Method info of the scans
+	if options.Verbose && ndesc.Node.NodeType == plan.Node_TABLE_SCAN {
+		accessInfo, err := ndesc.GetAccessInfo(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, accessInfo)
+	}
+
 	// Get Sort list info
 	if ndesc.Node.OrderBy != nil {
 		orderByInfo, err := ndesc.GetOrderByInfo(options)
@@ -205,6 +254,24 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, groupByInfo)
 	}
 
+	// Get Grouping Sets info
+	if ndesc.Node.GroupingSet != nil {
+		groupingSetsInfo, err := ndesc.GetGroupingSetsInfo(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, groupingSetsInfo)
+	}
+
+	// Get Window info
+	if ndesc.Node.WinSpec != nil {
+		winSpecInfo, err := NewWinSpecDescribeImpl(ndesc.Node.WinSpec).GetDescription(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, winSpecInfo)
+	}
+
 	// Get Filter list info
 	if ndesc.Node.WhereList != nil {
 		filterInfo, err := ndesc.GetWhereConditionInfo(options)
@@ -256,6 +323,57 @@ func (ndesc *NodeDescribeImpl) GetProjectListInfo(options *ExplainOptions) (stri
 	return result, nil
 }
 
+// GetAccessInfo returns how the table of a scan is accessed, the blocks of
+// which are read in full, pruned by the zone maps of the columns of the
+// prune conditions, or the rows of which are looked up by a secondary index
+func (ndesc *NodeDescribeImpl) GetAccessInfo(options *ExplainOptions) (string, error) {
+	//Access Method: zonemap scan on (n_nationkey), Prune Cond: (n_nationkey > 0), Blocks: 3
+	//Access Method: index scan using n_name_idx, Index Cond: (n_name = 'CHINA'), Blocks: 1
+	var result string = "Access Method: "
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		info := ndesc.Node.GetPruneInfo()
+		index := plan2.IndexPredicate(ndesc.Node)
+		if index != nil {
+			descV, err := describeExpr(index, options)
+			if err != nil {
+				return result, err
+			}
+			result += "index scan using " + info.GetIndex() + ", Index Cond: " + descV
+		} else if len(info.GetPredicates()) == 0 {
+			result += "full scan"
+		} else {
+			result += "zonemap scan"
+		}
+		// The index hints restrict the columns pruned by
+		if index == nil && info.GetRestricted() && len(info.GetColumns()) > 0 {
+			result += " on (" + strings.Join(info.GetColumns(), ", ") + ")"
+		}
+		if index == nil && len(info.GetPredicates()) > 0 {
+			result += ", Prune Cond: "
+			for i, v := range info.GetPredicates() {
+				if i > 0 {
+					result += " AND "
+				}
+				descV, err := describeExpr(v, options)
+				if err != nil {
+					return result, err
+				}
+				result += descV
+			}
+		}
+		if info.GetDeferred() {
+			result += ", Blocks: pruned at execution"
+		} else if info.GetResolved() {
+			result += ", Blocks: " + strconv.Itoa(len(info.GetBlocks()))
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetJoinConditionInfo(options *ExplainOptions) (string, error) {
 	var result string = "Join Cond:"
 	exprs := NewExprListDescribeImpl(ndesc.Node.OnList)
@@ -313,6 +431,36 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(options *ExplainOptions) (string,
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetGroupingSetsInfo(options *ExplainOptions) (string, error) {
+	var result string = "Grouping Sets: "
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		var first bool = true
+		for _, v := range ndesc.Node.GetGroupingSet() {
+			if !first {
+				result += ", "
+			}
+			first = false
+			result += "("
+			for i, key := range v.GetList().GetList() {
+				if i > 0 {
+					result += ", "
+				}
+				descV, err := describeExpr(key, options)
+				if err != nil {
+					return result, err
+				}
+				result += descV
+			}
+			result += ")"
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetOrderByInfo(options *ExplainOptions) (string, error) {
 	var result string = "Sort Key:"
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -349,16 +497,44 @@ type CostDescribeImpl struct {
 }
 
 func (c *CostDescribeImpl) GetDescription(options *ExplainOptions) (string, error) {
-	//(cost=11.75..13.15 rows=140 width=4)
+	//(cost=11.75..13.15 rows=140 ndv=20 width=4)
 	var result string = "(cost=" +
 		strconv.FormatFloat(c.Cost.Start, 'f', 2, 64) +
 		".." + strconv.FormatFloat(c.Cost.Total, 'f', 2, 64) +
-		" rows=" + strconv.FormatFloat(c.Cost.Card, 'f', 2, 64) +
-		" ndv=" + strconv.FormatFloat(c.Cost.Ndv, 'f', 2, 64) +
-		" width=" + strconv.FormatFloat(c.Cost.Rowsize, 'f', 0, 64)
+		" rows=" + strconv.FormatFloat(c.Cost.Card, 'f', 0, 64) +
+		" ndv=" + strconv.FormatFloat(c.Cost.Ndv, 'f', 0, 64) +
+		" width=" + strconv.FormatFloat(c.Cost.Rowsize, 'f', 0, 64) + ")"
 	return result, nil
 }
 
+type AnalyzeInfoDescribeImpl struct {
+	AnalyzeInfo *plan.AnalyzeInfo
+}
+
+func (a *AnalyzeInfoDescribeImpl) GetDescription(options *ExplainOptions) (string, error) {
+	//Analyze: inputRows=140 outputRows=20 inputBatches=2 outputBatches=1 timeConsumed=1.2ms memoryPeak=4096 spillBytes=0
+	info := a.AnalyzeInfo
+	var result string = "Analyze: inputRows=" + strconv.FormatInt(info.InputRows, 10) +
+		" outputRows=" + strconv.FormatInt(info.OutputRows, 10) +
+		" inputBatches=" + strconv.FormatInt(info.InputBatches, 10) +
+		" outputBatches=" + strconv.FormatInt(info.OutputBatches, 10) +
+		" timeConsumed=" + time.Duration(info.TimeConsumed).String() +
+		" memoryPeak=" + strconv.FormatInt(info.MemoryPeak, 10) +
+		" spillBytes=" + strconv.FormatInt(info.SpillBytes, 10)
+	return result, nil
+}
+
+// GetActual returns the rows, the calls, the time and the memory peak of the
+// node, which are explained next to its estimates
+func (a *AnalyzeInfoDescribeImpl) GetActual() string {
+	//(actual rows=24 loops=3 time=1.2ms memory=4096)
+	info := a.AnalyzeInfo
+	return "(actual rows=" + strconv.FormatInt(info.OutputRows, 10) +
+		" loops=" + strconv.FormatInt(info.Calls, 10) +
+		" time=" + time.Duration(info.TimeConsumed).String() +
+		" memory=" + strconv.FormatInt(info.MemoryPeak, 10) + ")"
+}
+
 type ExprListDescribeImpl struct {
 	ExprList []*plan.Expr // ProjectList,OnList,WhereList,GroupBy,GroupingSet and so on
 }
@@ -420,9 +596,73 @@ type WinSpecDescribeImpl struct {
 	WinSpec *plan.WindowSpec
 }
 
+func NewWinSpecDescribeImpl(WinSpec *plan.WindowSpec) *WinSpecDescribeImpl {
+	return &WinSpecDescribeImpl{
+		WinSpec: WinSpec,
+	}
+}
+
 func (w *WinSpecDescribeImpl) GetDescription(options *ExplainOptions) (string, error) {
-	//TODO implement me
-	panic("implement me")
+	//Window: sum(a), rank() over (partition by b order by c ASC range between unbounded preceding and current row)
+	var result string = "Window:"
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		funcs, err := NewExprListDescribeImpl(w.WinSpec.GetWindowFuncs()).GetDescription(options)
+		if err != nil {
+			return result, err
+		}
+		result += funcs + " over ("
+		var clauses []string
+		if len(w.WinSpec.GetPartitionBy()) > 0 {
+			partitionBy, err := NewExprListDescribeImpl(w.WinSpec.GetPartitionBy()).GetDescription(options)
+			if err != nil {
+				return result, err
+			}
+			clauses = append(clauses, "partition by"+partitionBy)
+		}
+		if len(w.WinSpec.GetOderyBy()) > 0 {
+			orderBy := "order by"
+			for i, v := range w.WinSpec.GetOderyBy() {
+				if i > 0 {
+					orderBy += ","
+				}
+				describe, err := NewOrderByDescribeImpl(v).GetDescription(options)
+				if err != nil {
+					return result, err
+				}
+				orderBy += describe
+			}
+			clauses = append(clauses, orderBy)
+		}
+		if frame := w.WinSpec.GetFrame(); frame != nil {
+			start, err := describeFrameBound(frame.GetStart(), options)
+			if err != nil {
+				return result, err
+			}
+			end, err := describeFrameBound(frame.GetEnd(), options)
+			if err != nil {
+				return result, err
+			}
+			clauses = append(clauses, strings.ToLower(frame.GetType().String())+" between "+start+" and "+end)
+		}
+		result += strings.Join(clauses, " ") + ")"
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+	}
+	return result, nil
+}
+
+func describeFrameBound(bound *plan.FrameBound, options *ExplainOptions) (string, error) {
+	kind := strings.ToLower(strings.ReplaceAll(bound.GetType().String(), "_", " "))
+	if bound.GetOffset() == nil {
+		return kind, nil
+	}
+	offset, err := describeExpr(bound.GetOffset(), options)
+	if err != nil {
+		return "", err
+	}
+	return offset + " " + kind, nil
 }
 
 type RowsetDataDescribeImpl struct {
@@ -15,8 +15,12 @@
 package explain
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/errno"
-	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 )
@@ -25,6 +29,9 @@ var _ ExplainQuery = &ExplainQueryImpl{}
 
 type ExplainQueryImpl struct {
 	QueryPlan *plan.Query
+	// TotalTime is the wall time of the execution analyzed, the time of the
+	// nodes is summed up if it is zero
+	TotalTime time.Duration
 }
 
 func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
@@ -34,9 +41,11 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	if options.Format == EXPLAIN_FORMAT_DOT {
+		return e.explainDot(buffer, options)
+	}
 	var Nodes []*plan.Node = e.QueryPlan.Nodes
-	for index, rootNodeId := range e.QueryPlan.Steps {
-		logutil.Infof("------------------------------------Query Plan-%v ---------------------------------------------", index)
+	for _, rootNodeId := range e.QueryPlan.Steps {
 		settings := FormatSettings{
 			buffer: buffer,
 			offset: 0,
@@ -51,9 +60,119 @@ func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *Expla
 	return nil
 }
 
+// ExplainAnalyze explains the plan with the runtime statistics of the
+// nodes filled by the execution
 func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) error {
-	//TODO implement me
-	panic("implement me")
+	opts := *options
+	opts.Anzlyze = true
+	if err := e.ExplainPlan(buffer, &opts); err != nil {
+		return err
+	}
+	if opts.Format != EXPLAIN_FORMAT_TEXT {
+		return nil
+	}
+
+	// Summary of the execution
+	var rows, memory, spill int64
+	total := e.TotalTime
+	for _, node := range e.QueryPlan.Nodes {
+		info := node.AnalyzeInfo
+		if info == nil {
+			continue
+		}
+		if e.TotalTime == 0 {
+			total += time.Duration(info.TimeConsumed)
+		}
+		if info.MemoryPeak > memory {
+			memory = info.MemoryPeak
+		}
+		spill += info.SpillBytes
+	}
+	if steps := e.QueryPlan.Steps; len(steps) > 0 {
+		rows = e.QueryPlan.Nodes[steps[len(steps)-1]].GetAnalyzeInfo().GetOutputRows()
+	}
+	buffer.PushNewLine("Summary:", true, 0)
+	buffer.PushNewLine("Total Time: "+total.String(), false, 0)
+	buffer.PushNewLine("Output Rows: "+strconv.FormatInt(rows, 10), false, 0)
+	buffer.PushNewLine("Memory Peak: "+strconv.FormatInt(memory, 10), false, 0)
+	buffer.PushNewLine("Spill Bytes: "+strconv.FormatInt(spill, 10), false, 0)
+	return nil
+}
+
+// explainDot explains the plan as a graphviz digraph, a node of which is
+// labeled by the operator and its properties, and an edge of which, from a
+// child to its parent, by the rows estimated for the child
+func (e *ExplainQueryImpl) explainDot(buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	// The properties are described as the text format
+	textOptions := *options
+	textOptions.Format = EXPLAIN_FORMAT_TEXT
+
+	Nodes := e.QueryPlan.Nodes
+	visited := make(map[int32]bool)
+	var visit func(id int32) error
+	visit = func(id int32) error {
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+		node := Nodes[id]
+		label, err := dotLabel(node, options, &textOptions)
+		if err != nil {
+			return err
+		}
+		buffer.PushNewLine(fmt.Sprintf("n%d [label=\"%s\"];", id, label), false, 0)
+		for _, child := range node.Children {
+			rows := "?"
+			if cost := Nodes[child].GetCost(); cost != nil {
+				rows = strconv.FormatFloat(cost.GetCard(), 'f', 0, 64)
+			}
+			buffer.PushNewLine(fmt.Sprintf("n%d -> n%d [label=\"rows=%s\"];", child, id, rows), false, 0)
+			if err = visit(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	buffer.PushNewLine("digraph plan {", true, 0)
+	buffer.PushNewLine("node [shape=box];", false, 0)
+	for _, rootNodeId := range e.QueryPlan.Steps {
+		if err := visit(rootNodeId); err != nil {
+			return err
+		}
+	}
+	buffer.PushNewLine("}", true, 0)
+	return nil
+}
+
+// dotLabel returns the label of node in a digraph, the lines of which are
+// left justified
+func dotLabel(node *plan.Node, options *ExplainOptions, textOptions *ExplainOptions) (string, error) {
+	nodedescImpl := NewNodeDescriptionImpl(node)
+	basicNodeInfo, err := nodedescImpl.GetNodeBasicInfo(options)
+	if err != nil {
+		return "", err
+	}
+	lines := []string{basicNodeInfo}
+	if options.Verbose && node.GetProjectList() != nil {
+		projecrtInfo, err := nodedescImpl.GetProjectListInfo(textOptions)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, projecrtInfo)
+	}
+	extraInfo, err := nodedescImpl.GetExtraInfo(textOptions)
+	if err != nil {
+		return "", err
+	}
+	lines = append(lines, extraInfo...)
+
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var label string
+	for _, line := range lines {
+		label += escaper.Replace(line) + `\l`
+	}
+	return label, nil
 }
 
 func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOptions) error {
@@ -97,6 +216,18 @@ func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOpti
 		for _, line := range extraInfo {
 			settings.buffer.PushNewLine(line, false, settings.level)
 		}
+
+		// Process analyze option information, "Analyze:"
+		if options.Anzlyze && nodedescImpl.Node.AnalyzeInfo != nil {
+			analyzeDescImpl := &AnalyzeInfoDescribeImpl{
+				AnalyzeInfo: nodedescImpl.Node.AnalyzeInfo,
+			}
+			analyzeInfo, err := analyzeDescImpl.GetDescription(options)
+			if err != nil {
+				return err
+			}
+			settings.buffer.PushNewLine(analyzeInfo, false, settings.level)
+		}
 	} else if options.Format == EXPLAIN_FORMAT_JSON {
 		return errors.New(errno.FeatureNotSupported, "unimplement explain format json")
 	} else if options.Format == EXPLAIN_FORMAT_DOT {
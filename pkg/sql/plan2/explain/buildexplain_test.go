@@ -17,6 +17,7 @@ package explain
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -24,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestSingleSql(t *testing.T) {
@@ -79,8 +81,14 @@ func TestSingleTableQuery(t *testing.T) {
 		"explain verbose SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10", //test agg
 		"explain SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10",         //test agg
 		"explain verbose SELECT DISTINCT N_NAME FROM NATION limit 10",
-		"explain verbose SELECT DISTINCT N_NAME FROM NATION", //test distinct
-		"explain SELECT DISTINCT N_NAME FROM NATION",         //test distinct
+		"explain verbose SELECT DISTINCT N_NAME FROM NATION",                                                    //test distinct
+		"explain SELECT DISTINCT N_NAME FROM NATION",                                                            //test distinct
+		"explain verbose SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY ROLLUP(N_NAME, N_REGIONKEY)", //test grouping sets
+		"explain SELECT N_NAME, N_REGIONKEY, count(*) FROM NATION GROUP BY GROUPING SETS(N_NAME, (N_NAME, N_REGIONKEY), ())",
+		"explain verbose SELECT N_NAME, rank() over (partition by N_REGIONKEY order by N_NATIONKEY desc), sum(N_NATIONKEY) over (partition by N_REGIONKEY) FROM NATION", //test window
+		"explain SELECT N_NAME, sum(N_NATIONKEY) over (order by N_NATIONKEY rows between 2 preceding and current row) FROM NATION ORDER BY N_NATIONKEY",
+		"explain verbose SELECT N_NAME FROM NATION UNION SELECT R_NAME FROM REGION", //test set operation
+		"explain SELECT N_REGIONKEY FROM NATION INTERSECT SELECT R_REGIONKEY FROM REGION EXCEPT SELECT C_NATIONKEY FROM CUSTOMER",
 		"explain verbose SELECT N_REGIONKEY + 2 as a, N_REGIONKEY/2, N_REGIONKEY* N_NATIONKEY, N_REGIONKEY % N_NATIONKEY, N_REGIONKEY - N_NATIONKEY FROM NATION WHERE -N_NATIONKEY < -20", //test more expr
 		"explain SELECT N_REGIONKEY + 2 as a, N_REGIONKEY/2, N_REGIONKEY* N_NATIONKEY, N_REGIONKEY % N_NATIONKEY, N_REGIONKEY - N_NATIONKEY FROM NATION WHERE -N_NATIONKEY < -20",         //test more expr
 		"explain verbose SELECT N_REGIONKEY FROM NATION where N_REGIONKEY >= N_NATIONKEY or (N_NAME like '%ddd' and N_REGIONKEY >0.5)",                                                    //test more expr
@@ -89,6 +97,7 @@ func TestSingleTableQuery(t *testing.T) {
 		"explain SELECT N_REGIONKEY FROM NATION where N_REGIONKEY between 2 and 2 OR N_NATIONKEY not between 3 and 10",                                                                    //test more expr
 		"explain verbose SELECT N_REGIONKEY FROM NATION where N_REGIONKEY is null and N_NAME is not null",
 		"explain SELECT N_REGIONKEY FROM NATION where N_REGIONKEY is null and N_NAME is not null",
+		"explain verbose SELECT N_NAME FROM NATION where N_NATIONKEY = ? and N_REGIONKEY > ? + 1", //test parameter
 	}
 	mockOptimizer := plan2.NewMockOptimizer()
 	runTestShouldPass(mockOptimizer, t, sqls)
@@ -167,6 +176,8 @@ func TestDerivedTableQuery(t *testing.T) {
 		"explain verbose select a.* from (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey ) a join NATION b on a.c_custkey = b.N_REGIONKEY where b.N_NATIONKEY > 10",
 		"explain select * from (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey ) a join NATION b on a.c_custkey = b.N_REGIONKEY where b.N_NATIONKEY > 10",
 		"explain verbose select * from (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey ) a join NATION b on a.c_custkey = b.N_REGIONKEY where b.N_NATIONKEY > 10",
+		"explain with a as (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey) select a.c_custkey from a, a b where a.ff = b.ff",
+		"explain verbose with a as (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey) select c_custkey from a where ff > 0",
 	}
 	mockOptimizer := plan2.NewMockOptimizer()
 	runTestShouldPass(mockOptimizer, t, sqls)
@@ -258,6 +269,8 @@ func runOneStmt(opt plan2.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return errors.New(errno.InvalidOptionValue, fmt.Sprintf("unrecognized value for EXPLAIN option \"%s\": \"%s\"", v.Name, v.Value))
 				}
@@ -283,3 +296,160 @@ func runOneStmt(opt plan2.Optimizer, t *testing.T, sql string) error {
 	}
 	return nil
 }
+
+func TestExplainAnalyze(t *testing.T) {
+	mock := plan2.NewMockOptimizer()
+	stmts, err := mysql.Parse("SELECT N_NAME FROM NATION WHERE N_NATIONKEY > 0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	logicPlan, err := plan2.BuildPlan(mock.CurrentContext(), stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry := logicPlan.GetQuery()
+	for _, node := range qry.Nodes {
+		node.AnalyzeInfo = &plan.AnalyzeInfo{
+			InputRows:     25,
+			OutputRows:    24,
+			InputBatches:  1,
+			OutputBatches: 1,
+			TimeConsumed:  int64(time.Millisecond),
+			MemoryPeak:    4096,
+			Calls:         2,
+		}
+	}
+	buffer := NewExplainDataBuffer()
+	if err = NewExplainQueryImpl(qry).ExplainPlan(buffer, NewExplainDefaultOptions()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, line := range buffer.Lines {
+		if strings.Contains(line, "Analyze:") {
+			t.Fatalf("unexpected statistics '%s'", line)
+		}
+	}
+	buffer = NewExplainDataBuffer()
+	if err = NewExplainQueryImpl(qry).ExplainAnalyze(buffer, NewExplainDefaultOptions()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cnt := 0
+	for _, line := range buffer.Lines {
+		if strings.Contains(line, "Analyze: inputRows=25 outputRows=24 inputBatches=1 outputBatches=1 timeConsumed=1ms") {
+			cnt++
+		}
+	}
+	if cnt != len(qry.Nodes) {
+		t.Fatalf("statistics of %d nodes explained, want %d", cnt, len(qry.Nodes))
+	}
+
+	// The actual statistics are next to the estimates, and summed up
+	explainQuery := NewExplainQueryImpl(qry)
+	explainQuery.TotalTime = 5 * time.Millisecond
+	buffer = NewExplainDataBuffer()
+	if err = explainQuery.ExplainAnalyze(buffer, NewExplainDefaultOptions()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cnt = 0
+	for _, line := range buffer.Lines {
+		if strings.Contains(line, "(cost=") && strings.Contains(line, "(actual rows=24 loops=2 time=1ms memory=4096)") {
+			cnt++
+		}
+	}
+	if cnt != len(qry.Nodes) {
+		t.Fatalf("actual statistics of %d nodes explained, want %d", cnt, len(qry.Nodes))
+	}
+	summary := strings.Join(buffer.Lines, "\n")
+	for _, line := range []string{"Summary:", "Total Time: 5ms", "Output Rows: 24", "Memory Peak: 4096"} {
+		if !strings.Contains(summary, line) {
+			t.Fatalf("'%s' is not in the summary:\n%s", line, summary)
+		}
+	}
+}
+
+func TestExplainDot(t *testing.T) {
+	sqls := []string{
+		"explain format = dot SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_NATIONKEY > 0 ORDER BY N_NAME",
+		"explain (format dot, verbose true) SELECT N_NAME, R_NAME FROM NATION join REGION on N_REGIONKEY = R_REGIONKEY WHERE N_NAME LIKE '%\"A'",
+	}
+	mockOptimizer := plan2.NewMockOptimizer()
+	runTestShouldPass(mockOptimizer, t, sqls)
+
+	stmts, err := mysql.Parse("SELECT N_NAME, R_NAME FROM NATION join REGION on N_REGIONKEY = R_REGIONKEY WHERE N_NATIONKEY > 0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry, err := mockOptimizer.Optimize(stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buffer := NewExplainDataBuffer()
+	if err = NewExplainQueryImpl(qry).ExplainPlan(buffer, &ExplainOptions{Format: EXPLAIN_FORMAT_DOT}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	lines := buffer.Lines
+	if lines[0] != "digraph plan {" || lines[len(lines)-1] != "}" {
+		t.Fatalf("not a digraph:\n%s", strings.Join(lines, "\n"))
+	}
+	nodes, edges := 0, 0
+	for _, line := range lines {
+		switch {
+		case strings.Contains(line, "->"):
+			if !strings.Contains(line, "[label=\"rows=") {
+				t.Fatalf("the edge '%s' has no estimated rows", line)
+			}
+			edges++
+		case strings.Contains(line, "[label="):
+			nodes++
+		}
+	}
+	if nodes != len(qry.Nodes) || edges != len(qry.Nodes)-1 {
+		t.Fatalf("%d nodes and %d edges for %d nodes:\n%s", nodes, edges, len(qry.Nodes), strings.Join(lines, "\n"))
+	}
+	graph := strings.Join(lines, "\n")
+	for _, label := range []string{"Table Scan on tpch.nation", "Join Cond:", "Filter: "} {
+		if !strings.Contains(graph, label) {
+			t.Fatalf("'%s' is not labeled:\n%s", label, graph)
+		}
+	}
+}
+
+func TestExplainVerboseCost(t *testing.T) {
+	mockOptimizer := plan2.NewMockOptimizer()
+	stmts, err := mysql.Parse("SELECT N_NAME, R_NAME FROM NATION join REGION on N_REGIONKEY = R_REGIONKEY WHERE N_NATIONKEY > 0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry, err := mockOptimizer.Optimize(stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buffer := NewExplainDataBuffer()
+	if err = NewExplainQueryImpl(qry).ExplainPlan(buffer, &ExplainOptions{Verbose: true}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	plan := strings.Join(buffer.Lines, "\n")
+	if strings.Contains(plan, "%") {
+		t.Fatalf("the estimates are not explained:\n%s", plan)
+	}
+	for _, line := range []string{
+		"Join (cost=",
+		"Table Scan on tpch.nation (cost=",
+		"Access Method: zonemap scan, Prune Cond: (n_nationkey > 0)",
+		"Access Method: full scan",
+	} {
+		if !strings.Contains(plan, line) {
+			t.Fatalf("'%s' is not explained:\n%s", line, plan)
+		}
+	}
+
+	// Without VERBOSE, the access methods are not explained
+	buffer = NewExplainDataBuffer()
+	if err = NewExplainQueryImpl(qry).ExplainPlan(buffer, NewExplainDefaultOptions()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, line := range buffer.Lines {
+		if strings.Contains(line, "Access Method:") {
+			t.Fatalf("unexpected access method '%s'", line)
+		}
+	}
+}
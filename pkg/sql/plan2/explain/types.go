@@ -15,7 +15,6 @@
 package explain
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"strings"
 )
 
@@ -28,10 +27,12 @@ type NodeDescribe interface {
 	GetNodeBasicInfo(options *ExplainOptions) (string, error)
 	GetExtraInfo(options *ExplainOptions) ([]string, error)
 	GetProjectListInfo(options *ExplainOptions) (string, error)
+	GetAccessInfo(options *ExplainOptions) (string, error)
 	GetJoinConditionInfo(options *ExplainOptions) (string, error)
 	GetWhereConditionInfo(options *ExplainOptions) (string, error)
 	GetOrderByInfo(options *ExplainOptions) (string, error)
 	GetGroupByInfo(options *ExplainOptions) (string, error)
+	GetGroupingSetsInfo(options *ExplainOptions) (string, error)
 }
 
 type NodeElemDescribe interface {
@@ -100,7 +101,6 @@ func (buf *ExplainDataBuffer) PushNewLine(line string, isNewNode bool, level int
 	}
 	buf.CurrentLine++
 	buf.Lines = append(buf.Lines, prefix+line)
-	logutil.Infof(buf.Lines[buf.CurrentLine])
 	buf.End++
 }
 
@@ -0,0 +1,465 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// maxShiftedConst is the max absolute value of the constants moved across
+// the comparisons of the integer columns, which cannot overflow the sums
+// with the values of the columns of 32 bits
+const maxShiftedConst = 1 << 32
+
+// foldExpr folds the functions of constants in e, simplifies the logical
+// operators of constant operands and canonicalizes the comparisons with
+// the constants at the right sides. The arithmetics of the columns of 32
+// bits integers and constants are moved to the constants. e is returned if
+// it cannot be simplified
+func foldExpr(e *Expr) *Expr {
+	if e == nil {
+		return nil
+	}
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return e
+	}
+	args := f.F.Args
+	for i, arg := range args {
+		args[i] = foldExpr(arg)
+	}
+	switch name := strings.ToUpper(f.F.Func.GetObjName()); name {
+	case "AND", "OR":
+		if len(args) == 2 {
+			return foldLogic(e, name == "AND", args[0], args[1])
+		}
+	case "NOT":
+		if len(args) != 1 {
+			break
+		}
+		if b, ok := boolConst(args[0]); ok {
+			return boolExpr(!b)
+		}
+	case "CAST":
+		if len(args) != 1 {
+			break
+		}
+		if c, ok := foldCast(args[0], e.Typ); ok {
+			return c
+		}
+	case "UNARY_PLUS":
+		if len(args) != 1 {
+			break
+		}
+		if _, ok := numConst(args[0]); ok {
+			return args[0]
+		}
+	case "UNARY_MINUS":
+		if len(args) != 1 {
+			break
+		}
+		if c, ok := numConst(args[0]); ok {
+			switch v := c.Value.(type) {
+			case *plan.Const_Ival:
+				if v.Ival != math.MinInt64 {
+					return intExpr(-v.Ival, e.Typ)
+				}
+			case *plan.Const_Dval:
+				return floatExpr(-v.Dval, e.Typ)
+			}
+		}
+	case "+", "-", "*", "/", "%":
+		if len(args) == 2 {
+			if c, ok := foldArith(name, args[0], args[1], e.Typ); ok {
+				return c
+			}
+		}
+	case "=", "<>", "<", "<=", ">", ">=":
+		if len(args) == 2 {
+			return foldCompare(e, name, args)
+		}
+	}
+	return e
+}
+
+// foldLogic simplifies AND or OR of l and r if either is a constant. The
+// constants of the booleans are not dropped from the other operands of
+// other types
+func foldLogic(e *Expr, and bool, l, r *Expr) *Expr {
+	lb, lok := boolConst(l)
+	rb, rok := boolConst(r)
+	switch {
+	case lok && lb != and, rok && rb != and:
+		// false for AND and true for OR
+		return boolExpr(!and)
+	case lok && rok:
+		return boolExpr(and)
+	case lok && r.Typ.GetId() == plan.Type_BOOL:
+		return r
+	case rok && l.Typ.GetId() == plan.Type_BOOL:
+		return l
+	}
+	return e
+}
+
+// foldCast casts the numeric constant e to the numeric type typ, which is
+// exact only
+func foldCast(e *Expr, typ *plan.Type) (*Expr, bool) {
+	c, ok := numConst(e)
+	if !ok || typ == nil {
+		return nil, false
+	}
+	switch v := c.Value.(type) {
+	case *plan.Const_Ival:
+		switch typ.Id {
+		case plan.Type_FLOAT32, plan.Type_FLOAT64:
+			return floatExpr(float64(v.Ival), typ), true
+		}
+		if lo, hi, ok := intRange(typ.Id); ok && v.Ival >= lo && v.Ival <= hi {
+			return intExpr(v.Ival, typ), true
+		}
+	case *plan.Const_Dval:
+		switch typ.Id {
+		case plan.Type_FLOAT64:
+			return floatExpr(v.Dval, typ), true
+		case plan.Type_FLOAT32:
+			if float64(float32(v.Dval)) == v.Dval {
+				return floatExpr(v.Dval, typ), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// foldArith computes the arithmetic op of the constants l and r, the
+// overflows, the divisions by zero and the integer divisions are left to
+// the execution
+func foldArith(op string, l, r *Expr, typ *plan.Type) (*Expr, bool) {
+	lc, lok := numConst(l)
+	rc, rok := numConst(r)
+	if !lok || !rok {
+		return nil, false
+	}
+	switch lv := lc.Value.(type) {
+	case *plan.Const_Ival:
+		rv, ok := rc.Value.(*plan.Const_Ival)
+		if !ok || typ.GetId() != plan.Type_INT64 {
+			return nil, false
+		}
+		if v, ok := intArith(op, lv.Ival, rv.Ival); ok {
+			return intExpr(v, typ), true
+		}
+	case *plan.Const_Dval:
+		rv, ok := rc.Value.(*plan.Const_Dval)
+		if !ok || typ.GetId() != plan.Type_FLOAT64 {
+			return nil, false
+		}
+		switch op {
+		case "+":
+			return floatExpr(lv.Dval+rv.Dval, typ), true
+		case "-":
+			return floatExpr(lv.Dval-rv.Dval, typ), true
+		case "*":
+			return floatExpr(lv.Dval*rv.Dval, typ), true
+		case "/":
+			if rv.Dval != 0 {
+				return floatExpr(lv.Dval/rv.Dval, typ), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func intArith(op string, a, b int64) (int64, bool) {
+	switch op {
+	case "+":
+		s := a + b
+		return s, (a >= 0) != (b >= 0) || (s >= 0) == (a >= 0)
+	case "-":
+		d := a - b
+		return d, (a >= 0) == (b >= 0) || (d >= 0) == (a >= 0)
+	case "*":
+		p := a * b
+		return p, a == 0 || (p/a == b && !(a == -1 && b == math.MinInt64))
+	case "%":
+		if b != 0 && b != -1 {
+			return a % b, true
+		}
+	}
+	return 0, false
+}
+
+// foldCompare evaluates the comparison of constants, and otherwise moves
+// the constant to the right side and the constants added to a column of 32
+// bits integers to it
+func foldCompare(e *Expr, op string, args []*Expr) *Expr {
+	l, r := args[0], args[1]
+	lc, lok := constOf(l)
+	rc, rok := constOf(r)
+	if lok && rok {
+		if cmp, ok := compareConsts(lc, rc); ok {
+			return boolExpr(compareResult(op, cmp))
+		}
+		return e
+	}
+	if lok {
+		l, r, op = r, l, flippedOps[op]
+	}
+	c, ok := numConst(r)
+	if !ok {
+		return e
+	}
+	v, ok := c.Value.(*plan.Const_Ival)
+	if !ok {
+		return newCompare(e, op, l, r)
+	}
+	k := v.Ival
+	for {
+		f, ok := l.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 2 || l.Typ.GetId() != plan.Type_INT64 {
+			break
+		}
+		x, y := f.F.Args[0], f.F.Args[1]
+		name := f.F.Func.GetObjName()
+		var shifted int64
+		switch {
+		case name == "+" && shiftable(x) && isShiftConst(y):
+			// x + c op k => x op k - c
+			shifted, ok = intArith("-", k, intConst(y))
+		case name == "+" && shiftable(y) && isShiftConst(x):
+			x = y
+			shifted, ok = intArith("-", k, intConst(f.F.Args[0]))
+		case name == "-" && shiftable(x) && isShiftConst(y):
+			// x - c op k => x op k + c
+			shifted, ok = intArith("+", k, intConst(y))
+		case name == "-" && shiftable(y) && isShiftConst(x):
+			// c - y op k => y op' c - k
+			x = y
+			op = flippedOps[op]
+			shifted, ok = intArith("-", intConst(f.F.Args[0]), k)
+		default:
+			ok = false
+		}
+		if !ok {
+			break
+		}
+		l, k = x, shifted
+	}
+	// The casts of the columns to INT64 are dropped if k is in their types
+	if inner := unwrapCast(l); inner != l && narrowInt(l) {
+		if lo, hi, ok := intRange(inner.Typ.GetId()); ok && k >= lo && k <= hi {
+			l = inner
+		}
+	}
+	if k != v.Ival {
+		r = intExpr(k, r.Typ)
+	}
+	return newCompare(e, op, l, r)
+}
+
+func newCompare(e *Expr, op string, l, r *Expr) *Expr {
+	f := e.Expr.(*plan.Expr_F)
+	if f.F.Func.GetObjName() == op && f.F.Args[0] == l && f.F.Args[1] == r {
+		return e
+	}
+	return &Expr{
+		Typ:   e.Typ,
+		Alias: e.Alias,
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: getFunctionObjRef(op),
+			Args: []*Expr{l, r},
+		}},
+	}
+}
+
+// shiftable returns true if e is an integer of 32 bits at most plus or minus
+// the constants below maxShiftedConst, which cannot overflow INT64 with the
+// constants moved from it
+func shiftable(e *Expr) bool {
+	if narrowInt(e) {
+		return true
+	}
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 || e.Typ.GetId() != plan.Type_INT64 {
+		return false
+	}
+	x, y := f.F.Args[0], f.F.Args[1]
+	switch f.F.Func.GetObjName() {
+	case "+", "-":
+		return (shiftable(x) && isShiftConst(y)) || (shiftable(y) && isShiftConst(x))
+	}
+	return false
+}
+
+// narrowInt returns true if e is an integer of 32 bits at most, or one cast
+// to INT64
+func narrowInt(e *Expr) bool {
+	_, isConst := e.Expr.(*plan.Expr_C)
+	if isConst {
+		return false
+	}
+	e = unwrapCast(e)
+	switch e.Typ.GetId() {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32,
+		plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32:
+		return true
+	}
+	return false
+}
+
+func isShiftConst(e *Expr) bool {
+	c, ok := numConst(e)
+	if !ok {
+		return false
+	}
+	v, ok := c.Value.(*plan.Const_Ival)
+	return ok && v.Ival > -maxShiftedConst && v.Ival < maxShiftedConst
+}
+
+func intConst(e *Expr) int64 {
+	return e.Expr.(*plan.Expr_C).C.GetIval()
+}
+
+func intRange(id plan.Type_TypeId) (int64, int64, bool) {
+	switch id {
+	case plan.Type_INT8:
+		return math.MinInt8, math.MaxInt8, true
+	case plan.Type_INT16:
+		return math.MinInt16, math.MaxInt16, true
+	case plan.Type_INT32:
+		return math.MinInt32, math.MaxInt32, true
+	case plan.Type_INT64:
+		return math.MinInt64, math.MaxInt64, true
+	case plan.Type_UINT8:
+		return 0, math.MaxUint8, true
+	case plan.Type_UINT16:
+		return 0, math.MaxUint16, true
+	case plan.Type_UINT32:
+		return 0, math.MaxUint32, true
+	case plan.Type_UINT64:
+		return 0, math.MaxInt64, true
+	}
+	return 0, 0, false
+}
+
+// constOf returns the constant of e if it is not null
+func constOf(e *Expr) (*plan.Const, bool) {
+	c, ok := e.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return nil, false
+	}
+	return c.C, true
+}
+
+// numConst returns the constant of e if it is a number
+func numConst(e *Expr) (*plan.Const, bool) {
+	c, ok := constOf(e)
+	if !ok {
+		return nil, false
+	}
+	switch c.Value.(type) {
+	case *plan.Const_Ival, *plan.Const_Dval:
+		return c, e.Typ.GetId() != plan.Type_BOOL
+	}
+	return nil, false
+}
+
+// compareConsts compares the numbers or the strings a and b
+func compareConsts(a, b *plan.Const) (int, bool) {
+	switch av := a.Value.(type) {
+	case *plan.Const_Ival:
+		switch bv := b.Value.(type) {
+		case *plan.Const_Ival:
+			return compareOrdered(av.Ival, bv.Ival), true
+		case *plan.Const_Dval:
+			return compareOrdered(float64(av.Ival), bv.Dval), true
+		}
+	case *plan.Const_Dval:
+		switch bv := b.Value.(type) {
+		case *plan.Const_Ival:
+			return compareOrdered(av.Dval, float64(bv.Ival)), true
+		case *plan.Const_Dval:
+			return compareOrdered(av.Dval, bv.Dval), true
+		}
+	case *plan.Const_Sval:
+		if bv, ok := b.Value.(*plan.Const_Sval); ok {
+			return strings.Compare(av.Sval, bv.Sval), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareResult(op string, cmp int) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "<>":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// boolConst returns the value of e if it is a boolean constant
+func boolConst(e *Expr) (bool, bool) {
+	c, ok := constOf(e)
+	if !ok || e.Typ.GetId() != plan.Type_BOOL {
+		return false, false
+	}
+	return c.GetIval() != 0, true
+}
+
+func boolExpr(b bool) *Expr {
+	var v int64
+	if b {
+		v = 1
+	}
+	return &Expr{
+		Typ:  &plan.Type{Id: plan.Type_BOOL},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+	}
+}
+
+func intExpr(v int64, typ *plan.Type) *Expr {
+	return &Expr{
+		Typ:  typ,
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+	}
+}
+
+func floatExpr(v float64, typ *plan.Type) *Expr {
+	return &Expr{
+		Typ:  typ,
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Dval{Dval: v}}},
+	}
+}
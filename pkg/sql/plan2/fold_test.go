@@ -0,0 +1,118 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// scanFilter returns the only filter of the scan of table
+func scanFilter(t *testing.T, sql, table string) *plan.Function {
+	query := buildWithStats(t, sql, false)
+	scan := scanOf(query, table)
+	if len(scan.WhereList) != 1 {
+		t.Fatalf("the scan should have one filter but now has %v", scan.WhereList)
+	}
+	f, ok := scan.WhereList[0].Expr.(*plan.Expr_F)
+	if !ok {
+		t.Fatalf("the filter should be a function but now is %v", scan.WhereList[0])
+	}
+	return f.F
+}
+
+func checkCompare(t *testing.T, f *plan.Function, op, name string, v int64) {
+	col, ok := f.Args[0].Expr.(*plan.Expr_Col)
+	c, cok := f.Args[1].Expr.(*plan.Expr_C)
+	if f.Func.ObjName != op || !ok || col.Col.Name != name || !cok || c.C.GetIval() != v {
+		t.Fatalf("the filter should be %v %v %v but now is %v", name, op, v, f)
+	}
+}
+
+func TestFoldCompare(t *testing.T) {
+	f := scanFilter(t, "SELECT N_NAME FROM NATION WHERE N_NATIONKEY + 1 > 5", "nation")
+	checkCompare(t, f, ">", "nation.n_nationkey", 4)
+	f = scanFilter(t, "SELECT N_NAME FROM NATION WHERE 10 - N_NATIONKEY >= 2 * 3", "nation")
+	checkCompare(t, f, "<=", "nation.n_nationkey", 4)
+	f = scanFilter(t, "SELECT N_NAME FROM NATION WHERE 1 + (N_NATIONKEY - 2) <> 7", "nation")
+	checkCompare(t, f, "<>", "nation.n_nationkey", 8)
+
+	// The casts of the columns are kept if the constant is out of their range
+	f = scanFilter(t, "SELECT N_NAME FROM NATION WHERE N_NATIONKEY < 3000000000", "nation")
+	if f.Func.ObjName != "<" {
+		t.Fatalf("the filter should be kept but now is %v", f)
+	}
+	// Not the floats
+	f = scanFilter(t, "SELECT N_NAME FROM NATION WHERE N_NATIONKEY + 1.5 > 5", "nation")
+	if _, ok := f.Args[0].Expr.(*plan.Expr_F); !ok {
+		t.Fatalf("the addition of a float should be kept but now is %v", f)
+	}
+}
+
+func TestFoldConstants(t *testing.T) {
+	query := buildWithStats(t, "SELECT N_NAME FROM NATION WHERE 1 + 2 = 3 AND N_REGIONKEY = 1", false)
+	checkCompare(t, scanOf(query, "nation").WhereList[0].Expr.(*plan.Expr_F).F, "=", "nation.n_regionkey", 1)
+	if len(scanOf(query, "nation").WhereList) != 1 {
+		t.Fatalf("1 + 2 = 3 should be dropped")
+	}
+
+	query = buildWithStats(t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 1 AND (2 > 3 OR 1 = 2)", false)
+	where := scanOf(query, "nation").WhereList
+	if b, ok := boolConst(where[0]); len(where) != 1 || !ok || b {
+		t.Fatalf("the filter should be false but now is %v", where)
+	}
+
+	query = buildWithStats(t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 1 OR 'a' < 'b'", false)
+	if where := scanOf(query, "nation").WhereList; len(where) != 0 {
+		t.Fatalf("the filter should be dropped but now is %v", where)
+	}
+
+	query = buildWithStats(t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY BETWEEN 1 AND 3 - 1", false)
+	f := scanOf(query, "nation").WhereList[0].Expr.(*plan.Expr_F).F
+	if f.Func.ObjName != "AND" {
+		t.Fatalf("the filter should be a range but now is %v", f)
+	}
+	checkCompare(t, f.Args[1].Expr.(*plan.Expr_F).F, "<=", "nation.n_regionkey", 2)
+}
+
+func TestFoldExpr(t *testing.T) {
+	int64Typ := &plan.Type{Id: plan.Type_INT64}
+	call := func(op string, typ *plan.Type, args ...*Expr) *Expr {
+		return &Expr{
+			Typ:  typ,
+			Expr: &plan.Expr_F{F: &plan.Function{Func: getFunctionObjRef(op), Args: args}},
+		}
+	}
+	// The overflows are left to the execution
+	max := intExpr(1<<63-1, int64Typ)
+	if e := foldExpr(call("+", int64Typ, max, intExpr(1, int64Typ))); e.GetF() == nil {
+		t.Fatalf("the overflow should not be folded but now is %v", e)
+	}
+	if e := foldExpr(call("-", int64Typ, intExpr(7, int64Typ), intExpr(9, int64Typ))); e.GetC().GetIval() != -2 {
+		t.Fatalf("7 - 9 should be -2 but now is %v", e)
+	}
+	if e := foldExpr(call("%", int64Typ, intExpr(7, int64Typ), intExpr(0, int64Typ))); e.GetF() == nil {
+		t.Fatalf("the modulo by zero should not be folded but now is %v", e)
+	}
+	cast := call("CAST", &plan.Type{Id: plan.Type_INT8}, intExpr(300, int64Typ))
+	if e := foldExpr(cast); e.GetF() == nil {
+		t.Fatalf("the cast out of range should not be folded but now is %v", e)
+	}
+	cast = call("CAST", &plan.Type{Id: plan.Type_FLOAT64}, intExpr(3, int64Typ))
+	if e := foldExpr(cast); e.GetC().GetDval() != 3 {
+		t.Fatalf("the cast should be folded to 3.0 but now is %v", e)
+	}
+}
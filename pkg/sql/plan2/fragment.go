@@ -0,0 +1,312 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+type Fragment = plan.Fragment
+
+// FragmentVersion is the version of the encoding of the fragments, which is
+// bumped once the fragments of an older version can not be run
+const FragmentVersion = 1
+
+// FragmentQuery cuts a copy of query at its exchanges into fragments, the
+// first of which is the root one running the steps. The scans and the
+// projections above them are gathered from the other nodes if query has no
+// exchange
+func FragmentQuery(query *Query) ([]*Fragment, error) {
+	query = proto.Clone(query).(*Query)
+	if !hasExchange(query) {
+		addGathers(query)
+	}
+	f := &fragmenter{query: query}
+	if _, err := f.cut(query.Steps, -1, 0); err != nil {
+		return nil, err
+	}
+	return f.frags, nil
+}
+
+// EncodeFragment returns the encoding of frag, which is the same for the
+// equal fragments
+func EncodeFragment(frag *Fragment) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(frag)
+}
+
+// DecodeFragment returns the fragment of data encoded by EncodeFragment
+func DecodeFragment(data []byte) (*Fragment, error) {
+	frag := &Fragment{}
+	if err := proto.Unmarshal(data, frag); err != nil {
+		return nil, errors.New(errno.InternalError, fmt.Sprintf("fragment is corrupted: %v", err))
+	}
+	if frag.Version != FragmentVersion {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("fragment of version %d is not supported", frag.Version))
+	}
+	query := frag.Query
+	if query == nil {
+		return nil, errors.New(errno.InternalError, fmt.Sprintf("fragment %d has no query", frag.Id))
+	}
+	n := int32(len(query.Nodes))
+	valid := func(id int32) bool {
+		return id >= 0 && id < n
+	}
+	for _, step := range query.Steps {
+		if !valid(step) {
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("fragment %d has no step %d", frag.Id, step))
+		}
+	}
+	for i, node := range query.Nodes {
+		if node.NodeId != int32(i) {
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("node %d of fragment %d is at %d", node.NodeId, frag.Id, i))
+		}
+		for _, child := range node.Children {
+			if !valid(child) {
+				return nil, errors.New(errno.InternalError, fmt.Sprintf("node %d of fragment %d has no child %d", i, frag.Id, child))
+			}
+		}
+	}
+	return frag, nil
+}
+
+func isExchange(node *Node) bool {
+	switch node.NodeType {
+	case plan.Node_BROADCAST, plan.Node_SPLIT, plan.Node_GATHER:
+		return true
+	}
+	return false
+}
+
+func hasExchange(query *Query) bool {
+	for _, node := range query.Nodes {
+		if isExchange(node) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeRefs calls fn with the nodes node refers to, its children and the
+// roots of its subqueries
+func nodeRefs(node *Node, fn func(*int32)) {
+	for i := range node.Children {
+		fn(&node.Children[i])
+	}
+	lists := append(nodeExprLists(node), []*Expr{node.Limit, node.Offset})
+	for _, list := range lists {
+		for _, e := range list {
+			walkExpr(e, func(e *Expr) {
+				if sub, ok := e.Expr.(*plan.Expr_Sub); ok {
+					fn(&sub.Sub.NodeId)
+				}
+			})
+		}
+	}
+}
+
+// local returns true if node reads or computes its rows by itself, without
+// the rows of the other nodes than its children
+func local(node *Node) bool {
+	lists := append(nodeExprLists(node), []*Expr{node.Limit, node.Offset})
+	for _, list := range lists {
+		for _, e := range list {
+			found := false
+			walkExpr(e, func(e *Expr) {
+				switch e.Expr.(type) {
+				case *plan.Expr_Sub, *plan.Expr_Corr:
+					found = true
+				}
+			})
+			if found || (e != nil && e.Expr == nil) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// addGathers adds a GATHER above each scan of query and the projections
+// above it, which are run by the nodes the blocks of the scan are on
+func addGathers(query *Query) {
+	// The parents of the nodes reachable from the steps
+	parents := make(map[int32]*Node)
+	reached := make(map[int32]bool)
+	var visit func(id int32)
+	visit = func(id int32) {
+		if reached[id] || id < 0 || int(id) >= len(query.Nodes) {
+			return
+		}
+		reached[id] = true
+		node := query.Nodes[id]
+		nodeRefs(node, func(child *int32) {
+			parents[*child] = node
+			visit(*child)
+		})
+	}
+	for _, step := range query.Steps {
+		visit(step)
+	}
+	for _, scan := range query.Nodes[:len(query.Nodes):len(query.Nodes)] {
+		if scan.NodeType != plan.Node_TABLE_SCAN || !reached[scan.NodeId] || !local(scan) {
+			continue
+		}
+		top := scan
+		for {
+			p, ok := parents[top.NodeId]
+			if !ok || p.NodeType != plan.Node_PROJECT || len(p.Children) != 1 || !local(p) {
+				break
+			}
+			top = p
+		}
+		gather := &Node{
+			NodeType: plan.Node_GATHER,
+			NodeId:   int32(len(query.Nodes)),
+			Cost:     top.Cost,
+			Children: []int32{top.NodeId},
+		}
+		for i, e := range top.ProjectList {
+			gather.ProjectList = append(gather.ProjectList, &Expr{
+				Typ:   e.Typ,
+				Alias: e.Alias,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{ColPos: int32(i), Name: e.Alias},
+				},
+			})
+		}
+		query.Nodes = append(query.Nodes, gather)
+		if p, ok := parents[top.NodeId]; ok {
+			nodeRefs(p, func(id *int32) {
+				if *id == top.NodeId {
+					*id = gather.NodeId
+				}
+			})
+			parents[gather.NodeId] = p
+		}
+		for i, step := range query.Steps {
+			if step == top.NodeId {
+				query.Steps[i] = gather.NodeId
+			}
+		}
+		parents[top.NodeId] = gather
+	}
+}
+
+type fragmenter struct {
+	query *Query
+	frags []*Fragment
+}
+
+// cut adds the fragment of the nodes below roots down to the exchanges,
+// whose children are cut into the children fragments. The fragment sends
+// its rows to the exchange node of the parent fragment
+func (f *fragmenter) cut(roots []int32, parent, exchange int32) (*Fragment, error) {
+	frag := &Fragment{
+		Version:    FragmentVersion,
+		Id:         int32(len(f.frags)),
+		Parent:     parent,
+		ExchangeId: exchange,
+		Query: &Query{
+			StmtType: f.query.StmtType,
+			Params:   f.query.Params,
+		},
+	}
+	f.frags = append(f.frags, frag)
+	// The local ids of the nodes in preorder
+	ids := make(map[int32]int32)
+	var exchanges []*Node
+	var visit func(id int32) error
+	visit = func(id int32) error {
+		if _, ok := ids[id]; ok {
+			return nil
+		}
+		if id < 0 || int(id) >= len(f.query.Nodes) {
+			return errors.New(errno.InternalError, fmt.Sprintf("node %d does not exist", id))
+		}
+		node := f.query.Nodes[id]
+		ids[id] = int32(len(frag.Query.Nodes))
+		frag.Query.Nodes = append(frag.Query.Nodes, node)
+		if isExchange(node) && len(node.Children) > 0 {
+			// The children are the roots of the next fragments
+			exchanges = append(exchanges, node)
+			return nil
+		}
+		var err error
+		nodeRefs(node, func(child *int32) {
+			if err == nil {
+				err = visit(*child)
+			}
+		})
+		return err
+	}
+	for _, root := range roots {
+		if err := visit(root); err != nil {
+			return nil, err
+		}
+	}
+	remap := func(id *int32) error {
+		lid, ok := ids[*id]
+		if !ok {
+			return errors.New(errno.InternalError, fmt.Sprintf("fragment %d refers to node %d of another fragment", frag.Id, *id))
+		}
+		*id = lid
+		return nil
+	}
+	for _, root := range roots {
+		frag.Query.Steps = append(frag.Query.Steps, ids[root])
+	}
+	for i, node := range frag.Query.Nodes {
+		orig := node
+		node = proto.Clone(node).(*Node)
+		node.NodeId = int32(i)
+		if isExchange(orig) {
+			node.Children = nil
+		}
+		var err error
+		nodeRefs(node, func(id *int32) {
+			if err == nil {
+				err = remap(id)
+			}
+		})
+		for _, list := range nodeExprLists(node) {
+			for _, e := range list {
+				walkExpr(e, func(e *Expr) {
+					if corr, ok := e.Expr.(*plan.Expr_Corr); ok && err == nil {
+						err = remap(&corr.Corr.NodeId)
+					}
+				})
+			}
+		}
+		if node.NodeType == plan.Node_CTE_SCAN && err == nil {
+			err = remap(&node.SourceStep)
+		}
+		if err != nil {
+			return nil, err
+		}
+		frag.Query.Nodes[i] = node
+	}
+	for _, node := range exchanges {
+		child, err := f.cut(node.Children, frag.Id, ids[node.NodeId])
+		if err != nil {
+			return nil, err
+		}
+		frag.Children = append(frag.Children, child.Id)
+	}
+	return frag, nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"google.golang.org/protobuf/proto"
+)
+
+// checkFragments checks the fragments survive the encoding and the
+// exchanges of the parents receive the rows of their children
+func checkFragments(t *testing.T, frags []*Fragment) {
+	for i, frag := range frags {
+		if frag.Id != int32(i) {
+			t.Fatalf("fragment %d is at %d", frag.Id, i)
+		}
+		data, err := EncodeFragment(frag)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		again, _ := EncodeFragment(frag)
+		if !bytes.Equal(data, again) {
+			t.Fatalf("the encoding of fragment %d is not stable", i)
+		}
+		decoded, err := DecodeFragment(data)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if !proto.Equal(frag, decoded) {
+			t.Fatalf("fragment %d is decoded as %v", i, decoded)
+		}
+		for _, node := range frag.Query.Nodes {
+			if isExchange(node) && len(node.Children) > 0 {
+				t.Fatalf("exchange %d of fragment %d should be a leaf", node.NodeId, i)
+			}
+		}
+		for _, child := range frag.Children {
+			c := frags[child]
+			if c.Parent != frag.Id || !isExchange(frag.Query.Nodes[c.ExchangeId]) {
+				t.Fatalf("fragment %d should send to an exchange of fragment %d, %v", child, i, c)
+			}
+		}
+	}
+	if frags[0].Parent != -1 {
+		t.Fatalf("the first fragment should be the root")
+	}
+}
+
+func TestFragmentQuery(t *testing.T) {
+	sql := "SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE R_NAME = 'ASIA'"
+	query := buildWithStats(t, sql, false)
+	frags, err := FragmentQuery(query)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	checkFragments(t, frags)
+	if len(frags) != 3 || len(frags[0].Children) != 2 {
+		t.Fatalf("the scans should be gathered, %v", frags)
+	}
+	if len(nodesOf(frags[0].Query, plan.Node_TABLE_SCAN)) != 0 || len(nodesOf(frags[0].Query, plan.Node_GATHER)) != 2 {
+		t.Fatalf("the root fragment should read the scans by the gathers, %v", frags[0])
+	}
+	for _, frag := range frags[1:] {
+		scans := nodesOf(frag.Query, plan.Node_TABLE_SCAN)
+		if len(scans) != 1 || len(scans[0].WhereList) != len(scanOf(query, scans[0].TableDef.Name).WhereList) {
+			t.Fatalf("the scan should be run by fragment %d with its filters, %v", frag.Id, frag)
+		}
+	}
+	if hasExchange(query) {
+		t.Fatalf("the query should not be modified")
+	}
+
+	// The exchanges planned are kept
+	again, err := FragmentQuery(frags[0].Query)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(again) != 1 || !proto.Equal(again[0].Query, frags[0].Query) {
+		t.Fatalf("the root fragment should not be gathered again, %v", again)
+	}
+
+	// A correlated column of the other fragment
+	bad := &Query{
+		Steps: []int32{2},
+		Nodes: []*Node{
+			{NodeType: plan.Node_TABLE_SCAN, NodeId: 0, WhereList: []*Expr{{Expr: &plan.Expr_Corr{Corr: &plan.CorrColRef{NodeId: 2}}}}},
+			{NodeType: plan.Node_GATHER, NodeId: 1, Children: []int32{0}},
+			{NodeType: plan.Node_PROJECT, NodeId: 2, Children: []int32{1}},
+		},
+	}
+	if _, err := FragmentQuery(bad); err == nil {
+		t.Fatalf("the fragment referring to another one should not be cut")
+	}
+}
+
+func TestFragmentTPCH(t *testing.T) {
+	_, fn, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(fn)
+	ctx := newStatsCompilerContext()
+	for qn := 1; qn <= 22; qn++ {
+		data, err := os.ReadFile(fmt.Sprintf("%s/tpch/q%d.sql", dir, qn))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		stmts, err := parsers.Parse(dialect.MYSQL, string(data))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, stmt := range stmts {
+			pn, err := BuildPlan(ctx, stmt)
+			if err != nil || pn.GetQuery() == nil {
+				// The views
+				continue
+			}
+			frags, err := FragmentQuery(pn.GetQuery())
+			if err != nil {
+				t.Fatalf("query %d: %+v", qn, err)
+			}
+			checkFragments(t, frags)
+			if len(frags) < 2 {
+				t.Fatalf("the scans of query %d should be gathered", qn)
+			}
+		}
+	}
+}
+
+func TestDecodeFragment(t *testing.T) {
+	frag := &Fragment{
+		Version: FragmentVersion,
+		Parent:  -1,
+		Query: &Query{
+			Steps: []int32{0},
+			Nodes: []*Node{{NodeType: plan.Node_PROJECT, Children: []int32{1}}},
+		},
+	}
+	data, _ := EncodeFragment(frag)
+	if _, err := DecodeFragment(data); err == nil {
+		t.Fatalf("the fragment without the child should not be decoded")
+	}
+	frag.Query.Nodes[0].Children = nil
+	frag.Version = FragmentVersion + 1
+	data, _ = EncodeFragment(frag)
+	if _, err := DecodeFragment(data); err == nil {
+		t.Fatalf("the fragment of a newer version should not be decoded")
+	}
+	if _, err := DecodeFragment([]byte{0xff, 0xff}); err == nil {
+		t.Fatalf("the corrupted fragment should not be decoded")
+	}
+}
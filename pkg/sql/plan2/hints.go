@@ -0,0 +1,285 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// planHints are the optimizer hints of the query blocks resolved against
+// the tables of their FROM clauses. The hints not honored are reported as
+// the warnings of the query
+type planHints struct {
+	joinOrders []*joinOrderHint
+	// joinMethods are the hints of the methods of the joins of the tables
+	joinMethods map[int32]*joinMethodHint
+	warnings    []string
+}
+
+// joinOrderHint keeps the tables of a JOIN_FIXED_ORDER hint in the textual
+// order, or joins the ones of a JOIN_ORDER hint first in its order
+type joinOrderHint struct {
+	text  string
+	ids   []int32
+	fixed bool
+	// applied is true once a join of the tables is ordered by the hint
+	applied bool
+}
+
+type joinMethodHint struct {
+	text   string
+	table  string
+	method string
+	// joined is true if the table is joined, applied if the join is of
+	// the method
+	joined, applied bool
+}
+
+// resolveHints resolves the hints of a query block against its tables. The
+// index hints are applied to the scans at once
+func resolveHints(hints tree.Hints, query *Query, selectCtx *SelectContext) {
+	h := selectCtx.hints
+	if h == nil || len(hints) == 0 {
+		return
+	}
+	for _, hint := range hints {
+		text := tree.String(hint, dialect.MYSQL)
+		if hint.Name == "" {
+			h.warnf("syntax error in the hints near '%s'", text)
+			break
+		}
+		switch hint.Name {
+		case "JOIN_FIXED_ORDER":
+			jh := &joinOrderHint{text: text, fixed: true}
+			for _, id := range selectCtx.tables {
+				jh.ids = append(jh.ids, id)
+			}
+			h.joinOrders = append(h.joinOrders, jh)
+		case "JOIN_ORDER":
+			jh := &joinOrderHint{text: text}
+			for _, name := range hint.Args {
+				id, ok := selectCtx.tables[strings.ToLower(name)]
+				if !ok {
+					h.warnf("%s is ignored: unknown table '%s'", text, name)
+					jh = nil
+					break
+				}
+				if jh.rank(id) < len(jh.ids) {
+					h.warnf("%s is ignored: table '%s' is repeated", text, name)
+					jh = nil
+					break
+				}
+				jh.ids = append(jh.ids, id)
+			}
+			if jh != nil && len(jh.ids) > 0 {
+				h.joinOrders = append(h.joinOrders, jh)
+			}
+		case "HASH_JOIN", "NO_HASH_JOIN":
+			method := JoinMethodHash
+			if hint.Name == "NO_HASH_JOIN" {
+				method = JoinMethodNestedLoop
+			}
+			names := hint.Args
+			if len(names) == 0 {
+				for name := range selectCtx.tables {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+			}
+			for _, name := range names {
+				id, ok := selectCtx.tables[strings.ToLower(name)]
+				if !ok {
+					h.warnf("%s is ignored: unknown table '%s'", text, name)
+					continue
+				}
+				if h.joinMethods == nil {
+					h.joinMethods = make(map[int32]*joinMethodHint)
+				}
+				if _, ok := h.joinMethods[id]; ok {
+					h.warnf("%s is ignored: conflicting with an earlier hint of table '%s'", text, name)
+					continue
+				}
+				h.joinMethods[id] = &joinMethodHint{text: text, table: name, method: method}
+			}
+		case "INDEX", "NO_INDEX":
+			h.resolveIndexHint(hint, text, query, selectCtx)
+		default:
+			h.warnf("hint '%s' is not supported", text)
+		}
+	}
+}
+
+// resolveIndexHint restricts the pruning predicates of the scan of an
+// INDEX hint to the columns of the indexes, or the ones of a NO_INDEX hint
+// to the columns of the other indexes. PRIMARY is the index of the primary
+// key
+func (h *planHints) resolveIndexHint(hint *tree.Hint, text string, query *Query, selectCtx *SelectContext) {
+	if len(hint.Args) == 0 {
+		h.warnf("%s is ignored: no table", text)
+		return
+	}
+	id, ok := selectCtx.tables[strings.ToLower(hint.Args[0])]
+	if !ok {
+		h.warnf("%s is ignored: unknown table '%s'", text, hint.Args[0])
+		return
+	}
+	if int(id) >= len(query.Nodes) || query.Nodes[id].NodeId != id {
+		h.warnf("%s is ignored: '%s' is not a table", text, hint.Args[0])
+		return
+	}
+	node := query.Nodes[id]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		h.warnf("%s is ignored: '%s' is not a table", text, hint.Args[0])
+		return
+	}
+	if node.PruneInfo.GetRestricted() {
+		h.warnf("%s is ignored: conflicting with an earlier hint of table '%s'", text, hint.Args[0])
+		return
+	}
+	indexes := tableIndexes(node.TableDef)
+	names := hint.Args[1:]
+	if len(names) == 0 && hint.Name == "INDEX" {
+		if len(indexes) == 0 {
+			h.warnf("%s is ignored: table '%s' has no index", text, hint.Args[0])
+			return
+		}
+		for name := range indexes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	cols := make(map[string]bool)
+	for _, name := range names {
+		idx, ok := indexes[strings.ToLower(name)]
+		if !ok {
+			h.warnf("%s is ignored: unknown index '%s' of table '%s'", text, name, hint.Args[0])
+			return
+		}
+		for _, col := range idx {
+			cols[col] = true
+		}
+	}
+	// NO_INDEX(t) restricts the predicates to no column
+	info := &plan.PruneInfo{Restricted: true}
+	for _, col := range node.TableDef.Cols {
+		if len(names) == 0 {
+			break
+		}
+		if cols[col.Name] == (hint.Name == "INDEX") {
+			info.Columns = append(info.Columns, col.Name)
+		}
+	}
+	node.PruneInfo = info
+}
+
+// tableIndexes returns the columns of the indexes of def by their names
+func tableIndexes(def *TableDef) map[string][]string {
+	indexes := make(map[string][]string)
+	for _, col := range def.Cols {
+		if col.Primary {
+			indexes["primary"] = append(indexes["primary"], col.Name)
+		}
+	}
+	for _, d := range def.Defs {
+		switch {
+		case d.GetPk() != nil:
+			indexes["primary"] = d.GetPk().Names
+		case d.GetIdx() != nil:
+			indexes[strings.ToLower(d.GetIdx().Name)] = d.GetIdx().ColNames
+		}
+	}
+	return indexes
+}
+
+func (h *planHints) warnf(format string, args ...interface{}) {
+	h.warnings = append(h.warnings, fmt.Sprintf(format, args...))
+}
+
+// joinOrder returns the join order hint of the joins of ids, which keeps
+// the order of any of them, or orders all the tables of the hint
+func (h *planHints) joinOrder(ids []int32) *joinOrderHint {
+	if h == nil {
+		return nil
+	}
+	in := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		in[id] = true
+	}
+	for _, jh := range h.joinOrders {
+		all, any := true, false
+		for _, id := range jh.ids {
+			all = all && in[id]
+			any = any || in[id]
+		}
+		if (jh.fixed && any) || (!jh.fixed && all) {
+			return jh
+		}
+	}
+	return nil
+}
+
+// rank returns the position of id in the hint, which is after all the
+// tables of the hint if it is not one of them
+func (jh *joinOrderHint) rank(id int32) int {
+	for i, hid := range jh.ids {
+		if hid == id {
+			return i
+		}
+	}
+	return len(jh.ids)
+}
+
+// joinMethod returns the join method hint of the table id
+func (h *planHints) joinMethod(id int32) *joinMethodHint {
+	if h == nil {
+		return nil
+	}
+	return h.joinMethods[id]
+}
+
+// report returns the warnings of the hints which are not honored
+func (h *planHints) report() []string {
+	if h == nil {
+		return nil
+	}
+	warnings := h.warnings
+	for _, jh := range h.joinOrders {
+		if !jh.applied {
+			warnings = append(warnings, fmt.Sprintf("%s is not honored: the tables are not joined by a chain of inner joins", jh.text))
+		}
+	}
+	var ids []int32
+	for id := range h.joinMethods {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		switch mh := h.joinMethods[id]; {
+		case !mh.joined:
+			warnings = append(warnings, fmt.Sprintf("%s is not honored for table '%s': the table is not joined", mh.text, mh.table))
+		case !mh.applied:
+			warnings = append(warnings, fmt.Sprintf("%s is not honored for table '%s': no equi condition", mh.text, mh.table))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+)
+
+func hasWarning(query *Query, substr string) bool {
+	for _, w := range query.Warnings {
+		if strings.Contains(w, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestJoinOrderHint(t *testing.T) {
+	sql := "SELECT /*+ JOIN_ORDER(O, C, L) */ * FROM LINEITEM L, ORDERS O, CUSTOMER C WHERE L_ORDERKEY = O_ORDERKEY AND O_CUSTKEY = C_CUSTKEY"
+	query := buildWithStats(t, sql, true)
+	root := query.Nodes[query.Steps[0]]
+	if scanName(query, root.Children[1]) != "lineitem" {
+		t.Fatalf("lineitem should be joined last, children are %v", root.Children)
+	}
+	bottom := query.Nodes[root.Children[0]]
+	if scanName(query, bottom.Children[0]) != "orders" || scanName(query, bottom.Children[1]) != "customer" {
+		t.Fatalf("orders should be joined with customer first, children are %v", bottom.Children)
+	}
+	if len(query.Warnings) != 0 {
+		t.Fatalf("the hint should be honored, %v", query.Warnings)
+	}
+
+	// Without statistics
+	sql = "SELECT /*+ JOIN_ORDER(C, N) */ * FROM NATION N JOIN CUSTOMER C ON N_NATIONKEY = C_NATIONKEY"
+	query = buildWithStats(t, sql, false)
+	if root := query.Nodes[query.Steps[0]]; scanName(query, root.Children[0]) != "customer" || len(query.Warnings) != 0 {
+		t.Fatalf("customer should be joined first, children are %v, %v", root.Children, query.Warnings)
+	}
+	// Against the build side of the estimates
+	sql = "SELECT /*+ JOIN_ORDER(N, C) */ * FROM NATION N JOIN CUSTOMER C ON N_NATIONKEY = C_NATIONKEY WHERE C_CUSTKEY > 10"
+	query = buildWithStats(t, sql, true)
+	if root := query.Nodes[query.Steps[0]]; scanName(query, root.Children[0]) != "nation" {
+		t.Fatalf("nation should be joined first, children are %v", root.Children)
+	}
+
+	sql = "SELECT /*+ JOIN_FIXED_ORDER() */ * FROM CUSTOMER, ORDERS, NATION, REGION WHERE C_CUSTKEY = O_CUSTKEY AND C_NATIONKEY = N_NATIONKEY AND N_REGIONKEY = R_REGIONKEY"
+	query = buildWithStats(t, sql, true)
+	if root := query.Nodes[query.Steps[0]]; scanName(query, root.Children[1]) != "region" || len(root.WhereList) != 3 {
+		t.Fatalf("the joins should be kept in order, children are %v", root.Children)
+	}
+
+	warnings := map[string]string{
+		"SELECT /*+ JOIN_ORDER(R, N) */ * FROM NATION N LEFT JOIN REGION R ON N_REGIONKEY = R_REGIONKEY": "is not honored",
+		"SELECT /*+ JOIN_ORDER(N, X) */ * FROM NATION N JOIN REGION R ON N_REGIONKEY = R_REGIONKEY":      "unknown table 'X'",
+		"SELECT /*+ JOIN_ORDER(N, N) */ * FROM NATION N JOIN REGION R ON N_REGIONKEY = R_REGIONKEY":      "is repeated",
+	}
+	for sql, w := range warnings {
+		if query := buildWithStats(t, sql, true); !hasWarning(query, w) {
+			t.Fatalf("'%s' should warn '%s' but warns %v", sql, w, query.Warnings)
+		}
+	}
+}
+
+func TestJoinMethodHint(t *testing.T) {
+	sql := "SELECT /*+ NO_HASH_JOIN(C) */ * FROM NATION JOIN CUSTOMER C ON N_NATIONKEY = C_NATIONKEY"
+	query := buildWithStats(t, sql, true)
+	if root := query.Nodes[query.Steps[0]]; root.ExtraOptions != JoinMethodNestedLoop || len(query.Warnings) != 0 {
+		t.Fatalf("the join should be a nested loop join but now is %v, %v", root.ExtraOptions, query.Warnings)
+	}
+
+	warnings := map[string]string{
+		"SELECT /*+ HASH_JOIN(C) */ * FROM NATION JOIN CUSTOMER C ON N_NATIONKEY > C_NATIONKEY": "no equi condition",
+		"SELECT /*+ HASH_JOIN(NATION) */ N_NAME FROM NATION":                                    "is not joined",
+		"SELECT /*+ HASH_JOIN(NATION) NO_HASH_JOIN(NATION) */ N_NAME FROM NATION, REGION":       "conflicting",
+	}
+	for sql, w := range warnings {
+		if query := buildWithStats(t, sql, true); !hasWarning(query, w) {
+			t.Fatalf("'%s' should warn '%s' but warns %v", sql, w, query.Warnings)
+		}
+	}
+	sql = "SELECT /*+ HASH_JOIN(C) */ * FROM NATION JOIN CUSTOMER C ON N_NATIONKEY > C_NATIONKEY"
+	if root := buildWithStats(t, sql, true).Nodes[0]; root.ExtraOptions == JoinMethodHash {
+		t.Fatalf("the join without equi conditions should not be a hash join")
+	}
+}
+
+func TestIndexHint(t *testing.T) {
+	where := " N_NAME FROM NATION WHERE N_NATIONKEY < 6 AND N_REGIONKEY = 1"
+	cols := map[string][]string{
+		"SELECT /*+ INDEX(NATION N_REGIONKEY_IDX) */":     {"n_regionkey"},
+		"SELECT /*+ NO_INDEX(NATION PRIMARY) */":          {"n_regionkey"},
+		"SELECT /*+ INDEX(NATION PRIMARY) */":             {"n_nationkey"},
+		"SELECT /*+ NO_INDEX(NATION) */":                  {},
+		"SELECT /*+ INDEX(NATION) */":                     {"n_nationkey", "n_regionkey"},
+		"SELECT /*+ INDEX(NATION FOO) */":                 {"n_nationkey", "n_regionkey"},
+		"SELECT /*+ INDEX(NATION PRIMARY) FOO(NATION) */": {"n_nationkey"},
+	}
+	for hint, names := range cols {
+		query := buildWithStats(t, hint+where, false)
+		preds := scanOf(query, "nation").PruneInfo.Predicates
+		if len(preds) != len(names) {
+			t.Fatalf("'%s' should prune by %v but now by %v", hint, names, preds)
+		}
+		for i, e := range preds {
+			if name := e.Expr.(*plan.Expr_F).F.Args[0].GetCol().Name; name != names[i] {
+				t.Fatalf("'%s' should prune by %v but now by %v", hint, names, preds)
+			}
+		}
+	}
+
+	warnings := map[string]string{
+		"SELECT /*+ INDEX(NATION FOO) */" + where:                           "unknown index 'FOO'",
+		"SELECT /*+ INDEX(N) */ * FROM (SELECT N_NAME FROM NATION) N":       "is not a table",
+		"SELECT /*+ INDEX(NATION PRIMARY) INDEX(NATION PRIMARY) */" + where: "conflicting",
+		"SELECT /*+ FOO(NATION) */" + where:                                 "is not supported",
+		"SELECT /*+ INDEX(NATION */" + where:                                "syntax error",
+		"SELECT /*+ INDEX(LINEITEM) */ * FROM LINEITEM":                     "has no index",
+	}
+	for sql, w := range warnings {
+		if query := buildWithStats(t, sql, false); !hasWarning(query, w) {
+			t.Fatalf("'%s' should warn '%s' but warns %v", sql, w, query.Warnings)
+		}
+	}
+
+	// Kept by the binds
+	stmt, err := mysql.ParseOne("SELECT /*+ NO_INDEX(NATION PRIMARY) */ N_NAME FROM NATION WHERE N_NATIONKEY = ? AND N_REGIONKEY = 1")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ctx := NewMockOptimizer().CurrentContext()
+	p, err := Prepare(ctx, stmt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	pn, err := p.Bind(ctx, []*Const{{Value: &plan.Const_Ival{Ival: 3}}})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if preds := scanOf(pn.GetQuery(), "nation").PruneInfo.Predicates; len(preds) != 1 {
+		t.Fatalf("the predicates should be restricted after the binds, %v", preds)
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// MaxDPJoinRelations is the max number of the relations of a chain of inner
+// joins ordered by dynamic programming. The longer ones are ordered
+// greedily
+const MaxDPJoinRelations = 10
+
+// joinTree is a tree of the joins of the leaves of a chain
+type joinTree struct {
+	// leaf is the index of the leaf, -1 for a join
+	leaf        int
+	left, right *joinTree
+	// lo and hi are the range of the ranks of the leaves below in the
+	// order of the output
+	lo, hi int
+	node   *Node
+}
+
+// joinGraph is the leaves of a chain of inner joins and the equi
+// conditions between them
+type joinGraph struct {
+	rels  []*relStats
+	conds []joinEdge
+}
+
+type joinEdge struct {
+	l, r int
+	// sel is the selectivity of the condition
+	sel float64
+}
+
+func leftDeepTree(order []int) *joinTree {
+	t := &joinTree{leaf: order[0]}
+	for _, i := range order[1:] {
+		t = &joinTree{leaf: -1, left: t, right: &joinTree{leaf: i}}
+	}
+	return t
+}
+
+func (t *joinTree) equal(o *joinTree) bool {
+	if t.leaf >= 0 || o.leaf >= 0 {
+		return t.leaf == o.leaf
+	}
+	return t.left.equal(o.left) && t.right.equal(o.right)
+}
+
+// leaves appends the leaves of t in the order of the output and sets the
+// ranges of ranks
+func (t *joinTree) leaves(order []int) []int {
+	t.lo = len(order)
+	if t.leaf >= 0 {
+		order = append(order, t.leaf)
+	} else {
+		order = t.right.leaves(t.left.leaves(order))
+	}
+	t.hi = len(order)
+	return order
+}
+
+// joins appends the joins of t in post order
+func (t *joinTree) joins(list []*joinTree) []*joinTree {
+	if t.leaf >= 0 {
+		return list
+	}
+	return append(t.right.joins(t.left.joins(list)), t)
+}
+
+// lca returns the lowest join of t joining the leaves of ranks a and b
+func (t *joinTree) lca(a, b int) *joinTree {
+	for t.leaf < 0 {
+		switch {
+		case a < t.left.hi && b < t.left.hi:
+			t = t.left
+		case a >= t.right.lo && b >= t.right.lo:
+			t = t.right
+		default:
+			return t
+		}
+	}
+	return t
+}
+
+// dpOrder returns the join tree of the least sum of the cards of the
+// joins, enumerating the splits of all the subsets of the leaves
+func (g *joinGraph) dpOrder() *joinTree {
+	n := len(g.rels)
+	full := 1<<n - 1
+	costs := make([]float64, full+1)
+	trees := make([]*joinTree, full+1)
+	for s := 1; s <= full; s++ {
+		card := 1.0
+		for i := 0; i < n; i++ {
+			if s&(1<<i) != 0 {
+				card *= g.rels[i].card
+			}
+		}
+		for _, c := range g.conds {
+			if s&(1<<c.l) != 0 && s&(1<<c.r) != 0 {
+				card *= c.sel
+			}
+		}
+		if bits.OnesCount(uint(s)) == 1 {
+			trees[s] = &joinTree{leaf: bits.TrailingZeros(uint(s))}
+			continue
+		}
+		// The left side has the lowest leaf, the build sides are picked
+		// by the cards later
+		low := s & -s
+		for l := (s - 1) & s; l > 0; l = (l - 1) & s {
+			if l&low == 0 {
+				continue
+			}
+			r := s ^ l
+			cost := costs[l] + costs[r] + card
+			if trees[s] == nil || cost < costs[s] {
+				costs[s] = cost
+				trees[s] = &joinTree{leaf: -1, left: trees[l], right: trees[r]}
+			}
+		}
+	}
+	return trees[full]
+}
+
+// cost returns the sum of the cards of the joins of t, which dpOrder
+// minimizes, and the set of the leaves below t
+func (g *joinGraph) cost(t *joinTree) (float64, []bool) {
+	if t.leaf >= 0 {
+		set := make([]bool, len(g.rels))
+		set[t.leaf] = true
+		return 0, set
+	}
+	lc, set := g.cost(t.left)
+	rc, rset := g.cost(t.right)
+	card := 1.0
+	for i, in := range rset {
+		set[i] = set[i] || in
+	}
+	for i, in := range set {
+		if in {
+			card *= g.rels[i].card
+		}
+	}
+	for _, c := range g.conds {
+		if set[c.l] && set[c.r] {
+			card *= c.sel
+		}
+	}
+	return lc + rc + card, set
+}
+
+// greedyOrder returns the left deep join tree starting from the leaves of
+// prefix, or the smallest leaf if it is empty, and joining the one
+// connected giving the smallest output next
+func (g *joinGraph) greedyOrder(prefix []int) *joinTree {
+	n := len(g.rels)
+	order := make([]int, 0, n)
+	used := make([]bool, n)
+	if len(prefix) == 0 {
+		first := 0
+		for i := 1; i < n; i++ {
+			if g.rels[i].card < g.rels[first].card {
+				first = i
+			}
+		}
+		prefix = []int{first}
+	}
+	card := 1.0
+	for _, i := range prefix {
+		card *= g.rels[i].card
+		for _, cd := range g.conds {
+			if (cd.l == i && used[cd.r]) || (cd.r == i && used[cd.l]) {
+				card *= cd.sel
+			}
+		}
+		order = append(order, i)
+		used[i] = true
+	}
+	for len(order) < n {
+		best, bestCard, bestConnected := -1, 0.0, false
+		for i := 0; i < n; i++ {
+			if used[i] {
+				continue
+			}
+			c, connected := card*g.rels[i].card, false
+			for _, cd := range g.conds {
+				if (cd.l == i && used[cd.r]) || (cd.r == i && used[cd.l]) {
+					c *= cd.sel
+					connected = true
+				}
+			}
+			if best < 0 || (connected && !bestConnected) || (connected == bestConnected && c < bestCard) {
+				best, bestCard, bestConnected = i, c, connected
+			}
+		}
+		order = append(order, best)
+		used[best] = true
+		card = bestCard
+	}
+	return leftDeepTree(order)
+}
+
+// reorderJoins reorders the chain of inner joins headed by top by the
+// estimates of its leaves. The chains of up to MaxDPJoinRelations leaves
+// are ordered by dynamic programming and the longer ones greedily. The
+// equi conditions of the WHERE list are pushed down to the lowest joins
+// reaching both sides, and the output of top is kept. Nothing is
+// reordered unless all the leaves are analyzed. The tables of a JOIN_ORDER
+// hint are joined first in its order, and the chains of the tables of a
+// JOIN_FIXED_ORDER hint are kept
+func (o *optimizer) reorderJoins(top *Node) {
+	if !o.joinChain(top, true) {
+		return
+	}
+	var joins []*Node
+	var leaves []int32
+	for node := top; ; {
+		o.reordered[node.NodeId] = true
+		joins = append(joins, node)
+		leaves = append(leaves, node.Children[1])
+		if !o.validNode(node.Children[0]) {
+			return
+		}
+		left := o.nodes[node.Children[0]]
+		if !o.joinChain(left, false) {
+			leaves = append(leaves, left.NodeId)
+			break
+		}
+		node = left
+	}
+	hint := o.hints.joinOrder(leaves)
+	if hint != nil && hint.fixed {
+		hint.applied = true
+		for _, node := range joins {
+			o.fixed[node.NodeId] = true
+		}
+		o.trace.add(TraceJoinOrder, top.NodeId, "kept by the hint "+hint.text)
+		return
+	}
+	n := len(leaves)
+	if hint == nil && (o.noJoinReorder || n < 3) {
+		if o.noJoinReorder {
+			o.trace.add(TraceJoinOrder, top.NodeId, "kept in the textual order, the join reorder is off")
+		}
+		return
+	}
+	for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+		leaves[i], leaves[j] = leaves[j], leaves[i]
+	}
+
+	// The positions of the columns in the WHERE list of top
+	g := &joinGraph{rels: make([]*relStats, n)}
+	offs := make([]int, n+1)
+	for i, id := range leaves {
+		g.rels[i] = o.visit(id)
+		if (!g.rels[i].analyzed && hint == nil) || len(g.rels[i].cols) != len(o.nodes[id].ProjectList) {
+			o.trace.add(TraceJoinOrder, top.NodeId, fmt.Sprintf("kept in the textual order, %s is not analyzed", o.nodeName(id)))
+			return
+		}
+		offs[i+1] = offs[i] + len(g.rels[i].cols)
+	}
+	leafOf := func(k int) int {
+		for i := 0; i < n; i++ {
+			if k < offs[i+1] {
+				return i
+			}
+		}
+		return -1
+	}
+	ndv := func(k int) float64 {
+		i := leafOf(k)
+		if col := g.rels[i].cols[k-offs[i]]; col != nil && col.Ndv > 0 {
+			return col.Ndv
+		}
+		return g.rels[i].card
+	}
+	for _, e := range top.WhereList {
+		if l, r, ok := equiCond(e); ok && l < offs[n] && r < offs[n] && leafOf(l) != leafOf(r) {
+			g.conds = append(g.conds, joinEdge{
+				l:   leafOf(l),
+				r:   leafOf(r),
+				sel: 1 / maxFloat(ndv(l), ndv(r), 1),
+			})
+		}
+	}
+
+	var tree *joinTree
+	switch {
+	case hint != nil:
+		prefix := make([]int, len(hint.ids))
+		for i, id := range leaves {
+			if r := hint.rank(id); r < len(prefix) {
+				prefix[r] = i
+			}
+		}
+		tree = g.greedyOrder(prefix)
+		hint.applied = true
+		for _, node := range joins {
+			o.fixed[node.NodeId] = true
+		}
+	case n <= MaxDPJoinRelations:
+		tree = g.dpOrder()
+	default:
+		tree = g.greedyOrder(nil)
+	}
+	identity := make([]int, n)
+	for i := range identity {
+		identity[i] = i
+	}
+	if o.trace != nil {
+		o.traceJoinOrder(top, g, leaves, tree, hint, leftDeepTree(identity))
+	}
+	if tree.equal(leftDeepTree(identity)) {
+		return
+	}
+	o.rewriteJoins(top, joins[1:], leaves, offs, tree)
+}
+
+// traceJoinOrder records the join orders considered for the chain headed by
+// top, of which tree is chosen
+func (o *optimizer) traceJoinOrder(top *Node, g *joinGraph, leaves []int32, tree *joinTree, hint *joinOrderHint, textual *joinTree) {
+	var alts []*TraceAlternative
+	consider := func(t *joinTree) {
+		for _, alt := range alts {
+			if alt.Plan == t.format(leaves, o.nodeName) {
+				return
+			}
+		}
+		cost, _ := g.cost(t)
+		alts = append(alts, &TraceAlternative{
+			Plan:   t.format(leaves, o.nodeName),
+			Cost:   cost,
+			Chosen: t == tree,
+		})
+	}
+	detail := fmt.Sprintf("%d tables ordered by dynamic programming", len(leaves))
+	switch {
+	case hint != nil:
+		detail = "ordered by the hint " + hint.text
+	case len(leaves) > MaxDPJoinRelations:
+		detail = fmt.Sprintf("%d tables ordered greedily", len(leaves))
+	}
+	consider(tree)
+	consider(textual)
+	if len(leaves) <= MaxDPJoinRelations && hint != nil {
+		consider(g.dpOrder())
+	}
+	consider(g.greedyOrder(nil))
+	for _, alt := range alts[1:] {
+		switch {
+		case hint != nil:
+			alt.Reason = "overridden by the hint"
+		case alt.Cost > alts[0].Cost:
+			alt.Reason = "costs more"
+		default:
+			alt.Reason = "costs the same as the one chosen"
+		}
+	}
+	o.trace.add(TraceJoinOrder, top.NodeId, detail, alts...)
+}
+
+// rewriteJoins rebuilds the chain headed by top from tree with the joins
+// of the chain, the original positions of the columns of the leaves are
+// at offs
+func (o *optimizer) rewriteJoins(top *Node, joins []*Node, leaves []int32, offs []int, tree *joinTree) {
+	n := len(leaves)
+	order := tree.leaves(nil)
+	noffs := make([]int, n)
+	off := 0
+	for _, i := range order {
+		noffs[i] = off
+		off += offs[i+1] - offs[i]
+	}
+	leafOf := func(k int) int {
+		for i := 0; i < n; i++ {
+			if k < offs[i+1] {
+				return i
+			}
+		}
+		return -1
+	}
+	pos := func(k int32) int32 {
+		i := leafOf(int(k))
+		if i < 0 {
+			return k
+		}
+		return int32(noffs[i] + int(k) - offs[i])
+	}
+	rankOf := func(k int) int {
+		for r, i := range order {
+			if k < noffs[i]+offs[i+1]-offs[i] {
+				return r
+			}
+		}
+		return n
+	}
+
+	id := func(t *joinTree) int32 {
+		if t.leaf >= 0 {
+			return leaves[t.leaf]
+		}
+		return t.node.NodeId
+	}
+	list := tree.joins(nil)
+	for j, t := range list {
+		if t == tree {
+			t.node = top
+		} else {
+			t.node = joins[j]
+		}
+		t.node.Children = []int32{id(t.left), id(t.right)}
+		if t != tree {
+			fillJoinProjectList(t.node, o.nodes[id(t.left)], o.nodes[id(t.right)])
+		}
+	}
+	seen := make(map[*plan.ColRef]bool)
+	if o.steps[top.NodeId] {
+		normalizeRefs(top.ProjectList, offs[n-1])
+		remapExprs(top.ProjectList, pos, seen)
+	} else {
+		tmp := &plan.Node{}
+		fillJoinProjectList(tmp, o.nodes[top.Children[0]], o.nodes[top.Children[1]])
+		for k := range top.ProjectList {
+			top.ProjectList[k] = tmp.ProjectList[pos(int32(k))]
+		}
+	}
+	remapExprs(top.WhereList, pos, seen)
+
+	// The positions in a join are the ones in top less the offset of its
+	// first leaf, as the leaves below a join are contiguous
+	where := top.WhereList[:0]
+	for _, e := range top.WhereList {
+		if l, r, ok := equiCond(e); ok {
+			if t := tree.lca(rankOf(l), rankOf(r)); t != tree && t.leaf < 0 {
+				start := int32(noffs[order[t.lo]])
+				remapExprs([]*Expr{e}, func(k int32) int32 {
+					return k - start
+				}, make(map[*plan.ColRef]bool))
+				t.node.OnList = append(t.node.OnList, e)
+				continue
+			}
+		}
+		where = append(where, e)
+	}
+	top.WhereList = where
+}
@@ -19,11 +19,19 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
 )
 
 type MockCompilerContext struct {
 	objects map[string]*plan.ObjectRef
 	tables  map[string]*plan.TableDef
+
+	noJoinReorder bool
+	// trace is the trace of the last query optimized if traceOptimizer
+	traceOptimizer bool
+	trace          *OptimizerTrace
+	// settings is the default ones of the node if nil
+	settings *settings.Settings
 }
 
 type col struct {
@@ -130,6 +138,23 @@ func NewMockCompilerContext() *MockCompilerContext {
 		{"l_comment", plan.Type_VARCHAR, false, 44, 0},
 	}
 
+	primaryKeys := map[string]string{
+		"nation":   "n_nationkey",
+		"region":   "r_regionkey",
+		"part":     "p_partkey",
+		"supplier": "s_suppkey",
+		"customer": "c_custkey",
+		"orders":   "o_orderkey",
+	}
+
+	indexes := map[string]*plan.IndexDef{
+		"nation": {
+			Typ:      plan.IndexDef_ZONEMAP,
+			Name:     "n_regionkey_idx",
+			ColNames: []string{"n_regionkey"},
+		},
+	}
+
 	defaultDbName := "tpch"
 
 	//build tpch context data(schema)
@@ -145,8 +170,9 @@ func NewMockCompilerContext() *MockCompilerContext {
 					Width:     col.Width,
 					Precision: col.Precision,
 				},
-				Name:  col.Name,
-				Pkidx: 1,
+				Name:    col.Name,
+				Pkidx:   1,
+				Primary: col.Name == primaryKeys[tableName],
 			})
 		}
 
@@ -165,6 +191,11 @@ func NewMockCompilerContext() *MockCompilerContext {
 			Name: tableName,
 			Cols: colDefs,
 		}
+		if idx, ok := indexes[tableName]; ok {
+			tables[tableName].Defs = append(tables[tableName].Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Idx{Idx: idx},
+			})
+		}
 		tableIdx++
 	}
 
@@ -202,6 +233,50 @@ func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) *Cost {
 	return c
 }
 
+// JoinReorder implements OptimizerOptions
+func (m *MockCompilerContext) JoinReorder() bool {
+	return !m.noJoinReorder
+}
+
+// SetJoinReorder lets the optimizer reorder the inner joins or not
+func (m *MockCompilerContext) SetJoinReorder(on bool) {
+	m.noJoinReorder = !on
+}
+
+// Settings implements SessionSettings
+func (m *MockCompilerContext) Settings() *settings.Settings {
+	if m.settings == nil {
+		return settings.Default()
+	}
+	return m.settings
+}
+
+// SetSettings sets the settings of the session planning the queries
+func (m *MockCompilerContext) SetSettings(s *settings.Settings) {
+	m.settings = s
+}
+
+// OptimizerTraceEnabled implements OptimizerTracer
+func (m *MockCompilerContext) OptimizerTraceEnabled() bool {
+	return m.traceOptimizer
+}
+
+// SetOptimizerTrace implements OptimizerTracer
+func (m *MockCompilerContext) SetOptimizerTrace(trace *OptimizerTrace) {
+	m.trace = trace
+}
+
+// SetTraceOptimizer lets the optimizer trace the queries or not
+func (m *MockCompilerContext) SetTraceOptimizer(on bool) {
+	m.traceOptimizer = on
+}
+
+// OptimizerTrace returns the trace of the last query optimized, nil if
+// the optimizer is not traced
+func (m *MockCompilerContext) OptimizerTrace() *OptimizerTrace {
+	return m.trace
+}
+
 type MockOptimizer struct {
 	ctxt MockCompilerContext
 }
@@ -228,6 +303,26 @@ func (moc *MockOptimizer) Optimize(stmt tree.Statement) (*Query, error) {
 	return query.GetQuery(), nil
 }
 
+// SetJoinReorder lets the optimizer reorder the inner joins or not
+func (moc *MockOptimizer) SetJoinReorder(on bool) {
+	moc.ctxt.SetJoinReorder(on)
+}
+
+// SetTraceOptimizer lets the optimizer trace the queries or not
+func (moc *MockOptimizer) SetTraceOptimizer(on bool) {
+	moc.ctxt.SetTraceOptimizer(on)
+}
+
+// SetSettings sets the settings of the session planning the queries
+func (moc *MockOptimizer) SetSettings(s *settings.Settings) {
+	moc.ctxt.SetSettings(s)
+}
+
+// OptimizerTrace returns the trace of the last query optimized
+func (moc *MockOptimizer) OptimizerTrace() *OptimizerTrace {
+	return moc.ctxt.OptimizerTrace()
+}
+
 func (moc *MockOptimizer) CurrentContext() CompilerContext {
 	return &moc.ctxt
 }
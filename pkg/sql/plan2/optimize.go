@@ -0,0 +1,780 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// JoinMethodHash is the ExtraOptions of a join with equi conditions,
+	// which builds a hash table on the right child
+	JoinMethodHash = "hash join"
+	// JoinMethodNestedLoop is the ExtraOptions of a join without any
+	// equi condition
+	JoinMethodNestedLoop = "nested loop join"
+)
+
+const (
+	// defaultSelectivity is the selectivity of a filter not analyzed
+	defaultSelectivity = 0.1
+	// defaultRangeSelectivity is the selectivity of a range filter on a
+	// column without histogram
+	defaultRangeSelectivity = 1.0 / 3
+)
+
+// relStats is the estimated output of a node
+type relStats struct {
+	card float64
+	// cols has the statistics of the output columns, nil if unknown
+	cols []*ColumnStats
+	// analyzed is true if all the scans below have statistics. The plan is
+	// not rewritten by the estimates of the defaults
+	analyzed bool
+}
+
+type optimizer struct {
+	ctx   CompilerContext
+	query *Query
+	// nodes are the nodes of query by their ids, which are not their
+	// indexes once a subquery is built
+	nodes map[int32]*Node
+	// stats is nil if ctx has no statistics
+	stats Statistics
+	rels  map[int32]*relStats
+	steps map[int32]bool
+	// pinned are the nodes whose outputs are referenced by correlated
+	// subqueries, which are not rewritten
+	pinned    map[int32]bool
+	reordered map[int32]bool
+	// stepTops are the nodes which the roots of the steps are rewritten to
+	// by unnesting the subqueries
+	stepTops map[int32]int32
+	// noJoinReorder keeps the inner joins in the textual order
+	noJoinReorder bool
+	hints         *planHints
+	// fixed are the joins ordered by the hints, whose children are not
+	// swapped
+	fixed map[int32]bool
+	// trace is nil unless ctx traces the optimizer
+	trace *OptimizerTrace
+}
+
+// optimizeQuery inlines or materializes the common table expressions,
+// unnests the subqueries, turns the null rejected outer joins into inner
+// joins, pushes the filters down to the scans, estimates the costs of the
+// nodes of query with the statistics of ctx, reorders the chains of inner
+// joins, picks the join methods and the build sides, removes the left joins
+// not referenced, prunes the columns not referenced and lists the blocks
+// the scans read. The join orders and methods of the hints override the
+// ones of the estimates, and the hints not honored are reported as the
+// warnings of query. The rules applied and the alternatives weighed are
+// traced if ctx traces the optimizer
+func optimizeQuery(ctx CompilerContext, query *Query, hints *planHints) {
+	o := &optimizer{
+		ctx:       ctx,
+		query:     query,
+		nodes:     make(map[int32]*Node),
+		rels:      make(map[int32]*relStats),
+		steps:     make(map[int32]bool),
+		pinned:    make(map[int32]bool),
+		reordered: make(map[int32]bool),
+		hints:     hints,
+		fixed:     make(map[int32]bool),
+	}
+	o.stats, _ = ctx.(Statistics)
+	if opts, ok := ctx.(OptimizerOptions); ok {
+		o.noJoinReorder = !opts.JoinReorder()
+	}
+	tracer, ok := ctx.(OptimizerTracer)
+	if ok && tracer.OptimizerTraceEnabled() {
+		o.trace = &OptimizerTrace{Steps: []*TraceStep{}}
+	}
+	for _, node := range query.Nodes {
+		o.nodes[node.NodeId] = node
+	}
+	o.inlineCTEs()
+	o.unnestSubqueries()
+	for _, id := range query.Steps {
+		o.steps[id] = true
+	}
+	for _, node := range query.Nodes {
+		for _, list := range nodeExprLists(node) {
+			for _, e := range list {
+				o.pinCorrelated(e)
+			}
+		}
+	}
+	o.simplifyOuterJoins()
+	visited := make(map[int32]bool)
+	for _, id := range query.Steps {
+		o.pushDownFilters(id, visited)
+	}
+	for _, id := range query.Steps {
+		o.visit(id)
+	}
+	o.pruneColumns()
+	resolveBlocks(ctx, query)
+	warnings := hints.report()
+	for _, w := range warnings {
+		o.trace.add(TraceHint, -1, w)
+	}
+	query.Warnings = append(query.Warnings, warnings...)
+	if o.trace != nil {
+		tracer.SetOptimizerTrace(o.trace)
+	}
+}
+
+// pinCorrelated pins the nodes the correlated columns of e are resolved
+// against
+func (o *optimizer) pinCorrelated(e *Expr) {
+	walkExpr(e, func(e *Expr) {
+		corr, ok := e.Expr.(*plan.Expr_Corr)
+		if !ok {
+			return
+		}
+		for id := corr.Corr.NodeId; o.validNode(id) && !o.pinned[id]; {
+			o.pinned[id] = true
+			node := o.nodes[id]
+			if node.ProjectList != nil || len(node.Children) == 0 {
+				break
+			}
+			id = node.Children[0]
+		}
+	})
+}
+
+func (o *optimizer) validNode(id int32) bool {
+	_, ok := o.nodes[id]
+	return ok
+}
+
+func (o *optimizer) visit(id int32) *relStats {
+	if rel, ok := o.rels[id]; ok {
+		return rel
+	}
+	if !o.validNode(id) {
+		return &relStats{card: 1}
+	}
+	// Guards against the cycles
+	o.rels[id] = &relStats{card: 1}
+	node := o.nodes[id]
+	if node.NodeType == plan.Node_JOIN {
+		o.reorderJoins(node)
+	}
+	for _, child := range node.Children {
+		o.visit(child)
+	}
+	var rel *relStats
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		rel = o.estimateScan(node)
+	case plan.Node_CTE_SCAN:
+		rel = o.estimateCteScan(node)
+	case plan.Node_JOIN:
+		rel = o.estimateJoin(node)
+	default:
+		rel = o.estimateNode(node)
+	}
+	o.rels[id] = rel
+	return rel
+}
+
+func (o *optimizer) childCost(node *Node, i int) *Cost {
+	if i < len(node.Children) && o.validNode(node.Children[i]) {
+		if c := o.nodes[node.Children[i]].Cost; c != nil {
+			return c
+		}
+	}
+	return &Cost{}
+}
+
+// estimateScan estimates a scan with the statistics of the table, or with
+// the defaults of Cost if it is not analyzed
+func (o *optimizer) estimateScan(node *Node) *relStats {
+	def := o.ctx.Cost(node.ObjRef, nil)
+	rows := def.Card
+	in := make([]*ColumnStats, len(node.TableDef.Cols))
+	var ts *TableStats
+	if o.stats != nil {
+		ts = o.stats.Stats(node.ObjRef)
+	}
+	if ts != nil {
+		rows = ts.Rows
+		for i, col := range node.TableDef.Cols {
+			in[i] = ts.Columns[col.Name]
+		}
+	}
+	rel := &relStats{card: rows, analyzed: ts != nil}
+	for _, e := range node.WhereList {
+		rel.card *= selectivity(e, in)
+	}
+	rel.cols = projectCols(node, in)
+	rel.capNdv()
+	node.Cost = &Cost{
+		Card:    rel.card,
+		Ndv:     rel.ndv(),
+		Rowsize: def.Rowsize,
+		Total:   rows,
+	}
+	return rel
+}
+
+// estimateNode estimates a node of one child passing its rows through,
+// filtering or aggregating them
+func (o *optimizer) estimateNode(node *Node) *relStats {
+	child := &relStats{card: 1, analyzed: true}
+	if len(node.Children) > 0 {
+		child = o.visit(node.Children[0])
+	}
+	rel := &relStats{card: child.card, analyzed: child.analyzed}
+	if node.NodeType == plan.Node_AGG {
+		rel.card = 1
+		for _, e := range node.GroupBy {
+			ndv := child.card * defaultSelectivity
+			if col := exprCol(e, child.cols); col != nil && col.Ndv > 0 {
+				ndv = col.Ndv
+			}
+			rel.card *= ndv
+		}
+		if rel.card > child.card {
+			rel.card = child.card
+		}
+	}
+	for _, e := range node.WhereList {
+		rel.card *= selectivity(e, child.cols)
+	}
+	rel.cols = projectCols(node, child.cols)
+	rel.capNdv()
+	cost := o.childCost(node, 0)
+	node.Cost = &Cost{
+		Card:    rel.card,
+		Ndv:     rel.ndv(),
+		Rowsize: cost.Rowsize,
+		Start:   cost.Start,
+		Total:   cost.Total + child.card,
+	}
+	return rel
+}
+
+// estimateJoin estimates a join and picks its method. A hash join builds
+// on the smaller child if both are analyzed, unless the order is hinted
+func (o *optimizer) estimateJoin(node *Node) *relStats {
+	if len(node.Children) != 2 {
+		return o.estimateNode(node)
+	}
+	left, right := o.outputOf(node.Children[0]), o.outputOf(node.Children[1])
+	equi := o.hasEquiCond(node, len(left.cols))
+	hash, hinted := equi, false
+	for _, child := range node.Children {
+		if mh := o.hints.joinMethod(child); mh != nil {
+			mh.joined = true
+			if mh.method == JoinMethodHash && !equi {
+				continue
+			}
+			hash, mh.applied = mh.method == JoinMethodHash, true
+			hinted = true
+		}
+	}
+	// The chains are ordered by reorderJoins
+	if jh := o.hints.joinOrder(node.Children); jh != nil && !o.fixed[node.NodeId] {
+		if !jh.fixed && jh.rank(node.Children[1]) < jh.rank(node.Children[0]) && o.swappable(node) {
+			o.swapChildren(node, len(left.cols), len(right.cols))
+			left, right = right, left
+		}
+		if jh.fixed || jh.rank(node.Children[0]) <= jh.rank(node.Children[1]) {
+			jh.applied = true
+			o.fixed[node.NodeId] = true
+		}
+	}
+	if hash && right.card > left.card && left.analyzed && right.analyzed && o.swappable(node) {
+		o.swapChildren(node, len(left.cols), len(right.cols))
+		left, right = right, left
+	}
+	in := append(append([]*ColumnStats{}, left.cols...), right.cols...)
+	rel := &relStats{card: left.card * right.card, analyzed: left.analyzed && right.analyzed}
+	for _, list := range [][]*Expr{node.OnList, node.WhereList} {
+		for _, e := range list {
+			if l, r, ok := equiCond(e); ok && (l < len(left.cols)) != (r < len(left.cols)) {
+				rel.card /= joinNdv(in, l, r, left, right, len(left.cols))
+				continue
+			}
+			rel.card *= selectivity(e, in)
+		}
+	}
+	if o.joinFlag(node, 1)&plan.Node_OUTER != 0 && rel.card < left.card {
+		rel.card = left.card
+	}
+	if o.joinFlag(node, 0)&plan.Node_OUTER != 0 && rel.card < right.card {
+		rel.card = right.card
+	}
+	// The rows of the left side are output once at most by the joins of
+	// the subqueries
+	switch flag := o.joinFlag(node, 1); {
+	case flag&(plan.Node_SEMI|plan.Node_SINGLE) != 0:
+		rel.card = math.Min(rel.card, left.card)
+	case flag&plan.Node_ANTI != 0:
+		rel.card = left.card - math.Min(rel.card, left.card)
+	case flag&plan.Node_MARK != 0:
+		rel.card = left.card
+		for _, e := range node.WhereList {
+			rel.card *= selectivity(e, in)
+		}
+	}
+	if o.steps[node.NodeId] {
+		rel.cols = projectCols(node, in)
+	} else {
+		rels := []*relStats{left, right}
+		rel.cols = make([]*ColumnStats, len(node.ProjectList))
+		for i, e := range node.ProjectList {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.RelPos >= 0 && col.Col.RelPos < 2 {
+				rel.cols[i] = colAt(rels[col.Col.RelPos].cols, col.Col.ColPos)
+			}
+		}
+	}
+	rel.capNdv()
+
+	lc, rc := o.childCost(node, 0), o.childCost(node, 1)
+	hashCost := &Cost{
+		Card:    rel.card,
+		Ndv:     rel.ndv(),
+		Rowsize: lc.Rowsize + rc.Rowsize,
+		Start:   lc.Start + rc.Total + right.card,
+	}
+	hashCost.Total = hashCost.Start + lc.Total - lc.Start + left.card + rel.card
+	loopCost := &Cost{
+		Card:    rel.card,
+		Ndv:     rel.ndv(),
+		Rowsize: lc.Rowsize + rc.Rowsize,
+		Start:   lc.Start + rc.Total,
+	}
+	loopCost.Total = loopCost.Start + lc.Total - lc.Start + left.card*right.card
+	if hash {
+		node.ExtraOptions, node.Cost = JoinMethodHash, hashCost
+	} else {
+		node.ExtraOptions, node.Cost = JoinMethodNestedLoop, loopCost
+	}
+	if o.trace != nil {
+		alts := []*TraceAlternative{
+			{Plan: JoinMethodHash, Cost: hashCost.Total, Chosen: hash},
+			{Plan: JoinMethodNestedLoop, Cost: loopCost.Total, Chosen: !hash},
+		}
+		reason := "the equi conditions are joined by hash"
+		switch {
+		case hinted:
+			reason = "overridden by the hint"
+		case !equi:
+			reason = "no equi condition"
+		}
+		detail := fmt.Sprintf("build side %s of %v rows", o.nodeName(node.Children[1]), right.card)
+		o.trace.add(TraceJoinMethod, node.NodeId, detail, choose(alts, reason)...)
+	}
+	return rel
+}
+
+// outputOf returns the estimate of node id with the columns fit to its
+// project list, which the joins refer to
+func (o *optimizer) outputOf(id int32) *relStats {
+	rel := o.visit(id)
+	if !o.validNode(id) || o.nodes[id].ProjectList == nil {
+		return rel
+	}
+	n := len(o.nodes[id].ProjectList)
+	cols := make([]*ColumnStats, n)
+	copy(cols, rel.cols)
+	return &relStats{card: rel.card, cols: cols, analyzed: rel.analyzed}
+}
+
+func (o *optimizer) joinFlag(node *Node, i int) plan.Node_JoinFlag {
+	if !o.validNode(node.Children[i]) {
+		return plan.Node_INNER
+	}
+	return o.nodes[node.Children[i]].JoinType
+}
+
+// hasEquiCond returns true if an equi condition of node compares the
+// columns of both children, which are split at width
+func (o *optimizer) hasEquiCond(node *Node, width int) bool {
+	for _, list := range [][]*Expr{node.OnList, node.WhereList} {
+		for _, e := range list {
+			if l, r, ok := equiCond(e); ok && (l < width) != (r < width) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// swappable returns true if the children of the inner join node could be
+// swapped
+func (o *optimizer) swappable(node *Node) bool {
+	return !o.pinned[node.NodeId] && !o.fixed[node.NodeId] &&
+		o.joinFlag(node, 0) == plan.Node_INNER && o.joinFlag(node, 1) == plan.Node_INNER
+}
+
+// swapChildren swaps the children of node of widths wl and wr keeping the
+// order of its output
+func (o *optimizer) swapChildren(node *Node, wl, wr int) {
+	node.Children[0], node.Children[1] = node.Children[1], node.Children[0]
+	pos := func(k int32) int32 {
+		if int(k) < wl {
+			return k + int32(wr)
+		}
+		return k - int32(wl)
+	}
+	seen := make(map[*plan.ColRef]bool)
+	remapExprs(node.OnList, pos, seen)
+	remapExprs(node.WhereList, pos, seen)
+	if o.steps[node.NodeId] {
+		normalizeRefs(node.ProjectList, wl)
+		remapExprs(node.ProjectList, pos, seen)
+		return
+	}
+	for _, e := range node.ProjectList {
+		if col, ok := e.Expr.(*plan.Expr_Col); ok && !seen[col.Col] {
+			seen[col.Col] = true
+			col.Col.RelPos = 1 - col.Col.RelPos
+		}
+	}
+}
+
+// joinChain returns true if node is a JOIN of a chain of inner joins
+// without conditions, like the ones of a FROM list. The head of the chain
+// may have a WHERE list and be the root of a step
+func (o *optimizer) joinChain(node *Node, head bool) bool {
+	if node.NodeType != plan.Node_JOIN || len(node.Children) != 2 || len(node.OnList) > 0 ||
+		o.pinned[node.NodeId] || o.reordered[node.NodeId] {
+		return false
+	}
+	if !head && (o.steps[node.NodeId] || len(node.WhereList) > 0) {
+		return false
+	}
+	return o.joinFlag(node, 0) == plan.Node_INNER && o.joinFlag(node, 1) == plan.Node_INNER
+}
+
+func (rel *relStats) ndv() float64 {
+	ndv := 0.0
+	for _, col := range rel.cols {
+		if col != nil && col.Ndv > ndv {
+			ndv = col.Ndv
+		}
+	}
+	if ndv == 0 || ndv > rel.card {
+		ndv = rel.card
+	}
+	return ndv
+}
+
+// capNdv floors the card at one row and caps the ndv of the columns by it
+func (rel *relStats) capNdv() {
+	if rel.card < 1 {
+		rel.card = 1
+	}
+	for i, col := range rel.cols {
+		if col != nil && col.Ndv > rel.card {
+			capped := *col
+			capped.Ndv = rel.card
+			rel.cols[i] = &capped
+		}
+	}
+}
+
+// projectCols returns the statistics of the project list of node over the
+// input columns in
+func projectCols(node *Node, in []*ColumnStats) []*ColumnStats {
+	if node.ProjectList == nil {
+		return in
+	}
+	cols := make([]*ColumnStats, len(node.ProjectList))
+	for i, e := range node.ProjectList {
+		if e.Expr == nil {
+			cols[i] = colAt(in, int32(i))
+			continue
+		}
+		cols[i] = exprCol(e, in)
+	}
+	return cols
+}
+
+func colAt(cols []*ColumnStats, pos int32) *ColumnStats {
+	if pos < 0 || int(pos) >= len(cols) {
+		return nil
+	}
+	return cols[pos]
+}
+
+// exprCol returns the statistics of the column e refers to
+func exprCol(e *Expr, in []*ColumnStats) *ColumnStats {
+	if col, ok := unwrapCast(e).Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 0 {
+		return colAt(in, col.Col.ColPos)
+	}
+	return nil
+}
+
+func joinNdv(in []*ColumnStats, l, r int, left, right *relStats, width int) float64 {
+	ndvOf := func(k int) float64 {
+		if col := colAt(in, int32(k)); col != nil && col.Ndv > 0 {
+			return col.Ndv
+		}
+		if k < width {
+			return left.card
+		}
+		return right.card
+	}
+	return maxFloat(ndvOf(l), ndvOf(r), 1)
+}
+
+// selectivity estimates the fraction of the rows over the columns in
+// passing the filter e
+func selectivity(e *Expr, in []*ColumnStats) float64 {
+	if b, ok := boolConst(e); ok {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return defaultSelectivity
+	}
+	args := f.F.Args
+	switch name := strings.ToUpper(f.F.Func.GetObjName()); name {
+	case "AND":
+		s := 1.0
+		for _, arg := range args {
+			s *= selectivity(arg, in)
+		}
+		return s
+	case "OR":
+		s := 0.0
+		for _, arg := range args {
+			as := selectivity(arg, in)
+			s = s + as - s*as
+		}
+		return s
+	case "NOT":
+		if len(args) == 1 {
+			return 1 - selectivity(args[0], in)
+		}
+	case "=", "<>", "<", "<=", ">", ">=":
+		if len(args) != 2 {
+			break
+		}
+		col, v, op, ok := colCompareConst(args[0], args[1], name, in)
+		if !ok {
+			if l, r := exprCol(args[0], in), exprCol(args[1], in); name == "=" && l != nil && r != nil {
+				return 1 / maxFloat(l.Ndv, r.Ndv, 1)
+			}
+			// A parameter is a constant of each execution
+			if name == "=" && (isParam(args[0]) || isParam(args[1])) {
+				col := exprCol(args[0], in)
+				if col == nil {
+					col = exprCol(args[1], in)
+				}
+				return eqSelectivity(col)
+			}
+			if name == "=" {
+				return defaultSelectivity
+			}
+			return defaultRangeSelectivity
+		}
+		switch op {
+		case "=":
+			return eqSelectivity(col)
+		case "<>":
+			if col == nil {
+				return 1 - defaultSelectivity
+			}
+			return (1 - col.NullFrac) * (1 - eqSelectivity(col))
+		default:
+			return rangeSelectivity(col, v, op)
+		}
+	}
+	return defaultSelectivity
+}
+
+func eqSelectivity(col *ColumnStats) float64 {
+	if col == nil || col.Ndv <= 0 {
+		return defaultSelectivity
+	}
+	return (1 - col.NullFrac) / maxFloat(col.Ndv, 1)
+}
+
+// rangeSelectivity estimates the fraction of the values of col op v by the
+// histogram of col
+func rangeSelectivity(col *ColumnStats, v float64, op string) float64 {
+	if col == nil || len(col.Histogram) < 2 {
+		return defaultRangeSelectivity
+	}
+	bounds := col.Histogram
+	m := len(bounds) - 1
+	below := 1.0
+	if v <= bounds[0] {
+		below = 0
+	} else if v < bounds[m] {
+		for i := 0; i < m; i++ {
+			if v < bounds[i+1] {
+				frac := 0.5
+				if width := bounds[i+1] - bounds[i]; width > 0 {
+					frac = (v - bounds[i]) / width
+				}
+				below = (float64(i) + frac) / float64(m)
+				break
+			}
+		}
+	}
+	if op == ">" || op == ">=" {
+		below = 1 - below
+	}
+	return (1 - col.NullFrac) * below
+}
+
+// colCompareConst matches a comparison of a column to a numeric constant,
+// with the operator flipped if the constant is on the left
+func colCompareConst(l, r *Expr, op string, in []*ColumnStats) (*ColumnStats, float64, string, bool) {
+	if v, ok := constValue(r); ok {
+		if col, ok := unwrapCast(l).Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 0 {
+			return colAt(in, col.Col.ColPos), v, op, true
+		}
+		return nil, 0, op, false
+	}
+	if v, ok := constValue(l); ok {
+		if col, ok := unwrapCast(r).Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 0 {
+			switch op {
+			case "<":
+				op = ">"
+			case "<=":
+				op = ">="
+			case ">":
+				op = "<"
+			case ">=":
+				op = "<="
+			}
+			return colAt(in, col.Col.ColPos), v, op, true
+		}
+	}
+	return nil, 0, op, false
+}
+
+func constValue(e *Expr) (float64, bool) {
+	c, ok := unwrapCast(e).Expr.(*plan.Expr_C)
+	if !ok {
+		return 0, false
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_Ival:
+		return float64(v.Ival), true
+	case *plan.Const_Dval:
+		return v.Dval, true
+	}
+	return 0, false
+}
+
+func unwrapCast(e *Expr) *Expr {
+	for {
+		f, ok := e.Expr.(*plan.Expr_F)
+		if !ok || !strings.EqualFold(f.F.Func.GetObjName(), "CAST") || len(f.F.Args) == 0 {
+			return e
+		}
+		e = f.F.Args[0]
+	}
+}
+
+// equiCond matches the equi condition of two columns of e
+func equiCond(e *Expr) (int, int, bool) {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+		return 0, 0, false
+	}
+	l, lok := f.F.Args[0].Expr.(*plan.Expr_Col)
+	r, rok := f.F.Args[1].Expr.(*plan.Expr_Col)
+	if !lok || !rok || l.Col.RelPos != 0 || r.Col.RelPos != 0 {
+		return 0, 0, false
+	}
+	return int(l.Col.ColPos), int(r.Col.ColPos), true
+}
+
+// normalizeRefs makes the columns of the right child of width wl in the
+// project list of a join, like the ones of SELECT *, refer to the
+// positions in the columns of both children
+func normalizeRefs(exprs []*Expr, wl int) {
+	for _, e := range exprs {
+		walkExpr(e, func(e *Expr) {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 1 {
+				col.Col.RelPos = 0
+				col.Col.ColPos += int32(wl)
+			}
+		})
+	}
+}
+
+// remapExprs maps the positions of the columns of exprs by pos. The
+// columns in seen are mapped already
+func remapExprs(exprs []*Expr, pos func(int32) int32, seen map[*plan.ColRef]bool) {
+	for _, e := range exprs {
+		walkExpr(e, func(e *Expr) {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 0 && !seen[col.Col] {
+				seen[col.Col] = true
+				col.Col.ColPos = pos(col.Col.ColPos)
+			}
+		})
+	}
+}
+
+// walkExpr calls fn on e and its arguments, the subqueries are not walked
+func walkExpr(e *Expr, fn func(*Expr)) {
+	if e == nil {
+		return
+	}
+	fn(e)
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range ex.F.Args {
+			walkExpr(arg, fn)
+		}
+	case *plan.Expr_List:
+		for _, item := range ex.List.List {
+			walkExpr(item, fn)
+		}
+	}
+}
+
+func nodeExprLists(node *Node) [][]*Expr {
+	lists := [][]*Expr{node.ProjectList, node.OnList, node.WhereList, node.GroupBy, node.GroupingSet}
+	for _, orderBy := range node.OrderBy {
+		lists = append(lists, []*Expr{orderBy.OrderBy})
+	}
+	if spec := node.WinSpec; spec != nil {
+		lists = append(lists, spec.PartitionBy, spec.WindowFuncs)
+		for _, orderBy := range spec.OderyBy {
+			lists = append(lists, []*Expr{orderBy.OrderBy})
+		}
+	}
+	return lists
+}
+
+func maxFloat(vs ...float64) float64 {
+	m := vs[0]
+	for _, v := range vs[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,249 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+)
+
+// statsCompilerContext has the statistics of tpch of scale 1
+type statsCompilerContext struct {
+	MockCompilerContext
+	rows map[string]float64
+	ndvs map[string]float64
+}
+
+func newStatsCompilerContext() *statsCompilerContext {
+	return &statsCompilerContext{
+		MockCompilerContext: *NewMockCompilerContext(),
+		rows: map[string]float64{
+			"region":   5,
+			"nation":   25,
+			"customer": 150000,
+			"orders":   1500000,
+			"lineitem": 6000000,
+		},
+		ndvs: map[string]float64{
+			"n_regionkey": 5,
+			"c_nationkey": 25,
+			"o_custkey":   100000,
+			"l_orderkey":  1500000,
+			"l_suppkey":   10000,
+		},
+	}
+}
+
+func (s *statsCompilerContext) Stats(obj *ObjectRef) *TableStats {
+	rows, ok := s.rows[obj.ObjName]
+	if !ok {
+		return nil
+	}
+	_, def := s.Resolve(obj.ObjName)
+	ts := &TableStats{
+		Rows:    rows,
+		Columns: make(map[string]*ColumnStats),
+	}
+	for _, col := range def.Cols {
+		ndv, ok := s.ndvs[col.Name]
+		if !ok {
+			ndv = rows
+		}
+		ts.Columns[col.Name] = &ColumnStats{Ndv: ndv}
+	}
+	if obj.ObjName == "nation" {
+		ts.Columns["n_nationkey"].Histogram = []float64{0, 24}
+	}
+	return ts
+}
+
+func buildWithStats(t *testing.T, sql string, analyzed bool) *Query {
+	ctx := newStatsCompilerContext()
+	if !analyzed {
+		ctx.rows = nil
+	}
+	return buildQuery(t, ctx, sql)
+}
+
+func buildQuery(t *testing.T, ctx CompilerContext, sql string) *Query {
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	p, err := BuildPlan(ctx, stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return p.GetQuery()
+}
+
+func scanName(query *Query, id int32) string {
+	node := query.Nodes[id]
+	if node.NodeType != plan.Node_TABLE_SCAN {
+		return ""
+	}
+	return node.ObjRef.ObjName
+}
+
+func TestScanCost(t *testing.T) {
+	query := buildWithStats(t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 3", true)
+	if card := query.Nodes[0].Cost.Card; card != 5 {
+		t.Fatalf("card should be 5 but now is %v", card)
+	}
+	query = buildWithStats(t, "SELECT N_NAME FROM NATION WHERE N_NATIONKEY < 6", true)
+	if card := query.Nodes[0].Cost.Card; math.Abs(card-6.25) > 1e-9 {
+		t.Fatalf("card should be 6.25 but now is %v", card)
+	}
+	query = buildWithStats(t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 3", false)
+	if card := query.Nodes[0].Cost.Card; card != 1000000*defaultSelectivity {
+		t.Fatalf("card should fall back to the defaults but now is %v", card)
+	}
+}
+
+func TestJoinBuildSide(t *testing.T) {
+	sql := "SELECT * FROM NATION JOIN CUSTOMER ON N_NATIONKEY = C_NATIONKEY WHERE C_CUSTKEY > 10"
+	query := buildWithStats(t, sql, true)
+	root := query.Nodes[query.Steps[0]]
+	if root.NodeType != plan.Node_JOIN || root.ExtraOptions != JoinMethodHash {
+		t.Fatalf("root should be a hash join but now is %v %v", root.NodeType, root.ExtraOptions)
+	}
+	if scanName(query, root.Children[0]) != "customer" || scanName(query, root.Children[1]) != "nation" {
+		t.Fatalf("nation should be the build side, children are %v", root.Children)
+	}
+	if root.ProjectList[0].Alias != "nation.n_nationkey" || root.ProjectList[0].Expr.(*plan.Expr_Col).Col.ColPos != 8 {
+		t.Fatalf("the output should be kept but now is %v", root.ProjectList[0])
+	}
+	if card := root.Cost.Card; card != 150000*defaultRangeSelectivity {
+		t.Fatalf("card should be %v but now is %v", 150000*defaultRangeSelectivity, card)
+	}
+
+	// Not analyzed
+	query = buildWithStats(t, sql, false)
+	root = query.Nodes[query.Steps[0]]
+	if scanName(query, root.Children[0]) != "nation" {
+		t.Fatalf("the children should be kept without statistics, children are %v", root.Children)
+	}
+
+	query = buildWithStats(t, "SELECT * FROM NATION JOIN CUSTOMER ON N_NATIONKEY > C_NATIONKEY", true)
+	root = query.Nodes[query.Steps[0]]
+	if root.ExtraOptions != JoinMethodNestedLoop || scanName(query, root.Children[0]) != "nation" {
+		t.Fatalf("root should be a nested loop join of the children kept")
+	}
+}
+
+func TestJoinOrder(t *testing.T) {
+	sql := "SELECT * FROM LINEITEM, ORDERS, CUSTOMER WHERE L_ORDERKEY = O_ORDERKEY AND O_CUSTKEY = C_CUSTKEY AND C_ACCTBAL > 0"
+	query := buildWithStats(t, sql, true)
+	root := query.Nodes[query.Steps[0]]
+	// customer is joined with orders first, then with lineitem, with the
+	// build sides on the smaller children
+	if scanName(query, root.Children[0]) != "lineitem" {
+		t.Fatalf("lineitem should be probed by the root, children are %v", root.Children)
+	}
+	bottom := query.Nodes[root.Children[1]]
+	if bottom.NodeType != plan.Node_JOIN || scanName(query, bottom.Children[0]) != "orders" ||
+		scanName(query, bottom.Children[1]) != "customer" {
+		t.Fatalf("orders should be joined with customer first, children are %v", bottom.Children)
+	}
+	if len(bottom.OnList) != 1 || len(root.WhereList) != 1 {
+		t.Fatalf("the join condition should be pushed down, %v %v", bottom.OnList, root.WhereList)
+	}
+	if len(query.Nodes[bottom.Children[1]].WhereList) != 1 {
+		t.Fatalf("c_acctbal > 0 should be pushed down to the scan of customer")
+	}
+	for i, e := range root.ProjectList {
+		col := e.Expr.(*plan.Expr_Col).Col
+		if i < 16 && col.ColPos != int32(i) {
+			t.Fatalf("column %v of lineitem is at %v", i, col.ColPos)
+		}
+		if i == 16 && (e.Alias != "orders.o_orderkey" || col.ColPos != 16) {
+			t.Fatalf("o_orderkey is at %v", col.ColPos)
+		}
+		if i == 25 && (e.Alias != "customer.c_custkey" || col.ColPos != 25) {
+			t.Fatalf("c_custkey is at %v", col.ColPos)
+		}
+	}
+	// A third of customer joins with the orders of 100000 customers
+	if card := root.Cost.Card; math.Abs(card-6000000*50000/100000) > 1 {
+		t.Fatalf("card should be %v but now is %v", 6000000*50000/100000, card)
+	}
+}
+
+func TestJoinOrderDP(t *testing.T) {
+	sql := "SELECT * FROM CUSTOMER, ORDERS, NATION, REGION WHERE C_CUSTKEY = O_CUSTKEY AND C_NATIONKEY = N_NATIONKEY AND N_REGIONKEY = R_REGIONKEY"
+	query := buildWithStats(t, sql, true)
+	root := query.Nodes[query.Steps[0]]
+	// ((customer, (nation, region)), orders) with orders probing
+	if scanName(query, root.Children[0]) != "orders" {
+		t.Fatalf("orders should be probed by the root, children are %v", root.Children)
+	}
+	mid := query.Nodes[root.Children[1]]
+	if scanName(query, mid.Children[0]) != "customer" {
+		t.Fatalf("customer should be joined with nation and region, children are %v", mid.Children)
+	}
+	bottom := query.Nodes[mid.Children[1]]
+	if scanName(query, bottom.Children[0]) != "nation" || scanName(query, bottom.Children[1]) != "region" {
+		t.Fatalf("nation should be joined with region first, children are %v", bottom.Children)
+	}
+	// The positions of the conditions pushed down are the ones in the
+	// columns of the children of the joins
+	if len(bottom.OnList) != 1 || len(mid.OnList) != 1 || len(root.WhereList) != 1 {
+		t.Fatalf("the join conditions should be pushed down")
+	}
+	args := bottom.OnList[0].Expr.(*plan.Expr_F).F.Args
+	if args[0].Expr.(*plan.Expr_Col).Col.ColPos != 2 || args[1].Expr.(*plan.Expr_Col).Col.ColPos != 4 {
+		t.Fatalf("n_regionkey = r_regionkey should be on 2 and 4, but now is %v", bottom.OnList[0])
+	}
+	args = mid.OnList[0].Expr.(*plan.Expr_F).F.Args
+	if args[0].Expr.(*plan.Expr_Col).Col.ColPos != 3 || args[1].Expr.(*plan.Expr_Col).Col.ColPos != 8 {
+		t.Fatalf("c_nationkey = n_nationkey should be on 3 and 8, but now is %v", mid.OnList[0])
+	}
+
+	ctx := newStatsCompilerContext()
+	ctx.SetJoinReorder(false)
+	query = buildQuery(t, ctx, sql)
+	root = query.Nodes[query.Steps[0]]
+	if scanName(query, root.Children[1]) != "region" || len(root.WhereList) != 3 {
+		t.Fatalf("the joins should be kept in order, children are %v", root.Children)
+	}
+}
+
+func TestJoinOrderGreedy(t *testing.T) {
+	sql := "SELECT * FROM NATION N1, NATION N2, NATION N3, NATION N4, NATION N5, NATION N6, NATION N7, NATION N8, NATION N9, NATION N10, REGION R " +
+		"WHERE N1.N_REGIONKEY = R.R_REGIONKEY"
+	for i := 1; i < 10; i++ {
+		sql += fmt.Sprintf(" AND N%d.N_NATIONKEY = N%d.N_NATIONKEY", i, i+1)
+	}
+	query := buildWithStats(t, sql, true)
+	root := query.Nodes[query.Steps[0]]
+	if len(root.WhereList) != 1 {
+		t.Fatalf("the join conditions should be pushed down, %v", root.WhereList)
+	}
+	// region is joined first
+	node := root
+	for depth := 0; depth < 9; depth++ {
+		node = query.Nodes[node.Children[0]]
+		if node.NodeType != plan.Node_JOIN {
+			t.Fatalf("the joins should be left deep")
+		}
+	}
+	if scanName(query, node.Children[0]) != "nation" || scanName(query, node.Children[1]) != "region" {
+		t.Fatalf("region should be joined first, children are %v", node.Children)
+	}
+}
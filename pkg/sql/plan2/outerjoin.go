@@ -0,0 +1,360 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// simplifyOuterJoins turns the outer joins into inner joins if a filter
+// above them is not true on the rows whose columns of the nullable side are
+// null, which are the only rows the outer joins add
+func (o *optimizer) simplifyOuterJoins() {
+	// The later steps are above the earlier ones of their derived tables
+	visited := make(map[int32]bool)
+	for i := len(o.query.Steps) - 1; i >= 0; i-- {
+		o.simplifyOuterJoin(o.query.Steps[i], nil, visited)
+	}
+}
+
+// simplifyOuterJoin simplifies the joins below node id, the output columns
+// of which in rejected are null rejected by the filters above it
+func (o *optimizer) simplifyOuterJoin(id int32, rejected map[int32]bool, visited map[int32]bool) {
+	if !o.validNode(id) || visited[id] {
+		return
+	}
+	visited[id] = true
+	node := o.nodes[id]
+	switch {
+	case node.NodeType == plan.Node_JOIN && len(node.Children) == 2:
+		o.simplifyJoin(node, rejected, visited)
+	case len(node.Children) == 1 && node.Limit == nil && node.Offset == nil &&
+		(node.NodeType == plan.Node_PROJECT || node.NodeType == plan.Node_SORT):
+		in := make(map[int32]bool)
+		o.mapRejected(node, rejected, func(col *plan.ColRef) {
+			if col.RelPos == 0 {
+				in[col.ColPos] = true
+			}
+		})
+		for _, e := range node.WhereList {
+			for _, pos := range nullRejected(e) {
+				in[pos] = true
+			}
+		}
+		o.simplifyOuterJoin(node.Children[0], in, visited)
+	default:
+		// The filters above an aggregation or a limit are not on its input
+		for _, child := range node.Children {
+			o.simplifyOuterJoin(child, nil, visited)
+		}
+	}
+}
+
+func (o *optimizer) simplifyJoin(node *Node, rejected map[int32]bool, visited map[int32]bool) {
+	wl := int32(len(o.output(node.Children[0])))
+	// The positions in the columns of both children
+	in := make(map[int32]bool)
+	o.mapRejected(node, rejected, func(col *plan.ColRef) {
+		switch col.RelPos {
+		case 0:
+			in[col.ColPos] = true
+		case 1:
+			in[wl+col.ColPos] = true
+		}
+	})
+	for _, e := range node.WhereList {
+		for _, pos := range nullRejected(e) {
+			in[pos] = true
+		}
+	}
+	left, right := o.nodes[node.Children[0]], o.nodes[node.Children[1]]
+	if left == nil || right == nil {
+		return
+	}
+	leftRejected, rightRejected := false, false
+	for pos := range in {
+		if pos < wl {
+			leftRejected = true
+		} else {
+			rightRejected = true
+		}
+	}
+	if right.JoinType == plan.Node_OUTER && rightRejected {
+		right.JoinType = plan.Node_INNER
+		o.trace.add(TraceSimplifyOuterJoin, node.NodeId, "the left join is an inner join, the filters above reject the nulls of "+o.nodeName(right.NodeId))
+	}
+	if left.JoinType == plan.Node_OUTER && leftRejected {
+		left.JoinType = plan.Node_INNER
+		o.trace.add(TraceSimplifyOuterJoin, node.NodeId, "the right join is an inner join, the filters above reject the nulls of "+o.nodeName(left.NodeId))
+	}
+	// The ON list of an inner join filters its rows, the one of an outer
+	// join filters the rows of the nullable side matched
+	for _, e := range node.OnList {
+		for _, pos := range nullRejected(e) {
+			switch {
+			case left.JoinType == plan.Node_INNER && right.JoinType == plan.Node_INNER:
+				in[pos] = true
+			case right.JoinType == plan.Node_OUTER && pos >= wl:
+				in[pos] = true
+			case left.JoinType == plan.Node_OUTER && pos < wl:
+				in[pos] = true
+			}
+		}
+	}
+	lin, rin := make(map[int32]bool), make(map[int32]bool)
+	for pos := range in {
+		if pos < wl {
+			lin[pos] = true
+		} else {
+			rin[pos-wl] = true
+		}
+	}
+	o.simplifyOuterJoin(left.NodeId, lin, visited)
+	if right.JoinType&subqueryJoinFlags != 0 {
+		rin = nil
+	}
+	o.simplifyOuterJoin(right.NodeId, rin, visited)
+}
+
+// mapRejected calls fn with the input columns of node, which the output
+// columns in rejected are null if any of them is
+func (o *optimizer) mapRejected(node *Node, rejected map[int32]bool, fn func(*plan.ColRef)) {
+	for pos := range rejected {
+		if node.ProjectList == nil {
+			fn(&plan.ColRef{ColPos: pos})
+			continue
+		}
+		if pos < 0 || int(pos) >= len(node.ProjectList) {
+			continue
+		}
+		e := node.ProjectList[pos]
+		if e.Expr == nil {
+			// A passthrough of the derived tables
+			fn(&plan.ColRef{ColPos: pos})
+			continue
+		}
+		for _, col := range strictCols(e) {
+			fn(col)
+		}
+	}
+}
+
+// nullRejected returns the positions of the columns of the filter e, which
+// is not true if any of them is null
+func nullRejected(e *Expr) []int32 {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok || hasSubquery(e) {
+		return nil
+	}
+	args := f.F.Args
+	switch name := strings.ToUpper(f.F.Func.GetObjName()); name {
+	case "AND":
+		var cols []int32
+		for _, arg := range args {
+			cols = append(cols, nullRejected(arg)...)
+		}
+		return cols
+	case "OR":
+		// The columns rejected by all the arguments
+		var cols []int32
+		for i, arg := range args {
+			argCols := nullRejected(arg)
+			if i == 0 {
+				cols = argCols
+				continue
+			}
+			kept := cols[:0]
+			for _, pos := range cols {
+				for _, p := range argCols {
+					if p == pos {
+						kept = append(kept, pos)
+						break
+					}
+				}
+			}
+			cols = kept
+		}
+		return cols
+	case "NOT":
+		if len(args) != 1 {
+			return nil
+		}
+		// IS NOT NULL
+		if g, ok := args[0].Expr.(*plan.Expr_F); ok && strings.EqualFold(g.F.Func.GetObjName(), "IFNULL") && len(g.F.Args) == 1 {
+			return colPositions(strictCols(g.F.Args[0]))
+		}
+		if g, ok := args[0].Expr.(*plan.Expr_F); ok && strictComparisons[strings.ToUpper(g.F.Func.GetObjName())] {
+			return nullRejected(args[0])
+		}
+	case "IN":
+		if len(args) > 0 {
+			return colPositions(strictCols(args[0]))
+		}
+	default:
+		if strictComparisons[name] {
+			var cols []*plan.ColRef
+			for _, arg := range args {
+				cols = append(cols, strictCols(arg)...)
+			}
+			return colPositions(cols)
+		}
+	}
+	return nil
+}
+
+// strictCols returns the columns of e which is null if any of them is
+func strictCols(e *Expr) []*plan.ColRef {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		return []*plan.ColRef{ex.Col}
+	case *plan.Expr_F:
+		if !strictFunctions[strings.ToUpper(ex.F.Func.GetObjName())] {
+			return nil
+		}
+		var cols []*plan.ColRef
+		for _, arg := range ex.F.Args {
+			cols = append(cols, strictCols(arg)...)
+		}
+		return cols
+	}
+	return nil
+}
+
+func colPositions(cols []*plan.ColRef) []int32 {
+	var poses []int32
+	for _, col := range cols {
+		if col.RelPos == 0 {
+			poses = append(poses, col.ColPos)
+		}
+	}
+	return poses
+}
+
+// strictComparisons are the filters which are null on a null argument
+var strictComparisons = map[string]bool{
+	"=":    true,
+	"<>":   true,
+	"<":    true,
+	"<=":   true,
+	">":    true,
+	">=":   true,
+	"LIKE": true,
+}
+
+// strictFunctions are the functions which return null on a null argument
+var strictFunctions = map[string]bool{
+	"+":           true,
+	"-":           true,
+	"*":           true,
+	"/":           true,
+	"%":           true,
+	"UNARY_PLUS":  true,
+	"UNARY_MINUS": true,
+	"CAST":        true,
+}
+
+// eliminateJoins removes the left joins whose columns of the right side are
+// not referenced by the nodes above them, need are the output columns
+// referenced, if a row of the left side matches one row of the right side
+// at most by the primary key of its table. A join removed is turned into a
+// projection of the left side
+func (o *optimizer) eliminateJoins(need map[int32][]bool) {
+	for _, node := range o.query.Nodes {
+		if node.NodeType != plan.Node_JOIN || len(node.Children) != 2 || len(node.WhereList) > 0 || o.pinned[node.NodeId] {
+			continue
+		}
+		if o.joinFlag(node, 0) != plan.Node_INNER || o.joinFlag(node, 1) != plan.Node_OUTER {
+			continue
+		}
+		wl := int32(len(o.output(node.Children[0])))
+		used := false
+		for i, e := range node.ProjectList {
+			if cols := need[node.NodeId]; i < len(cols) && !cols[i] {
+				continue
+			}
+			walkExpr(e, func(e *Expr) {
+				if col, ok := e.Expr.(*plan.Expr_Col); ok && (col.Col.RelPos == 1 || col.Col.ColPos >= wl) {
+					used = true
+				}
+			})
+		}
+		if used || !o.uniqueJoin(node, wl) {
+			continue
+		}
+		o.trace.add(TraceEliminateJoin, node.NodeId, fmt.Sprintf("the left join of %s is removed, its columns are not referenced and it matches a row at most", o.nodeName(node.Children[1])))
+		node.NodeType = plan.Node_PROJECT
+		node.Children = node.Children[:1]
+		node.OnList = nil
+		node.ExtraOptions = ""
+		for i, e := range node.ProjectList {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 1 {
+				// Not referenced
+				node.ProjectList[i] = &Expr{
+					Typ:   e.Typ,
+					Alias: e.Alias,
+					Expr:  &plan.Expr_C{C: &plan.Const{Isnull: true}},
+				}
+			}
+		}
+	}
+}
+
+// uniqueJoin returns true if the right child of the join node is a scan
+// whose primary key columns are all compared to the ones of the left side
+// by the equi conditions of node, which are split at wl
+func (o *optimizer) uniqueJoin(node *Node, wl int32) bool {
+	scan := o.nodes[node.Children[1]]
+	if scan == nil || scan.NodeType != plan.Node_TABLE_SCAN || scan.TableDef == nil {
+		return false
+	}
+	pks := make(map[string]bool)
+	for _, col := range scan.TableDef.Cols {
+		if col.Primary {
+			pks[col.Name] = true
+		}
+	}
+	for _, def := range scan.TableDef.Defs {
+		if pk := def.GetPk(); pk != nil {
+			for _, name := range pk.Names {
+				pks[name] = true
+			}
+		}
+	}
+	if len(pks) == 0 {
+		return false
+	}
+	for _, e := range node.OnList {
+		l, r, ok := equiCond(e)
+		if !ok || (int32(l) < wl) == (int32(r) < wl) {
+			continue
+		}
+		if int32(r) < wl {
+			r = l
+		}
+		pos := int32(r) - wl
+		if int(pos) >= len(scan.ProjectList) {
+			continue
+		}
+		col, ok := scan.ProjectList[pos].Expr.(*plan.Expr_Col)
+		if !ok || col.Col.ColPos < 0 || int(col.Col.ColPos) >= len(scan.TableDef.Cols) {
+			continue
+		}
+		delete(pks, scan.TableDef.Cols[col.Col.ColPos].Name)
+	}
+	return len(pks) == 0
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// reachable returns true if node id is below a step of query
+func reachable(query *Query, id int32) bool {
+	var below func(root int32) bool
+	below = func(root int32) bool {
+		if root == id {
+			return true
+		}
+		for _, child := range query.Nodes[root].Children {
+			if below(child) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, step := range query.Steps {
+		if below(step) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSimplifyOuterJoin(t *testing.T) {
+	sql := "SELECT N_NAME, R_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE R_NAME = 'ASIA'"
+	query := buildWithStats(t, sql, false)
+	region := scanOf(query, "region")
+	if region.JoinType != plan.Node_INNER {
+		t.Fatalf("the left join should be turned into an inner join but now is %v", region.JoinType)
+	}
+	if len(region.WhereList) != 1 {
+		t.Fatalf("the filter should be pushed down to the scan, %v", region.WhereList)
+	}
+
+	// The filters of the derived tables are above the joins
+	sql = "SELECT N_NAME FROM (SELECT N_NAME, R_REGIONKEY + 1 AS K FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY) T WHERE K > 2"
+	query = buildWithStats(t, sql, false)
+	if region = scanOf(query, "region"); region.JoinType != plan.Node_INNER {
+		t.Fatalf("the left join should be turned into an inner join but now is %v", region.JoinType)
+	}
+
+	sqls := []string{
+		"SELECT N_NAME, R_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE R_NAME IS NULL",
+		"SELECT N_NAME, R_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE R_NAME = 'ASIA' OR N_NAME = 'CHINA'",
+		"SELECT N_NAME, R_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE N_NAME = 'CHINA'",
+	}
+	for _, sql := range sqls {
+		query := buildWithStats(t, sql, false)
+		if region := scanOf(query, "region"); region.JoinType != plan.Node_OUTER {
+			t.Fatalf("the left join of '%s' should be kept but now is %v", sql, region.JoinType)
+		}
+	}
+}
+
+func TestEliminateJoin(t *testing.T) {
+	sql := "SELECT N_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE N_NATIONKEY < 6"
+	query := buildWithStats(t, sql, false)
+	if reachable(query, scanOf(query, "region").NodeId) {
+		t.Fatalf("the left join on the primary key of region should be removed, %v", query)
+	}
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_JOIN && reachable(query, node.NodeId) {
+			t.Fatalf("there should be no join but now is %v", node)
+		}
+	}
+	if nation := scanOf(query, "nation"); !reachable(query, nation.NodeId) || len(nation.WhereList) != 1 {
+		t.Fatalf("the filter should be kept on the scan, %v", nation)
+	}
+
+	sqls := []string{
+		// The right side is referenced
+		"SELECT N_NAME, R_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY",
+		// Not unique
+		"SELECT N_NAME FROM NATION LEFT JOIN CUSTOMER ON N_NATIONKEY = C_NATIONKEY",
+		// Not a left join
+		"SELECT N_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY",
+	}
+	for _, sql := range sqls {
+		query := buildWithStats(t, sql, false)
+		if len(nodesOf(query, plan.Node_JOIN)) != 1 {
+			t.Fatalf("the join of '%s' should be kept, %v", sql, query)
+		}
+	}
+}
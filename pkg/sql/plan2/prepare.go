@@ -0,0 +1,282 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
+)
+
+// PreparedPlan is the plan of a statement with parameter markers, which is
+// bound to the values of each execution. It is not modified by the binds
+type PreparedPlan struct {
+	Plan *plan.Plan
+	// ParamTypes are the types of the markers in order, Type_ANY for the
+	// ones the values of any type are bound to
+	ParamTypes []*plan.Type
+}
+
+// Prepare plans stmt whose parameter markers are typed by the expressions
+// they are compared to or computed with
+func Prepare(ctx CompilerContext, stmt tree.Statement) (*PreparedPlan, error) {
+	pn, err := BuildPlan(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	p := &PreparedPlan{Plan: pn}
+	walkParams(pn, func(e *Expr) {
+		pos := int(e.Expr.(*plan.Expr_P).P.Pos)
+		for len(p.ParamTypes) <= pos {
+			p.ParamTypes = append(p.ParamTypes, &plan.Type{Id: plan.Type_ANY, Nullable: true})
+		}
+		if p.ParamTypes[pos].Id == plan.Type_ANY {
+			p.ParamTypes[pos] = e.Typ
+		}
+	})
+	// The blocks listed are stale at the binds
+	if query := pn.GetQuery(); query != nil {
+		for _, node := range query.Nodes {
+			if info := node.PruneInfo; info != nil {
+				info.Partitions, info.Blocks = nil, nil
+				info.Resolved, info.Deferred = false, true
+			}
+		}
+	}
+	return p, nil
+}
+
+// Bind returns a copy of the plan whose parameters are replaced by params,
+// which are checked against the types of the parameters. The blocks of the
+// scans are listed again by ctx with the values bound
+func (p *PreparedPlan) Bind(ctx CompilerContext, params []*Const) (*plan.Plan, error) {
+	if len(params) != len(p.ParamTypes) {
+		return nil, errors.New(errno.UndefinedParameter, fmt.Sprintf("%d parameters are expected but got %d", len(p.ParamTypes), len(params)))
+	}
+	values := make([]*Expr, len(params))
+	for i, param := range params {
+		v, err := bindValue(p.ParamTypes[i], param)
+		if err != nil {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("parameter %d: %v", i+1, err))
+		}
+		values[i] = v
+	}
+	pn := proto.Clone(p.Plan).(*plan.Plan)
+	walkParams(pn, func(e *Expr) {
+		v := values[e.Expr.(*plan.Expr_P).P.Pos]
+		e.Typ, e.Expr = v.Typ, v.Expr
+	})
+	resolveBlocks(ctx, pn.GetQuery())
+	return pn, nil
+}
+
+// walkParams calls fn with the parameters of the query of pn
+func walkParams(pn *plan.Plan, fn func(*Expr)) {
+	query := pn.GetQuery()
+	if query == nil {
+		return
+	}
+	for _, node := range query.Nodes {
+		lists := append(nodeExprLists(node), []*Expr{node.Limit, node.Offset})
+		for _, list := range lists {
+			for _, e := range list {
+				walkExpr(e, func(e *Expr) {
+					if isParam(e) {
+						fn(e)
+					}
+				})
+			}
+		}
+	}
+}
+
+// bindValue returns the constant of the value c of a parameter of type typ
+func bindValue(typ *plan.Type, c *Const) (*Expr, error) {
+	if c == nil || c.Isnull {
+		return &Expr{
+			Typ:  typ,
+			Expr: &plan.Expr_C{C: &plan.Const{Isnull: true}},
+		}, nil
+	}
+	lit := &Expr{Expr: &plan.Expr_C{C: c}}
+	switch c.Value.(type) {
+	case *plan.Const_Ival:
+		lit.Typ = &plan.Type{Id: plan.Type_INT64}
+	case *plan.Const_Dval:
+		lit.Typ = &plan.Type{Id: plan.Type_FLOAT64}
+	case *plan.Const_Sval:
+		lit.Typ = &plan.Type{Id: plan.Type_VARCHAR, Width: int32(len(c.GetSval()))}
+	default:
+		return nil, fmt.Errorf("value '%v' is not supported", c)
+	}
+	if typ.Id == plan.Type_ANY || typ.Id == lit.Typ.Id {
+		return lit, nil
+	}
+	_, isStr := c.Value.(*plan.Const_Sval)
+	switch {
+	case checkIntType(typ.Id, "") == nil:
+		v, ok := c.Value.(*plan.Const_Ival)
+		if !ok {
+			return nil, fmt.Errorf("'%v' is not an integer", c)
+		}
+		if lo, hi, ok := intRange(typ.Id); ok && (v.Ival < lo || v.Ival > hi) {
+			return nil, fmt.Errorf("%d is out of the range of %v", v.Ival, typ.Id)
+		}
+	case checkNumberType(typ.Id, "") == nil:
+		if isStr {
+			return nil, fmt.Errorf("'%v' is not a number", c)
+		}
+	case typ.Id == plan.Type_CHAR || typ.Id == plan.Type_VARCHAR:
+		if !isStr {
+			return nil, fmt.Errorf("'%v' is not a string", c)
+		}
+		return lit, nil
+	case typ.Id == plan.Type_DATE || typ.Id == plan.Type_DATETIME || typ.Id == plan.Type_TIMESTAMP:
+		if !isStr {
+			return nil, fmt.Errorf("'%v' is not a time", c)
+		}
+	default:
+		return nil, fmt.Errorf("type %v is not supported", typ.Id)
+	}
+	if e, ok := foldCast(lit, typ); ok {
+		return e, nil
+	}
+	return &Expr{
+		Typ: typ,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: getFunctionObjRef("CAST"),
+				Args: []*Expr{lit},
+			},
+		},
+	}, nil
+}
+
+// PlanCache caches the prepared plans of the queries by their normalized
+// texts, the default databases and the schema versions of the contexts.
+// The least recently used ones are evicted beyond its capacity
+type PlanCache struct {
+	sync.Mutex
+	capacity int
+	lru      *list.List
+	plans    map[planCacheKey]*list.Element
+}
+
+type planCacheKey struct {
+	sql     string
+	db      string
+	version uint64
+}
+
+type planCacheEntry struct {
+	key  planCacheKey
+	plan *PreparedPlan
+}
+
+func NewPlanCache(capacity int) *PlanCache {
+	return &PlanCache{
+		capacity: capacity,
+		lru:      list.New(),
+		plans:    make(map[planCacheKey]*list.Element),
+	}
+}
+
+// Get returns the prepared plan of sql, which is parsed and planned if it
+// is not cached
+func (c *PlanCache) Get(ctx CompilerContext, sql string) (*PreparedPlan, error) {
+	key := planCacheKey{
+		sql: normalizeSQL(sql),
+		db:  ctx.DefaultDatabase(),
+	}
+	if v, ok := ctx.(SchemaVersion); ok {
+		key.version = v.SchemaVersion()
+	}
+	c.Lock()
+	if elem, ok := c.plans[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.Unlock()
+		return elem.Value.(*planCacheEntry).plan, nil
+	}
+	c.Unlock()
+
+	stmt, err := mysql.ParseOne(sql)
+	if err != nil {
+		return nil, err
+	}
+	p, err := Prepare(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	// The plans of the other statements depend on the data
+	if p.Plan.GetQuery() == nil || c.capacity <= 0 {
+		return p, nil
+	}
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.plans[key]; ok {
+		// Planned by another session meanwhile
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*planCacheEntry).plan, nil
+	}
+	c.plans[key] = c.lru.PushFront(&planCacheEntry{key: key, plan: p})
+	for c.lru.Len() > c.capacity {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.plans, elem.Value.(*planCacheEntry).key)
+	}
+	return p, nil
+}
+
+// Len returns the number of the plans cached
+func (c *PlanCache) Len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.lru.Len()
+}
+
+// normalizeSQL collapses the spaces out of the quotes of sql and trims the
+// trailing semicolons
+func normalizeSQL(sql string) string {
+	var b strings.Builder
+	var quote rune
+	space := false
+	for _, r := range strings.TrimRight(strings.TrimSpace(sql), "; \t\r\n") {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == ' ' || r == '\t' || r == '\r' || r == '\n':
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+type versionCompilerContext struct {
+	CompilerContext
+	version uint64
+}
+
+func (v *versionCompilerContext) SchemaVersion() uint64 {
+	return v.version
+}
+
+func TestPrepare(t *testing.T) {
+	cache := NewPlanCache(8)
+	ctx := NewMockOptimizer().CurrentContext()
+	p, err := cache.Get(ctx, "SELECT N_NAME FROM NATION WHERE N_NATIONKEY = ? AND N_NAME LIKE ?")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(p.ParamTypes) != 2 || p.ParamTypes[0].Id != plan.Type_INT32 || p.ParamTypes[1].Id != plan.Type_VARCHAR {
+		t.Fatalf("the parameters should be typed by the columns but now are %v", p.ParamTypes)
+	}
+	// The filters with the parameters are pushed down
+	nation := scanOf(p.Plan.GetQuery(), "nation")
+	if len(nation.WhereList) != 2 {
+		t.Fatalf("the filters should be pushed down to the scan, %v", nation.WhereList)
+	}
+
+	pn, err := p.Bind(ctx, []*Const{
+		{Value: &plan.Const_Ival{Ival: 3}},
+		{Value: &plan.Const_Sval{Sval: "CHINA%"}},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if preds := SargablePredicates(scanOf(pn.GetQuery(), "nation")); len(preds) != 1 {
+		t.Fatalf("n_nationkey = 3 should be sargable but now are %v", preds)
+	}
+	walkParams(pn, func(e *Expr) {
+		t.Fatalf("the parameter %v should be bound", e)
+	})
+	if len(scanOf(p.Plan.GetQuery(), "nation").WhereList) != 2 {
+		t.Fatalf("the prepared plan should not be modified")
+	}
+	walked := 0
+	walkParams(p.Plan, func(e *Expr) {
+		walked++
+	})
+	if walked != 2 {
+		t.Fatalf("the prepared plan should keep the parameters but now are %d", walked)
+	}
+
+	badParams := [][]*Const{
+		{{Value: &plan.Const_Ival{Ival: 3}}},
+		{{Value: &plan.Const_Sval{Sval: "3"}}, {Value: &plan.Const_Sval{Sval: "CHINA"}}},
+		{{Value: &plan.Const_Dval{Dval: 3.5}}, {Value: &plan.Const_Sval{Sval: "CHINA"}}},
+		{{Value: &plan.Const_Ival{Ival: 1 << 40}}, {Value: &plan.Const_Sval{Sval: "CHINA"}}},
+		{{Value: &plan.Const_Ival{Ival: 3}}, {Value: &plan.Const_Ival{Ival: 3}}},
+	}
+	for _, params := range badParams {
+		if _, err := p.Bind(ctx, params); err == nil {
+			t.Fatalf("%v should not be bound", params)
+		}
+	}
+	if _, err := p.Bind(ctx, []*Const{{Isnull: true}, {Value: &plan.Const_Sval{Sval: "CHINA"}}}); err != nil {
+		t.Fatalf("null should be bound, %+v", err)
+	}
+}
+
+func TestPlanCache(t *testing.T) {
+	cache := NewPlanCache(2)
+	ctx := &versionCompilerContext{CompilerContext: NewMockOptimizer().CurrentContext()}
+	sql := "SELECT N_NAME FROM NATION WHERE N_NATIONKEY = ?"
+	p1, err := cache.Get(ctx, sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	p2, err := cache.Get(ctx, "  SELECT N_NAME\n\tFROM NATION   WHERE N_NATIONKEY = ?;")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if p1 != p2 || cache.Len() != 1 {
+		t.Fatalf("the plan should be cached by the normalized sql")
+	}
+	if p3, _ := cache.Get(ctx, "SELECT N_NAME FROM NATION WHERE N_NAME = 'A  B'"); p3 == p1 || cache.Len() != 2 {
+		t.Fatalf("the spaces in the quotes should be kept")
+	}
+
+	// The schema changes
+	ctx.version++
+	p4, err := cache.Get(ctx, sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if p4 == p1 {
+		t.Fatalf("the plan of the old schema should not be used")
+	}
+	if cache.Len() != 2 {
+		t.Fatalf("the least recently used plan should be evicted but now are %d", cache.Len())
+	}
+	if normalizeSQL("select 'a  b' ,  \"c;\"  ;") != "select 'a  b' , \"c;\"" {
+		t.Fatalf("the sql is normalized as %s", normalizeSQL("select 'a  b' ,  \"c;\"  ;"))
+	}
+}
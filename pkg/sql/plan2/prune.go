@@ -0,0 +1,359 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// pruneColumns computes the output columns of the nodes referenced above
+// them from the steps down, and trims the project lists of the scans and
+// the joins to them. The left joins whose right sides are not referenced
+// are removed. The scans read the columns of the tables referenced by their
+// project lists and filters only. The outputs of the steps and of
+// the nodes referenced by the correlated columns are kept
+func (o *optimizer) pruneColumns() {
+	parents := make(map[int32]int)
+	for _, node := range o.query.Nodes {
+		for _, child := range node.Children {
+			parents[child]++
+		}
+	}
+	o.unshareExprs()
+
+	// The nodes in the order of their parents first
+	var order []*Node
+	visited := make(map[int32]bool)
+	var postOrder func(id int32)
+	postOrder = func(id int32) {
+		if !o.validNode(id) || visited[id] {
+			return
+		}
+		visited[id] = true
+		node := o.nodes[id]
+		for _, child := range node.Children {
+			postOrder(child)
+		}
+		order = append(order, node)
+	}
+	for _, node := range o.query.Nodes {
+		postOrder(node.NodeId)
+	}
+
+	need := make(map[int32][]bool)
+	for _, node := range o.query.Nodes {
+		if node.ProjectList == nil {
+			continue
+		}
+		need[node.NodeId] = make([]bool, len(node.ProjectList))
+		if !o.trimmable(node, parents) {
+			for i := range need[node.NodeId] {
+				need[node.NodeId][i] = true
+			}
+		}
+	}
+	require := func(id int32, pos int32) {
+		if cols := need[o.base(id)]; pos >= 0 && int(pos) < len(cols) {
+			cols[pos] = true
+		}
+	}
+	requireAll := func(id int32) {
+		for i := range need[o.base(id)] {
+			need[o.base(id)][i] = true
+		}
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		node := order[i]
+		switch {
+		case len(node.Children) == 0:
+		case node.NodeType == plan.Node_JOIN && len(node.Children) == 2:
+			wl := int32(len(o.output(node.Children[0])))
+			o.forEachRef(node, need[node.NodeId], func(col *plan.ColRef) {
+				switch {
+				case col.RelPos == 1:
+					require(node.Children[1], col.ColPos)
+				case col.ColPos < wl:
+					require(node.Children[0], col.ColPos)
+				default:
+					require(node.Children[1], col.ColPos-wl)
+				}
+			})
+		case len(node.Children) == 1 && !passthrough(node):
+			o.forEachRef(node, need[node.NodeId], func(col *plan.ColRef) {
+				require(node.Children[0], col.ColPos)
+			})
+		default:
+			for _, child := range node.Children {
+				requireAll(child)
+			}
+		}
+	}
+	o.eliminateJoins(need)
+
+	maps := make(map[int32][]int32)
+	lens := make(map[int32]int32)
+	for _, node := range o.query.Nodes {
+		if o.trimmable(node, parents) {
+			lens[node.NodeId] = int32(len(node.ProjectList))
+			maps[node.NodeId] = o.trimOutput(node, need[node.NodeId])
+		}
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil {
+			trimTable(node)
+		}
+	}
+	seen := make(map[*plan.ColRef]bool)
+	for _, node := range o.query.Nodes {
+		switch {
+		case len(node.Children) == 0:
+		case node.NodeType == plan.Node_JOIN && len(node.Children) == 2:
+			l, r := o.base(node.Children[0]), o.base(node.Children[1])
+			if maps[l] == nil && maps[r] == nil {
+				continue
+			}
+			wl, ok := lens[l]
+			if !ok {
+				wl = int32(len(o.output(l)))
+			}
+			nwl := int32(len(o.output(l)))
+			remapNodeRefs(node, seen, func(col *plan.ColRef) {
+				switch {
+				case col.RelPos == 1:
+					col.ColPos = mapPos(maps[r], col.ColPos)
+				case col.ColPos < wl:
+					col.ColPos = mapPos(maps[l], col.ColPos)
+				default:
+					col.ColPos = nwl + mapPos(maps[r], col.ColPos-wl)
+				}
+			})
+		case len(node.Children) == 1:
+			if m := maps[o.base(node.Children[0])]; m != nil {
+				remapNodeRefs(node, seen, func(col *plan.ColRef) {
+					col.ColPos = mapPos(m, col.ColPos)
+				})
+			}
+		}
+	}
+}
+
+// unshareExprs copies the expressions shared by several nodes, such as the
+// columns of * in the projections above a scan or a join, which are mapped
+// by each node. The columns of the right side of a join in the nodes above
+// it are moved to its output
+func (o *optimizer) unshareExprs() {
+	owners := make(map[*Expr]int32)
+	own := func(id int32, e *Expr) *Expr {
+		shared := false
+		walkExpr(e, func(e *Expr) {
+			if owner, ok := owners[e]; ok && owner != id {
+				shared = true
+			}
+		})
+		if shared {
+			e = cloneExpr(e)
+		}
+		walkExpr(e, func(e *Expr) {
+			owners[e] = id
+		})
+		return e
+	}
+	for _, node := range o.query.Nodes {
+		for _, list := range nodeExprLists(node) {
+			for i, e := range list {
+				list[i] = own(node.NodeId, e)
+			}
+		}
+		for _, orderBy := range node.OrderBy {
+			orderBy.OrderBy = own(node.NodeId, orderBy.OrderBy)
+		}
+		if node.WinSpec != nil {
+			for _, orderBy := range node.WinSpec.OderyBy {
+				orderBy.OrderBy = own(node.NodeId, orderBy.OrderBy)
+			}
+		}
+		if node.NodeType == plan.Node_JOIN || len(node.Children) != 1 {
+			continue
+		}
+		if base := o.nodes[o.base(node.Children[0])]; base != nil && base.NodeType == plan.Node_JOIN && len(base.Children) == 2 {
+			wl := len(o.output(base.Children[0]))
+			for _, list := range nodeExprLists(node) {
+				normalizeRefs(list, wl)
+			}
+		}
+	}
+}
+
+// trimmable returns true if the output of node is referenced by its parents
+// only
+func (o *optimizer) trimmable(node *Node, parents map[int32]int) bool {
+	id := node.NodeId
+	// The mark of a MARK join is after the columns of its right side
+	if o.steps[id] || o.pinned[id] || parents[id] == 0 || node.ProjectList == nil || node.JoinType == plan.Node_MARK {
+		return false
+	}
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		if node.TableDef == nil {
+			return false
+		}
+		for _, e := range node.ProjectList {
+			if e.Expr == nil {
+				return false
+			}
+		}
+		return true
+	case plan.Node_JOIN:
+		if len(node.Children) != 2 {
+			return false
+		}
+		for _, e := range node.ProjectList {
+			if _, ok := e.Expr.(*plan.Expr_Col); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// trimOutput keeps the output columns of node in need, at least one, and
+// returns the new positions of the old ones, -1 for the ones removed
+func (o *optimizer) trimOutput(node *Node, need []bool) []int32 {
+	kept := false
+	for _, ok := range need {
+		kept = kept || ok
+	}
+	if !kept && len(need) > 0 {
+		// The rows are counted still
+		need[0] = true
+	}
+	m := make([]int32, len(node.ProjectList))
+	list := make([]*Expr, 0, len(node.ProjectList))
+	for i, e := range node.ProjectList {
+		m[i] = -1
+		if need[i] {
+			m[i] = int32(len(list))
+			list = append(list, e)
+		}
+	}
+	node.ProjectList = list
+	return m
+}
+
+// trimTable trims the columns of the table of the scan node to the ones
+// referenced by its project list and filters
+func trimTable(node *Node) {
+	used := make([]bool, len(node.TableDef.Cols))
+	for _, list := range nodeExprLists(node) {
+		for _, e := range list {
+			walkExpr(e, func(e *Expr) {
+				if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.ColPos >= 0 && int(col.Col.ColPos) < len(used) {
+					used[col.Col.ColPos] = true
+				}
+			})
+		}
+	}
+	m := make([]int32, len(used))
+	def := &plan.TableDef{
+		Name: node.TableDef.Name,
+		Defs: node.TableDef.Defs,
+	}
+	for i, col := range node.TableDef.Cols {
+		m[i] = -1
+		if used[i] {
+			m[i] = int32(len(def.Cols))
+			def.Cols = append(def.Cols, col)
+		}
+	}
+	if len(def.Cols) == len(used) {
+		return
+	}
+	node.TableDef = def
+	remapNodeRefs(node, make(map[*plan.ColRef]bool), func(col *plan.ColRef) {
+		col.ColPos = mapPos(m, col.ColPos)
+	})
+}
+
+// base returns the node whose project list is the output of node id
+func (o *optimizer) base(id int32) int32 {
+	for o.validNode(id) {
+		node := o.nodes[id]
+		if node.ProjectList != nil || len(node.Children) == 0 {
+			return id
+		}
+		id = node.Children[0]
+	}
+	return -1
+}
+
+func (o *optimizer) output(id int32) []*Expr {
+	if node, ok := o.nodes[o.base(id)]; ok {
+		return node.ProjectList
+	}
+	return nil
+}
+
+// passthrough returns true if node outputs the columns of its child as
+// they are, as the projections of the derived tables and the materialized
+// common table expressions
+func passthrough(node *Node) bool {
+	for _, e := range node.ProjectList {
+		if e.Expr == nil {
+			return true
+		}
+	}
+	return node.NodeType == plan.Node_MATERIAL
+}
+
+// forEachRef calls fn with the columns referenced by node, the ones of the
+// project list in out only
+func (o *optimizer) forEachRef(node *Node, out []bool, fn func(*plan.ColRef)) {
+	visit := func(e *Expr) {
+		walkExpr(e, func(e *Expr) {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok {
+				fn(col.Col)
+			}
+		})
+	}
+	for i, e := range node.ProjectList {
+		if i >= len(out) || out[i] {
+			visit(e)
+		}
+	}
+	for _, list := range nodeExprLists(node)[1:] {
+		for _, e := range list {
+			visit(e)
+		}
+	}
+}
+
+func remapNodeRefs(node *Node, seen map[*plan.ColRef]bool, fn func(*plan.ColRef)) {
+	for _, list := range nodeExprLists(node) {
+		for _, e := range list {
+			walkExpr(e, func(e *Expr) {
+				if col, ok := e.Expr.(*plan.Expr_Col); ok && !seen[col.Col] {
+					seen[col.Col] = true
+					fn(col.Col)
+				}
+			})
+		}
+	}
+}
+
+func mapPos(m []int32, pos int32) int32 {
+	if m == nil || pos < 0 || int(pos) >= len(m) {
+		return pos
+	}
+	return m[pos]
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func colNames(def *TableDef) []string {
+	names := make([]string, len(def.Cols))
+	for i, col := range def.Cols {
+		names[i] = col.Name
+	}
+	return names
+}
+
+func colPos(e *Expr) int32 {
+	return e.Expr.(*plan.Expr_Col).Col.ColPos
+}
+
+func TestPruneColumns(t *testing.T) {
+	sql := "SELECT N_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE R_NAME = 'ASIA'"
+	query := buildWithStats(t, sql, false)
+	nation, region := scanOf(query, "nation"), scanOf(query, "region")
+	if names := colNames(nation.TableDef); len(names) != 2 || names[0] != "n_name" || names[1] != "n_regionkey" {
+		t.Fatalf("nation should read n_name and n_regionkey but now reads %v", names)
+	}
+	if len(nation.ProjectList) != 2 || colPos(nation.ProjectList[0]) != 0 || colPos(nation.ProjectList[1]) != 1 {
+		t.Fatalf("the output of nation should be trimmed, %v", nation.ProjectList)
+	}
+	// r_name is read by the filter only
+	if names := colNames(region.TableDef); len(names) != 2 || len(region.ProjectList) != 1 {
+		t.Fatalf("region should output r_regionkey only, reads %v", names)
+	}
+	if colPos(region.WhereList[0].Expr.(*plan.Expr_F).F.Args[0]) != 1 {
+		t.Fatalf("r_name should be at 1, %v", region.WhereList[0])
+	}
+
+	root := query.Nodes[query.Steps[0]]
+	args := root.OnList[0].Expr.(*plan.Expr_F).F.Args
+	if colPos(args[0]) != 1 || colPos(args[1]) != 2 {
+		t.Fatalf("n_regionkey = r_regionkey should be on 1 and 2, but now is %v", root.OnList[0])
+	}
+	if len(root.ProjectList) != 1 || colPos(root.ProjectList[0]) != 0 {
+		t.Fatalf("n_name should be at 0, %v", root.ProjectList)
+	}
+}
+
+func TestPruneColumnsOfJoins(t *testing.T) {
+	sql := "SELECT COUNT(*) FROM LINEITEM, ORDERS, CUSTOMER WHERE L_ORDERKEY = O_ORDERKEY AND O_CUSTKEY = C_CUSTKEY GROUP BY C_NAME"
+	query := buildWithStats(t, sql, false)
+	if names := colNames(scanOf(query, "lineitem").TableDef); len(names) != 1 || names[0] != "l_orderkey" {
+		t.Fatalf("lineitem should read l_orderkey only but now reads %v", names)
+	}
+	for _, node := range query.Nodes {
+		if node.NodeType != plan.Node_JOIN {
+			continue
+		}
+		// The inner join outputs the keys of the conditions of the outer
+		// one only
+		if left := query.Nodes[node.Children[0]]; left.NodeType == plan.Node_JOIN && len(left.ProjectList) != 3 {
+			t.Fatalf("the inner join should output 3 columns, %v", left.ProjectList)
+		}
+	}
+
+	// A column is kept for the rows counted
+	query = buildWithStats(t, "SELECT COUNT(*) FROM LINEITEM, ORDERS WHERE L_ORDERKEY = O_ORDERKEY", false)
+	if names := colNames(scanOf(query, "lineitem").TableDef); len(names) != 1 {
+		t.Fatalf("lineitem should read a column but now reads %v", names)
+	}
+
+	// All the columns of *
+	query = buildWithStats(t, "SELECT * FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY ORDER BY N_NAME", false)
+	if len(scanOf(query, "nation").TableDef.Cols) != 4 || len(scanOf(query, "region").ProjectList) != 3 {
+		t.Fatalf("the columns of * should be kept")
+	}
+}
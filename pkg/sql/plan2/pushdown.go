@@ -0,0 +1,319 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// pushDownFilters pushes the filters of the projections and the ones of the
+// joins referring to the columns of one side down through the joins and
+// the projections into the scans they come from. A filter stays if it
+// cannot reach a scan
+func (o *optimizer) pushDownFilters(id int32, visited map[int32]bool) {
+	if !o.validNode(id) || visited[id] {
+		return
+	}
+	visited[id] = true
+	node := o.nodes[id]
+	switch {
+	case node.NodeType == plan.Node_JOIN && len(node.Children) == 2:
+		node.WhereList = o.pushJoinFilters(node, node.WhereList, false)
+		node.OnList = o.pushJoinFilters(node, node.OnList, true)
+	case node.NodeType == plan.Node_PROJECT && len(node.Children) == 1:
+		kept := node.WhereList[:0]
+		for _, e := range node.WhereList {
+			if !pushable(e) || !o.pushFilter(node.Children[0], e) {
+				kept = append(kept, e)
+			}
+		}
+		node.WhereList = kept
+	}
+	for _, child := range node.Children {
+		o.pushDownFilters(child, visited)
+	}
+}
+
+// pushJoinFilters returns the filters of node not pushed down. The ones of
+// the ON list of an outer join are pushed to the nullable side only, and
+// the ones of the WHERE list to the preserved side only
+func (o *optimizer) pushJoinFilters(node *Node, filters []*Expr, on bool) []*Expr {
+	kept := filters[:0]
+	for _, e := range filters {
+		if !o.pushJoinFilter(node, e, on) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// pushJoinFilter pushes e on the columns of both children of node down to a
+// scan below one of them
+func (o *optimizer) pushJoinFilter(node *Node, e *Expr, on bool) bool {
+	if !pushable(e) {
+		return false
+	}
+	wl := len(o.nodes[node.Children[0]].ProjectList)
+	side := -1
+	walkExpr(e, func(e *Expr) {
+		if col, ok := e.Expr.(*plan.Expr_Col); ok {
+			s := 0
+			if int(col.Col.ColPos) >= wl {
+				s = 1
+			}
+			if side == -1 || side == s {
+				side = s
+			} else {
+				side = 2
+			}
+		}
+	})
+	if side < 0 || side > 1 {
+		return false
+	}
+	flag := o.joinFlag(node, side)
+	if on {
+		// The rows of the preserved side are kept anyway
+		flag = o.joinFlag(node, 1-side)
+	}
+	if flag != plan.Node_INNER {
+		return false
+	}
+	if side == 1 {
+		e = cloneExpr(e)
+		shiftCols(e, -int32(wl))
+	}
+	return o.pushFilter(node.Children[side], e)
+}
+
+// pushFilter pushes e on the output columns of node id down to the scan it
+// comes from, e is not changed if it cannot be pushed
+func (o *optimizer) pushFilter(id int32, e *Expr) bool {
+	if !o.validNode(id) {
+		return false
+	}
+	node := o.nodes[id]
+	if node.Limit != nil || node.Offset != nil {
+		return false
+	}
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN, plan.Node_CTE_SCAN:
+		if node.TableDef == nil {
+			return false
+		}
+		pushed, ok := o.mapOutput(node, e, func(col *plan.ColRef) (int32, bool) {
+			return col.ColPos, col.RelPos == 0
+		})
+		if !ok {
+			return false
+		}
+		node.WhereList = append(node.WhereList, pushed)
+		return true
+	case plan.Node_PROJECT:
+		if len(node.Children) != 1 {
+			return false
+		}
+		pushed, ok := o.mapOutput(node, e, func(col *plan.ColRef) (int32, bool) {
+			return col.ColPos, col.RelPos == 0
+		})
+		return ok && o.pushFilter(node.Children[0], pushed)
+	case plan.Node_JOIN:
+		if len(node.Children) != 2 {
+			return false
+		}
+		// The columns of the right child are after the ones of the left
+		// child in the input of a join
+		wl := int32(len(o.nodes[node.Children[0]].ProjectList))
+		pushed, ok := o.mapOutput(node, e, func(col *plan.ColRef) (int32, bool) {
+			switch col.RelPos {
+			case 0:
+				return col.ColPos, true
+			case 1:
+				return wl + col.ColPos, true
+			}
+			return 0, false
+		})
+		return ok && o.pushJoinFilter(node, pushed, false)
+	}
+	return false
+}
+
+// mapOutput returns a copy of e with its columns on the output of node
+// mapped to the input of node by the columns of its project list. It
+// fails if one is not a column
+func (o *optimizer) mapOutput(node *Node, e *Expr, input func(*plan.ColRef) (int32, bool)) (*Expr, bool) {
+	e = cloneExpr(e)
+	ok := true
+	walkExpr(e, func(e *Expr) {
+		col, isCol := e.Expr.(*plan.Expr_Col)
+		if !isCol || !ok {
+			return
+		}
+		if node.ProjectList == nil {
+			return
+		}
+		pos := col.Col.ColPos
+		if pos < 0 || int(pos) >= len(node.ProjectList) {
+			ok = false
+			return
+		}
+		out := node.ProjectList[pos]
+		if out.Expr == nil {
+			// A passthrough of the derived tables
+			return
+		}
+		ref, isRef := out.Expr.(*plan.Expr_Col)
+		if !isRef {
+			ok = false
+			return
+		}
+		if col.Col.ColPos, ok = input(ref.Col); ok {
+			col.Col.Name = ref.Col.Name
+		}
+	})
+	return e, ok
+}
+
+// pushable returns true if e is a filter without subqueries
+func pushable(e *Expr) bool {
+	ok := true
+	walkExpr(e, func(e *Expr) {
+		switch ex := e.Expr.(type) {
+		case *plan.Expr_Sub, *plan.Expr_Corr, *plan.Expr_V:
+			ok = false
+		case *plan.Expr_Col:
+			ok = ok && ex.Col.RelPos == 0
+		}
+	})
+	return ok
+}
+
+func shiftCols(e *Expr, delta int32) {
+	walkExpr(e, func(e *Expr) {
+		if col, ok := e.Expr.(*plan.Expr_Col); ok {
+			col.Col.ColPos += delta
+		}
+	})
+}
+
+// cloneExpr copies e with its columns, the constants are shared
+func cloneExpr(e *Expr) *Expr {
+	if e == nil {
+		return nil
+	}
+	c := &Expr{
+		Typ:   e.Typ,
+		Alias: e.Alias,
+	}
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		c.Expr = &plan.Expr_Col{Col: &plan.ColRef{Name: ex.Col.Name, RelPos: ex.Col.RelPos, ColPos: ex.Col.ColPos}}
+	case *plan.Expr_F:
+		args := make([]*Expr, len(ex.F.Args))
+		for i, arg := range ex.F.Args {
+			args[i] = cloneExpr(arg)
+		}
+		c.Expr = &plan.Expr_F{F: &plan.Function{Func: ex.F.Func, Args: args}}
+	case *plan.Expr_List:
+		list := make([]*Expr, len(ex.List.List))
+		for i, item := range ex.List.List {
+			list[i] = cloneExpr(item)
+		}
+		c.Expr = &plan.Expr_List{List: &plan.ExprList{List: list}}
+	default:
+		c.Expr = e.Expr
+	}
+	return c
+}
+
+// SargablePredicates returns the filters of the scan node comparing a
+// column of the table to a constant, which the engines could check with
+// the zone maps of the blocks to skip them. The columns are at the left
+// sides of the comparisons returned, named as in the table
+func SargablePredicates(node *Node) []*Expr {
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		return nil
+	}
+	var preds []*Expr
+	var collect func(e *Expr)
+	collect = func(e *Expr) {
+		f, ok := e.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 2 {
+			return
+		}
+		name := strings.ToUpper(f.F.Func.GetObjName())
+		if name == "AND" {
+			collect(f.F.Args[0])
+			collect(f.F.Args[1])
+			return
+		}
+		op, ok := sargableOps[name]
+		if !ok {
+			return
+		}
+		l, r := f.F.Args[0], f.F.Args[1]
+		if _, ok := l.Expr.(*plan.Expr_C); ok {
+			l, r, op = r, l, flippedOps[op]
+		}
+		col, lok := l.Expr.(*plan.Expr_Col)
+		_, rok := r.Expr.(*plan.Expr_C)
+		if !lok || !rok || col.Col.RelPos != 0 {
+			return
+		}
+		pos := col.Col.ColPos
+		if pos < 0 || int(pos) >= len(node.TableDef.Cols) {
+			return
+		}
+		l = &Expr{
+			Typ:   l.Typ,
+			Alias: l.Alias,
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{
+				Name:   node.TableDef.Cols[pos].Name,
+				ColPos: pos,
+			}},
+		}
+		preds = append(preds, &Expr{
+			Typ: e.Typ,
+			Expr: &plan.Expr_F{F: &plan.Function{
+				Func: getFunctionObjRef(op),
+				Args: []*Expr{l, r},
+			}},
+		})
+	}
+	for _, e := range node.WhereList {
+		collect(e)
+	}
+	return preds
+}
+
+var sargableOps = map[string]string{
+	"=":  "=",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+// flippedOps maps the op of "const op col" to the op of "col op const"
+var flippedOps = map[string]string{
+	"=":  "=",
+	"<>": "<>",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
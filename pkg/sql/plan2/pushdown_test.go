@@ -0,0 +1,116 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func scanOf(query *Query, name string) *Node {
+	for _, node := range query.Nodes {
+		if scanName(query, node.NodeId) == name {
+			return node
+		}
+	}
+	return nil
+}
+
+func TestPushDownFilters(t *testing.T) {
+	sql := "SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE N_NATIONKEY < 6 AND R_NAME = 'ASIA'"
+	query := buildWithStats(t, sql, false)
+	nation, region := scanOf(query, "nation"), scanOf(query, "region")
+	if len(nation.WhereList) != 1 || len(region.WhereList) != 1 {
+		t.Fatalf("the filters should be pushed down to the scans, %v %v", nation.WhereList, region.WhereList)
+	}
+	// The positions are the ones in the columns of the tables
+	col := nation.WhereList[0].Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_Col).Col
+	if col.ColPos != 0 {
+		t.Fatalf("n_nationkey should be at 0 but now is at %v", col.ColPos)
+	}
+	col = region.WhereList[0].Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_Col).Col
+	if col.ColPos != 1 {
+		t.Fatalf("r_name should be at 1 but now is at %v", col.ColPos)
+	}
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_JOIN && (len(node.WhereList) != 0 || len(node.OnList) != 1) {
+			t.Fatalf("only the join condition should be kept, %v %v", node.OnList, node.WhereList)
+		}
+	}
+}
+
+func TestPushDownOuterJoin(t *testing.T) {
+	sql := "SELECT N_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY AND R_NAME = 'ASIA' WHERE R_COMMENT IS NULL AND N_NATIONKEY < 6"
+	query := buildWithStats(t, sql, false)
+	nation, region := scanOf(query, "nation"), scanOf(query, "region")
+	// The filter of the ON list goes to the nullable side, the one of the
+	// WHERE list on the nullable side stays
+	if len(nation.WhereList) != 1 || len(region.WhereList) != 1 {
+		t.Fatalf("the filters should be pushed down to the scans, %v %v", nation.WhereList, region.WhereList)
+	}
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_JOIN && (len(node.WhereList) != 1 || len(node.OnList) != 1) {
+			t.Fatalf("the filter on the nullable side should be kept, %v %v", node.OnList, node.WhereList)
+		}
+	}
+}
+
+func TestPushDownDerivedTable(t *testing.T) {
+	sql := "SELECT A FROM (SELECT N_NATIONKEY AS A, N_NAME FROM NATION) T WHERE A > 10"
+	query := buildWithStats(t, sql, false)
+	nation := scanOf(query, "nation")
+	if len(nation.WhereList) != 1 {
+		t.Fatalf("the filter should be pushed down through the projections, %v", query)
+	}
+	col := nation.WhereList[0].Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_Col).Col
+	if col.ColPos != 0 || col.Name != "nation.n_nationkey" {
+		t.Fatalf("the filter should be on n_nationkey but now is on %v", col)
+	}
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_PROJECT && len(node.WhereList) != 0 {
+			t.Fatalf("the filter should not be kept, %v", node.WhereList)
+		}
+	}
+
+	// Not through a limit
+	sql = "SELECT A FROM (SELECT N_NATIONKEY AS A FROM NATION LIMIT 5) T WHERE A > 10"
+	query = buildWithStats(t, sql, false)
+	if nation = scanOf(query, "nation"); len(nation.WhereList) != 0 {
+		t.Fatalf("the filter should not be pushed down through a limit, %v", nation.WhereList)
+	}
+}
+
+func TestSargablePredicates(t *testing.T) {
+	sql := "SELECT N_NAME FROM NATION WHERE 6 > N_NATIONKEY AND N_NAME = 'CHINA' AND N_REGIONKEY + 1 = 2"
+	query := buildWithStats(t, sql, false)
+	preds := SargablePredicates(scanOf(query, "nation"))
+	// n_regionkey + 1 = 2 is folded into n_regionkey = 1
+	if len(preds) != 3 {
+		t.Fatalf("there should be 3 sargable predicates but now are %v", preds)
+	}
+	f := preds[0].Expr.(*plan.Expr_F).F
+	if f.Func.ObjName != "<" || f.Args[0].Expr.(*plan.Expr_Col).Col.Name != "n_nationkey" || f.Args[1].Expr.(*plan.Expr_C).C.GetIval() != 6 {
+		t.Fatalf("6 > n_nationkey should be flipped but now is %v", preds[0])
+	}
+	f = preds[1].Expr.(*plan.Expr_F).F
+	if f.Func.ObjName != "=" || f.Args[0].Expr.(*plan.Expr_Col).Col.Name != "n_name" || f.Args[1].Expr.(*plan.Expr_C).C.GetSval() != "CHINA" {
+		t.Fatalf("the second one should be n_name = 'CHINA' but now is %v", preds[1])
+	}
+	f = preds[2].Expr.(*plan.Expr_F).F
+	if f.Func.ObjName != "=" || f.Args[0].Expr.(*plan.Expr_Col).Col.Name != "n_regionkey" || f.Args[1].Expr.(*plan.Expr_C).C.GetIval() != 1 {
+		t.Fatalf("the third one should be n_regionkey = 1 but now is %v", preds[2])
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The rules recorded by the optimizer trace
+const (
+	TraceInlineCTE         = "inline_cte"
+	TraceUnnestSubquery    = "unnest_subquery"
+	TraceSimplifyOuterJoin = "simplify_outer_join"
+	TraceJoinOrder         = "join_order"
+	TraceJoinMethod        = "join_method"
+	TraceEliminateJoin     = "eliminate_join"
+	TraceHint              = "hint"
+)
+
+// OptimizerTrace is the record of the rules the optimizer applied to a
+// query and the alternatives it weighed, which is sent to the clients as
+// JSON
+type OptimizerTrace struct {
+	Steps []*TraceStep `json:"steps"`
+}
+
+// TraceStep is a rule applied to a node, or a choice between the
+// alternatives considered for it
+type TraceStep struct {
+	Rule   string `json:"rule"`
+	Node   int32  `json:"node"`
+	Detail string `json:"detail,omitempty"`
+	// Considered are the alternatives with their estimated costs, one of
+	// which is chosen
+	Considered []*TraceAlternative `json:"considered,omitempty"`
+}
+
+// TraceAlternative is an alternative of a choice of the optimizer
+type TraceAlternative struct {
+	Plan   string  `json:"plan"`
+	Cost   float64 `json:"cost"`
+	Chosen bool    `json:"chosen"`
+	// Reason is why it is rejected
+	Reason string `json:"reason,omitempty"`
+}
+
+// JSON returns the indented JSON document of t
+func (t *OptimizerTrace) JSON() string {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("{\"error\": %q}", err.Error())
+	}
+	return string(data)
+}
+
+// add records the rule applied to node, t is nil if the optimizer is not
+// traced
+func (t *OptimizerTrace) add(rule string, node int32, detail string, considered ...*TraceAlternative) {
+	if t == nil {
+		return
+	}
+	t.Steps = append(t.Steps, &TraceStep{
+		Rule:       rule,
+		Node:       node,
+		Detail:     detail,
+		Considered: considered,
+	})
+}
+
+// choose marks the alternative of the least cost chosen unless one is, and
+// the others rejected by reason
+func choose(alts []*TraceAlternative, reason string) []*TraceAlternative {
+	chosen := -1
+	for i, alt := range alts {
+		if alt.Chosen {
+			chosen = i
+		}
+	}
+	if chosen < 0 {
+		for i, alt := range alts {
+			if chosen < 0 || alt.Cost < alts[chosen].Cost {
+				chosen = i
+			}
+		}
+	}
+	for i, alt := range alts {
+		alt.Chosen = i == chosen
+		if !alt.Chosen && alt.Reason == "" {
+			alt.Reason = reason
+		}
+	}
+	return alts
+}
+
+// nodeName names node id in the trace by its table or its type
+func (o *optimizer) nodeName(id int32) string {
+	node, ok := o.nodes[id]
+	if !ok {
+		return fmt.Sprintf("#%d", id)
+	}
+	if node.TableDef != nil && node.TableDef.Name != "" {
+		return node.TableDef.Name
+	}
+	return fmt.Sprintf("%s#%d", strings.ToLower(node.NodeType.String()), id)
+}
+
+// format writes the join tree t of the leaves
+func (t *joinTree) format(leaves []int32, name func(int32) string) string {
+	if t.leaf >= 0 {
+		return name(leaves[t.leaf])
+	}
+	return "(" + t.left.format(leaves, name) + " JOIN " + t.right.format(leaves, name) + ")"
+}
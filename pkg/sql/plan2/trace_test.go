@@ -0,0 +1,111 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func traceOf(t *testing.T, sql string, analyzed bool) *OptimizerTrace {
+	ctx := newStatsCompilerContext()
+	ctx.SetTraceOptimizer(true)
+	if !analyzed {
+		ctx.rows = nil
+	}
+	buildQuery(t, ctx, sql)
+	if ctx.OptimizerTrace() == nil {
+		t.Fatalf("'%s' should be traced", sql)
+	}
+	return ctx.OptimizerTrace()
+}
+
+func stepsOf(trace *OptimizerTrace, rule string) []*TraceStep {
+	var steps []*TraceStep
+	for _, step := range trace.Steps {
+		if step.Rule == rule {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+func TestTraceJoinOrder(t *testing.T) {
+	sql := "SELECT * FROM LINEITEM, ORDERS, CUSTOMER WHERE L_ORDERKEY = O_ORDERKEY AND O_CUSTKEY = C_CUSTKEY"
+	trace := traceOf(t, sql, true)
+	steps := stepsOf(trace, TraceJoinOrder)
+	if len(steps) != 1 || len(steps[0].Considered) < 2 {
+		t.Fatalf("the join orders considered should be traced, %s", trace.JSON())
+	}
+	var chosen *TraceAlternative
+	for _, alt := range steps[0].Considered {
+		if alt.Chosen {
+			if chosen != nil {
+				t.Fatalf("one join order should be chosen, %s", trace.JSON())
+			}
+			chosen = alt
+		} else if alt.Reason == "" {
+			t.Fatalf("the rejected join order %s should have a reason", alt.Plan)
+		}
+	}
+	for _, alt := range steps[0].Considered {
+		if chosen == nil || alt.Cost < chosen.Cost {
+			t.Fatalf("the cheapest join order should be chosen, %s", trace.JSON())
+		}
+	}
+	if methods := stepsOf(trace, TraceJoinMethod); len(methods) != 2 || len(methods[0].Considered) != 2 {
+		t.Fatalf("the join methods of both joins should be traced, %s", trace.JSON())
+	}
+
+	// The document is read by the clients
+	var decoded OptimizerTrace
+	if err := json.Unmarshal([]byte(trace.JSON()), &decoded); err != nil || len(decoded.Steps) != len(trace.Steps) {
+		t.Fatalf("the trace should be a JSON document, %v", err)
+	}
+
+	// Not analyzed
+	trace = traceOf(t, sql, false)
+	if steps := stepsOf(trace, TraceJoinOrder); len(steps) != 1 || !strings.Contains(steps[0].Detail, "not analyzed") {
+		t.Fatalf("the join order kept should be traced, %s", trace.JSON())
+	}
+
+	ctx := newStatsCompilerContext()
+	buildQuery(t, ctx, sql)
+	if ctx.OptimizerTrace() != nil {
+		t.Fatalf("the optimizer should not be traced by default")
+	}
+}
+
+func TestTraceRules(t *testing.T) {
+	rules := map[string]string{
+		"SELECT N_NAME, R_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE R_NAME = 'ASIA'": TraceSimplifyOuterJoin,
+		"SELECT N_NAME FROM NATION LEFT JOIN REGION ON N_REGIONKEY = R_REGIONKEY WHERE N_NATIONKEY < 6":         TraceEliminateJoin,
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION WHERE R_NAME = 'ASIA')": TraceUnnestSubquery,
+		"WITH T AS (SELECT N_NAME FROM NATION) SELECT * FROM T":                                                 TraceInlineCTE,
+		"SELECT /*+ JOIN_ORDER(N, X) */ * FROM NATION N JOIN REGION R ON N_REGIONKEY = R_REGIONKEY":             TraceHint,
+	}
+	for sql, rule := range rules {
+		if trace := traceOf(t, sql, true); len(stepsOf(trace, rule)) == 0 {
+			t.Fatalf("%s of '%s' should be traced, %s", rule, sql, trace.JSON())
+		}
+	}
+
+	sql := "WITH T AS (SELECT N_NAME, N_REGIONKEY FROM NATION) SELECT * FROM T T1, T T2 WHERE T1.N_REGIONKEY = T2.N_REGIONKEY"
+	steps := stepsOf(traceOf(t, sql, true), TraceInlineCTE)
+	if len(steps) != 1 || len(steps[0].Considered) != 2 {
+		t.Fatalf("inlining and materializing the cte should be weighed, %v", steps)
+	}
+}
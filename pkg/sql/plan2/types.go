@@ -17,6 +17,14 @@ package plan2
 import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
+)
+
+const (
+	// MaxGroupingSets is the max number of grouping sets GROUP BY expands to
+	MaxGroupingSets = 4096
+	// MaxCubeColumns is the max number of columns of a CUBE
+	MaxCubeColumns = 12
 )
 
 type TableDef = plan.TableDef
@@ -35,6 +43,76 @@ type CompilerContext interface {
 	Cost(obj *ObjectRef, e *Expr) *Cost
 }
 
+// Statistics is implemented by the CompilerContext of an engine keeping the
+// statistics of its tables. Stats returns nil if there is none for obj, and
+// the optimizer falls back to the estimates of Cost
+type Statistics interface {
+	Stats(obj *ObjectRef) *TableStats
+}
+
+// OptimizerOptions is implemented by the CompilerContext of a session with
+// the variables tuning the optimizer
+type OptimizerOptions interface {
+	// JoinReorder returns false to keep the inner joins in the textual
+	// order for the plan stability
+	JoinReorder() bool
+}
+
+// SessionSettings is implemented by the CompilerContext of a session, whose
+// settings reach the planner and the executor of the plans built
+type SessionSettings interface {
+	Settings() *settings.Settings
+}
+
+// OptimizerTracer is implemented by the CompilerContext of a session
+// tracing the optimizer. The trace of each query optimized is passed to
+// SetOptimizerTrace if OptimizerTraceEnabled returns true
+type OptimizerTracer interface {
+	OptimizerTraceEnabled() bool
+	SetOptimizerTrace(trace *OptimizerTrace)
+}
+
+// SchemaVersion is implemented by the CompilerContext of an engine counting
+// the changes of its catalog. The plans cached under an older version are
+// planned again
+type SchemaVersion interface {
+	SchemaVersion() uint64
+}
+
+// BlockPruner is implemented by the CompilerContext of an engine listing the
+// blocks of a table which may have rows satisfying the predicates. The
+// predicates compare the columns of def named as in the table to constants.
+// It returns false if the blocks of obj are not listed
+type BlockPruner interface {
+	Blocks(obj *ObjectRef, def *TableDef, preds []*Expr) ([]uint32, []*plan.BlockRef, bool)
+}
+
+// IndexLookup is implemented by the CompilerContext of an engine keeping the
+// secondary indexes of the tables. Lookup returns the blocks of obj with the
+// rows whose column col equals the constant key, which are found by the
+// index of col. It returns false if the rows of obj are not looked up
+type IndexLookup interface {
+	Lookup(obj *ObjectRef, col string, key *Expr) ([]*plan.BlockRef, bool)
+}
+
+// TableStats is the statistics of a table consumed by the optimizer
+type TableStats struct {
+	Rows float64
+	// Columns is indexed by the column names, a column missing has no
+	// statistics
+	Columns map[string]*ColumnStats
+}
+
+// ColumnStats is the statistics of a column consumed by the optimizer
+type ColumnStats struct {
+	Ndv      float64
+	NullFrac float64
+	// Histogram is the ascending bounds of the equi-depth buckets of the
+	// numeric values, nil if unknown. The first and the last ones are the
+	// min and the max values
+	Histogram []float64
+}
+
 type Optimizer interface {
 	Optimize(stmt tree.Statement) (*Query, error)
 	CurrentContext() CompilerContext
@@ -52,4 +130,14 @@ type SelectContext struct {
 	subQueryIsScalar     bool
 
 	subQueryParentId []int32
+
+	//the tables of the from clause by their aliases, which the hints refer to
+	tables map[string]int32
+	//the hints of the query, shared by its blocks
+	hints *planHints
+
+	//the window functions of the projection, computed by the WINDOW nodes
+	windowFuncs []*windowFunc
+	//where the window functions are being built
+	windowScope windowScope
 }
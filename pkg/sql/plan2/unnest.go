@@ -0,0 +1,621 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// unnester is the state of unnesting the subqueries in the filters of a
+// scan or a join
+type unnester struct {
+	// base is the node of the filters, which the correlated columns of the
+	// subqueries refer to
+	base *Node
+	// top is the last join of the subqueries unnested above base, which
+	// outputs the columns of base
+	top   *Node
+	joins map[int32]bool
+}
+
+// unnestSubqueries rewrites the subqueries of EXISTS, IN and comparisons in
+// the filters of the scans and the joins into the joins with them, the
+// inner subqueries first. A subquery of a filter is joined by SEMI, by
+// ANTI if negated, or by SINGLE if scalar, and one nested in a filter is
+// joined by MARK, whose mark is the column after the ones of its children
+// in the input of the join. The correlated filters of a subquery are
+// pulled up into the conditions of its join, and a correlated scalar
+// subquery of aggregates is grouped by the columns compared with the outer
+// ones. The subqueries which cannot be unnested are kept
+func (o *optimizer) unnestSubqueries() {
+	o.stepTops = make(map[int32]int32)
+	for _, id := range append([]int32{}, o.query.Steps...) {
+		if o.stepIndex(id) >= 0 {
+			o.unnestStep(id)
+		}
+	}
+}
+
+func (o *optimizer) unnestStep(root int32) {
+	// The filters are on the scan or the join below the sorts and the
+	// aggregations
+	id := root
+	for o.validNode(id) {
+		node := o.nodes[id]
+		if (node.NodeType != plan.Node_SORT && node.NodeType != plan.Node_AGG) || len(node.Children) != 1 {
+			break
+		}
+		id = node.Children[0]
+	}
+	base, ok := o.nodes[id]
+	if !ok || (!isScan(base) && base.NodeType != plan.Node_JOIN) {
+		return
+	}
+	hasSub := false
+	for _, e := range base.WhereList {
+		hasSub = hasSub || hasSubquery(e)
+	}
+	if !hasSub {
+		return
+	}
+	// The projections of a step are moved to the top join
+	var proj []*Expr
+	if id == root {
+		proj = base.ProjectList
+		base.ProjectList = o.fillOutput(base)
+		if base.ProjectList == nil {
+			base.ProjectList = proj
+			return
+		}
+	}
+	u := &unnester{
+		base:  base,
+		top:   base,
+		joins: make(map[int32]bool),
+	}
+	kept := base.WhereList[:0]
+	for _, e := range base.WhereList {
+		sub := hasSubquery(e)
+		if !o.unnestFilter(u, e) {
+			kept = append(kept, e)
+			if sub {
+				o.trace.add(TraceUnnestSubquery, base.NodeId, "kept as a subquery, it is not joinable")
+			}
+			continue
+		}
+		if sub {
+			o.trace.add(TraceUnnestSubquery, base.NodeId, "unnested into a join")
+		}
+	}
+	base.WhereList = kept
+	if u.top == base {
+		if id == root {
+			base.ProjectList = proj
+		}
+		return
+	}
+	for _, node := range o.query.Nodes {
+		if u.joins[node.NodeId] {
+			continue
+		}
+		for i, child := range node.Children {
+			if child == base.NodeId {
+				node.Children[i] = u.top.NodeId
+			}
+		}
+	}
+	if id == root {
+		u.top.ProjectList = proj
+		u.top.Limit, u.top.Offset = base.Limit, base.Offset
+		base.Limit, base.Offset = nil, nil
+		o.query.Steps[o.stepIndex(root)] = u.top.NodeId
+		o.stepTops[root] = u.top.NodeId
+	}
+}
+
+// unnestFilter joins the subquery of the filter e of u.base, e is dropped
+// if it returns true
+func (o *optimizer) unnestFilter(u *unnester, e *Expr) bool {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) == 0 {
+		return false
+	}
+	args := f.F.Args
+	switch name := strings.ToUpper(f.F.Func.GetObjName()); name {
+	case "EXISTS":
+		if sub, ok := args[0].Expr.(*plan.Expr_Sub); ok {
+			return o.unnestJoin(u, sub.Sub, plan.Node_SEMI, "", nil) != nil
+		}
+	case "NOT":
+		if g, ok := args[0].Expr.(*plan.Expr_F); ok && strings.EqualFold(g.F.Func.GetObjName(), "EXISTS") && len(g.F.Args) == 1 {
+			if sub, ok := g.F.Args[0].Expr.(*plan.Expr_Sub); ok {
+				return o.unnestJoin(u, sub.Sub, plan.Node_ANTI, "", nil) != nil
+			}
+		}
+	case "IN":
+		if sub, ok := args[len(args)-1].Expr.(*plan.Expr_Sub); ok && len(args) == 2 {
+			return o.unnestJoin(u, sub.Sub, plan.Node_SEMI, "=", args[0]) != nil
+		}
+	case "=", "<>", "<", "<=", ">", ">=":
+		if len(args) != 2 {
+			break
+		}
+		if sub, ok := args[1].Expr.(*plan.Expr_Sub); ok && !hasSubquery(args[0]) {
+			return o.unnestJoin(u, sub.Sub, plan.Node_SINGLE, name, args[0]) != nil
+		}
+		if sub, ok := args[0].Expr.(*plan.Expr_Sub); ok && !hasSubquery(args[1]) {
+			return o.unnestJoin(u, sub.Sub, plan.Node_SINGLE, flippedOps[name], args[1]) != nil
+		}
+	}
+	return o.unnestMark(u, e)
+}
+
+// unnestMark joins the only subquery of EXISTS or IN nested in the filter e
+// by MARK, and moves e with the mark in place of it to the join
+func (o *optimizer) unnestMark(u *unnester, e *Expr) bool {
+	var pred *Expr
+	subs := 0
+	walkExpr(e, func(e *Expr) {
+		switch ex := e.Expr.(type) {
+		case *plan.Expr_Sub:
+			subs++
+		case *plan.Expr_F:
+			args := ex.F.Args
+			if len(args) == 0 {
+				return
+			}
+			if _, ok := args[len(args)-1].Expr.(*plan.Expr_Sub); !ok {
+				return
+			}
+			switch strings.ToUpper(ex.F.Func.GetObjName()) {
+			case "EXISTS", "IN":
+				pred = e
+			}
+		}
+	})
+	if subs != 1 || pred == nil {
+		return false
+	}
+	f := pred.Expr.(*plan.Expr_F).F
+	sub := f.Args[len(f.Args)-1].Expr.(*plan.Expr_Sub).Sub
+	var j *Node
+	if len(f.Args) == 1 {
+		j = o.unnestJoin(u, sub, plan.Node_MARK, "", nil)
+	} else if len(f.Args) == 2 {
+		j = o.unnestJoin(u, sub, plan.Node_MARK, "=", f.Args[0])
+	}
+	if j == nil {
+		return false
+	}
+	wl := len(o.output(j.Children[0]))
+	wr := len(o.output(j.Children[1]))
+	pred.Typ = &plan.Type{Id: plan.Type_BOOL}
+	pred.Expr = &plan.Expr_Col{Col: &plan.ColRef{ColPos: int32(wl + wr)}}
+	j.WhereList = append(j.WhereList, e)
+	return true
+}
+
+// unnestJoin joins the subquery sub above u.top by flag, comparing left
+// with its first column by cmp if cmp is not empty. It returns nil if sub
+// cannot be unnested
+func (o *optimizer) unnestJoin(u *unnester, sub *plan.SubQuery, flag plan.Node_JoinFlag, cmp string, left *Expr) *Node {
+	base, ok := o.nodes[sub.NodeId]
+	if !ok || o.stepIndex(o.stepTop(sub.NodeId)) < 0 {
+		return nil
+	}
+	top := o.nodes[o.stepTop(sub.NodeId)]
+	if (cmp != "" || flag == plan.Node_SINGLE) && len(top.ProjectList) != 1 {
+		return nil
+	}
+	if left != nil && hasSubquery(left) {
+		return nil
+	}
+	corrs, ok := o.correlatedFilters(u, base, top)
+	if !ok {
+		return nil
+	}
+	if len(corrs) > 0 && (top.Limit != nil || top.Offset != nil || base.Limit != nil || base.Offset != nil) {
+		return nil
+	}
+	scalarAgg := flag == plan.Node_SINGLE && hasAggregate(top.ProjectList[0])
+	if len(corrs) > 0 && scalarAgg {
+		return o.unnestScalarAgg(u, base, top, corrs, cmp, left)
+	}
+	if len(corrs) > 0 && hasAggregate(top.ProjectList...) {
+		// The rows of the aggregates are not filtered by the correlated
+		// columns
+		return nil
+	}
+
+	wl := int32(len(u.top.ProjectList))
+	var conds []*Expr
+	cols := make(map[int32]int32)
+	for _, e := range corrs {
+		base.WhereList = removeExpr(base.WhereList, e)
+		e = cloneExpr(e)
+		walkExpr(e, func(e *Expr) {
+			switch ex := e.Expr.(type) {
+			case *plan.Expr_Col:
+				// The columns of the subquery are added to its output
+				pos, ok := cols[ex.Col.ColPos]
+				if !ok {
+					pos = int32(len(top.ProjectList))
+					cols[ex.Col.ColPos] = pos
+					top.ProjectList = append(top.ProjectList, &Expr{
+						Typ:   e.Typ,
+						Alias: e.Alias,
+						Expr:  &plan.Expr_Col{Col: &plan.ColRef{Name: ex.Col.Name, ColPos: ex.Col.ColPos}},
+					})
+				}
+				ex.Col.ColPos = wl + pos
+			case *plan.Expr_Corr:
+				e.Expr = corrCol(ex.Corr)
+			}
+		})
+		conds = append(conds, e)
+	}
+	if cmp != "" {
+		conds = append(conds, compareExpr(cmp, left, subCol(top, 0, wl)))
+	}
+	return o.newSubqueryJoin(u, top, flag, conds)
+}
+
+// unnestScalarAgg joins the correlated scalar subquery of aggregates of the
+// step top, whose filters on base compare its columns with the outer ones
+// by corrs, grouped by the columns compared
+func (o *optimizer) unnestScalarAgg(u *unnester, base, top *Node, corrs []*Expr, cmp string, left *Expr) *Node {
+	for _, name := range aggregateNames(top.ProjectList[0]) {
+		// The empty groups of the counts are zeros, which are not joined
+		if strings.HasPrefix(name, "COUNT") {
+			return nil
+		}
+	}
+	keys := make([]*Expr, len(corrs))
+	outers := make([]*Expr, len(corrs))
+	for i, e := range corrs {
+		f, ok := e.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+			return nil
+		}
+		l, r := f.F.Args[0], f.F.Args[1]
+		if hasCorrelated(l) {
+			l, r = r, l
+		}
+		if hasCorrelated(l) || !onlyCorrelated(r) {
+			return nil
+		}
+		keys[i], outers[i] = l, r
+	}
+	fill := o.fillOutput(top)
+	if fill == nil {
+		return nil
+	}
+	for _, e := range corrs {
+		base.WhereList = removeExpr(base.WhereList, e)
+	}
+	agg := &Node{
+		NodeType:    plan.Node_AGG,
+		NodeId:      int32(len(o.query.Nodes)),
+		Children:    []int32{top.NodeId},
+		GroupBy:     keys,
+		ProjectList: []*Expr{top.ProjectList[0]},
+	}
+	for _, key := range keys {
+		agg.ProjectList = append(agg.ProjectList, cloneExpr(key))
+	}
+	top.ProjectList = fill
+	o.query.Nodes = append(o.query.Nodes, agg)
+	o.nodes[agg.NodeId] = agg
+	o.stepTops[top.NodeId] = agg.NodeId
+	o.query.Steps[o.stepIndex(top.NodeId)] = agg.NodeId
+
+	wl := int32(len(u.top.ProjectList))
+	conds := make([]*Expr, 0, len(keys)+1)
+	for i, outer := range outers {
+		outer = cloneExpr(outer)
+		walkExpr(outer, func(e *Expr) {
+			if corr, ok := e.Expr.(*plan.Expr_Corr); ok {
+				e.Expr = corrCol(corr.Corr)
+			}
+		})
+		conds = append(conds, compareExpr("=", outer, subCol(agg, int32(i+1), wl)))
+	}
+	conds = append(conds, compareExpr(cmp, left, subCol(agg, 0, wl)))
+	return o.newSubqueryJoin(u, agg, plan.Node_SINGLE, conds)
+}
+
+// correlatedFilters returns the filters of base, the node of the filters
+// of the step top, referring to the columns of u.base. It fails if the
+// subquery refers to the outer nodes otherwise
+func (o *optimizer) correlatedFilters(u *unnester, base, top *Node) ([]*Expr, bool) {
+	refs := o.outerRefs(top.NodeId)
+	if len(refs) == 0 {
+		return nil, true
+	}
+	if !isScan(base) && base.NodeType != plan.Node_JOIN {
+		return nil, false
+	}
+	var corrs []*Expr
+	n := 0
+	for _, e := range base.WhereList {
+		if !hasCorrelated(e) {
+			continue
+		}
+		if hasSubquery(e) {
+			return nil, false
+		}
+		ok := true
+		walkExpr(e, func(e *Expr) {
+			if corr, isCorr := e.Expr.(*plan.Expr_Corr); isCorr {
+				ok = ok && corr.Corr.NodeId == u.base.NodeId && corr.Corr.RelPos == 0
+				n++
+			}
+			if col, isCol := e.Expr.(*plan.Expr_Col); isCol {
+				ok = ok && col.Col.RelPos == 0
+			}
+		})
+		if !ok {
+			return nil, false
+		}
+		corrs = append(corrs, e)
+	}
+	// The correlated columns out of the filters of base are not pulled up
+	return corrs, n == len(refs)
+}
+
+// outerRefs returns the correlated columns in the nodes of the subquery of
+// the step root, and of the subqueries in them, referring to the nodes out
+// of it
+func (o *optimizer) outerRefs(root int32) []*plan.CorrColRef {
+	inner := make(map[int32]bool)
+	var refs []*plan.CorrColRef
+	var visit func(id int32)
+	visit = func(id int32) {
+		node, ok := o.nodes[id]
+		if !ok || inner[id] {
+			return
+		}
+		inner[id] = true
+		check := func(e *Expr) {
+			walkExpr(e, func(e *Expr) {
+				switch ex := e.Expr.(type) {
+				case *plan.Expr_Sub:
+					visit(o.stepTop(ex.Sub.NodeId))
+				case *plan.Expr_Corr:
+					refs = append(refs, ex.Corr)
+				}
+			})
+		}
+		for _, list := range nodeExprLists(node) {
+			for _, e := range list {
+				check(e)
+			}
+		}
+		check(node.Limit)
+		check(node.Offset)
+		for _, child := range node.Children {
+			visit(child)
+		}
+	}
+	visit(root)
+	outer := refs[:0]
+	for _, ref := range refs {
+		if !inner[ref.NodeId] {
+			outer = append(outer, ref)
+		}
+	}
+	return outer
+}
+
+// newSubqueryJoin joins the subquery of the node right above u.top by flag
+// on conds, outputting the columns of u.top
+func (o *optimizer) newSubqueryJoin(u *unnester, right *Node, flag plan.Node_JoinFlag, conds []*Expr) *Node {
+	if idx := o.stepIndex(right.NodeId); idx >= 0 {
+		o.query.Steps = append(o.query.Steps[:idx], o.query.Steps[idx+1:]...)
+	}
+	right.JoinType = flag
+	join := &Node{
+		NodeType: plan.Node_JOIN,
+		NodeId:   int32(len(o.query.Nodes)),
+		Children: []int32{u.top.NodeId, right.NodeId},
+		OnList:   conds,
+	}
+	fillJoinProjectList(join, u.top, &Node{})
+	o.query.Nodes = append(o.query.Nodes, join)
+	o.nodes[join.NodeId] = join
+	u.joins[join.NodeId] = true
+	u.top = join
+	return join
+}
+
+// fillOutput returns the project list of the columns of the scan or the
+// join node, as built before the projections of a step replace it
+func (o *optimizer) fillOutput(node *Node) []*Expr {
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN, plan.Node_CTE_SCAN:
+		if node.TableDef == nil {
+			return nil
+		}
+		alias := scanAlias(node)
+		list := make([]*Expr, len(node.TableDef.Cols))
+		for i, col := range node.TableDef.Cols {
+			list[i] = &Expr{
+				Typ:   col.Typ,
+				Alias: alias + "." + col.Name,
+				Expr:  &plan.Expr_Col{Col: &plan.ColRef{Name: col.Name, ColPos: int32(i)}},
+			}
+		}
+		return list
+	case plan.Node_JOIN:
+		if len(node.Children) != 2 {
+			return nil
+		}
+		left, lok := o.nodes[o.base(node.Children[0])]
+		right, rok := o.nodes[o.base(node.Children[1])]
+		if !lok || !rok {
+			return nil
+		}
+		tmp := &Node{}
+		if o.nodes[node.Children[1]].JoinType&subqueryJoinFlags != 0 {
+			// Only the columns of the left side are output
+			right = &Node{}
+		}
+		fillJoinProjectList(tmp, left, right)
+		return tmp.ProjectList
+	}
+	return nil
+}
+
+// subqueryJoinFlags are the flags of the subqueries joined, whose columns
+// are not output by the joins
+const subqueryJoinFlags = plan.Node_SEMI | plan.Node_ANTI | plan.Node_SINGLE | plan.Node_MARK
+
+// scanAlias returns the alias of the table of the scan node by the names of
+// the columns of its filters, which are qualified by it
+func scanAlias(node *Node) string {
+	alias := node.TableDef.Name
+	for _, list := range nodeExprLists(node) {
+		for _, e := range list {
+			walkExpr(e, func(e *Expr) {
+				col, ok := e.Expr.(*plan.Expr_Col)
+				if !ok || col.Col.ColPos < 0 || int(col.Col.ColPos) >= len(node.TableDef.Cols) {
+					return
+				}
+				suffix := "." + node.TableDef.Cols[col.Col.ColPos].Name
+				if strings.HasSuffix(col.Col.Name, suffix) {
+					alias = strings.TrimSuffix(col.Col.Name, suffix)
+				}
+			})
+		}
+	}
+	return alias
+}
+
+// isScan returns true if node scans a table or a materialized common table
+// expression
+func isScan(node *Node) bool {
+	return node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_CTE_SCAN
+}
+
+// stepTop returns the node which the step root is rewritten to
+func (o *optimizer) stepTop(root int32) int32 {
+	for {
+		top, ok := o.stepTops[root]
+		if !ok {
+			return root
+		}
+		root = top
+	}
+}
+
+func (o *optimizer) stepIndex(id int32) int {
+	for i, step := range o.query.Steps {
+		if step == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// subCol returns the column pos of the subquery node in the input of its
+// join, whose left side has wl columns
+func subCol(node *Node, pos int32, wl int32) *Expr {
+	e := node.ProjectList[pos]
+	return &Expr{
+		Typ:   e.Typ,
+		Alias: e.Alias,
+		Expr:  &plan.Expr_Col{Col: &plan.ColRef{Name: e.Alias, ColPos: wl + pos}},
+	}
+}
+
+func corrCol(corr *plan.CorrColRef) *plan.Expr_Col {
+	return &plan.Expr_Col{Col: &plan.ColRef{Name: corr.Name, ColPos: corr.ColPos}}
+}
+
+func compareExpr(op string, l, r *Expr) *Expr {
+	return &Expr{
+		Typ: &plan.Type{Id: plan.Type_BOOL},
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: getFunctionObjRef(op),
+			Args: []*Expr{l, r},
+		}},
+	}
+}
+
+func removeExpr(list []*Expr, e *Expr) []*Expr {
+	for i, item := range list {
+		if item == e {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+func hasSubquery(e *Expr) bool {
+	found := false
+	walkExpr(e, func(e *Expr) {
+		_, ok := e.Expr.(*plan.Expr_Sub)
+		found = found || ok
+	})
+	return found
+}
+
+func hasCorrelated(e *Expr) bool {
+	found := false
+	walkExpr(e, func(e *Expr) {
+		_, ok := e.Expr.(*plan.Expr_Corr)
+		found = found || ok
+	})
+	return found
+}
+
+// onlyCorrelated returns true if the columns of e are all correlated
+func onlyCorrelated(e *Expr) bool {
+	ok := true
+	walkExpr(e, func(e *Expr) {
+		switch e.Expr.(type) {
+		case *plan.Expr_Col, *plan.Expr_Sub:
+			ok = false
+		}
+	})
+	return ok && hasCorrelated(e)
+}
+
+func hasAggregate(exprs ...*Expr) bool {
+	for _, e := range exprs {
+		if len(aggregateNames(e)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateNames returns the names of the aggregate functions in e
+func aggregateNames(e *Expr) []string {
+	var names []string
+	walkExpr(e, func(e *Expr) {
+		f, ok := e.Expr.(*plan.Expr_F)
+		if !ok {
+			return
+		}
+		name := strings.ToUpper(f.F.Func.GetObjName())
+		if sig, ok := BuiltinFunctionsMap[name]; ok && sig.Flag == plan.Function_AGG {
+			names = append(names, name)
+		}
+	})
+	return names
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// subqueryJoins returns the flags of the joins unnested from the subqueries
+func subqueryJoins(query *Query) []plan.Node_JoinFlag {
+	var flags []plan.Node_JoinFlag
+	for _, node := range query.Nodes {
+		if node.NodeType != plan.Node_JOIN || len(node.Children) != 2 {
+			continue
+		}
+		if flag := query.Nodes[node.Children[1]].JoinType; flag&subqueryJoinFlags != 0 {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
+func hasSubqueryExpr(query *Query) bool {
+	found := false
+	for _, node := range query.Nodes {
+		for _, list := range [][]*Expr{node.ProjectList, node.WhereList, node.OnList, node.GroupBy, node.GroupingSet} {
+			for _, e := range list {
+				found = found || hasSubquery(e)
+			}
+		}
+	}
+	return found
+}
+
+func TestUnnestSubqueries(t *testing.T) {
+	cases := []struct {
+		sql  string
+		flag plan.Node_JoinFlag
+	}{
+		{"SELECT N_NAME FROM NATION WHERE EXISTS (SELECT * FROM REGION WHERE R_REGIONKEY = N_REGIONKEY AND R_NAME = 'ASIA')", plan.Node_SEMI},
+		{"SELECT N_NAME FROM NATION WHERE NOT EXISTS (SELECT * FROM REGION WHERE R_REGIONKEY = N_REGIONKEY)", plan.Node_ANTI},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION WHERE R_NAME = 'ASIA')", plan.Node_SEMI},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY = (SELECT R_REGIONKEY FROM REGION WHERE R_NAME = 'ASIA')", plan.Node_SINGLE},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY NOT IN (SELECT R_REGIONKEY FROM REGION)", plan.Node_MARK},
+	}
+	for _, c := range cases {
+		query := buildWithStats(t, c.sql, false)
+		if hasSubqueryExpr(query) {
+			t.Fatalf("the subquery of %q should be unnested, %v", c.sql, query)
+		}
+		if len(query.Steps) != 1 {
+			t.Fatalf("the step of the subquery of %q should be removed, %v", c.sql, query.Steps)
+		}
+		if flags := subqueryJoins(query); len(flags) != 1 || flags[0] != c.flag {
+			t.Fatalf("%q should be unnested into a %v join but now is %v", c.sql, c.flag, flags)
+		}
+		for i, node := range query.Nodes {
+			if node.NodeId != int32(i) {
+				t.Fatalf("node %d of %q has the id %d", i, c.sql, node.NodeId)
+			}
+		}
+	}
+
+	// The correlated condition is moved into the ON list of the join
+	query := buildWithStats(t, "SELECT N_NAME FROM NATION WHERE EXISTS (SELECT * FROM REGION WHERE R_REGIONKEY = N_REGIONKEY)", false)
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_JOIN && len(node.OnList) != 1 {
+			t.Fatalf("the correlated condition should be the join condition, %v", node.OnList)
+		}
+		for _, e := range node.WhereList {
+			if hasCorrelated(e) {
+				t.Fatalf("the correlated condition should not be kept, %v", node.WhereList)
+			}
+		}
+	}
+}
+
+func TestUnnestScalarAggregate(t *testing.T) {
+	sql := "SELECT N_NAME FROM NATION WHERE N_NATIONKEY = (SELECT MIN(S_SUPPKEY) FROM SUPPLIER WHERE S_NATIONKEY = N_NATIONKEY)"
+	query := buildWithStats(t, sql, false)
+	if hasSubqueryExpr(query) {
+		t.Fatalf("the subquery should be unnested, %v", query)
+	}
+	if flags := subqueryJoins(query); len(flags) != 1 || flags[0] != plan.Node_SINGLE {
+		t.Fatalf("the subquery should be unnested into a SINGLE join but now is %v", flags)
+	}
+	// The aggregate is grouped by the correlated column
+	grouped := false
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_AGG && len(node.GroupBy) == 1 {
+			grouped = true
+		}
+	}
+	if !grouped {
+		t.Fatalf("the aggregate should be grouped by s_nationkey, %v", query)
+	}
+
+	// COUNT returns 0 on no rows, which a join cannot tell from NULL
+	sql = "SELECT N_NAME FROM NATION WHERE N_NATIONKEY = (SELECT COUNT(*) FROM SUPPLIER WHERE S_NATIONKEY = N_NATIONKEY)"
+	query = buildWithStats(t, sql, false)
+	if !hasSubqueryExpr(query) || len(subqueryJoins(query)) != 0 {
+		t.Fatalf("the subquery with COUNT should be kept, %v", query)
+	}
+}
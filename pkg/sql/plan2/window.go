@@ -0,0 +1,374 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
+)
+
+// Where the window functions are built
+type windowScope int
+
+const (
+	windowNotAllowed windowScope = iota
+	// The window functions are added by the projection
+	windowInProjection
+	// The window functions of the projection are referred to by order by
+	windowInOrderBy
+)
+
+// windowFunc is a window function of the projection, which is computed by
+// the WINDOW node of its window
+type windowFunc struct {
+	// The text of the call, which names its result
+	name string
+	fn   *Expr
+	// The window without the functions
+	spec *plan.WindowSpec
+}
+
+// buildWindowFunc returns the reference to the result of the window
+// function expr, which is computed once for the same calls
+func buildWindowFunc(expr *tree.FuncExpr, funcName string, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*Expr, error) {
+	name := tree.String(expr, dialect.MYSQL)
+	if selectCtx == nil || selectCtx.windowScope == windowNotAllowed {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("window function '%v' is not allowed here", name))
+	}
+	for _, wf := range selectCtx.windowFuncs {
+		if wf.name == name {
+			return windowFuncRef(wf), nil
+		}
+	}
+	if selectCtx.windowScope != windowInProjection {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("window function '%v' should be in the projection", name))
+	}
+	sig, ok := BuiltinFunctionsMap[funcName]
+	if ok && sig.Flag != plan.Function_WIN && sig.Flag != plan.Function_AGG {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("'%v' is not a window function", funcName))
+	}
+
+	// The window functions are not nested
+	selectCtx.windowScope = windowNotAllowed
+	defer func() {
+		selectCtx.windowScope = windowInProjection
+	}()
+	fn, err := buildFunctionCall(expr, funcName, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	spec := &plan.WindowSpec{}
+	keys := make(map[string]bool)
+	for _, e := range expr.WindowSpec.PartitionBy {
+		key, err := buildExpr(e, ctx, query, selectCtx)
+		if err != nil {
+			return nil, err
+		}
+		if k := exprKey(key); !keys[k] {
+			keys[k] = true
+			spec.PartitionBy = append(spec.PartitionBy, key)
+		}
+	}
+	for _, order := range expr.WindowSpec.OrderBy {
+		orderBy, err := buildOrderBy(order, ctx, query, selectCtx)
+		if err != nil {
+			return nil, err
+		}
+		spec.OderyBy = append(spec.OderyBy, orderBy)
+	}
+	// The frames of the ranking functions are ignored
+	if !ok || sig.Flag != plan.Function_WIN {
+		spec.Frame, err = buildFrame(expr.WindowSpec.Frame, spec.OderyBy, ctx, query, selectCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fn.Alias = name
+	wf := &windowFunc{
+		name: name,
+		fn:   fn,
+		spec: spec,
+	}
+	selectCtx.windowFuncs = append(selectCtx.windowFuncs, wf)
+	return windowFuncRef(wf), nil
+}
+
+// windowFuncRef returns the column of the result of wf, which is named by
+// the call and is not a column of the input of the WINDOW node
+func windowFuncRef(wf *windowFunc) *Expr {
+	return &Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				Name:   wf.name,
+				RelPos: -1,
+				ColPos: -1,
+			},
+		},
+		Typ:   wf.fn.Typ,
+		Alias: wf.name,
+	}
+}
+
+// buildFrame builds the frame of a window of the order orderBy. Without
+// one, the frame is the rows up to the peers of the current one if the
+// window is ordered, or the whole partition
+func buildFrame(frame *tree.FrameClause, orderBy []*plan.OrderBySpec, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.FrameClause, error) {
+	if frame == nil {
+		if len(orderBy) == 0 {
+			return &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+				End:   &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_FOLLOWING},
+			}, nil
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}, nil
+	}
+	clause := &plan.FrameClause{Type: plan.FrameClause_ROWS}
+	if frame.Type == tree.FrameRange {
+		clause.Type = plan.FrameClause_RANGE
+	}
+	var err error
+	if clause.Start, err = buildFrameBound(frame.Start, clause.Type, ctx, query, selectCtx); err != nil {
+		return nil, err
+	}
+	if clause.End, err = buildFrameBound(frame.End, clause.Type, ctx, query, selectCtx); err != nil {
+		return nil, err
+	}
+	// The bound types are in the order of their positions
+	if clause.Start.Type == plan.FrameBound_UNBOUNDED_FOLLOWING || clause.End.Type == plan.FrameBound_UNBOUNDED_PRECEDING ||
+		clause.Start.Type > clause.End.Type {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("frame '%v' starts after its end", tree.String(frame, dialect.MYSQL)))
+	}
+	if clause.Type == plan.FrameClause_RANGE && (clause.Start.Offset != nil || clause.End.Offset != nil) && len(orderBy) != 1 {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("frame '%v' of an offset should have one order by key", tree.String(frame, dialect.MYSQL)))
+	}
+	return clause, nil
+}
+
+// buildFrameBound builds a bound of a frame, whose offset is a non-negative
+// integer, or a number of a RANGE frame
+func buildFrameBound(bound *tree.FrameBound, typ plan.FrameClause_FrameType, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.FrameBound, error) {
+	// The bound types of the tree are in the same order
+	fb := &plan.FrameBound{Type: plan.FrameBound_BoundType(bound.Type)}
+	if bound.Expr == nil {
+		return fb, nil
+	}
+	offset, err := buildExpr(bound.Expr, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	valid := false
+	if c, ok := offset.Expr.(*plan.Expr_C); ok && !c.C.Isnull {
+		switch v := c.C.Value.(type) {
+		case *plan.Const_Ival:
+			valid = v.Ival >= 0
+		case *plan.Const_Dval:
+			valid = typ == plan.FrameClause_RANGE && v.Dval >= 0
+		}
+	}
+	if !valid {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("frame offset '%v' should be a non-negative number", tree.String(bound.Expr, dialect.MYSQL)))
+	}
+	fb.Offset = offset
+	return fb, nil
+}
+
+// window is a WINDOW node of the functions of the same window
+type window struct {
+	key  string
+	spec *plan.WindowSpec
+}
+
+// planWindows adds the WINDOW nodes computing the window functions of the
+// projection. The functions of the same window are computed by one node.
+// A WINDOW node requires the rows of a partition next to each other and
+// sorted by the order keys, a SORT node is added below it unless the rows
+// are sorted so already. The windows of more keys are computed first, for
+// the ones of fewer keys to reuse their sorts
+func planWindows(query *Query, selectCtx *SelectContext) {
+	var windows []*window
+	for _, wf := range selectCtx.windowFuncs {
+		key := windowKey(wf.spec)
+		var w *window
+		for _, cur := range windows {
+			if cur.key == key {
+				w = cur
+			}
+		}
+		if w == nil {
+			w = &window{
+				key:  key,
+				spec: proto.Clone(wf.spec).(*plan.WindowSpec),
+			}
+			windows = append(windows, w)
+		}
+		w.spec.WindowFuncs = append(w.spec.WindowFuncs, wf.fn)
+	}
+	sort.SliceStable(windows, func(i, j int) bool {
+		return len(windows[i].spec.PartitionBy)+len(windows[i].spec.OderyBy) > len(windows[j].spec.PartitionBy)+len(windows[j].spec.OderyBy)
+	})
+
+	sorted := deliveredOrder(query, int32(len(query.Nodes)-1))
+	for len(windows) > 0 {
+		next := -1
+		for i, w := range windows {
+			if windowSorted(sorted, w.spec) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			next = 0
+			sortNode := &plan.Node{
+				NodeType: plan.Node_SORT,
+				OrderBy:  windowOrder(windows[0].spec),
+			}
+			appendQueryNode(query, sortNode, false)
+			sorted = sortNode.OrderBy
+		}
+		appendQueryNode(query, &plan.Node{
+			NodeType: plan.Node_WINDOW,
+			WinSpec:  windows[next].spec,
+		}, false)
+		windows = append(windows[:next], windows[next+1:]...)
+	}
+}
+
+// windowKey is the key of the window of spec, the partition keys are in any
+// order
+func windowKey(spec *plan.WindowSpec) string {
+	keys := make([]string, 0, len(spec.PartitionBy))
+	for _, e := range spec.PartitionBy {
+		keys = append(keys, exprKey(e))
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf("%q,", k))
+	}
+	b.WriteString("|")
+	for _, orderBy := range spec.OderyBy {
+		b.WriteString(fmt.Sprintf("%q %v,", exprKey(orderBy.OrderBy), orderDirection(orderBy)))
+	}
+	b.WriteString("|")
+	if spec.Frame != nil {
+		data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(spec.Frame)
+		b.Write(data)
+	}
+	return b.String()
+}
+
+// windowOrder returns the order the rows are sorted by for the window of
+// spec, the partition keys are followed by the order keys
+func windowOrder(spec *plan.WindowSpec) []*plan.OrderBySpec {
+	order := make([]*plan.OrderBySpec, 0, len(spec.PartitionBy)+len(spec.OderyBy))
+	for _, e := range spec.PartitionBy {
+		order = append(order, &plan.OrderBySpec{
+			OrderBy:      cloneExpr(e),
+			OrderByFlags: plan.OrderBySpec_INTERNAL,
+		})
+	}
+	for _, orderBy := range spec.OderyBy {
+		order = append(order, &plan.OrderBySpec{
+			OrderBy:      cloneExpr(orderBy.OrderBy),
+			OrderByFlags: orderBy.OrderByFlags,
+		})
+	}
+	return order
+}
+
+// windowSorted returns true if the rows sorted by sorted are sorted for the
+// window of spec, the partition keys of which are the first ones in any
+// order and direction
+func windowSorted(sorted []*plan.OrderBySpec, spec *plan.WindowSpec) bool {
+	n := len(spec.PartitionBy)
+	if len(sorted) < n+len(spec.OderyBy) {
+		return false
+	}
+	keys := make(map[string]bool)
+	for _, e := range spec.PartitionBy {
+		keys[exprKey(e)] = true
+	}
+	for _, orderBy := range sorted[:n] {
+		k := exprKey(orderBy.OrderBy)
+		if !keys[k] {
+			return false
+		}
+		delete(keys, k)
+	}
+	return orderSorted(sorted[n:], spec.OderyBy)
+}
+
+// orderSorted returns true if the rows sorted by sorted are sorted by order
+func orderSorted(sorted []*plan.OrderBySpec, order []*plan.OrderBySpec) bool {
+	if len(sorted) < len(order) {
+		return false
+	}
+	for i, orderBy := range order {
+		if exprKey(sorted[i].OrderBy) != exprKey(orderBy.OrderBy) || orderDirection(sorted[i]) != orderDirection(orderBy) {
+			return false
+		}
+	}
+	return true
+}
+
+// deliveredOrder returns the order the rows of node id are sorted by, the
+// WINDOW nodes keep the order of their input
+func deliveredOrder(query *Query, id int32) []*plan.OrderBySpec {
+	for id >= 0 && int(id) < len(query.Nodes) {
+		node := query.Nodes[id]
+		switch {
+		case node.NodeType == plan.Node_SORT:
+			return node.OrderBy
+		case node.NodeType == plan.Node_WINDOW && len(node.Children) == 1:
+			id = node.Children[0]
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// orderDirection returns the flags of orderBy, the default direction is
+// ascending
+func orderDirection(orderBy *plan.OrderBySpec) plan.OrderBySpec_OrderByFlag {
+	if orderBy.OrderByFlags == plan.OrderBySpec_INTERNAL {
+		return plan.OrderBySpec_ASC
+	}
+	return orderBy.OrderByFlags
+}
+
+// exprKey returns the key of e, which is the same for the equal exprs of
+// different aliases
+func exprKey(e *Expr) string {
+	e = cloneExpr(e)
+	walkExpr(e, func(e *Expr) {
+		e.Alias = ""
+	})
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(e)
+	return string(data)
+}
@@ -0,0 +1,95 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func TestWindow(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// map[sql string]the number of WINDOW and SORT nodes
+	checkList := map[string][2]int{
+		"SELECT N_NAME, row_number() over (partition by N_REGIONKEY order by N_NAME) FROM NATION":                                               {1, 1},
+		"SELECT N_NAME, rank() over (order by N_NAME), sum(N_NATIONKEY) over (order by N_NAME) FROM NATION":                                     {2, 1},
+		"SELECT N_NAME, count(*) over () FROM NATION":                                                                                           {1, 0},
+		"SELECT N_NAME, rank() over (partition by N_REGIONKEY order by N_NAME), count(*) over (partition by N_REGIONKEY) FROM NATION":           {2, 1},
+		"SELECT N_NAME, rank() over (partition by N_REGIONKEY, N_NAME), dense_rank() over (partition by N_NAME, N_REGIONKEY) FROM NATION":       {1, 1},
+		"SELECT N_NAME, rank() over (partition by N_REGIONKEY order by N_NAME), rank() over (order by N_NATIONKEY) FROM NATION":                 {2, 2},
+		"SELECT N_NAME, sum(N_NATIONKEY) over (order by N_NATIONKEY rows between 1 preceding and 1 following) FROM NATION ORDER BY N_NATIONKEY": {1, 1},
+		"SELECT N_NAME, rank() over (order by N_NATIONKEY) r FROM NATION ORDER BY r DESC":                                                       {1, 2},
+		"SELECT N_REGIONKEY, sum(count(*)) over (order by N_REGIONKEY) FROM NATION GROUP BY N_REGIONKEY":                                        {1, 1},
+	}
+	for sql, counts := range checkList {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		query := logicPlan.GetQuery()
+		windows, sorts := nodesOf(query, plan.Node_WINDOW), nodesOf(query, plan.Node_SORT)
+		if len(windows) != counts[0] || len(sorts) != counts[1] {
+			t.Fatalf("%v: %v windows and %v sorts, expected %v", sql, len(windows), len(sorts), counts)
+		}
+		for _, node := range windows {
+			if len(node.WinSpec.WindowFuncs) == 0 {
+				t.Fatalf("%v: the window %v computes no function", sql, node.NodeId)
+			}
+		}
+	}
+
+	// The functions of the same window are computed together
+	logicPlan, err := runOneStmt(mock, t, "SELECT rank() over (order by N_NAME), dense_rank() over (order by N_NAME), rank() over (order by N_NAME) FROM NATION")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	windows := nodesOf(logicPlan.GetQuery(), plan.Node_WINDOW)
+	if len(windows) != 1 || len(windows[0].WinSpec.WindowFuncs) != 2 {
+		t.Fatalf("the functions of the same window should be computed once by one node, %v", windows)
+	}
+
+	// The default frames
+	logicPlan, err = runOneStmt(mock, t, "SELECT sum(N_NATIONKEY) over (order by N_NAME), sum(N_NATIONKEY) over () FROM NATION")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, node := range nodesOf(logicPlan.GetQuery(), plan.Node_WINDOW) {
+		frame := node.WinSpec.Frame
+		expected := plan.FrameClause_ROWS
+		if len(node.WinSpec.OderyBy) > 0 {
+			expected = plan.FrameClause_RANGE
+		}
+		if frame == nil || frame.Type != expected || frame.Start.Type != plan.FrameBound_UNBOUNDED_PRECEDING {
+			t.Fatalf("the window %v has the frame %v", node.NodeId, frame)
+		}
+	}
+
+	// should error
+	sqls := []string{
+		"SELECT N_NAME FROM NATION WHERE rank() over (order by N_NAME) > 1",                                                 // not in the projection
+		"SELECT N_NAME FROM NATION GROUP BY rank() over (order by N_NAME)",                                                  // not in the projection
+		"SELECT N_NAME FROM NATION ORDER BY rank() over (order by N_NAME)",                                                  // not in the projection
+		"SELECT sum(rank() over (order by N_NAME)) over () FROM NATION",                                                     // nested
+		"SELECT rank() FROM NATION",                                                                                         // without a window
+		"SELECT abs(N_NATIONKEY) over () FROM NATION",                                                                       // not a window function
+		"SELECT sum(N_NATIONKEY) over (order by N_NAME rows between current row and 1 preceding) FROM NATION",               // starts after its end
+		"SELECT sum(N_NATIONKEY) over (order by N_NAME rows between unbounded following and current row) FROM NATION",       // starts after its end
+		"SELECT sum(N_NATIONKEY) over (order by N_NAME, N_NATIONKEY range between 1 preceding and current row) FROM NATION", // range of two keys
+		"SELECT sum(N_NATIONKEY) over (rows between 1.5 preceding and current row) FROM NATION",                             // not an integer
+	}
+	runTestShouldError(mock, t, sqls)
+}
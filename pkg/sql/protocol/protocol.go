@@ -641,6 +641,17 @@ func EncodeExtend(e extend.Extend, buf *bytes.Buffer) error {
 			}
 		}
 		return nil
+	case *extend.InExtend:
+		buf.WriteByte(In)
+		var not uint8
+		if v.Not {
+			not = 1
+		}
+		buf.Write(encoding.EncodeUint8(not))
+		if err := EncodeExtend(v.E, buf); err != nil {
+			return err
+		}
+		return EncodeVector(v.Vs, buf)
 	case *extend.ParenExtend:
 		buf.WriteByte(Paren)
 		return EncodeExtend(v.E, buf)
@@ -751,6 +762,22 @@ func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 			data = data[4:]
 		}
 		return e, data, nil
+	case In:
+		e := new(extend.InExtend)
+		data = data[1:]
+		e.Not = encoding.DecodeUint8(data[:1]) == 1
+		data = data[1:]
+		ext, data, err := DecodeExtend(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.E = ext
+		vs, data, err := DecodeVector(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.Vs = vs
+		return e, data, nil
 	case Star:
 		e := new(extend.StarExtend)
 		return e, data, nil
@@ -375,6 +375,11 @@ func TestExtend(t *testing.T) {
 				&extend.ValueExtend{V: NewFloatVector(1.2)},
 			},
 		},
+		&extend.InExtend{
+			Not: true,
+			E:   &extend.Attribute{Name: "in", Type: types.T_int32},
+			Vs:  NewInt32Vector(123123),
+		},
 		&extend.ParenExtend{
 			E: &extend.FuncExtend{Name: "Paren Extend"},
 		},
@@ -432,6 +437,20 @@ func TestExtend(t *testing.T) {
 				t.Error("Decode extend Name failed.")
 				return
 			}
+		case *extend.InExtend:
+			actualE := e.(*extend.InExtend)
+			if expectE.Not != actualE.Not {
+				t.Error("Decode extend Not failed.")
+				return
+			}
+			if expectE.E.(*extend.Attribute).Name != actualE.E.(*extend.Attribute).Name {
+				t.Error("Decode extend E failed.")
+				return
+			}
+			if expectE.Vs.String() != actualE.Vs.String() {
+				t.Error("Decode extend Vs failed.")
+				return
+			}
 		case *extend.ParenExtend:
 			actualE := e.(*extend.ParenExtend)
 			// E
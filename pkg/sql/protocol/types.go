@@ -31,6 +31,7 @@ const (
 	Func
 	Star
 	Value
+	In
 )
 
 const (
@@ -0,0 +1,218 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// Settings is the typed variables of a session, which reach the planner by
+// the CompilerContext and the executor by the process of a query
+type Settings struct {
+	// TimeZone of the session, the one of the system by default
+	TimeZone *time.Location
+	SqlMode  SqlMode
+	// DOP is the degree of parallelism of the scans, it is chosen by the
+	// rows estimated for each scan if not positive
+	DOP int
+	// MemoryLimit is the bytes which a query may use at most, the quota of
+	// the session is not lowered if not positive
+	MemoryLimit int64
+	// BatchSize is the max rows of the batches made by the operators
+	// merging their inputs, the default ones of the operators if not
+	// positive
+	BatchSize int
+	// Address is the ip:port of the local node, which runs the fragments
+	// of the queries sent to it
+	Address string
+}
+
+// defaults is the settings of the node, which the sessions start with
+var defaults = struct {
+	sync.RWMutex
+	s Settings
+}{
+	s: Settings{
+		TimeZone: time.Local,
+		SqlMode:  DefaultSqlMode,
+	},
+}
+
+// Default returns a copy of the default settings of the node
+func Default() *Settings {
+	defaults.RLock()
+	defer defaults.RUnlock()
+	s := defaults.s
+	return &s
+}
+
+// InitAddress sets the address of the local node in the default settings,
+// it is called once the node starts
+func InitAddress(addr string) {
+	defaults.Lock()
+	defer defaults.Unlock()
+	defaults.s.Address = addr
+}
+
+// Clone returns a copy of s, which is not changed by the variables set later
+// in the session
+func (s *Settings) Clone() *Settings {
+	c := *s
+	return &c
+}
+
+// Strict returns true if the bad values written abort the statement instead
+// of being adjusted with warnings
+func (s *Settings) Strict() bool {
+	return s.SqlMode.Has(ModeStrictTransTables) || s.SqlMode.Has(ModeStrictAllTables)
+}
+
+// ParseTimeZone parses the time zone of the variable time_zone, which is
+// SYSTEM, an offset such as '+08:00' or a name such as 'Asia/Shanghai'
+func ParseTimeZone(name string) (*time.Location, error) {
+	if strings.EqualFold(name, "SYSTEM") {
+		return time.Local, nil
+	}
+	if len(name) > 0 && (name[0] == '+' || name[0] == '-') {
+		var h, m int
+		if n, err := fmt.Sscanf(name[1:], "%d:%d", &h, &m); err != nil || n != 2 || h > 14 || m > 59 {
+			return nil, errors.New(errno.InvalidOptionValue, fmt.Sprintf("unknown or incorrect time zone: '%s'", name))
+		}
+		offset := h*3600 + m*60
+		if name[0] == '-' {
+			offset = -offset
+		}
+		return time.FixedZone(name, offset), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.New(errno.InvalidOptionValue, fmt.Sprintf("unknown or incorrect time zone: '%s'", name))
+	}
+	return loc, nil
+}
+
+// TimeZoneName returns the value of the variable time_zone of loc
+func TimeZoneName(loc *time.Location) string {
+	if loc == nil || loc == time.Local {
+		return "SYSTEM"
+	}
+	return loc.String()
+}
+
+// SqlMode is the set of the modes of the variable sql_mode
+type SqlMode uint32
+
+const (
+	ModeRealAsFloat SqlMode = 1 << iota
+	ModePipesAsConcat
+	ModeAnsiQuotes
+	ModeIgnoreSpace
+	ModeOnlyFullGroupBy
+	ModeNoUnsignedSubtraction
+	ModeNoDirInCreate
+	ModeNoAutoValueOnZero
+	ModeNoBackslashEscapes
+	ModeStrictTransTables
+	ModeStrictAllTables
+	ModeNoZeroInDate
+	ModeNoZeroDate
+	ModeAllowInvalidDates
+	ModeErrorForDivisionByZero
+	ModeHighNotPrecedence
+	ModeNoEngineSubstitution
+	ModePadCharToFullLength
+	ModeTimeTruncateFractional
+)
+
+// DefaultSqlMode is the sql_mode of the sessions by default, as in MySQL 8.0
+const DefaultSqlMode = ModeOnlyFullGroupBy | ModeStrictTransTables | ModeNoZeroInDate |
+	ModeNoZeroDate | ModeErrorForDivisionByZero | ModeNoEngineSubstitution
+
+// sqlModeNames is ordered by the bits of the modes
+var sqlModeNames = []string{
+	"REAL_AS_FLOAT",
+	"PIPES_AS_CONCAT",
+	"ANSI_QUOTES",
+	"IGNORE_SPACE",
+	"ONLY_FULL_GROUP_BY",
+	"NO_UNSIGNED_SUBTRACTION",
+	"NO_DIR_IN_CREATE",
+	"NO_AUTO_VALUE_ON_ZERO",
+	"NO_BACKSLASH_ESCAPES",
+	"STRICT_TRANS_TABLES",
+	"STRICT_ALL_TABLES",
+	"NO_ZERO_IN_DATE",
+	"NO_ZERO_DATE",
+	"ALLOW_INVALID_DATES",
+	"ERROR_FOR_DIVISION_BY_ZERO",
+	"HIGH_NOT_PRECEDENCE",
+	"NO_ENGINE_SUBSTITUTION",
+	"PAD_CHAR_TO_FULL_LENGTH",
+	"TIME_TRUNCATE_FRACTIONAL",
+}
+
+// sqlModeCombinations are the modes standing for the sets of modes
+var sqlModeCombinations = map[string]SqlMode{
+	"ANSI": ModeRealAsFloat | ModePipesAsConcat | ModeAnsiQuotes | ModeIgnoreSpace | ModeOnlyFullGroupBy,
+	"TRADITIONAL": ModeStrictTransTables | ModeStrictAllTables | ModeNoZeroInDate | ModeNoZeroDate |
+		ModeErrorForDivisionByZero | ModeNoEngineSubstitution,
+}
+
+// ParseSqlMode parses the comma separated modes of the variable sql_mode
+func ParseSqlMode(s string) (SqlMode, error) {
+	var mode SqlMode
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if m, ok := sqlModeCombinations[name]; ok {
+			mode |= m
+			continue
+		}
+		i := 0
+		for ; i < len(sqlModeNames); i++ {
+			if sqlModeNames[i] == name {
+				break
+			}
+		}
+		if i == len(sqlModeNames) {
+			return 0, errors.New(errno.InvalidOptionValue, fmt.Sprintf("variable 'sql_mode' can't be set to the value of '%s'", name))
+		}
+		mode |= 1 << i
+	}
+	return mode, nil
+}
+
+// Has returns true if m is set in mode
+func (mode SqlMode) Has(m SqlMode) bool {
+	return mode&m == m
+}
+
+func (mode SqlMode) String() string {
+	var names []string
+	for i, name := range sqlModeNames {
+		if mode.Has(1 << i) {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
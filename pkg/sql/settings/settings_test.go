@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlMode(t *testing.T) {
+	mode, err := ParseSqlMode(DefaultSqlMode.String())
+	require.NoError(t, err)
+	require.Equal(t, DefaultSqlMode, mode)
+
+	mode, err = ParseSqlMode(" ansi, strict_all_tables ")
+	require.NoError(t, err)
+	require.True(t, mode.Has(ModeAnsiQuotes|ModePipesAsConcat))
+	require.Equal(t, "REAL_AS_FLOAT,PIPES_AS_CONCAT,ANSI_QUOTES,IGNORE_SPACE,ONLY_FULL_GROUP_BY,STRICT_ALL_TABLES", mode.String())
+	require.True(t, (&Settings{SqlMode: mode}).Strict())
+
+	mode, err = ParseSqlMode("")
+	require.NoError(t, err)
+	require.False(t, (&Settings{SqlMode: mode}).Strict())
+
+	_, err = ParseSqlMode("STRICT_TRANS_TABLES,NO_SUCH_MODE")
+	require.Error(t, err)
+}
+
+func TestTimeZone(t *testing.T) {
+	loc, err := ParseTimeZone("system")
+	require.NoError(t, err)
+	require.Equal(t, "SYSTEM", TimeZoneName(loc))
+
+	loc, err = ParseTimeZone("-05:30")
+	require.NoError(t, err)
+	_, offset := time.Unix(0, 0).In(loc).Zone()
+	require.Equal(t, -(5*3600 + 30*60), offset)
+	require.Equal(t, "-05:30", TimeZoneName(loc))
+
+	loc, err = ParseTimeZone("UTC")
+	require.NoError(t, err)
+	require.Equal(t, "UTC", TimeZoneName(loc))
+
+	for _, name := range []string{"+15:00", "+08", "No/Such_Zone"} {
+		_, err = ParseTimeZone(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	InitAddress("127.0.0.1:20000")
+	defer InitAddress("")
+	s := Default()
+	require.Equal(t, "127.0.0.1:20000", s.Address)
+	require.Equal(t, DefaultSqlMode, s.SqlMode)
+	s.DOP = 4
+	require.Equal(t, 0, Default().DOP)
+	c := s.Clone()
+	c.DOP = 8
+	require.Equal(t, 4, s.DOP)
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iff
+
+import (
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Int8Iff  = fixedLengthIff[int8]
+	Int16Iff = fixedLengthIff[int16]
+	Int32Iff = fixedLengthIff[int32]
+	Int64Iff = fixedLengthIff[int64]
+
+	Uint8Iff  = fixedLengthIff[uint8]
+	Uint16Iff = fixedLengthIff[uint16]
+	Uint32Iff = fixedLengthIff[uint32]
+	Uint64Iff = fixedLengthIff[uint64]
+
+	Float32Iff = fixedLengthIff[float32]
+	Float64Iff = fixedLengthIff[float64]
+
+	Decimal64Iff  = fixedLengthIff[types.Decimal64]
+	Decimal128Iff = fixedLengthIff[types.Decimal128]
+
+	DateIff      = fixedLengthIff[types.Date]
+	DatetimeIff  = fixedLengthIff[types.Datetime]
+	TimestampIff = fixedLengthIff[types.Timestamp]
+
+	StrIff = strIff
+)
+
+// fixedLengthIff sets rs[i] to xs[i] if conds[i] is true and to ys[i]
+// otherwise. A branch of length 1 is a constant used for every row
+func fixedLengthIff[T any](conds []bool, xs, ys, rs []T) []T {
+	xstep, ystep := step(xs), step(ys)
+	for i, cond := range conds {
+		vs := [2]T{ys[i*ystep], xs[i*xstep]}
+		rs[i] = vs[b2i(cond)]
+	}
+	return rs[:len(conds)]
+}
+
+// strIff is fixedLengthIff of the strings. The data of rs is appended
+func strIff(conds []bool, xs, ys, rs *types.Bytes) *types.Bytes {
+	xstep, ystep := step(xs.Lengths), step(ys.Lengths)
+	vs := [2]*types.Bytes{ys, xs}
+	steps := [2]int{ystep, xstep}
+	for i, cond := range conds {
+		k := b2i(cond)
+		j := i * steps[k]
+		o, n := vs[k].Offsets[j], vs[k].Lengths[j]
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Lengths = append(rs.Lengths, n)
+		rs.Data = append(rs.Data, vs[k].Data[o:o+n]...)
+	}
+	return rs
+}
+
+// step is 0 for a constant and 1 for a column
+func step[T any](vs []T) int {
+	if len(vs) == 1 {
+		return 0
+	}
+	return 1
+}
+
+func b2i(b bool) int {
+	return int(*(*uint8)(unsafe.Pointer(&b)))
+}
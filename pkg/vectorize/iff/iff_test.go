@@ -0,0 +1,51 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iff
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIff(t *testing.T) {
+	conds := []bool{true, false, false, true}
+	xs := []int64{1, 2, 3, 4}
+	ys := []int64{-1, -2, -3, -4}
+	rs := make([]int64, len(conds))
+	require.Equal(t, []int64{1, -2, -3, 4}, Int64Iff(conds, xs, ys, rs))
+	require.Equal(t, []int64{1, 0, 0, 4}, Int64Iff(conds, xs, []int64{0}, rs))
+	require.Equal(t, []int64{7, -2, -3, 7}, Int64Iff(conds, []int64{7}, ys, rs))
+}
+
+func TestStrIff(t *testing.T) {
+	conds := []bool{false, true, true}
+	xs := &types.Bytes{
+		Data:    []byte("abc"),
+		Offsets: []uint32{0},
+		Lengths: []uint32{3},
+	}
+	ys := &types.Bytes{
+		Data:    []byte("xyyzzz"),
+		Offsets: []uint32{0, 1, 3},
+		Lengths: []uint32{1, 2, 3},
+	}
+	rs := StrIff(conds, xs, ys, &types.Bytes{})
+	require.Equal(t, 3, len(rs.Lengths))
+	require.Equal(t, []byte("x"), rs.Get(0))
+	require.Equal(t, []byte("abc"), rs.Get(1))
+	require.Equal(t, []byte("abc"), rs.Get(2))
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package in
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+var (
+	Int8In  = fixedIn[int8]
+	Int16In = fixedIn[int16]
+	Int32In = fixedIn[int32]
+	Int64In = fixedIn[int64]
+
+	Uint8In  = fixedIn[uint8]
+	Uint16In = fixedIn[uint16]
+	Uint32In = fixedIn[uint32]
+	Uint64In = fixedIn[uint64]
+
+	Float32In = fixedIn[float32]
+	Float64In = fixedIn[float64]
+
+	Decimal64In  = fixedIn[types.Decimal64]
+	Decimal128In = fixedIn[types.Decimal128]
+
+	DateIn      = fixedIn[types.Date]
+	DatetimeIn  = fixedIn[types.Datetime]
+	TimestampIn = fixedIn[types.Timestamp]
+
+	StrIn = strIn
+)
+
+// fixedIn returns in rs the rows of xs found in set, or the ones not found
+// if not is set. The null rows of nulls are never returned
+func fixedIn[T comparable](xs []T, set map[T]struct{}, nulls *roaring.Bitmap, not bool, rs []int64) []int64 {
+	rsi := 0
+	if nulls == nil || nulls.IsEmpty() {
+		for i, x := range xs {
+			if _, ok := set[x]; ok != not {
+				rs[rsi] = int64(i)
+				rsi++
+			}
+		}
+		return rs[:rsi]
+	}
+	for i, x := range xs {
+		if nulls.Contains(uint64(i)) {
+			continue
+		}
+		if _, ok := set[x]; ok != not {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+// strIn is fixedIn of the strings
+func strIn(xs *types.Bytes, set map[string]struct{}, nulls *roaring.Bitmap, not bool, rs []int64) []int64 {
+	rsi := 0
+	for i, n := 0, len(xs.Offsets); i < n; i++ {
+		if nulls != nil && nulls.Contains(uint64(i)) {
+			continue
+		}
+		if _, ok := set[string(xs.Get(int64(i)))]; ok != not {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
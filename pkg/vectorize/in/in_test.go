@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package in
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+func TestIn(t *testing.T) {
+	xs := []int32{1, 5, 3, 7, 5}
+	set := map[int32]struct{}{5: {}, 7: {}}
+	rs := make([]int64, len(xs))
+	require.Equal(t, []int64{1, 3, 4}, Int32In(xs, set, nil, false, rs))
+	require.Equal(t, []int64{0, 2}, Int32In(xs, set, nil, true, rs))
+	nulls := roaring.BitmapOf(0, 3)
+	require.Equal(t, []int64{1, 4}, Int32In(xs, set, nulls, false, rs))
+	require.Equal(t, []int64{2}, Int32In(xs, set, nulls, true, rs))
+}
+
+func TestStrIn(t *testing.T) {
+	xs := &types.Bytes{
+		Data:    []byte("abbccc"),
+		Offsets: []uint32{0, 1, 3},
+		Lengths: []uint32{1, 2, 3},
+	}
+	set := map[string]struct{}{"bb": {}, "d": {}}
+	rs := make([]int64, len(xs.Lengths))
+	require.Equal(t, []int64{1}, StrIn(xs, set, nil, false, rs))
+	require.Equal(t, []int64{0, 2}, StrIn(xs, set, nil, true, rs))
+	require.Equal(t, []int64{2}, StrIn(xs, set, roaring.BitmapOf(0), true, rs))
+}
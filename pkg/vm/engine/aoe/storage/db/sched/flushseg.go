@@ -41,8 +41,17 @@ func (e *flushSegEvent) Rollback(reason string) error {
 }
 
 func (e *flushSegEvent) Execute() error {
-	ids := e.Segment.BlockIds()
 	meta := e.Segment.GetMeta()
+	dir := meta.Table.Database.Catalog.Cfg.Dir
+	if file, ok := e.Segment.GetSegmentFile().(*dataio.UnsortedSegmentFile); ok {
+		// Sort the block files without loading the blocks
+		if s, err := dataio.NewSegmentSorter(file, meta, dir); err == nil {
+			err = s.Execute()
+			e.Destroyer = s.Rollback
+			return err
+		}
+	}
+	ids := e.Segment.BlockIds()
 	blks := make([]iface.IBlock, 0)
 	for _, id := range ids {
 		blk := e.Segment.StrongRefBlock(id)
@@ -54,7 +63,7 @@ func (e *flushSegEvent) Execute() error {
 			blk.Unref()
 		}
 	}
-	w := dataio.NewSegmentWriter(iter, meta, meta.Table.Database.Catalog.Cfg.Dir, fn)
+	w := dataio.NewSegmentWriter(iter, meta, dir, fn)
 	if err := w.Execute(); err != nil {
 		return err
 	}
@@ -36,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/fileformat"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -363,3 +364,66 @@ func TestTransientBlock(t *testing.T) {
 	// ok = tblk.PreSync(uint32(bat2.Vecs[0].Length()))
 	// assert.False(t, ok)
 }
+
+func TestSegmentSorter(t *testing.T) {
+	dir := initTestEnv(t)
+	blkRows, blkCnt := uint64(4), uint64(2)
+	catalog := metadata.MockCatalog(dir, blkRows, blkCnt, nil, nil)
+	defer catalog.Close()
+	schema := metadata.MockSchema(2)
+	gen := shard.NewMockIndexAllocator()
+	tblMeta := metadata.MockDBTable(catalog, "db1", schema, nil, blkCnt, gen.Shard(uint64(100)))
+	segMeta := tblMeta.SimpleGetSegment(uint64(1))
+	assert.NotNil(t, segMeta)
+
+	mgr := NewManager(dir, false)
+	segId := segMeta.AsCommonID().AsSegmentID()
+	file, err := mgr.RegisterUnsortedFiles(segId)
+	assert.Nil(t, err)
+	file.Ref()
+	_, err = NewSegmentSorter(file.(*UnsortedSegmentFile), segMeta, dir)
+	assert.ErrorIs(t, err, ErrBlkNotFlushed)
+
+	keys := [][]int32{{7, 1, 5, 3}, {6, 0, 4, 2}}
+	for i, blk := range segMeta.BlockSet {
+		pk := gvector.New(schema.ColDefs[0].Type)
+		assert.Nil(t, gvector.Append(pk, keys[i]))
+		vals := make([]int32, len(keys[i]))
+		for j, key := range keys[i] {
+			vals[j] = key * 10
+		}
+		col := gvector.New(schema.ColDefs[1].Type)
+		assert.Nil(t, gvector.Append(col, vals))
+		assert.Nil(t, NewBlockWriter([]*gvector.Vector{pk, col}, blk, dir).Execute())
+		assert.Nil(t, blk.SetCount(blkRows))
+		assert.Nil(t, blk.SimpleUpgrade(nil))
+	}
+
+	sorter, err := NewSegmentSorter(file.(*UnsortedSegmentFile), segMeta, dir)
+	assert.Nil(t, err)
+	assert.Nil(t, sorter.Execute())
+	sorted := sorter.Promote(mgr)
+	sorted.Ref()
+	assert.Nil(t, mgr.GetUnsortedFile(segId))
+	assert.Equal(t, sorted, mgr.GetSortedFile(segId))
+
+	for i := range segMeta.BlockSet {
+		id := segId
+		id.BlockID = uint64(i)
+		data, err := fileformat.ReadAll(NewBlockReader(sorted, id, 2))
+		assert.Nil(t, err)
+		for col, buf := range data {
+			vec := gvector.New(schema.ColDefs[col].Type)
+			assert.Nil(t, vec.Read(buf))
+			for j, v := range vec.Col.([]int32) {
+				key := int32(i*int(blkRows) + j)
+				if col == 1 {
+					key *= 10
+				}
+				assert.Equal(t, key, v)
+			}
+		}
+	}
+	sorted.Unref()
+	file.Unref()
+}
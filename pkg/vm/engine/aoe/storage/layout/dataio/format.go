@@ -0,0 +1,99 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataio
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/fileformat"
+)
+
+// segmentReader reads a segment file through fileformat.SegmentFile
+type segmentReader struct {
+	file   base.ISegmentFile
+	id     common.ID
+	colCnt int
+}
+
+// blockReader reads the parts of a block of a segment file through
+// fileformat.BlockFile
+type blockReader struct {
+	file   base.ISegmentFile
+	id     common.ID
+	colCnt int
+}
+
+// partReader reads a column part through fileformat.ColumnFile
+type partReader struct {
+	file base.ISegmentFile
+	id   common.ID
+}
+
+// NewSegmentReader returns the segment file of segment id with colCnt
+// columns. The block id of OpenBlockFile is the block idx in a sorted
+// segment file
+func NewSegmentReader(file base.ISegmentFile, id common.ID, colCnt int) fileformat.SegmentFile {
+	return &segmentReader{
+		file:   file,
+		id:     id.AsSegmentID(),
+		colCnt: colCnt,
+	}
+}
+
+// NewBlockReader returns the block file of block id of a segment file with
+// colCnt columns
+func NewBlockReader(file base.ISegmentFile, id common.ID, colCnt int) fileformat.BlockFile {
+	return &blockReader{
+		file:   file,
+		id:     id.AsBlockID(),
+		colCnt: colCnt,
+	}
+}
+
+func (r *segmentReader) OpenBlockFile(id uint64) (fileformat.BlockFile, error) {
+	blkId := r.id
+	blkId.BlockID = id
+	return NewBlockReader(r.file, blkId, r.colCnt), nil
+}
+
+func (r *blockReader) ColumnCount() int { return r.colCnt }
+
+func (r *blockReader) OpenColumnFile(col int) (fileformat.ColumnFile, error) {
+	if col < 0 || col >= r.colCnt {
+		return nil, fileformat.ErrNotFound
+	}
+	id := r.id
+	id.Idx = uint16(col)
+	return &partReader{file: r.file, id: id}, nil
+}
+
+func (r *partReader) Stat() fileformat.ColumnStat {
+	return fileformat.ColumnStat{
+		Size:       r.file.PartSize(uint64(r.id.Idx), r.id, false),
+		OriginSize: r.file.PartSize(uint64(r.id.Idx), r.id, true),
+		Algo:       r.file.DataCompressAlgo(r.id),
+	}
+}
+
+func (r *partReader) ReadOrigin(buf []byte) (int, error) {
+	stat := r.Stat()
+	data := make([]byte, stat.Size)
+	r.file.ReadPart(uint64(r.id.Idx), r.id, data)
+	return fileformat.Decompress(data, stat, buf)
+}
+
+func (r *partReader) Prefetch() {
+	_ = r.file.PrefetchPart(uint64(r.id.Idx), r.id)
+}
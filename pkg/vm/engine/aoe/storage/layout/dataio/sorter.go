@@ -0,0 +1,130 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataio
+
+import (
+	"errors"
+	"fmt"
+
+	gvector "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/fileformat"
+)
+
+var (
+	ErrBlkNotFlushed = errors.New("block not flushed")
+)
+
+// fileBlockIterator iterates the columns of the block files of an unsorted
+// segment file in the order of the blocks of the segment
+type fileBlockIterator struct {
+	blocks []fileformat.BlockFile
+	col    uint16
+}
+
+func (iter *fileBlockIterator) FetchColumn() ([]*gvector.Vector, error) {
+	column := make([]*gvector.Vector, len(iter.blocks))
+	for i, blk := range iter.blocks {
+		part, err := blk.OpenColumnFile(int(iter.col))
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, part.Stat().OriginSize)
+		if _, err = part.ReadOrigin(data); err != nil {
+			return nil, err
+		}
+		vec := gvector.New(encoding.DecodeType(data[:encoding.TypeSize]))
+		if err = vec.Read(data); err != nil {
+			return nil, err
+		}
+		column[i] = vec
+	}
+	return column, nil
+}
+
+func (iter *fileBlockIterator) BlockCount() uint32 { return uint32(len(iter.blocks)) }
+func (iter *fileBlockIterator) Reset(col uint16)   { iter.col = col }
+func (iter *fileBlockIterator) Clear()             {}
+
+// SegmentSorter merges the block files of an unsorted segment file by the
+// sort key into a sorted segment file with the embedded indices. It reads
+// the block files instead of the blocks loaded in memory
+type SegmentSorter struct {
+	file   *UnsortedSegmentFile
+	meta   *metadata.Segment
+	ids    []common.ID
+	writer *SegmentWriter
+}
+
+// NewSegmentSorter returns a sorter of file of the segment meta. It
+// returns ErrBlkNotFlushed if the block file of any block of meta is not
+// flushed yet
+func NewSegmentSorter(file *UnsortedSegmentFile, meta *metadata.Segment, dir string) (*SegmentSorter, error) {
+	s := &SegmentSorter{
+		file: file,
+		meta: meta,
+		ids:  make([]common.ID, len(meta.BlockSet)),
+	}
+	for i, blk := range meta.BlockSet {
+		if blk.CommitInfo.Op < metadata.OpUpgradeFull {
+			return nil, fmt.Errorf("%w: %s", ErrBlkNotFlushed, blk.AsCommonID().BlockString())
+		}
+		s.ids[i] = *blk.AsCommonID()
+	}
+	colCnt := len(meta.Table.Schema.ColDefs)
+	iter := &fileBlockIterator{
+		blocks: make([]fileformat.BlockFile, len(s.ids)),
+	}
+	for i, id := range s.ids {
+		file.RefBlock(id)
+		iter.blocks[i] = NewBlockReader(file, id, colCnt)
+	}
+	s.writer = NewSegmentWriter(iter, meta, dir, nil)
+	return s, nil
+}
+
+// Execute writes the sorted segment file. The block files are referenced
+// from NewSegmentSorter until Execute returns
+func (s *SegmentSorter) Execute() error {
+	defer func() {
+		for _, id := range s.ids {
+			s.file.UnrefBlock(id)
+		}
+	}()
+	return s.writer.Execute()
+}
+
+// Rollback removes the sorted segment file written
+func (s *SegmentSorter) Rollback(reason string) error {
+	if destroyer := s.writer.GetDestoryer(); destroyer != nil {
+		return destroyer(reason)
+	}
+	return nil
+}
+
+// Promote swaps the unsorted segment file in mgr for the sorted one under
+// the lock of mgr. The holders of the unsorted segment file keep reading it
+// until they release it. A segment opened by a table is promoted by the
+// upgrade of the segment instead
+func (s *SegmentSorter) Promote(mgr base.IManager) base.ISegmentFile {
+	id := s.meta.AsCommonID().AsSegmentID()
+	sf := mgr.UpgradeFile(id)
+	logutil.Infof("SegmentFile | %s | Promoted", id.SegmentString())
+	return sf
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+const (
+	// BatchBytes is the memory budget of a batch produced by a scan
+	BatchBytes = 1 << 20
+	// MinBatchRows and MaxBatchRows bound the rows of a batch of a scan, a
+	// batch of few rows does not amortize the cost of the operators
+	MinBatchRows = 1 << 10
+	MaxBatchRows = 1 << 16
+	// VarlenWidth is the width assumed for the values of a char or varchar
+	// column without a declared width
+	VarlenWidth = 64
+)
+
+// RowsPerBatch returns the rows of a batch of the columns of typs fitting
+// in BatchBytes
+func RowsPerBatch(typs []types.Type) int {
+	width := 0
+	for _, typ := range typs {
+		width += rowWidth(typ)
+	}
+	if width == 0 {
+		return MaxBatchRows
+	}
+	rows := BatchBytes / width
+	switch {
+	case rows < MinBatchRows:
+		return MinBatchRows
+	case rows > MaxBatchRows:
+		return MaxBatchRows
+	}
+	return rows
+}
+
+// rowWidth returns the bytes a value of typ takes in a batch
+func rowWidth(typ types.Type) int {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		// the offset and the length of each value come with its data
+		if typ.Width > 0 {
+			return int(typ.Width) + 8
+		}
+		return VarlenWidth + 8
+	}
+	return int(typ.Size)
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowsPerBatch(t *testing.T) {
+	i64 := types.Type{Oid: types.T_int64, Size: 8}
+	require.Equal(t, MaxBatchRows, RowsPerBatch(nil))
+	require.Equal(t, MaxBatchRows, RowsPerBatch([]types.Type{i64}))
+	require.Equal(t, BatchBytes/64, RowsPerBatch([]types.Type{i64, i64, i64, i64, i64, i64, i64, i64}))
+	require.Equal(t, BatchBytes/(8+100+8), RowsPerBatch([]types.Type{i64, {Oid: types.T_varchar, Size: 24, Width: 100}}))
+	wide := make([]types.Type, 1024)
+	for i := range wide {
+		wide[i] = types.Type{Oid: types.T_char, Size: 24}
+	}
+	require.Equal(t, MinBatchRows, RowsPerBatch(wide))
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fileformat defines the files of the segments, blocks and columns
+// read by both the aoe and the tae storage engines, so the work on the read
+// path of one lands in the other
+package fileformat
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+var (
+	ErrNotFound    = errors.New("fileformat: not found")
+	ErrInvalidSize = errors.New("fileformat: invalid decompressed size")
+)
+
+// ColumnStat is the stat of the data of a column of a block as stored
+type ColumnStat struct {
+	Size       int64
+	OriginSize int64
+	// Algo is the compress algorithm of the data
+	Algo int
+}
+
+// ColumnFile is the data of a column of a block
+type ColumnFile interface {
+	Stat() ColumnStat
+	// ReadOrigin reads the data decompressed into buf of the origin size
+	ReadOrigin(buf []byte) (int, error)
+	// Prefetch reads the data ahead where supported
+	Prefetch()
+}
+
+// BlockFile is the file of a block read by the column
+type BlockFile interface {
+	ColumnCount() int
+	// OpenColumnFile returns the data of column col or ErrNotFound
+	OpenColumnFile(col int) (ColumnFile, error)
+}
+
+// SegmentFile is the file of a segment holding the files of its blocks
+type SegmentFile interface {
+	// OpenBlockFile returns the file of block id or ErrNotFound
+	OpenBlockFile(id uint64) (BlockFile, error)
+}
+
+// Decompress decompresses data of stat into buf of the origin size
+func Decompress(data []byte, stat ColumnStat, buf []byte) (int, error) {
+	if stat.Algo == compress.None {
+		return copy(buf, data), nil
+	}
+	origin, err := compress.Decompress(data, buf, stat.Algo)
+	if err != nil {
+		return 0, err
+	}
+	if int64(len(origin)) != stat.OriginSize {
+		return 0, ErrInvalidSize
+	}
+	return copy(buf, origin), nil
+}
+
+// ReadAll reads the data of all the columns of block decompressed
+func ReadAll(block BlockFile) ([][]byte, error) {
+	data := make([][]byte, block.ColumnCount())
+	for col := range data {
+		column, err := block.OpenColumnFile(col)
+		if err != nil {
+			return nil, err
+		}
+		data[col] = make([]byte, column.Stat().OriginSize)
+		n, err := column.ReadOrigin(data[col])
+		if err != nil {
+			return nil, err
+		}
+		data[col] = data[col][:n]
+	}
+	return data, nil
+}
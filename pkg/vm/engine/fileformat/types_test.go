@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileformat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/stretchr/testify/assert"
+)
+
+type memColumn struct {
+	stat ColumnStat
+	data []byte
+}
+
+func (c *memColumn) Stat() ColumnStat { return c.stat }
+func (c *memColumn) Prefetch()        {}
+
+func (c *memColumn) ReadOrigin(buf []byte) (int, error) {
+	return Decompress(c.data, c.stat, buf)
+}
+
+type memBlock []*memColumn
+
+func (b memBlock) ColumnCount() int { return len(b) }
+
+func (b memBlock) OpenColumnFile(col int) (ColumnFile, error) {
+	if col >= len(b) {
+		return nil, ErrNotFound
+	}
+	return b[col], nil
+}
+
+func newMemColumn(t *testing.T, data []byte, algo int) *memColumn {
+	c := &memColumn{
+		stat: ColumnStat{OriginSize: int64(len(data)), Algo: algo},
+		data: data,
+	}
+	if algo != compress.None {
+		buf, err := compress.Compress(data, make([]byte, compress.CompressBound(len(data), algo)), algo)
+		assert.Nil(t, err)
+		c.data = buf
+	}
+	c.stat.Size = int64(len(c.data))
+	return c
+}
+
+func TestReadAll(t *testing.T) {
+	cols := [][]byte{[]byte("column 0"), []byte("column 1 data"), []byte("column 2")}
+	block := memBlock{
+		newMemColumn(t, cols[0], compress.None),
+		newMemColumn(t, cols[1], compress.Lz4),
+		newMemColumn(t, cols[2], compress.Zstd),
+	}
+	data, err := ReadAll(block)
+	assert.Nil(t, err)
+	assert.Equal(t, cols, data)
+
+	_, err = block.OpenColumnFile(3)
+	assert.Equal(t, ErrNotFound, err)
+	block[1].stat.OriginSize++
+	_, err = ReadAll(block)
+	assert.Equal(t, ErrInvalidSize, err)
+}
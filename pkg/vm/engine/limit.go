@@ -0,0 +1,43 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import "sync/atomic"
+
+// ScanLimit is the row limit of a scan shared by the readers of its splits
+type ScanLimit struct {
+	limit uint64
+	rows  uint64
+}
+
+func NewScanLimit(limit uint64) *ScanLimit {
+	return &ScanLimit{limit: limit}
+}
+
+// Add counts n rows produced by a split
+func (l *ScanLimit) Add(n uint64) {
+	if l == nil {
+		return
+	}
+	atomic.AddUint64(&l.rows, n)
+}
+
+// Done reports whether the splits have produced the rows wanted
+func (l *ScanLimit) Done() bool {
+	if l == nil {
+		return false
+	}
+	return atomic.LoadUint64(&l.rows) >= l.limit
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 )
 
 type nodeManager struct {
@@ -128,6 +129,7 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	if node.IsLoaded() {
 		node.Ref()
 		node.RUnlock()
+		metrics.BufferRequests.WithLabelValues(metrics.BufferHit).Inc()
 		return node.MakeHandle()
 	}
 	node.RUnlock()
@@ -136,8 +138,10 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	defer node.Unlock()
 	if node.IsLoaded() {
 		node.Ref()
+		metrics.BufferRequests.WithLabelValues(metrics.BufferHit).Inc()
 		return node.MakeHandle()
 	}
+	metrics.BufferRequests.WithLabelValues(metrics.BufferMiss).Inc()
 	ok := mgr.MakeRoom(node.Size())
 	if !ok {
 		return nil
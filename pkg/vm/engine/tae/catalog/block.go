@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type BlockDataFactory = func(meta *BlockEntry) data.Block
@@ -31,7 +32,10 @@ type BlockEntry struct {
 	*BaseEntry
 	segment *SegmentEntry
 	state   EntryState
-	blkData data.Block
+	// schema is the table schema version the block was created with
+	schema   *Schema
+	blkData  data.Block
+	rowStats RowStats
 }
 
 func NewReplayBlockEntry() *BlockEntry {
@@ -40,7 +44,7 @@ func NewReplayBlockEntry() *BlockEntry {
 	}
 }
 
-func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState, dataFactory BlockDataFactory) *BlockEntry {
+func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState, schema *Schema, dataFactory BlockDataFactory) *BlockEntry {
 	id := segment.GetTable().GetDB().catalog.NextBlock()
 	e := &BlockEntry{
 		BaseEntry: &BaseEntry{
@@ -53,6 +57,7 @@ func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState,
 		},
 		segment: segment,
 		state:   state,
+		schema:  schema,
 	}
 	if dataFactory != nil {
 		e.blkData = dataFactory(e)
@@ -72,6 +77,7 @@ func NewSysBlockEntry(segment *SegmentEntry, id uint64) *BlockEntry {
 		},
 		segment: segment,
 		state:   ES_Appendable,
+		schema:  segment.GetTable().GetSchema(),
 	}
 	return e
 }
@@ -130,7 +136,61 @@ func (entry *BlockEntry) AsCommonID() *common.ID {
 }
 
 func (entry *BlockEntry) GetBlockData() data.Block { return entry.blkData }
-func (entry *BlockEntry) GetSchema() *Schema       { return entry.GetSegment().GetTable().GetSchema() }
+
+// InitData makes the data of the block replayed without it
+func (entry *BlockEntry) InitData(dataFactory BlockDataFactory) {
+	if entry.blkData == nil {
+		entry.blkData = dataFactory(entry)
+	}
+}
+
+// GetSchema returns the schema version the block was created with
+func (entry *BlockEntry) GetSchema() *Schema {
+	if entry.schema == nil {
+		return entry.GetSegment().GetTable().GetSchema()
+	}
+	return entry.schema
+}
+
+// GetRowStats returns the statistics of the committed rows of the block
+func (entry *BlockEntry) GetRowStats() *RowStats { return &entry.rowStats }
+
+// AddRowStats adds the committed changes of rows and size to the statistics
+// of the block, its segment and its table
+func (entry *BlockEntry) AddRowStats(rows, size int64) {
+	entry.rowStats.add(rows, size)
+	if entry.segment == nil {
+		return
+	}
+	entry.segment.rowStats.add(rows, size)
+	if entry.segment.table != nil {
+		entry.segment.table.rowStats.add(rows, size)
+	}
+}
+
+// EstimateSize estimates the size of rows of the block
+func (entry *BlockEntry) EstimateSize(rows int64) int64 {
+	return rows * estimateRowSize(entry.GetSchema())
+}
+
+// DeleteRows removes the committed deletes of rows from the statistics
+func (entry *BlockEntry) DeleteRows(rows int64) {
+	entry.AddRowStats(-rows, -entry.EstimateSize(rows))
+}
+
+// ApplyCommit removes the rows of a dropped block from the statistics
+func (entry *BlockEntry) ApplyCommit(index *wal.Index) (err error) {
+	if err = entry.BaseEntry.ApplyCommit(index); err != nil {
+		return
+	}
+	entry.RLock()
+	dropped := entry.HasDropped()
+	entry.RUnlock()
+	if dropped {
+		entry.AddRowStats(-entry.rowStats.Rows(), -entry.rowStats.Size())
+	}
+	return
+}
 
 func (entry *BlockEntry) PrepareRollback() (err error) {
 	entry.RLock()
@@ -154,7 +214,10 @@ func (entry *BlockEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if err = binary.Write(w, binary.BigEndian, entry.state); err != nil {
 		return
 	}
-	n += 1
+	if err = binary.Write(w, binary.BigEndian, entry.GetSchema().Version); err != nil {
+		return
+	}
+	n += 1 + 4
 	return
 }
 
@@ -162,8 +225,14 @@ func (entry *BlockEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	err = binary.Read(r, binary.BigEndian, &entry.state)
-	n += 1
+	if err = binary.Read(r, binary.BigEndian, &entry.state); err != nil {
+		return
+	}
+	// Only the version is recorded and the schema is resolved against the
+	// table on replay
+	entry.schema = new(Schema)
+	err = binary.Read(r, binary.BigEndian, &entry.schema.Version)
+	n += 1 + 4
 	return
 }
 
@@ -176,6 +245,7 @@ func (entry *BlockEntry) Clone() CheckpointItem {
 		BaseEntry: entry.BaseEntry.Clone(),
 		state:     entry.state,
 		segment:   entry.segment,
+		schema:    entry.schema,
 	}
 	return cloned
 }
@@ -185,6 +255,7 @@ func (entry *BlockEntry) CloneCreate() CheckpointItem {
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		state:     entry.state,
 		segment:   entry.segment,
+		schema:    entry.schema,
 	}
 	return cloned
 }
@@ -144,6 +144,12 @@ func (catalog *Catalog) ReplayCmd(txncmd txnif.TxnCmd) (err error) {
 	case CmdCreateBlock:
 		cmd := txncmd.(*EntryCommand)
 		err = catalog.onReplayCreateBlock(cmd)
+	case CmdUpdateTable:
+		cmd := txncmd.(*EntryCommand)
+		err = catalog.onReplayUpdateTable(cmd)
+	case CmdUpdateDatabase:
+		cmd := txncmd.(*EntryCommand)
+		err = catalog.onReplayUpdateDatabase(cmd)
 	case CmdDropTable:
 		cmd := txncmd.(*EntryCommand)
 		err = catalog.onReplayDropTable(cmd)
@@ -178,6 +184,26 @@ func (catalog *Catalog) onReplayDropDatabase(cmd *EntryCommand) (err error) {
 	db.DeleteAt = cmd.entry.DeleteAt
 	return
 }
+func (catalog *Catalog) onReplayUpdateDatabase(cmd *EntryCommand) (err error) {
+	db, err := catalog.GetDatabaseByID(cmd.DBID)
+	if err != nil {
+		return err
+	}
+	versioned := cmd.DB.names[0]
+	db.Lock()
+	// Already replayed from the checkpoint
+	if versioned.ts <= db.names[len(db.names)-1].ts {
+		db.Unlock()
+		return
+	}
+	db.addNameLocked(versioned.ts, versioned.name)
+	db.Unlock()
+	catalog.Lock()
+	catalog.addNameNodeLocked(versioned.name, db.ID)
+	catalog.Unlock()
+	return
+}
+
 func (catalog *Catalog) onReplayDatabase(cmd *EntryCommand) (err error) {
 	cmd.DB.catalog = catalog
 	if cmd.DB.CurrOp == OpCreate {
@@ -207,6 +233,37 @@ func (catalog *Catalog) onReplayCreateTable(cmd *EntryCommand) (err error) {
 	return
 }
 
+func (catalog *Catalog) onReplayUpdateTable(cmd *EntryCommand) (err error) {
+	db, err := catalog.GetDatabaseByID(cmd.DBID)
+	if err != nil {
+		return err
+	}
+	tbl, err := db.GetTableEntryByID(cmd.TableID)
+	if err != nil {
+		return err
+	}
+	versioned := cmd.Table.schemas[0]
+	tbl.Lock()
+	if cmd.Table.stats != nil {
+		tbl.stats = cmd.Table.stats
+	}
+	last := tbl.schemas[len(tbl.schemas)-1]
+	// Already replayed from the checkpoint or only the stats are updated
+	if versioned.ts <= last.ts || (versioned.schema.Version == last.schema.Version &&
+		versioned.schema.Name == last.schema.Name) {
+		tbl.Unlock()
+		return
+	}
+	tbl.addSchemaLocked(versioned.ts, versioned.schema)
+	tbl.Unlock()
+	if versioned.schema.Name != last.schema.Name {
+		db.Lock()
+		db.addNameNodeLocked(versioned.schema.Name, tbl.ID)
+		db.Unlock()
+	}
+	return
+}
+
 func (catalog *Catalog) onReplayDropTable(cmd *EntryCommand) (err error) {
 	db, err := catalog.GetDatabaseByID(cmd.DBID)
 	if err != nil {
@@ -314,6 +371,7 @@ func (catalog *Catalog) onReplayCreateBlock(cmd *EntryCommand) (err error) {
 	cmd.Block.CurrOp = OpCreate
 	cmd.Block.segment = seg
 	cmd.Block.state = seg.state
+	cmd.Block.schema = tbl.GetSchemaByVersion(cmd.Block.schema.Version)
 	seg.addEntryLocked(cmd.Block)
 	return
 }
@@ -352,6 +410,7 @@ func (catalog *Catalog) onReplayBlock(cmd *EntryCommand) (err error) {
 		return
 	}
 	cmd.Block.segment = seg
+	cmd.Block.schema = rel.GetSchemaByVersion(cmd.Block.schema.Version)
 	if cmd.Block.CurrOp == OpCreate {
 		seg.addEntryLocked(cmd.Block)
 	} else {
@@ -417,43 +476,73 @@ func (catalog *Catalog) GetDatabaseByID(id uint64) (db *DBEntry, err error) {
 	return
 }
 
-func (catalog *Catalog) addEntryLocked(database *DBEntry) error {
-	nn := catalog.nameNodes[database.name]
+func (catalog *Catalog) checkAddEntryLocked(name string, txn txnif.TxnReader) error {
+	nn := catalog.nameNodes[name]
 	if nn == nil {
-		n := catalog.link.Insert(database)
-		catalog.entries[database.GetID()] = n
-
-		nn := newNodeList(catalog, &catalog.nodesMu, database.name)
-		catalog.nameNodes[database.name] = nn
-
-		nn.CreateNode(database.GetID())
-	} else {
-		node := nn.GetDBNode()
-		record := node.GetPayload().(*DBEntry)
-		record.RLock()
-		err := record.PrepareWrite(database.GetTxn(), record.RWMutex)
-		if err != nil {
-			record.RUnlock()
-			return err
-		}
-		if record.HasActiveTxn() {
-			if !record.IsDroppedUncommitted() {
-				record.RUnlock()
-				return ErrDuplicate
-			}
-		} else if !record.HasDropped() {
-			record.RUnlock()
+		return nil
+	}
+	node := nn.GetDBNode()
+	record := node.GetPayload().(*DBEntry)
+	record.RLock()
+	defer record.RUnlock()
+	if err := record.PrepareWrite(txn, record.RWMutex); err != nil {
+		return err
+	}
+	// The database was renamed away. Any other database in the list lost the
+	// name before the head took it
+	if record.latestNameLocked(txn) != name {
+		return nil
+	}
+	if record.HasActiveTxn() {
+		if !record.IsDroppedUncommitted() {
 			return ErrDuplicate
 		}
+	} else if !record.HasDropped() {
+		return ErrDuplicate
+	}
+	return nil
+}
 
-		record.RUnlock()
-		n := catalog.link.Insert(database)
-		catalog.entries[database.GetID()] = n
-		nn.CreateNode(database.GetID())
+func (catalog *Catalog) addEntryLocked(database *DBEntry) error {
+	if err := catalog.checkAddEntryLocked(database.name, database.GetTxn()); err != nil {
+		return err
 	}
+	n := catalog.link.Insert(database)
+	catalog.entries[database.GetID()] = n
+	catalog.addNameNodeLocked(database.name, database.GetID())
 	return nil
 }
 
+// addNameNodeLocked indexes the database of id by name. If the database had
+// the name before, its node is moved to the head of the list
+func (catalog *Catalog) addNameNodeLocked(name string, id uint64) {
+	nn := catalog.nameNodes[name]
+	if nn == nil {
+		nn = newNodeList(catalog, &catalog.nodesMu, name)
+		catalog.nameNodes[name] = nn
+	} else {
+		nn.DeleteNode(id)
+	}
+	nn.CreateNode(id)
+}
+
+func (catalog *Catalog) deleteNameNodeLocked(name string, id uint64) {
+	nn := catalog.nameNodes[name]
+	if nn == nil {
+		return
+	}
+	nn.DeleteNode(id)
+	if nn.Length() == 0 {
+		delete(catalog.nameNodes, name)
+	}
+}
+
+func (catalog *Catalog) removeNameNode(name string, id uint64) {
+	catalog.Lock()
+	defer catalog.Unlock()
+	catalog.deleteNameNodeLocked(name, id)
+}
+
 func (catalog *Catalog) MakeDBIt(reverse bool) *common.LinkIt {
 	catalog.RLock()
 	defer catalog.RUnlock()
@@ -504,12 +593,20 @@ func (catalog *Catalog) RemoveEntry(database *DBEntry) error {
 	if n, ok := catalog.entries[database.GetID()]; !ok {
 		return ErrNotFound
 	} else {
-		nn := catalog.nameNodes[database.name]
-		nn.DeleteNode(database.GetID())
-		catalog.link.Delete(n)
-		if nn.Length() == 0 {
-			delete(catalog.nameNodes, database.name)
+		// The database is indexed by all the names it ever had
+		database.RLock()
+		names := make(map[string]bool, len(database.names))
+		for _, versioned := range database.names {
+			names[versioned.name] = true
+		}
+		if database.pendingName != "" {
+			names[database.pendingName] = true
+		}
+		database.RUnlock()
+		for name := range names {
+			catalog.deleteNameNodeLocked(name, database.GetID())
 		}
+		catalog.link.Delete(n)
 	}
 	return nil
 }
@@ -554,6 +651,39 @@ func (catalog *Catalog) DropDBEntry(name string, txnCtx txnif.AsyncTxn) (deleted
 	return
 }
 
+// RenameDBEntry renames the database of name in txnCtx. The txns started
+// before txnCtx commits still find the database by the old name
+func (catalog *Catalog) RenameDBEntry(name, newName string, txnCtx txnif.AsyncTxn) (renamed *DBEntry, err error) {
+	if name == SystemDBName || newName == SystemDBName {
+		err = ErrNotPermitted
+		return
+	}
+	catalog.Lock()
+	defer catalog.Unlock()
+	dn := catalog.txnGetNodeByNameLocked(name, txnCtx)
+	if dn == nil {
+		err = ErrNotFound
+		return
+	}
+	entry := dn.GetPayload().(*DBEntry)
+	if err = catalog.checkAddEntryLocked(newName, txnCtx); err != nil {
+		return
+	}
+	if err = entry.Rename(txnCtx, newName); err != nil {
+		return
+	}
+	entry.RLock()
+	committed := entry.hasNameLocked(name)
+	entry.RUnlock()
+	// The old name was only given in txnCtx and nobody else can see it
+	if !committed {
+		catalog.deleteNameNodeLocked(name, entry.GetID())
+	}
+	catalog.addNameNodeLocked(newName, entry.GetID())
+	renamed = entry
+	return
+}
+
 func (catalog *Catalog) CreateDBEntry(name string, txnCtx txnif.AsyncTxn) (*DBEntry, error) {
 	var err error
 	catalog.Lock()
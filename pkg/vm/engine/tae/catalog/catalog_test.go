@@ -465,3 +465,83 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestRename(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	catalog := MockCatalog(dir, "mock", nil, nil)
+	defer catalog.Close()
+
+	txnMgr := txnbase.NewTxnManager(MockTxnStoreFactory(catalog), MockTxnFactory(catalog))
+	txnMgr.Start()
+	defer txnMgr.Stop()
+
+	schema := MockSchema(2)
+	txn1 := txnMgr.StartTxn(nil)
+	db, err := txn1.CreateDatabase("db")
+	assert.Nil(t, err)
+	_, err = db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn1.Commit())
+
+	txn2 := txnMgr.StartTxn(nil)
+
+	txn3 := txnMgr.StartTxn(nil)
+	_, err = txn3.RenameDatabase("db", "db")
+	assert.Equal(t, ErrDuplicate, err)
+	_, err = txn3.RenameDatabase("xx", "db2")
+	assert.Equal(t, ErrNotFound, err)
+	db, err = txn3.RenameDatabase("db", "db2")
+	assert.Nil(t, err)
+	_, err = db.RenameRelation(schema.Name, "tb2")
+	assert.Nil(t, err)
+	_, err = txn3.GetDatabase("db")
+	assert.Equal(t, ErrNotFound, err)
+	db, err = txn3.GetDatabase("db2")
+	assert.Nil(t, err)
+	_, err = db.GetRelationByName(schema.Name)
+	assert.Equal(t, ErrNotFound, err)
+	_, err = db.GetRelationByName("tb2")
+	assert.Nil(t, err)
+
+	// Another txn cannot take the new name before txn3 commits
+	txn4 := txnMgr.StartTxn(nil)
+	_, err = txn4.CreateDatabase("db2")
+	assert.Equal(t, txnif.TxnWWConflictErr, err)
+
+	assert.Nil(t, txn3.Commit())
+
+	// txn2 starts before txn3 commits and still finds the old names
+	_, err = txn2.GetDatabase("db2")
+	assert.Equal(t, ErrNotFound, err)
+	db, err = txn2.GetDatabase("db")
+	assert.Nil(t, err)
+	_, err = db.GetRelationByName("tb2")
+	assert.Equal(t, ErrNotFound, err)
+	_, err = db.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+
+	txn5 := txnMgr.StartTxn(nil)
+	db, err = txn5.GetDatabase("db2")
+	assert.Nil(t, err)
+	rel, err := db.GetRelationByName("tb2")
+	assert.Nil(t, err)
+	assert.Equal(t, "tb2", rel.(*mockTableHandle).entry.GetSchema().Name)
+	_, err = txn5.GetDatabase("db")
+	assert.Equal(t, ErrNotFound, err)
+	// The old names are free to be taken
+	schema2 := MockSchema(2)
+	schema2.Name = schema.Name
+	_, err = db.CreateRelation(schema2)
+	assert.Nil(t, err)
+	_, err = txn5.CreateDatabase("db")
+	assert.Nil(t, err)
+	assert.Nil(t, txn5.Commit())
+
+	_, err = txn2.GetDatabase("db")
+	assert.Nil(t, err)
+
+	entry, err := catalog.GetDBEntry("db2", txnMgr.StartTxn(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "db2", entry.GetName())
+	t.Log(catalog.SimplePPString(common.PPL1))
+}
@@ -37,6 +37,8 @@ const (
 	CmdLogTable
 	CmdLogSegment
 	CmdLogBlock
+	CmdUpdateTable
+	CmdUpdateDatabase
 )
 
 func init() {
@@ -52,6 +54,12 @@ func init() {
 	txnif.RegisterCmdFactory(CmdDropTable, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdUpdateTable, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
+	txnif.RegisterCmdFactory(CmdUpdateDatabase, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 	txnif.RegisterCmdFactory(CmdCreateSegment, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
@@ -243,10 +251,13 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.Segment.state); err != nil {
 			return
 		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Segment.partition); err != nil {
+			return
+		}
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.CreateAt); err != nil {
 			return
 		}
-		n += 8 + 8 + 8
+		n += 8 + 8 + 8 + 4
 	case CmdCreateBlock:
 		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
 			return
@@ -263,7 +274,33 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.CreateAt); err != nil {
 			return
 		}
-		n += 8 + 8 + 8 + 8
+		if err = binary.Write(w, binary.BigEndian, cmd.Block.GetSchema().Version); err != nil {
+			return
+		}
+		n += 8 + 8 + 8 + 8 + 4
+	case CmdUpdateTable:
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.db.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.entry.Txn.GetCommitTS()); err != nil {
+			return
+		}
+		n += 8 + 8 + 8
+		var schemaBuf []byte
+		if schemaBuf, err = cmd.Table.schema.Marshal(); err != nil {
+			return
+		}
+		if _, err = w.Write(schemaBuf); err != nil {
+			return
+		}
+		n += int64(len(schemaBuf))
+		if sn, err = writeStats(w, cmd.Table.stats); err != nil {
+			return
+		}
+		n += sn
 	case CmdDropTable:
 		if err = binary.Write(w, binary.BigEndian, cmd.Table.db.ID); err != nil {
 			return
@@ -275,6 +312,17 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 			return
 		}
 		n += 8 + 8 + 8
+	case CmdUpdateDatabase:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.entry.Txn.GetCommitTS()); err != nil {
+			return
+		}
+		if sn, err = common.WriteString(cmd.DB.name, w); err != nil {
+			return
+		}
+		n += sn + 8 + 8
 	case CmdDropDatabase:
 		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
 			return
@@ -428,6 +476,10 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		if err = binary.Read(r, binary.BigEndian, &state); err != nil {
 			return
 		}
+		var partition uint32
+		if err = binary.Read(r, binary.BigEndian, &partition); err != nil {
+			return
+		}
 		if err = binary.Read(r, binary.BigEndian, &cmd.entry.CreateAt); err != nil {
 			return
 		}
@@ -435,8 +487,9 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cmd.Segment = &SegmentEntry{
 			BaseEntry: cmd.entry,
 			state:     state,
+			partition: partition,
 		}
-		n += 8 + 8 + 8
+		n += 8 + 8 + 8 + 4
 	case CmdCreateBlock:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
@@ -456,8 +509,39 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cmd.entry.CurrOp = OpCreate
 		cmd.Block = &BlockEntry{
 			BaseEntry: cmd.entry,
+			schema:    new(Schema),
 		}
-		n += 8 + 8 + 8 + 8
+		if err = binary.Read(r, binary.BigEndian, &cmd.Block.schema.Version); err != nil {
+			return
+		}
+		n += 8 + 8 + 8 + 8 + 4
+	case CmdUpdateTable:
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		var ts uint64
+		if err = binary.Read(r, binary.BigEndian, &ts); err != nil {
+			return
+		}
+		cmd.Table = &TableEntry{
+			BaseEntry: cmd.entry,
+			schema:    new(Schema),
+		}
+		if sn, err = cmd.Table.schema.ReadFrom(r); err != nil {
+			return
+		}
+		cmd.Table.schemas = []*versionedSchema{{
+			ts:     ts,
+			schema: cmd.Table.schema,
+		}}
+		n += sn + 8 + 8 + 8
+		if sn, err = readStats(r, &cmd.Table.stats); err != nil {
+			return
+		}
+		n += sn
 	case CmdDropTable:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
@@ -469,6 +553,23 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 8 + 8 + 8
+	case CmdUpdateDatabase:
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		versioned := new(versionedName)
+		if err = binary.Read(r, binary.BigEndian, &versioned.ts); err != nil {
+			return
+		}
+		if versioned.name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		cmd.DB = &DBEntry{
+			BaseEntry: cmd.entry,
+			name:      versioned.name,
+			names:     []*versionedName{versioned},
+		}
+		n += sn + 8 + 8
 	case CmdDropDatabase:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
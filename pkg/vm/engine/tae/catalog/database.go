@@ -23,14 +23,27 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
+// versionedName is a committed name of a database with the commit ts of the
+// DDL that gave it
+type versionedName struct {
+	ts   uint64
+	name string
+}
+
 type DBEntry struct {
 	// *BaseEntry
 	*BaseEntry
 	catalog *Catalog
 	name    string
-	isSys   bool
+	// names holds all committed names in ascending order and the last one is
+	// always the same as name
+	names []*versionedName
+	// pendingName is the name given by an uncommitted txn
+	pendingName string
+	isSys       bool
 
 	entries   map[uint64]*common.DLNode
 	nameNodes map[string]*nodeList
@@ -52,6 +65,7 @@ func NewDBEntry(catalog *Catalog, name string, txnCtx txnif.AsyncTxn) *DBEntry {
 		},
 		catalog:   catalog,
 		name:      name,
+		names:     []*versionedName{{name: name}},
 		entries:   make(map[uint64]*common.DLNode),
 		nameNodes: make(map[string]*nodeList),
 		link:      new(common.Link),
@@ -72,6 +86,7 @@ func NewSystemDBEntry(catalog *Catalog) *DBEntry {
 		},
 		catalog:   catalog,
 		name:      SystemDBName,
+		names:     []*versionedName{{name: SystemDBName}},
 		entries:   make(map[uint64]*common.DLNode),
 		nameNodes: make(map[string]*nodeList),
 		link:      new(common.Link),
@@ -102,8 +117,107 @@ func (e *DBEntry) Compare(o common.NodePayload) int {
 	return e.DoCompre(oe)
 }
 
+// GetName returns the latest committed name
 func (e *DBEntry) GetName() string { return e.name }
 
+// TxnGetName returns the name visible to txn. It is the name given by txn
+// itself if any, otherwise the latest one committed before txn starts
+func (e *DBEntry) TxnGetName(txn txnif.TxnReader) string {
+	if txn == nil {
+		return e.GetName()
+	}
+	e.RLock()
+	defer e.RUnlock()
+	return e.txnGetNameLocked(txn)
+}
+
+func (e *DBEntry) txnGetNameLocked(txn txnif.TxnReader) string {
+	if e.pendingName != "" && e.IsSameTxn(txn) {
+		return e.pendingName
+	}
+	for i := len(e.names) - 1; i > 0; i-- {
+		ts := e.names[i].ts
+		if ts <= txn.GetStartTS() || ts == txn.GetCommitTS() {
+			return e.names[i].name
+		}
+	}
+	return e.names[0].name
+}
+
+// latestNameLocked returns the name of the database if txn commits
+func (e *DBEntry) latestNameLocked(txn txnif.TxnReader) string {
+	if txn != nil && e.pendingName != "" && e.IsSameTxn(txn) {
+		return e.pendingName
+	}
+	return e.name
+}
+
+// hasNameLocked returns true if the database ever committed the name
+func (e *DBEntry) hasNameLocked(name string) bool {
+	for _, versioned := range e.names {
+		if versioned.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// staleNameLocked returns the name given by an uncommitted rename that is
+// discarded, or an empty string if there is none
+func (e *DBEntry) staleNameLocked() string {
+	if e.pendingName == "" || e.hasNameLocked(e.pendingName) {
+		return ""
+	}
+	return e.pendingName
+}
+
+func (e *DBEntry) addNameLocked(ts uint64, name string) {
+	e.names = append(e.names, &versionedName{
+		ts:   ts,
+		name: name,
+	})
+	e.name = name
+}
+
+// Rename renames the database in txn. The new name is only visible to txn
+// until it is committed
+func (e *DBEntry) Rename(txn txnif.TxnReader, name string) (err error) {
+	e.Lock()
+	defer e.Unlock()
+	if e.Txn != nil {
+		if !e.IsSameTxn(txn) {
+			return txnif.TxnWWConflictErr
+		}
+		switch e.CurrOp {
+		case OpCreate:
+			// The database is not visible to others yet, rename it in place
+			e.name = name
+			e.names[0].name = name
+			return
+		case OpUpdate:
+			e.pendingName = name
+			return
+		default:
+			return ErrNotFound
+		}
+	}
+	if e.HasDropped() {
+		return ErrNotFound
+	}
+	// Another txn renamed the database after txn starts
+	if e.names[len(e.names)-1].ts > txn.GetStartTS() {
+		return txnif.TxnWWConflictErr
+	}
+	e.PrevCommit = &CommitInfo{
+		CurrOp:   e.CurrOp,
+		LogIndex: e.LogIndex,
+	}
+	e.Txn = txn
+	e.CurrOp = OpUpdate
+	e.pendingName = name
+	return
+}
+
 func (e *DBEntry) String() string {
 	e.RLock()
 	defer e.RUnlock()
@@ -207,58 +321,156 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	if n, ok := e.entries[table.GetID()]; !ok {
 		return ErrNotFound
 	} else {
-		nn := e.nameNodes[table.GetSchema().Name]
-		nn.DeleteNode(table.GetID())
-		e.link.Delete(n)
-		if nn.Length() == 0 {
-			delete(e.nameNodes, table.GetSchema().Name)
+		// The table is indexed by all the names it ever had
+		table.RLock()
+		names := make(map[string]bool, len(table.schemas))
+		for _, versioned := range table.schemas {
+			names[versioned.schema.Name] = true
 		}
+		if table.pending != nil {
+			names[table.pending.Name] = true
+		}
+		table.RUnlock()
+		for name := range names {
+			e.deleteNameNodeLocked(name, table.GetID())
+		}
+		e.link.Delete(n)
 	}
 	return
 }
 
-func (e *DBEntry) addEntryLocked(table *TableEntry) error {
-	nn := e.nameNodes[table.schema.Name]
+// RenameTableEntry renames the table of name in txnCtx. The txns started
+// before txnCtx commits still find the table by the old name
+func (e *DBEntry) RenameTableEntry(name, newName string, txnCtx txnif.AsyncTxn) (renamed *TableEntry, err error) {
+	e.Lock()
+	defer e.Unlock()
+	dn := e.txnGetNodeByNameLocked(name, txnCtx)
+	if dn == nil {
+		err = ErrNotFound
+		return
+	}
+	entry := dn.GetPayload().(*TableEntry)
+	if err = e.checkAddEntryLocked(newName, txnCtx); err != nil {
+		return
+	}
+	if err = entry.Rename(txnCtx, newName); err != nil {
+		return
+	}
+	entry.RLock()
+	committed := entry.hasNameLocked(name)
+	entry.RUnlock()
+	// The old name was only given in txnCtx and nobody else can see it
+	if !committed {
+		e.deleteNameNodeLocked(name, entry.GetID())
+	}
+	e.addNameNodeLocked(newName, entry.GetID())
+	renamed = entry
+	return
+}
+
+// addNameNodeLocked indexes the table of id by name. If the table had the
+// name before, its node is moved to the head of the list
+func (e *DBEntry) addNameNodeLocked(name string, id uint64) {
+	nn := e.nameNodes[name]
 	if nn == nil {
-		n := e.link.Insert(table)
-		e.entries[table.GetID()] = n
+		nn = newNodeList(e, &e.nodesMu, name)
+		e.nameNodes[name] = nn
+	} else {
+		nn.DeleteNode(id)
+	}
+	nn.CreateNode(id)
+}
 
-		nn := newNodeList(e, &e.nodesMu, table.schema.Name)
-		e.nameNodes[table.schema.Name] = nn
+func (e *DBEntry) deleteNameNodeLocked(name string, id uint64) {
+	nn := e.nameNodes[name]
+	if nn == nil {
+		return
+	}
+	nn.DeleteNode(id)
+	if nn.Length() == 0 {
+		delete(e.nameNodes, name)
+	}
+}
 
-		nn.CreateNode(table.GetID())
-	} else {
-		node := nn.GetTableNode()
-		record := node.GetPayload().(*TableEntry)
-		record.RLock()
-		err := record.PrepareWrite(table.GetTxn(), record.RWMutex)
-		if err != nil {
-			record.RUnlock()
-			return err
+func (e *DBEntry) removeNameNode(name string, id uint64) {
+	e.Lock()
+	defer e.Unlock()
+	e.deleteNameNodeLocked(name, id)
+}
+
+// CreateTableEntries creates a batch of tables in txnCtx. Either all of the
+// tables are created or none of them is
+func (e *DBEntry) CreateTableEntries(schemas []*Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) (created []*TableEntry, err error) {
+	names := make(map[string]bool, len(schemas))
+	for _, schema := range schemas {
+		if names[schema.Name] {
+			return nil, ErrDuplicate
 		}
-		if record.HasActiveTxn() {
-			if !record.IsDroppedUncommitted() {
-				record.RUnlock()
-				return ErrDuplicate
-			}
-		} else if !record.HasDropped() {
-			record.RUnlock()
+		names[schema.Name] = true
+	}
+	e.Lock()
+	defer e.Unlock()
+	for _, schema := range schemas {
+		if err = e.checkAddEntryLocked(schema.Name, txnCtx); err != nil {
+			return nil, err
+		}
+	}
+	created = make([]*TableEntry, 0, len(schemas))
+	for _, schema := range schemas {
+		table := NewTableEntry(e, schema, txnCtx, dataFactory)
+		if err = e.addEntryLocked(table); err != nil {
+			panic(err)
+		}
+		created = append(created, table)
+	}
+	return
+}
+
+func (e *DBEntry) checkAddEntryLocked(name string, txn txnif.TxnReader) error {
+	nn := e.nameNodes[name]
+	if nn == nil {
+		return nil
+	}
+	node := nn.GetTableNode()
+	record := node.GetPayload().(*TableEntry)
+	record.RLock()
+	defer record.RUnlock()
+	if err := record.PrepareWrite(txn, record.RWMutex); err != nil {
+		return err
+	}
+	// The table was renamed away. Any other table in the list lost the name
+	// before the head took it
+	if record.latestNameLocked(txn) != name {
+		return nil
+	}
+	if record.HasActiveTxn() {
+		if !record.IsDroppedUncommitted() {
 			return ErrDuplicate
 		}
-		record.RUnlock()
-		n := e.link.Insert(table)
-		e.entries[table.GetID()] = n
-		nn.CreateNode(table.GetID())
+	} else if !record.HasDropped() {
+		return ErrDuplicate
 	}
 	return nil
 }
 
+func (e *DBEntry) addEntryLocked(table *TableEntry) error {
+	if err := e.checkAddEntryLocked(table.schema.Name, table.GetTxn()); err != nil {
+		return err
+	}
+	n := e.link.Insert(table)
+	e.entries[table.GetID()] = n
+	e.addNameNodeLocked(table.schema.Name, table.GetID())
+	return nil
+}
+
 func (e *DBEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
 	cmdType := CmdCreateDatabase
 	e.RLock()
 	defer e.RUnlock()
 	if e.CurrOp == OpSoftDelete {
 		cmdType = CmdDropDatabase
+	} else if e.CurrOp == OpUpdate {
+		cmdType = CmdUpdateDatabase
 	}
 	return newDBCmd(id, cmdType, e), nil
 }
@@ -288,12 +500,45 @@ func (e *DBEntry) RecurLoop(processor Processor) (err error) {
 	return err
 }
 
+func (e *DBEntry) PrepareCommit() (err error) {
+	if err = e.BaseEntry.PrepareCommit(); err != nil {
+		return
+	}
+	e.Lock()
+	var stale string
+	if e.CurrOp == OpUpdate && e.pendingName != "" {
+		e.addNameLocked(e.Txn.GetCommitTS(), e.pendingName)
+	} else {
+		// The database is renamed and then dropped in the same txn
+		stale = e.staleNameLocked()
+	}
+	e.pendingName = ""
+	e.Unlock()
+	if stale != "" {
+		e.catalog.removeNameNode(stale, e.ID)
+	}
+	return
+}
+
+func (e *DBEntry) ApplyCommit(index *wal.Index) (err error) {
+	e.Lock()
+	if e.CurrOp == OpUpdate {
+		e.CurrOp = e.PrevCommit.CurrOp
+	}
+	e.Unlock()
+	return e.BaseEntry.ApplyCommit(index)
+}
+
 func (e *DBEntry) PrepareRollback() (err error) {
-	e.RLock()
+	e.Lock()
 	currOp := e.CurrOp
-	e.RUnlock()
+	stale := e.staleNameLocked()
+	e.pendingName = ""
+	e.Unlock()
 	if currOp == OpCreate {
 		err = e.catalog.RemoveEntry(e)
+	} else if stale != "" {
+		e.catalog.removeNameNode(stale, e.ID)
 	}
 	if err = e.BaseEntry.PrepareRollback(); err != nil {
 		return
@@ -305,12 +550,20 @@ func (entry *DBEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.BaseEntry.WriteTo(w); err != nil {
 		return
 	}
-	if err = binary.Write(w, binary.BigEndian, uint16(len(entry.name))); err != nil {
+	if err = binary.Write(w, binary.BigEndian, uint32(len(entry.names))); err != nil {
 		return
 	}
-	var sn int
-	sn, err = w.Write([]byte(entry.name))
-	n += int64(sn) + 2
+	n += 4
+	for _, versioned := range entry.names {
+		if err = binary.Write(w, binary.BigEndian, versioned.ts); err != nil {
+			return
+		}
+		var sn int64
+		if sn, err = common.WriteString(versioned.name, w); err != nil {
+			return
+		}
+		n += sn + 8
+	}
 	return
 }
 
@@ -318,17 +571,25 @@ func (entry *DBEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	size := uint16(0)
-	if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+	cnt := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
 		return
 	}
-	n += 2
-	buf := make([]byte, size)
-	if _, err = r.Read(buf); err != nil {
-		return
+	n += 4
+	entry.names = make([]*versionedName, 0, cnt)
+	for i := uint32(0); i < cnt; i++ {
+		versioned := new(versionedName)
+		if err = binary.Read(r, binary.BigEndian, &versioned.ts); err != nil {
+			return
+		}
+		var sn int64
+		if versioned.name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn + 8
+		entry.names = append(entry.names, versioned)
 	}
-	n += int64(size)
-	entry.name = string(buf)
+	entry.name = entry.names[len(entry.names)-1].name
 	return
 }
 
@@ -340,6 +601,7 @@ func (entry *DBEntry) Clone() CheckpointItem {
 	cloned := &DBEntry{
 		BaseEntry: entry.BaseEntry.Clone(),
 		name:      entry.name,
+		names:     entry.names,
 	}
 	return cloned
 }
@@ -348,6 +610,7 @@ func (entry *DBEntry) CloneCreate() CheckpointItem {
 	cloned := &DBEntry{
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		name:      entry.name,
+		names:     entry.names,
 	}
 	return cloned
 }
@@ -24,5 +24,12 @@ var (
 
 	ErrValidation = errors.New("tae catalog: validataion")
 
+	ErrNotNullableColumn = errors.New("tae catalog: column is neither nullable nor with default")
+	ErrDropSortKey       = errors.New("tae catalog: cannot drop sort key column")
+	ErrDropHiddenColumn  = errors.New("tae catalog: cannot drop hidden column")
+	ErrNoIndex           = errors.New("tae catalog: column has no index")
+	ErrInvalidPartition  = errors.New("tae catalog: invalid partition")
+	ErrDropPartitionKey  = errors.New("tae catalog: cannot drop partition key column")
+
 	ErrStopCurrRecur = errors.New("tae catalog: stop current recursion")
 )
@@ -130,6 +130,22 @@ func (h *mockDBHandle) CreateRelation(def interface{}) (rel handle.Relation, err
 	return
 }
 
+func (h *mockDBHandle) CreateRelations(defs []interface{}) (rels []handle.Relation, err error) {
+	schemas := make([]*Schema, len(defs))
+	for i, def := range defs {
+		schemas[i] = def.(*Schema)
+	}
+	tbls, err := h.entry.CreateTableEntries(schemas, h.Txn, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, tbl := range tbls {
+		h.Txn.GetStore().AddTxnEntry(0, tbl)
+		rels = append(rels, newMockTableHandle(h.catalog, h.Txn, tbl))
+	}
+	return
+}
+
 func (h *mockDBHandle) DropRelationByName(name string) (rel handle.Relation, err error) {
 	entry, err := h.entry.DropTableEntry(name, h.Txn)
 	if err != nil {
@@ -140,6 +156,16 @@ func (h *mockDBHandle) DropRelationByName(name string) (rel handle.Relation, err
 	return
 }
 
+func (h *mockDBHandle) RenameRelation(name, newName string) (rel handle.Relation, err error) {
+	entry, err := h.entry.RenameTableEntry(name, newName, h.Txn)
+	if err != nil {
+		return nil, err
+	}
+	h.Txn.GetStore().AddTxnEntry(0, entry)
+	rel = newMockTableHandle(h.catalog, h.Txn, entry)
+	return
+}
+
 func (h *mockDBHandle) String() string {
 	return h.entry.String()
 }
@@ -192,6 +218,15 @@ func (txn *mockTxn) DropDatabase(name string) (handle.Database, error) {
 	return newMockDBHandle(txn.catalog, txn, entry), nil
 }
 
+func (txn *mockTxn) RenameDatabase(name, newName string) (handle.Database, error) {
+	entry, err := txn.catalog.RenameDBEntry(name, newName, txn)
+	if err != nil {
+		return nil, err
+	}
+	txn.Store.AddTxnEntry(0, entry)
+	return newMockDBHandle(txn.catalog, txn, entry), nil
+}
+
 func MockData(schema *Schema, rows uint32) *batch.Batch {
 	return compute.MockBatch(schema.Types(), uint64(rows), int(schema.PrimaryKey), nil)
 }
@@ -143,6 +143,11 @@ func (n *nodeList) TxnGetTableNodeLocked(txnCtx txnif.TxnReader) *common.DLNode
 		entry := dlNode.GetPayload().(*TableEntry)
 		entry.RLock()
 		goNext = true
+		// The table has another name in the txn
+		if entry.txnGetSchemaLocked(txnCtx).Name != n.name {
+			entry.RUnlock()
+			return
+		}
 		// A txn is writing the entry
 		if entry.HasActiveTxn() {
 			// If the same txn is writing the entry:
@@ -227,6 +232,11 @@ func (n *nodeList) TxnGetDBNodeLocked(txnCtx txnif.TxnReader) *common.DLNode {
 		entry := dlNode.GetPayload().(*DBEntry)
 		entry.RLock()
 		goNext = true
+		// The database has another name in the txn
+		if entry.txnGetNameLocked(txnCtx) != n.name {
+			entry.RUnlock()
+			return
+		}
 		if entry.HasActiveTxn() {
 			if entry.IsSameTxn(txnCtx) {
 				if entry.IsDroppedUncommitted() {
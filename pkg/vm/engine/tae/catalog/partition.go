@@ -0,0 +1,211 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+type PartitionType int8
+
+const (
+	NoPartition PartitionType = iota
+	RangePartition
+	HashPartition
+)
+
+// PartitionDef partitions the rows of a table by the value of a column. The
+// rows of each partition are appended to the segments of the partition.
+// The NULLs are in partition 0
+type PartitionDef struct {
+	Type PartitionType `json:"type"`
+	Col  int32         `json:"col"`
+	// Bounds are the ascending exclusive upper bounds of the range
+	// partitions. The last range partition is unbounded
+	Bounds []interface{} `json:"bounds"`
+	// Num is the number of the hash partitions
+	Num uint32 `json:"num"`
+}
+
+// Count returns the number of partitions
+func (def *PartitionDef) Count() uint32 {
+	if def.Type == RangePartition {
+		return uint32(len(def.Bounds)) + 1
+	}
+	return def.Num
+}
+
+func (def *PartitionDef) Clone() *PartitionDef {
+	cloned := *def
+	if def.Bounds != nil {
+		cloned.Bounds = make([]interface{}, len(def.Bounds))
+		copy(cloned.Bounds, def.Bounds)
+	}
+	return &cloned
+}
+
+// Locate returns the partition of v of type typ. v is nil for NULL
+func (def *PartitionDef) Locate(v interface{}, typ types.Type) uint32 {
+	if v == nil {
+		return 0
+	}
+	if def.Type == RangePartition {
+		return uint32(sort.Search(len(def.Bounds), func(i int) bool {
+			return common.CompareGeneric(def.Bounds[i], v, typ) > 0
+		}))
+	}
+	h, _ := common.Hash(v, typ)
+	return uint32(h % uint64(def.Num))
+}
+
+// MayMatch returns false if no row of partition satisfies filter
+func (def *PartitionDef) MayMatch(partition uint32, typ types.Type, filter *handle.Filter) bool {
+	if filter.Op == handle.FilterIsNull {
+		return partition == 0
+	}
+	v, ok := partitionValue(filter.Val, typ)
+	if !ok {
+		return true
+	}
+	if filter.Op == handle.FilterEq {
+		return def.Locate(v, typ) == partition
+	}
+	if def.Type != RangePartition {
+		return true
+	}
+	// The rows of range partition i are in [Bounds[i-1], Bounds[i])
+	hasLower, hasUpper := partition > 0, int(partition) < len(def.Bounds)
+	switch filter.Op {
+	case handle.FilterLt:
+		return !hasLower || common.CompareGeneric(def.Bounds[partition-1], v, typ) < 0
+	case handle.FilterLe:
+		return !hasLower || common.CompareGeneric(def.Bounds[partition-1], v, typ) <= 0
+	case handle.FilterGt, handle.FilterGe:
+		return !hasUpper || common.CompareGeneric(def.Bounds[partition], v, typ) > 0
+	}
+	return true
+}
+
+func (def *PartitionDef) WriteTo(w io.Writer, typ types.Type) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, def.Col); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, def.Num); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint16(len(def.Bounds))); err != nil {
+		return
+	}
+	n = 4 + 4 + 2
+	for _, bound := range def.Bounds {
+		var buf []byte
+		if buf, err = common.EncodeKey(bound, typ); err != nil {
+			return
+		}
+		var sn int64
+		if sn, err = common.WriteString(string(buf), w); err != nil {
+			return
+		}
+		n += sn
+	}
+	return
+}
+
+// ReadFrom reads the definition except the type. The types of the columns
+// should be read already
+func (def *PartitionDef) ReadFrom(r io.Reader, colDefs []*ColDef) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &def.Col); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &def.Num); err != nil {
+		return
+	}
+	cnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n = 4 + 4 + 2
+	if int(def.Col) >= len(colDefs) {
+		err = ErrInvalidPartition
+		return
+	}
+	typ := colDefs[def.Col].Type
+	def.Bounds = nil
+	for i := uint16(0); i < cnt; i++ {
+		var buf string
+		var sn int64
+		if buf, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		def.Bounds = append(def.Bounds, common.DecodeKey([]byte(buf), typ))
+	}
+	return
+}
+
+func isPartitionType(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar:
+		return true
+	}
+	return false
+}
+
+// partitionValue returns v converted to a value of typ. It returns false if
+// v is not a value of typ
+func partitionValue(v interface{}, typ types.Type) (interface{}, bool) {
+	ok := false
+	switch typ.Oid {
+	case types.T_int8:
+		_, ok = v.(int8)
+	case types.T_int16:
+		_, ok = v.(int16)
+	case types.T_int32:
+		_, ok = v.(int32)
+	case types.T_int64:
+		_, ok = v.(int64)
+	case types.T_uint8:
+		_, ok = v.(uint8)
+	case types.T_uint16:
+		_, ok = v.(uint16)
+	case types.T_uint32:
+		_, ok = v.(uint32)
+	case types.T_uint64:
+		_, ok = v.(uint64)
+	case types.T_float32:
+		_, ok = v.(float32)
+	case types.T_float64:
+		_, ok = v.(float64)
+	case types.T_date:
+		_, ok = v.(types.Date)
+	case types.T_datetime:
+		_, ok = v.(types.Datetime)
+	case types.T_char, types.T_varchar:
+		if s, isStr := v.(string); isStr {
+			v = []byte(s)
+		}
+		_, ok = v.([]byte)
+	}
+	return v, ok
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	schema := MockSchema(2)
+	assert.Equal(t, ErrInvalidPartition, schema.SetRangePartition("mock_1", int32(20), int32(10)))
+	assert.Equal(t, ErrInvalidPartition, schema.SetHashPartition("mock_1", 0))
+	assert.Equal(t, ErrNotFound, schema.SetHashPartition("xxx", 2))
+	assert.False(t, schema.IsPartitioned())
+
+	assert.Nil(t, schema.SetRangePartition("mock_1", int32(10), int32(20)))
+	def := schema.Partition
+	typ := schema.ColDefs[1].Type
+	assert.Equal(t, uint32(3), def.Count())
+	assert.Equal(t, uint32(0), def.Locate(int32(9), typ))
+	assert.Equal(t, uint32(1), def.Locate(int32(10), typ))
+	assert.Equal(t, uint32(2), def.Locate(int32(100), typ))
+	assert.Equal(t, uint32(0), def.Locate(nil, typ))
+
+	eq := handle.NewColumnFilter("mock_1", handle.FilterEq, int32(15))
+	assert.False(t, schema.MayMatchPartition(0, eq))
+	assert.True(t, schema.MayMatchPartition(1, eq))
+	lt := handle.NewColumnFilter("mock_1", handle.FilterLt, int32(10))
+	assert.True(t, schema.MayMatchPartition(0, lt))
+	assert.False(t, schema.MayMatchPartition(1, lt))
+	ge := handle.NewColumnFilter("mock_1", handle.FilterGe, int32(20))
+	assert.False(t, schema.MayMatchPartition(1, ge))
+	assert.True(t, schema.MayMatchPartition(2, ge))
+	other := handle.NewColumnFilter("mock_0", handle.FilterEq, int32(15))
+	assert.True(t, schema.MayMatchPartition(0, other))
+
+	assert.Equal(t, ErrDropPartitionKey, schema.DropColumn("mock_1"))
+
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	schema2 := NewEmptySchema("")
+	_, err = schema2.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, def.Type, schema2.Partition.Type)
+	assert.Equal(t, def.Col, schema2.Partition.Col)
+	assert.Equal(t, def.Bounds, schema2.Partition.Bounds)
+
+	schema = MockSchema(3)
+	assert.Nil(t, schema.SetHashPartition("mock_2", 4))
+	assert.Equal(t, uint32(4), schema.Partition.Count())
+	p := schema.Partition.Locate(int32(7), typ)
+	assert.True(t, p < 4)
+	eq = handle.NewColumnFilter("mock_2", handle.FilterEq, int32(7))
+	for i := uint32(0); i < 4; i++ {
+		assert.Equal(t, i == p, schema.MayMatchPartition(i, eq))
+	}
+	assert.Nil(t, schema.DropColumn("mock_1"))
+	assert.Equal(t, int32(1), schema.Partition.Col)
+}
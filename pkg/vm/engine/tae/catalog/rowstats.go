@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import "sync/atomic"
+
+// RowStats is the number of the committed rows of an entry and the
+// estimated size of their data. It is maintained incrementally by the
+// committed appends, deletes and compactions of the blocks, so that it is
+// read in O(1) at any level
+type RowStats struct {
+	rows int64
+	size int64
+}
+
+func (stats *RowStats) Rows() int64 { return atomic.LoadInt64(&stats.rows) }
+func (stats *RowStats) Size() int64 { return atomic.LoadInt64(&stats.size) }
+
+func (stats *RowStats) add(rows, size int64) {
+	atomic.AddInt64(&stats.rows, rows)
+	atomic.AddInt64(&stats.size, size)
+}
+
+// ColumnSize estimates the size of the data of column attr by the share of
+// its type size in the row. It returns the size of all the columns if attr
+// is empty and 0 if attr is not found
+func (stats *RowStats) ColumnSize(schema *Schema, attr string) int64 {
+	if attr == "" {
+		return stats.Size()
+	}
+	idx, ok := schema.NameIndex[attr]
+	if !ok {
+		return 0
+	}
+	width := estimateRowSize(schema)
+	if width == 0 {
+		return 0
+	}
+	return stats.Size() * int64(schema.ColDefs[idx].Type.Size) / width
+}
+
+// estimateRowSize is the size of a row estimated the same way as the size of
+// an appended batch
+func estimateRowSize(schema *Schema) int64 {
+	size := int64(0)
+	for _, def := range schema.ColDefs {
+		size += int64(def.Type.Size)
+	}
+	return size
+}
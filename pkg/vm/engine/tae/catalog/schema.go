@@ -23,9 +23,15 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 type IndexT uint16
@@ -53,14 +59,62 @@ func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	return index
 }
 
+const (
+	CompressDefault int8 = iota
+	CompressNone
+	CompressLz4
+	CompressZstd
+)
+
+// CompositeKeyColumnName is the hidden sort key column of a schema with a
+// composite key
+const CompositeKeyColumnName = "__mo_cpkey"
+
 type ColDef struct {
-	Name          string
-	Idx           int
+	Name string
+	Idx  int
+	// SeqNum is the stable id of the column in its table. It is never
+	// reused, so a column dropped and added back with the same name is a
+	// different column to the blocks created before
+	SeqNum        uint16
 	Type          types.Type
 	Hidden        int8
 	NullAbility   int8
 	AutoIncrement int8
-	Comment       string
+	// Index is the name of the secondary index of the column, empty if it
+	// has none. The blocks keep a bloom filter of an indexed column and an
+	// index mapping its values to the rows
+	Index string
+	// Compression is the algorithm the column data is compressed by. The
+	// data of CompressDefault is compressed by lz4
+	Compression int8
+	Comment     string
+	// Default is the encoded default value of the column. A nil Default
+	// means NULL
+	Default []byte
+}
+
+func (def *ColDef) IsNullable() bool { return def.NullAbility != 0 }
+func (def *ColDef) HasDefault() bool { return def.Default != nil }
+func (def *ColDef) HasIndex() bool   { return def.Index != "" }
+
+// CompressAlgo returns the compress algorithm of the column data
+func (def *ColDef) CompressAlgo() int {
+	switch def.Compression {
+	case CompressNone:
+		return compress.None
+	case CompressZstd:
+		return compress.Zstd
+	}
+	return compress.Lz4
+}
+
+// DefaultValue returns the decoded default value or nil if the default is NULL
+func (def *ColDef) DefaultValue() interface{} {
+	if def.Default == nil {
+		return nil
+	}
+	return common.DecodeKey(def.Default, def.Type)
 }
 
 type Schema struct {
@@ -71,6 +125,16 @@ type Schema struct {
 	PrimaryKey       int32          `json:"primarykey"`
 	SegmentMaxBlocks uint16         `json:"segblocks"`
 	Comment          string         `json:"comment"`
+	// Version is bumped each time the column definitions are altered
+	Version uint32 `json:"version"`
+	// NextColSeqNum is the SeqNum of the next column added
+	NextColSeqNum uint16 `json:"nextseq"`
+	// CompositeKeys are the indexes of the columns of a composite sort key.
+	// The encoded key tuple is stored in the hidden column PrimaryKey
+	CompositeKeys []int32 `json:"cpkeys"`
+	// Partition partitions the rows by the value of a column. It is nil if
+	// the table is not partitioned
+	Partition *PartitionDef `json:"partition"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -91,11 +155,28 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &s.SegmentMaxBlocks); err != nil {
 		return
 	}
+	if err = binary.Read(r, binary.BigEndian, &s.Version); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.NextColSeqNum); err != nil {
+		return
+	}
+	keyCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &keyCnt); err != nil {
+		return
+	}
+	s.CompositeKeys = nil
+	if keyCnt > 0 {
+		s.CompositeKeys = make([]int32, keyCnt)
+		if err = binary.Read(r, binary.BigEndian, s.CompositeKeys); err != nil {
+			return
+		}
+	}
 	var sn int64
 	if s.Name, sn, err = common.ReadString(r); err != nil {
 		return
 	}
-	n = sn + 4 + 4 + 4 + 2
+	n = sn + 4 + 4 + 2 + 4 + 2 + 2 + 4*int64(keyCnt)
 	if s.Comment, sn, err = common.ReadString(r); err != nil {
 		return
 	}
@@ -104,6 +185,9 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
 	}
+	if s.NameIndex == nil {
+		s.NameIndex = make(map[string]int)
+	}
 	colBuf := make([]byte, encoding.TypeSize)
 	for i := uint16(0); i < colCnt; i++ {
 		if _, err = r.Read(colBuf); err != nil {
@@ -112,6 +196,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		n += int64(encoding.TypeSize)
 		colDef := new(ColDef)
 		colDef.Type = encoding.DecodeType(colBuf)
+		if err = binary.Read(r, binary.BigEndian, &colDef.SeqNum); err != nil {
+			return
+		}
+		n += 2
 		if colDef.Name, sn, err = common.ReadString(r); err != nil {
 			return
 		}
@@ -132,8 +220,43 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
+		if colDef.Index, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &colDef.Compression); err != nil {
+			return
+		}
+		n += 1
+		hasDefault := int8(0)
+		if err = binary.Read(r, binary.BigEndian, &hasDefault); err != nil {
+			return
+		}
+		n += 1
+		if hasDefault != 0 {
+			var def string
+			if def, sn, err = common.ReadString(r); err != nil {
+				return
+			}
+			n += sn
+			colDef.Default = []byte(def)
+		}
 		s.ColDefs = append(s.ColDefs, colDef)
 		colDef.Idx = int(i)
+		s.NameIndex[colDef.Name] = colDef.Idx
+	}
+	partitionType := NoPartition
+	if err = binary.Read(r, binary.BigEndian, &partitionType); err != nil {
+		return
+	}
+	n += 1
+	s.Partition = nil
+	if partitionType != NoPartition {
+		s.Partition = &PartitionDef{Type: partitionType}
+		if sn, err = s.Partition.ReadFrom(r, s.ColDefs); err != nil {
+			return
+		}
+		n += sn
 	}
 	return
 }
@@ -149,6 +272,20 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if err = binary.Write(&w, binary.BigEndian, s.SegmentMaxBlocks); err != nil {
 		return
 	}
+	if err = binary.Write(&w, binary.BigEndian, s.Version); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.NextColSeqNum); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.CompositeKeys))); err != nil {
+		return
+	}
+	if len(s.CompositeKeys) > 0 {
+		if err = binary.Write(&w, binary.BigEndian, s.CompositeKeys); err != nil {
+			return
+		}
+	}
 	if _, err = common.WriteString(s.Name, &w); err != nil {
 		return
 	}
@@ -162,6 +299,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = w.Write(encoding.EncodeType(colDef.Type)); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.SeqNum); err != nil {
+			return
+		}
 		if _, err = common.WriteString(colDef.Name, &w); err != nil {
 			return
 		}
@@ -177,6 +317,36 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, colDef.AutoIncrement); err != nil {
 			return
 		}
+		if _, err = common.WriteString(colDef.Index, &w); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.Compression); err != nil {
+			return
+		}
+		if !colDef.HasDefault() {
+			if err = binary.Write(&w, binary.BigEndian, int8(0)); err != nil {
+				return
+			}
+			continue
+		}
+		if err = binary.Write(&w, binary.BigEndian, int8(1)); err != nil {
+			return
+		}
+		if _, err = common.WriteString(string(colDef.Default), &w); err != nil {
+			return
+		}
+	}
+	partitionType := NoPartition
+	if s.Partition != nil {
+		partitionType = s.Partition.Type
+	}
+	if err = binary.Write(&w, binary.BigEndian, partitionType); err != nil {
+		return
+	}
+	if s.Partition != nil {
+		if _, err = s.Partition.WriteTo(&w, s.ColDefs[s.Partition.Col].Type); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -184,14 +354,337 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 
 func (s *Schema) AppendCol(name string, typ types.Type) {
 	colDef := &ColDef{
-		Name: name,
-		Type: typ,
-		Idx:  len(s.ColDefs),
+		Name:   name,
+		Type:   typ,
+		Idx:    len(s.ColDefs),
+		SeqNum: s.NextColSeqNum,
 	}
+	s.NextColSeqNum++
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
 }
 
+// Clone returns a deep copy of the schema
+func (s *Schema) Clone() *Schema {
+	cloned := *s
+	cloned.ColDefs = make([]*ColDef, len(s.ColDefs))
+	cloned.NameIndex = make(map[string]int)
+	for i, colDef := range s.ColDefs {
+		def := *colDef
+		cloned.ColDefs[i] = &def
+		cloned.NameIndex[def.Name] = def.Idx
+	}
+	if s.CompositeKeys != nil {
+		cloned.CompositeKeys = make([]int32, len(s.CompositeKeys))
+		copy(cloned.CompositeKeys, s.CompositeKeys)
+	}
+	if s.Partition != nil {
+		cloned.Partition = s.Partition.Clone()
+	}
+	return &cloned
+}
+
+// SetCompositeKey makes the named columns the composite sort key of the
+// schema. A hidden column of the memcomparable encoded key tuple is appended
+// as the sort key, so that the sorting, dedup and filtering of the engine
+// work on the composite key as on a single column key
+func (s *Schema) SetCompositeKey(names ...string) error {
+	if len(names) < 2 {
+		return ErrValidation
+	}
+	if s.IsCompositeKey() {
+		return ErrDuplicate
+	}
+	if _, ok := s.NameIndex[CompositeKeyColumnName]; ok {
+		return ErrDuplicate
+	}
+	keys := make([]int32, len(names))
+	for i, name := range names {
+		idx, ok := s.NameIndex[name]
+		if !ok {
+			return ErrNotFound
+		}
+		keys[i] = int32(idx)
+	}
+	s.CompositeKeys = keys
+	s.AppendCol(CompositeKeyColumnName, types.Type{Oid: types.T_varchar, Size: 24})
+	s.ColDefs[len(s.ColDefs)-1].Hidden = 1
+	s.PrimaryKey = int32(len(s.ColDefs) - 1)
+	return nil
+}
+
+func (s *Schema) IsCompositeKey() bool { return len(s.CompositeKeys) > 0 }
+
+// AddIndex adds the secondary index name on the column col other than the
+// sort key. The blocks created since keep an index mapping the values of
+// the column to their rows, and the blocks created before are scanned until
+// they are compacted
+func (s *Schema) AddIndex(name, col string) error {
+	idx, ok := s.NameIndex[col]
+	if !ok {
+		return ErrNotFound
+	}
+	if name == "" || idx == int(s.PrimaryKey) || !IsIndexable(s.ColDefs[idx].Type) {
+		return ErrValidation
+	}
+	for _, def := range s.ColDefs {
+		if def.Index == name {
+			return ErrDuplicate
+		}
+	}
+	def := s.ColDefs[idx]
+	if def.HasIndex() {
+		return ErrDuplicate
+	}
+	def.Index = name
+	return nil
+}
+
+// DropIndex drops the secondary index name
+func (s *Schema) DropIndex(name string) error {
+	for _, def := range s.ColDefs {
+		if def.Index == name {
+			def.Index = ""
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// GetIndexColIdx returns the index of the column of the secondary index
+// name, -1 if there is no such index
+func (s *Schema) GetIndexColIdx(name string) int {
+	for _, def := range s.ColDefs {
+		if def.Index == name {
+			return def.Idx
+		}
+	}
+	return -1
+}
+
+// IsIndexable returns true if the values of typ can be indexed
+func IsIndexable(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar:
+		return true
+	}
+	return false
+}
+
+// SetCompression sets the compress algorithm of the data of the named
+// column. algo is one of the names of compress.Algorithms
+func (s *Schema) SetCompression(name, algo string) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	typ, ok := compress.Algorithms[algo]
+	if !ok {
+		return ErrValidation
+	}
+	def := s.ColDefs[idx]
+	switch typ {
+	case compress.None:
+		def.Compression = CompressNone
+	case compress.Lz4:
+		def.Compression = CompressLz4
+	case compress.Zstd:
+		def.Compression = CompressZstd
+	}
+	return nil
+}
+
+// IndexedColumns returns the indexes of the columns with a secondary index
+func (s *Schema) IndexedColumns() []int {
+	idxes := make([]int, 0)
+	for _, def := range s.ColDefs {
+		if def.HasIndex() {
+			idxes = append(idxes, def.Idx)
+		}
+	}
+	return idxes
+}
+
+// EncodeCompositeKey encodes the values of the composite key columns, in the
+// order of the key columns, as stored in the sort key column
+func (s *Schema) EncodeCompositeKey(vals ...interface{}) []byte {
+	buf := make([]byte, 0, 64)
+	for i, idx := range s.CompositeKeys {
+		buf = compute.AppendTupleKey(buf, vals[i], s.ColDefs[idx].Type)
+	}
+	return buf
+}
+
+// FillCompositeKey returns a batch with the composite sort key column built
+// from the key columns of bat if bat is short of it. bat is returned as it
+// is if the schema has no composite key
+func (s *Schema) FillCompositeKey(bat *gbat.Batch) *gbat.Batch {
+	if !s.IsCompositeKey() || len(bat.Vecs) != len(s.ColDefs)-1 {
+		return bat
+	}
+	keys := make([]*gvec.Vector, len(s.CompositeKeys))
+	for i, idx := range s.CompositeKeys {
+		if idx > s.PrimaryKey {
+			idx--
+		}
+		keys[i] = bat.Vecs[idx]
+	}
+	filled := gbat.New(true, s.Attrs())
+	filled.Vecs = make([]*gvec.Vector, 0, len(s.ColDefs))
+	filled.Vecs = append(filled.Vecs, bat.Vecs[:s.PrimaryKey]...)
+	filled.Vecs = append(filled.Vecs, compute.EncodeCompositeKey(keys))
+	filled.Vecs = append(filled.Vecs, bat.Vecs[s.PrimaryKey:]...)
+	return filled
+}
+
+// SetRangePartition partitions the rows by the ranges of column name. The
+// bounds are the ascending exclusive upper bounds of the partitions and the
+// last partition is unbounded
+func (s *Schema) SetRangePartition(name string, bounds ...interface{}) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	typ := s.ColDefs[idx].Type
+	if !isPartitionType(typ) {
+		return ErrInvalidPartition
+	}
+	def := &PartitionDef{
+		Type:   RangePartition,
+		Col:    int32(idx),
+		Bounds: make([]interface{}, len(bounds)),
+	}
+	for i, bound := range bounds {
+		if def.Bounds[i], ok = partitionValue(bound, typ); !ok {
+			return ErrInvalidPartition
+		}
+		if i > 0 && common.CompareGeneric(def.Bounds[i-1], def.Bounds[i], typ) >= 0 {
+			return ErrInvalidPartition
+		}
+	}
+	s.Partition = def
+	return nil
+}
+
+// SetHashPartition partitions the rows into num partitions by the hash of
+// column name
+func (s *Schema) SetHashPartition(name string, num uint32) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	if num == 0 || !isPartitionType(s.ColDefs[idx].Type) {
+		return ErrInvalidPartition
+	}
+	s.Partition = &PartitionDef{
+		Type: HashPartition,
+		Col:  int32(idx),
+		Num:  num,
+	}
+	return nil
+}
+
+func (s *Schema) IsPartitioned() bool { return s.Partition != nil }
+
+// LocatePartitions returns the partition of each row of bat
+func (s *Schema) LocatePartitions(bat *gbat.Batch) []uint32 {
+	vec := bat.Vecs[s.Partition.Col]
+	typ := s.ColDefs[s.Partition.Col].Type
+	partitions := make([]uint32, gvec.Length(vec))
+	for i := range partitions {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		v, _ := partitionValue(compute.GetValue(vec, uint32(i)), typ)
+		partitions[i] = s.Partition.Locate(v, typ)
+	}
+	return partitions
+}
+
+// MayMatchPartition returns false if the filters on the partition column
+// prove no row of partition satisfies them
+func (s *Schema) MayMatchPartition(partition uint32, filters ...*handle.Filter) bool {
+	if s.Partition == nil {
+		return true
+	}
+	colDef := s.ColDefs[s.Partition.Col]
+	for _, filter := range filters {
+		if filter.Attr != colDef.Name {
+			continue
+		}
+		if !s.Partition.MayMatch(partition, colDef.Type, filter) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddColumn appends a column definition to the schema. Only nullable
+// columns or columns with a default value can be added because the rows
+// in the existing blocks need a value for the new column
+func (s *Schema) AddColumn(def *ColDef) error {
+	if _, ok := s.NameIndex[def.Name]; ok {
+		return ErrDuplicate
+	}
+	if !def.IsNullable() && !def.HasDefault() {
+		return ErrNotNullableColumn
+	}
+	if def.HasDefault() && len(def.Default) != int(def.Type.Size) && def.Type.Oid != types.T_char && def.Type.Oid != types.T_varchar {
+		return ErrValidation
+	}
+	colDef := *def
+	colDef.Idx = len(s.ColDefs)
+	colDef.SeqNum = s.NextColSeqNum
+	s.NextColSeqNum++
+	s.ColDefs = append(s.ColDefs, &colDef)
+	s.NameIndex[colDef.Name] = colDef.Idx
+	return nil
+}
+
+// DropColumn removes the named column from the schema. The sort key and the
+// hidden columns maintained by the engine cannot be dropped
+func (s *Schema) DropColumn(name string) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	if idx == int(s.PrimaryKey) {
+		return ErrDropSortKey
+	}
+	if s.ColDefs[idx].Hidden != 0 {
+		return ErrDropHiddenColumn
+	}
+	for _, key := range s.CompositeKeys {
+		if int(key) == idx {
+			return ErrDropSortKey
+		}
+	}
+	if s.Partition != nil && int(s.Partition.Col) == idx {
+		return ErrDropPartitionKey
+	}
+	s.ColDefs = append(s.ColDefs[:idx], s.ColDefs[idx+1:]...)
+	delete(s.NameIndex, name)
+	for i := idx; i < len(s.ColDefs); i++ {
+		s.ColDefs[i].Idx = i
+		s.NameIndex[s.ColDefs[i].Name] = i
+	}
+	if int(s.PrimaryKey) > idx {
+		s.PrimaryKey--
+	}
+	for i, key := range s.CompositeKeys {
+		if int(key) > idx {
+			s.CompositeKeys[i]--
+		}
+	}
+	if s.Partition != nil && int(s.Partition.Col) > idx {
+		s.Partition.Col--
+	}
+	return nil
+}
+
 func (s *Schema) String() string {
 	buf, _ := json.Marshal(s)
 	return string(buf)
@@ -253,6 +746,17 @@ func (s *Schema) GetColIdx(attr string) int {
 	return idx
 }
 
+// GetColIdxBySeqNum returns the index of the column with the stable id
+// seqNum, or -1 if the schema has no such column
+func (s *Schema) GetColIdxBySeqNum(seqNum uint16) int {
+	for _, def := range s.ColDefs {
+		if def.SeqNum == seqNum {
+			return def.Idx
+		}
+	}
+	return -1
+}
+
 func MockSchema(colCnt int) *Schema {
 	rand.Seed(time.Now().UnixNano())
 	schema := NewEmptySchema(fmt.Sprintf("%d", rand.Intn(1000000)))
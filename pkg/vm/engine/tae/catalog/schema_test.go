@@ -0,0 +1,43 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColCompression(t *testing.T) {
+	schema := MockSchema(3)
+	assert.Equal(t, ErrNotFound, schema.SetCompression("xxx", "zstd"))
+	assert.Equal(t, ErrValidation, schema.SetCompression("mock_0", "xxx"))
+	assert.Nil(t, schema.SetCompression("mock_0", "none"))
+	assert.Nil(t, schema.SetCompression("mock_1", "zstd"))
+	assert.Equal(t, compress.None, schema.ColDefs[0].CompressAlgo())
+	assert.Equal(t, compress.Zstd, schema.ColDefs[1].CompressAlgo())
+	assert.Equal(t, compress.Lz4, schema.ColDefs[2].CompressAlgo())
+
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	schema2 := NewEmptySchema("")
+	_, err = schema2.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	for i, def := range schema.ColDefs {
+		assert.Equal(t, def.CompressAlgo(), schema2.ColDefs[i].CompressAlgo())
+	}
+}
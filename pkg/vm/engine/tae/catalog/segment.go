@@ -22,6 +22,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
@@ -35,6 +36,9 @@ type SegmentEntry struct {
 	link    *common.Link
 	state   EntryState
 	segData data.Segment
+	// partition is the table partition the rows of the segment belong to
+	partition uint32
+	rowStats  RowStats
 }
 
 func NewSegmentEntry(table *TableEntry, txn txnif.AsyncTxn, state EntryState, dataFactory SegmentDataFactory) *SegmentEntry {
@@ -163,6 +167,20 @@ func (entry *SegmentEntry) String() string {
 	return entry.StringLocked()
 }
 
+func (entry *SegmentEntry) GetPartition() uint32 { return entry.partition }
+
+// SetPartition sets the partition of a segment created by an uncommitted
+// txn. It should be called before the txn commits
+func (entry *SegmentEntry) SetPartition(partition uint32) { entry.partition = partition }
+
+// MayMatch returns false if the segment is in a partition pruned by filters
+func (entry *SegmentEntry) MayMatch(txn txnif.AsyncTxn, filters ...*handle.Filter) bool {
+	return entry.table.TxnGetSchema(txn).MayMatchPartition(entry.partition, filters...)
+}
+
+// GetRowStats returns the statistics of the committed rows of the segment
+func (entry *SegmentEntry) GetRowStats() *RowStats { return &entry.rowStats }
+
 func (entry *SegmentEntry) IsAppendable() bool {
 	return entry.state == ES_Appendable
 }
@@ -202,9 +220,10 @@ func (entry *SegmentEntry) LastAppendableBlock() (blk *BlockEntry) {
 }
 
 func (entry *SegmentEntry) CreateBlock(txn txnif.AsyncTxn, state EntryState, dataFactory BlockDataFactory) (created *BlockEntry, err error) {
+	schema := entry.table.TxnGetSchema(txn)
 	entry.Lock()
 	defer entry.Unlock()
-	created = NewBlockEntry(entry, txn, state, dataFactory)
+	created = NewBlockEntry(entry, txn, state, schema, dataFactory)
 	entry.addEntryLocked(created)
 	return
 }
@@ -245,6 +264,13 @@ func (entry *SegmentEntry) GetCatalog() *Catalog { return entry.table.db.catalog
 
 func (entry *SegmentEntry) GetSegmentData() data.Segment { return entry.segData }
 
+// InitData makes the data of the segment replayed without it
+func (entry *SegmentEntry) InitData(dataFactory SegmentDataFactory) {
+	if entry.segData == nil {
+		entry.segData = dataFactory(entry)
+	}
+}
+
 func (entry *SegmentEntry) deleteEntryLocked(block *BlockEntry) error {
 	if n, ok := entry.entries[block.GetID()]; !ok {
 		return ErrNotFound
@@ -283,7 +309,10 @@ func (entry *SegmentEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if err = binary.Write(w, binary.BigEndian, entry.state); err != nil {
 		return
 	}
-	n = sn + 1
+	if err = binary.Write(w, binary.BigEndian, entry.partition); err != nil {
+		return
+	}
+	n = sn + 1 + 4
 	return
 }
 
@@ -291,8 +320,11 @@ func (entry *SegmentEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	err = binary.Read(r, binary.BigEndian, &entry.state)
-	n += 1
+	if err = binary.Read(r, binary.BigEndian, &entry.state); err != nil {
+		return
+	}
+	err = binary.Read(r, binary.BigEndian, &entry.partition)
+	n += 1 + 4
 	return
 }
 
@@ -305,6 +337,7 @@ func (entry *SegmentEntry) Clone() CheckpointItem {
 		BaseEntry: entry.BaseEntry.Clone(),
 		state:     entry.state,
 		table:     entry.table,
+		partition: entry.partition,
 	}
 	return cloned
 }
@@ -314,6 +347,7 @@ func (entry *SegmentEntry) CloneCreate() CheckpointItem {
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		state:     entry.state,
 		table:     entry.table,
+		partition: entry.partition,
 	}
 	return cloned
 }
@@ -0,0 +1,165 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// ColumnStats is the statistics of a column collected by ANALYZE
+type ColumnStats struct {
+	Name    string
+	NDV     uint64
+	NullCnt uint64
+	// Min and Max are encoded by common.EncodeKey. They are nil if all the
+	// values of the column are null
+	Min, Max []byte
+}
+
+// TableStats is the statistics of a table collected by ANALYZE
+type TableStats struct {
+	// TS is the start ts of the txn collecting the statistics
+	TS      uint64
+	Rows    uint64
+	Columns []*ColumnStats
+}
+
+func (stats *TableStats) GetColumn(name string) *ColumnStats {
+	for _, col := range stats.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// NullFraction returns the fraction of the null values of column name
+func (stats *TableStats) NullFraction(name string) float64 {
+	col := stats.GetColumn(name)
+	if col == nil || stats.Rows == 0 {
+		return 0
+	}
+	return float64(col.NullCnt) / float64(stats.Rows)
+}
+
+func (stats *TableStats) String() string {
+	s := fmt.Sprintf("<Stats>[TS=%d][Rows=%d]", stats.TS, stats.Rows)
+	for _, col := range stats.Columns {
+		s = fmt.Sprintf("%s\n[%s][NDV=%d][Nulls=%d]", s, col.Name, col.NDV, col.NullCnt)
+	}
+	return s
+}
+
+func (stats *TableStats) Marshal() (buf []byte, err error) {
+	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, stats.TS); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, stats.Rows); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(stats.Columns))); err != nil {
+		return
+	}
+	for _, col := range stats.Columns {
+		if _, err = common.WriteString(col.Name, &w); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, col.NDV); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, col.NullCnt); err != nil {
+			return
+		}
+		if err = writeBytes(&w, col.Min); err != nil {
+			return
+		}
+		if err = writeBytes(&w, col.Max); err != nil {
+			return
+		}
+	}
+	buf = w.Bytes()
+	return
+}
+
+func (stats *TableStats) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &stats.TS); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &stats.Rows); err != nil {
+		return
+	}
+	cnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n += 8 + 8 + 2
+	stats.Columns = make([]*ColumnStats, cnt)
+	for i := range stats.Columns {
+		col := new(ColumnStats)
+		var sn int64
+		if col.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &col.NDV); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &col.NullCnt); err != nil {
+			return
+		}
+		n += 8 + 8
+		if col.Min, sn, err = readBytes(r); err != nil {
+			return
+		}
+		n += sn
+		if col.Max, sn, err = readBytes(r); err != nil {
+			return
+		}
+		n += sn
+		stats.Columns[i] = col
+	}
+	return
+}
+
+func writeBytes(w io.Writer, buf []byte) (err error) {
+	if err = binary.Write(w, binary.BigEndian, uint32(len(buf))); err != nil {
+		return
+	}
+	_, err = w.Write(buf)
+	return
+}
+
+func readBytes(r io.Reader) (buf []byte, n int64, err error) {
+	size := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+		return
+	}
+	n = 4
+	if size == 0 {
+		return
+	}
+	buf = make([]byte, size)
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return
+	}
+	n += int64(size)
+	return
+}
@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"sync"
@@ -22,17 +23,35 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type TableDataFactory = func(meta *TableEntry) data.Table
 
+// versionedSchema is a committed schema version with the commit ts of the
+// DDL that created it
+type versionedSchema struct {
+	ts     uint64
+	schema *Schema
+}
+
 type TableEntry struct {
 	*BaseEntry
-	db        *DBEntry
-	schema    *Schema
-	entries   map[uint64]*common.DLNode
-	link      *common.Link
-	tableData data.Table
+	db     *DBEntry
+	schema *Schema
+	// schemas holds all committed schema versions in ascending order and the
+	// last one is always the same as schema
+	schemas []*versionedSchema
+	// pending is the schema altered by an uncommitted txn
+	pending *Schema
+	// stats is the statistics collected by the last committed ANALYZE and
+	// pendingStats is the one collected by an uncommitted txn
+	stats        *TableStats
+	pendingStats *TableStats
+	rowStats     RowStats
+	entries      map[uint64]*common.DLNode
+	link         *common.Link
+	tableData    data.Table
 }
 
 func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) *TableEntry {
@@ -48,6 +67,7 @@ func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFacto
 		},
 		db:      db,
 		schema:  schema,
+		schemas: []*versionedSchema{{schema: schema}},
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
 	}
@@ -69,6 +89,7 @@ func NewSystemTableEntry(db *DBEntry, id uint64, schema *Schema) *TableEntry {
 		},
 		db:      db,
 		schema:  schema,
+		schemas: []*versionedSchema{{schema: schema}},
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
 	}
@@ -103,6 +124,7 @@ func MockStaloneTableEntry(id uint64, schema *Schema) *TableEntry {
 			ID:      id,
 		},
 		schema:  schema,
+		schemas: []*versionedSchema{{schema: schema}},
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
 	}
@@ -125,9 +147,15 @@ func (entry *TableEntry) MakeSegmentIt(reverse bool) *common.LinkIt {
 }
 
 func (entry *TableEntry) CreateSegment(txn txnif.AsyncTxn, state EntryState, dataFactory SegmentDataFactory) (created *SegmentEntry, err error) {
+	return entry.CreatePartitionSegment(txn, state, 0, dataFactory)
+}
+
+// CreatePartitionSegment creates a segment of the rows of partition
+func (entry *TableEntry) CreatePartitionSegment(txn txnif.AsyncTxn, state EntryState, partition uint32, dataFactory SegmentDataFactory) (created *SegmentEntry, err error) {
 	entry.Lock()
 	defer entry.Unlock()
 	created = NewSegmentEntry(entry, txn, state, dataFactory)
+	created.partition = partition
 	entry.addEntryLocked(created)
 	return
 }
@@ -138,6 +166,8 @@ func (entry *TableEntry) MakeCommand(id uint32) (cmd txnif.TxnCmd, err error) {
 	defer entry.RUnlock()
 	if entry.CurrOp == OpSoftDelete {
 		cmdType = CmdDropTable
+	} else if entry.CurrOp == OpUpdate {
+		cmdType = CmdUpdateTable
 	}
 	return newTableCmd(id, cmdType, entry), nil
 }
@@ -156,10 +186,240 @@ func (entry *TableEntry) deleteEntryLocked(segment *SegmentEntry) error {
 	return nil
 }
 
+// GetSchema returns the latest committed schema
 func (entry *TableEntry) GetSchema() *Schema {
 	return entry.schema
 }
 
+// TxnGetSchema returns the schema visible to txn. It is the schema altered
+// by txn itself if any, otherwise the latest one committed before txn starts
+func (entry *TableEntry) TxnGetSchema(txn txnif.TxnReader) *Schema {
+	if txn == nil {
+		return entry.GetSchema()
+	}
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.txnGetSchemaLocked(txn)
+}
+
+func (entry *TableEntry) txnGetSchemaLocked(txn txnif.TxnReader) *Schema {
+	if entry.pending != nil && entry.IsSameTxn(txn) {
+		return entry.pending
+	}
+	for i := len(entry.schemas) - 1; i > 0; i-- {
+		ts := entry.schemas[i].ts
+		if ts <= txn.GetStartTS() || ts == txn.GetCommitTS() {
+			return entry.schemas[i].schema
+		}
+	}
+	return entry.schemas[0].schema
+}
+
+// LatestSchema returns the schema the txn should commit against. It is the
+// schema altered by txn itself if any, otherwise the latest committed one
+func (entry *TableEntry) LatestSchema(txn txnif.TxnReader) *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	if entry.pending != nil && entry.IsSameTxn(txn) {
+		return entry.pending
+	}
+	return entry.schema
+}
+
+func (entry *TableEntry) GetSchemaByVersion(version uint32) *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	for _, versioned := range entry.schemas {
+		if versioned.schema.Version == version {
+			return versioned.schema
+		}
+	}
+	return nil
+}
+
+// AddColumn adds a column to the table in txn. The new schema version is
+// only visible to txn until it is committed
+func (entry *TableEntry) AddColumn(txn txnif.TxnReader, def *ColDef) (err error) {
+	return entry.alterSchema(txn, true, func(schema *Schema) error {
+		return schema.AddColumn(def)
+	})
+}
+
+// DropColumn drops a column from the table in txn. The column data is kept
+// in the existing blocks and is reclaimed when the blocks are compacted
+func (entry *TableEntry) DropColumn(txn txnif.TxnReader, name string) (err error) {
+	return entry.alterSchema(txn, true, func(schema *Schema) error {
+		return schema.DropColumn(name)
+	})
+}
+
+// AddIndex adds the secondary index name on the column col in txn. The
+// column definitions are not changed, so the schema version is kept
+func (entry *TableEntry) AddIndex(txn txnif.TxnReader, name, col string) (err error) {
+	return entry.alterSchema(txn, false, func(schema *Schema) error {
+		return schema.AddIndex(name, col)
+	})
+}
+
+// DropIndex drops the secondary index name in txn. The indexes kept by the
+// blocks are reclaimed when the blocks are compacted
+func (entry *TableEntry) DropIndex(txn txnif.TxnReader, name string) (err error) {
+	return entry.alterSchema(txn, false, func(schema *Schema) error {
+		return schema.DropIndex(name)
+	})
+}
+
+// Rename renames the table in txn. The column definitions are not changed,
+// so the schema version is kept
+func (entry *TableEntry) Rename(txn txnif.TxnReader, name string) (err error) {
+	return entry.alterSchema(txn, false, func(schema *Schema) error {
+		schema.Name = name
+		return nil
+	})
+}
+
+// latestNameLocked returns the name of the table if txn commits
+func (entry *TableEntry) latestNameLocked(txn txnif.TxnReader) string {
+	if txn != nil && entry.pending != nil && entry.IsSameTxn(txn) {
+		return entry.pending.Name
+	}
+	return entry.schema.Name
+}
+
+// hasNameLocked returns true if any committed schema version has the name
+func (entry *TableEntry) hasNameLocked(name string) bool {
+	for _, versioned := range entry.schemas {
+		if versioned.schema.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// staleNameLocked returns the name given by an uncommitted rename that is
+// discarded, or an empty string if there is none
+func (entry *TableEntry) staleNameLocked() string {
+	if entry.pending == nil || entry.hasNameLocked(entry.pending.Name) {
+		return ""
+	}
+	return entry.pending.Name
+}
+
+// alterSchema applies alter to the schema of the table in txn. The schema
+// version is bumped if bump is true
+func (entry *TableEntry) alterSchema(txn txnif.TxnReader, bump bool, alter func(*Schema) error) (err error) {
+	entry.Lock()
+	defer entry.Unlock()
+	if entry.Txn != nil {
+		if !entry.IsSameTxn(txn) {
+			return txnif.TxnWWConflictErr
+		}
+		switch entry.CurrOp {
+		case OpCreate:
+			// The table is not visible to others yet, alter it in place
+			return alter(entry.schema)
+		case OpUpdate:
+			// The table may be only analyzed in txn
+			pending := entry.pending
+			if pending == nil {
+				if pending, err = entry.cloneSchemaLocked(txn); err != nil {
+					return
+				}
+			}
+			if err = alter(pending); err != nil {
+				return
+			}
+			if bump {
+				pending.Version = entry.schema.Version + 1
+			}
+			entry.pending = pending
+			return
+		default:
+			return ErrNotFound
+		}
+	}
+	if entry.HasDropped() {
+		return ErrNotFound
+	}
+	pending, err := entry.cloneSchemaLocked(txn)
+	if err != nil {
+		return
+	}
+	if err = alter(pending); err != nil {
+		return
+	}
+	if bump {
+		pending.Version++
+	}
+	entry.PrevCommit = &CommitInfo{
+		CurrOp:   entry.CurrOp,
+		LogIndex: entry.LogIndex,
+	}
+	entry.Txn = txn
+	entry.CurrOp = OpUpdate
+	entry.pending = pending
+	return
+}
+
+func (entry *TableEntry) cloneSchemaLocked(txn txnif.TxnReader) (schema *Schema, err error) {
+	// Another txn altered the schema after txn starts
+	if entry.schemas[len(entry.schemas)-1].ts > txn.GetStartTS() {
+		err = txnif.TxnWWConflictErr
+		return
+	}
+	schema = entry.schema.Clone()
+	return
+}
+
+// GetRowStats returns the statistics of the committed rows of the table
+func (entry *TableEntry) GetRowStats() *RowStats { return &entry.rowStats }
+
+// GetStats returns the committed statistics of the table or nil if it has
+// never been analyzed
+func (entry *TableEntry) GetStats() *TableStats {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.stats
+}
+
+// UpdateStats replaces the statistics of the table when txn commits. It
+// does not change the schema version
+func (entry *TableEntry) UpdateStats(txn txnif.TxnReader, stats *TableStats) (err error) {
+	entry.Lock()
+	defer entry.Unlock()
+	if entry.Txn != nil {
+		if !entry.IsSameTxn(txn) {
+			return txnif.TxnWWConflictErr
+		}
+		switch entry.CurrOp {
+		case OpCreate, OpUpdate:
+			entry.pendingStats = stats
+			return
+		default:
+			return ErrNotFound
+		}
+	}
+	if entry.HasDropped() {
+		return ErrNotFound
+	}
+	entry.PrevCommit = &CommitInfo{
+		CurrOp:   entry.CurrOp,
+		LogIndex: entry.LogIndex,
+	}
+	entry.Txn = txn
+	entry.CurrOp = OpUpdate
+	entry.pendingStats = stats
+	return
+}
+
+func (entry *TableEntry) addSchemaLocked(ts uint64, schema *Schema) {
+	entry.schemas = append(entry.schemas, &versionedSchema{
+		ts:     ts,
+		schema: schema,
+	})
+	entry.schema = schema
+}
+
 func (entry *TableEntry) Compare(o common.NodePayload) int {
 	oe := o.(*TableEntry).BaseEntry
 	return entry.DoCompre(oe)
@@ -205,11 +465,22 @@ func (entry *TableEntry) GetCatalog() *Catalog { return entry.db.catalog }
 
 func (entry *TableEntry) GetTableData() data.Table { return entry.tableData }
 
+// InitData makes the data of the table replayed without it
+func (entry *TableEntry) InitData(dataFactory TableDataFactory) {
+	if entry.tableData == nil {
+		entry.tableData = dataFactory(entry)
+	}
+}
+
 func (entry *TableEntry) LastAppendableSegmemt() (seg *SegmentEntry) {
+	return entry.LastAppendableSegmentInPartition(0)
+}
+
+func (entry *TableEntry) LastAppendableSegmentInPartition(partition uint32) (seg *SegmentEntry) {
 	it := entry.MakeSegmentIt(false)
 	for it.Valid() {
 		itSeg := it.Get().GetPayload().(*SegmentEntry)
-		if itSeg.IsAppendable() {
+		if itSeg.IsAppendable() && itSeg.partition == partition {
 			seg = itSeg
 			break
 		}
@@ -274,12 +545,50 @@ func (entry *TableEntry) RemoveEntry(segment *SegmentEntry) (err error) {
 	return entry.deleteEntryLocked(segment)
 }
 
+func (entry *TableEntry) PrepareCommit() (err error) {
+	if err = entry.BaseEntry.PrepareCommit(); err != nil {
+		return
+	}
+	entry.Lock()
+	var stale string
+	if entry.CurrOp == OpUpdate && entry.pending != nil {
+		entry.addSchemaLocked(entry.Txn.GetCommitTS(), entry.pending)
+	} else {
+		// The table is renamed and then dropped in the same txn
+		stale = entry.staleNameLocked()
+	}
+	if entry.pendingStats != nil {
+		entry.stats = entry.pendingStats
+	}
+	entry.pending = nil
+	entry.pendingStats = nil
+	entry.Unlock()
+	if stale != "" {
+		entry.db.removeNameNode(stale, entry.ID)
+	}
+	return
+}
+
+func (entry *TableEntry) ApplyCommit(index *wal.Index) (err error) {
+	entry.Lock()
+	if entry.CurrOp == OpUpdate {
+		entry.CurrOp = entry.PrevCommit.CurrOp
+	}
+	entry.Unlock()
+	return entry.BaseEntry.ApplyCommit(index)
+}
+
 func (entry *TableEntry) PrepareRollback() (err error) {
-	entry.RLock()
+	entry.Lock()
 	currOp := entry.CurrOp
-	entry.RUnlock()
+	stale := entry.staleNameLocked()
+	entry.pending = nil
+	entry.pendingStats = nil
+	entry.Unlock()
 	if currOp == OpCreate {
 		err = entry.GetDB().RemoveEntry(entry)
+	} else if stale != "" {
+		entry.db.removeNameNode(stale, entry.ID)
 	}
 	if err = entry.BaseEntry.PrepareRollback(); err != nil {
 		return
@@ -291,13 +600,26 @@ func (entry *TableEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.BaseEntry.WriteTo(w); err != nil {
 		return
 	}
-	buf, err := entry.schema.Marshal()
-	if err != nil {
+	if err = binary.Write(w, binary.BigEndian, uint32(len(entry.schemas))); err != nil {
 		return
 	}
-	sn := int(0)
-	sn, err = w.Write(buf)
-	n += int64(sn)
+	n += 4
+	for _, versioned := range entry.schemas {
+		if err = binary.Write(w, binary.BigEndian, versioned.ts); err != nil {
+			return
+		}
+		var buf []byte
+		if buf, err = versioned.schema.Marshal(); err != nil {
+			return
+		}
+		sn := int(0)
+		if sn, err = w.Write(buf); err != nil {
+			return
+		}
+		n += int64(sn) + 8
+	}
+	sn, err := writeStats(w, entry.stats)
+	n += sn
 	return
 }
 
@@ -305,11 +627,67 @@ func (entry *TableEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	if entry.schema == nil {
-		entry.schema = NewEmptySchema("")
+	cnt := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n += 4
+	entry.schemas = make([]*versionedSchema, 0, cnt)
+	for i := uint32(0); i < cnt; i++ {
+		versioned := &versionedSchema{
+			schema: NewEmptySchema(""),
+		}
+		if err = binary.Read(r, binary.BigEndian, &versioned.ts); err != nil {
+			return
+		}
+		sn := int64(0)
+		if sn, err = versioned.schema.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn + 8
+		entry.schemas = append(entry.schemas, versioned)
+	}
+	entry.schema = entry.schemas[len(entry.schemas)-1].schema
+	sn, err := readStats(r, &entry.stats)
+	n += sn
+	return
+}
+
+// writeStats writes a flag byte followed by the statistics if any
+func writeStats(w io.Writer, stats *TableStats) (n int64, err error) {
+	if stats == nil {
+		if err = binary.Write(w, binary.BigEndian, uint8(0)); err != nil {
+			return
+		}
+		n = 1
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint8(1)); err != nil {
+		return
+	}
+	var buf []byte
+	if buf, err = stats.Marshal(); err != nil {
+		return
+	}
+	sn := 0
+	if sn, err = w.Write(buf); err != nil {
+		return
+	}
+	n = int64(sn) + 1
+	return
+}
+
+func readStats(r io.Reader, stats **TableStats) (n int64, err error) {
+	flag := uint8(0)
+	if err = binary.Read(r, binary.BigEndian, &flag); err != nil {
+		return
+	}
+	n = 1
+	if flag == 0 {
+		return
 	}
-	sn := int64(0)
-	sn, err = entry.schema.ReadFrom(r)
+	*stats = new(TableStats)
+	sn, err := (*stats).ReadFrom(r)
 	n += sn
 	return
 }
@@ -322,6 +700,8 @@ func (entry *TableEntry) Clone() CheckpointItem {
 	cloned := &TableEntry{
 		BaseEntry: entry.BaseEntry.Clone(),
 		schema:    entry.schema,
+		schemas:   entry.schemas,
+		stats:     entry.stats,
 		db:        entry.db,
 	}
 	return cloned
@@ -331,6 +711,8 @@ func (entry *TableEntry) CloneCreate() CheckpointItem {
 	cloned := &TableEntry{
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		schema:    entry.schema,
+		schemas:   entry.schemas,
+		stats:     entry.stats,
 		db:        entry.db,
 	}
 	return cloned
@@ -0,0 +1,179 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+var (
+	ErrSubscriptionClosed = errors.New("tae cdc: subscription closed")
+)
+
+type ChangeType int8
+
+const (
+	ChangeInsert ChangeType = iota
+	ChangeUpdate
+	ChangeDelete
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeInsert:
+		return "INSERT"
+	case ChangeUpdate:
+		return "UPDATE"
+	case ChangeDelete:
+		return "DELETE"
+	}
+	panic("not supported")
+}
+
+// Change is a batch of rows changed by a committed txn. The batch of an
+// insert has all the columns. The batch of a delete has only the primary
+// key column and the batch of an update has the primary key column and the
+// updated column
+type Change struct {
+	Type     ChangeType
+	TxnID    uint64
+	CommitTS uint64
+	Data     *batch.Batch
+}
+
+func (c *Change) Rows() int {
+	return vector.Length(c.Data.Vecs[0])
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("[%s][Txn-%d][TS=%d][Rows=%d]", c.Type, c.TxnID, c.CommitTS, c.Rows())
+}
+
+// Subscription receives the changes published to a feed in commit order. The
+// changes are queued without limit so that a slow subscriber never blocks
+// the commit pipeline
+type Subscription struct {
+	cond    *sync.Cond
+	feed    *Feed
+	id      uint64
+	pending []*Change
+	closed  bool
+}
+
+// Next blocks until a change is published or the subscription is closed
+func (sub *Subscription) Next() (change *Change, err error) {
+	sub.cond.L.Lock()
+	defer sub.cond.L.Unlock()
+	for len(sub.pending) == 0 && !sub.closed {
+		sub.cond.Wait()
+	}
+	if len(sub.pending) == 0 {
+		err = ErrSubscriptionClosed
+		return
+	}
+	change = sub.pending[0]
+	sub.pending[0] = nil
+	sub.pending = sub.pending[1:]
+	return
+}
+
+// TryNext returns nil if no change is pending
+func (sub *Subscription) TryNext() (change *Change, err error) {
+	sub.cond.L.Lock()
+	defer sub.cond.L.Unlock()
+	if len(sub.pending) == 0 {
+		if sub.closed {
+			err = ErrSubscriptionClosed
+		}
+		return
+	}
+	change = sub.pending[0]
+	sub.pending[0] = nil
+	sub.pending = sub.pending[1:]
+	return
+}
+
+func (sub *Subscription) Close() {
+	sub.feed.unsubscribe(sub.id)
+	sub.cond.L.Lock()
+	sub.closed = true
+	sub.pending = nil
+	sub.cond.L.Unlock()
+	sub.cond.Broadcast()
+}
+
+func (sub *Subscription) publish(changes []*Change) {
+	sub.cond.L.Lock()
+	if !sub.closed {
+		sub.pending = append(sub.pending, changes...)
+	}
+	sub.cond.L.Unlock()
+	sub.cond.Broadcast()
+}
+
+// Feed fans out the committed changes of a table to its subscriptions
+type Feed struct {
+	sync.RWMutex
+	nextId uint64
+	subs   map[uint64]*Subscription
+}
+
+func NewFeed() *Feed {
+	return &Feed{
+		subs: make(map[uint64]*Subscription),
+	}
+}
+
+// Subscribe returns a subscription receiving the changes committed after it
+func (feed *Feed) Subscribe() *Subscription {
+	feed.Lock()
+	defer feed.Unlock()
+	feed.nextId++
+	sub := &Subscription{
+		cond: sync.NewCond(new(sync.Mutex)),
+		feed: feed,
+		id:   feed.nextId,
+	}
+	feed.subs[sub.id] = sub
+	return sub
+}
+
+func (feed *Feed) unsubscribe(id uint64) {
+	feed.Lock()
+	defer feed.Unlock()
+	delete(feed.subs, id)
+}
+
+func (feed *Feed) HasSubscriber() bool {
+	feed.RLock()
+	defer feed.RUnlock()
+	return len(feed.subs) > 0
+}
+
+func (feed *Feed) Publish(changes ...*Change) {
+	if len(changes) == 0 {
+		return
+	}
+	feed.RLock()
+	defer feed.RUnlock()
+	for _, sub := range feed.subs {
+		sub.publish(changes)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeed(t *testing.T) {
+	feed := NewFeed()
+	assert.False(t, feed.HasSubscriber())
+	// Nothing is queued without a subscriber
+	feed.Publish(&Change{CommitTS: 1})
+
+	sub1 := feed.Subscribe()
+	sub2 := feed.Subscribe()
+	assert.True(t, feed.HasSubscriber())
+	change, err := sub1.TryNext()
+	assert.Nil(t, err)
+	assert.Nil(t, change)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for ts := uint64(2); ts <= 4; ts++ {
+			change, err := sub2.Next()
+			assert.Nil(t, err)
+			assert.Equal(t, ts, change.CommitTS)
+		}
+	}()
+	feed.Publish(&Change{CommitTS: 2}, &Change{CommitTS: 3})
+	feed.Publish(&Change{CommitTS: 4})
+	wg.Wait()
+
+	for ts := uint64(2); ts <= 4; ts++ {
+		change, err := sub1.TryNext()
+		assert.Nil(t, err)
+		assert.Equal(t, ts, change.CommitTS)
+	}
+
+	sub1.Close()
+	_, err = sub1.Next()
+	assert.Equal(t, ErrSubscriptionClosed, err)
+	sub2.Close()
+	assert.False(t, feed.HasSubscriber())
+}
+
+func TestPublisher(t *testing.T) {
+	feed := NewFeed()
+	sub := feed.Subscribe()
+	defer sub.Close()
+	p := NewPublisher(1)
+	changesOf := func(ts uint64) []FeedChanges {
+		return []FeedChanges{{Feed: feed, Changes: []*Change{{CommitTS: ts}}}}
+	}
+	next := func() *Change {
+		change, err := sub.TryNext()
+		assert.Nil(t, err)
+		return change
+	}
+
+	p.Prepare(2, changesOf(2))
+	p.Prepare(3, changesOf(3))
+	p.Prepare(5, changesOf(5))
+	// Not published before the entry is committed
+	p.Decide(2, true)
+	assert.Nil(t, next())
+	// The entries of the other groups are ignored
+	p.OnCommitted(2, 5)
+	assert.Nil(t, next())
+	p.OnCommitted(1, 2)
+	assert.Equal(t, uint64(2), next().CommitTS)
+
+	// The txn of 5 waits for the one of 3 ahead
+	p.OnCommitted(1, 3)
+	p.OnCommitted(1, 4)
+	p.OnCommitted(1, 5)
+	p.Decide(5, true)
+	assert.Nil(t, next())
+	p.Decide(3, false)
+	assert.Equal(t, uint64(5), next().CommitTS)
+	assert.Nil(t, next())
+}
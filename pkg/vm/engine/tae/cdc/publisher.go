@@ -0,0 +1,106 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"sync"
+)
+
+// FeedChanges is the changes of a txn to be published to the feed of a
+// table
+type FeedChanges struct {
+	Feed    *Feed
+	Changes []*Change
+}
+
+// pendingTxn is the changes of a txn whose WAL entry is appended
+type pendingTxn struct {
+	lsn     uint64
+	decided bool
+	commit  bool
+	changes []FeedChanges
+}
+
+// Publisher publishes the changes of the txns to the feeds once the logstore
+// commits their WAL entries and the txns are committed, in the order of the
+// LSNs of the entries. It is the commit observer of the logstore
+type Publisher struct {
+	sync.Mutex
+	group uint32
+	// committed is the last LSN of group committed by the logstore
+	committed uint64
+	// pending is ordered by the LSNs
+	pending []*pendingTxn
+}
+
+// NewPublisher returns a publisher of the txns whose entries are of group
+func NewPublisher(group uint32) *Publisher {
+	return &Publisher{group: group}
+}
+
+// Prepare queues the changes of the txn whose WAL entry is of lsn. It is
+// called in the order of the LSNs
+func (p *Publisher) Prepare(lsn uint64, changes []FeedChanges) {
+	p.Lock()
+	defer p.Unlock()
+	p.pending = append(p.pending, &pendingTxn{
+		lsn:     lsn,
+		changes: changes,
+	})
+}
+
+// Decide publishes the changes of the txn of lsn if commit, or drops them
+func (p *Publisher) Decide(lsn uint64, commit bool) {
+	p.Lock()
+	defer p.Unlock()
+	for _, txn := range p.pending {
+		if txn.lsn == lsn {
+			txn.decided = true
+			txn.commit = commit
+			break
+		}
+	}
+	p.publishLocked()
+}
+
+// OnCommitted is called by the logstore once the entry of lsn is committed,
+// in the order of the LSNs of each group
+func (p *Publisher) OnCommitted(group uint32, lsn uint64) {
+	if group != p.group {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.committed = lsn
+	p.publishLocked()
+}
+
+// publishLocked publishes the txns decided whose entries are committed
+// until the first one which is not
+func (p *Publisher) publishLocked() {
+	for len(p.pending) != 0 {
+		txn := p.pending[0]
+		if !txn.decided || txn.lsn > p.committed {
+			return
+		}
+		if txn.commit {
+			for _, changes := range txn.changes {
+				changes.Feed.Publish(changes.Changes...)
+			}
+		}
+		p.pending[0] = nil
+		p.pending = p.pending[1:]
+	}
+}
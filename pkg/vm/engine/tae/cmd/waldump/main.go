@@ -0,0 +1,66 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// waldump prints the entries of a WAL of tae without replaying it
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+)
+
+var (
+	dir   = flag.String("dir", ".", "directory of the WAL files")
+	name  = flag.String("name", "wal", "name of the WAL")
+	group = flag.Uint("group", 0, "only print the entries of the group if not zero")
+	key   = flag.String("key", "", "hex AES key of the encrypted WAL")
+)
+
+func main() {
+	flag.Parse()
+	var keys entry.KeyProvider
+	if *key != "" {
+		buf, err := hex.DecodeString(*key)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid key: %v\n", err)
+			os.Exit(2)
+		}
+		keys = entry.NewStaticKeyProvider(buf)
+	}
+	entries, corrupted := 0, 0
+	err := store.WalkEntries(*dir, *name, keys, func(info *store.EntryInfo) error {
+		if info.Err != nil {
+			corrupted++
+		}
+		if *group != 0 && info.Group != uint32(*group) && info.Err == nil {
+			return nil
+		}
+		entries++
+		fmt.Println(info.String())
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "walk %s: %v\n", *dir, err)
+		os.Exit(1)
+	}
+	fmt.Printf("%d entries, %d corrupted\n", entries, corrupted)
+	if corrupted != 0 {
+		os.Exit(1)
+	}
+}
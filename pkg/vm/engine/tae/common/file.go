@@ -44,6 +44,12 @@ type IVFile interface {
 	GetFileType() FileType
 }
 
+// IOriginReader reads the data of a file decompressed into a buffer of the
+// origin size
+type IOriginReader interface {
+	ReadOrigin(buf []byte) (int, error)
+}
+
 type IRWFile interface {
 	io.Writer
 	IVFile
@@ -83,6 +83,56 @@ func AppendValue(vec *gvec.Vector, v interface{}) {
 	}
 }
 
+// MakeConstVector returns a vector of rows copies of v. If v is nil, the
+// returned vector is filled with NULLs
+func MakeConstVector(typ types.Type, rows int, v interface{}) *gvec.Vector {
+	vec := gvec.New(typ)
+	if v != nil {
+		for i := 0; i < rows; i++ {
+			AppendValue(vec, v)
+		}
+		return vec
+	}
+	switch typ.Oid {
+	case types.T_int8:
+		vec.Col = make([]int8, rows)
+	case types.T_int16:
+		vec.Col = make([]int16, rows)
+	case types.T_int32:
+		vec.Col = make([]int32, rows)
+	case types.T_int64:
+		vec.Col = make([]int64, rows)
+	case types.T_uint8:
+		vec.Col = make([]uint8, rows)
+	case types.T_uint16:
+		vec.Col = make([]uint16, rows)
+	case types.T_uint32:
+		vec.Col = make([]uint32, rows)
+	case types.T_uint64:
+		vec.Col = make([]uint64, rows)
+	case types.T_decimal64:
+		vec.Col = make([]types.Decimal64, rows)
+	case types.T_float32:
+		vec.Col = make([]float32, rows)
+	case types.T_float64:
+		vec.Col = make([]float64, rows)
+	case types.T_date:
+		vec.Col = make([]types.Date, rows)
+	case types.T_datetime:
+		vec.Col = make([]types.Datetime, rows)
+	case types.T_char, types.T_varchar, types.T_json:
+		vals := vec.Col.(*types.Bytes)
+		vals.Offsets = make([]uint32, rows)
+		vals.Lengths = make([]uint32, rows)
+	default:
+		panic("not expected")
+	}
+	for i := 0; i < rows; i++ {
+		nulls.Add(vec.Nsp, uint64(i))
+	}
+	return vec
+}
+
 func GetValue(col *gvec.Vector, row uint32) interface{} {
 	vals := col.Col
 	switch col.Typ.Oid {
@@ -276,6 +326,33 @@ func UpdateOffsets(data *types.Bytes, start, end int) {
 		data.Offsets[i+1] = data.Offsets[i] + data.Lengths[i]
 	}
 }
+
+// PartitionBatch splits bat into cnt batches. Row i of bat goes to batch
+// groups[i] in order. The batches without any row are nil
+func PartitionBatch(bat *gbat.Batch, groups []uint32, cnt uint32) []*gbat.Batch {
+	bats := make([]*gbat.Batch, cnt)
+	for row, group := range groups {
+		if bats[group] == nil {
+			bats[group] = gbat.New(true, bat.Attrs)
+			for j := range bat.Vecs {
+				bats[group].Vecs[j] = gvec.New(bat.Vecs[j].Typ)
+			}
+		}
+		for j, vec := range bat.Vecs {
+			dest := bats[group].Vecs[j]
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				nulls.Add(dest.Nsp, uint64(gvec.Length(dest)))
+			}
+			v := GetValue(vec, uint32(row))
+			if str, ok := v.(string); ok {
+				v = []byte(str)
+			}
+			AppendValue(dest, v)
+		}
+	}
+	return bats
+}
+
 func SplitBatch(bat *gbat.Batch, cnt int) []*gbat.Batch {
 	if cnt == 1 {
 		return []*gbat.Batch{bat}
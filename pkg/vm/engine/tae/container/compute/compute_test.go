@@ -15,13 +15,16 @@
 package compute
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/stretchr/testify/require"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/stretchr/testify/assert"
 )
@@ -133,3 +136,69 @@ func TestCheckRowExists(t *testing.T) {
 	_, exist = CheckRowExists(vec, int32(55), dels)
 	require.False(t, exist)
 }
+
+func TestAppendTupleKey(t *testing.T) {
+	i32 := types.Type{Oid: types.T_int32, Size: 4, Width: 32}
+	f64 := types.Type{Oid: types.T_float64, Size: 8, Width: 64}
+	str := types.Type{Oid: types.T_varchar, Size: 24}
+	encode := func(a int32, b float64, c string) []byte {
+		buf := AppendTupleKey(nil, a, i32)
+		buf = AppendTupleKey(buf, b, f64)
+		return AppendTupleKey(buf, c, str)
+	}
+	// The encoded tuples are in the same order as the tuples
+	ordered := [][]byte{
+		encode(-10, 1.5, "b"),
+		encode(-1, -2.5, "a"),
+		encode(-1, 0, ""),
+		encode(-1, 0, "a"),
+		encode(-1, 0, "a\x00"),
+		encode(-1, 0, "ab"),
+		encode(0, -1, "a"),
+		encode(7, 3.25, "a"),
+	}
+	for i := 1; i < len(ordered); i++ {
+		assert.Equal(t, -1, bytes.Compare(ordered[i-1], ordered[i]))
+	}
+}
+
+func TestTupleKeyNull(t *testing.T) {
+	i32 := types.Type{Oid: types.T_int32, Size: 4, Width: 32}
+	str := types.Type{Oid: types.T_varchar, Size: 24}
+	encode := func(a, c interface{}) []byte {
+		buf := AppendTupleKey(nil, a, i32)
+		return AppendTupleKey(buf, c, str)
+	}
+	// NULL is distinct from zero and the empty string, and sorts first
+	ordered := [][]byte{
+		encode(nil, nil),
+		encode(nil, ""),
+		encode(nil, "a"),
+		encode(int32(-1), nil),
+		encode(int32(0), nil),
+		encode(int32(0), ""),
+		encode(int32(0), "\x00"),
+	}
+	for i := 1; i < len(ordered); i++ {
+		assert.Equal(t, -1, bytes.Compare(ordered[i-1], ordered[i]))
+	}
+
+	ints := gvec.New(i32)
+	strs := gvec.New(str)
+	for i := 0; i < 4; i++ {
+		AppendValue(ints, int32(0))
+		AppendValue(strs, []byte(""))
+	}
+	nulls.Add(ints.Nsp, 1)
+	nulls.Add(strs.Nsp, 2)
+	nulls.Add(ints.Nsp, 3)
+	nulls.Add(strs.Nsp, 3)
+	keys := EncodeCompositeKey([]*gvec.Vector{ints, strs})
+	assert.Equal(t, encode(int32(0), ""), GetValue(keys, 0))
+	assert.Equal(t, encode(nil, ""), GetValue(keys, 1))
+	assert.Equal(t, encode(int32(0), nil), GetValue(keys, 2))
+	assert.Equal(t, encode(nil, nil), GetValue(keys, 3))
+	for i := uint32(1); i < 4; i++ {
+		assert.NotEqual(t, GetValue(keys, 0), GetValue(keys, i))
+	}
+}
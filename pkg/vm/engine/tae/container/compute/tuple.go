@@ -0,0 +1,146 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	// tupleKeyNull is the flag byte of a NULL part of a tuple key, which
+	// sorts before all the values
+	tupleKeyNull byte = 0
+	// tupleKeyValue is the flag byte before the encoding of a value
+	tupleKeyValue byte = 1
+)
+
+// AppendTupleKey appends the memcomparable encoding of v to buf. The encoded
+// values of several columns concatenated compare bytewise in the same order
+// as the tuples of the values. A nil v is NULL, which is only a flag byte
+// distinct from the one of any value
+func AppendTupleKey(buf []byte, v interface{}, typ types.Type) []byte {
+	if v == nil {
+		return append(buf, tupleKeyNull)
+	}
+	buf = append(buf, tupleKeyValue)
+	switch typ.Oid {
+	case types.T_int8:
+		return append(buf, uint8(v.(int8))^0x80)
+	case types.T_int16:
+		return appendUint16(buf, uint16(v.(int16))^0x8000)
+	case types.T_int32:
+		return appendUint32(buf, uint32(v.(int32))^0x80000000)
+	case types.T_int64:
+		return appendUint64(buf, uint64(v.(int64))^0x8000000000000000)
+	case types.T_uint8:
+		return append(buf, v.(uint8))
+	case types.T_uint16:
+		return appendUint16(buf, v.(uint16))
+	case types.T_uint32:
+		return appendUint32(buf, v.(uint32))
+	case types.T_uint64:
+		return appendUint64(buf, v.(uint64))
+	case types.T_decimal64:
+		return appendUint64(buf, uint64(v.(types.Decimal64))^0x8000000000000000)
+	case types.T_float32:
+		bits := math.Float32bits(v.(float32))
+		if bits&0x80000000 != 0 {
+			bits = ^bits
+		} else {
+			bits |= 0x80000000
+		}
+		return appendUint32(buf, bits)
+	case types.T_float64:
+		bits := math.Float64bits(v.(float64))
+		if bits&0x8000000000000000 != 0 {
+			bits = ^bits
+		} else {
+			bits |= 0x8000000000000000
+		}
+		return appendUint64(buf, bits)
+	case types.T_date:
+		return appendUint32(buf, uint32(v.(types.Date))^0x80000000)
+	case types.T_datetime:
+		return appendUint64(buf, uint64(v.(types.Datetime))^0x8000000000000000)
+	case types.T_char, types.T_varchar, types.T_json:
+		var data []byte
+		switch val := v.(type) {
+		case string:
+			data = []byte(val)
+		case []byte:
+			data = val
+		}
+		// 0x00 is escaped as 0x00 0xff and the value is terminated by
+		// 0x00 0x01, so that a prefix sorts before the longer values
+		for _, c := range data {
+			if c == 0 {
+				buf = append(buf, 0, 0xff)
+			} else {
+				buf = append(buf, c)
+			}
+		}
+		return append(buf, 0, 1)
+	default:
+		panic("not expected")
+	}
+}
+
+// EncodeCompositeKey encodes the rows of vecs into a varchar vector of the
+// composite keys
+func EncodeCompositeKey(vecs []*gvec.Vector) *gvec.Vector {
+	vec := gvec.New(types.Type{Oid: types.T_varchar, Size: 24})
+	if len(vecs) == 0 {
+		return vec
+	}
+	rows := gvec.Length(vecs[0])
+	buf := make([]byte, 0, 64)
+	for row := 0; row < rows; row++ {
+		buf = buf[:0]
+		for _, col := range vecs {
+			if nulls.Contains(col.Nsp, uint64(row)) {
+				buf = AppendTupleKey(buf, nil, col.Typ)
+				continue
+			}
+			buf = AppendTupleKey(buf, GetValue(col, uint32(row)), col.Typ)
+		}
+		key := make([]byte, len(buf))
+		copy(key, buf)
+		AppendValue(vec, key)
+	}
+	return vec
+}
+
+func appendUint16(buf []byte, v uint16) []byte {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/pierrec/lz4"
 )
 
 type VectorWrapper struct {
@@ -216,10 +215,10 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 	case compress.None:
 		nw, err := w.Write(buf)
 		return int64(nw), err
-	case compress.Lz4:
-		nb := lz4.CompressBlockBound(len(buf))
-		tmp := make([]byte, nb)
-		tmp, err = compress.Compress(buf, tmp, compress.Lz4)
+	case compress.Lz4, compress.Zstd:
+		algo := stat.CompressAlgo()
+		tmp := make([]byte, compress.CompressBound(len(buf), algo))
+		tmp, err = compress.Compress(buf, tmp, algo)
 		if err != nil {
 			return 0, err
 		}
@@ -248,6 +247,24 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 	}
 
 	stat := vec.File.Stat()
+	if or, ok := r.(common.IOriginReader); ok {
+		originSize := uint64(stat.OriginSize())
+		vec.MNode = common.GPool.Alloc(originSize)
+		data := vec.MNode.Buf[:originSize]
+		nr, err := or.ReadOrigin(data)
+		if err != nil {
+			common.GPool.Free(vec.MNode)
+			return n, err
+		}
+		t := encoding.DecodeType(data[:encoding.TypeSize])
+		v := gvec.New(t)
+		vec.Col = v.Col
+		err = vec.Vector.Read(data)
+		if err != nil {
+			common.GPool.Free(vec.MNode)
+		}
+		return int64(nr), err
+	}
 	// logutil.Infof("%d, %d, %d", stat.CompressAlgo(), stat.Size(), stat.OriginSize())
 	switch stat.CompressAlgo() {
 	case compress.None:
@@ -264,7 +281,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 		vec.Col = v.Col
 		err = vec.Vector.Read(data)
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd:
 		loadSize := uint64(stat.Size())
 		originSize := uint64(stat.OriginSize())
 		tmpNode := common.GPool.Alloc(loadSize)
@@ -274,7 +291,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			return n, err
 		}
 		vec.MNode = common.GPool.Alloc(originSize)
-		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], compress.Lz4)
+		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], stat.CompressAlgo())
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 			return n, err
@@ -295,6 +312,23 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 
 func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer, deCompressed *bytes.Buffer) (n int64, err error) {
 	stat := vec.File.Stat()
+	if or, ok := r.(common.IOriginReader); ok {
+		originSize := int(stat.OriginSize())
+		deCompressed.Reset()
+		if originSize > deCompressed.Cap() {
+			deCompressed.Grow(originSize)
+		}
+		buf := deCompressed.Bytes()[:originSize]
+		nr, err := or.ReadOrigin(buf)
+		if err != nil {
+			return n, err
+		}
+		t := encoding.DecodeType(buf[:encoding.TypeSize])
+		v := gvec.New(t)
+		vec.Col = v.Col
+		err = vec.Vector.Read(buf)
+		return int64(nr), err
+	}
 	switch stat.CompressAlgo() {
 	case compress.None:
 		deCompressed.Reset()
@@ -313,7 +347,7 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 			return n, err
 		}
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd:
 		loadSize := stat.Size()
 		originSize := stat.OriginSize()
 		compressed.Reset()
@@ -332,7 +366,7 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		buf, err = compress.Decompress(tmpBuf, buf, compress.Lz4)
+		buf, err = compress.Decompress(tmpBuf, buf, stat.CompressAlgo())
 		if err != nil {
 			return n, err
 		}
@@ -149,6 +149,9 @@ func (bf *blockFile) Destroy() error {
 
 func (bf *blockFile) Sync() error { return nil }
 
+// Prefetch is a no-op as the mock files are in memory
+func (bf *blockFile) Prefetch() {}
+
 func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch.IBatch, err error) {
 	attrs := make([]int, len(bf.columns))
 	vecs := make([]vector.IVector, len(attrs))
@@ -49,6 +49,9 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	return
 }
 
+// SetCompression is a no-op as the mock files are not compressed
+func (cb *columnBlock) SetCompression(algo int) {}
+
 func (cb *columnBlock) WriteData(buf []byte) (err error) {
 	_, err = cb.data.Write(buf)
 	return
@@ -41,6 +41,8 @@ func (sf *segmentFile) GetSegmentFile() *segment.Segment {
 	panic(any("implement me"))
 }
 
+func (sf *segmentFile) Seal() {}
+
 func newSegmentFile(name string, id uint64) *segmentFile {
 	sf := &segmentFile{
 		blocks: make(map[uint64]*blockFile),
@@ -16,9 +16,7 @@ package segmentio
 
 import (
 	"bytes"
-	"fmt"
 	"github.com/RoaringBitmap/roaring"
-	"github.com/matrixorigin/matrixone/pkg/compress"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -28,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
 	"sync"
 )
 
@@ -41,6 +40,8 @@ type blockFile struct {
 	deletes   *deletesFile
 	indexMeta *dataFile
 	destroy   sync.Mutex
+	// cache is nil if the block files are read without a cache
+	cache *BlockCache
 }
 
 func newBlock(id uint64, seg file.Segment, colCnt int, indexCnt map[int]int) *blockFile {
@@ -49,6 +50,9 @@ func newBlock(id uint64, seg file.Segment, colCnt int, indexCnt map[int]int) *bl
 		id:      id,
 		columns: make([]*columnBlock, colCnt),
 	}
+	if sf, ok := seg.(*segmentFile); ok {
+		bf.cache = sf.cache
+	}
 	bf.deletes = newDeletes(bf)
 	bf.indexMeta = newIndex(&columnBlock{block: bf}).dataFile
 	bf.OnZeroCB = bf.close
@@ -165,6 +169,15 @@ func (bf *blockFile) Destroy() error {
 	return nil
 }
 
+// Prefetch reads the data of the columns ahead
+func (bf *blockFile) Prefetch() {
+	bf.destroy.Lock()
+	defer bf.destroy.Unlock()
+	for _, cb := range bf.columns {
+		cb.data.Prefetch()
+	}
+}
+
 func (bf *blockFile) Sync() error { return bf.seg.GetSegmentFile().Sync() }
 
 func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch.IBatch, err error) {
@@ -176,31 +189,13 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 			return
 		}
 		defer f.Unref()
-		size := f.Stat().Size()
-		node := common.GPool.Alloc(uint64(size))
-		defer common.GPool.Free(node)
-		buf := node.Buf[:size]
-		if _, err = f.Read(buf); err != nil {
+		buf := make([]byte, f.Stat().OriginSize())
+		if _, err = colBlk.data.ReadOrigin(buf); err != nil {
 			return
 		}
 		vec := vector.NewVector(colTypes[i], uint64(maxRow))
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
-			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
-			if err != nil {
-				return nil, err
-			}
-			if len(decompress) != int(colBlk.data.stat.OriginSize()) {
-				panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
-					len(decompress), colBlk.data.stat.OriginSize())))
-			}
-			if err = vec.Unmarshal(decompress); err != nil {
-				return
-			}
-		} else {
-			if err = vec.Unmarshal(buf); err != nil {
-				return
-			}
+		if err = vec.Unmarshal(buf); err != nil {
+			return
 		}
 		vecs[i] = vec
 		attrs[i] = i
@@ -217,29 +212,13 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 			return
 		}
 		defer f.Unref()
-		size := f.Stat().Size()
-		buf := make([]byte, size)
-		if _, err = f.Read(buf); err != nil {
+		buf := make([]byte, f.Stat().OriginSize())
+		if _, err = colBlk.data.ReadOrigin(buf); err != nil {
 			return
 		}
 		vec := gvec.New(colTypes[i])
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
-			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
-			if err != nil {
-				return nil, err
-			}
-			if len(decompress) != int(colBlk.data.stat.OriginSize()) {
-				panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
-					len(decompress), colBlk.data.stat.OriginSize())))
-			}
-			if err = vec.Read(decompress); err != nil {
-				return
-			}
-		} else {
-			if err = vec.Read(buf); err != nil {
-				return
-			}
+		if err = vec.Read(buf); err != nil {
+			return
 		}
 		bat.Vecs[i] = vec
 	}
@@ -268,12 +247,42 @@ func (bf *blockFile) WriteBatch(bat *gbat.Batch, ts uint64) (err error) {
 	if err = bf.WriteRows(uint32(gvec.Length(bat.Vecs[0]))); err != nil {
 		return
 	}
+	cols := make([]*columnBlock, len(bat.Attrs))
+	bufs := make([][]byte, len(bat.Attrs))
 	for colIdx := range bat.Attrs {
-		if err = bf.WriteColumnVec(ts, colIdx, bat.Vecs[colIdx]); err != nil {
-			return
+		cb, err := bf.OpenColumn(colIdx)
+		if err != nil {
+			return err
+		}
+		defer cb.Close()
+		if err = cb.WriteTS(ts); err != nil {
+			return err
+		}
+		if bufs[colIdx], err = bat.Vecs[colIdx].Show(); err != nil {
+			return err
 		}
+		cols[colIdx] = bf.columns[colIdx]
 	}
-	return
+	return bf.writeColumnsData(cols, bufs)
+}
+
+// writeColumnsData writes bufs as the data of cols by one batch
+func (bf *blockFile) writeColumnsData(cols []*columnBlock, bufs [][]byte) error {
+	files := make([]*segment.BlockFile, len(cols))
+	algos := make([]int, len(cols))
+	for i, cb := range cols {
+		cb.mutex.RLock()
+		files[i] = cb.data.file[len(cb.data.file)-1]
+		cb.mutex.RUnlock()
+		algos[i] = cb.algo
+	}
+	if err := bf.seg.GetSegmentFile().AppendBatch(files, bufs, algos); err != nil {
+		return err
+	}
+	for i, cb := range cols {
+		cb.data.updateStat(files[i])
+	}
+	return nil
 }
 
 func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*roaring.Bitmap, vals map[uint16]map[uint32]interface{}, deletes *roaring.Bitmap) (err error) {
@@ -287,6 +296,8 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 	if err = bf.WriteTS(ts); err != nil {
 		return err
 	}
+	cols := make([]*columnBlock, 0, len(attrs))
+	bufs := make([][]byte, 0, len(attrs))
 	for _, colIdx := range attrs {
 		cb, err := bf.OpenColumn(colIdx)
 		if err != nil {
@@ -329,9 +340,8 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 		if err != nil {
 			return err
 		}
-		if err = cb.WriteData(buf); err != nil {
-			return err
-		}
+		cols = append(cols, bf.columns[colIdx])
+		bufs = append(bufs, buf)
 	}
-	return
+	return bf.writeColumnsData(cols, bufs)
 }
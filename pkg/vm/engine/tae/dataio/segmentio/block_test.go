@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/fileformat"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -84,3 +85,82 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockCompression(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	colCnt := 3
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	block := newBlock(common.NextGlobalSeqNum(), seg, colCnt, nil)
+	algos := []int{compress.None, compress.Lz4, compress.Zstd}
+	data := bytes.Repeat([]byte("hello tae "), 100)
+	for col, algo := range algos {
+		colBlk, err := block.OpenColumn(col)
+		assert.Nil(t, err)
+		colBlk.SetCompression(algo)
+		err = colBlk.WriteData(data)
+		assert.Nil(t, err)
+
+		df, err := colBlk.OpenDataFile()
+		assert.Nil(t, err)
+		stat := df.Stat()
+		assert.Equal(t, algo, stat.CompressAlgo())
+		assert.Equal(t, int64(len(data)), stat.OriginSize())
+		if algo == compress.None {
+			assert.Equal(t, stat.OriginSize(), stat.Size())
+		} else {
+			assert.Less(t, stat.Size(), stat.OriginSize())
+		}
+		buf := make([]byte, stat.Size())
+		_, err = df.Read(buf)
+		assert.Nil(t, err)
+		if algo != compress.None {
+			dbuf := make([]byte, stat.OriginSize())
+			buf, err = compress.Decompress(buf, dbuf, algo)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, data, buf)
+		extents := *df.(*dataFile).file[0].GetExtents()
+		assert.Equal(t, algo, extents[len(extents)-1].Algo())
+
+		df.Unref()
+		colBlk.Close()
+	}
+	block.Unref()
+}
+
+func TestBlockFileFormat(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	blkId := common.NextGlobalSeqNum()
+	blk, err := seg.OpenBlock(blkId, 2, nil)
+	assert.Nil(t, err)
+	cols := [][]byte{
+		bytes.Repeat([]byte("column 0 "), 100),
+		bytes.Repeat([]byte("column 1 "), 100),
+	}
+	for col, data := range cols {
+		colBlk, err := blk.OpenColumn(col)
+		assert.Nil(t, err)
+		colBlk.SetCompression(compress.Lz4)
+		assert.Nil(t, colBlk.WriteData(data))
+		colBlk.Close()
+	}
+
+	_, err = seg.(fileformat.SegmentFile).OpenBlockFile(blkId + 1)
+	assert.Equal(t, fileformat.ErrNotFound, err)
+	bf, err := seg.(fileformat.SegmentFile).OpenBlockFile(blkId)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, bf.ColumnCount())
+	data, err := fileformat.ReadAll(bf)
+	assert.Nil(t, err)
+	assert.Equal(t, cols, data)
+	_, err = bf.OpenColumnFile(2)
+	assert.Equal(t, fileformat.ErrNotFound, err)
+
+	blk.Close()
+	seg.Unref()
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
+)
+
+// blockCacheKey is the block file of a segment with the offset of its last
+// extent, which changes once more data is appended
+type blockCacheKey struct {
+	segment string
+	inode   uint64
+	extent  uint32
+}
+
+type blockCacheEntry struct {
+	key  blockCacheKey
+	data []byte
+}
+
+// BlockCache caches the decompressed data of the block files read from the
+// segment files sharing it. The data cached is charged to the buffer
+// manager and the least recently used data is evicted once the manager is
+// out of quota
+type BlockCache struct {
+	mutex   sync.Mutex
+	mgr     base.INodeManager
+	lru     *list.List
+	entries map[blockCacheKey]*list.Element
+	hits    uint64
+	misses  uint64
+	// prefetching is the keys being read in the background
+	prefetching map[blockCacheKey]bool
+	workers     chan struct{}
+}
+
+// MaxPrefetches is the max number of the block files read in the
+// background at the same time
+const MaxPrefetches = 8
+
+func NewBlockCache(mgr base.INodeManager) *BlockCache {
+	return &BlockCache{
+		mgr:     mgr,
+		lru:     list.New(),
+		entries: make(map[blockCacheKey]*list.Element),
+
+		prefetching: make(map[blockCacheKey]bool),
+		workers:     make(chan struct{}, MaxPrefetches),
+	}
+}
+
+// Prefetch caches the data of key returned by read in the background. The
+// prefetch is dropped if the data is cached or being read, or too many
+// prefetches are running
+func (c *BlockCache) Prefetch(key blockCacheKey, read func() ([]byte, error)) {
+	c.mutex.Lock()
+	if _, ok := c.entries[key]; ok || c.prefetching[key] {
+		c.mutex.Unlock()
+		return
+	}
+	select {
+	case c.workers <- struct{}{}:
+	default:
+		c.mutex.Unlock()
+		return
+	}
+	c.prefetching[key] = true
+	c.mutex.Unlock()
+	go func() {
+		defer func() {
+			c.mutex.Lock()
+			delete(c.prefetching, key)
+			c.mutex.Unlock()
+			<-c.workers
+		}()
+		if data, err := read(); err == nil {
+			c.Put(key, data)
+		}
+	}()
+}
+
+// Get returns the data cached of key or nil
+func (c *BlockCache) Get(key blockCacheKey) []byte {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		metrics.BlockCacheRequests.WithLabelValues(metrics.BufferMiss).Inc()
+		return nil
+	}
+	atomic.AddUint64(&c.hits, 1)
+	metrics.BlockCacheRequests.WithLabelValues(metrics.BufferHit).Inc()
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*blockCacheEntry).data
+}
+
+// Put caches data of key. data is not cached if the buffer manager is out
+// of quota with all the data cached evicted
+func (c *BlockCache) Put(key blockCacheKey, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.removeLocked(elem)
+	}
+	for !c.mgr.ApplyQuota(uint64(len(data))) {
+		back := c.lru.Back()
+		if back == nil {
+			return
+		}
+		c.removeLocked(back)
+	}
+	c.entries[key] = c.lru.PushFront(&blockCacheEntry{key: key, data: data})
+}
+
+// Remove evicts the data cached of the block file inode of segment
+func (c *BlockCache) Remove(segment string, inode uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key, elem := range c.entries {
+		if key.segment == segment && key.inode == inode {
+			c.removeLocked(elem)
+		}
+	}
+}
+
+func (c *BlockCache) removeLocked(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*blockCacheEntry)
+	delete(c.entries, entry.key)
+	c.mgr.RetuernQuota(uint64(len(entry.data)))
+}
+
+// Stats returns the number of the hits and the misses of the cache
+func (c *BlockCache) Stats() (hits, misses uint64) {
+	return atomic.LoadUint64(&c.hits), atomic.LoadUint64(&c.misses)
+}
+
+// Count returns the number of the block files cached
+func (c *BlockCache) Count() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.entries)
+}
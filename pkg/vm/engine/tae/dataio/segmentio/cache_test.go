@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"bytes"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCache(t *testing.T) {
+	mgr := buffer.NewNodeManager(100, nil)
+	cache := NewBlockCache(mgr)
+	key := func(inode uint64) blockCacheKey {
+		return blockCacheKey{segment: "seg", inode: inode}
+	}
+	cache.Put(key(1), make([]byte, 40))
+	cache.Put(key(2), make([]byte, 40))
+	assert.Equal(t, uint64(80), mgr.Total())
+	assert.NotNil(t, cache.Get(key(1)))
+	// The least recently used key 2 is evicted
+	cache.Put(key(3), make([]byte, 40))
+	assert.Nil(t, cache.Get(key(2)))
+	assert.NotNil(t, cache.Get(key(1)))
+	assert.NotNil(t, cache.Get(key(3)))
+	assert.Equal(t, uint64(80), mgr.Total())
+	// The data larger than the quota is not cached
+	cache.Put(key(4), make([]byte, 200))
+	assert.Nil(t, cache.Get(key(4)))
+	assert.Equal(t, 0, cache.Count())
+	assert.Equal(t, uint64(0), mgr.Total())
+
+	cache.Put(key(5), make([]byte, 10))
+	cache.Remove("seg", 5)
+	assert.Equal(t, 0, cache.Count())
+	assert.Equal(t, uint64(0), mgr.Total())
+	hits, misses := cache.Stats()
+	assert.Equal(t, uint64(3), hits)
+	assert.Equal(t, uint64(2), misses)
+}
+
+func TestBlockCacheRead(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	mgr := buffer.NewNodeManager(common.M, nil)
+	cache := NewBlockCache(mgr)
+	seg := NewSegmentFileIOFactoryWithCache(segment.LocalDriver, cache)(name, common.NextGlobalSeqNum())
+	block := newBlock(common.NextGlobalSeqNum(), seg, 1, nil)
+	data := bytes.Repeat([]byte("hello tae "), 100)
+	colBlk, err := block.OpenColumn(0)
+	assert.Nil(t, err)
+	assert.Nil(t, colBlk.WriteData(data))
+	df, err := colBlk.OpenDataFile()
+	assert.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		buf := make([]byte, df.Stat().OriginSize())
+		_, err = df.(common.IOriginReader).ReadOrigin(buf)
+		assert.Nil(t, err)
+		assert.Equal(t, data, buf)
+	}
+	hits, misses := cache.Stats()
+	assert.Equal(t, uint64(2), hits)
+	assert.Equal(t, uint64(1), misses)
+	assert.Equal(t, uint64(len(data)), mgr.Total())
+
+	df.Unref()
+	colBlk.Close()
+	// The data of the block files released is evicted
+	block.Unref()
+	assert.Equal(t, 0, cache.Count())
+	assert.Equal(t, uint64(0), mgr.Total())
+}
+
+func TestBlockCachePrefetch(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	cache := NewBlockCache(buffer.NewNodeManager(common.M, nil))
+	seg := NewSegmentFileIOFactoryWithCache(segment.LocalDriver, cache)(name, common.NextGlobalSeqNum())
+	block := newBlock(common.NextGlobalSeqNum(), seg, 2, nil)
+	data := bytes.Repeat([]byte("hello tae "), 100)
+	for col := 0; col < 2; col++ {
+		colBlk, err := block.OpenColumn(col)
+		assert.Nil(t, err)
+		assert.Nil(t, colBlk.WriteData(data))
+		colBlk.Close()
+	}
+
+	block.Prefetch()
+	testutils.WaitExpect(1000, func() bool {
+		return cache.Count() == 2
+	})
+	assert.Equal(t, 2, cache.Count())
+	buf := make([]byte, len(data))
+	_, err := block.columns[1].data.ReadOrigin(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, data, buf)
+	hits, _ := cache.Stats()
+	assert.Equal(t, uint64(1), hits)
+	block.Unref()
+}
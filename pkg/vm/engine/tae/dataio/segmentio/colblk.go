@@ -16,6 +16,7 @@ package segmentio
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -32,6 +33,8 @@ type columnBlock struct {
 	updates *updatesFile
 	data    *dataFile
 	col     int
+	// algo is the compress algorithm of the data written
+	algo int
 }
 
 func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
@@ -39,6 +42,7 @@ func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -64,6 +68,10 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	return
 }
 
+func (cb *columnBlock) SetCompression(algo int) {
+	cb.algo = algo
+}
+
 func (cb *columnBlock) WriteData(buf []byte) (err error) {
 	_, err = cb.data.Write(buf)
 	return
@@ -153,6 +161,9 @@ func (cb *columnBlock) Destroy() {
 	cb.mutex.RUnlock()
 	if files != nil {
 		for _, file := range files {
+			if cb.block.cache != nil {
+				cb.block.cache.Remove(file.GetSegement().GetName(), file.GetInode())
+			}
 			cb.block.seg.GetSegmentFile().ReleaseFile(file)
 		}
 	}
@@ -16,6 +16,7 @@ package segmentio
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/fileformat"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
 )
@@ -89,11 +90,16 @@ func (df *dataFile) Write(buf []byte) (n int, err error) {
 	df.colBlk.mutex.RLock()
 	file := df.file[len(df.file)-1]
 	df.colBlk.mutex.RUnlock()
-	err = file.GetSegement().Append(file, buf)
-	df.stat.algo = compress.Lz4
+	err = file.GetSegement().AppendWithCompression(file, buf, df.colBlk.algo)
+	df.updateStat(file)
+	return
+}
+
+// updateStat updates the stat of the data written to file
+func (df *dataFile) updateStat(file *segment.BlockFile) {
+	df.stat.algo = uint8(file.GetAlgo())
 	df.stat.originSize = file.GetOriginSize()
 	df.stat.size = file.GetFileSize()
-	return
 }
 
 func (df *dataFile) Read(buf []byte) (n int, err error) {
@@ -113,6 +119,117 @@ func (df *dataFile) Read(buf []byte) (n int, err error) {
 	return n, nil
 }
 
+// ReadOrigin reads the data decompressed into buf of the origin size. The
+// data of the block files is read through the block cache if any
+func (df *dataFile) ReadOrigin(buf []byte) (n int, err error) {
+	if df.file == nil {
+		return df.Read(buf)
+	}
+	df.colBlk.mutex.RLock()
+	file := df.file[len(df.file)-1]
+	df.colBlk.mutex.RUnlock()
+	cache := df.colBlk.block.cache
+	key, ok := cacheKey(file)
+	if cache == nil || !ok {
+		if n, mapped, err := readMappedOrigin(file, buf); mapped || err != nil {
+			return n, err
+		}
+		data, err := readOrigin(file)
+		return copy(buf, data), err
+	}
+	data := cache.Get(key)
+	if data == nil {
+		if data, err = readOrigin(file); err != nil {
+			return
+		}
+		cache.Put(key, data)
+	}
+	return copy(buf, data), nil
+}
+
+// Prefetch reads the data ahead into the block cache in the background, or
+// issues the readahead of the block file without a block cache
+func (df *dataFile) Prefetch() {
+	if df.file == nil {
+		return
+	}
+	df.colBlk.mutex.RLock()
+	file := df.file[len(df.file)-1]
+	df.colBlk.mutex.RUnlock()
+	cache := df.colBlk.block.cache
+	key, ok := cacheKey(file)
+	if !ok {
+		return
+	}
+	if cache == nil {
+		file.Prefetch()
+		return
+	}
+	cache.Prefetch(key, func() ([]byte, error) {
+		return readOrigin(file)
+	})
+}
+
+// cacheKey returns the key of file in the block cache. Nothing is cached of
+// an empty file
+func cacheKey(file *segment.BlockFile) (key blockCacheKey, ok bool) {
+	extents := *file.GetExtents()
+	if len(extents) == 0 {
+		return
+	}
+	key = blockCacheKey{
+		segment: file.GetSegement().GetName(),
+		inode:   file.GetInode(),
+		extent:  extents[len(extents)-1].Offset(),
+	}
+	return key, true
+}
+
+// readOrigin reads the data of file decompressed
+func readOrigin(file *segment.BlockFile) ([]byte, error) {
+	algo := file.GetAlgo()
+	data, mapped, err := file.ReadMapped()
+	if err != nil {
+		return nil, err
+	}
+	if !mapped {
+		data = make([]byte, file.GetFileSize())
+		if _, err := file.Read(data); err != nil {
+			return nil, err
+		}
+	} else if algo == compress.None {
+		return append([]byte(nil), data...), nil
+	}
+	if algo == compress.None {
+		return data, nil
+	}
+	origin := make([]byte, file.GetOriginSize())
+	if _, err = fileformat.Decompress(data, columnStat(file), origin); err != nil {
+		return nil, err
+	}
+	return origin, nil
+}
+
+func columnStat(file *segment.BlockFile) fileformat.ColumnStat {
+	return fileformat.ColumnStat{
+		Size:       file.GetFileSize(),
+		OriginSize: file.GetOriginSize(),
+		Algo:       file.GetAlgo(),
+	}
+}
+
+// readMappedOrigin decompresses the data of file from the mapping of a
+// sealed segment into buf of the origin size without allocation. It returns
+// false if the data is not mapped
+func readMappedOrigin(file *segment.BlockFile, buf []byte) (int, bool, error) {
+	data, mapped, err := file.ReadMapped()
+	if err != nil || !mapped {
+		return 0, mapped, err
+	}
+	n, err := fileformat.Decompress(data, columnStat(file), buf)
+	return n, true, err
+}
+
 func (df *dataFile) GetFileType() common.FileType {
 	return common.DiskFile
 }
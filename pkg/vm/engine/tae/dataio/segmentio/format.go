@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import "github.com/matrixorigin/matrixone/pkg/vm/engine/fileformat"
+
+var (
+	_ fileformat.SegmentFile = (*segmentFile)(nil)
+	_ fileformat.BlockFile   = (*blockFile)(nil)
+)
+
+// columnFile is the data of a column read through fileformat.ColumnFile
+type columnFile struct {
+	*dataFile
+}
+
+func (cf columnFile) Stat() fileformat.ColumnStat {
+	return fileformat.ColumnStat{
+		Size:       cf.stat.size,
+		OriginSize: cf.stat.originSize,
+		Algo:       int(cf.stat.algo),
+	}
+}
+
+func (sf *segmentFile) OpenBlockFile(id uint64) (fileformat.BlockFile, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+	bf := sf.blocks[id]
+	if bf == nil {
+		return nil, fileformat.ErrNotFound
+	}
+	return bf, nil
+}
+
+func (bf *blockFile) ColumnCount() int { return len(bf.columns) }
+
+func (bf *blockFile) OpenColumnFile(col int) (fileformat.ColumnFile, error) {
+	if col < 0 || col >= len(bf.columns) {
+		return nil, fileformat.ErrNotFound
+	}
+	return columnFile{bf.columns[col].data}, nil
+}
@@ -23,8 +23,20 @@ import (
 	"sync"
 )
 
-var SegmentFileIOFactory = func(name string, id uint64) file.Segment {
-	return newSegmentFile(name, id)
+var SegmentFileIOFactory = NewSegmentFileIOFactory(segment.LocalDriver)
+
+// NewSegmentFileIOFactory returns a factory creating the segment files
+// through driver
+func NewSegmentFileIOFactory(driver segment.Driver) file.SegmentFileFactory {
+	return NewSegmentFileIOFactoryWithCache(driver, nil)
+}
+
+// NewSegmentFileIOFactoryWithCache returns a factory creating the segment
+// files through driver sharing cache to read the block files
+func NewSegmentFileIOFactoryWithCache(driver segment.Driver, cache *BlockCache) file.SegmentFileFactory {
+	return func(name string, id uint64) file.Segment {
+		return newSegmentFile(name, id, driver, cache)
+	}
 }
 
 type segmentFile struct {
@@ -35,6 +47,7 @@ type segmentFile struct {
 	blocks map[uint64]*blockFile
 	name   string
 	seg    *segment.Segment
+	cache  *BlockCache
 }
 
 func (sf *segmentFile) RemoveBlock(id uint64) {
@@ -47,13 +60,14 @@ func (sf *segmentFile) RemoveBlock(id uint64) {
 	delete(sf.blocks, id)
 }
 
-func newSegmentFile(name string, id uint64) *segmentFile {
+func newSegmentFile(name string, id uint64, driver segment.Driver, cache *BlockCache) *segmentFile {
 	sf := &segmentFile{
 		blocks: make(map[uint64]*blockFile),
 		name:   name,
+		cache:  cache,
 	}
 	sf.seg = &segment.Segment{}
-	err := sf.seg.Init(sf.name)
+	err := sf.seg.InitWithDriver(sf.name, driver)
 	if err != nil {
 		return nil
 	}
@@ -114,6 +128,10 @@ func (sf *segmentFile) GetSegmentFile() *segment.Segment {
 	return sf.seg
 }
 
+func (sf *segmentFile) Seal() {
+	sf.seg.Seal()
+}
+
 func (sf *segmentFile) Sync() error {
 	return sf.seg.Sync()
 }
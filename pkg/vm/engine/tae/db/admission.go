@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+// admission throttles the writes when the memtables in memory or the WAL
+// entries not checkpointed exceed the thresholds. The flush and checkpoint
+// catch up while the writers back off
+type admission struct {
+	db       *DB
+	cfg      *options.AdmissionCfg
+	interval time.Duration
+}
+
+func newAdmission(db *DB, cfg *options.AdmissionCfg) *admission {
+	return &admission{
+		db:       db,
+		cfg:      cfg,
+		interval: time.Duration(cfg.WaitInterval) * time.Millisecond,
+	}
+}
+
+func (a *admission) overloaded() bool {
+	if a.cfg.MaxDirtyBytes > 0 && a.db.MTBufMgr.Total() > a.cfg.MaxDirtyBytes {
+		return true
+	}
+	if a.cfg.MaxWalBacklog > 0 && a.db.Wal.GetPenddingCnt() > a.cfg.MaxWalBacklog {
+		return true
+	}
+	return false
+}
+
+func (a *admission) Admit() error {
+	if a.overloaded() {
+		return txnbase.ErrTxnBusy
+	}
+	return nil
+}
+
+func (a *admission) Wait(ctx context.Context) error {
+	if !a.overloaded() {
+		return nil
+	}
+	logutil.Debugf("Admission: throttled, dirty=%d, wal backlog=%d",
+		a.db.MTBufMgr.Total(), a.db.Wal.GetPenddingCnt())
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return txnbase.ErrTxnBusy
+		case <-ticker.C:
+			if !a.overloaded() {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmission(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3)
+	schema.BlockMaxRows = 10
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Commit())
+	assert.True(t, tae.MTBufMgr.Total() > 0)
+
+	// Throttle the writes once the memtables are above 1 byte
+	tae.Opts.AdmissionCfg.MaxDirtyBytes = 1
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, txnbase.ErrTxnBusy, rel.Append(bats[1]))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := tae.StartTxnWithContext(ctx, nil)
+	assert.Equal(t, txnbase.ErrTxnBusy, err)
+
+	// The throttled append can be retried in the same txn
+	tae.Opts.AdmissionCfg.MaxDirtyBytes = 0
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = tae.StartTxnWithContext(context.Background(), nil)
+	assert.Nil(t, err)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(20), rel.Rows())
+	assert.Nil(t, txn.Commit())
+}
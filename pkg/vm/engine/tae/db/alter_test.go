@@ -0,0 +1,294 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddColumn(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	full := catalog.MockSchemaAll(13)
+	schema := catalog.MockSchemaAll(11)
+	schema.BlockMaxRows = 100
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+
+	bat := compute.MockBatch(full.Types(), 30, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 3)
+	oldBat := gbat.New(true, schema.Attrs())
+	oldBat.Vecs = bats[0].Vecs[:len(schema.ColDefs)]
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(oldBat))
+	assert.Nil(t, txn.Commit())
+
+	getRel := func(txn txnif.AsyncTxn) handle.Relation {
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		return rel
+	}
+
+	defaultVal, err := common.EncodeKey(types.Datetime(7), full.ColDefs[11].Type)
+	assert.Nil(t, err)
+	withDefault := &catalog.ColDef{
+		Name:    full.ColDefs[11].Name,
+		Type:    full.ColDefs[11].Type,
+		Default: defaultVal,
+	}
+	nullable := &catalog.ColDef{
+		Name:        full.ColDefs[12].Name,
+		Type:        full.ColDefs[12].Type,
+		NullAbility: 1,
+	}
+
+	txn1 := tae.StartTxn(nil)
+	txn2 := tae.StartTxn(nil)
+	rel = getRel(txn2)
+	assert.Equal(t, catalog.ErrDuplicate, rel.AddColumn(&catalog.ColDef{Name: full.ColDefs[0].Name, Type: full.ColDefs[0].Type, NullAbility: 1}))
+	assert.Equal(t, catalog.ErrNotNullableColumn, rel.AddColumn(&catalog.ColDef{Name: "not_null", Type: full.ColDefs[0].Type}))
+	assert.Nil(t, rel.AddColumn(withDefault))
+	assert.Nil(t, rel.AddColumn(nullable))
+	assert.Equal(t, 13, len(rel.Schema().(*catalog.Schema).ColDefs))
+
+	// Another txn cannot alter the table concurrently and still sees the old schema
+	assert.Equal(t, txnif.TxnWWConflictErr, getRel(txn1).AddColumn(&catalog.ColDef{Name: "c", Type: full.ColDefs[0].Type, NullAbility: 1}))
+	assert.Equal(t, 11, len(getRel(txn1).Schema().(*catalog.Schema).ColDefs))
+	assert.Nil(t, txn2.Commit())
+	assert.Equal(t, 11, len(getRel(txn1).Schema().(*catalog.Schema).ColDefs))
+
+	// The rows appended against the old schema cannot be committed
+	assert.Nil(t, getRel(txn1).Append(func() *gbat.Batch {
+		bat := gbat.New(true, schema.Attrs())
+		bat.Vecs = bats[2].Vecs[:len(schema.ColDefs)]
+		return bat
+	}()))
+	assert.Equal(t, txnimpl.ErrSchemaChanged, txn1.Commit())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	altered := rel.Schema().(*catalog.Schema)
+	assert.Equal(t, uint32(1), altered.Version)
+	assert.Equal(t, 13, len(altered.ColDefs))
+	it := rel.MakeBlockIt()
+	blk := it.GetBlock()
+	view, err := blk.GetColumnDataByName(withDefault.Name, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, view.Length())
+	assert.Equal(t, types.Datetime(7), view.GetValue(3))
+	view, err = blk.GetColumnDataById(12, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, view.Length())
+	assert.True(t, nulls.Contains(view.AppliedVec.Nsp, 5))
+	v, err := rel.GetValue(blk.Fingerprint(), 2, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, types.Datetime(7), v)
+
+	// The old appendable block is not appended any more
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	blkCnt := 0
+	it = rel.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		view, err := blk.GetColumnDataByName(nullable.Name, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 10, view.Length())
+		blkCnt++
+		it.Next()
+	}
+	assert.Equal(t, 2, blkCnt)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestDropColumn(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 100
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Commit())
+
+	getRel := func(txn txnif.AsyncTxn) handle.Relation {
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		return rel
+	}
+
+	dropped := schema.ColDefs[5].Name
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Equal(t, catalog.ErrDropSortKey, rel.DropColumn(schema.ColDefs[3].Name))
+	assert.Equal(t, catalog.ErrNotFound, rel.DropColumn("xxx"))
+	assert.Nil(t, rel.DropColumn(dropped))
+	altered := rel.Schema().(*catalog.Schema)
+	assert.Equal(t, 12, len(altered.ColDefs))
+	assert.Equal(t, -1, altered.GetColIdx(dropped))
+	assert.Equal(t, 2, int(altered.PrimaryKey))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	blk := rel.MakeBlockIt().GetBlock()
+	meta := blk.GetMeta().(*catalog.BlockEntry)
+	assert.Equal(t, 13, len(meta.GetSchema().ColDefs))
+	// The columns after the dropped one are read by name from the old block
+	view, err := blk.GetColumnDataById(5, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, view.Length())
+	_, err = blk.GetColumnDataByName(dropped, nil, nil)
+	assert.NotNil(t, err)
+	v, err := rel.GetValue(blk.Fingerprint(), 1, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, compute.GetValue(bats[0].Vecs[6], 1), v)
+
+	appendBat := gbat.New(true, altered.Attrs())
+	appendBat.Vecs = append(append(appendBat.Vecs[:0], bats[1].Vecs[:5]...), bats[1].Vecs[6:]...)
+	assert.Nil(t, rel.Append(appendBat))
+	assert.Nil(t, txn.Commit())
+
+	// Compaction rewrites the old block without the dropped column
+	factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+	assert.Nil(t, err)
+	task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	it := rel.MakeBlockIt()
+	rows := 0
+	for it.Valid() {
+		blk := it.GetBlock()
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		assert.Equal(t, 12, len(meta.GetSchema().ColDefs))
+		view, err := blk.GetColumnDataById(5, nil, nil)
+		assert.Nil(t, err)
+		rows += view.Length()
+		it.Next()
+	}
+	assert.Equal(t, 20, rows)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestReAddDroppedColumn(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 100
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+
+	bat := compute.MockBatch(schema.Types(), 10, int(schema.PrimaryKey), nil)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	getRel := func(txn txnif.AsyncTxn) handle.Relation {
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		return rel
+	}
+
+	// The column is added back with the same name and another type
+	name := schema.ColDefs[5].Name
+	txn = tae.StartTxn(nil)
+	assert.Nil(t, getRel(txn).DropColumn(name))
+	assert.Nil(t, txn.Commit())
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Nil(t, rel.AddColumn(&catalog.ColDef{
+		Name:        name,
+		Type:        types.Type{Oid: types.T_varchar, Size: 24},
+		NullAbility: 1,
+	}))
+	altered := rel.Schema().(*catalog.Schema)
+	assert.NotEqual(t, schema.ColDefs[5].SeqNum, altered.ColDefs[altered.GetColIdx(name)].SeqNum)
+	assert.Nil(t, txn.Commit())
+
+	// The old data of the column is not read back as the new column
+	checkAbsent := func(blk handle.Block) {
+		view, err := blk.GetColumnDataByName(name, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 10, view.Length())
+		assert.Equal(t, types.T_varchar, view.AppliedVec.Typ.Oid)
+		for i := 0; i < view.Length(); i++ {
+			assert.True(t, nulls.Contains(view.AppliedVec.Nsp, uint64(i)))
+		}
+	}
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	blk := rel.MakeBlockIt().GetBlock()
+	meta := blk.GetMeta().(*catalog.BlockEntry)
+	checkAbsent(blk)
+	v, err := rel.GetValue(blk.Fingerprint(), 2, uint16(altered.GetColIdx(name)))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+	assert.Nil(t, txn.Commit())
+
+	factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+	assert.Nil(t, err)
+	task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	it := rel.MakeBlockIt()
+	blkCnt := 0
+	for it.Valid() {
+		blk := it.GetBlock()
+		assert.NotEqual(t, meta.GetID(), blk.Fingerprint().BlockID)
+		checkAbsent(blk)
+		blkCnt++
+		it.Next()
+	}
+	assert.Equal(t, 1, blkCnt)
+	assert.Nil(t, txn.Commit())
+}
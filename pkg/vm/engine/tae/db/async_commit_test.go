@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncCommit(t *testing.T) {
+	opts := new(options.Options)
+	opts.WalCfg = &options.WalCfg{GroupCommitInterval: 10}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 100, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 10)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.CommitAsync())
+
+	txns := make([]txnif.AsyncTxn, 0, len(bats))
+	for _, data := range bats {
+		txn = tae.StartTxn(nil)
+		db, err = txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(data))
+		assert.Nil(t, txn.CommitAsync())
+		txns = append(txns, txn)
+	}
+
+	// The async commits are visible before they are durable
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(100), rel.Rows())
+	assert.Nil(t, txn.Commit())
+	assert.Nil(t, txn.WaitDurable())
+
+	for _, txn := range txns {
+		assert.Nil(t, txn.WaitDurable())
+		assert.Equal(t, txnif.TxnStateCommitted, txn.GetTxnState(false))
+	}
+}
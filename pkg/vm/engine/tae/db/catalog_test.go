@@ -16,11 +16,14 @@ package db
 
 import (
 	"bytes"
+	"fmt"
 	"sync"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 
@@ -454,3 +457,98 @@ func TestCheckpointCatalog(t *testing.T) {
 	// logEntry.Free()
 	// t.Log(lsn)
 }
+
+func TestCreateRelations(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+
+	txn := db.StartTxn(nil)
+	database, _ := txn.CreateDatabase("db")
+	existed := catalog.MockSchema(2)
+	existed.Name = "existed"
+	_, err := database.CreateRelation(existed)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	mockDefs := func(cnt int, prefix string) []interface{} {
+		defs := make([]interface{}, cnt)
+		for i := range defs {
+			schema := catalog.MockSchema(2)
+			schema.Name = fmt.Sprintf("%s%d", prefix, i)
+			defs[i] = schema
+		}
+		return defs
+	}
+
+	txn = db.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	// Nothing is created if any of the names is duplicated
+	defs := mockDefs(10, "dup")
+	defs[5].(*catalog.Schema).Name = "dup1"
+	_, err = database.CreateRelations(defs)
+	assert.Equal(t, catalog.ErrDuplicate, err)
+	defs = mockDefs(10, "existed")
+	defs[3].(*catalog.Schema).Name = existed.Name
+	_, err = database.CreateRelations(defs)
+	assert.Equal(t, catalog.ErrDuplicate, err)
+	_, err = database.GetRelationByName("existed0")
+	assert.Equal(t, catalog.ErrNotFound, err)
+
+	rels, err := database.CreateRelations(mockDefs(100, "tbl"))
+	assert.Nil(t, err)
+	assert.Equal(t, 100, len(rels))
+	assert.Nil(t, txn.Commit())
+
+	txn = db.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	_, err = database.GetRelationByName("tbl0")
+	assert.Nil(t, err)
+	_, err = database.GetRelationByName("tbl99")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestCompositeKey(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	assert.Equal(t, catalog.ErrValidation, schema.SetCompositeKey(schema.ColDefs[0].Name))
+	assert.Equal(t, catalog.ErrNotFound, schema.SetCompositeKey(schema.ColDefs[0].Name, "xxx"))
+	assert.Nil(t, schema.SetCompositeKey(schema.ColDefs[2].Name, schema.ColDefs[0].Name))
+	assert.Equal(t, catalog.ErrDuplicate, schema.SetCompositeKey(schema.ColDefs[1].Name, schema.ColDefs[0].Name))
+	assert.Equal(t, 5, len(schema.ColDefs))
+	assert.Equal(t, int32(4), schema.PrimaryKey)
+
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	replayed := catalog.NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.CompositeKeys, replayed.CompositeKeys)
+	assert.Equal(t, catalog.ErrDropSortKey, replayed.DropColumn(schema.ColDefs[0].Name))
+	assert.Equal(t, catalog.ErrDropHiddenColumn, replayed.DropColumn(catalog.CompositeKeyColumnName))
+
+	// The batch has the key columns only, the composite key is built on append
+	bat := compute.MockBatch(schema.Types()[:4], 30, 2, nil)
+	txn := tae.StartTxn(nil)
+	database, _ := txn.CreateDatabase("db")
+	rel, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Equal(t, txnbase.ErrDuplicated, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	assert.NotNil(t, rel.Append(bat))
+	key := []interface{}{compute.GetValue(bat.Vecs[2], 17), compute.GetValue(bat.Vecs[0], 17)}
+	id, offset, err := rel.GetByFilter(handle.NewEQFilter(key))
+	assert.Nil(t, err)
+	v, err := rel.GetValue(id, offset, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, compute.GetValue(bat.Vecs[3], 17), v)
+	assert.Nil(t, txn.Commit())
+}
@@ -0,0 +1,179 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeFeed(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, _ = db.CreateRelation(schema)
+	assert.Nil(t, txn.Commit())
+
+	_, err := tae.Subscribe("db", "xxx")
+	assert.NotNil(t, err)
+	sub, err := tae.Subscribe("db", schema.Name)
+	assert.Nil(t, err)
+	defer sub.Close()
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[0]))
+	// The rows deleted before commit are not published
+	assert.Nil(t, rel.Append(bats[1]))
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bats[1].Vecs[schema.PrimaryKey], 0)))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.RangeDelete(id, row, row))
+	assert.Nil(t, txn.Commit())
+
+	inserted := 0
+	for inserted < 19 {
+		change, err := sub.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, cdc.ChangeInsert, change.Type)
+		assert.Equal(t, txn.GetCommitTS(), change.CommitTS)
+		assert.Equal(t, len(schema.ColDefs), len(change.Data.Vecs))
+		inserted += change.Rows()
+	}
+	assert.Equal(t, 19, inserted)
+
+	pk := compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 2)
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(pk))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Update(id, row, 1, int16(999)))
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 5)))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.RangeDelete(id, row, row))
+	assert.Nil(t, txn.Commit())
+
+	change, err := sub.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, cdc.ChangeUpdate, change.Type)
+	assert.Equal(t, []string{schema.ColDefs[3].Name, schema.ColDefs[1].Name}, change.Data.Attrs)
+	assert.Equal(t, pk, compute.GetValue(change.Data.Vecs[0], 0))
+	assert.Equal(t, int16(999), compute.GetValue(change.Data.Vecs[1], 0))
+	change, err = sub.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, cdc.ChangeDelete, change.Type)
+	assert.Equal(t, 1, change.Rows())
+	assert.Equal(t, compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 5), compute.GetValue(change.Data.Vecs[0], 0))
+
+	// Nothing is published by a rollbacked txn
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(pk))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.RangeDelete(id, row, row))
+	assert.Nil(t, txn.Rollback())
+	change, err = sub.TryNext()
+	assert.Nil(t, err)
+	assert.Nil(t, change)
+}
+
+func TestChangeFeedOrder(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	bat := compute.MockBatch(schema.Types(), 8, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 4)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Commit())
+
+	sub, err := tae.Subscribe("db", schema.Name)
+	assert.Nil(t, err)
+	defer sub.Close()
+
+	// A committed row is deleted and then inserted again, and then another
+	// committed row is updated
+	pk := compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 0)
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(pk))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.RangeDelete(id, row, row))
+	assert.Nil(t, rel.Append(compute.SplitBatch(bats[0], 2)[0]))
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 1)))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Update(id, row, 1, int16(999)))
+	assert.Nil(t, txn.Commit())
+
+	change, err := sub.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, cdc.ChangeDelete, change.Type)
+	assert.Equal(t, pk, compute.GetValue(change.Data.Vecs[0], 0))
+	inserted := 0
+	for inserted < 3 {
+		change, err = sub.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, cdc.ChangeInsert, change.Type)
+		assert.Equal(t, txn.GetCommitTS(), change.CommitTS)
+		inserted += change.Rows()
+	}
+	assert.Equal(t, 3, inserted)
+	change, err = sub.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, cdc.ChangeUpdate, change.Type)
+	change, err = sub.TryNext()
+	assert.Nil(t, err)
+	assert.Nil(t, change)
+
+	// The txns are published in the order of their WAL entries
+	for i := 2; i < 4; i++ {
+		txn = tae.StartTxn(nil)
+		db, _ = txn.GetDatabase("db")
+		rel, _ = db.GetRelationByName(schema.Name)
+		assert.Nil(t, rel.Append(bats[i]))
+		assert.Nil(t, txn.Commit())
+	}
+	prev := uint64(0)
+	for i := 2; i < 4; i++ {
+		change, err := sub.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, cdc.ChangeInsert, change.Type)
+		assert.Less(t, prev, change.CommitTS)
+		prev = change.CommitTS
+	}
+}
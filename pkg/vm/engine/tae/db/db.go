@@ -15,19 +15,26 @@
 package db
 
 import (
+	"context"
 	"errors"
 	"io"
+	"net/http"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/segmentio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	wb "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
@@ -43,18 +50,36 @@ type DB struct {
 	IndexBufMgr base.INodeManager
 	MTBufMgr    base.INodeManager
 	TxnBufMgr   base.INodeManager
+	// BlockBufMgr is charged with the data cached by BlockCache
+	BlockBufMgr base.INodeManager
+
+	BlockCache *segmentio.BlockCache
 
 	TxnMgr *txnbase.TxnManager
 	Wal    wal.Driver
+	// Changes publishes the changes of the txns to the change feeds of the
+	// tables once their WAL entries are committed
+	Changes *cdc.Publisher
 
 	CKPDriver checkpoint.Driver
 
 	Scheduler tasks.TaskScheduler
 
 	TimedScanner wb.IHeartbeater
+	// TxnSweeper kills the txns past their deadline or the timeouts
+	TxnSweeper wb.IHeartbeater
+
+	Pins *PinTable
 
 	DBLocker io.Closer
 
+	// prepareLog is nil for a readonly db
+	prepareLog *prepareLog
+
+	// Metrics has the shared collectors and the ones of the db registered
+	Metrics       *prometheus.Registry
+	MetricsServer *http.Server
+
 	Closed *atomic.Value
 }
 
@@ -62,6 +87,22 @@ func (db *DB) StartTxn(info []byte) txnif.AsyncTxn {
 	return db.TxnMgr.StartTxn(info)
 }
 
+// StartTxnWithOptions starts a txn with the isolation level of opts
+func (db *DB) StartTxnWithOptions(opts txnbase.TxnOptions) txnif.AsyncTxn {
+	return db.TxnMgr.StartTxnWithOptions(opts)
+}
+
+// StartTxnWithContext starts a txn once the writes are admitted. It returns
+// txnbase.ErrTxnBusy if ctx is done before
+func (db *DB) StartTxnWithContext(ctx context.Context, info []byte) (txnif.AsyncTxn, error) {
+	return db.TxnMgr.StartTxnWithContext(ctx, info)
+}
+
+// StartTxnAt starts a read-only txn on the snapshot at ts
+func (db *DB) StartTxnAt(info []byte, ts uint64) (txnif.AsyncTxn, error) {
+	return db.TxnMgr.StartTxnAt(info, ts)
+}
+
 func (db *DB) CommitTxn(txn txnif.AsyncTxn) (err error) {
 	return txn.Commit()
 }
@@ -86,16 +127,120 @@ func (db *DB) RollbackTxn(txn txnif.AsyncTxn) error {
 	return txn.Rollback()
 }
 
+// AddTxnListener notifies listener of the lifecycle of the write txns
+func (db *DB) AddTxnListener(listener txnbase.TxnListener) {
+	db.TxnMgr.AddListener(listener)
+}
+
+// SetWalSyncPolicy sets how the WAL entries of the txns committed later
+// are made durable. store.SyncNone suits a bulk load followed by FlushWal
+func (db *DB) SetWalSyncPolicy(policy store.SyncPolicy) {
+	db.Wal.SetSyncPolicy(wal.GroupC, policy)
+	db.Wal.SetSyncPolicy(wal.GroupUC, policy)
+}
+
+// FlushWal makes the WAL entries of the txns committed before durable
+func (db *DB) FlushWal() error {
+	return db.Wal.Flush()
+}
+
+// forceWalCheckpoint schedules a checkpoint of the catalog to let the WAL
+// be truncated once it exceeds the retention limits
+func (db *DB) forceWalCheckpoint() {
+	if db.Opts.ReadOnly || db.Scheduler == nil || db.Catalog == nil {
+		return
+	}
+	_, err := db.Scheduler.ScheduleScopedFn(nil, tasks.CheckpointTask, nil,
+		db.Catalog.CheckpointClosure(db.Scheduler.GetSafeTS()))
+	if err != nil {
+		logutil.Warnf("force wal checkpoint: %v", err)
+	}
+}
+
+// ArchivedWal returns the compacted WAL files archived. With the ones in
+// the WAL dir they keep the whole WAL history
+func (db *DB) ArchivedWal() []store.ArchivedFile {
+	return db.Wal.Archived()
+}
+
+// SubscribeWal returns a subscription to the txns committed to the WAL
+// from the LSN from. The WAL files are kept until the txns are
+// acknowledged. The txns of the uncommitted entries spilled are not sent
+func (db *DB) SubscribeWal(from uint64) (*store.Subscription, error) {
+	return db.Wal.Subscribe(wal.GroupC, from)
+}
+
+// Follow applies the txns of src to a standby db like a replay. The
+// follower is started and stopped by the caller
+func (db *DB) Follow(src store.EntrySource) *store.Follower {
+	return store.NewFollower(src, db.replayHandle)
+}
+
+// InDoubtTxns returns the txns prepared before the restart without a
+// decision
+func (db *DB) InDoubtTxns() []*InDoubtTxn {
+	if db.prepareLog == nil {
+		return nil
+	}
+	return db.prepareLog.InDoubtTxns()
+}
+
+// ResolveInDoubt persists the decision of the coordinator on an in-doubt
+// txn. The writes of a committed txn are replayed in a new txn first. It
+// returns txnbase.ErrTxnNotPrepared if the txn is not in doubt
+func (db *DB) ResolveInDoubt(id uint64, commit bool) error {
+	if db.prepareLog == nil {
+		return txnbase.ErrTxnNotPrepared
+	}
+	return db.prepareLog.Resolve(id, commit, db.replayInDoubt)
+}
+
+// Subscribe returns a subscription to the changes committed to a table
+// after the subscription
+func (db *DB) Subscribe(dbName, tableName string) (sub *cdc.Subscription, err error) {
+	txn := db.StartTxn(nil)
+	defer func() {
+		_ = txn.Commit()
+	}()
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		return
+	}
+	meta := rel.GetMeta().(*catalog.TableEntry)
+	sub = meta.GetTableData().GetChangeFeed().Subscribe()
+	return
+}
+
 func (db *DB) Close() error {
 	if err := db.Closed.Load(); err != nil {
 		panic(err)
 	}
 	db.Closed.Store(ErrClosed)
-	db.TimedScanner.Stop()
-	db.CKPDriver.Stop()
+	if db.MetricsServer != nil {
+		db.MetricsServer.Close()
+	}
+	if db.TimedScanner != nil {
+		db.TimedScanner.Stop()
+	}
+	if db.CKPDriver != nil {
+		db.CKPDriver.Stop()
+	}
+	if db.TxnSweeper != nil {
+		db.TxnSweeper.Stop()
+	}
 	db.Scheduler.Stop()
 	db.TxnMgr.Stop()
+	if db.prepareLog != nil {
+		db.prepareLog.Close()
+	}
 	db.Wal.Close()
 	db.Opts.Catalog.Close()
+	if db.DBLocker == nil {
+		return nil
+	}
 	return db.DBLocker.Close()
 }
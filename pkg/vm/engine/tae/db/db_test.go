@@ -16,7 +16,10 @@ package db
 
 import (
 	"bytes"
+	"io/fs"
 	"math"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -35,12 +38,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/txnentries"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	ops "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 	"github.com/panjf2000/ants/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -1706,3 +1711,371 @@ func TestSystemDB2(t *testing.T) {
 	assert.Equal(t, 1000, rows)
 	assert.NoError(t, txn.Commit())
 }
+
+func TestSecondaryIndex(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	indexed := schema.ColDefs[2].Name
+	assert.Equal(t, catalog.ErrValidation, schema.AddIndex("idx", schema.ColDefs[3].Name))
+	assert.Equal(t, catalog.ErrValidation, schema.AddIndex("", indexed))
+	assert.Equal(t, catalog.ErrNotFound, schema.AddIndex("idx", "xxx"))
+	assert.Nil(t, schema.AddIndex("idx", indexed))
+	assert.Equal(t, catalog.ErrDuplicate, schema.AddIndex("idx", schema.ColDefs[4].Name))
+	assert.Equal(t, catalog.ErrDuplicate, schema.AddIndex("idx2", indexed))
+
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 6)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	for _, data := range bats[:4] {
+		assert.Nil(t, rel.Append(data))
+	}
+	assert.Nil(t, txn.Commit())
+
+	// compact compacts the first appendable block holding the row of key
+	compact := func(key interface{}) {
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		id, _, err := rel.GetByFilter(handle.NewEQFilter(key))
+		assert.Nil(t, err)
+		seg, err := rel.GetSegment(id.SegmentID)
+		assert.Nil(t, err)
+		blk, err := seg.GetBlock(id.BlockID)
+		assert.Nil(t, err)
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		assert.True(t, meta.IsAppendable())
+		assert.Nil(t, txn.Commit())
+		factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+		assert.Nil(t, err)
+		task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+		assert.Nil(t, err)
+		assert.Nil(t, task.WaitDone())
+	}
+	count := func(col int, key interface{}) int {
+		cnt := 0
+		for row := 0; row < vector.Length(bat.Vecs[col]); row++ {
+			if compute.GetValue(bat.Vecs[col], uint32(row)) == key {
+				cnt++
+			}
+		}
+		return cnt
+	}
+	check := func(rel handle.Relation, col int, key interface{}, expected int) {
+		ids, offsets, err := rel.GetByIndex(schema.ColDefs[col].Name, key)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, len(ids))
+		assert.Equal(t, len(ids), len(offsets))
+		if _, ok := key.(string); ok {
+			return
+		}
+		for i, id := range ids {
+			v, err := rel.GetValue(id, offsets[i], uint16(col))
+			assert.Nil(t, err)
+			assert.Equal(t, key, v)
+		}
+	}
+	key := func(col int, row uint32) interface{} {
+		return compute.GetValue(bat.Vecs[col], row)
+	}
+	compact(key(3, 0))
+
+	// Rows in the compacted block, the appendable block and the local segment
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[4]))
+	for _, row := range []uint32{3, 12, 22} {
+		check(rel, 2, key(2, row), count(2, key(2, row)))
+	}
+	check(rel, 2, int32(-1), 0)
+	_, _, err := rel.GetByIndex(schema.ColDefs[4].Name, key(4, 0))
+	assert.Equal(t, catalog.ErrNoIndex, err)
+	assert.Nil(t, txn.Commit())
+
+	// The deleted rows are skipped
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	for _, row := range []uint32{3, 12} {
+		ids, offsets, err := rel.GetByIndex(indexed, key(2, row))
+		assert.Nil(t, err)
+		assert.Nil(t, rel.RangeDelete(ids[0], offsets[0], offsets[0]))
+	}
+	assert.Nil(t, txn.Commit())
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	for _, row := range []uint32{3, 12} {
+		check(rel, 2, key(2, row), count(2, key(2, row))-1)
+	}
+
+	// The updated column of a block is scanned
+	ids, offsets, err := rel.GetByIndex(indexed, key(2, 5))
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Update(ids[0], offsets[0], 2, int32(-1)))
+	assert.Nil(t, txn.Commit())
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	check(rel, 2, int32(-1), 1)
+	check(rel, 2, key(2, 5), count(2, key(2, 5))-1)
+	assert.Nil(t, txn.Commit())
+
+	// An index added to a table is built in the blocks created after it and
+	// the blocks created before it are scanned
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.AddIndex("idx2", schema.ColDefs[12].Name))
+	assert.Nil(t, txn.Commit())
+	compact(key(3, 15))
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[5]))
+	for _, row := range []uint32{7, 15, 22, 27} {
+		check(rel, 12, key(12, row), count(12, key(12, row)))
+	}
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.DropIndex("idx2"))
+	_, _, err = rel.GetByIndex(schema.ColDefs[12].Name, key(12, 15))
+	assert.Equal(t, catalog.ErrNoIndex, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestZoneMapPruning(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	pk := schema.ColDefs[3].Name
+
+	bat := compute.MockBatch(schema.Types(), 40, int(schema.PrimaryKey), nil)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	metas := make([]*catalog.BlockEntry, 0)
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+		it.Next()
+	}
+	assert.Nil(t, txn.Commit())
+	assert.Equal(t, 4, len(metas))
+	for _, meta := range metas {
+		factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+		assert.Nil(t, err)
+		task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+		assert.Nil(t, err)
+		assert.Nil(t, task.WaitDone())
+	}
+
+	countBlocks := func(filters ...*handle.Filter) int {
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		cnt := 0
+		it := rel.MakeBlockItWithFilters(filters...)
+		for it.Valid() {
+			assert.False(t, it.GetBlock().IsAppendableBlock())
+			cnt++
+			it.Next()
+		}
+		assert.Nil(t, txn.Commit())
+		return cnt
+	}
+	assert.Equal(t, 4, countBlocks())
+	assert.Equal(t, 1, countBlocks(handle.NewColumnFilter(pk, handle.FilterLt, int64(5))))
+	assert.Equal(t, 2, countBlocks(handle.NewColumnFilter(pk, handle.FilterLe, int64(10))))
+	assert.Equal(t, 1, countBlocks(handle.NewColumnFilter(pk, handle.FilterGe, int64(35))))
+	assert.Equal(t, 0, countBlocks(handle.NewColumnFilter(pk, handle.FilterEq, int64(100))))
+	assert.Equal(t, 2, countBlocks(
+		handle.NewColumnFilter(pk, handle.FilterGt, int64(12)),
+		handle.NewColumnFilter(pk, handle.FilterLt, int64(25))))
+	assert.Equal(t, 0, countBlocks(handle.NewColumnFilter(pk, handle.FilterIsNull, nil)))
+	assert.Equal(t, 4, countBlocks(handle.NewColumnFilter(pk, handle.FilterIsNotNull, nil)))
+}
+
+func TestTimeTravel(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 3)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Commit())
+	ts := txn.GetCommitTS()
+
+	filter := handle.NewEQFilter(compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 5))
+	oldVal := compute.GetValue(bats[0].Vecs[1], 5)
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	id, row, err := rel.GetByFilter(filter)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Update(id, row, 1, int16(999)))
+	assert.Nil(t, rel.Append(bats[1]))
+	meta := rel.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry)
+	assert.Nil(t, txn.Commit())
+
+	// Keep the versions at ts readable across the compaction
+	snapshot, err := tae.StartTxnAt(nil, ts)
+	assert.Nil(t, err)
+
+	factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+	assert.Nil(t, err)
+	task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+	assert.True(t, tae.TxnMgr.StatSafeTS() < ts)
+
+	db, _ = snapshot.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	rows := 0
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		view, err := it.GetBlock().GetColumnDataById(1, nil, nil)
+		assert.Nil(t, err)
+		rows += view.Length()
+		it.Next()
+	}
+	assert.Equal(t, 10, rows)
+	id, row, err = rel.GetByFilter(filter)
+	assert.Nil(t, err)
+	v, err := rel.GetValue(id, row, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, oldVal, v)
+	assert.Nil(t, snapshot.Commit())
+
+	// A snapshot txn cannot write
+	snapshot, err = tae.StartTxnAt(nil, ts)
+	assert.Nil(t, err)
+	db, _ = snapshot.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, txnbase.ErrTxnSnapshotReadonly, rel.Append(bats[2]))
+	assert.Nil(t, snapshot.Commit())
+
+	_, err = tae.StartTxnAt(nil, tae.TxnMgr.TsAlloc.Get()+1)
+	assert.Equal(t, txnbase.ErrTxnTSTooNew, err)
+	// Without any active txn the versions at ts can be garbage collected
+	assert.True(t, tae.TxnMgr.StatSafeTS() >= ts)
+	_, err = tae.StartTxnAt(nil, ts)
+	assert.Equal(t, txnbase.ErrTxnTSTooOld, err)
+}
+
+func TestReadOnly(t *testing.T) {
+	opts := new(options.Options)
+	opts.WalCfg = &options.WalCfg{SyncPolicy: "xxx"}
+	_, err := Open(testutils.InitTestEnv(ModuleName, t), opts)
+	assert.Equal(t, options.ErrInvalidWalCfg, err)
+
+	opts = new(options.Options)
+	opts.ReadOnly = true
+	_, err = Open(testutils.InitTestEnv(ModuleName, t), opts)
+	assert.NotNil(t, err)
+
+	// A readonly db attaches to the directory of a running db
+	tae := initDB(t, nil)
+	defer tae.Close()
+	readonly, err := Open(tae.Dir, opts)
+	assert.Nil(t, err)
+	defer readonly.Close()
+	assert.Nil(t, readonly.TimedScanner)
+	assert.Nil(t, readonly.CKPDriver)
+
+	txn := readonly.StartTxn(nil)
+	_, err = txn.CreateDatabase("db")
+	assert.Equal(t, txnbase.ErrTxnReadonly, err)
+	_, err = txn.GetDatabase("db")
+	assert.NotNil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn = readonly.StartTxn(nil)
+	db, err := txn.GetDatabase(catalog.SystemDBName)
+	assert.Nil(t, err)
+	_, err = db.CreateRelation(catalog.MockSchema(2))
+	assert.Equal(t, txnbase.ErrTxnReadonly, err)
+	assert.Nil(t, txn.Commit())
+}
+
+// dirFiles returns the content of the files of dir by their paths
+func dirFiles(t *testing.T, dir string) map[string][]byte {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		files[path], err = os.ReadFile(path)
+		return err
+	})
+	assert.Nil(t, err)
+	return files
+}
+
+func TestReadOnlyAttach(t *testing.T) {
+	opts := new(options.Options)
+	opts.CheckpointCfg = new(options.CheckpointCfg)
+	opts.CheckpointCfg.ScannerInterval = 100000
+	opts.CheckpointCfg.ExecutionLevels = 20
+	opts.CheckpointCfg.ExecutionInterval = 100000
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3)
+	schema.BlockMaxRows = 10
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 5, int(schema.PrimaryKey), nil)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+	assert.Nil(t, tae.Wal.Flush())
+	// A torn entry being written at the tail of the wal
+	for path := range dirFiles(t, filepath.Join(tae.Dir, WALDir)) {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+		assert.Nil(t, err)
+		_, err = f.Write([]byte{1, 2, 3})
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+	}
+
+	// The readonly db neither writes, creates nor removes a file of the
+	// running db, the torn tail of its wal included
+	files := dirFiles(t, tae.Dir)
+	readonly, err := Open(tae.Dir, &options.Options{ReadOnly: true})
+	assert.Nil(t, err)
+	txn = readonly.StartTxn(nil)
+	_, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	_, err = readonly.Wal.AppendEntry(wal.GroupC, wal.LogEntry(store.FlushEntry))
+	assert.Equal(t, store.ErrReadOnly, err)
+	assert.Equal(t, store.ErrReadOnly, readonly.Wal.Compact())
+	assert.Nil(t, readonly.Close())
+	assert.Equal(t, files, dirFiles(t, tae.Dir))
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func getByKey(txn txnif.AsyncTxn, schema *catalog.Schema, key int32) (v interface{}, err error) {
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(key))
+	if err != nil {
+		return
+	}
+	return rel.GetValue(id, row, 0)
+}
+
+func TestIsolationNonRepeatableRead(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockNone)
+	defer tae.Close()
+	for _, level := range []txnif.IsolationLevel{txnif.IsolationSI, txnif.IsolationRC} {
+		txn := tae.StartTxnWithOptions(txnbase.TxnOptions{Isolation: level})
+		v1, err := getByKey(txn, schema, 1)
+		assert.Nil(t, err)
+
+		txn2 := tae.StartTxn(nil)
+		assert.Nil(t, updateByKey(txn2, schema, 1, int32(level)+100))
+		assert.Nil(t, txn2.Commit())
+
+		assert.Nil(t, txn.StartStatement())
+		v2, err := getByKey(txn, schema, 1)
+		assert.Nil(t, err)
+		if level == txnif.IsolationSI {
+			assert.Equal(t, v1, v2)
+		} else {
+			assert.Equal(t, int32(level)+100, v2)
+		}
+		assert.Nil(t, txn.Commit())
+	}
+}
+
+func TestIsolationLostUpdate(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockNone)
+	defer tae.Close()
+	for _, level := range []txnif.IsolationLevel{txnif.IsolationSI, txnif.IsolationRC} {
+		txn := tae.StartTxnWithOptions(txnbase.TxnOptions{Isolation: level})
+		_, err := getByKey(txn, schema, 2)
+		assert.Nil(t, err)
+
+		txn2 := tae.StartTxn(nil)
+		assert.Nil(t, updateByKey(txn2, schema, 2, 200))
+		assert.Nil(t, txn2.Commit())
+
+		// The update overwrites the one committed after the read in RC
+		assert.Nil(t, txn.StartStatement())
+		err = updateByKey(txn, schema, 2, 300)
+		if level == txnif.IsolationSI {
+			assert.NotNil(t, err)
+			assert.Nil(t, txn.Rollback())
+		} else {
+			assert.Nil(t, err)
+			assert.Nil(t, txn.Commit())
+		}
+	}
+}
+
+func TestIsolationWriteSkew(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockNone)
+	defer tae.Close()
+	for _, level := range []txnif.IsolationLevel{txnif.IsolationSI, txnif.IsolationRC} {
+		txn1 := tae.StartTxnWithOptions(txnbase.TxnOptions{Isolation: level})
+		txn2 := tae.StartTxnWithOptions(txnbase.TxnOptions{Isolation: level})
+		for _, txn := range []txnif.AsyncTxn{txn1, txn2} {
+			for _, key := range []int32{3, 4} {
+				_, err := getByKey(txn, schema, key)
+				assert.Nil(t, err)
+			}
+		}
+		// Each txn writes a row read by the other one. Both commit as only
+		// the write-write conflicts are detected
+		assert.Nil(t, updateByKey(txn1, schema, 3, 0))
+		assert.Nil(t, updateByKey(txn2, schema, 4, 0))
+		assert.Nil(t, txn1.Commit())
+		assert.Nil(t, txn2.Commit())
+	}
+}
+
+func TestIsolationRCOwnChanges(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockNone)
+	defer tae.Close()
+	txn := tae.StartTxnWithOptions(txnbase.TxnOptions{Isolation: txnif.IsolationRC})
+	assert.Nil(t, updateByKey(txn, schema, 5, 500))
+	assert.Nil(t, deleteByKey(txn, schema, 6))
+	assert.Nil(t, txn.StartStatement())
+
+	v, err := getByKey(txn, schema, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(500), v)
+	_, err = getByKey(txn, schema, 6)
+	assert.NotNil(t, err)
+	assert.Nil(t, updateByKey(txn, schema, 5, 501))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	v, err = getByKey(txn, schema, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(501), v)
+	_, err = getByKey(txn, schema, 6)
+	assert.NotNil(t, err)
+	assert.Nil(t, txn.Commit())
+}
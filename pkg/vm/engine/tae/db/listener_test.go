@@ -0,0 +1,121 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/assert"
+)
+
+var errVetoed = errors.New("vetoed")
+
+type testListener struct {
+	sync.Mutex
+	veto       uint64
+	preCommits map[uint64][]txnif.TableChanges
+	commits    map[uint64][]txnif.TableChanges
+	rollbacks  map[uint64][]txnif.TableChanges
+}
+
+func newTestListener() *testListener {
+	return &testListener{
+		preCommits: make(map[uint64][]txnif.TableChanges),
+		commits:    make(map[uint64][]txnif.TableChanges),
+		rollbacks:  make(map[uint64][]txnif.TableChanges),
+	}
+}
+
+func (l *testListener) OnPreCommit(txn txnif.AsyncTxn, changes []txnif.TableChanges) error {
+	l.Lock()
+	defer l.Unlock()
+	l.preCommits[txn.GetID()] = changes
+	if txn.GetID() == l.veto {
+		return errVetoed
+	}
+	return nil
+}
+
+func (l *testListener) OnCommit(txn txnif.AsyncTxn, changes []txnif.TableChanges) {
+	l.Lock()
+	defer l.Unlock()
+	l.commits[txn.GetID()] = changes
+}
+
+func (l *testListener) OnRollback(txn txnif.AsyncTxn, changes []txnif.TableChanges) {
+	l.Lock()
+	defer l.Unlock()
+	l.rollbacks[txn.GetID()] = changes
+}
+
+func TestTxnListener(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockNone)
+	defer tae.Close()
+	listener := newTestListener()
+	tae.AddTxnListener(listener)
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, deleteByKey(txn, schema, 1))
+	assert.Nil(t, deleteByKey(txn, schema, 2))
+	assert.Nil(t, updateByKey(txn, schema, 3, 100))
+	assert.Nil(t, txn.Commit())
+	expected := []txnif.TableChanges{{
+		DBId:     db.GetID(),
+		TableId:  rel.ID(),
+		Appended: 10,
+		Deleted:  2,
+		Updated:  1,
+	}}
+	assert.Equal(t, expected, listener.preCommits[txn.GetID()])
+	assert.Equal(t, expected, listener.commits[txn.GetID()])
+
+	// A readonly txn is not notified
+	txn = tae.StartTxn(nil)
+	_, err := getByKey(txn, schema, 3)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	assert.NotContains(t, listener.preCommits, txn.GetID())
+
+	txn = tae.StartTxn(nil)
+	assert.Nil(t, deleteByKey(txn, schema, 4))
+	assert.Nil(t, txn.Rollback())
+	assert.Len(t, listener.rollbacks[txn.GetID()], 1)
+	assert.NotContains(t, listener.commits, txn.GetID())
+
+	// The txn vetoed by the listener is rolled back
+	txn = tae.StartTxn(nil)
+	listener.Lock()
+	listener.veto = txn.GetID()
+	listener.Unlock()
+	assert.Nil(t, deleteByKey(txn, schema, 5))
+	assert.NotNil(t, txn.Commit())
+	assert.Len(t, listener.rollbacks[txn.GetID()], 1)
+	assert.NotContains(t, listener.commits, txn.GetID())
+
+	txn = tae.StartTxn(nil)
+	_, err = getByKey(txn, schema, 5)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func initLockDB(t *testing.T, policy string) (*DB, *catalog.Schema) {
+	opts := new(options.Options)
+	opts.TxnCfg = &options.TxnCfg{LockPolicy: policy}
+	tae := initDB(t, opts)
+	schema := catalog.MockSchema(2)
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 10, int(schema.PrimaryKey), nil)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+	return tae, schema
+}
+
+func deleteByKey(txn txnif.AsyncTxn, schema *catalog.Schema, key int32) error {
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(key))
+	if err != nil {
+		return err
+	}
+	return rel.RangeDelete(id, row, row)
+}
+
+func updateByKey(txn txnif.AsyncTxn, schema *catalog.Schema, key int32, v int32) error {
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(key))
+	if err != nil {
+		return err
+	}
+	return rel.Update(id, row, 0, v)
+}
+
+func TestRowLockNoWait(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockNoWait)
+	defer tae.Close()
+
+	txn1 := tae.StartTxn(nil)
+	assert.Nil(t, updateByKey(txn1, schema, 1, 100))
+	assert.Equal(t, 1, tae.TxnMgr.GetLockTable().HeldCnt(txn1.GetID()))
+
+	txn2 := tae.StartTxn(nil)
+	assert.Equal(t, txnbase.ErrTxnLockConflict, deleteByKey(txn2, schema, 1))
+	assert.Nil(t, deleteByKey(txn2, schema, 2))
+
+	assert.Nil(t, txn1.Commit())
+	assert.Equal(t, 0, tae.TxnMgr.GetLockTable().HeldCnt(txn1.GetID()))
+	assert.Nil(t, txn2.Commit())
+	assert.Equal(t, 0, tae.TxnMgr.GetLockTable().HeldCnt(txn2.GetID()))
+
+	txn := tae.StartTxn(nil)
+	assert.Nil(t, deleteByKey(txn, schema, 1))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestRowLockWait(t *testing.T) {
+	tae, schema := initLockDB(t, options.TxnLockWait)
+	defer tae.Close()
+
+	txn1 := tae.StartTxn(nil)
+	assert.Nil(t, deleteByKey(txn1, schema, 1))
+
+	// txn2 waits until txn1 rolls back
+	txn2 := tae.StartTxn(nil)
+	done := make(chan error)
+	go func() {
+		done <- deleteByKey(txn2, schema, 1)
+	}()
+	select {
+	case <-done:
+		t.Fatal("the delete is expected to wait for the lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Nil(t, txn1.Rollback())
+	assert.Nil(t, <-done)
+	assert.Nil(t, txn2.Commit())
+
+	// A txn waiting on another txn waiting on it is a deadlock
+	txn1 = tae.StartTxn(nil)
+	txn2 = tae.StartTxn(nil)
+	assert.Nil(t, updateByKey(txn1, schema, 2, 100))
+	assert.Nil(t, updateByKey(txn2, schema, 3, 100))
+	go func() {
+		done <- updateByKey(txn1, schema, 3, 200)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, txnbase.ErrTxnDeadlock, deleteByKey(txn2, schema, 2))
+	assert.Nil(t, txn2.Rollback())
+	assert.Nil(t, <-done)
+	assert.Nil(t, txn1.Commit())
+}
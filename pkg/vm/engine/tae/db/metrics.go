@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	segmentsDesc = prometheus.NewDesc(
+		"tae_segments",
+		"Number of segments by state.",
+		[]string{"state"}, nil)
+	blocksDesc = prometheus.NewDesc(
+		"tae_blocks",
+		"Number of blocks by state.",
+		[]string{"state"}, nil)
+)
+
+// spaceCollector reports the segment and block counts of the space report
+// on each scrape
+type spaceCollector struct {
+	db *DB
+}
+
+func (c *spaceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- segmentsDesc
+	ch <- blocksDesc
+}
+
+func (c *spaceCollector) Collect(ch chan<- prometheus.Metric) {
+	report := c.db.SpaceReport()
+	gauge := func(desc *prometheus.Desc, v int, state string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(v), state)
+	}
+	gauge(segmentsDesc, report.Segments, "total")
+	gauge(segmentsDesc, report.DroppedSegments, "dropped")
+	gauge(segmentsDesc, report.PinnedSegments, "pinned")
+	gauge(blocksDesc, report.Blocks, "total")
+	gauge(blocksDesc, report.DroppedBlocks, "dropped")
+	gauge(blocksDesc, report.PinnedBlocks, "pinned")
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	opts := new(options.Options)
+	opts.MetricsCfg = &options.MetricsCfg{ListenAddr: "127.0.0.1:0"}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	assert.NotNil(t, tae.MetricsServer)
+
+	schema := catalog.MockSchemaAll(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	_, err := txn.CreateDatabase("db")
+	assert.NotNil(t, err)
+	assert.Nil(t, txn.Rollback())
+
+	resp, err := http.Get("http://" + tae.MetricsServer.Addr + "/metrics")
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	buf, err := io.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	body := string(buf)
+	for _, name := range []string{
+		"tae_append_rows_total",
+		"tae_txn_committed_total",
+		"tae_txn_aborted_total",
+		"tae_wal_bytes_total",
+		"tae_wal_sync_duration_seconds",
+		"tae_wal_sync_entries",
+		"tae_buffer_requests_total",
+		"tae_flush_duration_seconds",
+		`tae_segments{state="total"} 2`,
+		`tae_blocks{state="total"} 3`,
+	} {
+		assert.True(t, strings.Contains(body, name), name)
+	}
+
+	families, err := tae.Metrics.Gather()
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(families))
+}
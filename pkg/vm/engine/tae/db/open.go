@@ -15,14 +15,22 @@
 package db
 
 import (
+	"io"
+	"os"
 	"sync/atomic"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/segmentio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/objstore"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	w "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
@@ -37,8 +45,19 @@ const (
 )
 
 func Open(dirname string, opts *options.Options) (db *DB, err error) {
-	dbLocker, err := createDBLock(dirname)
-	if err != nil {
+	opts = opts.FillDefaults(dirname)
+	if err = opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	var dbLocker io.Closer
+	if opts.ReadOnly {
+		// A readonly db can attach to the directory of a running db or a copy
+		// of it without the lock
+		if _, err = os.Stat(dirname); err != nil {
+			return nil, err
+		}
+	} else if dbLocker, err = createDBLock(dirname); err != nil {
 		return nil, err
 	}
 	defer func() {
@@ -47,37 +66,137 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		}
 	}()
 
-	opts = opts.FillDefaults(dirname)
-
 	indexBufMgr := buffer.NewNodeManager(opts.CacheCfg.IndexCapacity, nil)
 	mutBufMgr := buffer.NewNodeManager(opts.CacheCfg.InsertCapacity, nil)
 	txnBufMgr := buffer.NewNodeManager(opts.CacheCfg.TxnCapacity, nil)
+	blockBufMgr := buffer.NewNodeManager(opts.CacheCfg.BlockCapacity, nil)
 
 	db = &DB{
 		Dir:         dirname,
 		Opts:        opts,
 		IndexBufMgr: indexBufMgr,
+		BlockBufMgr: blockBufMgr,
 		MTBufMgr:    mutBufMgr,
 		TxnBufMgr:   txnBufMgr,
+		Pins:        NewPinTable(),
 		Closed:      new(atomic.Value),
 	}
 
-	db.Wal = wal.NewDriver(dirname, WALDir, nil)
+	walCfg := &store.StoreCfg{
+		SkipSync:      opts.WalCfg.SyncPolicy == options.WalSyncNone,
+		SyncDuration:  time.Duration(opts.WalCfg.GroupCommitInterval) * time.Millisecond,
+		SyncEntries:   opts.WalCfg.GroupCommitEntries,
+		SyncInterval:  time.Duration(opts.WalCfg.SyncInterval) * time.Millisecond,
+		KeyProvider:   opts.WalCfg.KeyProvider,
+		SalvageReplay: opts.WalCfg.SalvageReplay,
+		MaxFileSize:   int(opts.WalCfg.MaxFileSize),
+		ReadOnly:      opts.ReadOnly,
+	}
+	if opts.WalCfg.SyncPolicy == options.WalSyncInterval {
+		walCfg.GroupPolicies = map[uint32]store.SyncPolicy{
+			wal.GroupC:  store.SyncInterval,
+			wal.GroupUC: store.SyncInterval,
+		}
+	}
+	if opts.WalCfg.Compression == options.WalCompressLz4 {
+		walCfg.Compression = compress.Lz4
+	}
+	// The prepare log shares the config without the archiver and the
+	// retention
+	prepareCfg := *walCfg
+	walCfg.MaxTotalSize = opts.WalCfg.MaxTotalSize
+	walCfg.MaxAge = time.Duration(opts.WalCfg.MaxAge) * time.Millisecond
+	walCfg.ForceCheckpoint = db.forceWalCheckpoint
+	if opts.WalCfg.RetentionAction == options.WalRetentionBlock {
+		walCfg.RetentionAction = store.RetentionBlock
+	}
+	if opts.WalCfg.ArchiveDir != "" {
+		walCfg.Archiver = store.NewFSArchiver(opts.WalCfg.ArchiveDir)
+	} else if opts.WalCfg.ArchiveToObjectStore {
+		walCfg.Archiver = store.NewObjectArchiver(
+			newObjectStore(opts.ObjectStoreCfg),
+			opts.ObjectStoreCfg.Prefix+options.WalArchivePrefix)
+	}
+	db.Changes = cdc.NewPublisher(wal.GroupC)
+	walCfg.CommitObserver = db.Changes
+	db.Wal = wal.NewDriver(dirname, WALDir, walCfg)
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
-	if db.Opts.Catalog, err = catalog.OpenCatalog(dirname, CATALOGDir, nil, db.Scheduler); err != nil {
+	if db.Opts.Catalog, err = catalog.OpenCatalog(dirname, CATALOGDir, &store.StoreCfg{ReadOnly: opts.ReadOnly}, db.Scheduler); err != nil {
 		return
 	}
-	dataFactory := tables.NewDataFactory(segmentio.SegmentFileIOFactory, mutBufMgr, db.Scheduler, db.Dir)
+	driver := segment.LocalDriver
+	if cfg := opts.ObjectStoreCfg; cfg.Endpoint != "" {
+		driver = segment.NewObjectDriver(newObjectStore(cfg), cfg.Prefix, cfg.CacheCapacity)
+	} else if opts.StorageCfg.DirectIO || opts.StorageCfg.DSync {
+		var flags segment.OpenFlag
+		if opts.StorageCfg.DirectIO {
+			flags |= segment.FlagDirect
+		}
+		if opts.StorageCfg.DSync {
+			flags |= segment.FlagDSync
+		}
+		driver = segment.NewLocalDriver(flags)
+	}
+	if opts.KeyProvider != nil {
+		driver = segment.NewEncryptedDriver(driver, opts.KeyProvider)
+	}
+	db.BlockCache = segmentio.NewBlockCache(blockBufMgr)
+	segmentFactory := segmentio.NewSegmentFileIOFactoryWithCache(driver, db.BlockCache)
+	dataFactory := tables.NewDataFactory(segmentFactory, mutBufMgr, db.Scheduler, db.Dir)
 	db.Catalog = db.Opts.Catalog
 
 	// Init and start txn manager
-	txnStoreFactory := txnimpl.TxnStoreFactory(db.Opts.Catalog, db.Wal, txnBufMgr, dataFactory)
+	txnStoreFactory := txnimpl.TxnStoreFactory(db.Opts.Catalog, db.Wal, txnBufMgr, dataFactory, opts.TxnCfg.SpillRows, db.Changes)
 	txnFactory := txnimpl.TxnFactory(db.Opts.Catalog)
 	db.TxnMgr = txnbase.NewTxnManager(txnStoreFactory, txnFactory)
+	db.TxnMgr.SetSnapshotRetention(time.Duration(opts.TxnCfg.SnapshotRetention) * time.Millisecond)
+	db.TxnMgr.SetReadonly(opts.ReadOnly)
+	db.TxnMgr.SetAdmitter(newAdmission(db, opts.AdmissionCfg))
+	if opts.TxnCfg.LockPolicy != options.TxnLockNone {
+		db.TxnMgr.SetLockTable(txnbase.NewLockTable(opts.TxnCfg.LockPolicy == options.TxnLockWait))
+	}
+	if !opts.ReadOnly {
+		if err = db.replayData(dataFactory); err != nil {
+			return
+		}
+		if db.prepareLog, err = openPrepareLog(dirname, &prepareCfg); err != nil {
+			return
+		}
+		db.TxnMgr.SetPrepareLogger(db.prepareLog)
+		// The txns start after the checkpointed ones and the ids of the
+		// in-doubt txns are not reused
+		prevTxnId, prevTs := db.TxnMgr.IdAlloc.Get(), db.TxnMgr.TsAlloc.Get()
+		if db.prepareLog.maxTxnId > prevTxnId {
+			prevTxnId = db.prepareLog.maxTxnId
+		}
+		if ts := db.Catalog.GetCheckpointed().MaxTS; ts > prevTs {
+			prevTs = ts
+		}
+		_ = db.TxnMgr.Init(prevTxnId, prevTs)
+	}
+	db.TxnMgr.SetTxnTimeouts(
+		time.Duration(opts.TxnCfg.MaxLifetime)*time.Millisecond,
+		time.Duration(opts.TxnCfg.MaxIdle)*time.Millisecond)
 	db.TxnMgr.Start()
+	db.TxnSweeper = w.NewHeartBeater(time.Duration(opts.TxnCfg.SweepInterval)*time.Millisecond, &txnSweeper{db: db})
+	db.TxnSweeper.Start()
 
 	db.DBLocker, dbLocker = dbLocker, nil
 
+	db.Metrics = metrics.NewRegistry()
+	db.Metrics.MustRegister(&spaceCollector{db: db})
+	if opts.MetricsCfg.ListenAddr != "" {
+		if db.MetricsServer, err = metrics.Serve(opts.MetricsCfg.ListenAddr, db.Metrics); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	// A readonly db never checkpoints or compacts in background
+	if opts.ReadOnly {
+		return
+	}
+
 	// Init checkpoint driver
 	policyCfg := new(checkpoint.PolicyCfg)
 	policyCfg.Levels = int(opts.CheckpointCfg.ExecutionLevels)
@@ -87,7 +206,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	// Init timed scanner
 	scanner := NewDBScanner(db, nil)
 	calibrationOp := newCalibrationOp(db)
-	catalogMonotor := newCatalogStatsMonitor(db, opts.CheckpointCfg.CatalogUnCkpLimit, time.Duration(opts.CheckpointCfg.CatalogCkpInterval))
+	catalogMonotor := newCatalogStatsMonitor(db, opts.CheckpointCfg.CatalogUnCkpLimit, time.Duration(opts.CheckpointCfg.CatalogCkpInterval)*time.Millisecond)
 	scanner.RegisterOp(calibrationOp)
 	scanner.RegisterOp(catalogMonotor)
 	db.TimedScanner = w.NewHeartBeater(time.Duration(opts.CheckpointCfg.ScannerInterval)*time.Millisecond, scanner)
@@ -98,3 +217,14 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	return
 }
+
+func newObjectStore(cfg *options.ObjectStoreCfg) objstore.ObjectStore {
+	return objstore.NewS3Store(objstore.S3Cfg{
+		Endpoint:  cfg.Endpoint,
+		Region:    cfg.Region,
+		Bucket:    cfg.Bucket,
+		AccessKey: cfg.AccessKey,
+		SecretKey: cfg.SecretKey,
+		Secure:    cfg.Secure,
+	})
+}
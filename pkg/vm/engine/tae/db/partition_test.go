@@ -0,0 +1,107 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionedTable(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchema(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	assert.Nil(t, schema.SetRangePartition("mock_2", int32(10), int32(20)))
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 3)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bats[2]))
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Commit())
+
+	// Each batch is appended in another txn to check the appendable segment
+	// of each partition is found
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	segs := make(map[uint64]uint32)
+	it := rel.MakeSegmentIt()
+	for it.Valid() {
+		meta := it.GetSegment().GetMeta().(*catalog.SegmentEntry)
+		segs[meta.GetID()] = meta.GetPartition()
+		it.Next()
+	}
+	assert.Equal(t, 3, len(segs))
+	blkIt := rel.MakeBlockIt()
+	rows := 0
+	for blkIt.Valid() {
+		blk := blkIt.GetBlock()
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		view, err := blk.GetColumnDataByName("mock_2", nil, nil)
+		assert.Nil(t, err)
+		for i := 0; i < view.Length(); i++ {
+			v := compute.GetValue(view.AppliedVec, uint32(i)).(int32)
+			assert.Equal(t, uint32(v/10), meta.GetSegment().GetPartition())
+		}
+		rows += view.Length()
+		blkIt.Next()
+	}
+	assert.Equal(t, 30, rows)
+
+	eq := handle.NewColumnFilter("mock_2", handle.FilterEq, int32(15))
+	assert.Equal(t, []uint32{1}, rel.Partitions(eq))
+	ge := handle.NewColumnFilter("mock_2", handle.FilterGe, int32(10))
+	assert.Equal(t, []uint32{1, 2}, rel.Partitions(ge))
+	assert.Equal(t, []uint32{0, 1, 2}, rel.Partitions())
+	blkIt = rel.MakeBlockItWithFilters(ge)
+	for blkIt.Valid() {
+		meta := blkIt.GetBlock().GetMeta().(*catalog.BlockEntry)
+		assert.NotEqual(t, uint32(0), meta.GetSegment().GetPartition())
+		blkIt.Next()
+	}
+	assert.Nil(t, txn.Commit())
+	dbId, tblId := db.GetID(), rel.ID()
+	tae.Close()
+
+	c, err := catalog.OpenCatalog(tae.Dir, CATALOGDir, nil, nil)
+	assert.Nil(t, err)
+	defer c.Close()
+	dbEntry, err := c.GetDatabaseByID(dbId)
+	assert.Nil(t, err)
+	tblEntry, err := dbEntry.GetTableEntryByID(tblId)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), tblEntry.GetSchema().Partition.Count())
+	for id, partition := range segs {
+		seg, err := tblEntry.GetSegmentByID(id)
+		assert.Nil(t, err)
+		assert.Equal(t, partition, seg.GetPartition())
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+var (
+	ErrPinExpired   = errors.New("tae pin: lease expired")
+	ErrPinBadLease  = errors.New("tae pin: bad lease")
+	ErrScopesPinned = errors.New("tae pin: scopes pinned")
+)
+
+// Pin keeps a block or a segment from being compacted, merged or garbage
+// collected until it is released or its lease expires. External consumers
+// like backup or export tools should renew the lease periodically while
+// they are still reading the pinned files.
+type Pin struct {
+	table    *PinTable
+	id       uint64
+	scope    common.ID
+	expireAt time.Time
+}
+
+func (pin *Pin) ID() uint64        { return pin.id }
+func (pin *Pin) Scope() *common.ID { return &pin.scope }
+func (pin *Pin) ExpireAt() time.Time {
+	pin.table.RLock()
+	defer pin.table.RUnlock()
+	return pin.expireAt
+}
+
+func (pin *Pin) String() string {
+	return fmt.Sprintf("PIN<%d>[%s][Expire=%s]", pin.id, pin.scope.String(), pin.ExpireAt().Format(time.RFC3339Nano))
+}
+
+// Renew extends the lease of the pin to now + lease. An already expired pin
+// cannot be renewed because the pinned scopes may have been reclaimed
+func (pin *Pin) Renew(lease time.Duration) error {
+	return pin.table.renew(pin, lease)
+}
+
+// Unpin releases the pin. It is safe to unpin an expired pin
+func (pin *Pin) Unpin() {
+	pin.table.remove(pin)
+}
+
+type PinTable struct {
+	sync.RWMutex
+	idAlloc *common.IdAlloctor
+	pins    map[uint64]*Pin
+	now     func() time.Time
+}
+
+func NewPinTable() *PinTable {
+	return &PinTable{
+		idAlloc: common.NewIdAlloctor(1),
+		pins:    make(map[uint64]*Pin),
+		now:     time.Now,
+	}
+}
+
+func (table *PinTable) add(scope common.ID, lease time.Duration) (pin *Pin, err error) {
+	if lease <= 0 {
+		err = ErrPinBadLease
+		return
+	}
+	pin = &Pin{
+		table: table,
+		id:    table.idAlloc.Alloc(),
+		scope: scope,
+	}
+	table.Lock()
+	defer table.Unlock()
+	pin.expireAt = table.now().Add(lease)
+	table.pins[pin.id] = pin
+	return
+}
+
+func (table *PinTable) renew(pin *Pin, lease time.Duration) error {
+	if lease <= 0 {
+		return ErrPinBadLease
+	}
+	table.Lock()
+	defer table.Unlock()
+	now := table.now()
+	if _, ok := table.pins[pin.id]; !ok || !pin.expireAt.After(now) {
+		delete(table.pins, pin.id)
+		return ErrPinExpired
+	}
+	pin.expireAt = now.Add(lease)
+	return nil
+}
+
+func (table *PinTable) remove(pin *Pin) {
+	table.Lock()
+	defer table.Unlock()
+	delete(table.pins, pin.id)
+}
+
+// purgeExpiredLocked removes all expired pins and returns the remaining count
+func (table *PinTable) purgeExpiredLocked() int {
+	now := table.now()
+	for id, pin := range table.pins {
+		if !pin.expireAt.After(now) {
+			delete(table.pins, id)
+		}
+	}
+	return len(table.pins)
+}
+
+// CheckScopes returns ErrScopesPinned if any of the specified scopes is
+// overlapped with an active pin
+func (table *PinTable) CheckScopes(scopes []common.ID) error {
+	table.Lock()
+	defer table.Unlock()
+	if table.purgeExpiredLocked() == 0 {
+		return nil
+	}
+	for _, pin := range table.pins {
+		for i := range scopes {
+			if err := ScopeConflictCheck(&pin.scope, &scopes[i]); err != nil {
+				return ErrScopesPinned
+			}
+		}
+	}
+	return nil
+}
+
+func (table *PinTable) IsPinned(scope *common.ID) bool {
+	return table.CheckScopes([]common.ID{*scope}) != nil
+}
+
+func (table *PinTable) Pins() []*Pin {
+	table.Lock()
+	defer table.Unlock()
+	table.purgeExpiredLocked()
+	pins := make([]*Pin, 0, len(table.pins))
+	for _, pin := range table.pins {
+		pins = append(pins, pin)
+	}
+	return pins
+}
+
+// PinBlock pins the specified block for lease duration
+func (db *DB) PinBlock(entry *catalog.BlockEntry, lease time.Duration) (*Pin, error) {
+	return db.pinEntry(entry.BaseEntry, *entry.AsCommonID(), lease)
+}
+
+// PinSegment pins the specified segment and all its blocks for lease duration
+func (db *DB) PinSegment(entry *catalog.SegmentEntry, lease time.Duration) (*Pin, error) {
+	return db.pinEntry(entry.BaseEntry, *entry.AsCommonID(), lease)
+}
+
+func (db *DB) pinEntry(entry *catalog.BaseEntry, scope common.ID, lease time.Duration) (*Pin, error) {
+	entry.RLock()
+	dropped := entry.IsDroppedCommitted()
+	entry.RUnlock()
+	if dropped {
+		return nil, catalog.ErrNotFound
+	}
+	return db.Pins.add(scope, lease)
+}
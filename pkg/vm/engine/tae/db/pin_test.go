@@ -0,0 +1,102 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinTable(t *testing.T) {
+	table := NewPinTable()
+	now := time.Now()
+	table.now = func() time.Time { return now }
+
+	_, err := table.add(common.ID{TableID: 1, SegmentID: 2}, 0)
+	assert.Equal(t, ErrPinBadLease, err)
+
+	segPin, err := table.add(common.ID{TableID: 1, SegmentID: 2}, time.Second)
+	assert.Nil(t, err)
+	blkPin, err := table.add(common.ID{TableID: 1, SegmentID: 3, BlockID: 4}, time.Second*2)
+	assert.Nil(t, err)
+
+	assert.True(t, table.IsPinned(&common.ID{TableID: 1, SegmentID: 2, BlockID: 5}))
+	assert.True(t, table.IsPinned(&common.ID{TableID: 1, SegmentID: 3}))
+	assert.True(t, table.IsPinned(&common.ID{TableID: 1, SegmentID: 3, BlockID: 4}))
+	assert.False(t, table.IsPinned(&common.ID{TableID: 1, SegmentID: 3, BlockID: 5}))
+	assert.False(t, table.IsPinned(&common.ID{TableID: 2, SegmentID: 2}))
+
+	now = now.Add(time.Millisecond * 1500)
+	assert.False(t, table.IsPinned(&common.ID{TableID: 1, SegmentID: 2, BlockID: 5}))
+	assert.Equal(t, ErrPinExpired, segPin.Renew(time.Second))
+	assert.Nil(t, blkPin.Renew(time.Second))
+	assert.Equal(t, 1, len(table.Pins()))
+
+	blkPin.Unpin()
+	assert.False(t, table.IsPinned(&common.ID{TableID: 1, SegmentID: 3, BlockID: 4}))
+	assert.Equal(t, 0, len(table.Pins()))
+}
+
+func TestPinBlock(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 100
+	schema.SegmentMaxBlocks = 2
+
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows), int(schema.PrimaryKey), nil)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	err := rel.Append(bat)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	it := rel.MakeBlockIt()
+	meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+	assert.Nil(t, txn.Commit())
+
+	pin, err := tae.PinBlock(meta, time.Minute)
+	assert.Nil(t, err)
+
+	report := tae.SpaceReport()
+	t.Log(report.String())
+	assert.Equal(t, 1, report.Blocks)
+	assert.Equal(t, 1, report.PinnedBlocks)
+	assert.Equal(t, 1, report.PinnedSegments)
+	assert.Equal(t, 1, len(report.Pins))
+
+	factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+	assert.Nil(t, err)
+	_, err = tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+	assert.Equal(t, ErrScopesPinned, err)
+	_, err = tae.Scheduler.ScheduleMultiScopedFn(nil, tasks.GCTask, MakeSegmentScopes(meta.GetSegment()), gcBlockClosure(meta))
+	assert.Equal(t, ErrScopesPinned, err)
+
+	pin.Unpin()
+	assert.Equal(t, 0, tae.SpaceReport().PinnedBlocks)
+	task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenameRelation(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+	oldName := schema.Name
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	// A txn appending concurrently is not affected by the rename
+	txn1 := tae.StartTxn(nil)
+	db, _ = txn1.GetDatabase("db")
+	rel, _ := db.GetRelationByName(oldName)
+	assert.Nil(t, rel.Append(bats[0]))
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	_, err = db.RenameRelation(oldName, "tb")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	assert.Nil(t, txn1.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	_, err = db.GetRelationByName(oldName)
+	assert.Equal(t, catalog.ErrNotFound, err)
+	rel, err = db.GetRelationByName("tb")
+	assert.Nil(t, err)
+	assert.Equal(t, "tb", rel.Schema().(*catalog.Schema).Name)
+	assert.Equal(t, uint32(0), rel.Schema().(*catalog.Schema).Version)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+
+	// A rolled back rename leaves the names unchanged
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	_, err = db.RenameRelation("tb", "tb2")
+	assert.Nil(t, err)
+	_, err = txn.RenameDatabase("db", "db2")
+	assert.Nil(t, err)
+	assert.Equal(t, "db2", db.GetName())
+	assert.Nil(t, txn.Rollback())
+
+	txn = tae.StartTxn(nil)
+	_, err = txn.GetDatabase("db2")
+	assert.Equal(t, catalog.ErrNotFound, err)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	_, err = db.GetRelationByName("tb2")
+	assert.Equal(t, catalog.ErrNotFound, err)
+	_, err = db.GetRelationByName("tb")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	// A relation renamed and dropped in the same txn is gone by both names
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	_, err = db.RenameRelation("tb", "tb2")
+	assert.Nil(t, err)
+	_, err = db.DropRelationByName("tb2")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	_, err = db.GetRelationByName("tb")
+	assert.Equal(t, catalog.ErrNotFound, err)
+	_, err = db.GetRelationByName("tb2")
+	assert.Equal(t, catalog.ErrNotFound, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestReplayRename(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchema(2)
+	oldName := schema.Name
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, txn.Commit())
+	dbId, tblId := db.GetID(), rel.ID()
+	assert.Nil(t, tae.Catalog.Checkpoint(tae.Scheduler.GetSafeTS()))
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.RenameDatabase("db", "db2")
+	_, err := db.RenameRelation(oldName, "tb")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	// A database created and renamed in the same txn is created by the new name
+	txn = tae.StartTxn(nil)
+	_, _ = txn.CreateDatabase("db3")
+	_, err = txn.RenameDatabase("db3", "db4")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	tae.Close()
+
+	c, err := catalog.OpenCatalog(tae.Dir, CATALOGDir, nil, nil)
+	assert.Nil(t, err)
+	defer c.Close()
+	dbEntry, err := c.GetDatabaseByID(dbId)
+	assert.Nil(t, err)
+	assert.Equal(t, "db2", dbEntry.GetName())
+	tblEntry, err := dbEntry.GetTableEntryByID(tblId)
+	assert.Nil(t, err)
+	assert.Equal(t, "tb", tblEntry.GetSchema().Name)
+
+	txnMgr := txnbase.NewTxnManager(catalog.MockTxnStoreFactory(c), catalog.MockTxnFactory(c))
+	txnMgr.Start()
+	defer txnMgr.Stop()
+	txn = txnMgr.StartTxn(nil)
+	_, err = txn.GetDatabase("db")
+	assert.Equal(t, catalog.ErrNotFound, err)
+	_, err = txn.GetDatabase("db3")
+	assert.Equal(t, catalog.ErrNotFound, err)
+	_, err = txn.GetDatabase("db4")
+	assert.Nil(t, err)
+	db, err = txn.GetDatabase("db2")
+	assert.Nil(t, err)
+	_, err = db.GetRelationByName(oldName)
+	assert.Equal(t, catalog.ErrNotFound, err)
+	_, err = db.GetRelationByName("tb")
+	assert.Nil(t, err)
+}
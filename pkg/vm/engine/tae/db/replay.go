@@ -9,6 +9,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
@@ -18,6 +19,50 @@ func (db *DB) ReplayDDL() {
 	db.Wal.Replay(db.replayHandle)
 }
 
+// replayData makes the data of the entries replayed from the catalog
+// checkpoints and moves the ids of the catalog past theirs
+func (db *DB) replayData(dataFactory *tables.DataFactory) (err error) {
+	c := db.Catalog
+	maxDB, maxTable, maxSegment, maxBlock := c.CurrDB(), c.CurrTable(), c.CurrSegment(), c.CurrBlock()
+	processor := new(catalog.LoopProcessor)
+	processor.DatabaseFn = func(entry *catalog.DBEntry) error {
+		if entry.IsSystemDB() {
+			return catalog.ErrStopCurrRecur
+		}
+		if entry.GetID() > maxDB {
+			maxDB = entry.GetID()
+		}
+		return nil
+	}
+	processor.TableFn = func(entry *catalog.TableEntry) error {
+		if entry.GetID() > maxTable {
+			maxTable = entry.GetID()
+		}
+		entry.InitData(dataFactory.MakeTableFactory())
+		return nil
+	}
+	processor.SegmentFn = func(entry *catalog.SegmentEntry) error {
+		if entry.GetID() > maxSegment {
+			maxSegment = entry.GetID()
+		}
+		entry.InitData(dataFactory.MakeSegmentFactory())
+		return nil
+	}
+	processor.BlockFn = func(entry *catalog.BlockEntry) error {
+		if entry.GetID() > maxBlock {
+			maxBlock = entry.GetID()
+		}
+		segFile := entry.GetSegment().GetSegmentData().GetSegmentFile()
+		entry.InitData(dataFactory.MakeBlockFactory(segFile))
+		return nil
+	}
+	if err = c.RecurLoop(processor); err != nil {
+		return
+	}
+	c.IDAlloctor.Init(maxDB, maxTable, maxSegment, maxBlock)
+	return
+}
+
 func (db *DB) replayHandle(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
 	r := bytes.NewBuffer(payload)
 	txnCmd, _, err := txnbase.BuildCommandFrom(r)
@@ -68,10 +113,6 @@ func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) (err error) {
 		if err != nil {
 			return err
 		}
-		attrs := make([]string, len(tb.GetSchema().ColDefs))
-		for i := range attrs {
-			attrs[i] = tb.GetSchema().ColDefs[i].Name
-		}
 		seg, err := tb.GetSegmentByID(id.SegmentID)
 		if err != nil {
 			return err
@@ -80,6 +121,7 @@ func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) (err error) {
 		if err != nil {
 			return err
 		}
+		attrs := blk.GetSchema().Attrs()
 		start := info.GetSrcOff()
 		end := start + info.GetSrcLen() - 1
 		bat, err := db.window(attrs, data, deletes, start, end)
@@ -0,0 +1,89 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+// SpaceReport is a snapshot of the space occupied by the data entries in the
+// catalog. Dropped entries are still occupying space until they are
+// garbage collected and pinned entries are excluded from GC and merge.
+type SpaceReport struct {
+	Segments        int
+	Blocks          int
+	DroppedSegments int
+	DroppedBlocks   int
+	PinnedSegments  int
+	PinnedBlocks    int
+	Pins            []*Pin
+}
+
+func (report *SpaceReport) String() string {
+	s := fmt.Sprintf("SPACE[SEG=%d(Dropped=%d,Pinned=%d)][BLK=%d(Dropped=%d,Pinned=%d)]",
+		report.Segments,
+		report.DroppedSegments,
+		report.PinnedSegments,
+		report.Blocks,
+		report.DroppedBlocks,
+		report.PinnedBlocks)
+	for _, pin := range report.Pins {
+		s = fmt.Sprintf("%s\n\t%s", s, pin.String())
+	}
+	return s
+}
+
+func (db *DB) SpaceReport() *SpaceReport {
+	report := &SpaceReport{
+		Pins: db.Pins.Pins(),
+	}
+	processor := new(catalog.LoopProcessor)
+	processor.DatabaseFn = func(entry *catalog.DBEntry) error {
+		if entry.IsSystemDB() {
+			return catalog.ErrStopCurrRecur
+		}
+		return nil
+	}
+	processor.SegmentFn = func(entry *catalog.SegmentEntry) error {
+		report.Segments++
+		entry.RLock()
+		if entry.IsDroppedCommitted() {
+			report.DroppedSegments++
+		}
+		entry.RUnlock()
+		if db.Pins.IsPinned(entry.AsCommonID()) {
+			report.PinnedSegments++
+		}
+		return nil
+	}
+	processor.BlockFn = func(entry *catalog.BlockEntry) error {
+		report.Blocks++
+		entry.RLock()
+		if entry.IsDroppedCommitted() {
+			report.DroppedBlocks++
+		}
+		entry.RUnlock()
+		if db.Pins.IsPinned(entry.AsCommonID()) {
+			report.PinnedBlocks++
+		}
+		return nil
+	}
+	if err := db.Catalog.RecurLoop(processor); err != nil {
+		panic(err)
+	}
+	return report
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowStats(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	// Each row of 3 int32 columns is estimated 12 bytes
+	rowSize := int64(12)
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 3)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+
+	getRel := func(txn txnif.AsyncTxn) handle.Relation {
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		return rel
+	}
+
+	// The uncommitted appends are not counted
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Nil(t, rel.Append(bats[2]))
+	assert.Equal(t, int64(20), rel.Rows())
+	assert.Nil(t, txn.Rollback())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Equal(t, int64(20), rel.Rows())
+	assert.Equal(t, 20*rowSize, rel.Size(""))
+	assert.Equal(t, 20*int64(4), rel.Size("mock_0"))
+	assert.Equal(t, int64(0), rel.Size("xxx"))
+	segIt := rel.MakeSegmentIt()
+	seg := segIt.GetSegment()
+	assert.Equal(t, int64(20), seg.Rows())
+	assert.Equal(t, 20*rowSize, seg.Size(""))
+	blk := seg.MakeBlockIt().GetBlock()
+	meta := blk.GetMeta().(*catalog.BlockEntry)
+	assert.Equal(t, int64(10), blk.CommittedRows())
+	assert.Equal(t, 10*rowSize, blk.Size(""))
+	assert.Nil(t, blk.RangeDelete(1, 2))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Equal(t, int64(18), rel.Rows())
+	assert.Equal(t, 18*rowSize, rel.Size(""))
+	assert.Equal(t, int64(8), meta.GetRowStats().Rows())
+	assert.Equal(t, int64(18), meta.GetSegment().GetRowStats().Rows())
+	assert.Nil(t, txn.Commit())
+
+	// A rolled back delete is not counted
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Nil(t, rel.RangeDelete(meta.AsCommonID(), 5, 5))
+	assert.Nil(t, txn.Rollback())
+	assert.Equal(t, int64(8), meta.GetRowStats().Rows())
+
+	// Compaction replaces the block and keeps the rows
+	factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+	assert.Nil(t, err)
+	task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, factory)
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+	assert.Equal(t, int64(0), meta.GetRowStats().Rows())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Equal(t, int64(18), rel.Rows())
+	assert.Equal(t, 18*rowSize, rel.Size(""))
+	rows := int64(0)
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		rows += it.GetBlock().CommittedRows()
+		it.Next()
+	}
+	assert.Equal(t, int64(18), rows)
+	assert.Nil(t, txn.Commit())
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavepoint(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 15, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 3)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Savepoint("sp1"))
+
+	assert.Nil(t, rel.Append(bats[2]))
+	assert.Nil(t, updateByKey(txn, schema, 0, 100))
+	assert.Nil(t, deleteByKey(txn, schema, 1))
+	assert.Nil(t, updateByKey(txn, schema, 5, 100))
+	assert.Nil(t, deleteByKey(txn, schema, 6))
+	assert.Nil(t, txn.Savepoint("sp2"))
+	assert.Nil(t, deleteByKey(txn, schema, 2))
+
+	assert.Nil(t, txn.RollbackTo("sp1"))
+	assert.Equal(t, txnbase.ErrSavepointNotFound, txn.RollbackTo("sp2"))
+	_, _, err := rel.GetByFilter(handle.NewEQFilter(int32(10)))
+	assert.ErrorIs(t, err, txnbase.ErrNotFound)
+	for _, key := range []int32{1, 2, 6} {
+		_, _, err = rel.GetByFilter(handle.NewEQFilter(key))
+		assert.Nil(t, err)
+	}
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(int32(5)))
+	assert.Nil(t, err)
+	v, err := rel.GetValue(id, row, 0)
+	assert.Nil(t, err)
+	assert.NotEqual(t, int32(100), v)
+
+	// The rows rolled back can be appended again
+	assert.Nil(t, rel.Append(bats[2]))
+	assert.Nil(t, updateByKey(txn, schema, 0, 100))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(15), rel.Rows())
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(0)))
+	assert.Nil(t, err)
+	v, err = rel.GetValue(id, row, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(100), v)
+	assert.Nil(t, txn.Commit())
+}
@@ -192,7 +192,7 @@ func (monitor *catalogStatsMonitor) onSegment(entry *catalog.SegmentEntry) (err
 	checkpointed := monitor.db.Scheduler.GetCheckpointedLSN()
 	gcNeeded := false
 	entry.RLock()
-	if entry.IsDroppedCommitted() {
+	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.maxTs) {
 		logIndex := entry.GetLogIndex()
 		if logIndex != nil {
 			gcNeeded = checkpointed >= logIndex.LSN
@@ -205,6 +205,9 @@ func (s *taskScheduler) ScheduleScopedFn(ctx *tasks.Context, taskType tasks.Task
 func (s *taskScheduler) Schedule(task tasks.Task) (err error) {
 	taskType := task.Type()
 	if taskType == tasks.DataCompactionTask || taskType == tasks.GCTask {
+		if err = s.db.Pins.CheckScopes(task.(tasks.MScopedTask).Scopes()); err != nil {
+			return
+		}
 		dispatcher := s.Dispatchers[task.Type()].(*asyncJobDispatcher)
 		return dispatcher.TryDispatch(task)
 	}
@@ -0,0 +1,104 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/stretchr/testify/assert"
+)
+
+func initSpillDB(t *testing.T) (*DB, *catalog.Schema) {
+	opts := new(options.Options)
+	opts.TxnCfg = &options.TxnCfg{SpillRows: txnbase.MaxNodeRows}
+	tae := initDB(t, opts)
+	schema := catalog.MockSchema(2)
+	schema.PrimaryKey = 1
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	return tae, schema
+}
+
+func TestSpillTxn(t *testing.T) {
+	tae, schema := initSpillDB(t)
+	defer tae.Close()
+	bat := compute.MockBatch(schema.Types(), 25000, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 5)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Nil(t, txn.Savepoint("sp"))
+	assert.Nil(t, rel.Append(bat))
+	assert.Equal(t, txnimpl.ErrSavepointSpilled, txn.RollbackTo("sp"))
+
+	// The spilled rows are read and deduped like the rows in memory
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(int32(5)))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), id.PartID)
+	v, err := rel.GetValue(id, row, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), v)
+	assert.NotNil(t, rel.Append(bats[0]))
+	assert.Nil(t, deleteByKey(txn, schema, 7))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(24999), rel.Rows())
+	_, _, err = rel.GetByFilter(handle.NewEQFilter(int32(7)))
+	assert.ErrorIs(t, err, txnbase.ErrNotFound)
+	for _, key := range []int32{15000, 24000} {
+		_, _, err = rel.GetByFilter(handle.NewEQFilter(key))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, txn.Commit())
+}
+
+func TestSpillDedup(t *testing.T) {
+	tae, schema := initSpillDB(t)
+	defer tae.Close()
+	bat := compute.MockBatch(schema.Types(), 20000, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 200)
+
+	txn1 := tae.StartTxn(nil)
+	db, _ := txn1.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bat))
+
+	// The uncommitted spilled rows are not visible to txn2
+	txn2 := tae.StartTxn(nil)
+	db, _ = txn2.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn2.Commit())
+	assert.NotNil(t, txn1.Commit())
+
+	txn := tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(100), rel.Rows())
+	assert.Nil(t, txn.Commit())
+}
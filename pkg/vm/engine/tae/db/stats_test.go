@@ -0,0 +1,114 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"testing"
+
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	bat := compute.MockBatch(schema.Types(), 40, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 4)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, rel.Append(bats[2]))
+	assert.Nil(t, txn.Commit())
+
+	getRel := func(txn txnif.AsyncTxn) handle.Relation {
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		return rel
+	}
+	meta := rel.GetMeta().(*catalog.TableEntry)
+	assert.Nil(t, meta.GetStats())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Nil(t, rel.DropColumn(schema.ColDefs[12].Name))
+	assert.Nil(t, rel.AddColumn(&catalog.ColDef{Name: "nullable", Type: schema.ColDefs[0].Type, NullAbility: 1}))
+	assert.Nil(t, rel.RangeDelete(rel.MakeBlockIt().GetBlock().Fingerprint(), 0, 4))
+	assert.Nil(t, txn.Commit())
+
+	// A txn appending concurrently is not affected by the analyze
+	txn1 := tae.StartTxn(nil)
+	bat = gbat.New(true, append(schema.Attrs()[:12], "nullable"))
+	copy(bat.Vecs, bats[3].Vecs[:12])
+	bat.Vecs[12] = compute.MockBatch(schema.Types()[:1], 10, -1, nil).Vecs[0]
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Nil(t, rel.Analyze())
+	// The stats are invisible until committed
+	assert.Nil(t, meta.GetStats())
+	assert.Nil(t, txn.Commit())
+	assert.Nil(t, getRel(txn1).Append(bat))
+	assert.Nil(t, txn1.Commit())
+
+	stats := meta.GetStats()
+	assert.NotNil(t, stats)
+	t.Log(stats.String())
+	assert.Equal(t, uint64(25), stats.Rows)
+	assert.Equal(t, 13, len(stats.Columns))
+	assert.Equal(t, uint32(1), meta.GetSchema().Version)
+
+	pk := stats.GetColumn(schema.ColDefs[3].Name)
+	assert.InDelta(t, 25, pk.NDV, 1)
+	assert.Equal(t, uint64(0), pk.NullCnt)
+	assert.Equal(t, int64(5), common.DecodeKey(pk.Min, schema.ColDefs[3].Type))
+	assert.Equal(t, int64(29), common.DecodeKey(pk.Max, schema.ColDefs[3].Type))
+
+	nullable := stats.GetColumn("nullable")
+	assert.Equal(t, uint64(25), nullable.NullCnt)
+	assert.Equal(t, uint64(0), nullable.NDV)
+	assert.Nil(t, nullable.Min)
+	assert.Equal(t, float64(1), stats.NullFraction("nullable"))
+	assert.Nil(t, stats.GetColumn(schema.ColDefs[12].Name))
+
+	// The stats are persisted with the table entry
+	var w bytes.Buffer
+	_, err := meta.WriteTo(&w)
+	assert.Nil(t, err)
+	replayed := catalog.NewReplayTableEntry()
+	_, err = replayed.ReadFrom(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, stats, replayed.GetStats())
+
+	txn = tae.StartTxn(nil)
+	rel = getRel(txn)
+	assert.Nil(t, rel.Analyze())
+	assert.Nil(t, txn.Commit())
+	assert.Equal(t, uint64(35), meta.GetStats().Rows)
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// txnSweeper kills the txns past their deadline or the txn timeouts on each
+// heartbeat
+type txnSweeper struct {
+	db *DB
+}
+
+func (sweeper *txnSweeper) OnExec() {
+	if killed := sweeper.db.TxnMgr.KillExpired(time.Now()); killed > 0 {
+		logutil.Infof("TxnSweeper killed %d txns", killed)
+	}
+}
+
+func (sweeper *txnSweeper) OnStopped() {
+	logutil.Infof("TxnSweeper Stopped")
+}
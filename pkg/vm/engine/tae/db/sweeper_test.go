@@ -0,0 +1,102 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+type killRecorder struct {
+	killed map[uint64]error
+}
+
+func (r *killRecorder) OnTxnKilled(txn txnif.AsyncTxn, reason error) {
+	r.killed[txn.GetID()] = reason
+}
+
+func initSweeperDB(t *testing.T, maxIdle time.Duration) (*DB, *catalog.Schema) {
+	opts := new(options.Options)
+	opts.TxnCfg = &options.TxnCfg{
+		LockPolicy: options.TxnLockNoWait,
+		MaxIdle:    maxIdle.Milliseconds(),
+		// The txns are killed by the tests only
+		SweepInterval: time.Hour.Milliseconds(),
+	}
+	tae := initDB(t, opts)
+	schema := catalog.MockSchema(2)
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 10, int(schema.PrimaryKey), nil)
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+	return tae, schema
+}
+
+func TestTxnDeadline(t *testing.T) {
+	tae, schema := initSweeperDB(t, 0)
+	defer tae.Close()
+	recorder := &killRecorder{killed: make(map[uint64]error)}
+	tae.TxnMgr.SetObserver(recorder)
+
+	txn1 := tae.StartTxnWithOptions(txnbase.TxnOptions{Timeout: time.Minute})
+	assert.Nil(t, updateByKey(txn1, schema, 1, 100))
+	txn2 := tae.StartTxn(nil)
+	assert.Nil(t, updateByKey(txn2, schema, 2, 100))
+
+	assert.Equal(t, 0, tae.TxnMgr.KillExpired(time.Now()))
+	assert.Equal(t, 1, tae.TxnMgr.KillExpired(time.Now().Add(2*time.Minute)))
+	assert.Equal(t, txnbase.ErrTxnTimeout, recorder.killed[txn1.GetID()])
+	assert.Equal(t, txnif.TxnStateRollbacked, txn1.GetTxnState(true))
+
+	// The row lock of the killed txn is released
+	assert.Equal(t, 0, tae.TxnMgr.GetLockTable().HeldCnt(txn1.GetID()))
+	_, err := txn1.GetDatabase("db")
+	assert.Equal(t, txnbase.ErrTxnNotActive, err)
+	assert.Equal(t, txnbase.ErrTxnTimeout, txn1.Commit())
+	assert.Nil(t, updateByKey(txn2, schema, 1, 200))
+	assert.Nil(t, txn2.Commit())
+}
+
+func TestTxnIdleKill(t *testing.T) {
+	tae, schema := initSweeperDB(t, time.Second)
+	defer tae.Close()
+
+	txn1 := tae.StartTxn(nil)
+	assert.Nil(t, updateByKey(txn1, schema, 1, 100))
+	txn2 := tae.StartTxn(nil)
+	now := time.Now()
+	assert.Equal(t, 0, tae.TxnMgr.KillExpired(now))
+	assert.Equal(t, 2, tae.TxnMgr.KillExpired(now.Add(2*time.Second)))
+	assert.Equal(t, txnbase.ErrTxnIdleTimeout, txn1.Rollback())
+	assert.Equal(t, txnbase.ErrTxnIdleTimeout, txn2.Commit())
+	assert.Equal(t, 0, tae.TxnMgr.StatActiveTxnCnt())
+
+	// A killed txn is not killed again and an ended txn is not killed
+	assert.Equal(t, 0, tae.TxnMgr.KillExpired(now.Add(time.Hour)))
+	txn := tae.StartTxn(nil)
+	assert.Nil(t, updateByKey(txn, schema, 1, 300))
+	assert.Nil(t, txn.Commit())
+	assert.Equal(t, txnbase.ErrTxnNotActive, txn.Kill(txnbase.ErrTxnTimeout))
+}
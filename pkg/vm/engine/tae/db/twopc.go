@@ -0,0 +1,308 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+)
+
+var ErrInDoubtDDL = errors.New("tae: ddl of an in-doubt txn not supported")
+
+const PrepareDir = "prepare"
+
+const (
+	ETTxnPrepare = entry.ETCustomizedStart + 200 + iota
+	ETTxnDecision
+)
+
+// InDoubtTxn is a txn prepared before the restart without a decision
+type InDoubtTxn struct {
+	ID       uint64
+	CommitTS uint64
+	Info     []byte
+	// Record is the WAL record of the writes of the txn
+	Record []byte
+}
+
+// prepareLog persists the prepare and the decision records of the two-phase
+// commits in a logstore. A prepare record has the writes of the txn. The
+// txns prepared but not decided are in doubt after the restart and wait for
+// the coordinator to resolve them
+type prepareLog struct {
+	sync.Mutex
+	store    store.Store
+	inDoubt  map[uint64]*InDoubtTxn
+	maxTxnId uint64
+}
+
+func openPrepareLog(dir string, cfg *store.StoreCfg) (log *prepareLog, err error) {
+	driver, err := store.NewBaseStore(dir, PrepareDir, cfg)
+	if err != nil {
+		return
+	}
+	log = &prepareLog{
+		store:   driver,
+		inDoubt: make(map[uint64]*InDoubtTxn),
+	}
+	if err = driver.Replay(log.onReplay); err != nil {
+		driver.Close()
+		log = nil
+	}
+	return
+}
+
+func (log *prepareLog) onReplay(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
+	if typ != ETTxnPrepare && typ != ETTxnDecision {
+		return
+	}
+	r := bytes.NewBuffer(payload)
+	var id uint64
+	if err = binary.Read(r, binary.BigEndian, &id); err != nil {
+		return
+	}
+	if id > log.maxTxnId {
+		log.maxTxnId = id
+	}
+	switch typ {
+	case ETTxnPrepare:
+		txn := &InDoubtTxn{ID: id}
+		if err = binary.Read(r, binary.BigEndian, &txn.CommitTS); err != nil {
+			return
+		}
+		var length uint32
+		if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+			return
+		}
+		txn.Info = make([]byte, length)
+		if _, err = io.ReadFull(r, txn.Info); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+			return
+		}
+		txn.Record = make([]byte, length)
+		if _, err = io.ReadFull(r, txn.Record); err != nil {
+			return
+		}
+		log.inDoubt[id] = txn
+	case ETTxnDecision:
+		delete(log.inDoubt, id)
+	}
+	return
+}
+
+func (log *prepareLog) append(typ uint16, buf []byte) (err error) {
+	e := entry.GetBase()
+	defer e.Free()
+	e.SetType(typ)
+	if err = e.Unmarshal(buf); err != nil {
+		return
+	}
+	if _, err = log.store.AppendEntry(entry.GTCustomizedStart, e); err != nil {
+		return
+	}
+	return e.WaitDone()
+}
+
+func (log *prepareLog) LogPrepare(txn txnif.AsyncTxn) error {
+	var w bytes.Buffer
+	_ = binary.Write(&w, binary.BigEndian, txn.GetID())
+	_ = binary.Write(&w, binary.BigEndian, txn.GetCommitTS())
+	_ = binary.Write(&w, binary.BigEndian, uint32(len(txn.GetInfo())))
+	w.Write(txn.GetInfo())
+	record := txn.GetStore().GetTxnRecord()
+	_ = binary.Write(&w, binary.BigEndian, uint32(len(record)))
+	w.Write(record)
+	return log.append(ETTxnPrepare, w.Bytes())
+}
+
+func (log *prepareLog) LogDecision(txn txnif.AsyncTxn, commit bool) error {
+	return log.logDecision(txn.GetID(), commit)
+}
+
+func (log *prepareLog) logDecision(id uint64, commit bool) error {
+	var w bytes.Buffer
+	_ = binary.Write(&w, binary.BigEndian, id)
+	_ = binary.Write(&w, binary.BigEndian, commit)
+	return log.append(ETTxnDecision, w.Bytes())
+}
+
+func (log *prepareLog) InDoubtTxns() (txns []*InDoubtTxn) {
+	log.Lock()
+	defer log.Unlock()
+	for _, txn := range log.inDoubt {
+		txns = append(txns, txn)
+	}
+	return
+}
+
+// Resolve persists the decision on the in-doubt txn id. The writes of the
+// txn are applied by apply before the decision to commit is persisted
+func (log *prepareLog) Resolve(id uint64, commit bool, apply func(*InDoubtTxn) error) (err error) {
+	log.Lock()
+	defer log.Unlock()
+	txn, ok := log.inDoubt[id]
+	if !ok {
+		return txnbase.ErrTxnNotPrepared
+	}
+	if commit && apply != nil {
+		if err = apply(txn); err != nil {
+			return
+		}
+	}
+	if err = log.logDecision(id, commit); err != nil {
+		return
+	}
+	delete(log.inDoubt, id)
+	return
+}
+
+func (log *prepareLog) Close() error {
+	return log.store.Close()
+}
+
+// replayInDoubt commits the writes of the in-doubt txn in a new txn
+func (db *DB) replayInDoubt(prepared *InDoubtTxn) (err error) {
+	cmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(prepared.Record))
+	if err != nil {
+		return
+	}
+	txn := db.StartTxn(prepared.Info)
+	if err = db.replayInDoubtCmd(txn, cmd); err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	return txn.Commit()
+}
+
+func (db *DB) replayInDoubtCmd(txn txnif.AsyncTxn, txnCmd txnif.TxnCmd) (err error) {
+	switch cmd := txnCmd.(type) {
+	case *txnbase.ComposedCmd:
+		for _, subCmd := range cmd.Cmds {
+			if err = db.replayInDoubtCmd(txn, subCmd); err != nil {
+				break
+			}
+		}
+	case *txnimpl.AppendCmd:
+		err = db.replayInDoubtAppend(txn, cmd)
+	case *updates.UpdateCmd:
+		switch cmd.GetType() {
+		case txnbase.CmdDelete:
+			node := cmd.GetDeleteNode()
+			var rel handle.Relation
+			if rel, err = db.inDoubtRelation(txn, cmd.GetDBID(), node.GetID().TableID); err != nil {
+				return
+			}
+			it := node.GetDeleteMaskLocked().Iterator()
+			for it.HasNext() {
+				row := it.Next()
+				if err = rel.RangeDelete(node.GetID(), row, row); err != nil {
+					break
+				}
+			}
+		case txnbase.CmdUpdate:
+			node := cmd.GetUpdateNode()
+			var rel handle.Relation
+			if rel, err = db.inDoubtRelation(txn, cmd.GetDBID(), node.GetID().TableID); err != nil {
+				return
+			}
+			vals := node.GetValues()
+			it := node.GetMask().Iterator()
+			for it.HasNext() {
+				row := it.Next()
+				if err = rel.Update(node.GetID(), row, node.GetID().Idx, vals[row]); err != nil {
+					break
+				}
+			}
+		}
+	case *catalog.EntryCommand:
+		// The segments and the blocks are created by the appends
+		if typ := cmd.GetType(); typ != catalog.CmdCreateSegment && typ != catalog.CmdCreateBlock {
+			err = ErrInDoubtDDL
+		}
+	}
+	return
+}
+
+func (db *DB) replayInDoubtAppend(txn txnif.AsyncTxn, cmd *txnimpl.AppendCmd) (err error) {
+	var data batch.IBatch
+	var deletes *roaring.Bitmap
+	for _, subTxnCmd := range cmd.Cmds {
+		switch subCmd := subTxnCmd.(type) {
+		case *txnbase.BatchCmd:
+			data = subCmd.Bat
+		case *txnbase.DeleteBitmapCmd:
+			deletes = subCmd.Bitmap
+		case *txnbase.PointerCmd:
+			batEntry, err := db.Wal.LoadEntry(subCmd.Group, subCmd.Lsn)
+			if err != nil {
+				return err
+			}
+			txnCmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(batEntry.GetPayload()))
+			if err != nil {
+				return err
+			}
+			data = txnCmd.(*txnbase.BatchCmd).Bat
+		}
+	}
+	for _, info := range cmd.Infos {
+		rel, err := db.inDoubtRelation(txn, info.GetDBID(), info.GetDest().TableID)
+		if err != nil {
+			return err
+		}
+		start := info.GetSrcOff()
+		bat, err := db.window(rel.GetMeta().(*catalog.TableEntry).GetSchema().Attrs(), data, deletes, start, start+info.GetSrcLen()-1)
+		if err != nil {
+			return err
+		}
+		if err = rel.Append(bat); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// inDoubtRelation returns the relation of txn of the table written by an
+// in-doubt txn
+func (db *DB) inDoubtRelation(txn txnif.AsyncTxn, dbId, tableId uint64) (rel handle.Relation, err error) {
+	dbEntry, err := db.Catalog.GetDatabaseByID(dbId)
+	if err != nil {
+		return
+	}
+	tableEntry, err := dbEntry.GetTableEntryByID(tableId)
+	if err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbEntry.GetName())
+	if err != nil {
+		return
+	}
+	return database.GetRelationByName(tableEntry.GetSchema().Name)
+}
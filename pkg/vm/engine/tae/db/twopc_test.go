@@ -0,0 +1,172 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoPhaseCommit(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	assert.Equal(t, txnbase.ErrTxnNotPrepared, txn.CommitPrepared())
+	db, _ = txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[0]))
+	assert.Nil(t, txn.Prepare())
+	assert.Equal(t, txnbase.ErrTxnPrepared, txn.Commit())
+	assert.Nil(t, txn.CommitPrepared())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Prepare())
+	assert.Nil(t, txn.RollbackPrepared())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(10), rel.Rows())
+	// A txn failed to prepare is rolled back
+	assert.Nil(t, rel.Append(bats[1]))
+	txn2 := tae.StartTxn(nil)
+	db, _ = txn2.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+	assert.NotNil(t, txn2.Prepare())
+	assert.False(t, tae.TxnMgr.IsPrepared(txn2.GetID()))
+	assert.Empty(t, tae.InDoubtTxns())
+}
+
+func TestInDoubtTxn(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := compute.MockBatch(schema.Types(), 10, int(schema.PrimaryKey), nil)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn([]byte("gtid-1"))
+	db, _ = txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Prepare())
+	txn2 := tae.StartTxn([]byte("gtid-2"))
+	assert.Nil(t, txn2.Prepare())
+	assert.Nil(t, txn2.RollbackPrepared())
+	tae.Close()
+
+	log, err := openPrepareLog(tae.Dir, nil)
+	assert.Nil(t, err)
+	txns := log.InDoubtTxns()
+	assert.Equal(t, 1, len(txns))
+	assert.Equal(t, txn.GetID(), txns[0].ID)
+	assert.Equal(t, txn.GetCommitTS(), txns[0].CommitTS)
+	assert.Equal(t, []byte("gtid-1"), txns[0].Info)
+	assert.Equal(t, txn2.GetID(), log.maxTxnId)
+	assert.Nil(t, log.Resolve(txn.GetID(), true, nil))
+	assert.Equal(t, txnbase.ErrTxnNotPrepared, log.Resolve(txn.GetID(), true, nil))
+	assert.Nil(t, log.Close())
+
+	log, err = openPrepareLog(tae.Dir, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, log.InDoubtTxns())
+	assert.Nil(t, log.Close())
+}
+
+func TestResolveInDoubtTxn(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	schema2 := catalog.MockSchema(2)
+	schema2.BlockMaxRows = 10
+	schema2.SegmentMaxBlocks = 2
+	bat := compute.MockBatch(schema.Types(), 25, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 5)
+
+	txn := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	_, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	_, err = db.CreateRelation(schema2)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	assert.Nil(t, tae.Catalog.Checkpoint(tae.Scheduler.GetSafeTS()))
+
+	// Both txns are in doubt after the restart
+	txn = tae.StartTxn([]byte("gtid-1"))
+	db, _ = txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	for _, bat := range bats[:3] {
+		assert.Nil(t, rel.Append(bat))
+	}
+	assert.Nil(t, txn.Prepare())
+	txn2 := tae.StartTxn([]byte("gtid-2"))
+	db, _ = txn2.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema2.Name)
+	assert.Nil(t, rel.Append(compute.MockBatch(schema2.Types(), 5, int(schema2.PrimaryKey), nil)))
+	assert.Nil(t, txn2.Prepare())
+	tae.Close()
+
+	tae, err = Open(tae.Dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	assert.Equal(t, 2, len(tae.InDoubtTxns()))
+	assert.Nil(t, tae.ResolveInDoubt(txn.GetID(), true))
+	assert.Nil(t, tae.ResolveInDoubt(txn2.GetID(), false))
+	assert.Equal(t, txnbase.ErrTxnNotPrepared, tae.ResolveInDoubt(txn.GetID(), true))
+	assert.Empty(t, tae.InDoubtTxns())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(15), rel.Rows())
+	for i, bat := range bats {
+		_, _, err = rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)))
+		assert.Equal(t, i < 3, err == nil)
+	}
+	rel, _ = db.GetRelationByName(schema2.Name)
+	assert.Equal(t, int64(0), rel.Rows())
+	assert.Nil(t, txn.Commit())
+}
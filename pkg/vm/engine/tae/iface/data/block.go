@@ -68,9 +68,15 @@ type Block interface {
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	GetRowsByIndex(txn txnif.AsyncTxn, colIdx int, key interface{}) ([]uint32, error)
+	MayMatch(txn txnif.AsyncTxn, filters ...*handle.Filter) bool
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (interface{}, error)
+	// GetRowCommitTS returns the commit ts of the append of row
+	GetRowCommitTS(row uint32) uint64
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
+	// Prefetch reads the data of a non-appendable block ahead of a scan
+	Prefetch()
 
 	SetMaxCheckpointTS(ts uint64)
 	GetMaxCheckpointTS() uint64
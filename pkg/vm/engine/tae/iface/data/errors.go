@@ -21,6 +21,7 @@ var (
 	ErrAppendableBlockNotFound   = errors.New("tae: no appendable block")
 	ErrNotAppendable             = errors.New("tae: not appendable")
 	ErrStaleRequest              = errors.New("tae: stale request")
+	ErrColumnNotMaterialized     = errors.New("tae: column not materialized in block")
 
 	ErrPossibleDuplicate = errors.New("tae: possible duplicate")
 	ErrDuplicate         = errors.New("tae: duplicate")
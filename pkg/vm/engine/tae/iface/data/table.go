@@ -14,14 +14,21 @@
 
 package data
 
-import "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
 
 type TableHandle interface {
 	GetAppender() (BlockAppender, error)
 	SetAppender(*common.ID) BlockAppender
+	// GetPartitionAppender returns the appender of the last appendable
+	// block of partition
+	GetPartitionAppender(partition uint32) (BlockAppender, error)
 }
 
 type Table interface {
 	GetHandle() TableHandle
 	ApplyHandle(TableHandle)
+	GetChangeFeed() *cdc.Feed
 }
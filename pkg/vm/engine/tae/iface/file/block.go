@@ -43,6 +43,8 @@ type Block interface {
 	WriteIndexMeta(buf []byte) (err error)
 
 	OpenColumn(colIdx int) (ColumnBlock, error)
+	// Prefetch reads the data of the columns ahead in the background
+	Prefetch()
 	// WriteColumn(colIdx int, ts uint64, data []byte, updates []byte) (common.IVFile, error)
 
 	// TODO: Remove later
@@ -58,6 +60,8 @@ type Block interface {
 type ColumnBlock interface {
 	io.Closer
 	WriteTS(ts uint64) error
+	// SetCompression sets the compress algorithm of the data written later
+	SetCompression(algo int)
 	WriteData(buf []byte) error
 	WriteIndex(idx int, buf []byte) error
 	WriteUpdates(buf []byte) error
@@ -26,5 +26,7 @@ type Segment interface {
 	String() string
 	RemoveBlock(id uint64)
 	GetSegmentFile() *segment.Segment
+	// Seal marks the segment immutable to read its data through the mmap
+	Seal()
 	// IsAppendable() bool
 }
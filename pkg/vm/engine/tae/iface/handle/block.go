@@ -35,12 +35,20 @@ const (
 	FilterEq FilterOp = iota
 	FilterBatchEq
 	FilterBtw
+	FilterLt
+	FilterLe
+	FilterGt
+	FilterGe
+	FilterIsNull
+	FilterIsNotNull
 )
 
 type Filter struct {
 	Op  FilterOp
 	Col *vector.Vector
 	Val interface{}
+	// Attr is the filtered column of a column filter used to prune blocks
+	Attr string
 }
 
 func NewEQFilter(v interface{}) *Filter {
@@ -50,6 +58,16 @@ func NewEQFilter(v interface{}) *Filter {
 	}
 }
 
+// NewColumnFilter returns a filter on column attr. Blocks whose zone map of
+// attr proves no row matches are skipped by the iterators made with filters
+func NewColumnFilter(attr string, op FilterOp, v interface{}) *Filter {
+	return &Filter{
+		Op:   op,
+		Val:  v,
+		Attr: attr,
+	}
+}
+
 type BlockReader interface {
 	io.Closer
 	ID() uint64
@@ -60,7 +78,16 @@ type BlockReader interface {
 	GetMeta() interface{}
 	Fingerprint() *common.ID
 	Rows() int
+	// CommittedRows returns the number of the committed rows not deleted.
+	// Rows returns the number of the rows visible to the txn including the
+	// deleted ones
+	CommittedRows() int64
+	// Size returns the estimated size of the committed data of column attr
+	// or of all the columns if attr is empty
+	Size(attr string) int64
 	BatchDedup(col *vector.Vector) error
+	// MayMatch returns false if the zone maps prove no row satisfies filters
+	MayMatch(filters ...*Filter) bool
 
 	IsAppendableBlock() bool
 
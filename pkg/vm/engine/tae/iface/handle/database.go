@@ -22,7 +22,12 @@ type Database interface {
 	GetID() uint64
 	GetName() string
 	CreateRelation(def interface{}) (Relation, error)
+	// CreateRelations creates a batch of relations atomically
+	CreateRelations(defs []interface{}) ([]Relation, error)
 	DropRelationByName(name string) (Relation, error)
+	// RenameRelation renames a relation. The txns started before the txn
+	// commits still find it by the old name
+	RenameRelation(name, newName string) (Relation, error)
 
 	GetRelationByName(name string) (Relation, error)
 	RelationCnt() int64
@@ -29,7 +29,10 @@ type Reader interface {
 type Relation interface {
 	io.Closer
 	ID() uint64
+	// Rows returns the number of the committed rows not deleted
 	Rows() int64
+	// Size returns the estimated size of the committed data of column attr
+	// or of all the columns if attr is empty
 	Size(attr string) int64
 	String() string
 	SimplePPString(common.PPLevel) string
@@ -38,14 +41,34 @@ type Relation interface {
 	MakeSegmentIt() SegmentIt
 	MakeReader() Reader
 	MakeBlockIt() BlockIt
+	// MakeBlockItWithFilters skips the segments and blocks pruned by filters
+	MakeBlockItWithFilters(filters ...*Filter) BlockIt
+	// Partitions returns the partitions that may have rows satisfying
+	// filters. It returns nil if the relation is not partitioned
+	Partitions(filters ...*Filter) []uint32
 
 	RangeDelete(id *common.ID, start, end uint32) error
 	Update(id *common.ID, row uint32, col uint16, v interface{}) error
 	GetByFilter(filter *Filter) (id *common.ID, offset uint32, err error)
+	// GetByIndex returns the physical addresses of the rows whose column
+	// attr equals val, which are looked up by the secondary index of attr
+	GetByIndex(attr string, val interface{}) (ids []*common.ID, offsets []uint32, err error)
 	GetValue(id *common.ID, row uint32, col uint16) (interface{}, error)
 
 	BatchDedup(col *vector.Vector) error
 	Append(data *batch.Batch) error
+	// AddColumn adds a nullable or default-valued column to the relation
+	AddColumn(def interface{}) error
+	// DropColumn drops a column other than the sort key from the relation
+	DropColumn(name string) error
+	// AddIndex adds the secondary index name on the column col other than
+	// the sort key
+	AddIndex(name, col string) error
+	// DropIndex drops the secondary index name
+	DropIndex(name string) error
+	// Analyze collects the statistics of the relation, which are persisted
+	// in the catalog when the txn commits
+	Analyze() error
 
 	GetMeta() interface{}
 	CreateSegment() (Segment, error)
@@ -29,7 +29,13 @@ type SegmentIt interface {
 type SegmentReader interface {
 	io.Closer
 	GetID() uint64
+	// Rows returns the number of the committed rows not deleted
+	Rows() int64
+	// Size returns the estimated size of the committed data of column attr
+	// or of all the columns if attr is empty
+	Size(attr string) int64
 	MakeBlockIt() BlockIt
+	MakeBlockItWithFilters(filters ...*Filter) BlockIt
 	MakeReader() Reader
 	// GetByFilter(filter Filter, offsetOnly bool) (map[uint64]*batch.Batch, error)
 	String() string
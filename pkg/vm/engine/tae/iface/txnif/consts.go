@@ -26,6 +26,19 @@ const (
 	TxnStateRollbacked
 )
 
+// IsolationLevel is the isolation level of a txn. Both levels detect the
+// write-write conflicts only, so write skew is possible in both
+type IsolationLevel int8
+
+const (
+	// IsolationSI reads the snapshot at the start of the txn
+	IsolationSI IsolationLevel = iota
+	// IsolationRC reads the snapshot at the start of each statement. The
+	// changes committed by others between the statements are visible, so
+	// non-repeatable reads and lost updates are possible
+	IsolationRC
+)
+
 func TxnStrState(state int32) string {
 	switch state {
 	case TxnStateActive:
@@ -17,6 +17,7 @@ package txnif
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -45,6 +46,10 @@ type TxnReader interface {
 	GetStartTS() uint64
 	GetCommitTS() uint64
 	GetInfo() []byte
+	GetIsolation() IsolationLevel
+	GetStartAt() time.Time
+	GetDeadline() time.Time
+	GetLastActive() time.Time
 	IsTerminated(bool) bool
 	IsVisible(o TxnReader) bool
 	GetTxnState(waitIfcommitting bool) int32
@@ -57,6 +62,9 @@ type TxnReader interface {
 type TxnHandle interface {
 	CreateDatabase(name string) (handle.Database, error)
 	DropDatabase(name string) (handle.Database, error)
+	// RenameDatabase renames a database. The txns started before the txn
+	// commits still find it by the old name
+	RenameDatabase(name, newName string) (handle.Database, error)
 	GetDatabase(name string) (handle.Database, error)
 	DatabaseNames() []string
 }
@@ -70,7 +78,23 @@ type TxnChanger interface {
 	ToRollbackedLocked() error
 	ToRollbackingLocked(ts uint64) error
 	Commit() error
+	CommitAsync() error
+	WaitDurable() error
 	Rollback() error
+	Prepare() error
+	CommitPrepared() error
+	RollbackPrepared() error
+	SetIsolation(level IsolationLevel)
+	SetDeadline(deadline time.Time)
+	Touch()
+	Kill(reason error) error
+	StartStatement() error
+	CheckWritable() error
+	Admit() error
+	IsPessimistic() bool
+	LockRows(tableId uint64, keys ...interface{}) error
+	Savepoint(name string) error
+	RollbackTo(name string) error
 	SetError(error)
 	SetPrepareCommitFn(func(interface{}) error)
 }
@@ -170,19 +194,28 @@ type TxnStore interface {
 	LogBlockID(dbId, tid, bid uint64)
 
 	Append(dbId, id uint64, data *batch.Batch) error
+	AddColumn(dbId, id uint64, def interface{}) error
+	DropColumn(dbId, id uint64, name string) error
+	AddIndex(dbId, id uint64, name, col string) error
+	DropIndex(dbId, id uint64, name string) error
+	Analyze(dbId, id uint64) error
 
 	RangeDelete(dbId uint64, id *common.ID, start, end uint32) error
 	Update(dbId uint64, id *common.ID, row uint32, col uint16, v interface{}) error
 	GetByFilter(dbId uint64, id uint64, filter *handle.Filter) (*common.ID, uint32, error)
+	GetByIndex(dbId uint64, id uint64, attr string, val interface{}) ([]*common.ID, []uint32, error)
 	GetValue(dbId uint64, id *common.ID, row uint32, col uint16) (interface{}, error)
 
 	CreateRelation(dbId uint64, def interface{}) (handle.Relation, error)
+	CreateRelations(dbId uint64, defs []interface{}) ([]handle.Relation, error)
 	DropRelationByName(dbId uint64, name string) (handle.Relation, error)
+	RenameRelation(dbId uint64, name, newName string) (handle.Relation, error)
 	GetRelationByName(dbId uint64, name string) (handle.Relation, error)
 
 	CreateDatabase(name string) (handle.Database, error)
 	GetDatabase(name string) (handle.Database, error)
 	DropDatabase(name string) (handle.Database, error)
+	RenameDatabase(name, newName string) (handle.Database, error)
 	DatabaseNames() []string
 
 	GetSegment(dbId uint64, id *common.ID) (handle.Segment, error)
@@ -198,10 +231,27 @@ type TxnStore interface {
 
 	LogTxnEntry(dbId, tableId uint64, entry TxnEntry, readed []*common.ID) error
 
+	Savepoint(name string) error
+	RollbackTo(name string) error
+	SetStartTS(ts uint64)
+	SetAsyncCommit()
+	WaitDurable() error
+	GetChanges() []TableChanges
+	GetTxnRecord() []byte
+
 	IsReadonly() bool
 	IncreateWriteCnt() int
 }
 
+// TableChanges is the summary of the rows changed by a txn in a table
+type TableChanges struct {
+	DBId     uint64
+	TableId  uint64
+	Appended uint32
+	Deleted  uint32
+	Updated  uint32
+}
+
 type TxnEntryType int16
 
 type TxnEntry interface {
@@ -16,10 +16,12 @@ package acif
 
 import (
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 type IAppendableBlockIndexHolder interface {
@@ -27,6 +29,7 @@ type IAppendableBlockIndexHolder interface {
 	BatchInsert(keys *vector.Vector, start uint32, count int, offset uint32, verify bool) error
 	Delete(key interface{}) error
 	Search(key interface{}) (uint32, error)
+	BatchInsertColumns(bat *batch.Batch, start uint32, count int, offset uint32) error
 	//Upgrade() (INonAppendableBlockIndexHolder, error)
 	BatchDedup(keys *vector.Vector) error
 }
@@ -35,10 +38,12 @@ type INonAppendableBlockIndexHolder interface {
 	IBlockIndexHolder
 	MayContainsKey(key interface{}) bool
 	MayContainsAnyKeys(keys *vector.Vector) (error, *roaring.Bitmap)
+	MayMatch(colIdx uint16, filter *handle.Filter) bool
 	InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error
 }
 
 type IBlockIndexHolder interface {
 	GetHostBlockId() uint64
+	SearchColumn(colIdx uint16, key interface{}) (rows []uint32, indexed bool, err error)
 	Destroy() error
 }
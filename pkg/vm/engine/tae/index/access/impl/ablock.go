@@ -16,6 +16,7 @@ package impl
 
 import (
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
@@ -28,6 +29,9 @@ type appendableBlockIndexHolder struct {
 	treeIndex    basic.ARTMap
 	zoneMapIndex *basic.ZoneMap
 	schema       *catalog.Schema
+	// columns are the row maps of the indexed columns. They are accessed
+	// with the mvcc lock of the block held
+	columns map[uint16]*basic.RowMap
 }
 
 func NewAppendableBlockIndexHolder(host data.Block, schema *catalog.Schema) *appendableBlockIndexHolder {
@@ -37,9 +41,35 @@ func NewAppendableBlockIndexHolder(host data.Block, schema *catalog.Schema) *app
 	pkType := schema.GetPKType()
 	holder.treeIndex = basic.NewSimpleARTMap(pkType, nil)
 	holder.zoneMapIndex = basic.NewZoneMap(pkType, nil)
+	holder.columns = make(map[uint16]*basic.RowMap)
+	for _, idx := range schema.IndexedColumns() {
+		holder.columns[uint16(idx)] = basic.NewRowMap(schema.ColDefs[idx].Type)
+	}
 	return holder
 }
 
+// BatchInsertColumns maps the values of the indexed columns of bat from start
+// to the rows from offset
+func (holder *appendableBlockIndexHolder) BatchInsertColumns(bat *batch.Batch, start uint32, count int, offset uint32) error {
+	for colIdx, m := range holder.columns {
+		if err := m.BatchInsert(bat.Vecs[colIdx], int(start), count, offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchColumn returns the rows whose column colIdx equals key. indexed is
+// false if the column is not indexed when the block is created
+func (holder *appendableBlockIndexHolder) SearchColumn(colIdx uint16, key interface{}) (rows []uint32, indexed bool, err error) {
+	m, ok := holder.columns[colIdx]
+	if !ok {
+		return
+	}
+	rows, err = m.Search(key)
+	return rows, true, err
+}
+
 func (holder *appendableBlockIndexHolder) BatchInsert(keys *vector.Vector, start uint32, count int, offset uint32, verify bool) error {
 	// TODO: consume `count` when needed
 	if err := holder.zoneMapIndex.BatchUpdate(keys, start, -1); err != nil {
@@ -94,6 +124,7 @@ func (holder *appendableBlockIndexHolder) BatchDedup(keys *vector.Vector) error
 func (holder *appendableBlockIndexHolder) Destroy() error {
 	holder.treeIndex = nil
 	holder.zoneMapIndex = nil
+	holder.columns = nil
 	return nil
 }
 
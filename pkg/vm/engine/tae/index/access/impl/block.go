@@ -21,6 +21,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	gCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
@@ -31,6 +33,93 @@ type nonAppendableBlockIndexHolder struct {
 	zoneMapIndex      *io.BlockZoneMapIndexReader
 	staticFilterIndex *io.StaticFilterIndexReader
 	schema            *catalog.Schema
+	// columns keeps the indexes of the columns other than the sort key
+	columns map[uint16]*columnIndex
+}
+
+type columnIndex struct {
+	zoneMapIndex      *io.BlockZoneMapIndexReader
+	staticFilterIndex *io.StaticFilterIndexReader
+	rowIndex          *io.RowIndexReader
+}
+
+func (idx *columnIndex) mayContainsKey(key interface{}) bool {
+	if idx.zoneMapIndex != nil {
+		if exist, err := idx.zoneMapIndex.MayContainsKey(key); err != nil || !exist {
+			return false
+		}
+	}
+	if idx.staticFilterIndex != nil {
+		if exist, err := idx.staticFilterIndex.MayContainsKey(key); err != nil || !exist {
+			return false
+		}
+	}
+	return true
+}
+
+func (idx *columnIndex) destroy() (err error) {
+	if idx.zoneMapIndex != nil {
+		if err = idx.zoneMapIndex.Destroy(); err != nil {
+			return
+		}
+	}
+	if idx.staticFilterIndex != nil {
+		if err = idx.staticFilterIndex.Destroy(); err != nil {
+			return
+		}
+	}
+	if idx.rowIndex != nil {
+		err = idx.rowIndex.Destroy()
+	}
+	return
+}
+
+// SearchColumn returns the rows whose column colIdx equals key by the row
+// index of the column. indexed is false if the block has no row index of the
+// column, which is created before the index
+func (holder *nonAppendableBlockIndexHolder) SearchColumn(colIdx uint16, key interface{}) (rows []uint32, indexed bool, err error) {
+	idx, ok := holder.columns[colIdx]
+	if !ok || idx.rowIndex == nil {
+		return
+	}
+	indexed = true
+	if !idx.mayContainsKey(key) {
+		return
+	}
+	rows, err = idx.rowIndex.Search(key)
+	return
+}
+
+// MayMatch returns false only if the zone map of the column proves no row
+// satisfies the filter
+func (holder *nonAppendableBlockIndexHolder) MayMatch(colIdx uint16, filter *handle.Filter) bool {
+	reader := holder.zoneMapIndex
+	if colIdx != uint16(holder.schema.PrimaryKey) {
+		idx, ok := holder.columns[colIdx]
+		if !ok {
+			return true
+		}
+		reader = idx.zoneMapIndex
+	}
+	if reader == nil {
+		return true
+	}
+	return reader.Eval(func(zm *basic.ZoneMap) bool {
+		switch filter.Op {
+		case handle.FilterEq:
+			exist, err := zm.MayContainsKey(filter.Val)
+			return err != nil || exist
+		case handle.FilterLt, handle.FilterLe:
+			return zm.MayMatchRange(filter.Val, true, filter.Op == handle.FilterLe)
+		case handle.FilterGt, handle.FilterGe:
+			return zm.MayMatchRange(filter.Val, false, filter.Op == handle.FilterGe)
+		case handle.FilterIsNull:
+			return zm.GetNullCnt() > 0
+		case handle.FilterIsNotNull:
+			return zm.Initialized()
+		}
+		return true
+	})
 }
 
 func (holder *nonAppendableBlockIndexHolder) MayContainsKey(key interface{}) bool {
@@ -77,25 +166,27 @@ func (holder *nonAppendableBlockIndexHolder) MayContainsAnyKeys(keys *vector.Vec
 }
 
 func NewEmptyNonAppendableBlockIndexHolder() *nonAppendableBlockIndexHolder {
-	return &nonAppendableBlockIndexHolder{}
+	return &nonAppendableBlockIndexHolder{
+		columns: make(map[uint16]*columnIndex),
+	}
 }
 
 func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error {
 	holder.host = host
 	holder.schema = schema
-	pkIdx := schema.PrimaryKey
+	pkIdx := uint16(schema.PrimaryKey)
 	blkFile := host.GetBlockFile()
 	idxMetas, err := blkFile.LoadIndexMeta()
 	if err != nil {
 		return err
 	}
 
-	colFile, err := blkFile.OpenColumn(int(pkIdx))
-	if err != nil {
-		return err
-	}
 	for _, meta := range idxMetas.Metas {
 		internal := meta.InternalIdx
+		colFile, err := blkFile.OpenColumn(int(meta.ColIdx))
+		if err != nil {
+			return err
+		}
 		colFile.GetDataFileStat()
 		idxFile, err := colFile.OpenIndexFile(int(internal))
 		if err != nil {
@@ -120,8 +211,12 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 			if err != nil {
 				return err
 			}
-			holder.zoneMapIndex = reader
-		case common.StaticFilterIndex:
+			if meta.ColIdx == pkIdx {
+				holder.zoneMapIndex = reader
+			} else {
+				holder.columnIndex(meta.ColIdx).zoneMapIndex = reader
+			}
+		case common.StaticFilterIndex, common.BloomFilterIndex:
 			size := idxFile.Stat().Size()
 			buf := make([]byte, size)
 			_, err = idxFile.Read(buf)
@@ -129,6 +224,9 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 				return err
 			}
 			reader := io.NewStaticFilterIndexReader()
+			if meta.IdxType == common.BloomFilterIndex {
+				reader = io.NewBloomFilterIndexReader()
+			}
 			// TODO: refactor id generation
 			id := gCommon.ID{
 				BlockID:   host.GetID().BlockID,
@@ -139,7 +237,23 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 			if err != nil {
 				return err
 			}
-			holder.staticFilterIndex = reader
+			if meta.ColIdx == pkIdx {
+				holder.staticFilterIndex = reader
+			} else {
+				holder.columnIndex(meta.ColIdx).staticFilterIndex = reader
+			}
+		case common.RowIndex:
+			reader := io.NewRowIndexReader()
+			// TODO: refactor id generation
+			id := gCommon.ID{
+				BlockID:   host.GetID().BlockID,
+				SegmentID: uint64(meta.InternalIdx),
+				Idx:       meta.ColIdx,
+			}
+			if err = reader.Init(bufManager, idxFile, &id); err != nil {
+				return err
+			}
+			holder.columnIndex(meta.ColIdx).rowIndex = reader
 		default:
 			panic("unsupported index type for block")
 		}
@@ -154,9 +268,23 @@ func (holder *nonAppendableBlockIndexHolder) Destroy() (err error) {
 	if err = holder.staticFilterIndex.Destroy(); err != nil {
 		return err
 	}
+	for _, idx := range holder.columns {
+		if err = idx.destroy(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (holder *nonAppendableBlockIndexHolder) columnIndex(colIdx uint16) *columnIndex {
+	idx, ok := holder.columns[colIdx]
+	if !ok {
+		idx = new(columnIndex)
+		holder.columns[colIdx] = idx
+	}
+	return idx
+}
+
 func (holder *nonAppendableBlockIndexHolder) GetHostBlockId() uint64 {
 	return holder.host.GetID().BlockID
 }
@@ -0,0 +1,171 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// DefaultBloomBitsPerKey gives a false positive rate below 1%
+const DefaultBloomBitsPerKey = 10
+
+type bloomFilter struct {
+	typ    types.Type
+	hashes uint32
+	bits   []uint64
+}
+
+// NewBloomFilter builds a bloom filter on the values of data with bitsPerKey
+// bits for each value
+func NewBloomFilter(data *vector.Vector, bitsPerKey int) (StaticFilter, error) {
+	if bitsPerKey <= 0 {
+		bitsPerKey = DefaultBloomBitsPerKey
+	}
+	keys := vector.Length(data)
+	nbits := keys * bitsPerKey
+	if nbits < 64 {
+		nbits = 64
+	}
+	// k = ln2 * m / n minimizes the false positive rate
+	hashes := uint32(float64(bitsPerKey) * 0.69)
+	if hashes < 1 {
+		hashes = 1
+	} else if hashes > 30 {
+		hashes = 30
+	}
+	bf := &bloomFilter{
+		typ:    data.Typ,
+		hashes: hashes,
+		bits:   make([]uint64, (nbits+63)/64),
+	}
+	collector := func(v interface{}) error {
+		hash, err := common.Hash(v, bf.typ)
+		if err != nil {
+			return err
+		}
+		bf.add(hash)
+		return nil
+	}
+	if err := common.ProcessVector(data, 0, -1, collector, nil); err != nil {
+		return nil, err
+	}
+	return bf, nil
+}
+
+func NewBloomFilterFromSource(data []byte) (StaticFilter, error) {
+	bf := bloomFilter{}
+	if err := bf.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &bf, nil
+}
+
+// add and contains use double hashing to derive the probe positions from
+// one 64-bit hash
+func (filter *bloomFilter) add(hash uint64) {
+	nbits := uint64(len(filter.bits)) * 64
+	delta := hash>>33 | hash<<31
+	for i := uint32(0); i < filter.hashes; i++ {
+		pos := hash % nbits
+		filter.bits[pos/64] |= 1 << (pos % 64)
+		hash += delta
+	}
+}
+
+func (filter *bloomFilter) contains(hash uint64) bool {
+	nbits := uint64(len(filter.bits)) * 64
+	delta := hash>>33 | hash<<31
+	for i := uint32(0); i < filter.hashes; i++ {
+		pos := hash % nbits
+		if filter.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+		hash += delta
+	}
+	return true
+}
+
+func (filter *bloomFilter) MayContainsKey(key interface{}) (bool, error) {
+	hash, err := common.Hash(key, filter.typ)
+	if err != nil {
+		return false, err
+	}
+	return filter.contains(hash), nil
+}
+
+func (filter *bloomFilter) MayContainsAnyKeys(keys *vector.Vector, visibility *roaring.Bitmap) (bool, *roaring.Bitmap, error) {
+	positive := roaring.NewBitmap()
+	row := uint32(0)
+
+	collector := func(v interface{}) error {
+		hash, err := common.Hash(v, filter.typ)
+		if err != nil {
+			return err
+		}
+		if filter.contains(hash) {
+			positive.Add(row)
+		}
+		row++
+		return nil
+	}
+
+	if err := common.ProcessVector(keys, 0, -1, collector, visibility); err != nil {
+		return false, nil, err
+	}
+	return positive.GetCardinality() != 0, positive, nil
+}
+
+func (filter *bloomFilter) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeType(filter.typ))
+	buf.Write(encoding.EncodeUint32(filter.hashes))
+	buf.Write(encoding.EncodeUint64Slice(filter.bits))
+	return buf.Bytes(), nil
+}
+
+func (filter *bloomFilter) Unmarshal(buf []byte) error {
+	filter.typ = encoding.DecodeType(buf[:encoding.TypeSize])
+	buf = buf[encoding.TypeSize:]
+	filter.hashes = encoding.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	filter.bits = append([]uint64{}, encoding.DecodeUint64Slice(buf)...)
+	return nil
+}
+
+func (filter *bloomFilter) Print() string {
+	s := "<BF>\n"
+	s += filter.typ.String()
+	s += "\n"
+	s += strconv.Itoa(int(filter.hashes))
+	s += "\n"
+	s += strconv.Itoa(len(filter.bits) * 64)
+	s += "\n"
+	s += "</BF>"
+	return s
+}
+
+func (filter *bloomFilter) GetMemoryUsage() uint32 {
+	size := uint32(0)
+	size += 4
+	size += uint32(len(filter.bits)) * 8
+	return size
+}
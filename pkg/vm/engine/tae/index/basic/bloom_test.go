@@ -0,0 +1,91 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilterNumeric(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	data := common.MockVec(typ, 40000, 0)
+	bf, err := NewBloomFilter(data, DefaultBloomBitsPerKey)
+	require.NoError(t, err)
+	var positive *roaring.Bitmap
+	var res bool
+	var exist bool
+
+	res, err = bf.MayContainsKey(int32(1209))
+	require.NoError(t, err)
+	require.True(t, res)
+
+	require.Panics(t, func() {
+		res, err = bf.MayContainsKey(int16(0))
+	})
+
+	query := common.MockVec(typ, 2000, 1000)
+	exist, positive, err = bf.MayContainsAnyKeys(query, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), positive.GetCardinality())
+	require.True(t, exist)
+
+	visibility := roaring.NewBitmap()
+	visibility.AddRange(uint64(0), uint64(1000))
+	_, positive, err = bf.MayContainsAnyKeys(query, visibility)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), positive.GetCardinality())
+
+	query = common.MockVec(typ, 20000, 40000)
+	_, positive, err = bf.MayContainsAnyKeys(query, nil)
+	require.NoError(t, err)
+	fpRate := float32(positive.GetCardinality()) / float32(20000)
+	require.True(t, fpRate < float32(0.02))
+
+	buf, err := bf.Marshal()
+	require.NoError(t, err)
+	bf1, err := NewBloomFilterFromSource(buf)
+	require.NoError(t, err)
+	query = common.MockVec(typ, 40000, 0)
+	exist, positive, err = bf1.MayContainsAnyKeys(query, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40000), positive.GetCardinality())
+	require.True(t, exist)
+}
+
+func TestBloomFilterString(t *testing.T) {
+	typ := types.Type{Oid: types.T_varchar}
+	data := common.MockVec(typ, 4000, 0)
+	// Duplicated keys are accepted
+	require.NoError(t, vector.Append(data, common.MockVec(typ, 100, 0).Col))
+	bf, err := NewBloomFilter(data, 0)
+	require.NoError(t, err)
+
+	res, err := bf.MayContainsKey([]byte(strconv.Itoa(1209)))
+	require.NoError(t, err)
+	require.True(t, res)
+
+	query := common.MockVec(typ, 2000, 4000)
+	_, positive, err := bf.MayContainsAnyKeys(query, nil)
+	require.NoError(t, err)
+	fpRate := float32(positive.GetCardinality()) / float32(2000)
+	require.True(t, fpRate < float32(0.02))
+}
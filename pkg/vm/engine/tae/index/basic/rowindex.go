@@ -0,0 +1,177 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
+)
+
+// RowMap maps the values of a column of an appendable block to the offsets
+// of their rows. It grows with the appends and is not persisted, the rows
+// of a block replayed are inserted again. The null values are not mapped
+type RowMap struct {
+	typ  types.Type
+	rows map[string][]uint32
+}
+
+func NewRowMap(typ types.Type) *RowMap {
+	return &RowMap{
+		typ:  typ,
+		rows: make(map[string][]uint32),
+	}
+}
+
+// BatchInsert maps the count values of keys from start to the rows from
+// offset
+func (m *RowMap) BatchInsert(keys *vector.Vector, start, count int, offset uint32) error {
+	for i := 0; i < count; i++ {
+		row := uint32(start + i)
+		if nulls.Contains(keys.Nsp, uint64(row)) {
+			continue
+		}
+		key, err := encodeRowKey(compute.GetValue(keys, row), m.typ)
+		if err != nil {
+			return err
+		}
+		m.rows[string(key)] = append(m.rows[string(key)], offset+uint32(i))
+	}
+	return nil
+}
+
+// Search returns the rows of key in ascending order
+func (m *RowMap) Search(key interface{}) ([]uint32, error) {
+	ikey, err := encodeRowKey(key, m.typ)
+	if err != nil {
+		return nil, err
+	}
+	return m.rows[string(ikey)], nil
+}
+
+// encodeRowKey encodes key of typ, a string key of a char column is encoded
+// as its bytes
+func encodeRowKey(key interface{}, typ types.Type) ([]byte, error) {
+	if s, ok := key.(string); ok {
+		key = []byte(s)
+	}
+	return common.EncodeKey(key, typ)
+}
+
+// RowIndex maps the values of a column of a non-appendable block to the
+// offsets of their rows. It is built once the block data is written and is
+// persisted with the block. The keys are sorted and searched by a binary
+// search, the rows of the i-th key are rows[offsets[i]:offsets[i+1]]
+type RowIndex struct {
+	typ     types.Type
+	keys    []string
+	offsets []uint32
+	rows    []uint32
+}
+
+// NewRowIndex builds the index of the values of data. The null values are
+// not indexed
+func NewRowIndex(data *vector.Vector) (*RowIndex, error) {
+	m := NewRowMap(data.Typ)
+	if err := m.BatchInsert(data, 0, vector.Length(data), 0); err != nil {
+		return nil, err
+	}
+	idx := &RowIndex{
+		typ:     data.Typ,
+		keys:    make([]string, 0, len(m.rows)),
+		offsets: make([]uint32, 0, len(m.rows)+1),
+	}
+	for key := range m.rows {
+		idx.keys = append(idx.keys, key)
+	}
+	sort.Strings(idx.keys)
+	for _, key := range idx.keys {
+		idx.offsets = append(idx.offsets, uint32(len(idx.rows)))
+		idx.rows = append(idx.rows, m.rows[key]...)
+	}
+	idx.offsets = append(idx.offsets, uint32(len(idx.rows)))
+	return idx, nil
+}
+
+func NewRowIndexFromSource(data []byte) (*RowIndex, error) {
+	idx := RowIndex{}
+	if err := idx.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &idx, nil
+}
+
+// Search returns the rows of key in ascending order
+func (idx *RowIndex) Search(key interface{}) ([]uint32, error) {
+	ikey, err := encodeRowKey(key, idx.typ)
+	if err != nil {
+		return nil, err
+	}
+	pos := sort.SearchStrings(idx.keys, string(ikey))
+	if pos == len(idx.keys) || idx.keys[pos] != string(ikey) {
+		return nil, nil
+	}
+	return idx.rows[idx.offsets[pos]:idx.offsets[pos+1]], nil
+}
+
+func (idx *RowIndex) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeType(idx.typ))
+	keys := encoding.EncodeStringSlice(idx.keys)
+	buf.Write(encoding.EncodeUint32(uint32(len(keys))))
+	buf.Write(keys)
+	buf.Write(encoding.EncodeUint32(uint32(len(idx.offsets))))
+	buf.Write(encoding.EncodeUint32Slice(idx.offsets))
+	buf.Write(encoding.EncodeUint32Slice(idx.rows))
+	return buf.Bytes(), nil
+}
+
+func (idx *RowIndex) Unmarshal(buf []byte) error {
+	if len(buf) < encoding.TypeSize+4 {
+		return errors.ErrInvalidIndexData
+	}
+	idx.typ = encoding.DecodeType(buf[:encoding.TypeSize])
+	buf = buf[encoding.TypeSize:]
+	size := encoding.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	idx.keys = append([]string{}, encoding.DecodeStringSlice(buf[:size])...)
+	buf = buf[size:]
+	cnt := encoding.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	idx.offsets = append([]uint32{}, encoding.DecodeUint32Slice(buf[:4*cnt])...)
+	buf = buf[4*cnt:]
+	idx.rows = append([]uint32{}, encoding.DecodeUint32Slice(buf)...)
+	return nil
+}
+
+func (idx *RowIndex) Print() string {
+	s := "<RI>\n"
+	s += idx.typ.String()
+	s += "\n"
+	s += strconv.Itoa(len(idx.keys))
+	s += "\n"
+	s += strconv.Itoa(len(idx.rows))
+	s += "\n"
+	s += "</RI>"
+	return s
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowIndex(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	// 0..999 twice, the rows of a value are i and i+1000
+	data := common.MockVec(typ, 1000, 0)
+	require.NoError(t, vector.Append(data, common.MockVec(typ, 1000, 0).Col))
+	nulls.Add(data.Nsp, 1005)
+	idx, err := NewRowIndex(data)
+	require.NoError(t, err)
+
+	rows, err := idx.Search(int32(7))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{7, 1007}, rows)
+	// The null value is not indexed
+	rows, err = idx.Search(int32(5))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{5}, rows)
+	rows, err = idx.Search(int32(1000))
+	require.NoError(t, err)
+	require.Empty(t, rows)
+	require.Panics(t, func() {
+		_, _ = idx.Search(int16(0))
+	})
+
+	buf, err := idx.Marshal()
+	require.NoError(t, err)
+	idx1, err := NewRowIndexFromSource(buf)
+	require.NoError(t, err)
+	for i := int32(0); i < 1000; i += 97 {
+		rows, err = idx1.Search(i)
+		require.NoError(t, err)
+		require.Equal(t, []uint32{uint32(i), uint32(i) + 1000}, rows)
+	}
+}
+
+func TestRowIndexString(t *testing.T) {
+	typ := types.Type{Oid: types.T_varchar}
+	idx, err := NewRowIndex(common.MockVec(typ, 100, 0))
+	require.NoError(t, err)
+	buf, err := idx.Marshal()
+	require.NoError(t, err)
+	idx, err = NewRowIndexFromSource(buf)
+	require.NoError(t, err)
+	rows, err := idx.Search([]byte("42"))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{42}, rows)
+
+	// An empty index
+	idx, err = NewRowIndex(vector.New(typ))
+	require.NoError(t, err)
+	buf, err = idx.Marshal()
+	require.NoError(t, err)
+	idx, err = NewRowIndexFromSource(buf)
+	require.NoError(t, err)
+	rows, err = idx.Search([]byte("42"))
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
+
+func TestRowMap(t *testing.T) {
+	typ := types.Type{Oid: types.T_int64}
+	m := NewRowMap(typ)
+	require.NoError(t, m.BatchInsert(common.MockVec(typ, 10, 0), 0, 10, 0))
+	// The rows 5..9 of the vector are appended from the row 10
+	require.NoError(t, m.BatchInsert(common.MockVec(typ, 10, 0), 5, 5, 10))
+	rows, err := m.Search(int64(6))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{6, 11}, rows)
+	rows, err = m.Search(int64(3))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{3}, rows)
+}
@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -33,6 +34,9 @@ type ZoneMap struct {
 	min         interface{}
 	max         interface{}
 	initialized bool
+	// nullCnt is the count of the null values, which are not used to update
+	// min and max
+	nullCnt uint32
 }
 
 func NewZoneMap(typ types.Type, mutex *sync.RWMutex) *ZoneMap {
@@ -89,12 +93,51 @@ func (zm *ZoneMap) BatchUpdate(vec *vector.Vector, offset uint32, length int) er
 	}
 	zm.mu.Lock()
 	defer zm.mu.Unlock()
-	if err := common.ProcessVector(vec, offset, length, zm.UpdateLocked, nil); err != nil {
+	var visibility *roaring.Bitmap
+	if nulls.Any(vec.Nsp) {
+		end := uint32(vector.Length(vec))
+		if length >= 0 && offset+uint32(length) < end {
+			end = offset + uint32(length)
+		}
+		visibility = roaring.NewBitmap()
+		for row := offset; row < end; row++ {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				zm.nullCnt++
+				continue
+			}
+			visibility.Add(row - offset)
+		}
+	}
+	if err := common.ProcessVector(vec, offset, length, zm.UpdateLocked, visibility); err != nil {
 		return err
 	}
 	return nil
 }
 
+func (zm *ZoneMap) GetNullCnt() uint32 {
+	zm.mu.RLock()
+	defer zm.mu.RUnlock()
+	return zm.nullCnt
+}
+
+// MayMatchRange returns false if no value in the zone map is less than (or
+// equal to when inclusive) v when lt is set, or greater than (or equal to)
+// v otherwise
+func (zm *ZoneMap) MayMatchRange(v interface{}, lt, inclusive bool) bool {
+	zm.mu.RLock()
+	defer zm.mu.RUnlock()
+	if !zm.initialized {
+		return false
+	}
+	var res int
+	if lt {
+		res = common.CompareGeneric(zm.min, v, zm.typ)
+		return res < 0 || (inclusive && res == 0)
+	}
+	res = common.CompareGeneric(zm.max, v, zm.typ)
+	return res > 0 || (inclusive && res == 0)
+}
+
 func (zm *ZoneMap) Query(key interface{}) (int, error) {
 	// TODO: mismatch error
 	zm.mu.RLock()
@@ -223,6 +266,7 @@ func (zm *ZoneMap) Print() string {
 func (zm *ZoneMap) Marshal() ([]byte, error) {
 	var buf bytes.Buffer
 	buf.Write(encoding.EncodeType(zm.typ))
+	buf.Write(encoding.EncodeUint32(zm.nullCnt))
 	if !zm.initialized {
 		buf.Write(encoding.EncodeInt8(0))
 		return buf.Bytes(), nil
@@ -292,6 +336,8 @@ func (zm *ZoneMap) Marshal() ([]byte, error) {
 func (zm *ZoneMap) Unmarshal(buf []byte) error {
 	zm.typ = encoding.DecodeType(buf[:encoding.TypeSize])
 	buf = buf[encoding.TypeSize:]
+	zm.nullCnt = encoding.DecodeUint32(buf[:4])
+	buf = buf[4:]
 	init := encoding.DecodeInt8(buf[:1])
 	buf = buf[1:]
 	zm.mu = new(sync.RWMutex)
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/stretchr/testify/require"
@@ -194,3 +195,27 @@ func TestZoneMapString(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, res)
 }
+
+func TestZoneMapNulls(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	zm := NewZoneMap(typ, nil)
+	vec := common.MockVec(typ, 100, 0)
+	// The null slots hold 0 and 99, which must not widen the range
+	nulls.Add(vec.Nsp, 0, 99)
+	require.NoError(t, zm.BatchUpdate(vec, 0, -1))
+	require.Equal(t, uint32(2), zm.GetNullCnt())
+	require.Equal(t, int32(1), zm.GetMin())
+	require.Equal(t, int32(98), zm.GetMax())
+
+	require.False(t, zm.MayMatchRange(int32(1), true, false))
+	require.True(t, zm.MayMatchRange(int32(1), true, true))
+	require.False(t, zm.MayMatchRange(int32(98), false, false))
+	require.True(t, zm.MayMatchRange(int32(98), false, true))
+
+	buf, err := zm.Marshal()
+	require.NoError(t, err)
+	zm1, err := NewZoneMapFromSource(buf)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), zm1.GetNullCnt())
+	require.Equal(t, int32(98), zm1.GetMax())
+}
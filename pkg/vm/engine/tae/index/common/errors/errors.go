@@ -21,4 +21,5 @@ var (
 	ErrKeyNotFound      = errors.New("index: key not found")
 	ErrKeyDuplicate     = errors.New("index: duplicate key occurred")
 	ErrTypeMismatch     = errors.New("index: type mismatch")
+	ErrInvalidIndexData = errors.New("index: invalid index data")
 )
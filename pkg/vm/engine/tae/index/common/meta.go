@@ -28,6 +28,9 @@ const (
 	SegmentZoneMapIndex
 	StaticFilterIndex
 	ARTIndex
+	BloomFilterIndex
+	// RowIndex maps the values of a column to the offsets of their rows
+	RowIndex
 )
 
 type CompressType uint8
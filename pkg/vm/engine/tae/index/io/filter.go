@@ -31,11 +31,13 @@ type staticFilterIndexNode struct {
 	//host  dataio.IndexFile
 	//meta  *common.IndexMeta
 	host  gCommon.IVFile
+	typ   common.IndexType
 	inner basic.StaticFilter
 }
 
-func newStaticFilterIndexNode(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID) *staticFilterIndexNode {
+func newStaticFilterIndexNode(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID, typ common.IndexType) *staticFilterIndexNode {
 	impl := new(staticFilterIndexNode)
+	impl.typ = typ
 	impl.Node = buffer.NewNode(impl, mgr, *id, uint64(host.Stat().Size()))
 	impl.LoadFunc = impl.OnLoad
 	impl.UnloadFunc = impl.OnUnload
@@ -65,7 +67,11 @@ func (n *staticFilterIndexNode) OnLoad() {
 	buf := make([]byte, rawSize)
 	if err = common.Decompress(data, buf, common.CompressType(compressTyp)); err != nil {
 	}
-	n.inner, err = basic.NewBinaryFuseFilterFromSource(buf)
+	if n.typ == common.BloomFilterIndex {
+		n.inner, err = basic.NewBloomFilterFromSource(buf)
+	} else {
+		n.inner, err = basic.NewBinaryFuseFilterFromSource(buf)
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -92,15 +98,21 @@ func (n *staticFilterIndexNode) Close() (err error) {
 }
 
 type StaticFilterIndexReader struct {
+	typ   common.IndexType
 	inode *staticFilterIndexNode
 }
 
 func NewStaticFilterIndexReader() *StaticFilterIndexReader {
-	return &StaticFilterIndexReader{}
+	return &StaticFilterIndexReader{typ: common.StaticFilterIndex}
+}
+
+// NewBloomFilterIndexReader reads the filter written by a bloom filter writer
+func NewBloomFilterIndexReader() *StaticFilterIndexReader {
+	return &StaticFilterIndexReader{typ: common.BloomFilterIndex}
 }
 
 func (reader *StaticFilterIndexReader) Init(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID) error {
-	reader.inode = newStaticFilterIndexNode(mgr, host, id)
+	reader.inode = newStaticFilterIndexNode(mgr, host, id, reader.typ)
 	return nil
 }
 
@@ -124,6 +136,7 @@ func (reader *StaticFilterIndexReader) MayContainsAnyKeys(keys *vector.Vector, v
 }
 
 type StaticFilterIndexWriter struct {
+	typ         common.IndexType
 	cType       common.CompressType
 	host        gCommon.IRWFile
 	inner       basic.StaticFilter
@@ -133,7 +146,13 @@ type StaticFilterIndexWriter struct {
 }
 
 func NewStaticFilterIndexWriter() *StaticFilterIndexWriter {
-	return &StaticFilterIndexWriter{}
+	return &StaticFilterIndexWriter{typ: common.StaticFilterIndex}
+}
+
+// NewBloomFilterIndexWriter writes a bloom filter instead of a binary fuse
+// filter. A bloom filter is built in one pass and tolerates duplicated keys
+func NewBloomFilterIndexWriter() *StaticFilterIndexWriter {
+	return &StaticFilterIndexWriter{typ: common.BloomFilterIndex}
 }
 
 func (writer *StaticFilterIndexWriter) Init(host gCommon.IRWFile, cType common.CompressType, colIdx uint16, internalIdx uint16) error {
@@ -148,7 +167,13 @@ func (writer *StaticFilterIndexWriter) Finalize() (*common.IndexMeta, error) {
 	if writer.inner != nil {
 		panic("formerly finalized filter not cleared yet")
 	}
-	sf, err := basic.NewBinaryFuseFilter(writer.data)
+	var sf basic.StaticFilter
+	var err error
+	if writer.typ == common.BloomFilterIndex {
+		sf, err = basic.NewBloomFilter(writer.data, basic.DefaultBloomBitsPerKey)
+	} else {
+		sf, err = basic.NewBinaryFuseFilter(writer.data)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +182,7 @@ func (writer *StaticFilterIndexWriter) Finalize() (*common.IndexMeta, error) {
 
 	appender := writer.host
 	meta := common.NewEmptyIndexMeta()
-	meta.SetIndexType(common.StaticFilterIndex)
+	meta.SetIndexType(writer.typ)
 	meta.SetCompressType(writer.cType)
 	meta.SetIndexedColumn(writer.colIdx)
 	meta.SetInternalIndex(writer.internalIdx)
@@ -0,0 +1,184 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+	gCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
+)
+
+type rowIndexNode struct {
+	*buffer.Node
+	mgr   base.INodeManager
+	host  gCommon.IVFile
+	inner *basic.RowIndex
+}
+
+func newRowIndexNode(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID) *rowIndexNode {
+	impl := new(rowIndexNode)
+	impl.Node = buffer.NewNode(impl, mgr, *id, uint64(host.Stat().Size()))
+	impl.LoadFunc = impl.OnLoad
+	impl.UnloadFunc = impl.OnUnload
+	impl.DestroyFunc = impl.OnDestroy
+	impl.host = host
+	impl.mgr = mgr
+	mgr.RegisterNode(impl)
+	return impl
+}
+
+func (n *rowIndexNode) OnLoad() {
+	if n.inner != nil {
+		// no-op
+		return
+	}
+	var err error
+	stat := n.host.Stat()
+	size := stat.Size()
+	compressTyp := stat.CompressAlgo()
+	data := make([]byte, size)
+	if _, err = n.host.Read(data); err != nil {
+		panic(err)
+	}
+	rawSize := stat.OriginSize()
+	buf := make([]byte, rawSize)
+	if err = common.Decompress(data, buf, common.CompressType(compressTyp)); err != nil {
+		panic(err)
+	}
+	if n.inner, err = basic.NewRowIndexFromSource(buf); err != nil {
+		panic(err)
+	}
+}
+
+func (n *rowIndexNode) OnUnload() {
+	if n.inner == nil {
+		// no-op
+		return
+	}
+	n.inner = nil
+}
+
+func (n *rowIndexNode) OnDestroy() {
+	n.host.Unref()
+}
+
+func (n *rowIndexNode) Close() (err error) {
+	if err = n.Node.Close(); err != nil {
+		return err
+	}
+	n.inner = nil
+	return nil
+}
+
+// RowIndexReader searches the rows of the values of a column of a block in
+// the index persisted with the block
+type RowIndexReader struct {
+	inode *rowIndexNode
+}
+
+func NewRowIndexReader() *RowIndexReader {
+	return new(RowIndexReader)
+}
+
+func (reader *RowIndexReader) Init(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID) error {
+	reader.inode = newRowIndexNode(mgr, host, id)
+	return nil
+}
+
+func (reader *RowIndexReader) Destroy() (err error) {
+	if err = reader.inode.Close(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Search returns the offsets of the rows of key in ascending order
+func (reader *RowIndexReader) Search(key interface{}) ([]uint32, error) {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	rows, err := handle.GetNode().(*rowIndexNode).inner.Search(key)
+	if err != nil {
+		return nil, err
+	}
+	// The rows are copied out of the node, which may be unloaded once
+	// unpinned
+	return append([]uint32{}, rows...), nil
+}
+
+type RowIndexWriter struct {
+	cType       common.CompressType
+	host        gCommon.IRWFile
+	data        *vector.Vector
+	colIdx      uint16
+	internalIdx uint16
+}
+
+func NewRowIndexWriter() *RowIndexWriter {
+	return new(RowIndexWriter)
+}
+
+func (writer *RowIndexWriter) Init(host gCommon.IRWFile, cType common.CompressType, colIdx uint16, internalIdx uint16) error {
+	writer.host = host
+	writer.cType = cType
+	writer.colIdx = colIdx
+	writer.internalIdx = internalIdx
+	return nil
+}
+
+func (writer *RowIndexWriter) Finalize() (*common.IndexMeta, error) {
+	idx, err := basic.NewRowIndex(writer.data)
+	if err != nil {
+		return nil, err
+	}
+	writer.data = nil
+
+	meta := common.NewEmptyIndexMeta()
+	meta.SetIndexType(common.RowIndex)
+	meta.SetCompressType(writer.cType)
+	meta.SetIndexedColumn(writer.colIdx)
+	meta.SetInternalIndex(writer.internalIdx)
+
+	iBuf, err := idx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	rawSize := uint32(len(iBuf))
+	compressed := common.Compress(iBuf, writer.cType)
+	exactSize := uint32(len(compressed))
+	meta.SetSize(rawSize, exactSize)
+	if _, err = writer.host.Write(compressed); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// AddValues adds the values of the rows following the ones added before
+func (writer *RowIndexWriter) AddValues(values *vector.Vector) error {
+	if writer.data == nil {
+		writer.data = values
+		return nil
+	}
+	if writer.data.Typ != values.Typ {
+		return errors.ErrTypeMismatch
+	}
+	if err := vector.Append(writer.data, values.Col); err != nil {
+		return err
+	}
+	return nil
+}
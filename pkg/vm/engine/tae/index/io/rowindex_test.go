@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowIndex(t *testing.T) {
+	bufManager := buffer.NewNodeManager(1024*1024, nil)
+	file := common.MockRWFile()
+	typ := types.Type{Oid: types.T_int32}
+
+	writer := NewRowIndexWriter()
+	require.NoError(t, writer.Init(file, idxCommon.Plain, 0, 2))
+	require.NoError(t, writer.AddValues(idxCommon.MockVec(typ, 1000, 0)))
+	require.NoError(t, writer.AddValues(idxCommon.MockVec(typ, 1000, 500)))
+	meta, err := writer.Finalize()
+	require.NoError(t, err)
+	require.Equal(t, idxCommon.RowIndex, meta.IdxType)
+	require.Equal(t, uint16(2), meta.InternalIdx)
+
+	reader := NewRowIndexReader()
+	require.NoError(t, reader.Init(bufManager, file, &common.ID{}))
+	rows, err := reader.Search(int32(100))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{100}, rows)
+	rows, err = reader.Search(int32(700))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{700, 1200}, rows)
+	rows, err = reader.Search(int32(2000))
+	require.NoError(t, err)
+	require.Empty(t, rows)
+	require.NoError(t, reader.Destroy())
+}
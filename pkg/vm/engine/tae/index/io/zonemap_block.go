@@ -122,6 +122,13 @@ func (reader *BlockZoneMapIndexReader) MayContainsKey(key interface{}) (bool, er
 	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsKey(key)
 }
 
+// Eval calls fn with the loaded zone map pinned in memory
+func (reader *BlockZoneMapIndexReader) Eval(fn func(zm *basic.ZoneMap) bool) bool {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return fn(handle.GetNode().(*blockZoneMapIndexNode).inner)
+}
+
 type BlockZoneMapIndexWriter struct {
 	cType       common.CompressType
 	host        gCommon.IRWFile
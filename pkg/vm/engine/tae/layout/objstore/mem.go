@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import "sync"
+
+type memStore struct {
+	sync.RWMutex
+	objects map[string][]byte
+}
+
+// NewMemStore returns an object store in memory for tests
+func NewMemStore() *memStore {
+	return &memStore{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (s *memStore) Put(key string, data []byte) error {
+	buf := make([]byte, len(data))
+	copy(buf, data)
+	s.Lock()
+	defer s.Unlock()
+	s.objects[key] = buf
+	return nil
+}
+
+func (s *memStore) Get(key string) ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *memStore) Delete(key string) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+// Count returns the number of objects
+func (s *memStore) Count() int {
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.objects)
+}
@@ -0,0 +1,267 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+var (
+	ErrS3Request = errors.New("tae objstore: s3 request failed")
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3DateFormat    = "20060102"
+	s3TimeFormat    = "20060102T150405Z"
+	s3DefaultRegion = "us-east-1"
+)
+
+// S3Cfg is the access to a bucket of S3 or any S3 compatible storage like
+// minio. The objects are addressed in path style
+type S3Cfg struct {
+	// Endpoint is the host[:port] of the service
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// Secure uses https instead of http
+	Secure bool
+}
+
+type s3Store struct {
+	cfg    S3Cfg
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3Store returns an object store of the bucket in cfg. The requests are
+// signed with AWS signature version 4
+func NewS3Store(cfg S3Cfg) *s3Store {
+	if cfg.Region == "" {
+		cfg.Region = s3DefaultRegion
+	}
+	return &s3Store{
+		cfg:    cfg,
+		client: &http.Client{Timeout: time.Minute},
+		now:    time.Now,
+	}
+}
+
+func (s *s3Store) Put(key string, data []byte) error {
+	resp, err := s.do(http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s.respError(resp)
+	}
+	return nil
+}
+
+func (s *s3Store) Get(key string) ([]byte, error) {
+	resp, err := s.do(http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	}
+	return nil, s.respError(resp)
+}
+
+func (s *s3Store) Delete(key string) error {
+	resp, err := s.do(http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return s.respError(resp)
+	}
+	return nil
+}
+
+func (s *s3Store) respError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	logutil.Warnf("%s %s: %s %s", resp.Request.Method, resp.Request.URL, resp.Status, strings.TrimSpace(string(body)))
+	return ErrS3Request
+}
+
+func (s *s3Store) do(method, key string, data []byte) (*http.Response, error) {
+	scheme := "http"
+	if s.cfg.Secure {
+		scheme = "https"
+	}
+	u := &url.URL{
+		Scheme: scheme,
+		Host:   s.cfg.Endpoint,
+		Path:   "/" + s.cfg.Bucket + "/" + key,
+	}
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(data))
+	s.sign(req, data)
+	return s.client.Do(req)
+}
+
+// sign adds the headers of AWS signature version 4 to req
+func (s *s3Store) sign(req *http.Request, payload []byte) {
+	payloadHash := hashHex(payload)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	v4 := signer{
+		region:    s.cfg.Region,
+		service:   s3Service,
+		accessKey: s.cfg.AccessKey,
+		secretKey: s.cfg.SecretKey,
+	}
+	v4.sign(req, payloadHash, s.now())
+}
+
+// signer signs the requests with AWS signature version 4
+type signer struct {
+	region    string
+	service   string
+	accessKey string
+	secretKey string
+}
+
+// sign sets the date and the authorization of req signed at t. The host and
+// all the headers of req are signed
+func (s signer) sign(req *http.Request, payloadHash string, t time.Time) {
+	t = t.UTC()
+	req.Header.Set("X-Amz-Date", t.Format(s3TimeFormat))
+	signed := []string{"host"}
+	for name := range req.Header {
+		signed = append(signed, strings.ToLower(name))
+	}
+	sort.Strings(signed)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKey, s.scope(t), strings.Join(signed, ";"),
+		s.signature(req, signed, payloadHash, t)))
+}
+
+func (s signer) scope(t time.Time) string {
+	return strings.Join([]string{t.Format(s3DateFormat), s.region, s.service, "aws4_request"}, "/")
+}
+
+// signature returns the signature of req at t over the headers of signed,
+// which are in lower case and sorted
+func (s signer) signature(req *http.Request, signed []string, payloadHash string, t time.Time) string {
+	t = t.UTC()
+	var headers strings.Builder
+	for _, name := range signed {
+		value := req.Host
+		if name == "host" {
+			if value == "" {
+				value = req.URL.Host
+			}
+		} else {
+			value = strings.Join(strings.Fields(strings.Join(req.Header.Values(name), ",")), " ")
+		}
+		headers.WriteString(name + ":" + value + "\n")
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		headers.String(),
+		strings.Join(signed, ";"),
+		payloadHash,
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		t.Format(s3TimeFormat),
+		s.scope(t),
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), t.Format(s3DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s.service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalQuery returns the parameters encoded and sorted by their names
+// and then their values
+func canonicalQuery(query url.Values) string {
+	params := make([][2]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, [2]string{uriEncode(name, true), uriEncode(value, true)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+	pairs := make([]string, len(params))
+	for i, param := range params {
+		pairs[i] = param[0] + "=" + param[1]
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes all the bytes of s but the unreserved ones, and
+// the slashes unless slash is true
+func uriEncode(s string, slash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' && !slash {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
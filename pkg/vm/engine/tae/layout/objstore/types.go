@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("tae objstore: object not found")
+)
+
+// ObjectStore keeps the objects by key. An object is always written and
+// read as a whole
+type ObjectStore interface {
+	Put(key string, data []byte) error
+	// Get returns ErrNotFound if there is no object of key
+	Get(key string) ([]byte, error)
+	// Delete is a noop if there is no object of key
+	Delete(key string) error
+}
@@ -17,4 +17,20 @@ package segment
 type Allocator interface {
 	Allocate(len uint64) (uint64, uint64)
 	Free(start uint32, len uint32)
+	// Reserve marks the pages of the range in use, which are allocated
+	// before the restart
+	Reserve(start uint32, len uint32)
+	Stats() AllocatorStats
+}
+
+// AllocatorStats is the occupancy of the space of an allocator
+type AllocatorStats struct {
+	Capacity uint64
+	Used     uint64
+	// FreeExtents is the number of the free ranges. Many small ones mean
+	// the space is fragmented
+	FreeExtents int
+	// LargestFree is the length of the largest free range, the largest
+	// allocation that can succeed
+	LargestFree uint64
 }
@@ -189,6 +189,17 @@ func (b *BitmapAllocator) Free(start uint32, len uint32) {
 	b.lastPos = uint64(start)
 }
 
+func (b *BitmapAllocator) Reserve(start uint32, len uint32) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	pos := uint64(start / b.pageSize)
+	end := p2roundup(uint64(start)+uint64(len), uint64(b.pageSize)) / uint64(b.pageSize)
+	b.markAllocFree0(pos, end, false)
+	l0start := p2align(pos, BITS_PER_UNITSET)
+	l0end := p2roundup(end, BITS_PER_UNITSET)
+	b.markLevel1(l0start, l0end, false)
+}
+
 func (b *BitmapAllocator) Allocate(len uint64) (uint64, uint64) {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
@@ -266,3 +277,34 @@ func (b *BitmapAllocator) Allocate(len uint64) (uint64, uint64) {
 	}
 	return 0, 0
 }
+
+func (b *BitmapAllocator) Stats() (stats AllocatorStats) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	var run uint64
+	flush := func() {
+		if run == 0 {
+			return
+		}
+		stats.FreeExtents++
+		if run > stats.LargestFree {
+			stats.LargestFree = run
+		}
+		run = 0
+	}
+	var free uint64
+	for _, val := range b.level0 {
+		for i := 0; i < BITS_PER_UNIT; i++ {
+			if val&(1<<i) == 0 {
+				flush()
+				continue
+			}
+			run += uint64(b.pageSize)
+			free += uint64(b.pageSize)
+		}
+	}
+	flush()
+	stats.Capacity = uint64(len(b.level0)) * BITS_PER_UNIT * uint64(b.pageSize)
+	stats.Used = stats.Capacity - free
+	return
+}
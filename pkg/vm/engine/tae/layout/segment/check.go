@@ -0,0 +1,288 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+var (
+	ErrDanglingExtent   = errors.New("tae segment: dangling extent")
+	ErrOverlappedExtent = errors.New("tae segment: overlapped extent")
+	ErrSizeMismatch     = errors.New("tae segment: size mismatch")
+)
+
+// CheckIssue is an inconsistency found in a segment file
+type CheckIssue struct {
+	// File is the block file of the issue. It is empty for the issues of
+	// the segment
+	File string
+	// Extent is the index of the extent of the issue in File or -1
+	Extent int
+	Err    error
+	// Repaired is set if the issue is repaired by the repair mode
+	Repaired bool
+}
+
+func (issue *CheckIssue) String() string {
+	s := issue.Err.Error()
+	if issue.File != "" {
+		s = fmt.Sprintf("%s: %s", issue.File, s)
+	}
+	if issue.Extent >= 0 {
+		s = fmt.Sprintf("%s of extent %d", s, issue.Extent)
+	}
+	if issue.Repaired {
+		s += " (repaired)"
+	}
+	return s
+}
+
+// CheckReport is the result of the check of a segment file
+type CheckReport struct {
+	Segment string
+	Version uint64
+	// Files is the number of the block files
+	Files  int
+	Issues []*CheckIssue
+	repair bool
+}
+
+// OK returns true if no issue is left unrepaired
+func (r *CheckReport) OK() bool {
+	for _, issue := range r.Issues {
+		if !issue.Repaired {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *CheckReport) addIssue(file string, extent int, err error) *CheckIssue {
+	if r == nil {
+		return nil
+	}
+	issue := &CheckIssue{File: file, Extent: extent, Err: err}
+	r.Issues = append(r.Issues, issue)
+	return issue
+}
+
+// Check checks the segment files in dir without changing them
+func Check(dir string) ([]*CheckReport, error) {
+	return CheckWithDriver(dir, LocalDriver, false)
+}
+
+// Repair checks the segment files in dir and repairs them
+func Repair(dir string) ([]*CheckReport, error) {
+	return CheckWithDriver(dir, LocalDriver, true)
+}
+
+// CheckWithDriver checks the segment files in dir opened through driver.
+// They are repaired if repair is set
+func CheckWithDriver(dir string, driver Driver, repair bool) ([]*CheckReport, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.seg"))
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*CheckReport, 0, len(names))
+	for _, name := range names {
+		report, err := CheckSegment(name, driver, repair)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// CheckSegment validates the superblock, the inode log, the bounds of the
+// extents, their overlaps, checksums and compressed sizes of the segment
+// file of name. The repair is conservative: the dangling extents are
+// dropped and the allocators are rebuilt, while the other issues are only
+// reported
+func CheckSegment(name string, driver Driver, repair bool) (*CheckReport, error) {
+	report := &CheckReport{Segment: name, repair: repair}
+	s := &Segment{check: report}
+	if err := s.OpenWithDriver(name, driver); err != nil {
+		if err == ErrInvalidSuperBlock || err == ErrUnsupportedVersion {
+			report.addIssue("", -1, err)
+			return report, nil
+		}
+		return nil, err
+	}
+	defer s.segFile.Close()
+	report.Version = s.super.version
+	fileSize, err := s.segFile.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*BlockFile, 0, len(s.nodes))
+	for _, file := range s.nodes {
+		if file != s.log.logFile {
+			files = append(files, file)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+	report.Files = len(files)
+
+	dangling := make([]*CheckIssue, 0)
+	for _, file := range files {
+		for i, ext := range file.snode.extents {
+			end := int64(ext.offset) + int64(ext.data.offset) + int64(ext.data.length)
+			if !ext.inDataArea() || end > fileSize {
+				dangling = append(dangling, report.addIssue(file.name, i, ErrDanglingExtent))
+			}
+		}
+	}
+	if repair && len(dangling) > 0 {
+		if err = s.checkWritable(); err != nil {
+			report.addIssue("", -1, err)
+		} else if err = s.dropDangling(files, fileSize); err != nil {
+			return nil, err
+		} else {
+			for _, issue := range dangling {
+				issue.Repaired = true
+			}
+		}
+	}
+	// The data of the files with dangling extents left is not read
+	unread := make(map[string]bool)
+	for _, issue := range dangling {
+		if !issue.Repaired {
+			unread[issue.File] = true
+		}
+	}
+
+	type span struct {
+		file   string
+		extent int
+		offset uint32
+		end    uint32
+	}
+	spans := make([]span, 0)
+	for _, file := range files {
+		var size uint64
+		for i, ext := range file.snode.extents {
+			size += uint64(ext.data.length)
+			if ext.data.offset+ext.data.length > ext.length {
+				report.addIssue(file.name, i, ErrSizeMismatch)
+			}
+			if ext.inDataArea() {
+				spans = append(spans, span{file.name, i, ext.offset, ext.End()})
+			}
+		}
+		if size != file.snode.size {
+			report.addIssue(file.name, -1, ErrSizeMismatch)
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].offset < spans[j].offset
+	})
+	for i := 1; i < len(spans); i++ {
+		if spans[i].offset < spans[i-1].end {
+			report.addIssue(spans[i].file, spans[i].extent, ErrOverlappedExtent)
+		}
+	}
+
+	for _, file := range files {
+		if unread[file.name] {
+			continue
+		}
+		corrupted, err := file.Verify()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range corrupted {
+			report.addIssue(file.name, c.Extent, c)
+		}
+		if len(corrupted) == 0 {
+			if err = file.checkOriginSize(); err != nil {
+				report.addIssue(file.name, -1, err)
+			}
+		}
+	}
+	if repair {
+		if err = s.segFile.Sync(); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// dropDangling drops the extents of files out of the data area or past the
+// end of the segment file and rebuilds the allocators
+func (s *Segment) dropDangling(files []*BlockFile, fileSize int64) error {
+	for _, file := range files {
+		file.snode.mutex.Lock()
+		extents := make([]Extent, 0, len(file.snode.extents))
+		for _, ext := range file.snode.extents {
+			end := int64(ext.offset) + int64(ext.data.offset) + int64(ext.data.length)
+			if ext.inDataArea() && end <= fileSize {
+				extents = append(extents, ext)
+				continue
+			}
+			file.snode.size -= uint64(ext.data.length)
+		}
+		dropped := len(extents) != len(file.snode.extents)
+		file.snode.extents = extents
+		file.snode.mutex.Unlock()
+		if !dropped {
+			continue
+		}
+		if err := s.log.Append(file); err != nil {
+			return err
+		}
+	}
+	s.rebuildAllocator()
+	return nil
+}
+
+// checkOriginSize decompresses the data of the extents appended and checks
+// the size is the origin size of the file
+func (b *BlockFile) checkOriginSize() error {
+	b.snode.mutex.RLock()
+	defer b.snode.mutex.RUnlock()
+	var size uint64
+	for num, ext := range b.snode.extents {
+		if ext.typ != APPEND {
+			return nil
+		}
+		if ext.algo == compress.None {
+			size += uint64(ext.data.length)
+			continue
+		}
+		buf := make([]byte, ext.data.length)
+		if err := b.readExtentAt(num, ext.data.offset, buf); err != nil {
+			return err
+		}
+		data, err := compress.Decompress(buf, make([]byte, b.snode.originSize), int(ext.algo))
+		if err != nil {
+			return ErrSizeMismatch
+		}
+		size += uint64(len(data))
+	}
+	if size != b.snode.originSize {
+		return ErrSizeMismatch
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func issueErrs(report *CheckReport) map[error]int {
+	errs := make(map[error]int)
+	for _, issue := range report.Issues {
+		errs[issue.Err]++
+	}
+	return errs
+}
+
+func TestSegment_Check(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	seg := Segment{}
+	assert.Nil(t, seg.Init(path.Join(dir, "check.seg")))
+	seg.Mount()
+	a := seg.NewBlockFile("a")
+	b := seg.NewBlockFile("b")
+	assert.Nil(t, seg.Append(a, []byte("data of a")))
+	assert.Nil(t, seg.Append(b, []byte("data of b")))
+	assert.Nil(t, seg.Sync())
+
+	reports, err := Check(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reports))
+	assert.Equal(t, 2, reports[0].Files)
+	assert.Equal(t, uint64(SUPER_VERSION), reports[0].Version)
+	assert.Equal(t, 0, len(reports[0].Issues))
+
+	// An extent past the end of the file and one sharing the data of another
+	dangling := Extent{typ: APPEND, offset: SIZE - BLOCK_SIZE, length: BLOCK_SIZE,
+		data: entry{length: 16}}
+	a.snode.extents = append(a.snode.extents, dangling)
+	a.snode.size += 16
+	assert.Nil(t, seg.log.Append(a))
+	b.snode.extents = append(b.snode.extents, a.snode.extents[0])
+	b.snode.size += uint64(a.snode.extents[0].data.length)
+	assert.Nil(t, seg.log.Append(b))
+	assert.Nil(t, seg.segFile.Close())
+
+	reports, err = Check(dir)
+	assert.Nil(t, err)
+	report := reports[0]
+	assert.False(t, report.OK())
+	errs := issueErrs(report)
+	assert.Equal(t, 1, errs[ErrDanglingExtent])
+	assert.Equal(t, 1, errs[ErrOverlappedExtent])
+	for _, issue := range report.Issues {
+		if issue.Err == ErrDanglingExtent {
+			assert.Equal(t, "a", issue.File)
+			assert.Equal(t, 1, issue.Extent)
+		}
+	}
+
+	reports, err = Repair(dir)
+	assert.Nil(t, err)
+	report = reports[0]
+	for _, issue := range report.Issues {
+		assert.Equal(t, issue.Err == ErrDanglingExtent, issue.Repaired)
+	}
+
+	reports, err = Check(dir)
+	assert.Nil(t, err)
+	errs = issueErrs(reports[0])
+	assert.Equal(t, 0, errs[ErrDanglingExtent])
+	assert.Equal(t, 1, errs[ErrOverlappedExtent])
+	seg = Segment{}
+	assert.Nil(t, seg.Open(path.Join(dir, "check.seg")))
+	assert.Equal(t, 1, len(seg.nodes["a"].snode.extents))
+	assert.Nil(t, seg.segFile.Close())
+}
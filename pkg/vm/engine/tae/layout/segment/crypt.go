@@ -0,0 +1,180 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	CipherNone = iota
+	// CipherAES256CTR encrypts by AES-256 in the counter mode keeping the
+	// size of the data
+	CipherAES256CTR
+)
+
+// DATA_KEY_SIZE is the size of the data key of a segment
+const DATA_KEY_SIZE = 32
+
+var (
+	ErrInvalidKey    = errors.New("tae segment: invalid key")
+	ErrNoKeyProvider = errors.New("tae segment: no key provider")
+)
+
+// KeyProvider wraps the data keys of the segments by a master key. The
+// wrapped keys are kept in the superblocks
+type KeyProvider interface {
+	WrapKey(key []byte) ([]byte, error)
+	UnwrapKey(wrapped []byte) ([]byte, error)
+}
+
+type staticKeyProvider struct {
+	aead cipher.AEAD
+}
+
+// NewStaticKeyProvider returns a provider wrapping the data keys by AES-GCM
+// with master, which is an AES key of 16, 24 or 32 bytes
+func NewStaticKeyProvider(master []byte) (KeyProvider, error) {
+	block, err := aes.NewCipher(master)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &staticKeyProvider{aead: aead}, nil
+}
+
+func (p *staticKeyProvider) WrapKey(key []byte) ([]byte, error) {
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return p.aead.Seal(nonce, nonce, key, nil), nil
+}
+
+func (p *staticKeyProvider) UnwrapKey(wrapped []byte) ([]byte, error) {
+	size := p.aead.NonceSize()
+	if len(wrapped) < size {
+		return nil, ErrInvalidKey
+	}
+	key, err := p.aead.Open(nil, wrapped[:size], wrapped[size:], nil)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+type encryptedDriver struct {
+	Driver
+	keys KeyProvider
+}
+
+// NewEncryptedDriver returns a driver of the segment files encrypted by the
+// data keys wrapped by keys. The segment files created before without
+// encryption are still readable and writable
+func NewEncryptedDriver(driver Driver, keys KeyProvider) Driver {
+	return &encryptedDriver{
+		Driver: driver,
+		keys:   keys,
+	}
+}
+
+// newDataKey generates the data key of a new segment wrapped by the key
+// provider of driver. It returns a nil key if driver does not encrypt
+func newDataKey(driver Driver) (key, wrapped []byte, err error) {
+	ed, ok := driver.(*encryptedDriver)
+	if !ok {
+		return
+	}
+	key = make([]byte, DATA_KEY_SIZE)
+	if _, err = rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	if wrapped, err = ed.keys.WrapKey(key); err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
+// unwrapDataKey unwraps the data key of a segment by the key provider of
+// driver
+func unwrapDataKey(driver Driver, wrapped []byte) ([]byte, error) {
+	ed, ok := driver.(*encryptedDriver)
+	if !ok {
+		return nil, ErrNoKeyProvider
+	}
+	key, err := ed.keys.UnwrapKey(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != DATA_KEY_SIZE {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+func newNonce() (uint64, error) {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// xorKeyStream encrypts or decrypts buf at the position pos of the key
+// stream of nonce
+func xorKeyStream(block cipher.Block, nonce uint64, pos int64, buf []byte) {
+	var iv [aes.BlockSize]byte
+	binary.BigEndian.PutUint64(iv[:8], nonce)
+	binary.BigEndian.PutUint64(iv[8:], uint64(pos)/aes.BlockSize)
+	stream := cipher.NewCTR(block, iv[:])
+	if skip := pos % aes.BlockSize; skip > 0 {
+		var pad [aes.BlockSize]byte
+		stream.XORKeyStream(pad[:skip], pad[:skip])
+	}
+	stream.XORKeyStream(buf, buf)
+}
+
+// seal returns buf encrypted to be written at off and the nonce of it. The
+// data is encrypted at its offset in the segment file, so the extents cut
+// by the updates are still decrypted. buf is returned as it is if the
+// segment is not encrypted
+func (s *Segment) seal(buf []byte, off int64) ([]byte, uint64, error) {
+	if s.block == nil {
+		return buf, 0, nil
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, 0, err
+	}
+	sealed := make([]byte, len(buf))
+	copy(sealed, buf)
+	xorKeyStream(s.block, nonce, off, sealed)
+	return sealed, nonce, nil
+}
+
+// dataCipher returns the cipher of the data written to the segment
+func (s *Segment) dataCipher() uint8 {
+	if s.block == nil {
+		return CipherNone
+	}
+	return CipherAES256CTR
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment_Encryption(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "encrypted.seg")
+	keys, err := NewStaticKeyProvider(bytes.Repeat([]byte{1}, 32))
+	assert.Nil(t, err)
+	driver := NewEncryptedDriver(LocalDriver, keys)
+	seg := Segment{}
+	assert.Nil(t, seg.InitWithDriver(name, driver))
+	seg.Mount()
+	plain := bytes.Repeat([]byte("plain column data "), 100)
+	file := seg.NewBlockFile("secret_block_name")
+	assert.Nil(t, seg.AppendWithCompression(file, plain, compress.None))
+	assert.Nil(t, seg.AppendWithCompression(file, plain[:7], compress.None))
+	batch := seg.NewBlockFile("batch")
+	assert.Nil(t, seg.AppendBatch([]*BlockFile{batch}, [][]byte{plain}, []int{compress.Lz4}))
+	expected := append(append([]byte{}, plain...), plain[:7]...)
+	assert.Equal(t, expected, readAll(t, file))
+	corrupted, err := VerifySegment(&seg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corrupted))
+	assert.Nil(t, seg.segFile.Close())
+
+	// Neither the data nor the inodes are stored in the clear
+	raw, err := os.ReadFile(name)
+	assert.Nil(t, err)
+	assert.False(t, bytes.Contains(raw, plain[:16]))
+	assert.False(t, bytes.Contains(raw, []byte("secret_block_name")))
+
+	seg = Segment{}
+	assert.Nil(t, seg.OpenWithDriver(name, driver))
+	file = seg.nodes["secret_block_name"]
+	assert.Equal(t, uint8(CipherAES256CTR), file.snode.cipher)
+	assert.Equal(t, expected, readAll(t, file))
+	data, err := compress.Decompress(readAll(t, seg.nodes["batch"]), make([]byte, len(plain)), compress.Lz4)
+	assert.Nil(t, err)
+	assert.Equal(t, plain, data)
+	assert.Nil(t, seg.segFile.Close())
+
+	seg = Segment{}
+	assert.Equal(t, ErrNoKeyProvider, seg.Open(name))
+	other, err := NewStaticKeyProvider(bytes.Repeat([]byte{2}, 32))
+	assert.Nil(t, err)
+	seg = Segment{}
+	assert.Equal(t, ErrInvalidKey, seg.OpenWithDriver(name, NewEncryptedDriver(LocalDriver, other)))
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"io"
+	"os"
+	"sync"
+	"unsafe"
+)
+
+// DIRECT_ALIGN is the alignment of the offsets, the lengths and the buffers
+// of the I/O on the files opened with O_DIRECT
+const DIRECT_ALIGN = BLOCK_SIZE
+
+// alignedBuffer returns a zeroed buffer of size bytes aligned to
+// DIRECT_ALIGN
+func alignedBuffer(size int) []byte {
+	buf := make([]byte, size+DIRECT_ALIGN)
+	shift := 0
+	if rem := int(uintptr(unsafe.Pointer(&buf[0])) & (DIRECT_ALIGN - 1)); rem != 0 {
+		shift = DIRECT_ALIGN - rem
+	}
+	return buf[shift : shift+size]
+}
+
+func alignDown(off int64) int64 { return off &^ (DIRECT_ALIGN - 1) }
+func alignUp(off int64) int64   { return alignDown(off + DIRECT_ALIGN - 1) }
+
+// directFile is a file opened with O_DIRECT. The I/O is done through the
+// aligned buffers and a write merges the blocks it covers partially with
+// their data on disk
+type directFile struct {
+	mutex  sync.Mutex
+	file   *os.File
+	offset int64
+}
+
+func newDirectFile(file *os.File) *directFile {
+	return &directFile{file: file}
+}
+
+func (f *directFile) ReadAt(p []byte, off int64) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	start := alignDown(off)
+	buf := alignedBuffer(int(alignUp(off+int64(len(p))) - start))
+	nr, err := f.file.ReadAt(buf, start)
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+	skip := int(off - start)
+	if nr <= skip {
+		return 0, io.EOF
+	}
+	n = copy(p, buf[skip:nr])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *directFile) WriteAt(p []byte, off int64) (n int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.writeAtLocked(p, off)
+}
+
+func (f *directFile) writeAtLocked(p []byte, off int64) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	start := alignDown(off)
+	end := alignUp(off + int64(len(p)))
+	buf := alignedBuffer(int(end - start))
+	if off != start {
+		if err = f.readBlock(buf[:DIRECT_ALIGN], start); err != nil {
+			return 0, err
+		}
+	}
+	if tail := end - DIRECT_ALIGN; off+int64(len(p)) != end && (tail != start || off == start) {
+		if err = f.readBlock(buf[len(buf)-DIRECT_ALIGN:], tail); err != nil {
+			return 0, err
+		}
+	}
+	copy(buf[off-start:], p)
+	if _, err = f.file.WriteAt(buf, start); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// readBlock reads the block at off. The block past the end of the file is
+// left zeroed
+func (f *directFile) readBlock(buf []byte, off int64) error {
+	if _, err := f.file.ReadAt(buf, off); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+func (f *directFile) Write(p []byte) (n int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	n, err = f.writeAtLocked(p, f.offset)
+	f.offset += int64(n)
+	return
+}
+
+func (f *directFile) Seek(offset int64, whence int) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		info, err := f.file.Stat()
+		if err != nil {
+			return 0, err
+		}
+		offset += info.Size()
+	default:
+		return 0, os.ErrInvalid
+	}
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+func (f *directFile) Truncate(size int64) error { return f.file.Truncate(size) }
+func (f *directFile) Sync() error               { return f.file.Sync() }
+func (f *directFile) Close() error              { return f.file.Close() }
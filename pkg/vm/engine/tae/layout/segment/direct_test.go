@@ -0,0 +1,96 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectFile(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "direct")
+	f, err := os.Create(name)
+	assert.Nil(t, err)
+	file := newDirectFile(f)
+	defer file.Close()
+
+	expected := make([]byte, 3*DIRECT_ALIGN)
+	write := func(p []byte, off int64) {
+		n, err := file.WriteAt(p, off)
+		assert.Nil(t, err)
+		assert.Equal(t, len(p), n)
+		copy(expected[off:], p)
+	}
+	write(bytes.Repeat([]byte{1}, 100), 10)
+	write(bytes.Repeat([]byte{2}, DIRECT_ALIGN), 50)
+	write(bytes.Repeat([]byte{3}, 2*DIRECT_ALIGN), DIRECT_ALIGN)
+	write(bytes.Repeat([]byte{4}, 10), 2*DIRECT_ALIGN-5)
+
+	buf := make([]byte, len(expected))
+	n, err := file.ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, len(buf), n)
+	assert.Equal(t, expected, buf)
+	buf = make([]byte, 20)
+	_, err = file.ReadAt(buf, 2*DIRECT_ALIGN-10)
+	assert.Nil(t, err)
+	assert.Equal(t, expected[2*DIRECT_ALIGN-10:2*DIRECT_ALIGN+10], buf)
+	_, err = file.ReadAt(buf, 4*DIRECT_ALIGN)
+	assert.Equal(t, io.EOF, err)
+
+	off, err := file.Seek(7, io.SeekStart)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), off)
+	_, err = file.Write([]byte{5, 5})
+	assert.Nil(t, err)
+	_, err = file.Write([]byte{6})
+	assert.Nil(t, err)
+	buf = make([]byte, 4)
+	_, err = file.ReadAt(buf, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0, 5, 5, 6}, buf)
+}
+
+func TestSegment_OpenFlags(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "direct.seg")
+	driver := NewLocalDriver(FlagDirect | FlagDSync)
+	seg := Segment{}
+	assert.Nil(t, seg.InitWithDriver(name, driver))
+	seg.Mount()
+	file := seg.NewBlockFile("block")
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, seg.Append(file, []byte(fmt.Sprintf("block data %d", i))))
+	}
+	data := readAll(t, file)
+	assert.Nil(t, seg.Sync())
+	assert.Nil(t, seg.segFile.Close())
+
+	seg = Segment{}
+	assert.Nil(t, seg.OpenWithDriver(name, driver))
+	assert.Equal(t, data, readAll(t, seg.nodes["block"]))
+	corrupted, err := VerifySegment(&seg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corrupted))
+	assert.Nil(t, seg.segFile.Close())
+}
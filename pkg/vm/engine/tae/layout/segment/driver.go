@@ -0,0 +1,96 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"syscall"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// File is the file backing a segment
+type File interface {
+	io.ReaderAt
+	io.WriterAt
+	io.WriteSeeker
+	Truncate(size int64) error
+	Sync() error
+	Close() error
+}
+
+// Driver creates, opens and removes the files backing the segments
+type Driver interface {
+	Create(name string) (File, error)
+	Open(name string) (File, error)
+	Remove(name string) error
+}
+
+// OpenFlag is the flags the local segment files are opened with
+type OpenFlag int
+
+const (
+	// FlagDirect bypasses the page cache with O_DIRECT
+	FlagDirect OpenFlag = 1 << iota
+	// FlagDSync makes every write durable with O_DSYNC
+	FlagDSync
+)
+
+type localDriver struct {
+	flags    OpenFlag
+	warnOnce sync.Once
+}
+
+// NewLocalDriver returns a driver keeping the segment files on local disk
+// opened with flags. The files are opened without O_DIRECT on the platforms
+// and the file systems not supporting it
+func NewLocalDriver(flags OpenFlag) Driver {
+	return &localDriver{flags: flags}
+}
+
+func (d *localDriver) Create(name string) (File, error) {
+	return d.openFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (d *localDriver) Open(name string) (File, error) { return d.openFile(name, os.O_RDWR, 0) }
+func (d *localDriver) Remove(name string) error       { return os.Remove(name) }
+
+func (d *localDriver) openFile(name string, flag int, perm os.FileMode) (File, error) {
+	if d.flags&FlagDSync != 0 {
+		flag |= dsyncFlag
+	}
+	if d.flags&FlagDirect == 0 {
+		return os.OpenFile(name, flag, perm)
+	}
+	if directFlag != 0 {
+		f, err := os.OpenFile(name, flag|directFlag, perm)
+		if err == nil {
+			return newDirectFile(f), nil
+		}
+		if !errors.Is(err, syscall.EINVAL) {
+			return nil, err
+		}
+	}
+	d.warnOnce.Do(func() {
+		logutil.Warnf("tae segment: direct I/O is not supported for %s, fall back to buffered I/O", name)
+	})
+	return os.OpenFile(name, flag, perm)
+}
+
+// LocalDriver keeps the segment files on local disk
+var LocalDriver Driver = NewLocalDriver(0)
@@ -14,6 +14,19 @@
 
 package segment
 
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+var (
+	ErrChecksumMismatch = errors.New("tae segment: checksum mismatch")
+)
+
 type ExtentType uint8
 
 const (
@@ -31,12 +44,40 @@ type Extent struct {
 	offset uint32
 	length uint32
 	data   entry
+	// checksum is the CRC32C of the data. A zero checksum is not verified
+	checksum uint32
+	// algo is the compress algorithm of the data
+	algo uint8
+	// nonce is the nonce the data is encrypted by in an encrypted segment
+	nonce uint64
+}
+
+// CorruptionError is returned for the data of an extent not matching its
+// checksum
+type CorruptionError struct {
+	Segment string
+	File    string
+	Extent  int
+	Offset  uint32
+}
+
+func (e *CorruptionError) Error() string {
+	return fmt.Sprintf("tae segment: corrupted extent %d at %d of %s in %s",
+		e.Extent, e.Offset, e.File, e.Segment)
+}
+
+func (e *CorruptionError) Unwrap() error {
+	return ErrChecksumMismatch
 }
 
 func (ex *Extent) End() uint32 {
 	return ex.offset + ex.length
 }
 
+func (ex *Extent) inDataArea() bool {
+	return ex.offset >= DATA_START && uint64(ex.offset)+uint64(ex.length) <= SIZE
+}
+
 func (ex *Extent) Offset() uint32 {
 	return ex.offset
 }
@@ -45,6 +86,10 @@ func (ex *Extent) Length() uint32 {
 	return ex.length
 }
 
+func (ex *Extent) Algo() int {
+	return int(ex.algo)
+}
+
 func (ex *Extent) GetData() *entry {
 	return &ex.data
 }
@@ -56,3 +101,40 @@ func (en *entry) GetOffset() uint32 {
 func (en *entry) GetLength() uint32 {
 	return en.length
 }
+
+// Write writes the extent to the log
+func (ex *Extent) Write(w io.Writer) error {
+	for _, v := range []interface{}{ex.typ, ex.offset, ex.length, ex.data.offset, ex.data.length, ex.checksum, ex.algo, ex.nonce} {
+		if err := binary.Write(w, binary.BigEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replay reads the extent written to the log
+func (ex *Extent) Replay(r io.Reader) error {
+	return ex.replay(r, layouts[SUPER_VERSION])
+}
+
+// replay reads the extent written in the layout l
+func (ex *Extent) replay(r io.Reader, l layout) error {
+	fields := []interface{}{&ex.typ, &ex.offset, &ex.length, &ex.data.offset, &ex.data.length}
+	if l.checksum {
+		fields = append(fields, &ex.checksum)
+	}
+	if l.compression {
+		fields = append(fields, &ex.algo)
+	} else {
+		ex.algo = compress.Lz4
+	}
+	if l.encryption {
+		fields = append(fields, &ex.nonce)
+	}
+	for _, v := range fields {
+		if err := binary.Read(r, binary.BigEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"sort"
+	"sync"
+)
+
+type freeExtent struct {
+	offset uint64
+	length uint64
+}
+
+func (e freeExtent) end() uint64 {
+	return e.offset + e.length
+}
+
+// ExtentAllocator allocates the pages of a space first fit from the free
+// ranges sorted by offset. A range freed is merged with the free ranges
+// adjacent to it, so the space freed piece by piece can be allocated as a
+// whole again
+type ExtentAllocator struct {
+	mutex    sync.Mutex
+	pageSize uint32
+	capacity uint64
+	freeSize uint64
+	free     []freeExtent
+}
+
+func NewExtentAllocator(capacity uint64, pageSize uint32) Allocator {
+	capacity = p2align(capacity, uint64(pageSize))
+	a := &ExtentAllocator{
+		pageSize: pageSize,
+		capacity: capacity,
+		freeSize: capacity,
+	}
+	if capacity > 0 {
+		a.free = []freeExtent{{offset: 0, length: capacity}}
+	}
+	return a
+}
+
+func (a *ExtentAllocator) Allocate(size uint64) (uint64, uint64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	length := p2roundup(size, uint64(a.pageSize))
+	if length == 0 {
+		return 0, 0
+	}
+	for i := range a.free {
+		e := &a.free[i]
+		if e.length < length {
+			continue
+		}
+		offset := e.offset
+		e.offset += length
+		e.length -= length
+		if e.length == 0 {
+			a.free = append(a.free[:i], a.free[i+1:]...)
+		}
+		a.freeSize -= length
+		return offset, length
+	}
+	return 0, 0
+}
+
+// Free frees the pages in the range. It is merged with the free ranges it
+// touches or overlaps, so a range freed twice is counted once
+func (a *ExtentAllocator) Free(start uint32, size uint32) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	merged := freeExtent{
+		offset: p2align(uint64(start), uint64(a.pageSize)),
+		length: p2align(uint64(size), uint64(a.pageSize)),
+	}
+	if merged.length == 0 {
+		return
+	}
+	if merged.end() > a.capacity {
+		panic(any("free out of range"))
+	}
+	i := sort.Search(len(a.free), func(i int) bool {
+		return a.free[i].end() >= merged.offset
+	})
+	j := i
+	var removed uint64
+	for ; j < len(a.free) && a.free[j].offset <= merged.end(); j++ {
+		end := merged.end()
+		if a.free[j].end() > end {
+			end = a.free[j].end()
+		}
+		if a.free[j].offset < merged.offset {
+			merged.offset = a.free[j].offset
+		}
+		merged.length = end - merged.offset
+		removed += a.free[j].length
+	}
+	a.freeSize += merged.length - removed
+	rear := append([]freeExtent{merged}, a.free[j:]...)
+	a.free = append(a.free[:i], rear...)
+}
+
+// Reserve removes the pages the range touches from the free ranges
+func (a *ExtentAllocator) Reserve(start uint32, size uint32) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	begin := p2align(uint64(start), uint64(a.pageSize))
+	end := p2roundup(uint64(start)+uint64(size), uint64(a.pageSize))
+	free := make([]freeExtent, 0, len(a.free)+1)
+	for _, e := range a.free {
+		if e.end() <= begin || e.offset >= end {
+			free = append(free, e)
+			continue
+		}
+		reserved := e
+		if e.offset < begin {
+			free = append(free, freeExtent{offset: e.offset, length: begin - e.offset})
+			reserved.offset = begin
+		}
+		if e.end() > end {
+			free = append(free, freeExtent{offset: end, length: e.end() - end})
+			reserved.length = end - reserved.offset
+		} else {
+			reserved.length = e.end() - reserved.offset
+		}
+		a.freeSize -= reserved.length
+	}
+	a.free = free
+}
+
+func (a *ExtentAllocator) Stats() (stats AllocatorStats) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	stats.Capacity = a.capacity
+	stats.Used = a.capacity - a.freeSize
+	stats.FreeExtents = len(a.free)
+	for _, e := range a.free {
+		if e.length > stats.LargestFree {
+			stats.LargestFree = e.length
+		}
+	}
+	return
+}
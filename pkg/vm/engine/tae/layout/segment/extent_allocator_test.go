@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtentAllocator(t *testing.T) {
+	page := uint64(4096)
+	a := NewExtentAllocator(page*16, uint32(page))
+	stats := a.Stats()
+	assert.Equal(t, page*16, stats.Capacity)
+	assert.Equal(t, uint64(0), stats.Used)
+	assert.Equal(t, 1, stats.FreeExtents)
+
+	// An allocation is rounded up to pages
+	offsets := make([]uint64, 4)
+	for i := range offsets {
+		offset, allocated := a.Allocate(1)
+		assert.Equal(t, page, allocated)
+		offsets[i] = offset
+	}
+	assert.Equal(t, []uint64{0, page, page * 2, page * 3}, offsets)
+	assert.Equal(t, page*4, a.Stats().Used)
+
+	// The ranges freed apart are not merged
+	a.Free(uint32(offsets[0]), uint32(page))
+	a.Free(uint32(offsets[2]), uint32(page))
+	stats = a.Stats()
+	assert.Equal(t, 3, stats.FreeExtents)
+	assert.Equal(t, page*12, stats.LargestFree)
+	offset, allocated := a.Allocate(page * 2)
+	assert.Equal(t, page*4, offset)
+	assert.Equal(t, page*2, allocated)
+	a.Free(uint32(offset), uint32(allocated))
+
+	// The ranges freed next to each other are merged into one
+	a.Free(uint32(offsets[1]), uint32(page))
+	stats = a.Stats()
+	assert.Equal(t, 2, stats.FreeExtents)
+	assert.Equal(t, page, stats.Used)
+	offset, allocated = a.Allocate(page * 3)
+	assert.Equal(t, uint64(0), offset)
+	assert.Equal(t, page*3, allocated)
+	a.Free(uint32(offset), uint32(allocated))
+	a.Free(uint32(offsets[3]), uint32(page))
+	stats = a.Stats()
+	assert.Equal(t, uint64(0), stats.Used)
+	assert.Equal(t, 1, stats.FreeExtents)
+	assert.Equal(t, page*16, stats.LargestFree)
+
+	// A range freed twice is counted once
+	a.Free(0, uint32(page))
+	assert.Equal(t, uint64(0), a.Stats().Used)
+
+	offset, allocated = a.Allocate(page * 16)
+	assert.Equal(t, uint64(0), offset)
+	assert.Equal(t, page*16, allocated)
+	_, allocated = a.Allocate(1)
+	assert.Equal(t, uint64(0), allocated)
+	assert.Equal(t, 0, a.Stats().FreeExtents)
+}
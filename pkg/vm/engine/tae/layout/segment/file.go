@@ -17,9 +17,10 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
-	"github.com/pierrec/lz4"
+	"hash/crc32"
 	"io"
 )
 
@@ -53,29 +54,65 @@ func (b *BlockFile) GetName() string {
 	return b.name
 }
 
+// GetAlgo returns the compress algorithm of the data appended last
+func (b *BlockFile) GetAlgo() int {
+	b.snode.mutex.RLock()
+	defer b.snode.mutex.RUnlock()
+	return int(b.snode.algo)
+}
+
 func (b *BlockFile) Append(offset uint64, data []byte) (err error) {
-	colSize := len(data)
-	buf := make([]byte, lz4.CompressBlockBound(colSize))
-	if buf, err = compress.Compress(data, buf, compress.Lz4); err != nil {
+	return b.AppendWithCompression(offset, data, compress.Lz4)
+}
+
+// AppendWithCompression appends data compressed by algo. The data of
+// compress.None is appended as it is. The data of an encrypted segment is
+// encrypted after compressed
+func (b *BlockFile) AppendWithCompression(offset uint64, data []byte, algo int) (err error) {
+	buf, err := compressData(data, algo)
+	if err != nil {
+		return err
+	}
+	buf, nonce, err := b.segment.seal(buf, int64(offset))
+	if err != nil {
 		return err
 	}
-	cbufLen := uint32(p2roundup(uint64(len(buf)), uint64(b.segment.super.blockSize)))
 	_, err = b.segment.segFile.WriteAt(buf, int64(offset))
 	if err != nil {
 		return err
 	}
+	b.appendExtent(offset, buf, len(data), algo, nonce)
+	return nil
+}
+
+// compressData returns data compressed by algo
+func compressData(data []byte, algo int) (buf []byte, err error) {
+	if algo == compress.None {
+		return data, nil
+	}
+	buf = make([]byte, compress.CompressBound(len(data), algo))
+	return compress.Compress(data, buf, algo)
+}
+
+// appendExtent adds the extent of buf written at offset, which is the data
+// of originSize bytes compressed by algo and encrypted by nonce
+func (b *BlockFile) appendExtent(offset uint64, buf []byte, originSize int, algo int, nonce uint64) {
+	cbufLen := uint32(p2roundup(uint64(len(buf)), uint64(b.segment.super.blockSize)))
 	b.snode.mutex.Lock()
-	b.snode.algo = compress.Lz4
+	b.snode.algo = uint8(algo)
+	b.snode.cipher = b.segment.dataCipher()
 	b.snode.extents = append(b.snode.extents, Extent{
-		typ:    APPEND,
-		offset: uint32(offset),
-		length: cbufLen,
-		data:   entry{offset: 0, length: uint32(len(buf))},
+		typ:      APPEND,
+		offset:   uint32(offset),
+		length:   cbufLen,
+		data:     entry{offset: 0, length: uint32(len(buf))},
+		checksum: crc32.Checksum(buf, crcTable),
+		algo:     uint8(algo),
+		nonce:    nonce,
 	})
 	b.snode.size += uint64(len(buf))
-	b.snode.originSize += uint64(len(data))
+	b.snode.originSize += uint64(originSize)
 	b.snode.mutex.Unlock()
-	return nil
 }
 
 func extentsInsert(extents *[]Extent, idx int, vals []Extent) {
@@ -90,6 +127,7 @@ func extentsRemove(extents *[]Extent, vals []Extent) {
 			if e.offset == ext.offset &&
 				e.length == ext.length {
 				*extents = append((*extents)[:i], (*extents)[i+1:]...)
+				break
 			}
 		}
 	}
@@ -109,6 +147,15 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 	}
 	free := make([]Extent, 0)
 	remove := make([]Extent, 0)
+	if num == len(b.snode.extents) {
+		// Nothing is updated past the end of the file
+		b.snode.extents = append(b.snode.extents, Extent{
+			typ:    UPDATE,
+			offset: offset,
+			length: length,
+		})
+		return free
+	}
 	ext := b.snode.extents[num]
 	var remaining uint32 = 0
 	if ext.length > fOffset+length {
@@ -118,6 +165,7 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 	if fOffset == 0 && ext.length-fOffset-length == 0 {
 		b.snode.extents[num].typ = UPDATE
 		b.snode.extents[num].offset = offset
+		b.snode.extents[num].checksum = 0
 		free = append(free, Extent{
 			offset: oldOff,
 			length: length,
@@ -133,6 +181,7 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 		vals = append(vals, Extent{
 			offset: ext.End() - remaining,
 			length: remaining,
+			nonce:  ext.nonce,
 		})
 	}
 	freeLength := length
@@ -142,6 +191,8 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 			break
 		}
 		e := &b.snode.extents[idx]
+		// The data of the extents cut no longer match their checksums
+		e.checksum = 0
 		if idx == num {
 			b.snode.extents[num].length = fOffset
 			xLen := ext.length - fOffset
@@ -183,6 +234,12 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 	if len(remove) > 0 {
 		extentsRemove(&b.snode.extents, remove)
 	}
+	if fOffset == 0 {
+		// The head of the extent is updated and nothing of it is kept
+		b.snode.extents = append(b.snode.extents[:num], b.snode.extents[num+1:]...)
+		extentsInsert(&b.snode.extents, num, vals)
+		return free
+	}
 	extentsInsert(&b.snode.extents, num+1, vals)
 	return free
 }
@@ -195,7 +252,11 @@ func (b *BlockFile) Update(offset uint64, data []byte, fOffset uint32) ([]Extent
 	if err = binary.Write(&sbuffer, binary.BigEndian, data); err != nil {
 		return nil, err
 	}
-	cbufLen := uint32(p2roundup(uint64(sbuffer.Len()), uint64(b.segment.super.blockSize)))
+	buf, nonce, err := b.segment.seal(sbuffer.Bytes(), int64(offset))
+	if err != nil {
+		return nil, err
+	}
+	cbufLen := uint32(p2roundup(uint64(len(buf)), uint64(b.segment.super.blockSize)))
 	//if cbufLen > uint32(sbuffer.Len()) {
 	//zero := make([]byte, cbufLen-uint32(sbuffer.Len()))
 	//binary.Write(&sbuffer, binary.BigEndian, zero)
@@ -204,12 +265,23 @@ func (b *BlockFile) Update(offset uint64, data []byte, fOffset uint32) ([]Extent
 	if err != nil {
 		return nil, err
 	}
-	_, err = b.segment.segFile.Write(sbuffer.Bytes())
+	_, err = b.segment.segFile.Write(buf)
 	if err != nil {
 		return nil, err
 	}
 	logutil.Infof("extents is %d", len(b.snode.extents))
-	return b.repairExtent(uint32(offset), fOffset, cbufLen), nil
+	free := b.repairExtent(uint32(offset), fOffset, cbufLen)
+	if b.segment.block != nil {
+		b.snode.mutex.Lock()
+		b.snode.cipher = b.segment.dataCipher()
+		for i := range b.snode.extents {
+			if ext := &b.snode.extents[i]; ext.typ == UPDATE && ext.offset == uint32(offset) {
+				ext.nonce = nonce
+			}
+		}
+		b.snode.mutex.Unlock()
+	}
+	return free, nil
 }
 
 func (b *BlockFile) GetExtents() *[]Extent {
@@ -217,6 +289,16 @@ func (b *BlockFile) GetExtents() *[]Extent {
 	return extents
 }
 
+// Prefetch issues the readahead of the data of the file. It returns false
+// if the readahead is not supported
+func (b *BlockFile) Prefetch() bool {
+	b.snode.mutex.RLock()
+	extents := make([]Extent, len(b.snode.extents))
+	copy(extents, b.snode.extents)
+	b.snode.mutex.RUnlock()
+	return b.segment.Prefetch(extents)
+}
+
 func (b *BlockFile) Read(data []byte) (n int, err error) {
 	bufLen := len(data)
 	if bufLen == 0 {
@@ -259,6 +341,7 @@ func (b *BlockFile) ReadExtent(offset, length uint32, data []byte) (uint32, erro
 	for {
 		buf := data
 		readOne := length
+		readOff := offset
 		if offset > 0 {
 			if b.snode.extents[num].length-offset < length {
 				readOne = b.snode.extents[num].length - offset
@@ -268,8 +351,7 @@ func (b *BlockFile) ReadExtent(offset, length uint32, data []byte) (uint32, erro
 			readOne = b.snode.extents[num].length
 		}
 		buf = buf[read : read+readOne]
-		_, err := b.segment.segFile.ReadAt(buf, int64(b.snode.extents[num].offset)+int64(offset))
-		if err != nil && err != io.EOF {
+		if err := b.readExtentAt(num, readOff, buf); err != nil {
 			return 0, err
 		}
 		read += readOne
@@ -280,3 +362,70 @@ func (b *BlockFile) ReadExtent(offset, length uint32, data []byte) (uint32, erro
 		}
 	}
 }
+
+// readExtentAt reads buf at off of the extent of num. The whole data of an
+// extent with a checksum is read and verified
+func (b *BlockFile) readExtentAt(num int, off uint32, buf []byte) error {
+	ext := &b.snode.extents[num]
+	if err := b.readExtentData(ext, num, off, buf); err != nil {
+		return err
+	}
+	if b.snode.cipher != CipherNone {
+		if b.segment.block == nil {
+			return ErrNoKeyProvider
+		}
+		xorKeyStream(b.segment.block, ext.nonce, int64(ext.offset)+int64(off), buf)
+	}
+	return nil
+}
+
+// readExtentData reads buf at off of the extent ext of num as it is stored
+func (b *BlockFile) readExtentData(ext *Extent, num int, off uint32, buf []byte) error {
+	if ext.checksum == 0 {
+		_, err := b.segment.segFile.ReadAt(buf, int64(ext.offset)+int64(off))
+		if err != nil && err != io.EOF {
+			return err
+		}
+		return nil
+	}
+	size := ext.data.offset + ext.data.length
+	if off+uint32(len(buf)) > size {
+		size = off + uint32(len(buf))
+	}
+	data := make([]byte, size)
+	if _, err := b.segment.segFile.ReadAt(data, int64(ext.offset)); err != nil && err != io.EOF {
+		return err
+	}
+	if crc32.Checksum(data[ext.data.offset:ext.data.offset+ext.data.length], crcTable) != ext.checksum {
+		return &CorruptionError{
+			Segment: b.segment.name,
+			File:    b.name,
+			Extent:  num,
+			Offset:  ext.offset,
+		}
+	}
+	copy(buf, data[off:])
+	return nil
+}
+
+// Verify reads the data of all the extents with checksums and returns the
+// ones corrupted
+func (b *BlockFile) Verify() (corrupted []*CorruptionError, err error) {
+	b.snode.mutex.RLock()
+	defer b.snode.mutex.RUnlock()
+	for num, ext := range b.snode.extents {
+		if ext.checksum == 0 {
+			continue
+		}
+		err = b.readExtentAt(num, 0, make([]byte, ext.data.length))
+		var corruption *CorruptionError
+		if errors.As(err, &corruption) {
+			corrupted = append(corrupted, corruption)
+			continue
+		}
+		if err != nil {
+			return
+		}
+	}
+	return corrupted, nil
+}
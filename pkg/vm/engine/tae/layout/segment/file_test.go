@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type extentRange struct {
+	offset, length uint32
+}
+
+func extentRanges(extents []Extent) []extentRange {
+	ranges := make([]extentRange, len(extents))
+	for i, ext := range extents {
+		ranges[i] = extentRange{ext.offset, ext.length}
+	}
+	return ranges
+}
+
+func newTestBlockFile(ranges ...extentRange) *BlockFile {
+	extents := make([]Extent, len(ranges))
+	for i, r := range ranges {
+		extents[i] = Extent{typ: APPEND, offset: r.offset, length: r.length}
+	}
+	return &BlockFile{snode: &Inode{extents: extents}}
+}
+
+func TestRepairExtent(t *testing.T) {
+	cases := []struct {
+		name                      string
+		extents                   []extentRange
+		offset, fOffset, length   uint32
+		expectExtents, expectFree []extentRange
+	}{{
+		name:          "whole extent",
+		extents:       []extentRange{{0, 4096}, {4096, 4096}},
+		offset:        8192,
+		length:        4096,
+		expectExtents: []extentRange{{8192, 4096}, {4096, 4096}},
+		expectFree:    []extentRange{{0, 4096}},
+	}, {
+		name:          "head of extent",
+		extents:       []extentRange{{0, 8192}},
+		offset:        16384,
+		length:        4096,
+		expectExtents: []extentRange{{16384, 4096}, {4096, 4096}},
+		expectFree:    []extentRange{{0, 4096}},
+	}, {
+		name:          "middle of extent",
+		extents:       []extentRange{{0, 12288}},
+		offset:        16384,
+		fOffset:       4096,
+		length:        4096,
+		expectExtents: []extentRange{{0, 4096}, {16384, 4096}, {8192, 4096}},
+		expectFree:    []extentRange{{4096, 4096}},
+	}, {
+		name:          "tail of extent",
+		extents:       []extentRange{{0, 8192}, {8192, 4096}},
+		offset:        16384,
+		fOffset:       4096,
+		length:        4096,
+		expectExtents: []extentRange{{0, 4096}, {16384, 4096}, {8192, 4096}},
+		expectFree:    []extentRange{{4096, 4096}},
+	}, {
+		name:          "across extents",
+		extents:       []extentRange{{0, 4096}, {8192, 8192}},
+		offset:        32768,
+		length:        8192,
+		expectExtents: []extentRange{{32768, 8192}, {12288, 4096}},
+		expectFree:    []extentRange{{0, 4096}, {8192, 4096}},
+	}, {
+		name:          "over whole extents",
+		extents:       []extentRange{{0, 4096}, {8192, 4096}, {20480, 4096}},
+		offset:        40960,
+		length:        8192,
+		expectExtents: []extentRange{{40960, 8192}, {20480, 4096}},
+		expectFree:    []extentRange{{0, 4096}, {8192, 4096}},
+	}, {
+		name:          "past the end",
+		extents:       []extentRange{{0, 4096}},
+		offset:        8192,
+		fOffset:       4096,
+		length:        4096,
+		expectExtents: []extentRange{{0, 4096}, {8192, 4096}},
+		expectFree:    []extentRange{},
+	}}
+	for _, c := range cases {
+		file := newTestBlockFile(c.extents...)
+		free := file.repairExtent(c.offset, c.fOffset, c.length)
+		assert.Equal(t, c.expectFree, extentRanges(free), c.name)
+		assert.Equal(t, c.expectExtents, extentRanges(file.snode.extents), c.name)
+	}
+}
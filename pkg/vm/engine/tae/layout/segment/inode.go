@@ -26,6 +26,7 @@ const (
 type Inode struct {
 	inode      uint64
 	algo       uint8
+	cipher     uint8
 	size       uint64
 	originSize uint64
 	mutex      sync.RWMutex
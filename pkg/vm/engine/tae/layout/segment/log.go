@@ -17,71 +17,378 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"sort"
+	"sync"
 )
 
+var (
+	ErrLogFull          = errors.New("tae segment: log is full")
+	ErrInvalidLogRecord = errors.New("tae segment: invalid log record")
+)
+
+// LOG_MAGIC starts every record of the log. A record is invalidated by
+// zeroing it
+const LOG_MAGIC = uint32(0x534c4f47)
+
+// LOG_VERSIONED_MAGIC starts the records with their version after the
+// header. The records of LOG_MAGIC are of the version of the superblock
+const LOG_VERSIONED_MAGIC = uint32(0x534c4756)
+
+// LOG_HEADER_SIZE is the size of the magic and the length of a record
+const LOG_HEADER_SIZE = 8
+
+// crcTable checksums the log records and the data of the extents
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Log keeps a record of the inode of each block file in the log area. A
+// record is written to new pages before the old one is invalidated
 type Log struct {
+	mutex     sync.Mutex
 	logFile   *BlockFile
 	seq       uint64
 	offset    uint64
 	allocator Allocator
 }
 
-func (ex Extent) Replay() {
+// logRecord is an inode replayed from the log
+type logRecord struct {
+	version uint64
+	seq     uint64
+	name    string
+	inode   *Inode
+	offset  uint32
+	length  uint32
+}
 
+// encodeLogRecord encodes the inode of file as the magic, the length, the
+// version, the fields and the CRC32C of all before it. The fields of an
+// encrypted segment are encrypted by the nonce before them
+func encodeLogRecord(file *BlockFile, seq uint64) ([]byte, error) {
+	var w bytes.Buffer
+	w.Write(make([]byte, LOG_HEADER_SIZE))
+	if err := binary.Write(&w, binary.BigEndian, uint16(SUPER_VERSION)); err != nil {
+		return nil, err
+	}
+	block := file.segment.block
+	var nonce uint64
+	if block != nil {
+		var err error
+		if nonce, err = newNonce(); err != nil {
+			return nil, err
+		}
+		if err = binary.Write(&w, binary.BigEndian, nonce); err != nil {
+			return nil, err
+		}
+	}
+	start := w.Len()
+	file.snode.mutex.RLock()
+	defer file.snode.mutex.RUnlock()
+	snode := file.snode
+	for _, v := range []interface{}{
+		seq, snode.inode, snode.algo, snode.cipher, snode.state, snode.size, snode.originSize,
+		uint16(len(file.name)), []byte(file.name), uint64(len(snode.extents)),
+	} {
+		if err := binary.Write(&w, binary.BigEndian, v); err != nil {
+			return nil, err
+		}
+	}
+	for i := range snode.extents {
+		if err := snode.extents[i].Write(&w); err != nil {
+			return nil, err
+		}
+	}
+	buf := w.Bytes()
+	if block != nil {
+		xorKeyStream(block, nonce, 0, buf[start:])
+	}
+	binary.BigEndian.PutUint32(buf, LOG_VERSIONED_MAGIC)
+	binary.BigEndian.PutUint32(buf[4:], uint32(len(buf)+4))
+	if err := binary.Write(&w, binary.BigEndian, crc32.Checksum(buf, crcTable)); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
 }
 
-func (l Log) RemoveInode(file *BlockFile) error {
-	file.snode.state = REMOVE
-	err := l.Append(file)
+// decodeLogRecord decodes a record of the log of segment
+func decodeLogRecord(buf []byte, segment *Segment) (*logRecord, error) {
+	if len(buf) < LOG_HEADER_SIZE+4 {
+		return nil, ErrInvalidLogRecord
+	}
+	body := buf[:len(buf)-4]
+	if crc32.Checksum(body, crcTable) != binary.BigEndian.Uint32(buf[len(body):]) {
+		return nil, ErrInvalidLogRecord
+	}
+	r := bytes.NewReader(body[LOG_HEADER_SIZE:])
+	superVersion := segment.super.version
+	rec := &logRecord{inode: &Inode{}, version: superVersion}
+	l, err := getLayout(superVersion)
 	if err != nil {
+		return nil, err
+	}
+	switch binary.BigEndian.Uint32(buf) {
+	case LOG_VERSIONED_MAGIC:
+		var version uint16
+		if err = binary.Read(r, binary.BigEndian, &version); err != nil {
+			return nil, err
+		}
+		rec.version = uint64(version)
+		if l, err = getLayout(rec.version); err != nil || !l.recordVersion {
+			return nil, ErrUnsupportedVersion
+		}
+	case LOG_MAGIC:
+		if l.recordVersion {
+			return nil, ErrInvalidLogRecord
+		}
+	default:
+		return nil, ErrInvalidLogRecord
+	}
+	if l.encryption && segment.block != nil {
+		var nonce uint64
+		if err = binary.Read(r, binary.BigEndian, &nonce); err != nil {
+			return nil, err
+		}
+		fields := body[len(body)-r.Len():]
+		xorKeyStream(segment.block, nonce, 0, fields)
+		r = bytes.NewReader(fields)
+	}
+	snode := rec.inode
+	var nameLen uint16
+	fields := []interface{}{&rec.seq, &snode.inode, &snode.algo}
+	if l.encryption {
+		fields = append(fields, &snode.cipher)
+	}
+	fields = append(fields, &snode.state, &snode.size, &snode.originSize, &nameLen)
+	for _, v := range fields {
+		if err := binary.Read(r, binary.BigEndian, v); err != nil {
+			return nil, err
+		}
+	}
+	name := make([]byte, nameLen)
+	if _, err := io.ReadFull(r, name); err != nil {
+		return nil, err
+	}
+	rec.name = string(name)
+	var cnt uint64
+	if err := binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return nil, err
+	}
+	if cnt > uint64(r.Len()) {
+		return nil, ErrInvalidLogRecord
+	}
+	snode.extents = make([]Extent, cnt)
+	for i := range snode.extents {
+		if err := snode.extents[i].replay(r, l); err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
+
+func (l *Log) RemoveInode(file *BlockFile) error {
+	if err := l.logFile.segment.checkWritable(); err != nil {
 		return err
 	}
-	l.allocator.Free(file.snode.logExtents.offset, file.snode.logExtents.length)
-	return nil
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	file.snode.state = REMOVE
+	if err := l.appendLocked(file); err != nil {
+		return err
+	}
+	// The inode is gone once none of its records is left. Until then the
+	// record of REMOVE keeps it from being replayed
+	err := l.freeLocked(file.snode.logExtents)
+	file.snode.logExtents = Extent{}
+	return err
 }
 
-func (l Log) Append(file *BlockFile) error {
-	var (
-		err     error
-		ibuffer bytes.Buffer
-	)
-	segment := l.logFile.segment
-	if err = binary.Write(&ibuffer, binary.BigEndian, file.snode.inode); err != nil {
+func (l *Log) Append(file *BlockFile) error {
+	if err := l.logFile.segment.checkWritable(); err != nil {
 		return err
 	}
-	if err = binary.Write(&ibuffer, binary.BigEndian, file.snode.algo); err != nil {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.appendLocked(file)
+}
+
+func (l *Log) appendLocked(file *BlockFile) error {
+	l.seq++
+	buf, err := encodeLogRecord(file, l.seq)
+	if err != nil {
 		return err
 	}
-	if err = binary.Write(&ibuffer, binary.BigEndian, file.snode.state); err != nil {
+	offset, allocated := l.allocator.Allocate(uint64(len(buf)))
+	if allocated == 0 {
+		if err = l.compactLocked(); err != nil {
+			return err
+		}
+		if offset, allocated = l.allocator.Allocate(uint64(len(buf))); allocated == 0 {
+			return ErrLogFull
+		}
+	}
+	segment := l.logFile.segment
+	if _, err = segment.segFile.WriteAt(buf, int64(offset+LOG_START)); err != nil {
 		return err
 	}
-	if err = binary.Write(&ibuffer, binary.BigEndian, file.snode.size); err != nil {
+	if err = l.freeLocked(file.snode.logExtents); err != nil {
 		return err
 	}
-	if err = binary.Write(&ibuffer, binary.BigEndian, uint64(len(file.snode.extents))); err != nil {
+	file.snode.logExtents.offset = uint32(offset)
+	file.snode.logExtents.length = uint32(allocated)
+	return nil
+}
+
+// freeLocked invalidates the record in ext and frees its pages
+func (l *Log) freeLocked(ext Extent) error {
+	if ext.length == 0 {
+		return nil
+	}
+	if err := l.invalidate(ext.offset); err != nil {
 		return err
 	}
-	file.snode.mutex.RLock()
-	extents := file.snode.extents
-	file.snode.mutex.RUnlock()
-	for _, ext := range extents {
-		if err = binary.Write(&ibuffer, binary.BigEndian, ext.typ); err != nil {
+	l.allocator.Free(ext.offset, ext.length)
+	return nil
+}
+
+func (l *Log) invalidate(offset uint32) error {
+	segment := l.logFile.segment
+	_, err := segment.segFile.WriteAt(make([]byte, LOG_HEADER_SIZE), int64(offset)+LOG_START)
+	return err
+}
+
+// compactLocked moves the records down to the gaps before them large
+// enough to hold them, which merges the free pages of the log. A record is
+// copied before the one moved is invalidated, so a crash leaves at least
+// one of the two copies
+func (l *Log) compactLocked() error {
+	segment := l.logFile.segment
+	segment.mutex.Lock()
+	files := make([]*BlockFile, 0, len(segment.nodes))
+	for _, file := range segment.nodes {
+		if file.snode != nil && file.snode.logExtents.length > 0 {
+			files = append(files, file)
+		}
+	}
+	segment.mutex.Unlock()
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].snode.logExtents.offset < files[j].snode.logExtents.offset
+	})
+	var cursor uint32
+	for _, file := range files {
+		ext := file.snode.logExtents
+		if ext.offset >= cursor+ext.length {
+			buf := make([]byte, ext.length)
+			if _, err := segment.segFile.ReadAt(buf, int64(ext.offset)+LOG_START); err != nil {
+				return err
+			}
+			if _, err := segment.segFile.WriteAt(buf, int64(cursor)+LOG_START); err != nil {
+				return err
+			}
+			l.allocator.Reserve(cursor, ext.length)
+			if err := l.freeLocked(ext); err != nil {
+				return err
+			}
+			file.snode.logExtents.offset = cursor
+		}
+		cursor = file.snode.logExtents.offset + ext.length
+	}
+	return nil
+}
+
+// Replay rebuilds the block files from the records in the log area. The
+// latest record of an inode wins and the inodes removed are dropped. The
+// records not replayed are invalidated so that they never win later
+func (l *Log) Replay() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	segment := l.logFile.segment
+	pageSize := uint64(segment.GetPageSize())
+	latest := make(map[uint64]*logRecord)
+	stale := make([]*logRecord, 0)
+	header := make([]byte, LOG_HEADER_SIZE)
+	for pos := uint64(0); pos+LOG_HEADER_SIZE <= LOG_SIZE; {
+		if _, err := segment.segFile.ReadAt(header, int64(pos)+LOG_START); err != nil {
+			if err == io.EOF {
+				break
+			}
 			return err
 		}
-		if err = binary.Write(&ibuffer, binary.BigEndian, ext.offset); err != nil {
+		magic := binary.BigEndian.Uint32(header)
+		length := uint64(binary.BigEndian.Uint32(header[4:]))
+		if (magic != LOG_MAGIC && magic != LOG_VERSIONED_MAGIC) || length > LOG_SIZE-pos {
+			pos += pageSize
+			continue
+		}
+		buf := make([]byte, length)
+		if _, err := segment.segFile.ReadAt(buf, int64(pos)+LOG_START); err != nil {
 			return err
 		}
-		if err = binary.Write(&ibuffer, binary.BigEndian, ext.length); err != nil {
+		rec, err := decodeLogRecord(buf, segment)
+		if err == ErrUnsupportedVersion {
 			return err
 		}
+		if err != nil {
+			segment.check.addIssue("", -1, ErrInvalidLogRecord)
+			pos += pageSize
+			continue
+		}
+		rec.offset = uint32(pos)
+		rec.length = uint32(p2roundup(length, pageSize))
+		pos += uint64(rec.length)
+		prev := latest[rec.inode.inode]
+		if prev != nil && prev.seq >= rec.seq {
+			stale = append(stale, rec)
+			continue
+		}
+		if prev != nil {
+			stale = append(stale, prev)
+		}
+		latest[rec.inode.inode] = rec
 	}
-	ibufLen := (segment.super.blockSize - (uint32(ibuffer.Len()) % segment.super.blockSize)) + uint32(ibuffer.Len())
-	offset, allocated := l.allocator.Allocate(uint64(ibufLen))
-	if _, err = segment.segFile.WriteAt(ibuffer.Bytes(), int64(offset+LOG_START)); err != nil {
-		return err
+
+	names := make(map[string]*logRecord)
+	for _, rec := range latest {
+		if rec.seq > l.seq {
+			l.seq = rec.seq
+		}
+		if rec.inode.inode > segment.lastInode {
+			segment.lastInode = rec.inode.inode
+		}
+		if rec.inode.state == REMOVE {
+			stale = append(stale, rec)
+			continue
+		}
+		// The dangling extents are reported and dropped by the check
+		if err := segment.validate(rec.inode); err != nil && segment.check == nil {
+			return err
+		}
+		if prev := names[rec.name]; prev != nil {
+			if prev.seq > rec.seq {
+				stale = append(stale, rec)
+				continue
+			}
+			stale = append(stale, prev)
+		}
+		names[rec.name] = rec
 	}
-	l.allocator.Free(file.snode.logExtents.offset, file.snode.logExtents.length)
-	file.snode.logExtents.offset = uint32(offset)
-	file.snode.logExtents.length = uint32(allocated)
+	for _, rec := range stale {
+		if segment.check != nil && !segment.check.repair {
+			break
+		}
+		if err := l.invalidate(rec.offset); err != nil {
+			return err
+		}
+	}
+	for name, rec := range names {
+		rec.inode.logExtents = Extent{offset: rec.offset, length: rec.length}
+		segment.nodes[name] = &BlockFile{
+			snode:   rec.inode,
+			name:    name,
+			segment: segment,
+		}
+	}
+	segment.rebuildAllocator()
 	return nil
 }
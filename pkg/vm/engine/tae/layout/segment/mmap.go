@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"hash/crc32"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// Seal marks the segment immutable. The data of a sealed segment is read
+// through the mmap where supported
+func (s *Segment) Seal() {
+	s.mapMutex.Lock()
+	defer s.mapMutex.Unlock()
+	s.sealed = true
+}
+
+// mapped returns the mapping of the segment file covering [0, end) or nil
+// if the segment is not mapped. The file is mapped again if it grows past
+// the mapping. The mappings are only unmapped by Destroy, as the slices of
+// them may still be in use
+func (s *Segment) mapped(end int64) []byte {
+	s.mapMutex.Lock()
+	defer s.mapMutex.Unlock()
+	if !s.sealed || s.mapFailed || s.block != nil {
+		return nil
+	}
+	if n := len(s.mappings); n > 0 && int64(len(s.mappings[n-1])) >= end {
+		return s.mappings[n-1]
+	}
+	data, err := mapFile(s.segFile)
+	if err != nil {
+		logutil.Warnf("%s | SegmentFile | mmap unavailable: %v", s.name, err)
+		s.mapFailed = true
+		return nil
+	}
+	if int64(len(data)) < end {
+		// The data is not written yet
+		if err = unmapFile(data); err != nil {
+			logutil.Warnf("%s | SegmentFile | munmap: %v", s.name, err)
+		}
+		return nil
+	}
+	s.mappings = append(s.mappings, data)
+	return data
+}
+
+func (s *Segment) unmap() {
+	s.mapMutex.Lock()
+	defer s.mapMutex.Unlock()
+	for _, data := range s.mappings {
+		if err := unmapFile(data); err != nil {
+			logutil.Warnf("%s | SegmentFile | munmap: %v", s.name, err)
+		}
+	}
+	s.mappings = nil
+}
+
+// ReadMapped returns the data of the file as stored in the mapping of the
+// sealed segment without copying it. The data must not be modified. It
+// returns false if the data is not mapped or not in a single extent
+func (b *BlockFile) ReadMapped() ([]byte, bool, error) {
+	b.snode.mutex.RLock()
+	defer b.snode.mutex.RUnlock()
+	if len(b.snode.extents) != 1 {
+		return nil, false, nil
+	}
+	ext := &b.snode.extents[0]
+	start := int64(ext.offset) + int64(ext.data.offset)
+	end := start + int64(ext.data.length)
+	mapping := b.segment.mapped(end)
+	if mapping == nil {
+		return nil, false, nil
+	}
+	data := mapping[start:end:end]
+	if ext.checksum != 0 && crc32.Checksum(data, crcTable) != ext.checksum {
+		return nil, false, &CorruptionError{
+			Segment: b.segment.name,
+			File:    b.name,
+			Extent:  0,
+			Offset:  ext.offset,
+		}
+	}
+	return data, true, nil
+}
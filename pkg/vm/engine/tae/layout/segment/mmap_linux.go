@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package segment
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+var errNotMappable = errors.New("tae segment: file not mappable")
+
+// mapFile maps the whole file f read-only. Only the files opened with a
+// descriptor are mapped
+func mapFile(f File) ([]byte, error) {
+	fd, ok := f.(interface{ Fd() uintptr })
+	if !ok {
+		return nil, errNotMappable
+	}
+	var stat unix.Stat_t
+	if err := unix.Fstat(int(fd.Fd()), &stat); err != nil {
+		return nil, err
+	}
+	if stat.Size == 0 {
+		return nil, errNotMappable
+	}
+	return unix.Mmap(int(fd.Fd()), 0, int(stat.Size), unix.PROT_READ, unix.MAP_SHARED)
+}
+
+func unmapFile(data []byte) error {
+	return unix.Munmap(data)
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"errors"
+	"path"
+	"runtime"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment_Mmap(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("mmap is not supported")
+	}
+	dir := testutils.InitTestEnv(ModuleName, t)
+	seg := Segment{}
+	assert.Nil(t, seg.Init(path.Join(dir, "mmap.seg")))
+	seg.Mount()
+	a := seg.NewBlockFile("a")
+	assert.Nil(t, seg.Append(a, []byte("data of a")))
+	_, mapped, err := a.ReadMapped()
+	assert.Nil(t, err)
+	assert.False(t, mapped)
+
+	seg.Seal()
+	data, mapped, err := a.ReadMapped()
+	assert.Nil(t, err)
+	assert.True(t, mapped)
+	assert.Equal(t, readAll(t, a), data)
+
+	// The file is mapped again once grown
+	b := seg.NewBlockFile("b")
+	assert.Nil(t, seg.Append(b, []byte("data of b")))
+	data, mapped, err = b.ReadMapped()
+	assert.Nil(t, err)
+	assert.True(t, mapped)
+	assert.Equal(t, readAll(t, b), data)
+	assert.Equal(t, 2, len(seg.mappings))
+
+	assert.Nil(t, seg.Append(b, []byte("more data of b")))
+	_, mapped, err = b.ReadMapped()
+	assert.Nil(t, err)
+	assert.False(t, mapped)
+
+	_, err = seg.segFile.WriteAt([]byte{^data[0]}, int64(b.snode.extents[0].offset))
+	assert.Nil(t, err)
+	_, _, err = a.ReadMapped()
+	assert.Nil(t, err)
+	b.snode.extents = b.snode.extents[:1]
+	_, _, err = b.ReadMapped()
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	seg.Destroy()
+	assert.Nil(t, seg.mappings)
+}
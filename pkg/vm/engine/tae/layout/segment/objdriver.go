@@ -0,0 +1,514 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/objstore"
+)
+
+var (
+	ErrShrinkObjectFile = errors.New("tae segment: object file cannot be shrunk")
+	ErrInvalidSeek      = errors.New("tae segment: invalid seek")
+)
+
+// ChunkSize is the size of the objects a segment file is split into
+const ChunkSize = 4 * 1024 * 1024
+
+// The states of a chunk kept in the state file next to the segment file
+const (
+	// chunkUploaded is set once the chunk is in the object store
+	chunkUploaded byte = 1 << iota
+	// chunkDirty is set before the chunk is written on local disk and
+	// cleared once it is written back
+	chunkDirty
+	// chunkEvicted is set before the chunk is dropped from local disk and
+	// cleared once it is fetched again
+	chunkEvicted
+)
+
+func stateName(name string) string { return name + ".chunks" }
+
+// ObjectDriver keeps the segment files in an object store. A file is split
+// into chunks of ChunkSize and each chunk written is an object. The writes
+// go to the file on local disk and the dirty chunks are written back when
+// the file is synced. Once the chunks cached on local disk exceed the
+// capacity, the least recently used files are evicted and their chunks are
+// fetched from the object store again on access. The states of the chunks
+// are saved next to the file, so that a file reopened only fetches the
+// chunks evicted and writes back the ones never synced
+type ObjectDriver struct {
+	sync.RWMutex
+	store    objstore.ObjectStore
+	prefix   string
+	capacity int64
+	clock    uint64
+	files    map[string]*objectFile
+}
+
+var _ Driver = (*ObjectDriver)(nil)
+
+// NewObjectDriver returns a driver keeping the segment files in store. The
+// object keys are the file names prefixed by prefix
+func NewObjectDriver(store objstore.ObjectStore, prefix string, capacity int64) *ObjectDriver {
+	return &ObjectDriver{
+		store:    store,
+		prefix:   prefix,
+		capacity: capacity,
+		files:    make(map[string]*objectFile),
+	}
+}
+
+func (d *ObjectDriver) Create(name string) (File, error) {
+	local, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	f := &objectFile{
+		driver:   d,
+		name:     name,
+		key:      d.prefix + filepath.Base(name),
+		local:    local,
+		cached:   make(map[int64]bool),
+		dirty:    make(map[int64]bool),
+		uploaded: make(map[int64]bool),
+	}
+	if err = f.saveStateLocked(); err != nil {
+		local.Close()
+		return nil, err
+	}
+	f.touch()
+	d.Lock()
+	d.files[name] = f
+	d.Unlock()
+	return f, nil
+}
+
+// Open reopens the file of name created before. The chunks evicted are
+// fetched from the object store on access and the others are read from
+// local disk. The chunks written since their last sync are written back on
+// the next one, as well as all the chunks of a file without states
+func (d *ObjectDriver) Open(name string) (File, error) {
+	d.RLock()
+	f := d.files[name]
+	d.RUnlock()
+	if f != nil {
+		return f, nil
+	}
+	local, err := os.OpenFile(name, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := local.Stat()
+	if err != nil {
+		local.Close()
+		return nil, err
+	}
+	state, err := os.ReadFile(stateName(name))
+	saved := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		local.Close()
+		return nil, err
+	}
+	f = &objectFile{
+		driver:   d,
+		name:     name,
+		key:      d.prefix + filepath.Base(name),
+		local:    local,
+		size:     stat.Size(),
+		cached:   make(map[int64]bool),
+		dirty:    make(map[int64]bool),
+		uploaded: make(map[int64]bool),
+	}
+	for idx := int64(0); idx*ChunkSize < f.size; idx++ {
+		if !saved {
+			f.cached[idx] = true
+			f.dirty[idx] = true
+			continue
+		}
+		// The chunks past the states saved are only extended with zeros
+		var s byte
+		if idx < int64(len(state)) {
+			s = state[idx]
+		}
+		if s&chunkUploaded != 0 {
+			f.uploaded[idx] = true
+			if s&chunkEvicted != 0 {
+				f.cold = true
+				continue
+			}
+		}
+		f.cached[idx] = true
+		if s&chunkDirty != 0 {
+			f.dirty[idx] = true
+		}
+	}
+	f.touch()
+	d.Lock()
+	d.files[name] = f
+	d.Unlock()
+	return f, nil
+}
+
+// Remove deletes the objects of the file as well as the local file
+func (d *ObjectDriver) Remove(name string) error {
+	d.Lock()
+	f := d.files[name]
+	delete(d.files, name)
+	d.Unlock()
+	if f != nil {
+		if err := f.removeObjects(); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(stateName(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Remove(name)
+}
+
+// Evict writes back the dirty chunks of the file of name and drops all of
+// its chunks from local disk
+func (d *ObjectDriver) Evict(name string) error {
+	d.RLock()
+	f := d.files[name]
+	d.RUnlock()
+	if f == nil {
+		return os.ErrNotExist
+	}
+	return f.evict()
+}
+
+// CachedSize returns the bytes of the chunks cached on local disk
+func (d *ObjectDriver) CachedSize() (size int64) {
+	for _, f := range d.getFiles() {
+		size += f.cachedSize()
+	}
+	return
+}
+
+func (d *ObjectDriver) getFiles() []*objectFile {
+	d.RLock()
+	defer d.RUnlock()
+	files := make([]*objectFile, 0, len(d.files))
+	for _, f := range d.files {
+		files = append(files, f)
+	}
+	return files
+}
+
+// evictCold evicts the least recently used files until the cached chunks
+// fit in the capacity
+func (d *ObjectDriver) evictCold() {
+	files := d.getFiles()
+	size := int64(0)
+	for _, f := range files {
+		size += f.cachedSize()
+	}
+	if size <= d.capacity {
+		return
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].lastUsed() < files[j].lastUsed()
+	})
+	for _, f := range files {
+		if size <= d.capacity {
+			break
+		}
+		cached := f.cachedSize()
+		if err := f.evict(); err != nil {
+			logutil.Warnf("Evict %s: %v", f.name, err)
+			continue
+		}
+		size -= cached
+	}
+}
+
+type objectFile struct {
+	sync.Mutex
+	driver *ObjectDriver
+	name   string
+	key    string
+	local  *os.File
+	size   int64
+	pos    int64
+	used   uint64
+	// cold is true once the file is evicted and the chunks not cached have
+	// to be fetched from the object store
+	cold bool
+	// cached are the chunks holding data on local disk
+	cached map[int64]bool
+	// dirty are the chunks written since the last sync
+	dirty map[int64]bool
+	// uploaded are the chunks in the object store
+	uploaded map[int64]bool
+}
+
+func (f *objectFile) chunkKey(idx int64) string {
+	return fmt.Sprintf("%s/%d", f.key, idx)
+}
+
+func (f *objectFile) touch() {
+	atomic.StoreUint64(&f.used, atomic.AddUint64(&f.driver.clock, 1))
+}
+
+func (f *objectFile) lastUsed() uint64 {
+	return atomic.LoadUint64(&f.used)
+}
+
+func (f *objectFile) cachedSize() int64 {
+	f.Lock()
+	defer f.Unlock()
+	return int64(len(f.cached)) * ChunkSize
+}
+
+// loadLocked fetches the chunks overlapping [off, off+n) not cached
+func (f *objectFile) loadLocked(off, n int64) error {
+	if !f.cold || n <= 0 {
+		return nil
+	}
+	for idx := off / ChunkSize; idx <= (off+n-1)/ChunkSize; idx++ {
+		if f.cached[idx] {
+			continue
+		}
+		if f.uploaded[idx] {
+			data, err := f.driver.store.Get(f.chunkKey(idx))
+			if err != nil {
+				return err
+			}
+			if _, err = f.local.WriteAt(data, idx*ChunkSize); err != nil {
+				return err
+			}
+		}
+		f.cached[idx] = true
+	}
+	return nil
+}
+
+func (f *objectFile) ReadAt(p []byte, off int64) (n int, err error) {
+	f.Lock()
+	defer f.Unlock()
+	f.touch()
+	if err = f.loadLocked(off, int64(len(p))); err != nil {
+		return
+	}
+	return f.local.ReadAt(p, off)
+}
+
+func (f *objectFile) WriteAt(p []byte, off int64) (n int, err error) {
+	f.Lock()
+	defer f.Unlock()
+	return f.writeAtLocked(p, off)
+}
+
+func (f *objectFile) writeAtLocked(p []byte, off int64) (n int, err error) {
+	f.touch()
+	if len(p) == 0 {
+		return
+	}
+	// The chunks partially written keep the rest of the data
+	if err = f.loadLocked(off, int64(len(p))); err != nil {
+		return
+	}
+	// The chunks are saved dirty before they are written, so that they are
+	// written back even if the file is reopened before its next sync
+	dirtied := false
+	for idx := off / ChunkSize; idx <= (off+int64(len(p))-1)/ChunkSize; idx++ {
+		f.cached[idx] = true
+		if !f.dirty[idx] {
+			f.dirty[idx] = true
+			dirtied = true
+		}
+	}
+	if dirtied {
+		if err = f.saveStateLocked(); err != nil {
+			return
+		}
+	}
+	if n, err = f.local.WriteAt(p, off); err != nil {
+		return
+	}
+	if off+int64(n) > f.size {
+		f.size = off + int64(n)
+	}
+	return
+}
+
+func (f *objectFile) Write(p []byte) (n int, err error) {
+	f.Lock()
+	defer f.Unlock()
+	n, err = f.writeAtLocked(p, f.pos)
+	f.pos += int64(n)
+	return
+}
+
+func (f *objectFile) Seek(offset int64, whence int) (int64, error) {
+	f.Lock()
+	defer f.Unlock()
+	pos := offset
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		pos += f.pos
+	case io.SeekEnd:
+		pos += f.size
+	default:
+		return f.pos, ErrInvalidSeek
+	}
+	if pos < 0 {
+		return f.pos, ErrInvalidSeek
+	}
+	f.pos = pos
+	return pos, nil
+}
+
+// Truncate extends the file with zeros
+func (f *objectFile) Truncate(size int64) error {
+	f.Lock()
+	defer f.Unlock()
+	if size < f.size {
+		return ErrShrinkObjectFile
+	}
+	if err := f.local.Truncate(size); err != nil {
+		return err
+	}
+	f.size = size
+	return nil
+}
+
+// Sync writes back the dirty chunks to the object store
+func (f *objectFile) Sync() error {
+	f.Lock()
+	err := f.syncLocked()
+	f.Unlock()
+	if err != nil {
+		return err
+	}
+	f.driver.evictCold()
+	return nil
+}
+
+func (f *objectFile) syncLocked() (err error) {
+	if len(f.dirty) == 0 {
+		return
+	}
+	if err = f.local.Sync(); err != nil {
+		return
+	}
+	for idx := range f.dirty {
+		size := f.size - idx*ChunkSize
+		if size > ChunkSize {
+			size = ChunkSize
+		}
+		buf := make([]byte, size)
+		if _, err = f.local.ReadAt(buf, idx*ChunkSize); err != nil && err != io.EOF {
+			return
+		}
+		if err = f.driver.store.Put(f.chunkKey(idx), buf); err != nil {
+			return
+		}
+		f.uploaded[idx] = true
+		delete(f.dirty, idx)
+	}
+	return f.saveStateLocked()
+}
+
+func (f *objectFile) evict() (err error) {
+	f.Lock()
+	defer f.Unlock()
+	if err = f.syncLocked(); err != nil {
+		return
+	}
+	// The chunks are saved evicted before their data is punched out
+	f.cold = true
+	f.cached = make(map[int64]bool)
+	if err = f.saveStateLocked(); err != nil {
+		return
+	}
+	// Punch out all the data and keep the size
+	if err = f.local.Truncate(0); err != nil {
+		return
+	}
+	if err = f.local.Truncate(f.size); err != nil {
+		return
+	}
+	logutil.Infof("Evicted %s", f.name)
+	return
+}
+
+// saveStateLocked saves the states of the chunks to the state file of f,
+// which is replaced as a whole
+func (f *objectFile) saveStateLocked() (err error) {
+	n := (f.size + ChunkSize - 1) / ChunkSize
+	for idx := range f.dirty {
+		if idx >= n {
+			n = idx + 1
+		}
+	}
+	state := make([]byte, n)
+	for idx := int64(0); idx < n; idx++ {
+		if f.uploaded[idx] {
+			state[idx] |= chunkUploaded
+		}
+		if f.dirty[idx] {
+			state[idx] |= chunkDirty
+		}
+		if f.cold && !f.cached[idx] {
+			state[idx] |= chunkEvicted
+		}
+	}
+	name := stateName(f.name)
+	tmp, err := os.Create(name + ".tmp")
+	if err != nil {
+		return
+	}
+	if _, err = tmp.Write(state); err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (f *objectFile) removeObjects() (err error) {
+	f.Lock()
+	defer f.Unlock()
+	for idx := range f.uploaded {
+		if err = f.driver.store.Delete(f.chunkKey(idx)); err != nil {
+			return
+		}
+		delete(f.uploaded, idx)
+	}
+	return
+}
+
+func (f *objectFile) Close() error {
+	f.Lock()
+	defer f.Unlock()
+	return f.local.Close()
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/objstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectDriver(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "obj.seg")
+	store := objstore.NewMemStore()
+	driver := NewObjectDriver(store, "tae/", 4*ChunkSize)
+
+	f, err := driver.Create(name)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Truncate(3*ChunkSize))
+	head := bytes.Repeat([]byte("h"), 100)
+	_, err = f.Write(head)
+	assert.Nil(t, err)
+	// A write across two chunks
+	tail := bytes.Repeat([]byte("t"), 200)
+	_, err = f.WriteAt(tail, 2*ChunkSize-100)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Sync())
+	assert.Equal(t, 3, store.Count())
+
+	assert.Nil(t, driver.Evict(name))
+	assert.Equal(t, int64(0), driver.CachedSize())
+
+	buf := make([]byte, 100)
+	_, err = f.ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, head, buf)
+	assert.Equal(t, int64(ChunkSize), driver.CachedSize())
+
+	// A partial write keeps the rest of the evicted chunk
+	_, err = f.WriteAt([]byte("x"), 2*ChunkSize+10)
+	assert.Nil(t, err)
+	buf = make([]byte, 200)
+	_, err = f.ReadAt(buf, 2*ChunkSize-100)
+	assert.Nil(t, err)
+	tail[110] = 'x'
+	assert.Equal(t, tail, buf)
+
+	// The rest of the file reads zeros
+	_, err = f.ReadAt(buf, 2*ChunkSize+1000)
+	assert.Nil(t, err)
+	assert.Equal(t, make([]byte, 200), buf)
+
+	assert.Nil(t, f.Sync())
+	assert.Nil(t, f.Close())
+
+	// A file reopened by another driver reads the chunks synced
+	driver = NewObjectDriver(store, "tae/", 4*ChunkSize)
+	f, err = driver.Open(name)
+	assert.Nil(t, err)
+	_, err = f.ReadAt(buf, 2*ChunkSize-100)
+	assert.Nil(t, err)
+	assert.Equal(t, tail, buf)
+	assert.Nil(t, f.Close())
+
+	assert.Nil(t, driver.Remove(name))
+	assert.Equal(t, 0, store.Count())
+}
+
+func TestObjectDriverCapacity(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	store := objstore.NewMemStore()
+	driver := NewObjectDriver(store, "tae/", 2*ChunkSize)
+
+	data := bytes.Repeat([]byte("d"), ChunkSize)
+	files := make([]File, 3)
+	for i := range files {
+		f, err := driver.Create(path.Join(dir, string(rune('a'+i))+".seg"))
+		assert.Nil(t, err)
+		_, err = f.Write(data)
+		assert.Nil(t, err)
+		assert.Nil(t, f.Sync())
+		files[i] = f
+	}
+	// The least recently used file is evicted
+	assert.Equal(t, int64(2*ChunkSize), driver.CachedSize())
+	assert.True(t, files[0].(*objectFile).cold)
+	buf := make([]byte, ChunkSize)
+	_, err := files[0].ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, data, buf)
+	for _, f := range files {
+		assert.Nil(t, f.Close())
+	}
+}
+
+func TestObjectDriverReopen(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "obj.seg")
+	store := objstore.NewMemStore()
+	driver := NewObjectDriver(store, "tae/", 4*ChunkSize)
+
+	f, err := driver.Create(name)
+	assert.Nil(t, err)
+	synced := bytes.Repeat([]byte("s"), 100)
+	_, err = f.WriteAt(synced, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Sync())
+	written := bytes.Repeat([]byte("w"), 100)
+	_, err = f.WriteAt(written, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	// The chunk written since the last sync is read from local disk and
+	// written back on the next sync
+	driver = NewObjectDriver(store, "tae/", 4*ChunkSize)
+	f, err = driver.Open(name)
+	assert.Nil(t, err)
+	buf := make([]byte, 100)
+	_, err = f.ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, written, buf)
+	assert.Nil(t, f.Sync())
+	data, err := store.Get("tae/obj.seg/0")
+	assert.Nil(t, err)
+	assert.Equal(t, written, data)
+
+	// The chunk evicted is fetched from the object store
+	assert.Nil(t, driver.Evict(name))
+	assert.Nil(t, f.Close())
+	driver = NewObjectDriver(store, "tae/", 4*ChunkSize)
+	f, err = driver.Open(name)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), driver.CachedSize())
+	_, err = f.ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, written, buf)
+	assert.Equal(t, int64(ChunkSize), driver.CachedSize())
+	assert.Nil(t, f.Close())
+
+	assert.Nil(t, driver.Remove(name))
+	assert.Equal(t, 0, store.Count())
+	_, err = os.Stat(stateName(name))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -16,10 +16,12 @@ package segment
 
 import (
 	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
 	"encoding/binary"
+	"errors"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
-	"os"
 	"sync"
 )
 
@@ -31,31 +33,53 @@ const DATA_START = LOG_START + BLOCK_SIZE*INODE_NUM
 const DATA_SIZE = SIZE - DATA_START
 const LOG_SIZE = DATA_START - LOG_START
 
+// SUPER_VERSION is the version of the layout of the segment files written
+const SUPER_VERSION = 6
+
+var (
+	ErrInvalidSuperBlock = errors.New("tae segment: invalid superblock")
+)
+
 type SuperBlock struct {
 	version   uint64
 	blockSize uint32
 	colCnt    uint32
 	lognode   *Inode
+	// cipher is the cipher of the segment and wrappedKey is its data key
+	// wrapped by the key provider
+	cipher     uint8
+	wrappedKey []byte
 }
 
 type Segment struct {
 	mutex     sync.Mutex
-	segFile   *os.File
+	driver    Driver
+	segFile   File
 	lastInode uint64
 	super     SuperBlock
 	nodes     map[string]*BlockFile
 	log       *Log
 	allocator Allocator
 	name      string
+	// block encrypts the data and the log of an encrypted segment
+	block cipher.Block
+	// check is the report of the segment opened by CheckSegment
+	check *CheckReport
+	// mappings are the read-only mappings of the file of a sealed segment
+	mapMutex  sync.Mutex
+	sealed    bool
+	mappings  [][]byte
+	mapFailed bool
 }
 
 func (s *Segment) Init(name string) error {
-	var (
-		err     error
-		sbuffer bytes.Buffer
-	)
+	return s.InitWithDriver(name, LocalDriver)
+}
+
+// InitWithDriver creates the segment file of name through driver
+func (s *Segment) InitWithDriver(name string, driver Driver) error {
 	s.super = SuperBlock{
-		version:   1,
+		version:   SUPER_VERSION,
 		blockSize: BLOCK_SIZE,
 	}
 	log := &Inode{
@@ -64,8 +88,20 @@ func (s *Segment) Init(name string) error {
 		state: RESIDENT,
 	}
 	s.name = name
+	s.driver = driver
 	s.super.lognode = log
-	segmentFile, err := os.Create(name)
+	key, wrapped, err := newDataKey(driver)
+	if err != nil {
+		return err
+	}
+	if key != nil {
+		if s.block, err = aes.NewCipher(key); err != nil {
+			return err
+		}
+		s.super.cipher = CipherAES256CTR
+		s.super.wrappedKey = wrapped
+	}
+	segmentFile, err := driver.Create(name)
 	if err != nil {
 		return err
 	}
@@ -75,20 +111,43 @@ func (s *Segment) Init(name string) error {
 	if err != nil {
 		return err
 	}
+	buf, err := s.encodeSuper()
+	if err != nil {
+		return err
+	}
+	if _, err := s.segFile.Write(buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// encodeSuper encodes the superblock padded to a block
+func (s *Segment) encodeSuper() ([]byte, error) {
+	var sbuffer bytes.Buffer
 	/*header := make([]byte, 32)
 	copy(header, encoding.EncodeUint64(sb.version))*/
-	err = binary.Write(&sbuffer, binary.BigEndian, s.super.version)
+	err := binary.Write(&sbuffer, binary.BigEndian, s.super.version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err = binary.Write(&sbuffer, binary.BigEndian, uint8(compress.Lz4)); err != nil {
-		return err
+		return nil, err
 	}
 	if err = binary.Write(&sbuffer, binary.BigEndian, s.super.blockSize); err != nil {
-		return err
+		return nil, err
 	}
 	if err = binary.Write(&sbuffer, binary.BigEndian, s.super.colCnt); err != nil {
-		return err
+		return nil, err
+	}
+	if layouts[s.super.version].encryption {
+		for _, v := range []interface{}{
+			s.super.cipher, uint16(len(s.super.wrappedKey)), s.super.wrappedKey,
+		} {
+			if err = binary.Write(&sbuffer, binary.BigEndian, v); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	cbufLen := (s.super.blockSize - (uint32(sbuffer.Len()) % s.super.blockSize)) + uint32(sbuffer.Len())
@@ -96,17 +155,121 @@ func (s *Segment) Init(name string) error {
 	if cbufLen > uint32(sbuffer.Len()) {
 		zero := make([]byte, cbufLen-uint32(sbuffer.Len()))
 		if err = binary.Write(&sbuffer, binary.BigEndian, zero); err != nil {
-			return err
+			return nil, err
 		}
 	}
+	return sbuffer.Bytes(), nil
+}
+
+func (s *Segment) Open(name string) error {
+	return s.OpenWithDriver(name, LocalDriver)
+}
+
+// OpenWithDriver opens the segment file of name created through driver
+// before and recovers its block files from the log
+func (s *Segment) OpenWithDriver(name string, driver Driver) error {
+	segmentFile, err := driver.Open(name)
+	if err != nil {
+		return err
+	}
+	s.name = name
+	s.driver = driver
+	s.segFile = segmentFile
+	if err = s.readSuper(); err != nil {
+		segmentFile.Close()
+		return err
+	}
+	s.Mount()
+	if err = s.log.Replay(); err != nil {
+		segmentFile.Close()
+		return err
+	}
+	return nil
+}
 
-	if _, err := s.segFile.Write(sbuffer.Bytes()); err != nil {
+// readSuper reads the superblock written by InitWithDriver and checks its
+// version is readable
+func (s *Segment) readSuper() error {
+	var algo uint8
+	buf := make([]byte, BLOCK_SIZE)
+	if _, err := s.segFile.ReadAt(buf, 0); err != nil {
 		return err
 	}
+	r := bytes.NewReader(buf)
+	for _, v := range []interface{}{&s.super.version, &algo, &s.super.blockSize, &s.super.colCnt} {
+		if err := binary.Read(r, binary.BigEndian, v); err != nil {
+			return err
+		}
+	}
+	l, err := getLayout(s.super.version)
+	if err != nil || s.super.blockSize != BLOCK_SIZE {
+		return ErrInvalidSuperBlock
+	}
+	if l.encryption {
+		var keyLen uint16
+		for _, v := range []interface{}{&s.super.cipher, &keyLen} {
+			if err = binary.Read(r, binary.BigEndian, v); err != nil {
+				return err
+			}
+		}
+		if int(keyLen) > r.Len() {
+			return ErrInvalidSuperBlock
+		}
+		s.super.wrappedKey = make([]byte, keyLen)
+		if _, err = r.Read(s.super.wrappedKey); err != nil {
+			return err
+		}
+	}
+	switch s.super.cipher {
+	case CipherNone:
+	case CipherAES256CTR:
+		key, err := unwrapDataKey(s.driver, s.super.wrappedKey)
+		if err != nil {
+			return err
+		}
+		if s.block, err = aes.NewCipher(key); err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidSuperBlock
+	}
+	s.super.lognode = &Inode{
+		inode: 1,
+		size:  0,
+		state: RESIDENT,
+	}
+	return nil
+}
 
+// validate checks the extents of an inode replayed are in the data area
+func (s *Segment) validate(ino *Inode) error {
+	for i := range ino.extents {
+		if !ino.extents[i].inDataArea() {
+			return ErrInvalidLogRecord
+		}
+	}
 	return nil
 }
 
+// rebuildAllocator rebuilds the allocators of the data and the log from
+// the extents of the block files and their records
+func (s *Segment) rebuildAllocator() {
+	s.allocator = NewExtentAllocator(DATA_SIZE, s.GetPageSize())
+	s.log.allocator = NewExtentAllocator(LOG_SIZE, s.GetPageSize())
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, file := range s.nodes {
+		if ext := file.snode.logExtents; ext.length > 0 {
+			s.log.allocator.Reserve(ext.offset, ext.length)
+		}
+		for _, ext := range file.snode.extents {
+			if ext.inDataArea() {
+				s.allocator.Reserve(ext.offset-DATA_START, ext.length)
+			}
+		}
+	}
+}
+
 func (s *Segment) Mount() {
 	s.lastInode = 1
 	var seq uint64
@@ -122,8 +285,8 @@ func (s *Segment) Mount() {
 	s.log.offset = LOG_START + s.log.logFile.snode.size
 	s.log.seq = seq + 1
 	s.nodes[logFile.name] = s.log.logFile
-	s.allocator = NewBitmapAllocator(DATA_SIZE, s.GetPageSize())
-	s.log.allocator = NewBitmapAllocator(LOG_SIZE, s.GetPageSize())
+	s.allocator = NewExtentAllocator(DATA_SIZE, s.GetPageSize())
+	s.log.allocator = NewExtentAllocator(LOG_SIZE, s.GetPageSize())
 }
 
 func (s *Segment) Unmount() {
@@ -133,12 +296,13 @@ func (s *Segment) Unmount() {
 func (s *Segment) Destroy() {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	s.unmap()
 	err := s.segFile.Close()
 	if err != nil {
 		panic(any(err.Error()))
 	}
 	logutil.Infof(" %s | SegmentFile | Destroying", s.name)
-	err = os.Remove(s.name)
+	err = s.driver.Remove(s.name)
 	if err != nil {
 		panic(any(err.Error()))
 	}
@@ -170,12 +334,20 @@ func (s *Segment) NewBlockFile(fname string) *BlockFile {
 }
 
 func (s *Segment) Append(fd *BlockFile, pl []byte) error {
+	return s.AppendWithCompression(fd, pl, compress.Lz4)
+}
+
+// AppendWithCompression appends pl to fd compressed by algo
+func (s *Segment) AppendWithCompression(fd *BlockFile, pl []byte, algo int) error {
+	if err := s.checkWritable(); err != nil {
+		return err
+	}
 	offset, allocated := s.allocator.Allocate(uint64(len(pl)))
 	if allocated == 0 {
 		//panic(any("no space"))
 		panic(any("no space"))
 	}
-	err := fd.Append(DATA_START+offset, pl)
+	err := fd.AppendWithCompression(DATA_START+offset, pl, algo)
 	if err != nil {
 		return err
 	}
@@ -186,7 +358,82 @@ func (s *Segment) Append(fd *BlockFile, pl []byte) error {
 	return nil
 }
 
+// AppendBatch appends pls[i] compressed by algos[i] to fds[i]. The data is
+// written contiguously by one vectored write if the space allows
+func (s *Segment) AppendBatch(fds []*BlockFile, pls [][]byte, algos []int) error {
+	if err := s.checkWritable(); err != nil {
+		return err
+	}
+	bufs := make([][]byte, len(pls))
+	lengths := make([]uint64, len(pls))
+	total := uint64(0)
+	for i, pl := range pls {
+		buf, err := compressData(pl, algos[i])
+		if err != nil {
+			return err
+		}
+		bufs[i] = buf
+		lengths[i] = p2roundup(uint64(len(buf)), uint64(s.super.blockSize))
+		total += lengths[i]
+	}
+	offset, allocated := s.allocator.Allocate(total)
+	if allocated == 0 {
+		// The space is too fragmented to hold the data contiguously
+		for i, fd := range fds {
+			if err := s.AppendWithCompression(fd, pls[i], algos[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	nonces := make([]uint64, len(bufs))
+	pos := int64(DATA_START + offset)
+	for i := range bufs {
+		var err error
+		if bufs[i], nonces[i], err = s.seal(bufs[i], pos); err != nil {
+			return err
+		}
+		pos += int64(lengths[i])
+	}
+	iovs := make([][]byte, 0, 2*len(bufs))
+	for i, buf := range bufs {
+		iovs = append(iovs, buf)
+		if pad := lengths[i] - uint64(len(buf)); pad > 0 && i < len(bufs)-1 {
+			iovs = append(iovs, make([]byte, pad))
+		}
+	}
+	if err := writeVectorAt(s.segFile, iovs, int64(DATA_START+offset)); err != nil {
+		return err
+	}
+	offset += DATA_START
+	for i, fd := range fds {
+		fd.appendExtent(offset, bufs[i], len(pls[i]), algos[i], nonces[i])
+		if err := s.log.Append(fd); err != nil {
+			return err
+		}
+		offset += lengths[i]
+	}
+	return nil
+}
+
+// gatherWriteAt writes bufs at off by one write of a buffer gathering them
+func gatherWriteAt(f File, bufs [][]byte, off int64) error {
+	size := 0
+	for _, buf := range bufs {
+		size += len(buf)
+	}
+	data := make([]byte, 0, size)
+	for _, buf := range bufs {
+		data = append(data, buf...)
+	}
+	_, err := f.WriteAt(data, off)
+	return err
+}
+
 func (s *Segment) Update(fd *BlockFile, pl []byte, fOffset uint64) error {
+	if err := s.checkWritable(); err != nil {
+		return err
+	}
 	offset, _ := s.allocator.Allocate(uint64(len(pl)))
 	free, err := fd.Update(DATA_START+offset, pl, uint32(fOffset))
 	if err != nil {
@@ -226,6 +473,33 @@ func (s *Segment) Free(fd *BlockFile) {
 	fd.snode.extents = []Extent{}
 }
 
+// VerifySegment scrubs the block files of s and returns the extents
+// corrupted. It is meant for the background checks
+func VerifySegment(s *Segment) ([]*CorruptionError, error) {
+	s.mutex.Lock()
+	files := make([]*BlockFile, 0, len(s.nodes))
+	for _, file := range s.nodes {
+		if file.snode != nil {
+			files = append(files, file)
+		}
+	}
+	s.mutex.Unlock()
+	corrupted := make([]*CorruptionError, 0)
+	for _, file := range files {
+		errs, err := file.Verify()
+		if err != nil {
+			return nil, err
+		}
+		corrupted = append(corrupted, errs...)
+	}
+	return corrupted, nil
+}
+
+// AllocatorStats returns the occupancy of the data space
+func (s *Segment) AllocatorStats() AllocatorStats {
+	return s.allocator.Stats()
+}
+
 func (s *Segment) GetPageSize() uint32 {
 	return s.super.blockSize
 }
@@ -237,3 +511,14 @@ func (s *Segment) Sync() error {
 func (s *Segment) GetName() string {
 	return s.name
 }
+
+// Prefetch issues the readahead of the data of extents. It returns false if
+// the file of the segment does not support the readahead
+func (s *Segment) Prefetch(extents []Extent) bool {
+	for _, ext := range extents {
+		if !readahead(s.segFile, int64(ext.offset), int64(ext.length)) {
+			return false
+		}
+	}
+	return true
+}
@@ -17,10 +17,13 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
 	"github.com/stretchr/testify/assert"
+	"os"
 	"path"
 	"testing"
 )
@@ -90,3 +93,132 @@ func TestSegment_Init(t *testing.T) {
 	seg.Append(file, []byte(fmt.Sprintf("this is tests %d", 514)))
 	seg.Append(file, []byte(fmt.Sprintf("this is tests %d", 515)))*/
 }
+
+func readAll(t *testing.T, file *BlockFile) []byte {
+	buf := make([]byte, file.GetFileSize())
+	n, err := file.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, len(buf), n)
+	return buf
+}
+
+func TestSegment_Open(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "open.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	files := make([]*BlockFile, 4)
+	for i := range files {
+		files[i] = seg.NewBlockFile(fmt.Sprintf("block%d", i))
+		for j := 0; j <= i; j++ {
+			err := seg.Append(files[i], []byte(fmt.Sprintf("block %d data %d", i, j)))
+			assert.Nil(t, err)
+		}
+	}
+	data := readAll(t, files[3])
+	seg.ReleaseFile(files[0])
+	seg.ReleaseFile(files[2])
+	// The records left are moved down to the pages freed
+	assert.Nil(t, seg.log.compactLocked())
+	assert.Equal(t, uint32(0), files[1].snode.logExtents.offset)
+	assert.Nil(t, seg.Sync())
+	used := seg.AllocatorStats().Used
+	assert.Nil(t, seg.segFile.Close())
+
+	seg = Segment{}
+	assert.Nil(t, seg.Open(name))
+	assert.Equal(t, 3, len(seg.nodes))
+	assert.Nil(t, seg.nodes["block0"])
+	assert.Nil(t, seg.nodes["block2"])
+	file := seg.nodes["block3"]
+	assert.Equal(t, 4, len(file.snode.extents))
+	assert.Equal(t, data, readAll(t, file))
+	assert.Equal(t, used, seg.AllocatorStats().Used)
+	assert.True(t, seg.NewBlockFile("block4").GetInode() > file.GetInode())
+	assert.Nil(t, seg.Append(file, []byte("more data")))
+	assert.Nil(t, seg.segFile.Close())
+
+	seg = Segment{}
+	assert.Nil(t, seg.Open(name))
+	assert.Equal(t, 5, len(seg.nodes["block3"].snode.extents))
+	assert.Nil(t, seg.segFile.Close())
+
+	f, err := os.OpenFile(name, os.O_RDWR, 0)
+	assert.Nil(t, err)
+	_, err = f.WriteAt([]byte{0xff}, 0)
+	assert.Nil(t, err)
+	f.Close()
+	seg = Segment{}
+	assert.Equal(t, ErrInvalidSuperBlock, seg.Open(name))
+}
+
+func TestSegment_Checksum(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "checksum.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	file := seg.NewBlockFile("block")
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, seg.Append(file, []byte(fmt.Sprintf("block data %d", i))))
+	}
+	data := readAll(t, file)
+	corrupted, err := VerifySegment(&seg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corrupted))
+
+	// Flip the first byte of the second extent
+	ext := file.snode.extents[1]
+	b := make([]byte, 1)
+	_, err = seg.segFile.ReadAt(b, int64(ext.offset))
+	assert.Nil(t, err)
+	_, err = seg.segFile.WriteAt([]byte{^b[0]}, int64(ext.offset))
+	assert.Nil(t, err)
+	_, err = file.Read(make([]byte, len(data)))
+	var corruption *CorruptionError
+	assert.True(t, errors.As(err, &corruption))
+	assert.Equal(t, 1, corruption.Extent)
+	assert.Equal(t, "block", corruption.File)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	corrupted, err = VerifySegment(&seg)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(corrupted))
+	assert.Equal(t, ext.offset, corrupted[0].Offset)
+}
+
+func TestSegment_AppendBatch(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "batch.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	algos := []int{compress.None, compress.Lz4, compress.Zstd}
+	fds := make([]*BlockFile, len(algos))
+	pls := make([][]byte, len(algos))
+	for i := range algos {
+		fds[i] = seg.NewBlockFile(fmt.Sprintf("col%d", i))
+		pls[i] = bytes.Repeat([]byte(fmt.Sprintf("column data %d", i)), 1000)
+	}
+	assert.Nil(t, seg.AppendBatch(fds, pls, algos))
+
+	offset := fds[0].snode.extents[0].offset
+	for i, fd := range fds {
+		ext := fd.snode.extents[0]
+		assert.Equal(t, offset, ext.offset)
+		offset += ext.length
+		assert.Equal(t, algos[i], fd.GetAlgo())
+		assert.Equal(t, int64(len(pls[i])), fd.GetOriginSize())
+		data := readAll(t, fd)
+		if algos[i] != compress.None {
+			data, err := compress.Decompress(data, make([]byte, len(pls[i])), algos[i])
+			assert.Nil(t, err)
+			assert.Equal(t, pls[i], data)
+			continue
+		}
+		assert.Equal(t, pls[i], data)
+	}
+	corrupted, err := VerifySegment(&seg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corrupted))
+}
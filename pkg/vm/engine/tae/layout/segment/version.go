@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"errors"
+)
+
+// MIN_SUPER_VERSION is the oldest version of the segment files readable
+const MIN_SUPER_VERSION = 2
+
+var (
+	ErrUnsupportedVersion = errors.New("tae segment: unsupported version")
+	ErrUpgradeRequired    = errors.New("tae segment: upgrade required")
+)
+
+// layout is the set of the fields in the records of a version
+type layout struct {
+	// checksum is set for the extents with the CRC32C of their data
+	checksum bool
+	// compression is set for the extents with the compress algorithm of
+	// their data. The data of the extents without it is compressed by lz4
+	compression bool
+	// recordVersion is set for the log records with their own version
+	recordVersion bool
+	// encryption is set for the cipher and the data key in the superblock,
+	// the cipher of the inodes and the nonces of the extents
+	encryption bool
+}
+
+// layouts is the compatibility matrix of the versions readable. A version
+// not in it is not read at all rather than misread
+var layouts = map[uint64]layout{
+	2: {},
+	3: {checksum: true},
+	4: {checksum: true, compression: true},
+	5: {checksum: true, compression: true, recordVersion: true},
+	6: {checksum: true, compression: true, recordVersion: true, encryption: true},
+}
+
+func getLayout(version uint64) (layout, error) {
+	l, ok := layouts[version]
+	if !ok {
+		return layout{}, ErrUnsupportedVersion
+	}
+	return l, nil
+}
+
+// Version returns the version of the layout of the segment file
+func (s *Segment) Version() uint64 {
+	return s.super.version
+}
+
+// checkWritable returns ErrUpgradeRequired for a segment file of an older
+// version. It has to be upgraded before written
+func (s *Segment) checkWritable() error {
+	if s.super.version != SUPER_VERSION {
+		return ErrUpgradeRequired
+	}
+	return nil
+}
+
+func Upgrade(name string) error {
+	return UpgradeWithDriver(name, LocalDriver)
+}
+
+// UpgradeWithDriver upgrades the segment file of name offline to the
+// current version. The records of the inodes are rewritten one by one
+// before the superblock, so an upgrade interrupted can be run again
+func UpgradeWithDriver(name string, driver Driver) error {
+	s := &Segment{}
+	if err := s.OpenWithDriver(name, driver); err != nil {
+		return err
+	}
+	defer s.segFile.Close()
+	if s.super.version == SUPER_VERSION {
+		return nil
+	}
+	s.mutex.Lock()
+	files := make([]*BlockFile, 0, len(s.nodes))
+	for _, file := range s.nodes {
+		if file.snode.logExtents.length > 0 {
+			files = append(files, file)
+		}
+	}
+	s.mutex.Unlock()
+	s.log.mutex.Lock()
+	for _, file := range files {
+		if err := s.log.appendLocked(file); err != nil {
+			s.log.mutex.Unlock()
+			return err
+		}
+	}
+	s.log.mutex.Unlock()
+	if err := s.segFile.Sync(); err != nil {
+		return err
+	}
+	s.super.version = SUPER_VERSION
+	buf, err := s.encodeSuper()
+	if err != nil {
+		return err
+	}
+	if _, err = s.segFile.WriteAt(buf, 0); err != nil {
+		return err
+	}
+	return s.segFile.Sync()
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// rewriteRecord rewrites the record of file in the log as a record of the
+// older version without its own version
+func rewriteRecord(t *testing.T, s *Segment, file *BlockFile, version uint64) {
+	l := layouts[version]
+	var w bytes.Buffer
+	w.Write(make([]byte, LOG_HEADER_SIZE))
+	snode := file.snode
+	for _, v := range []interface{}{
+		s.log.seq, snode.inode, snode.algo, snode.state, snode.size, snode.originSize,
+		uint16(len(file.name)), []byte(file.name), uint64(len(snode.extents)),
+	} {
+		assert.Nil(t, binary.Write(&w, binary.BigEndian, v))
+	}
+	for _, ext := range snode.extents {
+		fields := []interface{}{ext.typ, ext.offset, ext.length, ext.data.offset, ext.data.length}
+		if l.checksum {
+			fields = append(fields, ext.checksum)
+		}
+		for _, v := range fields {
+			assert.Nil(t, binary.Write(&w, binary.BigEndian, v))
+		}
+	}
+	buf := w.Bytes()
+	binary.BigEndian.PutUint32(buf, LOG_MAGIC)
+	binary.BigEndian.PutUint32(buf[4:], uint32(len(buf)+4))
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, crc32.Checksum(buf, crcTable)))
+	_, err := s.segFile.WriteAt(w.Bytes(), int64(snode.logExtents.offset)+LOG_START)
+	assert.Nil(t, err)
+}
+
+func writeSuperVersion(t *testing.T, s *Segment, version uint64) {
+	s.super.version = version
+	buf, err := s.encodeSuper()
+	assert.Nil(t, err)
+	_, err = s.segFile.WriteAt(buf, 0)
+	assert.Nil(t, err)
+}
+
+func TestSegment_Upgrade(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "upgrade.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	files := make([]*BlockFile, 2)
+	data := make([][]byte, len(files))
+	for i := range files {
+		files[i] = seg.NewBlockFile(fmt.Sprintf("block%d", i))
+		assert.Nil(t, seg.Append(files[i], []byte(fmt.Sprintf("block data %d", i))))
+		data[i] = readAll(t, files[i])
+	}
+	for _, file := range files {
+		rewriteRecord(t, &seg, file, 3)
+	}
+	writeSuperVersion(t, &seg, 3)
+	assert.Nil(t, seg.segFile.Close())
+
+	seg = Segment{}
+	assert.Nil(t, seg.Open(name))
+	assert.Equal(t, uint64(3), seg.Version())
+	for i := range files {
+		file := seg.nodes[fmt.Sprintf("block%d", i)]
+		assert.Equal(t, data[i], readAll(t, file))
+		assert.Equal(t, files[i].snode.extents, file.snode.extents)
+	}
+	assert.Equal(t, ErrUpgradeRequired, seg.Append(seg.nodes["block0"], []byte("more data")))
+	assert.Nil(t, seg.segFile.Close())
+
+	assert.Nil(t, Upgrade(name))
+	seg = Segment{}
+	assert.Nil(t, seg.Open(name))
+	assert.Equal(t, uint64(SUPER_VERSION), seg.Version())
+	for i := range files {
+		assert.Equal(t, data[i], readAll(t, seg.nodes[fmt.Sprintf("block%d", i)]))
+	}
+	file := seg.nodes["block0"]
+	assert.Nil(t, seg.Append(file, []byte("more data")))
+	assert.Equal(t, 2, len(file.snode.extents))
+	corrupted, err := VerifySegment(&seg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corrupted))
+	assert.Nil(t, Upgrade(name))
+
+	// A version from the future is not read
+	writeSuperVersion(t, &seg, SUPER_VERSION+1)
+	assert.Nil(t, seg.segFile.Close())
+	seg = Segment{}
+	assert.Equal(t, ErrInvalidSuperBlock, seg.Open(name))
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package segment
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// IOV_MAX is the max number of the buffers of one vectored write
+const IOV_MAX = 1024
+
+// writeVectorAt writes bufs at off of f. The local files are written by
+// pwritev and the others by one write of the buffers gathered
+func writeVectorAt(f File, bufs [][]byte, off int64) error {
+	file, ok := f.(*os.File)
+	if !ok {
+		return gatherWriteAt(f, bufs, off)
+	}
+	for len(bufs) > 0 {
+		iovs := bufs
+		if len(iovs) > IOV_MAX {
+			iovs = iovs[:IOV_MAX]
+		}
+		n, err := unix.Pwritev(int(file.Fd()), iovs, off)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return io.ErrShortWrite
+		}
+		off += int64(n)
+		// Skip the bytes written, which may end in the middle of a buffer
+		for n > 0 {
+			if n < len(bufs[0]) {
+				bufs[0] = bufs[0][n:]
+				break
+			}
+			n -= len(bufs[0])
+			bufs = bufs[1:]
+		}
+		for len(bufs) > 0 && len(bufs[0]) == 0 {
+			bufs = bufs[1:]
+		}
+	}
+	return nil
+}
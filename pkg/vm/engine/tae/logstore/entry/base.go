@@ -16,16 +16,27 @@ package entry
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"math/rand"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/pierrec/lz4"
 )
 
+var (
+	ErrCorruptedPayload = errors.New("tae: corrupted entry payload")
+	ErrChecksumMismatch = errors.New("tae: entry checksum mismatch")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
 var (
 	_basePool = sync.Pool{New: func() interface{} {
 		return &Base{
@@ -386,6 +397,63 @@ func (b *Base) Unmarshal(buf []byte) error {
 	return nil
 }
 
+// Compress compresses the payload by lz4 if it has at least minSize bytes
+// and is shrunk by the compression. The compressed payload is prefixed by
+// the size of the original one
+func (b *Base) Compress(minSize int) error {
+	payload := b.GetPayload()
+	if b.IsCompressed() || len(payload) < minSize {
+		return nil
+	}
+	buf := make([]byte, 4+lz4.CompressBlockBound(len(payload)))
+	binary.BigEndian.PutUint32(buf, uint32(len(payload)))
+	compressed, err := compress.Compress(payload, buf[4:], compress.Lz4)
+	if err != nil {
+		return err
+	}
+	if len(compressed) == 0 || 4+len(compressed) >= len(payload) {
+		return nil
+	}
+	b.setPayload(buf[:4+len(compressed)])
+	b.setFlag(ETCompressedFlag, true)
+	return nil
+}
+
+func (b *Base) setPayload(payload []byte) {
+	if b.node != nil {
+		common.GPool.Free(b.node)
+		b.node = nil
+	}
+	b.payload = payload
+	b.SetPayloadSize(len(payload))
+}
+
+// Decompress restores the payload compressed by Compress
+func (b *Base) Decompress() error {
+	if !b.IsCompressed() {
+		return nil
+	}
+	payload := b.GetPayload()
+	if len(payload) < 4 {
+		return ErrCorruptedPayload
+	}
+	size := binary.BigEndian.Uint32(payload)
+	node := common.GPool.Alloc(uint64(size))
+	buf, err := compress.Decompress(payload[4:], node.Buf[:size], compress.Lz4)
+	if err != nil || len(buf) != int(size) {
+		common.GPool.Free(node)
+		return ErrCorruptedPayload
+	}
+	if b.node != nil {
+		common.GPool.Free(b.node)
+	}
+	b.node = node
+	b.payload = buf
+	b.SetPayloadSize(int(size))
+	b.setFlag(ETCompressedFlag, false)
+	return nil
+}
+
 func (b *Base) ReadFrom(r io.Reader) (int64, error) {
 	if b.node == nil {
 		b.node = common.GPool.Alloc(uint64(b.GetPayloadSize()))
@@ -424,7 +492,25 @@ func (b *Base) ReadAt(r *os.File, offset int) (int, error) {
 	return n1 + n2, nil
 }
 
+// checksum is the CRC32C of the descriptor without the checksum, the info
+// and the payload as written
+func (b *Base) checksum() uint32 {
+	sum := crc32.Update(0, crcTable, b.descBuf[:ChecksumOffset])
+	sum = crc32.Update(sum, crcTable, b.GetInfoBuf())
+	return crc32.Update(sum, crcTable, b.payload)
+}
+
+// VerifyChecksum returns ErrChecksumMismatch if the entry read differs
+// from the one written
+func (b *Base) VerifyChecksum() error {
+	if b.checksum() != b.GetChecksum() {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
 func (b *Base) WriteTo(w io.Writer) (int64, error) {
+	b.setChecksum(b.checksum())
 	n1, err := b.descriptor.WriteTo(w)
 	if err != nil {
 		return n1, err
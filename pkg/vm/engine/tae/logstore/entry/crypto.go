@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var (
+	ErrNoKeyProvider = errors.New("tae: no key provider for an encrypted entry")
+	ErrKeyNotFound   = errors.New("tae: encryption key not found")
+)
+
+// KeyProvider provides the AES keys encrypting the entries. A key is kept
+// until no entry encrypted by it is left
+type KeyProvider interface {
+	// CurrentKey returns the key encrypting the new entries and its id
+	CurrentKey() (id uint32, key []byte, err error)
+	// GetKey returns the key of id
+	GetKey(id uint32) ([]byte, error)
+}
+
+// StaticKeyProvider is a KeyProvider of the keys in memory
+type StaticKeyProvider struct {
+	Current uint32
+	Keys    map[uint32][]byte
+}
+
+func NewStaticKeyProvider(key []byte) *StaticKeyProvider {
+	return &StaticKeyProvider{
+		Keys: map[uint32][]byte{0: key},
+	}
+}
+
+func (p *StaticKeyProvider) CurrentKey() (uint32, []byte, error) {
+	key, err := p.GetKey(p.Current)
+	return p.Current, key, err
+}
+
+func (p *StaticKeyProvider) GetKey(id uint32) ([]byte, error) {
+	key, ok := p.Keys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+// Encrypt encrypts the info and the payload by the current key of keys.
+// Each of them is prefixed by the key id and the nonce
+func (b *Base) Encrypt(keys KeyProvider) error {
+	if b.IsEncrypted() {
+		return nil
+	}
+	id, key, err := keys.CurrentKey()
+	if err != nil {
+		return err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	info, err := seal(aead, id, b.GetInfoBuf())
+	if err != nil {
+		return err
+	}
+	payload, err := seal(aead, id, b.GetPayload())
+	if err != nil {
+		return err
+	}
+	b.SetInfoBuf(info)
+	b.SetInfoSize(len(info))
+	b.setPayload(payload)
+	b.setFlag(ETEncryptedFlag, true)
+	return nil
+}
+
+// Decrypt restores the info and the payload encrypted by Encrypt
+func (b *Base) Decrypt(keys KeyProvider) error {
+	if !b.IsEncrypted() {
+		return nil
+	}
+	if keys == nil {
+		return ErrNoKeyProvider
+	}
+	info, err := open(keys, b.GetInfoBuf())
+	if err != nil {
+		return err
+	}
+	payload, err := open(keys, b.GetPayload())
+	if err != nil {
+		return err
+	}
+	b.SetInfoBuf(info)
+	b.SetInfoSize(len(info))
+	b.setPayload(payload)
+	b.setFlag(ETEncryptedFlag, false)
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns the key id, the nonce and the sealed buf. The key id is
+// authenticated with buf
+func seal(aead cipher.AEAD, id uint32, buf []byte) ([]byte, error) {
+	header := make([]byte, 4+aead.NonceSize(), 4+aead.NonceSize()+len(buf)+aead.Overhead())
+	binary.BigEndian.PutUint32(header, id)
+	if _, err := io.ReadFull(rand.Reader, header[4:]); err != nil {
+		return nil, err
+	}
+	return aead.Seal(header, header[4:], buf, header[:4]), nil
+}
+
+func open(keys KeyProvider, buf []byte) ([]byte, error) {
+	if len(buf) < 4 {
+		return nil, ErrCorruptedPayload
+	}
+	key, err := keys.GetKey(binary.BigEndian.Uint32(buf))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) < 4+aead.NonceSize() {
+		return nil, ErrCorruptedPayload
+	}
+	nonce := buf[4 : 4+aead.NonceSize()]
+	plain, err := aead.Open(nil, nonce, buf[4+aead.NonceSize():], buf[:4])
+	if err != nil {
+		return nil, ErrCorruptedPayload
+	}
+	return plain, nil
+}
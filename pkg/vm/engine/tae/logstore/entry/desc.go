@@ -23,10 +23,22 @@ import (
 const (
 	PayloadSizeOffset = int(unsafe.Sizeof(ETInvalid))
 	InfoSizeOffset    = int(unsafe.Sizeof(ETInvalid) + unsafe.Sizeof(uint32(0)))
-	DescriptorSize    = int(unsafe.Sizeof(ETInvalid) + 2*unsafe.Sizeof(uint32(0)))
+	ChecksumOffset    = int(unsafe.Sizeof(ETInvalid) + 2*unsafe.Sizeof(uint32(0)))
+	DescriptorSize    = int(unsafe.Sizeof(ETInvalid) + 3*unsafe.Sizeof(uint32(0)))
 )
 
-//type u16, payloadsize u32, infosize u32
+const (
+	// ETCompressedFlag is set in the type of an entry with the payload
+	// compressed by lz4
+	ETCompressedFlag Type = 1 << 15
+	// ETEncryptedFlag is set in the type of an entry with the info and the
+	// payload encrypted by AES-GCM
+	ETEncryptedFlag Type = 1 << 14
+
+	etFlags = ETCompressedFlag | ETEncryptedFlag
+)
+
+//type u16, payloadsize u32, infosize u32, checksum u32
 type descriptor struct {
 	descBuf []byte
 }
@@ -57,10 +69,15 @@ func (desc *descriptor) SetInfoSize(size int) {
 	binary.BigEndian.PutUint32(desc.descBuf[InfoSizeOffset:], uint32(size))
 }
 
+func (desc *descriptor) setChecksum(sum uint32) {
+	binary.BigEndian.PutUint32(desc.descBuf[ChecksumOffset:], sum)
+}
+
 func (desc *descriptor) reset() {
 	desc.SetType(ETInvalid)
 	desc.SetPayloadSize(0)
 	desc.SetInfoSize(0)
+	desc.setChecksum(0)
 }
 
 func (desc *descriptor) GetMetaBuf() []byte {
@@ -68,7 +85,25 @@ func (desc *descriptor) GetMetaBuf() []byte {
 }
 
 func (desc *descriptor) GetType() Type {
-	return binary.BigEndian.Uint16(desc.descBuf)
+	return binary.BigEndian.Uint16(desc.descBuf) &^ etFlags
+}
+
+func (desc *descriptor) IsCompressed() bool {
+	return binary.BigEndian.Uint16(desc.descBuf)&ETCompressedFlag != 0
+}
+
+func (desc *descriptor) IsEncrypted() bool {
+	return binary.BigEndian.Uint16(desc.descBuf)&ETEncryptedFlag != 0
+}
+
+func (desc *descriptor) setFlag(flag Type, on bool) {
+	t := binary.BigEndian.Uint16(desc.descBuf)
+	if on {
+		t |= flag
+	} else {
+		t &^= flag
+	}
+	binary.BigEndian.PutUint16(desc.descBuf, t)
 }
 
 func (desc *descriptor) GetPayloadSize() int {
@@ -79,6 +114,10 @@ func (desc *descriptor) GetInfoSize() int {
 	return int(binary.BigEndian.Uint32(desc.descBuf[InfoSizeOffset:]))
 }
 
+func (desc *descriptor) GetChecksum() uint32 {
+	return binary.BigEndian.Uint32(desc.descBuf[ChecksumOffset:])
+}
+
 func (desc *descriptor) TotalSize() int {
 	return DescriptorSize + desc.GetPayloadSize() + desc.GetInfoSize()
 }
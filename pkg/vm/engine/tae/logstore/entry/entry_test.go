@@ -142,3 +142,72 @@ func checkInfoEqual(t *testing.T, info, info2 *Info) {
 	}
 	assert.Equal(t, info.GroupLSN, info2.GroupLSN)
 }
+
+func TestEncrypt(t *testing.T) {
+	keys := NewStaticKeyProvider(bytes.Repeat([]byte{1}, 32))
+	payload := bytes.Repeat([]byte("helloworld"), 100)
+	info := &Info{Group: GTCustomizedStart, TxnId: 3}
+	e := GetBase()
+	defer e.Free()
+	e.SetType(ETCustomizedStart)
+	e.SetInfoBuf(info.Marshal())
+	e.SetInfoSize(len(e.GetInfoBuf()))
+	assert.Nil(t, e.Unmarshal(payload))
+	assert.Nil(t, e.Compress(0))
+	assert.Nil(t, e.Encrypt(keys))
+	assert.True(t, e.IsEncrypted())
+	assert.True(t, e.IsCompressed())
+	assert.Equal(t, ETCustomizedStart, e.GetType())
+	assert.False(t, bytes.Contains(e.GetPayload(), []byte("hello")))
+
+	var buf bytes.Buffer
+	_, err := e.WriteTo(&buf)
+	assert.Nil(t, err)
+	e2 := GetBase()
+	defer e2.Free()
+	_, err = buf.Read(e2.GetMetaBuf())
+	assert.Nil(t, err)
+	_, err = e2.ReadFrom(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrNoKeyProvider, e2.Decrypt(nil))
+	assert.Equal(t, ErrKeyNotFound, e2.Decrypt(&StaticKeyProvider{}))
+	assert.Equal(t, ErrCorruptedPayload, e2.Decrypt(NewStaticKeyProvider(bytes.Repeat([]byte{2}, 32))))
+	assert.Nil(t, e2.Decrypt(keys))
+	assert.Nil(t, e2.Decompress())
+	assert.Equal(t, payload, e2.GetPayload())
+	assert.Equal(t, info.TxnId, Unmarshal(e2.GetInfoBuf()).TxnId)
+}
+
+func TestChecksum(t *testing.T) {
+	info := &Info{Group: GTCustomizedStart, TxnId: 3}
+	e := GetBase()
+	defer e.Free()
+	e.SetType(ETCustomizedStart)
+	e.SetInfoBuf(info.Marshal())
+	e.SetInfoSize(len(e.GetInfoBuf()))
+	assert.Nil(t, e.Unmarshal([]byte("helloworld")))
+
+	var buf bytes.Buffer
+	_, err := e.WriteTo(&buf)
+	assert.Nil(t, err)
+	written := buf.Bytes()
+	read := func(data []byte) (err error) {
+		r := bytes.NewBuffer(data)
+		e2 := GetBase()
+		defer e2.Free()
+		if _, err = r.Read(e2.GetMetaBuf()); err != nil {
+			return
+		}
+		if _, err = e2.ReadFrom(r); err != nil {
+			return
+		}
+		return e2.VerifyChecksum()
+	}
+	assert.Nil(t, read(written))
+	for _, off := range []int{0, ChecksumOffset, DescriptorSize, len(written) - 1} {
+		corrupted := make([]byte, len(written))
+		copy(corrupted, written)
+		corrupted[off] ^= 0xff
+		assert.Equal(t, ErrChecksumMismatch, read(corrupted))
+	}
+}
@@ -50,8 +50,11 @@ type Desc interface {
 	SetInfoSize(int)
 	TotalSize() int
 	GetMetaBuf() []byte
+	GetChecksum() uint32
 	IsFlush() bool
 	IsCheckpoint() bool
+	IsCompressed() bool
+	IsEncrypted() bool
 }
 
 type Entry interface {
@@ -66,6 +69,11 @@ type Entry interface {
 	UnmarshalFromNode(*common.MemNode, bool) error
 	ReadFrom(io.Reader) (int64, error)
 	WriteTo(io.Writer) (int64, error)
+	VerifyChecksum() error
+	Compress(minSize int) error
+	Decompress() error
+	Encrypt(KeyProvider) error
+	Decrypt(KeyProvider) error
 
 	WaitDone() error
 	DoneWithErr(error)
@@ -0,0 +1,184 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/objstore"
+)
+
+var archiveSuffix = ".archive"
+
+// Archiver copies a sealed file covered by the checkpoints before it is
+// removed from the store
+type Archiver interface {
+	// Archive copies the file of name read from r and returns where it is
+	// archived
+	Archive(name string, r io.Reader) (location string, err error)
+}
+
+// ArchivedFile maps a removed file of the store to its archived copy
+type ArchivedFile struct {
+	Name     string `json:"name"`
+	Version  int    `json:"version"`
+	Location string `json:"location"`
+}
+
+type fsArchiver struct {
+	dir string
+}
+
+// NewFSArchiver archives the files to dir
+func NewFSArchiver(dir string) *fsArchiver {
+	return &fsArchiver{dir: dir}
+}
+
+func (a *fsArchiver) Archive(name string, r io.Reader) (location string, err error) {
+	if err = os.MkdirAll(a.dir, 0755); err != nil {
+		return
+	}
+	location = filepath.Join(a.dir, name)
+	tmp := location + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return
+	}
+	if _, err = io.Copy(f, r); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return
+	}
+	err = os.Rename(tmp, location)
+	return
+}
+
+type objectArchiver struct {
+	store  objstore.ObjectStore
+	prefix string
+}
+
+// NewObjectArchiver archives the files to store under prefix
+func NewObjectArchiver(store objstore.ObjectStore, prefix string) *objectArchiver {
+	return &objectArchiver{
+		store:  store,
+		prefix: prefix,
+	}
+}
+
+func (a *objectArchiver) Archive(name string, r io.Reader) (location string, err error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return
+	}
+	location = a.prefix + name
+	err = a.store.Put(location, data)
+	return
+}
+
+// archiveLog records the archived files of a store one JSON line per file
+type archiveLog struct {
+	sync.RWMutex
+	archiver Archiver
+	name     string
+	files    []ArchivedFile
+}
+
+func openArchiveLog(dir, name string, archiver Archiver) (*archiveLog, error) {
+	l := &archiveLog{
+		archiver: archiver,
+		name:     filepath.Join(dir, name+archiveSuffix),
+	}
+	data, err := ioutil.ReadFile(l.name)
+	if os.IsNotExist(err) {
+		return l, nil
+	} else if err != nil {
+		return nil, err
+	}
+	offset := 0
+	for offset < len(data) {
+		end := bytes.IndexByte(data[offset:], '\n')
+		if end < 0 {
+			break
+		}
+		var file ArchivedFile
+		if err = json.Unmarshal(data[offset:offset+end], &file); err != nil {
+			break
+		}
+		l.files = append(l.files, file)
+		offset += end + 1
+	}
+	// The line torn by a crash is dropped for the later lines
+	if offset < len(data) {
+		err = os.Truncate(l.name, int64(offset))
+	}
+	return l, err
+}
+
+// archive copies vf by the archiver and records where it is archived
+func (l *archiveLog) archive(vf VFile) error {
+	f, err := os.Open(vf.Name())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	file := ArchivedFile{
+		Name:    filepath.Base(vf.Name()),
+		Version: vf.Id(),
+	}
+	if file.Location, err = l.archiver.Archive(file.Name, f); err != nil {
+		return err
+	}
+	buf, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	l.Lock()
+	defer l.Unlock()
+	w, err := os.OpenFile(l.name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(append(buf, '\n')); err == nil {
+		err = w.Sync()
+	}
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	l.files = append(l.files, file)
+	return nil
+}
+
+func (l *archiveLog) archived() []ArchivedFile {
+	l.RLock()
+	defer l.RUnlock()
+	files := make([]ArchivedFile, len(l.files))
+	copy(files, l.files)
+	return files
+}
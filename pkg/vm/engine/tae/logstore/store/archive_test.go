@@ -0,0 +1,72 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/objstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive(t *testing.T) {
+	dir := "/tmp/logstore/testarchive"
+	os.RemoveAll(dir)
+	assert.Nil(t, os.MkdirAll(dir, 0755))
+	vf, err := newVFile(nil, MakeVersionFile(dir, "mock", 1), 1, nil, nil)
+	assert.Nil(t, err)
+	defer vf.Close()
+	data := []byte("sealed entries")
+	_, err = vf.WriteAt(data, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, vf.Sync())
+
+	archiveDir := filepath.Join(dir, "archive")
+	l, err := openArchiveLog(dir, "mock", NewFSArchiver(archiveDir))
+	assert.Nil(t, err)
+	assert.Nil(t, l.archive(vf))
+	files := l.archived()
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, 1, files[0].Version)
+	archived, err := ioutil.ReadFile(files[0].Location)
+	assert.Nil(t, err)
+	assert.Equal(t, data, archived)
+
+	mem := objstore.NewMemStore()
+	l, err = openArchiveLog(dir, "mock", NewObjectArchiver(mem, "wal/"))
+	assert.Nil(t, err)
+	assert.Equal(t, files, l.archived())
+	assert.Nil(t, l.archive(vf))
+	archived, err = mem.Get("wal/" + filepath.Base(vf.Name()))
+	assert.Nil(t, err)
+	assert.Equal(t, data, archived)
+
+	// A torn line is dropped on open and the later lines are kept
+	f, err := os.OpenFile(l.name, os.O_APPEND|os.O_WRONLY, 0644)
+	assert.Nil(t, err)
+	_, err = f.Write([]byte(`{"name":`))
+	assert.Nil(t, err)
+	f.Close()
+	l, err = openArchiveLog(dir, "mock", NewFSArchiver(archiveDir))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(l.archived()))
+	assert.Nil(t, l.archive(vf))
+	l, err = openArchiveLog(dir, "mock", NewFSArchiver(archiveDir))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(l.archived()))
+}
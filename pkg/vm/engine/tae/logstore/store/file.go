@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
@@ -77,18 +78,28 @@ type rotateFile struct {
 	wg sync.WaitGroup
 
 	bsInfo *storeInfo
+	// readOnly is true if the files are written by another store
+	readOnly bool
 }
 
 func OpenRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChecker,
 	historyFactory HistoryFactory, bsInfo *storeInfo) (*rotateFile, error) {
+	return openRotateFile(dir, name, mu, rotateChecker, historyFactory, bsInfo, false)
+}
+
+// openRotateFile opens the files of dir. If readOnly, the files are opened
+// read only and none is created
+func openRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChecker,
+	historyFactory HistoryFactory, bsInfo *storeInfo, readOnly bool) (*rotateFile, error) {
 	var err error
 	if mu == nil {
 		mu = new(sync.RWMutex)
 	}
 	newDir := false
 	if _, err = os.Stat(dir); os.IsNotExist(err) {
-		err = os.MkdirAll(dir, 0755)
-		if err != nil {
+		if readOnly {
+			err = nil
+		} else if err = os.MkdirAll(dir, 0755); err != nil {
 			return nil, err
 		}
 		newDir = true
@@ -110,6 +121,11 @@ func OpenRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChec
 		commitQueue: make(chan *vFile, 10000),
 		history:     historyFactory(),
 		bsInfo:      bsInfo,
+		readOnly:    readOnly,
+	}
+	flag := os.O_RDWR
+	if readOnly {
+		flag = os.O_RDONLY
 	}
 	if !newDir {
 		files, err := ioutil.ReadDir(dir)
@@ -123,7 +139,7 @@ func OpenRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChec
 				continue
 			}
 			file, err := os.OpenFile(
-				path.Join(dir, f.Name()), os.O_RDWR, os.ModePerm)
+				path.Join(dir, f.Name()), flag, os.ModePerm)
 			if err != nil {
 				return nil, err
 			}
@@ -135,6 +151,8 @@ func OpenRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChec
 				history:    rf.history,
 				size:       int(f.Size()),
 				syncpos:    int(f.Size()),
+				createTime: f.ModTime(),
+				readOnly:   readOnly,
 			}
 			vf.vInfo = newVInfo(vf)
 			// vf.ReadMeta()
@@ -144,16 +162,17 @@ func OpenRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChec
 			return vfiles[i].(*vFile).version < vfiles[j].(*vFile).version
 		})
 		if len(vfiles) == 0 {
-			err = rf.scheduleNew()
-			if err != nil {
-				return nil, err
+			if !readOnly {
+				if err = rf.scheduleNew(); err != nil {
+					return nil, err
+				}
 			}
 		} else {
 			rf.history.Extend(vfiles[:len(vfiles)-1]...)
 			rf.uncommitted = append(
 				rf.uncommitted, vfiles[len(vfiles)-1].(*vFile))
 		}
-	} else {
+	} else if !readOnly {
 		err = rf.scheduleNew()
 	}
 	rf.commitCtx, rf.commitCancel = context.WithCancel(context.Background())
@@ -163,20 +182,19 @@ func OpenRotateFile(dir, name string, mu *sync.RWMutex, rotateChecker RotateChec
 }
 
 func (rf *rotateFile) Replay(r *replayer, o ReplayObserver) error {
-	err := rf.history.Replay(r, o)
-	if err != nil {
-		return err
+	ids := rf.history.EntryIds()
+	files := make([]*vFile, 0, len(ids)+len(rf.uncommitted))
+	for _, id := range ids {
+		files = append(files, rf.history.GetEntry(id).(*vFile))
 	}
-	for _, vf := range rf.uncommitted {
-		err = vf.Replay(r, vf)
-		if err != nil {
-			return nil
-		}
-	}
-	return nil
+	files = append(files, rf.uncommitted...)
+	return r.replayFiles(files, o)
 }
 
 func (rf *rotateFile) TryTruncate(size int64) error {
+	if rf.readOnly {
+		return ErrReadOnly
+	}
 	l := len(rf.uncommitted)
 	if l == 0 {
 		return errors.New("all files committed")
@@ -189,6 +207,26 @@ func (rf *rotateFile) TryTruncate(size int64) error {
 	return err
 }
 
+func (rf *rotateFile) Usage() (size int64, oldest time.Time) {
+	add := func(vf *vFile) {
+		size += int64(vf.SizeLocked())
+		if oldest.IsZero() || vf.createTime.Before(oldest) {
+			oldest = vf.createTime
+		}
+	}
+	for _, id := range rf.history.EntryIds() {
+		if vf := rf.history.GetEntry(id); vf != nil {
+			add(vf.(*vFile))
+		}
+	}
+	rf.RLock()
+	defer rf.RUnlock()
+	for _, vf := range rf.uncommitted {
+		add(vf)
+	}
+	return
+}
+
 func (rf *rotateFile) commitLoop() {
 	defer rf.wg.Done()
 	for {
@@ -256,6 +294,9 @@ func (rf *rotateFile) makeSpace(size int) (rotated *vFile, curr *vFileState, err
 	if err != nil {
 		return nil, nil, err
 	}
+	if rf.readOnly {
+		return nil, nil, ErrReadOnly
+	}
 	if l == 0 || rotNeeded {
 		if rotNeeded {
 			rotated = rf.uncommitted[l-1]
@@ -315,6 +356,21 @@ func (rf *rotateFile) Sync() error {
 	return lastFile.Sync()
 }
 
+// WriteBuf writes the buffered entries of the uncommitted files to the
+// OS without the fsync
+func (rf *rotateFile) WriteBuf() error {
+	rf.RLock()
+	files := make([]*vFile, len(rf.uncommitted))
+	copy(files, rf.uncommitted)
+	rf.RUnlock()
+	for _, f := range files {
+		if err := f.WriteBuf(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (rf *rotateFile) Load(ver int, groupId uint32, lsn uint64) (entry.Entry, error) {
 	vf, err := rf.GetEntryByVersion(ver)
 	if err != nil {
@@ -35,6 +35,10 @@ var (
 type history struct {
 	mu      *sync.RWMutex
 	entries []VFile
+	// archive is called on the entries to delete before they are removed
+	archive func(VFile) error
+	// retain keeps the entries it returns true for from the truncation
+	retain func(VFile) bool
 }
 
 func newHistory(mu *sync.RWMutex) *history {
@@ -102,6 +106,22 @@ func (h *history) DropEntry(id int) (VFile, error) {
 	return entry, entry.Destroy()
 }
 
+// SetArchiveHook sets the hook called on the entries truncated before they
+// are removed. An entry is not removed if the hook fails on it
+func (h *history) SetArchiveHook(hook func(VFile) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.archive = hook
+}
+
+// SetRetainHook sets the hook keeping the entries it returns true for
+// from the truncation
+func (h *history) SetRetainHook(hook func(VFile) bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.retain = hook
+}
+
 func (h *history) Extend(entries ...VFile) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -147,6 +167,7 @@ func (h *history) TryTruncate() error {
 	for i, entry := range h.entries {
 		entries[i] = entry
 	}
+	retain, archive := h.retain, h.archive
 	h.mu.RUnlock()
 	for i := len(entries) - 1; i >= 0; i-- {
 		e := entries[i]
@@ -159,12 +180,19 @@ func (h *history) TryTruncate() error {
 			return err
 		}
 		wrapper := entryWrapper{entry: e}
-		if e.IsToDelete(c) {
+		if e.IsToDelete(c) && (retain == nil || !retain(e)) {
 			wrapper.offset = i
 			toDelete = append(toDelete, wrapper)
 		}
 		// e.FreeMeta()
 	}
+	if archive != nil {
+		for _, wrapper := range toDelete {
+			if err := archive(wrapper.entry); err != nil {
+				return err
+			}
+		}
+	}
 	h.mu.Lock()
 	for _, wrapper := range toDelete {
 		h.entries = append(h.entries[:wrapper.offset], h.entries[wrapper.offset+1:]...)
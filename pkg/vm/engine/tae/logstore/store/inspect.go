@@ -0,0 +1,211 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+)
+
+// ErrStopWalk stops WalkEntries without an error if returned by fn
+var ErrStopWalk = errors.New("tae: stop walk")
+
+// EntryInfo describes an entry of the store walked by WalkEntries
+type EntryInfo struct {
+	File    string
+	Version int
+	Offset  int
+	// Size is the size of the entry as written
+	Size       int
+	Type       entry.Type
+	Group      uint32
+	LSN        uint64
+	TxnId      uint64
+	Compressed bool
+	Encrypted  bool
+	// Checkpoints are the ranges checkpointed by a checkpoint entry
+	Checkpoints []entry.CkpRanges
+	// Checkpointed is true if the entry is covered by a checkpoint entry
+	// of the store
+	Checkpointed bool
+	// Err is why the entry cannot be decoded. The later entries of the
+	// file are not walked
+	Err error
+}
+
+func (info *EntryInfo) String() string {
+	s := fmt.Sprintf("%s@%d size=%d type=%d group=%d lsn=%d txn=%d",
+		filepath.Base(info.File), info.Offset, info.Size, info.Type,
+		info.Group, info.LSN, info.TxnId)
+	if info.Compressed {
+		s += " compressed"
+	}
+	if info.Encrypted {
+		s += " encrypted"
+	}
+	if info.Checkpointed {
+		s += " checkpointed"
+	}
+	for _, ckp := range info.Checkpoints {
+		s += fmt.Sprintf(" ckp=%s", ckp.String())
+	}
+	if info.Err != nil {
+		s += fmt.Sprintf(" err=%v", info.Err)
+	}
+	return s
+}
+
+// WalkEntries calls fn on the entries of the store of name in dir in the
+// order they are written without applying them. The files are only read,
+// so it is safe on the store of a running db. keys decrypt the infos of
+// the encrypted entries and may be nil. It stops at the first error of fn
+func WalkEntries(dir, name string, keys entry.KeyProvider, fn func(*EntryInfo) error) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	type versionFile struct {
+		name    string
+		version int
+	}
+	vfiles := make([]versionFile, 0, len(files))
+	for _, f := range files {
+		version, err := ParseVersion(f.Name(), name, suffix)
+		if err != nil {
+			continue
+		}
+		vfiles = append(vfiles, versionFile{filepath.Join(dir, f.Name()), version})
+	}
+	sort.Slice(vfiles, func(i, j int) bool {
+		return vfiles[i].version < vfiles[j].version
+	})
+
+	// The coverage of the checkpoints is known after all the entries
+	infos := make([]*EntryInfo, 0)
+	checkpointed := make(map[uint32]*common.ClosedIntervals)
+	for _, vf := range vfiles {
+		err = walkFile(vf.name, vf.version, keys, func(info *EntryInfo) {
+			infos = append(infos, info)
+			for _, ckp := range info.Checkpoints {
+				if ckp.Ranges == nil {
+					continue
+				}
+				if intervals, ok := checkpointed[ckp.Group]; ok {
+					intervals.TryMerge(*ckp.Ranges)
+				} else {
+					checkpointed[ckp.Group] = common.NewClosedIntervalsByIntervals(ckp.Ranges)
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, info := range infos {
+		if intervals, ok := checkpointed[info.Group]; ok && info.Err == nil {
+			info.Checkpointed = intervals.ContainsInterval(
+				common.ClosedInterval{Start: info.LSN, End: info.LSN})
+		}
+		if err = fn(info); err == ErrStopWalk {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkFile(name string, version int, keys entry.KeyProvider, fn func(*EntryInfo)) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := int(stat.Size())
+	for offset := 0; offset < size; {
+		info := &EntryInfo{
+			File:    name,
+			Version: version,
+			Offset:  offset,
+		}
+		e := entry.GetBase()
+		inspectEntry(f, offset, size, keys, e, info)
+		e.Free()
+		fn(info)
+		if info.Err != nil {
+			break
+		}
+		offset += info.Size
+	}
+	return nil
+}
+
+// inspectEntry decodes the entry at offset of f into info. A malformed info
+// is reported by info.Err instead of a panic
+func inspectEntry(f *os.File, offset, size int, keys entry.KeyProvider, e *entry.Base, info *EntryInfo) {
+	defer func() {
+		if r := recover(); r != nil {
+			info.Err = fmt.Errorf("tae: corrupted entry info: %v", r)
+		}
+	}()
+	var err error
+	if _, err = f.ReadAt(e.GetMetaBuf(), int64(offset)); err != nil {
+		info.Err = err
+		return
+	}
+	info.Size = e.TotalSize()
+	info.Type = e.GetType()
+	info.Compressed = e.IsCompressed()
+	info.Encrypted = e.IsEncrypted()
+	if offset+info.Size > size {
+		info.Err = io.ErrUnexpectedEOF
+		return
+	}
+	if _, err = e.ReadAt(f, offset); err != nil {
+		info.Err = err
+		return
+	}
+	if err = e.VerifyChecksum(); err != nil {
+		info.Err = err
+		return
+	}
+	if info.Encrypted {
+		if err = e.Decrypt(keys); err != nil {
+			info.Err = err
+			return
+		}
+	}
+	if len(e.GetInfoBuf()) == 0 {
+		return
+	}
+	v := entry.Unmarshal(e.GetInfoBuf())
+	info.Group = v.Group
+	info.LSN = v.GroupLSN
+	info.TxnId = v.TxnId
+	info.Checkpoints = v.Checkpoints
+	return
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+)
+
+// SyncPolicy is how the entries of a group are made durable
+type SyncPolicy int8
+
+const (
+	// SyncOnCommit makes an entry durable before it is done
+	SyncOnCommit SyncPolicy = iota
+	// SyncInterval makes an entry done once it is written. The written
+	// entries are synced every sync interval
+	SyncInterval
+	// SyncNone makes an entry done once it is written and leaves the sync
+	// to the OS, Flush or the sync of another entry
+	SyncNone
+)
+
+var (
+	DefaultSyncInterval = time.Second
+)
+
+// SetSyncPolicy sets the sync policy of the entries of group appended
+// later
+func (bs *baseStore) SetSyncPolicy(group uint32, policy SyncPolicy) {
+	bs.policyMu.Lock()
+	defer bs.policyMu.Unlock()
+	bs.policies[group] = policy
+}
+
+// getSyncPolicy returns the policy of e. A flush entry is always synced
+func (bs *baseStore) getSyncPolicy(e entry.Entry) SyncPolicy {
+	if e.IsFlush() {
+		return SyncOnCommit
+	}
+	info := e.GetInfo()
+	if info == nil {
+		return bs.defaultPolicy
+	}
+	bs.policyMu.RLock()
+	defer bs.policyMu.RUnlock()
+	policy, ok := bs.policies[info.(*entry.Info).Group]
+	if !ok {
+		return bs.defaultPolicy
+	}
+	return policy
+}
+
+// Flush makes the entries appended before durable whatever the sync
+// policies of their groups
+func (bs *baseStore) Flush() error {
+	e := entry.GetBase()
+	defer e.Free()
+	e.SetType(entry.ETFlush)
+	if err := e.Unmarshal(make([]byte, 0)); err != nil {
+		return err
+	}
+	if _, err := bs.AppendEntry(entry.GTNoop, e); err != nil {
+		return err
+	}
+	return e.WaitDone()
+}
@@ -19,10 +19,31 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
 )
 
+var DefaultReplayWorkers = 4
+
+// ReplayProgress is the progress of a replay
+type ReplayProgress struct {
+	// Files is the number of the files to replay
+	Files int
+	// Replayed is the number of the files replayed
+	Replayed int
+	// Entries is the number of the entries replayed
+	Entries int
+	// Bytes is the size of the entries replayed
+	Bytes int64
+}
+
+// ProgressObserver is notified of the progress of a replay once a file is
+// replayed
+type ProgressObserver interface {
+	OnReplayProgress(ReplayProgress)
+}
+
 type noopObserver struct {
 }
 
@@ -41,8 +62,17 @@ type replayer struct {
 	entrys          []*replayEntry
 	checkpointrange map[uint32]*common.ClosedIntervals
 	checkpoints     []*replayEntry
-	mergeFuncs      map[uint32]func(pre, curr []byte) []byte
+	mergeFuncs      map[uint32]MergeFunc
 	applyEntry      ApplyHandle
+	// keys decrypt the encrypted entries
+	keys entry.KeyProvider
+	// workers is the number of the files decoded in parallel
+	workers  int
+	progress ProgressObserver
+	// salvage skips the entries failed to replay after logging them
+	salvage bool
+	// file is the name of the file replayed
+	file string
 
 	//syncbase
 	addrs    map[uint32]map[int]common.ClosedInterval
@@ -71,7 +101,7 @@ func (r *replayer) updateaddrs(groupId uint32, version int, lsn uint64) {
 	}
 	interval, ok := m[version]
 	if !ok {
-		interval = common.ClosedInterval{}
+		interval = common.ClosedInterval{Start: lsn, End: lsn}
 	}
 	interval.TryMerge(common.ClosedInterval{Start: lsn, End: lsn})
 	m[version] = interval
@@ -90,7 +120,7 @@ func newReplayer(h ApplyHandle) *replayer {
 		entrys:          make([]*replayEntry, 0),
 		checkpointrange: make(map[uint32]*common.ClosedIntervals),
 		checkpoints:     make([]*replayEntry, 0),
-		mergeFuncs:      make(map[uint32]func(pre []byte, curr []byte) []byte),
+		mergeFuncs:      make(map[uint32]MergeFunc),
 		applyEntry:      h,
 		addrs:           make(map[uint32]map[int]common.ClosedInterval),
 		groupLSN:        make(map[uint32]uint64),
@@ -98,6 +128,10 @@ func newReplayer(h ApplyHandle) *replayer {
 	}
 }
 
+// MergeFunc merges the payload of an uncommitted entry or the txn entry of
+// a txn into the merged payload of the entries before it
+type MergeFunc = func(pre, curr []byte) []byte
+
 func defaultMergePayload(pre, curr []byte) []byte {
 	return append(pre, curr...)
 }
@@ -113,11 +147,12 @@ func (r *replayer) mergeUncommittedEntries(pre, curr *replayEntry) *replayEntry
 	return curr
 }
 
-func (r *replayer) Apply() {
+// Apply applies the entries replayed. It stops at the first entry failed
+// unless in the salvage mode
+func (r *replayer) Apply() error {
 	for _, e := range r.checkpoints {
-		err := r.applyEntry(e.group, e.commitId, e.payload, e.entryType, e.info)
-		if err != nil {
-			panic(err)
+		if err := r.apply(e, e.info); err != nil {
+			return err
 		}
 	}
 
@@ -130,33 +165,72 @@ func (r *replayer) Apply() {
 			}
 		}
 		if e.entryType == entry.ETTxn {
-			// var pre *replayEntry
-			tidMap, ok := r.uncommit[e.group]
-			if ok {
-				entries, ok := tidMap[e.tid]
-				if ok {
-					for _, entry := range entries {
-						err := r.applyEntry(entry.group, entry.commitId, entry.payload, entry.entryType, nil)
-						if err != nil {
-							panic(err)
-						}
-						// pre = r.mergeUncommittedEntries(
-						// 	pre, entry)
+			entries := r.uncommit[e.group][e.tid]
+			if _, ok := r.mergeFuncs[e.group]; ok {
+				// The uncommitted entries are merged with the txn entry into
+				// one payload
+				var pre *replayEntry
+				for _, entry := range entries {
+					pre = r.mergeUncommittedEntries(pre, entry)
+				}
+				e = r.mergeUncommittedEntries(pre, e)
+			} else {
+				for _, entry := range entries {
+					if err := r.apply(entry, nil); err != nil {
+						return err
 					}
 				}
 			}
-			// e = r.mergeUncommittedEntries(pre, e)
-			err := r.applyEntry(e.group, e.commitId, e.payload, e.entryType, nil)
-			if err != nil {
-				panic(err)
-			}
-		} else {
-			err := r.applyEntry(e.group, e.commitId, e.payload, e.entryType, nil)
-			if err != nil {
-				panic(err)
-			}
+		}
+		if err := r.apply(e, nil); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+// apply applies e by the ApplyHandle. The error or the panic of it is
+// returned as a ReplayError, which is logged and skipped in the salvage
+// mode
+func (r *replayer) apply(e *replayEntry, info interface{}) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic: %v", v)
+		}
+		if err == nil {
+			return
+		}
+		err = &ReplayError{
+			Group:  e.group,
+			LSN:    e.commitId,
+			File:   e.file,
+			Offset: e.offset,
+			Err:    err,
+		}
+		if r.salvage {
+			logutil.Warnf("%v: skipped", err)
+			err = nil
+		}
+	}()
+	return r.applyEntry(e.group, e.commitId, e.payload, e.entryType, info)
+}
+
+// ReplayError is an entry failed to replay
+type ReplayError struct {
+	Group  uint32
+	LSN    uint64
+	File   string
+	Offset int
+	Err    error
+}
+
+func (e *ReplayError) Error() string {
+	return fmt.Sprintf("tae: replay entry %d-%d at %d of %s: %v",
+		e.Group, e.LSN, e.Offset, e.File, e.Err)
+}
+
+func (e *ReplayError) Unwrap() error {
+	return e.Err
 }
 
 type replayEntry struct {
@@ -168,6 +242,9 @@ type replayEntry struct {
 	// checkpointRange *common.ClosedInterval
 	payload []byte
 	info    interface{}
+	// file and offset are where the entry is written
+	file   string
+	offset int
 }
 
 func (r *replayEntry) String() string {
@@ -196,6 +273,8 @@ func (r *replayer) onReplayEntry(e entry.Entry, vf ReplayObserver) error {
 			Offset:  r.state.pos,
 		}
 		replayEty := &replayEntry{
+			file:      r.file,
+			offset:    r.state.pos,
 			entryType: typ,
 			payload:   make([]byte, e.GetPayloadSize()),
 			info:      info,
@@ -226,17 +305,22 @@ func (r *replayer) onReplayEntry(e entry.Entry, vf ReplayObserver) error {
 			if !ok {
 				tidMap = make(map[uint64][]*replayEntry)
 			}
-			entries, ok := tidMap[info.TxnId]
+			entries, ok := tidMap[tinfo.Tid]
 			if !ok {
 				entries = make([]*replayEntry, 0)
 			}
 			replayEty := &replayEntry{
-				payload: make([]byte, e.GetPayloadSize()),
+				file:      r.file,
+				offset:    r.state.pos,
+				entryType: typ,
+				group:     tinfo.Group,
+				tid:       tinfo.Tid,
+				payload:   make([]byte, e.GetPayloadSize()),
 				// info:    addr,
 			}
 			copy(replayEty.payload, e.GetPayload())
 			entries = append(entries, replayEty)
-			tidMap[info.TxnId] = entries
+			tidMap[tinfo.Tid] = entries
 			r.uncommit[tinfo.Group] = tidMap
 		}
 	case entry.ETTxn:
@@ -253,6 +337,8 @@ func (r *replayer) onReplayEntry(e entry.Entry, vf ReplayObserver) error {
 			Offset:  r.state.pos,
 		}
 		replayEty := &replayEntry{
+			file:      r.file,
+			offset:    r.state.pos,
 			entryType: e.GetType(),
 			group:     info.Group,
 			commitId:  info.GroupLSN,
@@ -276,6 +362,8 @@ func (r *replayer) onReplayEntry(e entry.Entry, vf ReplayObserver) error {
 			Offset:  r.state.pos,
 		}
 		replayEty := &replayEntry{
+			file:      r.file,
+			offset:    r.state.pos,
 			entryType: e.GetType(),
 			group:     info.Group,
 			commitId:  info.GroupLSN,
@@ -288,53 +376,193 @@ func (r *replayer) onReplayEntry(e entry.Entry, vf ReplayObserver) error {
 	return nil
 }
 
-func (r *replayer) replayHandler(v VFile, o ReplayObserver) error {
-	vfile := v.(*vFile)
-	if vfile.version != r.version {
-		r.state.pos = 0
-	}
-	current := vfile.GetState()
-	entry := entry.GetBase()
-	defer entry.Free()
+// readEntry reads and decodes the entry at pos of vf. A torn entry at the
+// end of vf is truncated and io.EOF is returned
+func (r *replayer) readEntry(vf *vFile, pos int) (e *entry.Base, size int, err error) {
+	current := vf.GetState()
+	e = entry.GetBase()
+	defer func() {
+		if err != nil {
+			e.Free()
+			e = nil
+		}
+	}()
 
-	metaBuf := entry.GetMetaBuf()
-	_, err := vfile.Read(metaBuf)
+	metaBuf := e.GetMetaBuf()
+	if _, err = vf.Read(metaBuf); err != nil {
+		if errors.Is(err, io.EOF) {
+			vf.truncate(pos)
+		}
+		return
+	}
+	n, err := e.ReadFrom(vf)
 	if err != nil {
-		if !errors.Is(err, io.EOF) {
-			return err
+		if errors.Is(err, io.EOF) {
+			vf.truncate(pos)
+		}
+		return
+	}
+	if int(n) != e.TotalSizeExpectMeta() {
+		if current.pos == pos+int(n) {
+			vf.truncate(pos)
+			err = io.EOF
+		} else {
+			err = fmt.Errorf("payload mismatch: %d != %d", n, e.GetPayloadSize())
 		}
-		err2 := vfile.Truncate(int64(r.state.pos))
-		if err2 != nil {
-			panic(err2)
+		return
+	}
+	size = e.TotalSize()
+	if err = e.VerifyChecksum(); err != nil {
+		// A torn write leaves a corrupted entry only at the end of the file
+		if pos+size >= current.pos {
+			vf.truncate(pos)
+			err = io.EOF
+		} else {
+			err = &CorruptionError{File: vf.Name(), Offset: pos}
 		}
-		return err
+		return
 	}
+	if err = e.Decrypt(r.keys); err != nil {
+		return
+	}
+	err = e.Decompress()
+	return
+}
 
-	n, err := entry.ReadFrom(vfile)
-	if err != nil {
-		if !errors.Is(err, io.EOF) {
-			return err
+// CorruptionError is returned on replay for a corrupted entry followed by
+// other entries, which cannot be a torn write
+type CorruptionError struct {
+	File   string
+	Offset int
+}
+
+func (e *CorruptionError) Error() string {
+	return fmt.Sprintf("tae: corrupted entry at %d of %s", e.Offset, e.File)
+}
+
+func (e *CorruptionError) Unwrap() error {
+	return entry.ErrChecksumMismatch
+}
+
+// safeReplayEntry returns the panic of onReplayEntry on a malformed entry
+// as an error
+func (r *replayer) safeReplayEntry(e entry.Entry, vf ReplayObserver) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic: %v", v)
+		}
+	}()
+	return r.onReplayEntry(e, vf)
+}
+
+// decodedEntry is an entry decoded with its offset in the file
+type decodedEntry struct {
+	e    *entry.Base
+	pos  int
+	size int
+}
+
+// decodedFile is the entries of a file decoded by a replay worker
+type decodedFile struct {
+	vf      *vFile
+	entries []decodedEntry
+	err     error
+	done    chan struct{}
+}
+
+func (df *decodedFile) free() {
+	for _, de := range df.entries {
+		de.e.Free()
+	}
+	df.entries = nil
+}
+
+func (r *replayer) decode(df *decodedFile) {
+	defer close(df.done)
+	pos := 0
+	for {
+		e, size, err := r.readEntry(df.vf, pos)
+		var corruption *CorruptionError
+		if r.salvage && errors.As(err, &corruption) {
+			logutil.Warnf("%v: skipped", err)
+			pos += size
+			continue
 		}
-		err2 := vfile.Truncate(int64(r.state.pos))
-		if err2 != nil {
-			panic(err2)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				df.err = err
+			}
+			return
 		}
-		return err
+		df.entries = append(df.entries, decodedEntry{e: e, pos: pos, size: size})
+		pos += size
 	}
-	if int(n) != entry.TotalSizeExpectMeta() {
-		if current.pos == r.state.pos+int(n) {
-			err2 := vfile.Truncate(int64(current.pos))
-			if err2 != nil {
-				return err
+}
+
+// replayFiles reads and decodes the files by the workers in parallel and
+// replays the decoded entries file by file in order, which keeps the
+// entries of a group in the order of the group LSN. At most workers files
+// are decoded and not replayed at a time
+func (r *replayer) replayFiles(files []*vFile, o ReplayObserver) (err error) {
+	workers := r.workers
+	if workers <= 0 {
+		workers = DefaultReplayWorkers
+	}
+	pending := make(chan *decodedFile, workers)
+	tokens := make(chan struct{}, workers)
+	stop := make(chan struct{})
+	go func() {
+		defer close(pending)
+		for _, vf := range files {
+			select {
+			case tokens <- struct{}{}:
+			case <-stop:
+				return
 			}
-			return io.EOF
-		} else {
-			return fmt.Errorf("payload mismatch: %d != %d", n, entry.GetPayloadSize())
+			df := &decodedFile{vf: vf, done: make(chan struct{})}
+			go r.decode(df)
+			pending <- df
+		}
+	}()
+	progress := ReplayProgress{Files: len(files)}
+	for df := range pending {
+		<-df.done
+		if err == nil {
+			if err = df.err; err == nil {
+				err = r.replayDecoded(df, o, &progress)
+			}
+			if err != nil {
+				close(stop)
+			}
+		}
+		df.free()
+		<-tokens
+	}
+	return
+}
+
+func (r *replayer) replayDecoded(df *decodedFile, o ReplayObserver, progress *ReplayProgress) error {
+	vf := df.vf
+	o.OnNewEntry(vf.Id())
+	r.version = vf.version
+	r.file = vf.Name()
+	for _, de := range df.entries {
+		r.state.pos = de.pos
+		if err := r.safeReplayEntry(de.e, vf); err != nil {
+			rerr := &ReplayError{File: r.file, Offset: de.pos, Err: err}
+			if !r.salvage {
+				return rerr
+			}
+			logutil.Warnf("%v: skipped", rerr)
+			continue
 		}
+		progress.Entries++
+		progress.Bytes += int64(de.size)
 	}
-	if err = r.onReplayEntry(entry, o); err != nil {
-		return err
+	vf.OnReplay(r)
+	progress.Replayed++
+	if r.progress != nil {
+		r.progress.OnReplayProgress(*progress)
 	}
-	r.state.pos += entry.TotalSize()
 	return nil
 }
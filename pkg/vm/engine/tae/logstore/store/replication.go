@@ -0,0 +1,265 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	ErrSubscriptionClosed = errors.New("tae: subscription closed")
+	ErrEntryTruncated     = errors.New("tae: entry truncated")
+)
+
+// ReplicatedEntry is a committed entry sent to a follower
+type ReplicatedEntry struct {
+	Group   uint32
+	LSN     uint64
+	Type    uint16
+	Payload []byte
+}
+
+// EntrySource is where a follower reads the entries to apply. A transport
+// between the hosts implements it by a Subscription of the leader
+type EntrySource interface {
+	// Next blocks until the next entry is committed or ctx is done
+	Next(ctx context.Context) (*ReplicatedEntry, error)
+	// Ack acknowledges the entries till lsn are applied
+	Ack(lsn uint64)
+}
+
+// subscriptions are the subscriptions of a store and the notification of
+// the commits to them
+type subscriptions struct {
+	sync.RWMutex
+	subs      map[*Subscription]struct{}
+	committed chan struct{}
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		subs:      make(map[*Subscription]struct{}),
+		committed: make(chan struct{}),
+	}
+}
+
+func (s *subscriptions) waitCommitted() <-chan struct{} {
+	s.RLock()
+	defer s.RUnlock()
+	return s.committed
+}
+
+// notify wakes up the subscriptions waiting for the commits
+func (s *subscriptions) notify() {
+	s.Lock()
+	defer s.Unlock()
+	s.notifyLocked()
+}
+
+func (s *subscriptions) notifyLocked() {
+	if len(s.subs) == 0 {
+		return
+	}
+	close(s.committed)
+	s.committed = make(chan struct{})
+}
+
+// Subscription streams the committed entries of a group in the order of
+// the LSN. The files with the entries not acknowledged are not truncated
+type Subscription struct {
+	store  *baseStore
+	group  uint32
+	next   uint64
+	acked  uint64
+	closed int32
+}
+
+// Subscribe returns a subscription to the entries of group from the LSN
+// from. It returns ErrEntryTruncated if the entry of from is truncated
+func (bs *baseStore) Subscribe(group uint32, from uint64) (*Subscription, error) {
+	if from == 0 {
+		from = 1
+	}
+	sub := &Subscription{
+		store: bs,
+		group: group,
+		next:  from,
+		acked: from - 1,
+	}
+	bs.subs.Lock()
+	bs.subs.subs[sub] = struct{}{}
+	bs.subs.Unlock()
+	if from <= bs.GetSynced(group) {
+		e, err := bs.Load(group, from)
+		if e != nil {
+			e.Free()
+		}
+		if err != nil {
+			sub.Close()
+			return nil, ErrEntryTruncated
+		}
+	}
+	return sub, nil
+}
+
+// retained returns true if vf has the entries not acknowledged by a
+// subscription
+func (bs *baseStore) retained(vf VFile) bool {
+	bs.subs.RLock()
+	defer bs.subs.RUnlock()
+	if len(bs.subs.subs) == 0 {
+		return false
+	}
+	bs.addrmu.RLock()
+	defer bs.addrmu.RUnlock()
+	for sub := range bs.subs.subs {
+		interval, ok := bs.addrs[sub.group][vf.Id()]
+		if ok && interval.End > atomic.LoadUint64(&sub.acked) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the next committed entry of the group. It is not safe to
+// call it concurrently
+func (sub *Subscription) Next(ctx context.Context) (*ReplicatedEntry, error) {
+	for {
+		if atomic.LoadInt32(&sub.closed) == 1 || sub.store.IsClosed() {
+			return nil, ErrSubscriptionClosed
+		}
+		// Wait on the notification got before the check not to miss the
+		// commit in between
+		committed := sub.store.subs.waitCommitted()
+		if sub.next <= sub.store.GetSynced(sub.group) {
+			return sub.load()
+		}
+		select {
+		case <-committed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (sub *Subscription) load() (*ReplicatedEntry, error) {
+	e, err := sub.store.Load(sub.group, sub.next)
+	if err != nil {
+		if e != nil {
+			e.Free()
+		}
+		return nil, err
+	}
+	defer e.Free()
+	re := &ReplicatedEntry{
+		Group:   sub.group,
+		LSN:     sub.next,
+		Type:    e.GetType(),
+		Payload: make([]byte, len(e.GetPayload())),
+	}
+	copy(re.Payload, e.GetPayload())
+	sub.next++
+	return re, nil
+}
+
+// Ack releases the entries till lsn to the truncation
+func (sub *Subscription) Ack(lsn uint64) {
+	for {
+		acked := atomic.LoadUint64(&sub.acked)
+		if lsn <= acked || atomic.CompareAndSwapUint64(&sub.acked, acked, lsn) {
+			return
+		}
+	}
+}
+
+// Acked returns the LSN of the last entry acknowledged
+func (sub *Subscription) Acked() uint64 {
+	return atomic.LoadUint64(&sub.acked)
+}
+
+// Close releases the entries held by the subscription
+func (sub *Subscription) Close() {
+	if !atomic.CompareAndSwapInt32(&sub.closed, 0, 1) {
+		return
+	}
+	subs := sub.store.subs
+	subs.Lock()
+	defer subs.Unlock()
+	// Wake up Next blocked on the commits
+	subs.notifyLocked()
+	delete(subs.subs, sub)
+}
+
+// Follower applies the entries of a source by an ApplyHandle like a replay
+// and acknowledges them once applied
+type Follower struct {
+	src     EntrySource
+	apply   ApplyHandle
+	applied uint64
+	err     error
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func NewFollower(src EntrySource, apply ApplyHandle) *Follower {
+	f := &Follower{
+		src:   src,
+		apply: apply,
+	}
+	f.ctx, f.cancel = context.WithCancel(context.Background())
+	return f
+}
+
+func (f *Follower) Start() {
+	f.wg.Add(1)
+	go f.loop()
+}
+
+func (f *Follower) loop() {
+	defer f.wg.Done()
+	for {
+		e, err := f.src.Next(f.ctx)
+		if err != nil {
+			if f.ctx.Err() == nil {
+				f.err = err
+			}
+			return
+		}
+		if err = f.apply(e.Group, e.LSN, e.Payload, e.Type, nil); err != nil {
+			f.err = err
+			return
+		}
+		atomic.StoreUint64(&f.applied, e.LSN)
+		f.src.Ack(e.LSN)
+	}
+}
+
+// Applied returns the LSN of the last entry applied
+func (f *Follower) Applied() uint64 {
+	return atomic.LoadUint64(&f.applied)
+}
+
+// Stop stops the follower and returns the error it stopped on if any
+func (f *Follower) Stop() error {
+	f.cancel()
+	f.wg.Wait()
+	return f.err
+}
+
+var _ EntrySource = (*Subscription)(nil)
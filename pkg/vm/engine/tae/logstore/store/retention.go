@@ -0,0 +1,141 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+)
+
+var (
+	ErrRetentionExceeded = errors.New("tae: wal retention exceeded")
+)
+
+var (
+	DefaultRetentionCheckInterval = time.Second
+)
+
+// RetentionAction is what is done once the files not truncated exceed
+// MaxTotalSize or MaxAge
+type RetentionAction int8
+
+const (
+	// RetentionCheckpoint calls ForceCheckpoint and compacts the files
+	// checkpointed
+	RetentionCheckpoint RetentionAction = iota
+	// RetentionBlock fails the appends of the groups other than the
+	// checkpoints until the files are truncated
+	RetentionBlock
+)
+
+// RetentionError is returned by the appends blocked by the retention
+type RetentionError struct {
+	Size         int64
+	MaxTotalSize int64
+	Age          time.Duration
+	MaxAge       time.Duration
+}
+
+func (e *RetentionError) Error() string {
+	return fmt.Sprintf("tae: wal of %d bytes and %v exceeds %d bytes or %v",
+		e.Size, e.Age, e.MaxTotalSize, e.MaxAge)
+}
+
+func (e *RetentionError) Unwrap() error {
+	return ErrRetentionExceeded
+}
+
+type retention struct {
+	maxTotalSize    int64
+	maxAge          time.Duration
+	action          RetentionAction
+	forceCheckpoint func()
+}
+
+func (r *retention) enabled() bool {
+	return r.maxTotalSize > 0 || r.maxAge > 0
+}
+
+// check returns a RetentionError if the files of size and of the oldest
+// one created at oldest exceed the limits
+func (r *retention) check(size int64, oldest time.Time) error {
+	var age time.Duration
+	if !oldest.IsZero() {
+		age = time.Since(oldest)
+	}
+	if (r.maxTotalSize > 0 && size > r.maxTotalSize) ||
+		(r.maxAge > 0 && age > r.maxAge) {
+		return &RetentionError{
+			Size:         size,
+			MaxTotalSize: r.maxTotalSize,
+			Age:          age,
+			MaxAge:       r.maxAge,
+		}
+	}
+	return nil
+}
+
+func (bs *baseStore) retentionLoop() {
+	defer bs.wg.Done()
+	ticker := time.NewTicker(DefaultRetentionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bs.flushCtx.Done():
+			return
+		case <-ticker.C:
+			bs.checkRetention()
+		}
+	}
+}
+
+// checkRetention checks the files not truncated against the limits. The
+// appends are blocked until the next check if they are exceeded with
+// RetentionBlock
+func (bs *baseStore) checkRetention() error {
+	err := bs.retention.check(bs.file.Usage())
+	if err != nil && bs.retention.action == RetentionCheckpoint {
+		logutil.Warnf("%v: force checkpoint", err)
+		if bs.retention.forceCheckpoint != nil {
+			bs.retention.forceCheckpoint()
+		}
+		if err := bs.TryCompact(); err != nil {
+			logutil.Warnf("compact wal: %v", err)
+		}
+		err = bs.retention.check(bs.file.Usage())
+	}
+	bs.retentionMu.Lock()
+	defer bs.retentionMu.Unlock()
+	bs.retentionErr = nil
+	if bs.retention.action == RetentionBlock {
+		bs.retentionErr = err
+	}
+	return err
+}
+
+// blocked returns the error of the last check if the appends of group are
+// blocked by the retention
+func (bs *baseStore) blocked(group uint32) error {
+	if group == entry.GTCKp || group == entry.GTNoop {
+		return nil
+	}
+	bs.retentionMu.RLock()
+	defer bs.retentionMu.RUnlock()
+	return bs.retentionErr
+}
@@ -23,9 +23,11 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 )
 
 var (
@@ -34,9 +36,14 @@ var (
 	DefaultMaxCommitSize = 10
 	DefaultBatchPerSync  = 100
 	DefaultSyncDuration  = time.Millisecond * 2
-	FlushEntry           entry.Entry
+	// DefaultMinCompressSize is the min size of a payload compressed
+	DefaultMinCompressSize = 1024
+	FlushEntry             entry.Entry
 )
 
+// ErrReadOnly is returned by the writes to a store opened read only
+var ErrReadOnly = errors.New("tae: logstore is read only")
+
 func init() {
 	FlushEntry = entry.GetBase()
 	FlushEntry.SetType(entry.ETFlush)
@@ -85,6 +92,32 @@ type baseStore struct {
 	wg              sync.WaitGroup
 	file            File
 	mu              *sync.RWMutex
+	skipSync        bool
+	syncWindow      time.Duration
+	syncEntries     int
+	compression     int
+	keys            entry.KeyProvider
+	policyMu        sync.RWMutex
+	policies        map[uint32]SyncPolicy
+	defaultPolicy   SyncPolicy
+	syncInterval    time.Duration
+	// unsynced is true if an entry of SyncInterval is written but not
+	// synced. It is only accessed by the sync loop
+	unsynced bool
+	archives *archiveLog
+	// replayWorkers is the number of the files decoded in parallel on replay
+	replayWorkers  int
+	replayProgress ProgressObserver
+	commitObserver CommitObserver
+	salvageReplay  bool
+	readOnly       bool
+	mergeMu        sync.RWMutex
+	mergeFuncs     map[uint32]MergeFunc
+	subs           *subscriptions
+	retention      retention
+	retentionMu    sync.RWMutex
+	// retentionErr fails the appends blocked by the retention
+	retentionErr error
 }
 
 func NewBaseStore(dir, name string, cfg *StoreCfg) (*baseStore, error) {
@@ -98,14 +131,61 @@ func NewBaseStore(dir, name string, cfg *StoreCfg) (*baseStore, error) {
 		commitQueue:     make(chan []*batch, DefaultMaxCommitSize*100),
 		postCommitQueue: make(chan []*batch, DefaultMaxCommitSize*100),
 		mu:              &sync.RWMutex{},
+		subs:            newSubscriptions(),
+		mergeFuncs:      make(map[uint32]MergeFunc),
 	}
 	if cfg == nil {
 		cfg = &StoreCfg{}
 	}
-	bs.file, err = OpenRotateFile(dir, name, nil, cfg.RotateChecker, cfg.HistoryFactory, &bs.storeInfo)
+	bs.skipSync = cfg.SkipSync
+	bs.syncWindow = cfg.SyncDuration
+	bs.syncEntries = cfg.SyncEntries
+	bs.compression = cfg.Compression
+	bs.keys = cfg.KeyProvider
+	if bs.syncWindow <= 0 {
+		bs.syncWindow = DefaultSyncDuration
+	}
+	bs.policies = make(map[uint32]SyncPolicy)
+	for group, policy := range cfg.GroupPolicies {
+		bs.policies[group] = policy
+	}
+	if bs.skipSync {
+		bs.defaultPolicy = SyncNone
+	}
+	bs.syncInterval = cfg.SyncInterval
+	bs.replayWorkers = cfg.ReplayWorkers
+	bs.replayProgress = cfg.ReplayProgress
+	bs.commitObserver = cfg.CommitObserver
+	bs.readOnly = cfg.ReadOnly
+	bs.salvageReplay = cfg.SalvageReplay
+	if bs.syncInterval <= 0 {
+		bs.syncInterval = DefaultSyncInterval
+	}
+	bs.retention = retention{
+		maxTotalSize:    cfg.MaxTotalSize,
+		maxAge:          cfg.MaxAge,
+		action:          cfg.RetentionAction,
+		forceCheckpoint: cfg.ForceCheckpoint,
+	}
+	if bs.readOnly {
+		bs.retention = retention{}
+	}
+	checker := cfg.RotateChecker
+	if checker == nil && cfg.MaxFileSize > 0 {
+		checker = NewMaxSizeRotateChecker(cfg.MaxFileSize)
+	}
+	bs.file, err = openRotateFile(dir, name, nil, checker, cfg.HistoryFactory, &bs.storeInfo, bs.readOnly)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Archiver != nil && !bs.readOnly {
+		if bs.archives, err = openArchiveLog(dir, name, cfg.Archiver); err != nil {
+			bs.file.Close()
+			return nil, err
+		}
+		bs.file.GetHistory().SetArchiveHook(bs.archives.archive)
+	}
+	bs.file.GetHistory().SetRetainHook(bs.retained)
 	bs.flushCtx, bs.flushCancel = context.WithCancel(context.Background())
 	bs.start()
 	return bs, nil
@@ -117,6 +197,10 @@ func (bs *baseStore) start() {
 	go bs.syncLoop()
 	go bs.commitLoop()
 	go bs.postCommitLoop()
+	if bs.retention.enabled() {
+		bs.wg.Add(1)
+		go bs.retentionLoop()
+	}
 }
 
 func (bs *baseStore) flushLoop() {
@@ -124,7 +208,9 @@ func (bs *baseStore) flushLoop() {
 	defer bs.wg.Done()
 	entries := make([]entry.Entry, 0, DefaultMaxBatchSize)
 	bats := make([]*batch, 0, DefaultBatchPerSync)
-	ticker := time.NewTicker(DefaultSyncDuration)
+	// pending is the number of the entries written since the last sync
+	pending := 0
+	ticker := time.NewTicker(bs.syncWindow)
 	for {
 		t1 := time.Now()
 		select {
@@ -156,8 +242,10 @@ func (bs *baseStore) flushLoop() {
 			bs.onEntriesDuration += time.Since(t1)
 			t1 = time.Now()
 			bats = append(bats, bat)
-			if len(bats) >= DefaultBatchPerSync || time.Since(t0) > DefaultSyncDuration {
-				if len(bats) >= DefaultBatchPerSync {
+			pending += len(entries)
+			full := len(bats) >= DefaultBatchPerSync || bs.syncEntries > 0 && pending >= bs.syncEntries
+			if full || time.Since(t0) > bs.syncWindow {
+				if full {
 					bs.bySize++
 				} else {
 					bs.byDuration++
@@ -172,6 +260,7 @@ func (bs *baseStore) flushLoop() {
 				// }
 				bs.syncQueue <- syncBatch
 				bats = bats[:0]
+				pending = 0
 			}
 			entries = entries[:0]
 			bs.flushLoop2Duration += time.Since(t1)
@@ -187,6 +276,7 @@ func (bs *baseStore) flushLoop() {
 				copy(syncBatch, bats)
 				bs.syncQueue <- syncBatch
 				bats = bats[:0]
+				pending = 0
 			}
 			bs.flushLoop2Duration += time.Since(t1)
 			t1 = time.Now()
@@ -197,11 +287,17 @@ func (bs *baseStore) flushLoop() {
 func (bs *baseStore) syncLoop() {
 	defer bs.wg.Done()
 	batches := make([]*batch, 0, DefaultMaxSyncSize)
+	ticker := time.NewTicker(bs.syncInterval)
+	defer ticker.Stop()
 	for {
 		t0 := time.Now()
 		select {
 		case <-bs.flushCtx.Done():
 			return
+		case <-ticker.C:
+			if bs.unsynced {
+				bs.sync()
+			}
 		case e := <-bs.syncQueue:
 			bs.syncQueueDuration += time.Since(t0)
 			t0 = time.Now()
@@ -286,9 +382,13 @@ func (bs *baseStore) onPostCommits(batches []*batch) {
 			if err != nil {
 				panic(err)
 			}
+			if bs.commitObserver != nil {
+				bs.commitObserver.OnCommitted(info.Group, info.GroupLSN)
+			}
 		}
 		bs.syncBase.OnCommit()
 	}
+	bs.subs.notify()
 }
 
 func (bs *baseStore) onCommits(batches []*batch) {
@@ -315,9 +415,31 @@ func (bs *baseStore) onCommits(batches []*batch) {
 	bs.postCommitQueue <- bats
 }
 
+// sync syncs the written entries
+func (bs *baseStore) sync() {
+	start := time.Now()
+	if err := bs.file.Sync(); err != nil {
+		panic(err)
+	}
+	metrics.ObserveSince(metrics.WalSyncDuration, start)
+	bs.unsynced = false
+}
+
+// onSyncs syncs the batches if any entry of them is of SyncOnCommit
 func (bs *baseStore) onSyncs(batches []*batch) {
-	var err error
-	if err = bs.file.Sync(); err != nil {
+	needSync := false
+	for _, bat := range batches {
+		needSync = needSync || bat.needSync
+		bs.unsynced = bs.unsynced || bat.unsynced
+	}
+	if needSync {
+		bs.sync()
+		entries := 0
+		for _, bat := range batches {
+			entries += len(bat.entrys)
+		}
+		metrics.WalSyncEntries.Observe(float64(entries))
+	} else if err := bs.file.WriteBuf(); err != nil {
 		panic(err)
 	}
 	bats := make([]*batch, len(batches))
@@ -361,6 +483,10 @@ func (bs *baseStore) PrepareEntry(e entry.Entry) (entry.Entry, error) {
 }
 
 func (bs *baseStore) onEntries(entries []entry.Entry) *batch {
+	bat := &batch{
+		// preparecommit: bs.PrepareCommit(),
+		entrys: make([]entry.Entry, len(entries)),
+	}
 	for _, e := range entries {
 		if e.IsPrintTime() {
 			logutil.Infof("flush queue takes %dms", e.Duration().Milliseconds())
@@ -371,12 +497,24 @@ func (bs *baseStore) onEntries(entries []entry.Entry) *batch {
 		if err != nil {
 			panic(err)
 		}
+		if bs.compression == compress.Lz4 {
+			if err = e.Compress(DefaultMinCompressSize); err != nil {
+				panic(err)
+			}
+		}
+		if bs.keys != nil {
+			if err = e.Encrypt(bs.keys); err != nil {
+				panic(err)
+			}
+		}
 		if err = appender.Prepare(e.TotalSize(), e.GetInfo()); err != nil {
 			panic(err)
 		}
-		if _, err = e.WriteTo(appender); err != nil {
+		var n int64
+		if n, err = e.WriteTo(appender); err != nil {
 			panic(err)
 		}
+		metrics.WalBytes.Add(float64(n))
 		if e.IsPrintTime() {
 			logutil.Infof("onentry1 takes %dms", e.Duration().Milliseconds())
 			e.StartTime()
@@ -384,6 +522,12 @@ func (bs *baseStore) onEntries(entries []entry.Entry) *batch {
 		if err = appender.Commit(); err != nil {
 			panic(err)
 		}
+		switch bs.getSyncPolicy(e) {
+		case SyncOnCommit:
+			bat.needSync = true
+		case SyncInterval:
+			bat.unsynced = true
+		}
 		if e.IsPrintTime() {
 			logutil.Infof("onEntries2 takes %dms", e.Duration().Milliseconds())
 			e.StartTime()
@@ -394,16 +538,16 @@ func (bs *baseStore) onEntries(entries []entry.Entry) *batch {
 		// 	e.StartTime()
 		// }
 	}
-	bat := &batch{
-		// preparecommit: bs.PrepareCommit(),
-		entrys: make([]entry.Entry, len(entries)),
-	}
 	copy(bat.entrys, entries)
 	return bat
 }
 
 type batch struct {
 	entrys []entry.Entry
+	// needSync is true if an entry is of SyncOnCommit
+	needSync bool
+	// unsynced is true if an entry is of SyncInterval
+	unsynced bool
 	// preparecommit *prepareCommit
 	infos []*entry.Info
 }
@@ -420,6 +564,7 @@ func (bs *baseStore) Close() error {
 	bs.flushWg.Wait()
 	bs.flushCancel()
 	bs.wg.Wait()
+	bs.subs.notify()
 	fmt.Printf("***********************\n")
 	fmt.Printf("%d|10µs|%d|1ms|%d|5ms|%d|10ms|%d\n",
 		bs.append10µs, bs.append1ms, bs.append2ms, bs.append3ms, bs.appendgt3ms)
@@ -444,9 +589,30 @@ func (bs *baseStore) Checkpoint(e entry.Entry) (err error) {
 }
 
 func (bs *baseStore) TryCompact() error {
+	if bs.readOnly {
+		return ErrReadOnly
+	}
 	return bs.file.GetHistory().TryTruncate()
 }
 
+// RegisterMergeFunc registers how the uncommitted entries of a txn of group
+// are merged with the txn entry on replay. The uncommitted entries of the
+// groups without one are applied one by one before the txn entry
+func (bs *baseStore) RegisterMergeFunc(group uint32, fn MergeFunc) {
+	bs.mergeMu.Lock()
+	defer bs.mergeMu.Unlock()
+	bs.mergeFuncs[group] = fn
+}
+
+// Archived returns the files archived and removed. It is empty if the
+// store has no archiver
+func (bs *baseStore) Archived() []ArchivedFile {
+	if bs.archives == nil {
+		return nil
+	}
+	return bs.archives.archived()
+}
+
 func (bs *baseStore) TryTruncate(size int64) error {
 	return bs.file.TryTruncate(size)
 }
@@ -470,6 +636,12 @@ func (bs *baseStore) AppendEntry(groupId uint32, e entry.Entry) (id uint64, err
 	if bs.IsClosed() {
 		return 0, common.ClosedErr
 	}
+	if bs.readOnly {
+		return 0, ErrReadOnly
+	}
+	if err = bs.blocked(groupId); err != nil {
+		return 0, err
+	}
 	bs.flushWg.Add(1)
 	if bs.IsClosed() {
 		bs.flushWg.Done()
@@ -514,6 +686,15 @@ func (s *baseStore) Sync() error {
 
 func (s *baseStore) Replay(h ApplyHandle) error {
 	r := newReplayer(h)
+	r.keys = s.keys
+	r.workers = s.replayWorkers
+	r.progress = s.replayProgress
+	r.salvage = s.salvageReplay
+	s.mergeMu.RLock()
+	for group, fn := range s.mergeFuncs {
+		r.mergeFuncs[group] = fn
+	}
+	s.mergeMu.RUnlock()
 	o := &noopObserver{}
 	err := s.file.Replay(r, o)
 	if err != nil {
@@ -525,7 +706,9 @@ func (s *baseStore) Replay(h ApplyHandle) error {
 	for _, ent := range r.entrys {
 		s.synced.ids[ent.group] = ent.commitId
 	}
-	r.Apply()
+	if err = r.Apply(); err != nil {
+		return err
+	}
 	s.OnReplay(r)
 	return nil
 }
@@ -535,5 +718,16 @@ func (s *baseStore) Load(groupId uint32, lsn uint64) (entry.Entry, error) {
 	if err != nil {
 		return nil, err
 	}
-	return s.file.Load(ver, groupId, lsn)
+	e, err := s.file.Load(ver, groupId, lsn)
+	if err != nil {
+		return e, err
+	}
+	if err = e.VerifyChecksum(); err != nil {
+		return e, err
+	}
+	if err = e.Decrypt(s.keys); err != nil {
+		return e, err
+	}
+	err = e.Decompress()
+	return e, err
 }
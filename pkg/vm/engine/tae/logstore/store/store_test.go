@@ -17,15 +17,19 @@ package store
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	// "time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -704,3 +708,628 @@ func TestLoad(t *testing.T) {
 
 	s.Close()
 }
+
+func TestCompressedReplay(t *testing.T) {
+	testReplayWith(t, &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+		Compression:   compress.Lz4,
+	})
+}
+
+func TestEncryptedReplay(t *testing.T) {
+	testReplayWith(t, &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+		Compression:   compress.Lz4,
+		KeyProvider:   entry.NewStaticKeyProvider(bytes.Repeat([]byte{1}, 32)),
+	})
+}
+
+func testReplayWith(t *testing.T, cfg *StoreCfg) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+
+	group := entry.GTCustomizedStart
+	payloads := make(map[string]bool)
+	lsns := make([]uint64, 0)
+	for i := 0; i < 10; i++ {
+		payload := []byte(fmt.Sprintf("entry-%d", i))
+		if i%2 == 0 {
+			payload = bytes.Repeat(payload, 1000)
+		}
+		payloads[string(payload)] = true
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		buf := make([]byte, len(payload))
+		copy(buf, payload)
+		assert.Nil(t, e.Unmarshal(buf))
+		lsn, err := s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		assert.Equal(t, i%2 == 0, e.IsCompressed())
+		assert.Equal(t, cfg.KeyProvider != nil, e.IsEncrypted())
+		e.Free()
+		lsns = append(lsns, lsn)
+	}
+	loaded, err := s.Load(group, lsns[0])
+	assert.Nil(t, err)
+	assert.False(t, loaded.IsCompressed())
+	assert.False(t, loaded.IsEncrypted())
+	assert.True(t, payloads[string(loaded.GetPayload())])
+	loaded.Free()
+	s.Close()
+
+	s, err = NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	defer s.Close()
+	replayed := 0
+	a := func(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
+		assert.True(t, payloads[string(payload)])
+		assert.Equal(t, entry.ETCustomizedStart, typ)
+		replayed++
+		return nil
+	}
+	assert.Nil(t, s.Replay(a))
+	assert.Equal(t, len(payloads), replayed)
+}
+
+func TestSyncEntries(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+		SyncDuration:  time.Hour,
+		SyncEntries:   10,
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	defer s.Close()
+
+	// The entries are synced once 10 of them are written without waiting
+	// for the sync duration
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			e := entry.GetBase()
+			defer e.Free()
+			e.SetType(entry.ETCustomizedStart)
+			e.SetInfo(&entry.Info{Group: entry.GTCustomizedStart})
+			assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("entry-%d", i))))
+			_, err := s.AppendEntry(entry.GTCustomizedStart, e)
+			assert.Nil(t, err)
+			assert.Nil(t, e.WaitDone())
+		}(i)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 10):
+		t.Fatal("entries not synced")
+	}
+}
+
+type lsnObserver chan uint64
+
+func (o lsnObserver) OnCommitted(group uint32, lsn uint64) {
+	if group == entry.GTCustomizedStart {
+		o <- lsn
+	}
+}
+
+func TestCommitObserver(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	observer := make(lsnObserver, 10)
+	cfg := &StoreCfg{
+		RotateChecker:  NewMaxSizeRotateChecker(int(common.K) * 2000),
+		CommitObserver: observer,
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	defer s.Close()
+
+	lsns := make([]uint64, 0, 10)
+	for i := 0; i < 10; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: entry.GTCustomizedStart})
+		assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("entry-%d", i))))
+		lsn, err := s.AppendEntry(entry.GTCustomizedStart, e)
+		assert.Nil(t, err)
+		lsns = append(lsns, lsn)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	// The entries are observed in the order of their LSNs once committed
+	for _, lsn := range lsns {
+		select {
+		case observed := <-observer:
+			assert.Equal(t, lsn, observed)
+		case <-time.After(time.Second * 10):
+			t.Fatal("entries not observed")
+		}
+	}
+}
+
+func TestSyncPolicy(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	noSync := entry.GTCustomizedStart
+	intervalSync := entry.GTCustomizedStart + 1
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+		GroupPolicies: map[uint32]SyncPolicy{intervalSync: SyncInterval},
+		SyncInterval:  time.Hour,
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	s.SetSyncPolicy(noSync, SyncNone)
+
+	appended := 0
+	for i := 0; i < 10; i++ {
+		for _, group := range []uint32{noSync, intervalSync} {
+			e := entry.GetBase()
+			e.SetType(entry.ETCustomizedStart)
+			e.SetInfo(&entry.Info{Group: group})
+			assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("entry-%d-%d", group, i))))
+			_, err := s.AppendEntry(group, e)
+			assert.Nil(t, err)
+			assert.Nil(t, e.WaitDone())
+			e.Free()
+			appended++
+		}
+	}
+	assert.Nil(t, s.Flush())
+	s.Close()
+
+	s, err = NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	defer s.Close()
+	replayed := 0
+	a := func(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
+		assert.True(t, bytes.HasPrefix(payload, []byte(fmt.Sprintf("entry-%d-", group))))
+		replayed++
+		return nil
+	}
+	assert.Nil(t, s.Replay(a))
+	assert.Equal(t, appended, replayed)
+}
+
+type progressRecorder struct {
+	progress []ReplayProgress
+}
+
+func (r *progressRecorder) OnReplayProgress(progress ReplayProgress) {
+	r.progress = append(r.progress, progress)
+}
+
+func TestParallelReplay(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	recorder := new(progressRecorder)
+	cfg := &StoreCfg{
+		RotateChecker:  NewMaxSizeRotateChecker(int(common.K) * 4),
+		ReplayWorkers:  3,
+		ReplayProgress: recorder,
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+
+	groups := []uint32{entry.GTCustomizedStart, entry.GTCustomizedStart + 1}
+	lsns := make(map[uint32][]uint64)
+	for i := 0; i < 100; i++ {
+		group := groups[i%len(groups)]
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		payload := bytes.Repeat([]byte(fmt.Sprintf("%d-", i)), 100)
+		assert.Nil(t, e.Unmarshal(payload))
+		lsn, err := s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+		lsns[group] = append(lsns[group], lsn)
+	}
+	s.Close()
+
+	s, err = NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	defer s.Close()
+	replayed := make(map[uint32][]uint64)
+	a := func(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
+		replayed[group] = append(replayed[group], commitId)
+		return nil
+	}
+	assert.Nil(t, s.Replay(a))
+	// The entries of a group are applied in the order of the group LSN
+	assert.Equal(t, lsns, replayed)
+	assert.True(t, len(recorder.progress) > 1)
+	last := recorder.progress[len(recorder.progress)-1]
+	assert.Equal(t, last.Files, last.Replayed)
+	assert.Equal(t, 100, last.Entries)
+
+	e, err := s.Load(groups[1], lsns[groups[1]][10])
+	assert.Nil(t, err)
+	assert.Equal(t, bytes.Repeat([]byte("21-"), 100), e.GetPayload())
+	e.Free()
+}
+
+func TestCorruptedReplay(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	group := entry.GTCustomizedStart
+	for i := 0; i < 10; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("entry-%d", i))))
+		_, err = s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	s.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+suffix))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(files))
+	corrupt := func(off int64) {
+		f, err := os.OpenFile(files[0], os.O_RDWR, 0644)
+		assert.Nil(t, err)
+		defer f.Close()
+		if off < 0 {
+			stat, err := f.Stat()
+			assert.Nil(t, err)
+			off += stat.Size()
+		}
+		b := make([]byte, 1)
+		_, err = f.ReadAt(b, off)
+		assert.Nil(t, err)
+		b[0] ^= 0xff
+		_, err = f.WriteAt(b, off)
+		assert.Nil(t, err)
+	}
+	replay := func() (replayed int, err error) {
+		s, err := NewBaseStore(dir, name, cfg)
+		assert.Nil(t, err)
+		defer s.Close()
+		err = s.Replay(func(uint32, uint64, []byte, uint16, interface{}) error {
+			replayed++
+			return nil
+		})
+		return
+	}
+
+	// A corrupted last entry is a torn write and is truncated
+	corrupt(-1)
+	replayed, err := replay()
+	assert.Nil(t, err)
+	assert.Equal(t, 9, replayed)
+
+	// A corrupted entry followed by others is not replayed
+	corrupt(int64(entry.ChecksumOffset))
+	_, err = replay()
+	var corruption *CorruptionError
+	assert.True(t, errors.As(err, &corruption))
+	assert.True(t, errors.Is(err, entry.ErrChecksumMismatch))
+	assert.Equal(t, files[0], corruption.File)
+	assert.Equal(t, 0, corruption.Offset)
+}
+
+func TestWalkEntries(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	group := entry.GTCustomizedStart
+	lsns := make([]uint64, 0)
+	for i := 0; i < 10; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		assert.Nil(t, e.Unmarshal(bytes.Repeat([]byte("x"), 500)))
+		lsn, err := s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+		lsns = append(lsns, lsn)
+	}
+	ckp := entry.GetBase()
+	ckp.SetType(entry.ETCheckpoint)
+	ckp.SetInfo(&entry.Info{
+		Group: entry.GTCKp,
+		Checkpoints: []entry.CkpRanges{{
+			Group: group,
+			Ranges: common.NewClosedIntervalsByInterval(
+				&common.ClosedInterval{Start: lsns[0], End: lsns[4]}),
+		}},
+	})
+	assert.Nil(t, ckp.Unmarshal(make([]byte, 0)))
+	_, err = s.AppendEntry(entry.GTCKp, ckp)
+	assert.Nil(t, err)
+	assert.Nil(t, ckp.WaitDone())
+	ckp.Free()
+	s.Close()
+
+	walked := make([]*EntryInfo, 0)
+	err = WalkEntries(dir, name, nil, func(info *EntryInfo) error {
+		walked = append(walked, info)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 11, len(walked))
+	for i, info := range walked[:10] {
+		assert.Nil(t, info.Err)
+		assert.Equal(t, group, info.Group)
+		assert.Equal(t, lsns[i], info.LSN)
+		assert.Equal(t, entry.ETCustomizedStart, info.Type)
+		assert.Equal(t, i < 5, info.Checkpointed)
+	}
+	assert.Equal(t, entry.ETCheckpoint, walked[10].Type)
+	assert.Equal(t, 1, len(walked[10].Checkpoints))
+	// The walk spans the rotated files
+	assert.True(t, walked[10].Version > walked[0].Version)
+
+	walked = walked[:0]
+	err = WalkEntries(dir, name, nil, func(info *EntryInfo) error {
+		walked = append(walked, info)
+		return ErrStopWalk
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(walked))
+}
+
+func TestReplication(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	defer s.Close()
+	group := entry.GTCustomizedStart
+
+	sub, err := s.Subscribe(group, 1)
+	assert.Nil(t, err)
+	defer sub.Close()
+	applied := make([]string, 0)
+	follower := NewFollower(sub, func(g uint32, lsn uint64, payload []byte, typ uint16, info interface{}) error {
+		assert.Equal(t, group, g)
+		assert.Equal(t, uint64(len(applied)+1), lsn)
+		applied = append(applied, string(payload))
+		return nil
+	})
+	follower.Start()
+
+	// A subscription without acks holds the truncation
+	hold, err := s.Subscribe(group, 1)
+	assert.Nil(t, err)
+	defer hold.Close()
+
+	lsns := make([]uint64, 0)
+	for i := 0; i < 20; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		payload := fmt.Sprintf("%03d", i)
+		assert.Nil(t, e.Unmarshal(append([]byte(payload), make([]byte, 500)...)[:500]))
+		lsn, err := s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+		lsns = append(lsns, lsn)
+	}
+	testutils.WaitExpect(4000, func() bool {
+		return follower.Applied() == lsns[19]
+	})
+	assert.Nil(t, follower.Stop())
+	assert.Equal(t, 20, len(applied))
+	for i, payload := range applied {
+		assert.Equal(t, fmt.Sprintf("%03d", i), payload[:3])
+	}
+	assert.Equal(t, lsns[19], sub.Acked())
+
+	ckp := entry.GetBase()
+	ckp.SetType(entry.ETCheckpoint)
+	ckp.SetInfo(&entry.Info{
+		Group: entry.GTCKp,
+		Checkpoints: []entry.CkpRanges{{
+			Group: group,
+			Ranges: common.NewClosedIntervalsByInterval(
+				&common.ClosedInterval{Start: lsns[0], End: lsns[19]}),
+		}},
+	})
+	assert.Nil(t, ckp.Unmarshal(make([]byte, 0)))
+	_, err = s.AppendEntry(entry.GTCKp, ckp)
+	assert.Nil(t, err)
+	assert.Nil(t, ckp.WaitDone())
+	ckp.Free()
+
+	assert.Nil(t, s.TryCompact())
+	e, err := s.Load(group, lsns[0])
+	assert.Nil(t, err)
+	e.Free()
+
+	hold.Ack(lsns[19])
+	assert.Nil(t, s.TryCompact())
+	_, err = s.Load(group, lsns[0])
+	assert.NotNil(t, err)
+	_, err = s.Subscribe(group, lsns[0])
+	assert.Equal(t, ErrEntryTruncated, err)
+}
+
+func TestReplaySalvage(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	group := entry.GTCustomizedStart
+	for i := 0; i < 5; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{Group: group})
+		assert.Nil(t, e.Unmarshal([]byte(fmt.Sprintf("entry-%d", i))))
+		_, err = s.AppendEntry(group, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	s.Close()
+
+	errBad := errors.New("bad entry")
+	replay := func(salvage bool) (applied []string, err error) {
+		cfg.SalvageReplay = salvage
+		s, err := NewBaseStore(dir, name, cfg)
+		assert.Nil(t, err)
+		defer s.Close()
+		err = s.Replay(func(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) error {
+			switch string(payload) {
+			case "entry-1":
+				return errBad
+			case "entry-3":
+				panic("bad entry")
+			}
+			applied = append(applied, string(payload))
+			return nil
+		})
+		return
+	}
+
+	applied, err := replay(false)
+	var replayErr *ReplayError
+	assert.True(t, errors.As(err, &replayErr))
+	assert.True(t, errors.Is(err, errBad))
+	assert.Equal(t, group, replayErr.Group)
+	assert.NotEmpty(t, replayErr.File)
+	assert.Equal(t, []string{"entry-0"}, applied)
+
+	applied, err = replay(true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"entry-0", "entry-2", "entry-4"}, applied)
+}
+
+func TestMergeUncommitted(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 2000),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.Nil(t, err)
+	group := entry.GTCustomizedStart
+	appendEntry := func(groupId uint32, typ entry.Type, info *entry.Info, payload string) {
+		e := entry.GetBase()
+		e.SetType(typ)
+		e.SetInfo(info)
+		assert.Nil(t, e.Unmarshal([]byte(payload)))
+		_, err := s.AppendEntry(groupId, e)
+		assert.Nil(t, err)
+		assert.Nil(t, e.WaitDone())
+		e.Free()
+	}
+	for i := 0; i < 3; i++ {
+		appendEntry(entry.GTUncommit, entry.ETUncommitted, &entry.Info{
+			Group:     entry.GTUncommit,
+			Uncommits: []entry.Tid{{Group: group, Tid: 1}},
+		}, fmt.Sprintf("u%d", i))
+	}
+	appendEntry(group, entry.ETTxn, &entry.Info{Group: group, TxnId: 1}, "txn")
+	s.Close()
+
+	replay := func(merge MergeFunc) (applied []string) {
+		s, err := NewBaseStore(dir, name, cfg)
+		assert.Nil(t, err)
+		defer s.Close()
+		if merge != nil {
+			s.RegisterMergeFunc(group, merge)
+		}
+		err = s.Replay(func(g uint32, commitId uint64, payload []byte, typ uint16, info interface{}) error {
+			assert.Equal(t, group, g)
+			applied = append(applied, string(payload))
+			return nil
+		})
+		assert.Nil(t, err)
+		return
+	}
+	assert.Equal(t, []string{"u0", "u1", "u2", "txn"}, replay(nil))
+	merged := replay(func(pre, curr []byte) []byte {
+		return []byte(string(pre) + "|" + string(curr))
+	})
+	assert.Equal(t, []string{"u0|u1|u2|txn"}, merged)
+}
+
+func TestRetention(t *testing.T) {
+	dir := "/tmp/logstore/teststore"
+	name := "mock"
+	os.RemoveAll(dir)
+	group := entry.GTCustomizedStart
+	appendEntry := func(s *baseStore) error {
+		e := entry.GetBase()
+		defer e.Free()
+		e.SetType(entry.ETCustomizedStart)
+		assert.Nil(t, e.Unmarshal([]byte("payload")))
+		if _, err := s.AppendEntry(group, e); err != nil {
+			return err
+		}
+		return e.WaitDone()
+	}
+
+	s, err := NewBaseStore(dir, name, &StoreCfg{
+		MaxTotalSize:    1,
+		RetentionAction: RetentionBlock,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, s.checkRetention())
+	assert.Nil(t, appendEntry(s))
+	err = s.checkRetention()
+	var retentionErr *RetentionError
+	assert.True(t, errors.As(err, &retentionErr))
+	assert.Equal(t, int64(1), retentionErr.MaxTotalSize)
+	err = appendEntry(s)
+	assert.True(t, errors.Is(err, ErrRetentionExceeded))
+	// The flushes and the checkpoints are not blocked
+	assert.Nil(t, s.Flush())
+	s.Close()
+
+	var checkpoints int32
+	s, err = NewBaseStore(dir, name, &StoreCfg{
+		MaxAge:          time.Nanosecond,
+		RetentionAction: RetentionCheckpoint,
+		ForceCheckpoint: func() { atomic.AddInt32(&checkpoints, 1) },
+	})
+	assert.Nil(t, err)
+	defer s.Close()
+	assert.NotNil(t, s.checkRetention())
+	assert.True(t, atomic.LoadInt32(&checkpoints) > 0)
+	assert.Nil(t, appendEntry(s))
+}
@@ -210,9 +210,9 @@ func (base *syncBase) OnEntryReceived(v *entry.Info) error {
 	}
 	interval, ok := versionRanges[addr.Version]
 	if !ok {
-		interval = common.ClosedInterval{}
+		interval = common.ClosedInterval{Start: addr.LSN, End: addr.LSN}
 	}
-	interval.TryMerge(common.ClosedInterval{Start: 0, End: addr.LSN})
+	interval.TryMerge(common.ClosedInterval{Start: addr.LSN, End: addr.LSN})
 	versionRanges[addr.Version] = interval
 	base.addrs[addr.Group] = versionRanges
 	// fmt.Printf("versionsMap is %v\n", base.addrs)
@@ -17,6 +17,7 @@ package store
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
 )
@@ -24,6 +25,59 @@ import (
 type StoreCfg struct {
 	RotateChecker  RotateChecker
 	HistoryFactory HistoryFactory
+	// SkipSync leaves the flush of the written entries to the OS
+	SkipSync bool
+	// SyncDuration is the max interval between the group syncs of the
+	// written entries. DefaultSyncDuration is used if it is zero
+	SyncDuration time.Duration
+	// SyncEntries is the number of the written entries synced at once
+	// without waiting for SyncDuration. It is not checked if zero
+	SyncEntries int
+	// Compression is compress.Lz4 to compress the payloads of at least
+	// DefaultMinCompressSize bytes or compress.None
+	Compression int
+	// GroupPolicies are the sync policies of the groups. The other groups
+	// are of SyncOnCommit or of SyncNone if SkipSync
+	GroupPolicies map[uint32]SyncPolicy
+	// SyncInterval is the interval of the syncs of the entries of
+	// SyncInterval. DefaultSyncInterval is used if it is zero
+	SyncInterval time.Duration
+	// KeyProvider encrypts the entries written if it is not nil. It is
+	// required to read the encrypted entries
+	KeyProvider entry.KeyProvider
+	// Archiver copies the files covered by the checkpoints before they are
+	// removed if it is not nil
+	Archiver Archiver
+	// ReplayWorkers is the number of the files read and decoded in parallel
+	// on replay. DefaultReplayWorkers is used if it is zero
+	ReplayWorkers int
+	// ReplayProgress is notified of the progress of the replay if it is not
+	// nil
+	ReplayProgress ProgressObserver
+	// CommitObserver is notified of the entries committed if it is not nil
+	CommitObserver CommitObserver
+	// ReadOnly opens the files of a store written by another one to replay
+	// and load its entries. No file is created, written or removed and the
+	// archiver and the retention are not used
+	ReadOnly bool
+	// SalvageReplay skips the entries failed to replay after logging them
+	// instead of failing the replay
+	SalvageReplay bool
+	// MaxFileSize is the size a file is rotated at if RotateChecker is nil.
+	// DefaultRotateCheckerMaxSize is used if it is zero
+	MaxFileSize int
+	// MaxTotalSize is the max size of the files not truncated. It is not
+	// checked if zero
+	MaxTotalSize int64
+	// MaxAge is the max age of the oldest file not truncated. It is not
+	// checked if zero
+	MaxAge time.Duration
+	// RetentionAction is what is done once MaxTotalSize or MaxAge is
+	// exceeded
+	RetentionAction RetentionAction
+	// ForceCheckpoint is called to checkpoint the groups once MaxTotalSize
+	// or MaxAge is exceeded with RetentionCheckpoint
+	ForceCheckpoint func()
 }
 
 type RotateChecker interface {
@@ -78,6 +132,13 @@ type ReplayObserver interface {
 	OnNewUncommit(addrs []*VFileAddress)
 }
 
+// CommitObserver is notified of the entries committed in the order of their
+// LSNs in each group. It is called in the commit pipeline and should not
+// block
+type CommitObserver interface {
+	OnCommitted(group uint32, lsn uint64)
+}
+
 type ReplayHandle = func(VFile, ReplayObserver) error
 
 type History interface {
@@ -92,6 +153,8 @@ type History interface {
 	Empty() bool
 	Replay(*replayer, ReplayObserver) error
 	TryTruncate() error
+	SetArchiveHook(func(VFile) error)
+	SetRetainHook(func(VFile) bool)
 }
 
 type ApplyHandle = func(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error)
@@ -104,16 +167,26 @@ type File interface {
 	FileReader
 
 	Sync() error
+	WriteBuf() error
 	GetAppender() FileAppender
 	Replay(*replayer, ReplayObserver) error
 	GetHistory() History
 	TryTruncate(int64) error
 	Load(ver int, groupId uint32, lsn uint64) (entry.Entry, error)
+	// Usage returns the size of the files not truncated and the creation
+	// time of the oldest one
+	Usage() (size int64, oldest time.Time)
 }
 
 type Store interface {
 	io.Closer
 	Sync() error
+	Flush() error
+	SetSyncPolicy(group uint32, policy SyncPolicy)
+	// Archived returns the files archived and removed
+	Archived() []ArchivedFile
+	Subscribe(group uint32, from uint64) (*Subscription, error)
+	RegisterMergeFunc(group uint32, fn MergeFunc)
 	Replay(ApplyHandle) error
 	GetCheckpointed(uint32) uint64
 	GetSynced(uint32) uint64
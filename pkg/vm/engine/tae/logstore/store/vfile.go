@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"sync"
 	"sync/atomic"
@@ -55,8 +54,13 @@ type vFile struct {
 	bufpos     int //update when write
 	syncpos    int //update when sync
 	bufSize    int
+	// createTime is the time the file is created or, for a file opened,
+	// last modified
+	createTime time.Time
 
 	bsInfo *storeInfo
+	// readOnly is true if the file is written by another store
+	readOnly bool
 }
 
 func newVFile(mu *sync.RWMutex, name string, version int, history History, bsInfo *storeInfo) (*vFile, error) {
@@ -75,6 +79,7 @@ func newVFile(mu *sync.RWMutex, name string, version int, history History, bsInf
 		history:    history,
 		buf:        make([]byte, DefaultBufSize),
 		bufSize:    int(DefaultBufSize),
+		createTime: time.Now(),
 	}
 	vf.vInfo = newVInfo(vf)
 	return vf, nil
@@ -162,12 +167,24 @@ func (vf *vFile) Commit() {
 
 //TODO reuse wait sync
 func (vf *vFile) Sync() error {
+	return vf.flush(true)
+}
+
+// WriteBuf writes the buffered entries to the file without the fsync
+func (vf *vFile) WriteBuf() error {
+	return vf.flush(false)
+}
+
+func (vf *vFile) flush(fsync bool) error {
 	vf.Lock()
 	defer vf.Unlock()
-	if vf.bsInfo != nil {
+	if fsync && vf.bsInfo != nil {
 		vf.bsInfo.syncTimes++
 	}
 	if vf.buf == nil {
+		if !fsync {
+			return nil
+		}
 		err := vf.File.Sync()
 		return err
 	}
@@ -194,6 +211,9 @@ func (vf *vFile) Sync() error {
 	}
 	vf.bufpos = 0
 	// fmt.Printf("199bufpos is %v\n",vf.bufpos)
+	if !fsync {
+		return nil
+	}
 	t0 = time.Now()
 	err = vf.File.Sync()
 	if err != nil {
@@ -295,17 +315,21 @@ func (vf *vFile) Destroy() error {
 }
 
 func (vf *vFile) Replay(r *replayer, observer ReplayObserver) error {
-	observer.OnNewEntry(vf.Id())
-	for {
-		if err := r.replayHandler(vf, vf); err != nil {
-			if errors.Is(err, io.EOF) {
-				break
-			}
-			return err
+	return r.replayFiles([]*vFile{vf}, observer)
+}
+
+// truncate drops the torn entry at pos and the later bytes on replay. The
+// bytes of a file read only are kept, as its writer may be writing them
+func (vf *vFile) truncate(pos int) {
+	if !vf.readOnly {
+		if err := vf.File.Truncate(int64(pos)); err != nil {
+			panic(err)
 		}
 	}
-	vf.OnReplay(r)
-	return nil
+	vf.Lock()
+	defer vf.Unlock()
+	vf.size = pos
+	vf.syncpos = pos
 }
 
 func (vf *vFile) OnNewEntry(int) {}
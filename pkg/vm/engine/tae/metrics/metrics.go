@@ -0,0 +1,145 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "tae"
+
+// The collectors below are shared by all the dbs in the process. Each db
+// registers them in its own registry together with the collectors of the db
+var (
+	AppendRows = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "append_rows_total",
+		Help:      "Number of rows appended by committed txns.",
+	})
+	TxnCommitted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "txn_committed_total",
+		Help:      "Number of committed txns.",
+	})
+	TxnAborted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "txn_aborted_total",
+		Help:      "Number of rollbacked txns.",
+	})
+	TxnKilled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "txn_killed_total",
+		Help:      "Number of txns killed by the reason of the kill.",
+	}, []string{"reason"})
+	WalBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "wal_bytes_total",
+		Help:      "Number of bytes written to the WAL.",
+	})
+	WalSyncDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "wal_sync_duration_seconds",
+		Help:      "Duration of syncing the WAL file.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+	})
+	WalSyncEntries = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "wal_sync_entries",
+		Help:      "Number of WAL entries made durable by one sync.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	})
+	FlushDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "flush_duration_seconds",
+		Help:      "Duration of flushing a block to the block file.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+	})
+	CompactionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "compaction_duration_seconds",
+		Help:      "Duration of compacting blocks by the type of the compaction.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+	}, []string{"type"})
+	BufferRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "buffer_requests_total",
+		Help:      "Number of pins to the buffer managers by whether the node was loaded.",
+	}, []string{"result"})
+	BlockCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "block_cache_requests_total",
+		Help:      "Number of reads of the block cache by whether the data was cached.",
+	}, []string{"result"})
+)
+
+const (
+	CompactionBlock  = "block"
+	CompactionABlock = "ablock"
+	CompactionMerge  = "merge"
+
+	BufferHit  = "hit"
+	BufferMiss = "miss"
+
+	TxnKilledTimeout = "timeout"
+	TxnKilledIdle    = "idle"
+)
+
+// NewRegistry returns a registry with the shared collectors registered
+func NewRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		AppendRows,
+		TxnCommitted,
+		TxnAborted,
+		TxnKilled,
+		WalBytes,
+		WalSyncDuration,
+		WalSyncEntries,
+		FlushDuration,
+		CompactionDuration,
+		BufferRequests,
+		BlockCacheRequests,
+	)
+	return registry
+}
+
+// ObserveSince records the duration since start in seconds
+func ObserveSince(observer prometheus.Observer, start time.Time) {
+	observer.Observe(time.Since(start).Seconds())
+}
+
+// Serve exposes the metrics in registry at /metrics on addr. The address
+// listened on is set to the Addr of the returned server
+func Serve(addr string, registry *prometheus.Registry) (server *http.Server, err error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server = &http.Server{
+		Addr:    listener.Addr().String(),
+		Handler: mux,
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return
+}
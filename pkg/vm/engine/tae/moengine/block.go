@@ -17,6 +17,7 @@ package moengine
 import (
 	"bytes"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -29,18 +30,63 @@ func newBlock(h handle.Block) *txnBlock {
 	}
 }
 
-func (blk *txnBlock) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
+// Read reads the columns attrs of the block, or of the rows of the offsets
+// rows only if rows is not nil
+func (blk *txnBlock) Read(cs []uint64, attrs []string, rows []uint32, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
 	var view *model.ColumnView
 	var err error
 	bat := batch.New(true, attrs)
 	bat.Vecs = make([]*vector.Vector, len(attrs))
+	length := -1
+	// The rows deleted are not read, the physical addresses skip them
+	var deletes *roaring.Bitmap
 	for i, attr := range attrs {
+		if isHiddenAttr(attr) {
+			continue
+		}
 		view, err = blk.handle.GetColumnDataByName(attr, compressed[i], deCompressed[i])
 		if err != nil {
 			return nil, err
 		}
 		view.AppliedVec.Ref = cs[i]
 		bat.Vecs[i] = view.AppliedVec
+		length = view.Length()
+		deletes = view.DeleteMask
+	}
+	if length == -1 {
+		length = blk.handle.Rows()
+	}
+	for i, attr := range attrs {
+		if !isHiddenAttr(attr) {
+			continue
+		}
+		bat.Vecs[i] = blk.makeHiddenVector(attr, length, deletes)
+		bat.Vecs[i].Ref = cs[i]
+	}
+	if rows != nil {
+		sels := rowPositions(rows, deletes, length)
+		for _, vec := range bat.Vecs {
+			vector.Shrink(vec, sels)
+		}
 	}
 	return bat, nil
 }
+
+// rowPositions returns the positions of the rows of the offsets rows in the
+// vectors of length read, which skip the deleted rows
+func rowPositions(rows []uint32, deletes *roaring.Bitmap, length int) []int64 {
+	sels := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		pos := int64(row)
+		if deletes != nil {
+			if deletes.Contains(row) {
+				continue
+			}
+			pos -= int64(deletes.Rank(row))
+		}
+		if pos < int64(length) {
+			sels = append(sels, pos)
+		}
+	}
+	return sels
+}
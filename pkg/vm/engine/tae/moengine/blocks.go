@@ -0,0 +1,145 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+// listBlocks returns the partitions and the blocks of rel not pruned by
+// filters
+func listBlocks(rel handle.Relation, filters []*handle.Filter) ([]uint32, []*plan.BlockRef) {
+	var it handle.BlockIt
+	if len(filters) > 0 {
+		it = rel.MakeBlockItWithFilters(filters...)
+	} else {
+		it = rel.MakeBlockIt()
+	}
+	defer it.Close()
+	var blocks []*plan.BlockRef
+	for ; it.Valid(); it.Next() {
+		id := it.GetBlock().Fingerprint()
+		blocks = append(blocks, &plan.BlockRef{
+			SegmentId: id.SegmentID,
+			BlockId:   id.BlockID,
+		})
+	}
+	return rel.Partitions(filters...), blocks
+}
+
+// lookupBlocks returns the blocks with the rows of rel whose column attr
+// equals the constant key, which are found by the secondary index of attr.
+// The rows appended in the transaction are not in the blocks and are left
+// out as by the block readers. It returns false if attr has no index or key
+// is not a value of its type
+func lookupBlocks(rel handle.Relation, attr string, key *plan.Expr) ([]*plan.BlockRef, bool) {
+	schema := rel.Schema().(*catalog.Schema)
+	idx := schema.GetColIdx(attr)
+	c, ok := key.Expr.(*plan.Expr_C)
+	if idx == -1 || !ok || !schema.ColDefs[idx].HasIndex() {
+		return nil, false
+	}
+	val, ok := planConstValue(schema.ColDefs[idx].Type, c.C)
+	if !ok {
+		return nil, false
+	}
+	ids, offsets, err := rel.GetByIndex(attr, val)
+	if err != nil {
+		return nil, false
+	}
+	blocks := []*plan.BlockRef{}
+	refs := make(map[[2]uint64]*plan.BlockRef)
+	for i, id := range ids {
+		if id.PartID != 0 {
+			continue
+		}
+		ref, ok := refs[[2]uint64{id.SegmentID, id.BlockID}]
+		if !ok {
+			ref = &plan.BlockRef{
+				SegmentId: id.SegmentID,
+				BlockId:   id.BlockID,
+			}
+			refs[[2]uint64{id.SegmentID, id.BlockID}] = ref
+			blocks = append(blocks, ref)
+		}
+		ref.Rows = append(ref.Rows, offsets[i])
+	}
+	for _, ref := range blocks {
+		sort.Slice(ref.Rows, func(i, j int) bool { return ref.Rows[i] < ref.Rows[j] })
+	}
+	return blocks, true
+}
+
+// blockListIt iterates the blocks listed by listBlocks, which are visible to
+// the transaction listing them until it ends
+type blockListIt struct {
+	sync.RWMutex
+	rel    handle.Relation
+	blocks []*plan.BlockRef
+	pos    int
+	blk    handle.Block
+}
+
+func newBlockListIt(rel handle.Relation, blocks []*plan.BlockRef) *blockListIt {
+	it := &blockListIt{
+		rel:    rel,
+		blocks: blocks,
+	}
+	it.seek()
+	return it
+}
+
+// seek moves to the first block from pos which is found
+func (it *blockListIt) seek() {
+	for it.blk = nil; it.pos < len(it.blocks); it.pos++ {
+		ref := it.blocks[it.pos]
+		seg, err := it.rel.GetSegment(ref.SegmentId)
+		if err != nil {
+			continue
+		}
+		if blk, err := seg.GetBlock(ref.BlockId); err == nil {
+			it.blk = blk
+			return
+		}
+	}
+}
+
+func (it *blockListIt) Valid() bool {
+	return it.blk != nil
+}
+
+func (it *blockListIt) Next() {
+	it.pos++
+	it.seek()
+}
+
+func (it *blockListIt) GetBlock() handle.Block {
+	return it.blk
+}
+
+// GetRows returns the offsets of the rows read in the current block, nil if
+// the whole block is read
+func (it *blockListIt) GetRows() []uint32 {
+	return it.blocks[it.pos].Rows
+}
+
+func (it *blockListIt) Close() error {
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+var (
+	_ plan2.CompilerContext = (*compilerContext)(nil)
+	_ plan2.Statistics      = (*compilerContext)(nil)
+	_ plan2.BlockPruner     = (*compilerContext)(nil)
+	_ plan2.IndexLookup     = (*compilerContext)(nil)
+)
+
+const (
+	// defaultCard is the cardinality of a table not found
+	defaultCard = 1000000
+	// defaultSelectivity is applied to a scan with any filter
+	defaultSelectivity = 0.1
+)
+
+type compilerContext struct {
+	txn    txnif.AsyncTxn
+	dbName string
+}
+
+// NewCompilerContext resolves the tables in txn and estimates the costs of
+// scanning them with the statistics collected by ANALYZE
+func NewCompilerContext(txn Txn, dbName string) *compilerContext {
+	return &compilerContext{
+		txn:    txn.(txnif.AsyncTxn),
+		dbName: dbName,
+	}
+}
+
+// Analyze collects the statistics of the table name of the database dbName
+// in txn. The compiler contexts of the txns started once txn commits estimate
+// the costs with them
+func Analyze(txn Txn, dbName, name string) error {
+	db, err := txn.(txnif.AsyncTxn).GetDatabase(dbName)
+	if err != nil {
+		return err
+	}
+	rel, err := db.GetRelationByName(name)
+	if err != nil {
+		return err
+	}
+	return rel.Analyze()
+}
+
+func (ctx *compilerContext) DefaultDatabase() string {
+	return ctx.dbName
+}
+
+func (ctx *compilerContext) DatabaseExists(name string) bool {
+	_, err := ctx.txn.GetDatabase(name)
+	return err == nil
+}
+
+// getRelation finds the relation of name, which is qualified by the database
+// name or not
+func (ctx *compilerContext) getRelation(name string) (db handle.Database, rel handle.Relation, err error) {
+	dbName, tblName := ctx.dbName, name
+	if idx := strings.IndexByte(name, '.'); idx >= 0 {
+		dbName, tblName = name[:idx], name[idx+1:]
+	}
+	if db, err = ctx.txn.GetDatabase(dbName); err != nil {
+		return
+	}
+	rel, err = db.GetRelationByName(tblName)
+	return
+}
+
+func (ctx *compilerContext) Resolve(name string) (*plan2.ObjectRef, *plan2.TableDef) {
+	db, rel, err := ctx.getRelation(name)
+	if err != nil {
+		return nil, nil
+	}
+	schema := rel.Schema().(*catalog.Schema)
+	obj := &plan2.ObjectRef{
+		Db:      int64(db.GetID()),
+		Obj:     int64(rel.ID()),
+		DbName:  db.GetName(),
+		ObjName: schema.Name,
+	}
+	def := &plan2.TableDef{
+		Name: schema.Name,
+		Cols: make([]*plan.ColDef, len(schema.ColDefs)),
+	}
+	for i, colDef := range schema.ColDefs {
+		def.Cols[i] = &plan.ColDef{
+			Name: colDef.Name,
+			Typ: &plan.Type{
+				Id:        plan.Type_TypeId(colDef.Type.Oid),
+				Nullable:  colDef.IsNullable(),
+				Width:     colDef.Type.Width,
+				Precision: colDef.Type.Precision,
+			},
+			Primary: i == int(schema.PrimaryKey),
+		}
+		if colDef.HasIndex() {
+			def.Defs = append(def.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Idx{Idx: &plan.IndexDef{
+					Typ:      plan.IndexDef_SECONDARY,
+					Name:     colDef.Index,
+					ColNames: []string{colDef.Name},
+				}},
+			})
+		}
+	}
+	return obj, def
+}
+
+// Cost estimates the cost of scanning obj by the committed row count and the
+// statistics of the last ANALYZE. The filter e is not analyzed yet and a
+// fixed selectivity is applied if there is any
+func (ctx *compilerContext) Cost(obj *plan2.ObjectRef, e *plan2.Expr) *plan2.Cost {
+	c := &plan2.Cost{
+		Card: defaultCard,
+		Ndv:  defaultCard,
+	}
+	_, rel, err := ctx.getRelation(obj.DbName + "." + obj.ObjName)
+	if err != nil {
+		return c
+	}
+	schema := rel.Schema().(*catalog.Schema)
+	for _, colDef := range schema.ColDefs {
+		c.Rowsize += float64(columnSize(colDef.Type))
+	}
+	c.Card = float64(rel.Rows())
+	c.Ndv = c.Card
+	if stats := rel.GetMeta().(*catalog.TableEntry).GetStats(); stats != nil {
+		c.Ndv = 0
+		for _, col := range stats.Columns {
+			if float64(col.NDV) > c.Ndv {
+				c.Ndv = float64(col.NDV)
+			}
+		}
+		// The sketch may overestimate a little
+		if c.Ndv > c.Card {
+			c.Ndv = c.Card
+		}
+	}
+	c.Total = c.Card * c.Rowsize
+	if e != nil {
+		c.Card *= defaultSelectivity
+		c.Ndv *= defaultSelectivity
+	}
+	return c
+}
+
+// Stats returns the statistics of obj collected by the last ANALYZE, with
+// the row count committed. The histograms have one bucket from the min to
+// the max values of the numeric columns. It returns nil if obj is not
+// analyzed
+func (ctx *compilerContext) Stats(obj *plan2.ObjectRef) *plan2.TableStats {
+	_, rel, err := ctx.getRelation(obj.DbName + "." + obj.ObjName)
+	if err != nil {
+		return nil
+	}
+	stats := rel.GetMeta().(*catalog.TableEntry).GetStats()
+	if stats == nil {
+		return nil
+	}
+	schema := rel.Schema().(*catalog.Schema)
+	ts := &plan2.TableStats{
+		Rows:    float64(rel.Rows()),
+		Columns: make(map[string]*plan2.ColumnStats, len(stats.Columns)),
+	}
+	for _, col := range stats.Columns {
+		idx := schema.GetColIdx(col.Name)
+		if idx < 0 {
+			continue
+		}
+		cs := &plan2.ColumnStats{
+			Ndv:      float64(col.NDV),
+			NullFrac: stats.NullFraction(col.Name),
+		}
+		if cs.Ndv > ts.Rows {
+			cs.Ndv = ts.Rows
+		}
+		typ := schema.ColDefs[idx].Type
+		if col.Min != nil && col.Max != nil {
+			lo, lok := numericValue(col.Min, typ)
+			hi, hok := numericValue(col.Max, typ)
+			if lok && hok {
+				cs.Histogram = []float64{lo, hi}
+			}
+		}
+		ts.Columns[col.Name] = cs
+	}
+	return ts
+}
+
+// Blocks lists the blocks of obj whose zone maps may satisfy preds, which
+// are visible to the transaction of ctx
+func (ctx *compilerContext) Blocks(obj *plan2.ObjectRef, _ *plan2.TableDef, preds []*plan2.Expr) ([]uint32, []*plan.BlockRef, bool) {
+	_, rel, err := ctx.getRelation(obj.DbName + "." + obj.ObjName)
+	if err != nil {
+		return nil, nil, false
+	}
+	partitions, blocks := listBlocks(rel, makePlanFilters(rel.Schema().(*catalog.Schema), nil, preds))
+	return partitions, blocks, true
+}
+
+// Lookup lists the blocks of obj with the rows whose column col equals key,
+// which are visible to the transaction of ctx
+func (ctx *compilerContext) Lookup(obj *plan2.ObjectRef, col string, key *plan2.Expr) ([]*plan.BlockRef, bool) {
+	_, rel, err := ctx.getRelation(obj.DbName + "." + obj.ObjName)
+	if err != nil {
+		return nil, false
+	}
+	return lookupBlocks(rel, col, key)
+}
+
+// numericValue decodes the key encoded by common.EncodeKey of a numeric
+// column of typ
+func numericValue(key []byte, typ types.Type) (float64, bool) {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime:
+	default:
+		return 0, false
+	}
+	switch v := common.DecodeKey(key, typ).(type) {
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case types.Date:
+		return float64(v), true
+	case types.Datetime:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// columnSize is the average size of a value of typ
+func columnSize(typ types.Type) int32 {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		if typ.Width > 0 {
+			return typ.Width
+		}
+	}
+	return typ.Size
+}
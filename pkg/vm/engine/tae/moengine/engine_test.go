@@ -1,11 +1,15 @@
 package moengine
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/adaptor"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
@@ -14,6 +18,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -78,3 +84,287 @@ func TestEngine(t *testing.T) {
 	}
 	t.Log(tae.Catalog.SimplePPString(common.PPL1))
 }
+
+func TestHiddenColumns(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	meta := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry)
+	bat := compute.MockBatch(meta.GetSchema().Types(), 10, int(meta.GetSchema().PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+	assert.Nil(t, rel.Write(0, bats[0], txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+	firstTS := txn.(txnif.AsyncTxn).GetCommitTS()
+
+	// The rows appended later to the same block have their own commit ts
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Write(0, bats[1], txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+	secondTS := txn.(txnif.AsyncTxn).GetCommitTS()
+	assert.True(t, secondTS > firstTS)
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	attrs := rel.(engine.HiddenRelation).HiddenAttributes(nil)
+	assert.Equal(t, 2, len(attrs))
+	reader := rel.NewReader(1, nil, nil, nil)[0]
+	hbat, err := reader.Read([]uint64{1, 1, 1}, []string{PhyAddrColumnName, meta.GetSchema().ColDefs[0].Name, CommitTSColumnName})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, vector.Length(hbat.Vecs[0]))
+	assert.Equal(t, 10, vector.Length(hbat.Vecs[2]))
+	blk := meta.LastAppendableSegmemt().LastAppendableBlock()
+	assert.Equal(t, fmt.Sprintf("%d-%d-3", blk.GetSegment().GetID(), blk.GetID()), string(hbat.Vecs[0].Col.(*types.Bytes).Get(3)))
+	commitTS := hbat.Vecs[2].Col.([]uint64)
+	assert.Equal(t, firstTS, commitTS[4])
+	assert.Equal(t, secondTS, commitTS[5])
+	assert.Nil(t, txn.Commit())
+}
+
+func TestDeleteByHiddenKeys(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	meta := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry)
+	bat := compute.MockBatch(meta.GetSchema().Types(), 10, int(meta.GetSchema().PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	blk := meta.LastAppendableSegmemt().LastAppendableBlock()
+	key := func(row int) []byte {
+		return []byte(fmt.Sprintf("%d-%d-%d", blk.GetSegment().GetID(), blk.GetID(), row))
+	}
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	r := rel.(engine.HiddenKeyRelation)
+	assert.Equal(t, PhyAddrColumnName, r.HiddenKey(nil))
+	keys := vector.New(hiddenAttrs[0].Type)
+	assert.Nil(t, vector.Append(keys, [][]byte{key(2), key(3)}))
+	assert.Nil(t, r.DeleteByHiddenKeys(keys, nil))
+	assert.NotNil(t, r.UpdateByHiddenKey([]byte("2-3"), nil, nil, nil))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	reader := rel.NewReader(1, nil, nil, nil)[0]
+	hbat, err := reader.Read([]uint64{1, 1}, []string{PhyAddrColumnName, meta.GetSchema().ColDefs[0].Name})
+	assert.Nil(t, err)
+	assert.Equal(t, 8, vector.Length(hbat.Vecs[0]))
+	// The physical addresses skip the rows deleted
+	assert.Equal(t, key(4), hbat.Vecs[0].Col.(*types.Bytes).Get(2))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestReaderLimit(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	meta := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry)
+	bat := compute.MockBatch(meta.GetSchema().Types(), 10, int(meta.GetSchema().PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	attrs := []string{meta.GetSchema().ColDefs[0].Name}
+	limit := engine.NewScanLimit(5)
+	readers := rel.NewReader(2, nil, nil, nil)
+	for _, reader := range readers {
+		reader.(engine.LimitedReader).SetLimit(limit)
+	}
+	limit.Add(5)
+	for _, reader := range readers {
+		lbat, err := reader.Read([]uint64{1}, attrs)
+		assert.Nil(t, err)
+		assert.Nil(t, lbat)
+	}
+	lbat, err := rel.NewReader(1, nil, nil, nil)[0].Read([]uint64{1}, attrs)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, vector.Length(lbat.Vecs[0]))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestReaderCanceled(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	meta := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry)
+	bat := compute.MockBatch(meta.GetSchema().Types(), 10, int(meta.GetSchema().PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	attrs := []string{meta.GetSchema().ColDefs[0].Name}
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := rel.NewReader(1, nil, nil, nil)[0]
+	reader.(engine.CancelableReader).SetContext(ctx)
+	cancel()
+	_, err = reader.Read([]uint64{1}, attrs)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestCompilerContext(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+
+	txn := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	ctx := NewCompilerContext(txn, "db")
+	assert.True(t, ctx.DatabaseExists("db"))
+	assert.False(t, ctx.DatabaseExists("xx"))
+	obj, def := ctx.Resolve(schema.Name)
+	assert.NotNil(t, obj)
+	assert.Equal(t, "db", obj.DbName)
+	assert.Equal(t, 4, len(def.Cols))
+	assert.True(t, def.Cols[2].Primary)
+	assert.Equal(t, int32(types.T_int64), int32(def.Cols[3].Typ.Id))
+	obj2, _ := ctx.Resolve("db." + schema.Name)
+	assert.Equal(t, obj, obj2)
+	obj2, def = ctx.Resolve("xx")
+	assert.Nil(t, obj2)
+	assert.Nil(t, def)
+
+	// Not analyzed yet
+	cost := ctx.Cost(obj, nil)
+	assert.Equal(t, float64(30), cost.Card)
+	assert.Equal(t, float64(30), cost.Ndv)
+	assert.Equal(t, float64(1+2+4+8), cost.Rowsize)
+	assert.Nil(t, ctx.Stats(obj))
+
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.Analyze())
+	assert.Nil(t, txn.Commit())
+
+	txn = tae.StartTxn(nil)
+	ctx = NewCompilerContext(txn, "db")
+	cost = ctx.Cost(obj, nil)
+	assert.Equal(t, float64(30), cost.Card)
+	assert.InDelta(t, 30, cost.Ndv, 1)
+	filtered := ctx.Cost(obj, &plan.Expr{})
+	assert.Equal(t, cost.Card*defaultSelectivity, filtered.Card)
+	stats := ctx.Stats(obj)
+	assert.NotNil(t, stats)
+	assert.Equal(t, float64(30), stats.Rows)
+	col := stats.Columns[schema.ColDefs[3].Name]
+	assert.InDelta(t, 30, col.Ndv, 1)
+	assert.Equal(t, 2, len(col.Histogram))
+	assert.LessOrEqual(t, col.Histogram[0], col.Histogram[1])
+	db, _ = txn.GetDatabase("db")
+	h, _ := db.GetRelationByName(schema.Name)
+	assert.Equal(t, int64(30), newRelation(h).Rows())
+	assert.InDelta(t, 30, newRelation(h).CardinalNumber(schema.ColDefs[2].Name), 1)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestPlanFilters(t *testing.T) {
+	schema := catalog.MockSchemaAll(4)
+	cmp := func(op string, pos int32, c *plan.Const) *plan.Expr {
+		return &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{ObjName: op},
+			Args: []*plan.Expr{
+				{Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: schema.ColDefs[pos].Name, ColPos: pos}}},
+				{Expr: &plan.Expr_C{C: c}},
+			},
+		}}}
+	}
+	filters := makePlanFilters(schema, nil, []*plan.Expr{
+		cmp("<", 2, &plan.Const{Value: &plan.Const_Ival{Ival: 10}}),
+		// Out of the range of int8
+		cmp("=", 0, &plan.Const{Value: &plan.Const_Ival{Ival: 300}}),
+		cmp("=", 3, &plan.Const{Isnull: true}),
+		cmp("<>", 3, &plan.Const{Value: &plan.Const_Ival{Ival: 1}}),
+	})
+	assert.Equal(t, 1, len(filters))
+	assert.Equal(t, handle.FilterLt, filters[0].Op)
+	assert.Equal(t, schema.ColDefs[2].Name, filters[0].Attr)
+	assert.Equal(t, int32(10), filters[0].Val)
+}
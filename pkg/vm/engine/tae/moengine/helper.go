@@ -38,6 +38,14 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 			col.PrimaryKey = true
 		}
 		tblInfo.Columns = append(tblInfo.Columns, col)
+		if colDef.HasIndex() {
+			tblInfo.Indices = append(tblInfo.Indices, aoe.IndexInfo{
+				Type:        aoe.ZoneMap,
+				Columns:     []uint64{uint64(idx)},
+				ColumnNames: []string{colDef.Name},
+				Name:        colDef.Index,
+			})
+		}
 	}
 	return tblInfo
 }
@@ -85,9 +93,10 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 	schema := catalog.NewEmptySchema(info.Name)
 	for idx, colInfo := range info.Columns {
 		newInfo := &catalog.ColDef{
-			Name: colInfo.Name,
-			Idx:  idx,
-			Type: colInfo.Type,
+			Name:   colInfo.Name,
+			Idx:    idx,
+			SeqNum: uint16(idx),
+			Type:   colInfo.Type,
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = int32(idx)
@@ -96,6 +105,18 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
 	}
+	schema.NextColSeqNum = uint16(len(schema.ColDefs))
+	// The single column indexes become the secondary indexes of the columns.
+	// The ones on the sort key or the columns not indexable are served by
+	// the zone maps only
+	for _, idxInfo := range info.Indices {
+		if idxInfo.Type != aoe.ZoneMap || len(idxInfo.ColumnNames) != 1 {
+			continue
+		}
+		if err := schema.AddIndex(idxInfo.Name, idxInfo.ColumnNames[0]); err != nil {
+			logutil.Debugf("Table to schema, index %s is skipped: %v", idxInfo.Name, err)
+		}
+	}
 
 	return schema
 }
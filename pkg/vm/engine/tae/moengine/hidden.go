@@ -0,0 +1,139 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+const (
+	// PhyAddrColumnName is the hidden column of the physical address of a
+	// row, formatted as "segment-block-offset"
+	PhyAddrColumnName = "__mo_phyaddr"
+	// CommitTSColumnName is the hidden column of the commit ts of the append
+	// of a row
+	CommitTSColumnName = "__mo_commit_ts"
+)
+
+var hiddenAttrs = []engine.Attribute{
+	{
+		Name: PhyAddrColumnName,
+		Alg:  0,
+		Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 64},
+	},
+	{
+		Name: CommitTSColumnName,
+		Alg:  0,
+		Type: types.Type{Oid: types.T_uint64, Size: 8, Width: 64},
+	},
+}
+
+func isHiddenAttr(attr string) bool {
+	return attr == PhyAddrColumnName || attr == CommitTSColumnName
+}
+
+// makeHiddenVector synthesizes the hidden column attr of rows rows from the
+// block metadata, the rows deleted are skipped
+func (blk *txnBlock) makeHiddenVector(attr string, rows int, deletes *roaring.Bitmap) *vector.Vector {
+	meta := blk.handle.GetMeta().(*catalog.BlockEntry)
+	switch attr {
+	case PhyAddrColumnName:
+		vec := vector.New(hiddenAttrs[0].Type)
+		id := meta.AsCommonID()
+		for i, n := uint32(0), 0; n < rows; i++ {
+			if deletes != nil && deletes.Contains(i) {
+				continue
+			}
+			addr := fmt.Sprintf("%d-%d-%d", id.SegmentID, id.BlockID, i)
+			compute.AppendValue(vec, []byte(addr))
+			n++
+		}
+		return vec
+	case CommitTSColumnName:
+		vec := vector.New(hiddenAttrs[1].Type)
+		blkData := meta.GetBlockData()
+		for i, n := uint32(0), 0; n < rows; i++ {
+			if deletes != nil && deletes.Contains(i) {
+				continue
+			}
+			compute.AppendValue(vec, blkData.GetRowCommitTS(i))
+			n++
+		}
+		return vec
+	}
+	panic(fmt.Sprintf("bad hidden column %s", attr))
+}
+
+// HiddenKey returns the hidden column of the physical addresses of the rows
+func (rel *txnRelation) HiddenKey(_ engine.Snapshot) string {
+	return PhyAddrColumnName
+}
+
+// DeleteByHiddenKeys deletes the rows of the physical addresses of keys
+func (rel *txnRelation) DeleteByHiddenKeys(keys *vector.Vector, _ engine.Snapshot) error {
+	vs := keys.Col.(*types.Bytes)
+	for i := range vs.Offsets {
+		if nulls.Contains(keys.Nsp, uint64(i)) {
+			continue
+		}
+		id, row, err := rel.parseHiddenKey(vs.Get(int64(i)))
+		if err != nil {
+			return err
+		}
+		if err = rel.handle.RangeDelete(id, row, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateByHiddenKey sets the columns attrs of the row of the physical
+// address key to vals
+func (rel *txnRelation) UpdateByHiddenKey(key []byte, attrs []string, vals []interface{}, _ engine.Snapshot) error {
+	id, row, err := rel.parseHiddenKey(key)
+	if err != nil {
+		return err
+	}
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	for i, attr := range attrs {
+		idx := schema.GetColIdx(attr)
+		if idx < 0 {
+			return fmt.Errorf("tae: column %s not found", attr)
+		}
+		if err = rel.handle.Update(id, row, uint16(idx), vals[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseHiddenKey returns the block and the row of a physical address
+func (rel *txnRelation) parseHiddenKey(key []byte) (*common.ID, uint32, error) {
+	id := &common.ID{TableID: rel.handle.ID()}
+	var row uint32
+	if _, err := fmt.Sscanf(string(key), "%d-%d-%d", &id.SegmentID, &id.BlockID, &row); err != nil {
+		return nil, 0, fmt.Errorf("tae: bad physical address %q", key)
+	}
+	return id, row, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+var filterOps = map[int]handle.FilterOp{
+	overload.EQ: handle.FilterEq,
+	overload.LT: handle.FilterLt,
+	overload.LE: handle.FilterLe,
+	overload.GT: handle.FilterGt,
+	overload.GE: handle.FilterGe,
+}
+
+// flippedOps maps the op of "const op col" to the op of "col op const"
+var flippedOps = map[handle.FilterOp]handle.FilterOp{
+	handle.FilterEq: handle.FilterEq,
+	handle.FilterLt: handle.FilterGt,
+	handle.FilterLe: handle.FilterGe,
+	handle.FilterGt: handle.FilterLt,
+	handle.FilterGe: handle.FilterLe,
+}
+
+// makeFilters converts the conjunctive comparisons between a column and a
+// constant of the column type in the scan condition into filters pruning
+// blocks by their zone maps. The other predicates are left to the scan
+func makeFilters(schema *catalog.Schema, e extend.Extend) (filters []*handle.Filter) {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return makeFilters(schema, v.E)
+	case *extend.BinaryExtend:
+		if v.Op == overload.And {
+			filters = append(filters, makeFilters(schema, v.Left)...)
+			return append(filters, makeFilters(schema, v.Right)...)
+		}
+		op, ok := filterOps[v.Op]
+		if !ok {
+			return
+		}
+		attr, lok := v.Left.(*extend.Attribute)
+		val, rok := v.Right.(*extend.ValueExtend)
+		if !lok || !rok {
+			if attr, lok = v.Right.(*extend.Attribute); !lok {
+				return
+			}
+			if val, rok = v.Left.(*extend.ValueExtend); !rok {
+				return
+			}
+			op = flippedOps[op]
+		}
+		if filter := makeColumnFilter(schema, attr, op, val); filter != nil {
+			filters = append(filters, filter)
+		}
+	}
+	return
+}
+
+func makeColumnFilter(schema *catalog.Schema, attr *extend.Attribute, op handle.FilterOp, val *extend.ValueExtend) *handle.Filter {
+	idx := schema.GetColIdx(attr.Name)
+	if idx == -1 || val.V == nil || nulls.Any(val.V.Nsp) {
+		return nil
+	}
+	// The zone maps compare values of the column type only
+	if schema.ColDefs[idx].Type.Oid != val.V.Typ.Oid {
+		return nil
+	}
+	return handle.NewColumnFilter(attr.Name, op, compute.GetValue(val.V, 0))
+}
+
+var planFilterOps = map[string]handle.FilterOp{
+	"=":  handle.FilterEq,
+	"<":  handle.FilterLt,
+	"<=": handle.FilterLe,
+	">":  handle.FilterGt,
+	">=": handle.FilterGe,
+}
+
+// makePlanFilters converts the sargable predicates of a plan2 scan into
+// filters pruning blocks by their zone maps. The constants not exactly
+// representable in the column type are skipped. The columns are the attrs
+// at their positions, or named as in the table if attrs is nil
+func makePlanFilters(schema *catalog.Schema, attrs []string, preds []*plan.Expr) (filters []*handle.Filter) {
+	for _, e := range preds {
+		f, ok := e.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 2 {
+			continue
+		}
+		op, ok := planFilterOps[f.F.Func.GetObjName()]
+		if !ok {
+			continue
+		}
+		col, lok := f.F.Args[0].Expr.(*plan.Expr_Col)
+		c, rok := f.F.Args[1].Expr.(*plan.Expr_C)
+		if !lok || !rok {
+			continue
+		}
+		name := col.Col.Name
+		if attrs != nil {
+			if col.Col.ColPos < 0 || int(col.Col.ColPos) >= len(attrs) {
+				continue
+			}
+			name = attrs[col.Col.ColPos]
+		}
+		idx := schema.GetColIdx(name)
+		if idx == -1 {
+			continue
+		}
+		def := schema.ColDefs[idx]
+		if val, ok := planConstValue(def.Type, c.C); ok {
+			filters = append(filters, handle.NewColumnFilter(def.Name, op, val))
+		}
+	}
+	return
+}
+
+func planConstValue(typ types.Type, c *plan.Const) (interface{}, bool) {
+	if c == nil || c.Isnull {
+		return nil, false
+	}
+	switch v := c.Value.(type) {
+	case *plan.Const_Ival:
+		switch typ.Oid {
+		case types.T_int8:
+			return int8(v.Ival), v.Ival >= math.MinInt8 && v.Ival <= math.MaxInt8
+		case types.T_int16:
+			return int16(v.Ival), v.Ival >= math.MinInt16 && v.Ival <= math.MaxInt16
+		case types.T_int32:
+			return int32(v.Ival), v.Ival >= math.MinInt32 && v.Ival <= math.MaxInt32
+		case types.T_int64:
+			return v.Ival, true
+		case types.T_uint8:
+			return uint8(v.Ival), v.Ival >= 0 && v.Ival <= math.MaxUint8
+		case types.T_uint16:
+			return uint16(v.Ival), v.Ival >= 0 && v.Ival <= math.MaxUint16
+		case types.T_uint32:
+			return uint32(v.Ival), v.Ival >= 0 && v.Ival <= math.MaxUint32
+		case types.T_uint64:
+			return uint64(v.Ival), v.Ival >= 0
+		case types.T_float64:
+			return float64(v.Ival), int64(float64(v.Ival)) == v.Ival
+		}
+	case *plan.Const_Dval:
+		switch typ.Oid {
+		case types.T_float32:
+			return float32(v.Dval), float64(float32(v.Dval)) == v.Dval
+		case types.T_float64:
+			return v.Dval, true
+		}
+	case *plan.Const_Sval:
+		switch typ.Oid {
+		case types.T_char, types.T_varchar:
+			return v.Sval, true
+		}
+	}
+	return nil, false
+}
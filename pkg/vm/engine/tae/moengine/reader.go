@@ -16,19 +16,24 @@ package moengine
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 var (
-	_ engine.Reader = (*txnReader)(nil)
+	_ engine.Reader           = (*txnReader)(nil)
+	_ engine.LimitedReader    = (*txnReader)(nil)
+	_ engine.CancelableReader = (*txnReader)(nil)
 )
 
 func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
-	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs)
+	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs) + len(hiddenAttrs)
 	cds := make([]*bytes.Buffer, attrCnt)
 	dds := make([]*bytes.Buffer, attrCnt)
 	for i := 0; i < attrCnt; i++ {
@@ -43,17 +48,73 @@ func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
 	}
 }
 
+// SetLimit stops the reader once the readers sharing limit have produced
+// the rows wanted
+func (r *txnReader) SetLimit(limit *engine.ScanLimit) {
+	r.limit = limit
+}
+
+// SetContext stops the reader with the error of ctx once it is canceled
+func (r *txnReader) SetContext(ctx context.Context) {
+	r.ctx = ctx
+}
+
+// Read produces the blocks of the relation in batches of the rows fitting
+// in engine.BatchBytes
 func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
-	r.it.Lock()
-	if !r.it.Valid() {
-		r.it.Unlock()
+	if r.limit.Done() {
 		return nil, nil
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
-	block := newBlock(h)
-	return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	if r.bat == nil {
+		if r.ctx != nil {
+			if err := r.ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		r.it.Lock()
+		if !r.it.Valid() {
+			r.it.Unlock()
+			return nil, nil
+		}
+		h := r.it.GetBlock()
+		var rows []uint32
+		if it, ok := r.it.(*blockListIt); ok {
+			rows = it.GetRows()
+		}
+		r.it.Next()
+		r.it.Unlock()
+		block := newBlock(h)
+		bat, err := block.Read(refCount, attrs, rows, r.compressed, r.decompressed)
+		if err != nil || len(bat.Vecs) == 0 {
+			return bat, err
+		}
+		if r.batchRows == 0 {
+			typs := make([]types.Type, len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				typs[i] = vec.Typ
+			}
+			r.batchRows = engine.RowsPerBatch(typs)
+		}
+		if r.rows = vector.Length(bat.Vecs[0]); r.rows <= r.batchRows {
+			return bat, nil
+		}
+		r.bat, r.offset = bat, 0
+	}
+	end := r.offset + r.batchRows
+	if end > r.rows {
+		end = r.rows
+	}
+	bat := batch.New(true, r.bat.Attrs)
+	for i, vec := range r.bat.Vecs {
+		// the windows share the data of the block
+		bat.Vecs[i] = vector.Window(vec, r.offset, end, vector.New(vec.Typ))
+		bat.Vecs[i].Or = true
+		bat.Vecs[i].Ref = vec.Ref
+	}
+	if r.offset = end; r.offset == r.rows {
+		r.bat = nil
+	}
+	return bat, nil
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
@@ -15,7 +15,10 @@
 package moengine
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
@@ -24,7 +27,12 @@ import (
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ engine.Relation          = (*txnRelation)(nil)
+	_ engine.HiddenRelation    = (*txnRelation)(nil)
+	_ engine.HiddenKeyRelation = (*txnRelation)(nil)
+	_ engine.PredicateRelation = (*txnRelation)(nil)
+	_ engine.BlockRelation     = (*txnRelation)(nil)
+	_ engine.IndexRelation     = (*txnRelation)(nil)
 )
 
 func newRelation(h handle.Relation) *txnRelation {
@@ -43,28 +51,53 @@ func (rel *txnRelation) Nodes(_ engine.Snapshot) (nodes engine.Nodes) {
 	return
 }
 
-func (_ *txnRelation) Size(_ string) int64 {
-	return 0
+func (rel *txnRelation) Size(attr string) int64 {
+	return rel.handle.Size(attr)
 }
 
-func (_ *txnRelation) CardinalNumber(_ string) int64 {
+// CardinalNumber returns the NDV of attr collected by the last ANALYZE
+func (rel *txnRelation) CardinalNumber(attr string) int64 {
+	stats := rel.handle.GetMeta().(*catalog.TableEntry).GetStats()
+	if stats == nil {
+		return 0
+	}
+	if col := stats.GetColumn(attr); col != nil {
+		return int64(col.NDV)
+	}
 	return 0
 }
 
-func (_ *txnRelation) CreateIndex(_ uint64, _ []engine.TableDef) error {
-	panic("implement me")
+func (rel *txnRelation) CreateIndex(ts uint64, defs []engine.TableDef) error {
+	for _, def := range defs {
+		if err := rel.AddTableDef(ts, def, nil); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (_ *txnRelation) DropIndex(_ uint64, _ string) error {
-	panic("implement me")
+func (rel *txnRelation) DropIndex(_ uint64, name string) error {
+	return rel.handle.DropIndex(name)
 }
 
-func (_ *txnRelation) AddTableDef(u uint64, def engine.TableDef, _ engine.Snapshot) error {
-	panic("implement me")
+// AddTableDef creates the secondary index of an index definition on a single
+// column. The other definitions are not supported
+func (rel *txnRelation) AddTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	idx, ok := def.(*engine.IndexTableDef)
+	if !ok || idx.Typ != engine.ZoneMap || len(idx.ColNames) != 1 {
+		return fmt.Errorf("tae: unsupported table definition %T", def)
+	}
+	return rel.handle.AddIndex(idx.Name, idx.ColNames[0])
 }
 
-func (_ *txnRelation) DelTableDef(u uint64, def engine.TableDef, _ engine.Snapshot) error {
-	panic("implement me")
+// DelTableDef drops the secondary index of an index definition. The other
+// definitions are not supported
+func (rel *txnRelation) DelTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	idx, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		return fmt.Errorf("tae: unsupported table definition %T", def)
+	}
+	return rel.handle.DropIndex(idx.Name)
 }
 
 func (rel *txnRelation) TableDefs(_ engine.Snapshot) []engine.TableDef {
@@ -78,8 +111,20 @@ func (rel *txnRelation) Rows() int64 {
 	return rel.handle.Rows()
 }
 
-func (_ *txnRelation) Index() []*engine.IndexTableDef {
-	panic("implement me")
+// Index returns the definitions of the secondary indexes
+func (rel *txnRelation) Index() []*engine.IndexTableDef {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	var defs []*engine.IndexTableDef
+	for _, colDef := range schema.ColDefs {
+		if colDef.HasIndex() {
+			defs = append(defs, &engine.IndexTableDef{
+				Typ:      engine.ZoneMap,
+				ColNames: []string{colDef.Name},
+				Name:     colDef.Index,
+			})
+		}
+	}
+	return defs
 }
 
 func (rel *txnRelation) GetPriKeyOrHideKey(_ engine.Snapshot) ([]engine.Attribute, bool) {
@@ -90,6 +135,12 @@ func (rel *txnRelation) GetPriKeyOrHideKey(_ engine.Snapshot) ([]engine.Attribut
 	return attrs, true
 }
 
+func (rel *txnRelation) HiddenAttributes(_ engine.Snapshot) []engine.Attribute {
+	attrs := make([]engine.Attribute, len(hiddenAttrs))
+	copy(attrs, hiddenAttrs)
+	return attrs
+}
+
 func (rel *txnRelation) Attribute() []engine.Attribute {
 	meta := rel.handle.GetMeta().(*catalog.TableEntry)
 	attrs := make([]engine.Attribute, len(meta.GetSchema().ColDefs))
@@ -105,8 +156,33 @@ func (rel *txnRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) err
 	return rel.handle.Append(bat)
 }
 
-func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
-	it := rel.handle.MakeBlockIt()
+func (rel *txnRelation) NewReader(num int, e extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
+	return rel.newReaders(num, makeFilters(rel.handle.Schema().(*catalog.Schema), e))
+}
+
+func (rel *txnRelation) NewReaderWithPredicates(num int, preds []*plan.Expr, _ engine.Snapshot) []engine.Reader {
+	return rel.newReaders(num, makePlanFilters(rel.handle.Schema().(*catalog.Schema), nil, preds))
+}
+
+func (rel *txnRelation) Blocks(preds []*plan.Expr, attrs []string, _ engine.Snapshot) ([]uint32, []*plan.BlockRef) {
+	return listBlocks(rel.handle, makePlanFilters(rel.handle.Schema().(*catalog.Schema), attrs, preds))
+}
+
+func (rel *txnRelation) NewBlockReader(blocks []*plan.BlockRef, _ engine.Snapshot) engine.Reader {
+	return newReader(rel.handle, newBlockListIt(rel.handle, blocks))
+}
+
+func (rel *txnRelation) Lookup(attr string, key *plan.Expr, _ engine.Snapshot) ([]*plan.BlockRef, bool) {
+	return lookupBlocks(rel.handle, attr, key)
+}
+
+func (rel *txnRelation) newReaders(num int, filters []*handle.Filter) (rds []engine.Reader) {
+	var it handle.BlockIt
+	if len(filters) > 0 {
+		it = rel.handle.MakeBlockItWithFilters(filters...)
+	} else {
+		it = rel.handle.MakeBlockIt()
+	}
 	for i := 0; i < num; i++ {
 		reader := newReader(rel.handle, it)
 		rds = append(rds, reader)
@@ -16,6 +16,9 @@ package moengine
 
 import (
 	"bytes"
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
@@ -58,6 +61,15 @@ type txnBlock struct {
 type txnReader struct {
 	handle       handle.Relation
 	it           handle.BlockIt
+	limit        *engine.ScanLimit
+	ctx          context.Context
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
+
+	// bat is the block read and not produced yet from the row offset, the
+	// batches produced have batchRows rows at most
+	bat       *batch.Batch
+	rows      int
+	offset    int
+	batchRows int
 }
@@ -14,17 +14,29 @@
 
 package options
 
+import "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+
+// CacheCfg is the capacity in bytes of the buffer managers
 type CacheCfg struct {
 	IndexCapacity  uint64 `toml:"index-cache-size"`
 	InsertCapacity uint64 `toml:"insert-cache-size"`
 	TxnCapacity    uint64 `toml:"txn-cache-size"`
+	// BlockCapacity is the capacity of the cache of the block data read
+	BlockCapacity uint64 `toml:"block-cache-size"`
 }
 
 type StorageCfg struct {
 	BlockMaxRows     uint32 `toml:"block-max-rows"`
 	SegmentMaxBlocks uint16 `toml:"segment-max-blocks"`
+	// DirectIO opens the local segment files with O_DIRECT to bypass the
+	// page cache. It falls back to the buffered I/O where not supported
+	DirectIO bool `toml:"direct-io"`
+	// DSync opens the local segment files with O_DSYNC
+	DSync bool `toml:"dsync"`
 }
 
+// CheckpointCfg configures the intervals in milliseconds of the background
+// scanner and checkpoint
 type CheckpointCfg struct {
 	ScannerInterval    int64 `toml:"scanner-inerterval"`
 	ExecutionInterval  int64 `toml:"execution-inerterval"`
@@ -33,7 +45,97 @@ type CheckpointCfg struct {
 	CatalogCkpInterval int64 `toml:"catalog-ckp-interval"`
 }
 
+type TxnCfg struct {
+	// SnapshotRetention is how long in milliseconds the versions of a
+	// timestamp are kept readable by StartTxnAt
+	SnapshotRetention int64 `toml:"snapshot-retention"`
+	// LockPolicy is one of TxnLockNone, TxnLockWait and TxnLockNoWait
+	LockPolicy string `toml:"lock-policy"`
+	// SpillRows is the number of rows appended to a table in a txn above
+	// which the rows are spilled to provisional blocks. Zero disables the
+	// spill
+	SpillRows uint32 `toml:"spill-rows"`
+	// MaxLifetime and MaxIdle are the max milliseconds a txn stays active
+	// and idle before it is killed. Zero disables the limit
+	MaxLifetime int64 `toml:"max-lifetime"`
+	MaxIdle     int64 `toml:"max-idle"`
+	// SweepInterval is the interval in milliseconds the expired txns are
+	// killed
+	SweepInterval int64 `toml:"sweep-interval"`
+}
+
 type SchedulerCfg struct {
 	IOWorkers    int `toml:"io-workers"`
 	AsyncWorkers int `toml:"async-workers"`
 }
+
+type WalCfg struct {
+	// SyncPolicy is WalSyncGroup, WalSyncInterval or WalSyncNone
+	SyncPolicy string `toml:"sync-policy"`
+	// SyncInterval is the interval in milliseconds of the syncs of
+	// WalSyncInterval. The WAL default is used if it is zero
+	SyncInterval int64 `toml:"sync-interval"`
+	// GroupCommitInterval is the max interval in milliseconds between the
+	// group syncs of the WAL. It bounds the window an async commit is not
+	// durable. The WAL default is used if it is zero
+	GroupCommitInterval int64 `toml:"group-commit-interval"`
+	// GroupCommitEntries is the number of the WAL entries synced at once
+	// before the group commit interval. It is not checked if zero
+	GroupCommitEntries int `toml:"group-commit-entries"`
+	// Compression is WalCompressNone or WalCompressLz4
+	Compression string `toml:"compression"`
+	// KeyProvider encrypts the WAL entries at rest if it is not nil. The
+	// WAL written with it cannot be replayed without it
+	KeyProvider entry.KeyProvider `toml:"-"`
+	// ArchiveDir is the directory the compacted WAL files are archived to
+	ArchiveDir string `toml:"archive-dir"`
+	// ArchiveToObjectStore archives the compacted WAL files to the object
+	// store under WalArchivePrefix of the prefix of ObjectStoreCfg
+	ArchiveToObjectStore bool `toml:"archive-to-object-store"`
+	// SalvageReplay skips the WAL entries failed to replay after logging
+	// them instead of failing the open
+	SalvageReplay bool `toml:"salvage-replay"`
+	// MaxFileSize is the size in bytes a WAL file is rotated at. The WAL
+	// default is used if it is zero
+	MaxFileSize int64 `toml:"max-file-size"`
+	// MaxTotalSize is the max size in bytes of the WAL not truncated. It is
+	// not checked if zero
+	MaxTotalSize int64 `toml:"max-total-size"`
+	// MaxAge is the max age in milliseconds of the oldest WAL file not
+	// truncated. It is not checked if zero
+	MaxAge int64 `toml:"max-age"`
+	// RetentionAction is WalRetentionCheckpoint or WalRetentionBlock
+	RetentionAction string `toml:"retention-action"`
+}
+
+type MetricsCfg struct {
+	// ListenAddr is the address of the HTTP listener exposing the metrics
+	// at /metrics. No listener is started if it is empty
+	ListenAddr string `toml:"listen-addr"`
+}
+
+// AdmissionCfg is the thresholds above which the appends are throttled. A
+// zero threshold is not checked
+type AdmissionCfg struct {
+	// MaxDirtyBytes is the max bytes of the memtables in memory
+	MaxDirtyBytes uint64 `toml:"max-dirty-bytes"`
+	// MaxWalBacklog is the max WAL entries not checkpointed
+	MaxWalBacklog uint64 `toml:"max-wal-backlog"`
+	// WaitInterval is the interval in milliseconds a throttled txn rechecks
+	// the thresholds
+	WaitInterval int64 `toml:"wait-interval"`
+}
+
+// ObjectStoreCfg configures the object store keeping the segment files. The
+// segment files stay on local disk if Endpoint is empty
+type ObjectStoreCfg struct {
+	Endpoint  string `toml:"endpoint"`
+	Region    string `toml:"region"`
+	Bucket    string `toml:"bucket"`
+	Prefix    string `toml:"prefix"`
+	AccessKey string `toml:"access-key"`
+	SecretKey string `toml:"secret-key"`
+	Secure    bool   `toml:"secure"`
+	// CacheCapacity is the bytes of the segment files cached on local disk
+	CacheCapacity int64 `toml:"cache-capacity"`
+}
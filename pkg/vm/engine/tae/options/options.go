@@ -14,42 +14,183 @@
 
 package options
 
+import "errors"
+
+var (
+	ErrInvalidCheckpointCfg  = errors.New("tae options: invalid checkpoint config")
+	ErrInvalidSchedulerCfg   = errors.New("tae options: invalid scheduler config")
+	ErrInvalidTxnCfg         = errors.New("tae options: invalid txn config")
+	ErrInvalidWalCfg         = errors.New("tae options: invalid wal config")
+	ErrInvalidObjectStoreCfg = errors.New("tae options: invalid object store config")
+	ErrInvalidAdmissionCfg   = errors.New("tae options: invalid admission config")
+)
+
+// FillDefaults fills the zero values with the defaults
 func (o *Options) FillDefaults(dirname string) *Options {
 	if o == nil {
 		o = &Options{}
 	}
 
 	if o.CacheCfg == nil {
-		o.CacheCfg = &CacheCfg{
-			IndexCapacity:  DefaultIndexCacheSize,
-			InsertCapacity: DefaultMTCacheSize,
-			TxnCapacity:    DefaultTxnCacheSize,
-		}
+		o.CacheCfg = &CacheCfg{}
+	}
+	if o.CacheCfg.IndexCapacity == 0 {
+		o.CacheCfg.IndexCapacity = DefaultIndexCacheSize
+	}
+	if o.CacheCfg.InsertCapacity == 0 {
+		o.CacheCfg.InsertCapacity = DefaultMTCacheSize
+	}
+	if o.CacheCfg.TxnCapacity == 0 {
+		o.CacheCfg.TxnCapacity = DefaultTxnCacheSize
+	}
+	if o.CacheCfg.BlockCapacity == 0 {
+		o.CacheCfg.BlockCapacity = DefaultBlockCacheSize
 	}
 
 	if o.StorageCfg == nil {
-		o.StorageCfg = &StorageCfg{
-			BlockMaxRows:     DefaultBlockMaxRows,
-			SegmentMaxBlocks: DefaultBlocksPerSegment,
-		}
+		o.StorageCfg = &StorageCfg{}
+	}
+	if o.StorageCfg.BlockMaxRows == 0 {
+		o.StorageCfg.BlockMaxRows = DefaultBlockMaxRows
+	}
+	if o.StorageCfg.SegmentMaxBlocks == 0 {
+		o.StorageCfg.SegmentMaxBlocks = DefaultBlocksPerSegment
 	}
 
 	if o.CheckpointCfg == nil {
-		o.CheckpointCfg = &CheckpointCfg{
-			ScannerInterval:    DefaultScannerInterval,
-			ExecutionInterval:  DefaultExecutionInterval,
-			ExecutionLevels:    DefaultExecutionLevels,
-			CatalogCkpInterval: DefaultCatalogCkpInterval,
-			CatalogUnCkpLimit:  DefaultCatalogUnCkpLimit,
-		}
+		o.CheckpointCfg = &CheckpointCfg{}
+	}
+	if o.CheckpointCfg.ScannerInterval == 0 {
+		o.CheckpointCfg.ScannerInterval = DefaultScannerInterval
+	}
+	if o.CheckpointCfg.ExecutionInterval == 0 {
+		o.CheckpointCfg.ExecutionInterval = DefaultExecutionInterval
+	}
+	if o.CheckpointCfg.ExecutionLevels == 0 {
+		o.CheckpointCfg.ExecutionLevels = DefaultExecutionLevels
+	}
+	if o.CheckpointCfg.CatalogCkpInterval == 0 {
+		o.CheckpointCfg.CatalogCkpInterval = DefaultCatalogCkpInterval
+	}
+	if o.CheckpointCfg.CatalogUnCkpLimit == 0 {
+		o.CheckpointCfg.CatalogUnCkpLimit = DefaultCatalogUnCkpLimit
 	}
 
 	if o.SchedulerCfg == nil {
-		o.SchedulerCfg = &SchedulerCfg{
-			IOWorkers:    DefaultIOWorkers,
-			AsyncWorkers: DefaultAsyncWorkers,
+		o.SchedulerCfg = &SchedulerCfg{}
+	}
+	if o.SchedulerCfg.IOWorkers == 0 {
+		o.SchedulerCfg.IOWorkers = DefaultIOWorkers
+	}
+	if o.SchedulerCfg.AsyncWorkers == 0 {
+		o.SchedulerCfg.AsyncWorkers = DefaultAsyncWorkers
+	}
+
+	if o.TxnCfg == nil {
+		o.TxnCfg = &TxnCfg{
+			SnapshotRetention: DefaultSnapshotRetention,
 		}
 	}
+	if o.TxnCfg.LockPolicy == "" {
+		o.TxnCfg.LockPolicy = DefaultTxnLockPolicy
+	}
+	if o.TxnCfg.SweepInterval == 0 {
+		o.TxnCfg.SweepInterval = DefaultTxnSweepInterval
+	}
+
+	if o.WalCfg == nil {
+		o.WalCfg = &WalCfg{}
+	}
+	if o.WalCfg.SyncPolicy == "" {
+		o.WalCfg.SyncPolicy = DefaultWalSyncPolicy
+	}
+	if o.WalCfg.Compression == "" {
+		o.WalCfg.Compression = DefaultWalCompression
+	}
+	if o.WalCfg.RetentionAction == "" {
+		o.WalCfg.RetentionAction = DefaultWalRetentionAction
+	}
+
+	if o.MetricsCfg == nil {
+		o.MetricsCfg = &MetricsCfg{}
+	}
+
+	if o.ObjectStoreCfg == nil {
+		o.ObjectStoreCfg = &ObjectStoreCfg{}
+	}
+	if o.ObjectStoreCfg.CacheCapacity == 0 {
+		o.ObjectStoreCfg.CacheCapacity = DefaultObjectCacheCapacity
+	}
+
+	if o.AdmissionCfg == nil {
+		o.AdmissionCfg = &AdmissionCfg{}
+	}
+	if o.AdmissionCfg.WaitInterval == 0 {
+		o.AdmissionCfg.WaitInterval = DefaultAdmissionWaitInterval
+	}
 
 	return o
 }
+
+// Validate checks the options filled by FillDefaults
+func (o *Options) Validate() error {
+	checkpointCfg := o.CheckpointCfg
+	if checkpointCfg.ScannerInterval < 0 ||
+		checkpointCfg.ExecutionInterval < 0 ||
+		checkpointCfg.ExecutionLevels < 0 ||
+		checkpointCfg.CatalogUnCkpLimit < 0 ||
+		checkpointCfg.CatalogCkpInterval < 0 ||
+		checkpointCfg.CatalogCkpInterval >= MaxCatalogCkpInterval {
+		return ErrInvalidCheckpointCfg
+	}
+	if o.SchedulerCfg.IOWorkers < 0 || o.SchedulerCfg.AsyncWorkers < 0 {
+		return ErrInvalidSchedulerCfg
+	}
+	if o.TxnCfg.SnapshotRetention < 0 ||
+		o.TxnCfg.MaxLifetime < 0 ||
+		o.TxnCfg.MaxIdle < 0 ||
+		o.TxnCfg.SweepInterval < 0 {
+		return ErrInvalidTxnCfg
+	}
+	switch o.TxnCfg.LockPolicy {
+	case TxnLockNone, TxnLockWait, TxnLockNoWait:
+	default:
+		return ErrInvalidTxnCfg
+	}
+	switch o.WalCfg.SyncPolicy {
+	case WalSyncGroup, WalSyncInterval, WalSyncNone:
+	default:
+		return ErrInvalidWalCfg
+	}
+	if o.WalCfg.SyncInterval < 0 {
+		return ErrInvalidWalCfg
+	}
+	if o.WalCfg.GroupCommitInterval < 0 || o.WalCfg.GroupCommitEntries < 0 {
+		return ErrInvalidWalCfg
+	}
+	if o.WalCfg.ArchiveToObjectStore &&
+		(o.WalCfg.ArchiveDir != "" || o.ObjectStoreCfg.Endpoint == "") {
+		return ErrInvalidWalCfg
+	}
+	switch o.WalCfg.Compression {
+	case WalCompressNone, WalCompressLz4:
+	default:
+		return ErrInvalidWalCfg
+	}
+	if o.WalCfg.MaxFileSize < 0 || o.WalCfg.MaxTotalSize < 0 || o.WalCfg.MaxAge < 0 {
+		return ErrInvalidWalCfg
+	}
+	switch o.WalCfg.RetentionAction {
+	case WalRetentionCheckpoint, WalRetentionBlock:
+	default:
+		return ErrInvalidWalCfg
+	}
+	if o.ObjectStoreCfg.Endpoint != "" && o.ObjectStoreCfg.Bucket == "" ||
+		o.ObjectStoreCfg.CacheCapacity < 0 {
+		return ErrInvalidObjectStoreCfg
+	}
+	if o.AdmissionCfg.WaitInterval < 0 {
+		return ErrInvalidAdmissionCfg
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillDefaults(t *testing.T) {
+	var opts *Options
+	opts = opts.FillDefaults("")
+	assert.Nil(t, opts.Validate())
+	assert.Equal(t, DefaultBlockMaxRows, opts.StorageCfg.BlockMaxRows)
+	assert.Equal(t, DefaultWalSyncPolicy, opts.WalCfg.SyncPolicy)
+	assert.Equal(t, DefaultTxnLockPolicy, opts.TxnCfg.LockPolicy)
+	assert.False(t, opts.ReadOnly)
+
+	// Only the zero values are filled
+	opts = &Options{
+		CheckpointCfg: &CheckpointCfg{ScannerInterval: 10},
+	}
+	opts = opts.FillDefaults("")
+	assert.Equal(t, int64(10), opts.CheckpointCfg.ScannerInterval)
+	assert.Equal(t, DefaultExecutionLevels, opts.CheckpointCfg.ExecutionLevels)
+	assert.Equal(t, DefaultCatalogCkpInterval, opts.CheckpointCfg.CatalogCkpInterval)
+}
+
+func TestValidate(t *testing.T) {
+	opts := new(Options).FillDefaults("")
+	opts.WalCfg.SyncPolicy = "xxx"
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.SyncPolicy = WalSyncNone
+	assert.Nil(t, opts.Validate())
+	opts.WalCfg.SyncPolicy = WalSyncInterval
+	opts.WalCfg.SyncInterval = -1
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.SyncInterval = 100
+	assert.Nil(t, opts.Validate())
+	opts.WalCfg.GroupCommitInterval = -1
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.GroupCommitInterval = 10
+	assert.Nil(t, opts.Validate())
+	opts.WalCfg.GroupCommitEntries = -1
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.GroupCommitEntries = 64
+	assert.Nil(t, opts.Validate())
+	opts.WalCfg.Compression = "xxx"
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.Compression = WalCompressLz4
+	assert.Nil(t, opts.Validate())
+	opts.WalCfg.ArchiveToObjectStore = true
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.ArchiveToObjectStore = false
+	assert.Equal(t, WalRetentionCheckpoint, opts.WalCfg.RetentionAction)
+	opts.WalCfg.RetentionAction = "xxx"
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.RetentionAction = WalRetentionBlock
+	assert.Nil(t, opts.Validate())
+	opts.WalCfg.MaxTotalSize = -1
+	assert.Equal(t, ErrInvalidWalCfg, opts.Validate())
+	opts.WalCfg.MaxTotalSize = 0
+
+	opts.CheckpointCfg.CatalogCkpInterval = MaxCatalogCkpInterval
+	assert.Equal(t, ErrInvalidCheckpointCfg, opts.Validate())
+	opts.CheckpointCfg.CatalogCkpInterval = -1
+	assert.Equal(t, ErrInvalidCheckpointCfg, opts.Validate())
+	opts.CheckpointCfg.CatalogCkpInterval = DefaultCatalogCkpInterval
+
+	opts.SchedulerCfg.IOWorkers = -1
+	assert.Equal(t, ErrInvalidSchedulerCfg, opts.Validate())
+	opts.SchedulerCfg.IOWorkers = DefaultIOWorkers
+
+	opts.TxnCfg.SnapshotRetention = -1
+	assert.Equal(t, ErrInvalidTxnCfg, opts.Validate())
+	opts.TxnCfg.SnapshotRetention = 0
+	opts.TxnCfg.LockPolicy = "xxx"
+	assert.Equal(t, ErrInvalidTxnCfg, opts.Validate())
+	opts.TxnCfg.LockPolicy = TxnLockWait
+	assert.Nil(t, opts.Validate())
+}
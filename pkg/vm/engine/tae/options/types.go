@@ -17,12 +17,14 @@ package options
 import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
 )
 
 const (
 	DefaultTxnCacheSize   = 256 * common.M
 	DefaultIndexCacheSize = 128 * common.M
 	DefaultMTCacheSize    = 4 * common.G
+	DefaultBlockCacheSize = 256 * common.M
 
 	DefaultBlockMaxRows     = uint32(40000)
 	DefaultBlocksPerSegment = uint16(40)
@@ -33,14 +35,87 @@ const (
 	DefaultCatalogCkpInterval = int64(60000) // millisecond
 	DefaultCatalogUnCkpLimit  = int64(10)
 
+	DefaultSnapshotRetention = int64(0)    // millisecond
+	DefaultTxnSweepInterval  = int64(1000) // millisecond
+
 	DefaultIOWorkers    = int(8)
 	DefaultAsyncWorkers = int(16)
+
+	DefaultWalSyncPolicy = WalSyncGroup
+
+	DefaultWalCompression = WalCompressNone
+
+	DefaultWalRetentionAction = WalRetentionCheckpoint
+
+	DefaultTxnLockPolicy = TxnLockNone
+
+	DefaultObjectCacheCapacity = int64(4 * common.G)
+
+	DefaultAdmissionWaitInterval = int64(10) // millisecond
+
+	// MaxCatalogCkpInterval is the max interval the catalog stays
+	// uncheckpointed
+	MaxCatalogCkpInterval = int64(180000) // millisecond
+)
+
+const (
+	// WalSyncGroup fsyncs the WAL once for each group of committed entries
+	WalSyncGroup = "group"
+	// WalSyncNone leaves the WAL flush to the OS. A crash of the host may
+	// lose the last committed txns
+	WalSyncNone = "none"
+	// WalSyncInterval fsyncs the WAL entries of the txns every sync
+	// interval. A crash may lose the txns committed in the last interval
+	WalSyncInterval = "interval"
+)
+
+const (
+	// WalCompressNone writes the WAL payloads as is
+	WalCompressNone = "none"
+	// WalCompressLz4 compresses the large WAL payloads by lz4
+	WalCompressLz4 = "lz4"
+)
+
+const (
+	// WalRetentionCheckpoint forces a checkpoint once the WAL exceeds the
+	// retention limits
+	WalRetentionCheckpoint = "checkpoint"
+	// WalRetentionBlock fails the commits once the WAL exceeds the
+	// retention limits until it is checkpointed and truncated
+	WalRetentionBlock = "block"
+)
+
+// WalArchivePrefix is the prefix of the keys of the WAL files archived to
+// the object store
+const WalArchivePrefix = "wal-archive/"
+
+const (
+	// TxnLockNone resolves the write conflicts at the write and commit
+	TxnLockNone = "none"
+	// TxnLockWait locks the rows updated or deleted by a txn until it is
+	// done. A txn waits for the rows locked by another txn and fails if the
+	// wait would deadlock
+	TxnLockWait = "wait"
+	// TxnLockNoWait locks the rows like TxnLockWait but a txn fails at once
+	// on the rows locked by another txn
+	TxnLockNoWait = "no-wait"
 )
 
 type Options struct {
-	CacheCfg      *CacheCfg      `toml:"cache-cfg"`
-	StorageCfg    *StorageCfg    `toml:"storage-cfg"`
-	CheckpointCfg *CheckpointCfg `toml:"checkpoint-cfg"`
-	SchedulerCfg  *SchedulerCfg  `toml:"scheduler-cfg"`
-	Catalog       *catalog.Catalog
+	CacheCfg       *CacheCfg       `toml:"cache-cfg"`
+	StorageCfg     *StorageCfg     `toml:"storage-cfg"`
+	CheckpointCfg  *CheckpointCfg  `toml:"checkpoint-cfg"`
+	SchedulerCfg   *SchedulerCfg   `toml:"scheduler-cfg"`
+	TxnCfg         *TxnCfg         `toml:"txn-cfg"`
+	WalCfg         *WalCfg         `toml:"wal-cfg"`
+	MetricsCfg     *MetricsCfg     `toml:"metrics-cfg"`
+	ObjectStoreCfg *ObjectStoreCfg `toml:"object-store-cfg"`
+	AdmissionCfg   *AdmissionCfg   `toml:"admission-cfg"`
+	// ReadOnly opens the db without the background checkpoint and compaction
+	// and rejects the commit of any write
+	ReadOnly bool `toml:"read-only"`
+	Catalog  *catalog.Catalog
+	// KeyProvider wraps the data keys of the segment files encrypted at
+	// rest. The segment files are not encrypted if it is nil
+	KeyProvider segment.KeyProvider
 }
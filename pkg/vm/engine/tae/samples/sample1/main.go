@@ -15,6 +15,8 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"os"
 	"runtime/pprof"
 	"sync"
@@ -23,8 +25,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/panjf2000/ants/v2"
 )
 
@@ -32,6 +37,8 @@ var sampleDir = "/tmp/sample1"
 var dbName = "db"
 var cpuprofile = "/tmp/sample1/cpuprofile"
 var memprofile = "/tmp/sample1/memprofile"
+var metricsAddr = flag.String("metrics-addr", "", "address to expose the metrics at /metrics")
+var maxDirtyBytes = flag.Uint64("max-dirty-bytes", common.G, "memtable bytes above which the appends are throttled")
 
 func init() {
 	os.RemoveAll(sampleDir)
@@ -50,7 +57,11 @@ func stopProfile() {
 }
 
 func main() {
-	tae, _ := db.Open(sampleDir, nil)
+	flag.Parse()
+	opts := new(options.Options)
+	opts.MetricsCfg = &options.MetricsCfg{ListenAddr: *metricsAddr}
+	opts.AdmissionCfg = &options.AdmissionCfg{MaxDirtyBytes: *maxDirtyBytes}
+	tae, _ := db.Open(sampleDir, opts)
 	defer tae.Close()
 
 	schema := catalog.MockSchemaAll(10)
@@ -73,7 +84,12 @@ func main() {
 	doAppend := func(b *batch.Batch) func() {
 		return func() {
 			defer wg.Done()
-			txn := tae.StartTxn(nil)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			txn, err := tae.StartTxnWithContext(ctx, nil)
+			if err != nil {
+				panic(err)
+			}
 			db, err := txn.GetDatabase(dbName)
 			if err != nil {
 				panic(err)
@@ -82,7 +98,11 @@ func main() {
 			if err != nil {
 				panic(err)
 			}
-			if err := rel.Append(b); err != nil {
+			// Back off while the flush catches up
+			for err = rel.Append(b); err == txnbase.ErrTxnBusy; err = rel.Append(b) {
+				time.Sleep(10 * time.Millisecond)
+			}
+			if err != nil {
 				panic(err)
 			}
 			if err := txn.Commit(); err != nil {
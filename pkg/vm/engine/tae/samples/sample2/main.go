@@ -16,6 +16,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"os"
 	"runtime/pprof"
 	"sync"
@@ -28,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/panjf2000/ants/v2"
 )
 
@@ -35,6 +37,7 @@ var sampleDir = "/tmp/sample2"
 var dbName = "db"
 var cpuprofile = "/tmp/sample2/cpuprofile"
 var memprofile = "/tmp/sample2/memprofile"
+var metricsAddr = flag.String("metrics-addr", "", "address to expose the metrics at /metrics")
 
 func init() {
 	os.RemoveAll(sampleDir)
@@ -55,7 +58,10 @@ func stopProfile() {
 }
 
 func main() {
-	tae, _ := db.Open(sampleDir, nil)
+	flag.Parse()
+	opts := new(options.Options)
+	opts.MetricsCfg = &options.MetricsCfg{ListenAddr: *metricsAddr}
+	tae, _ := db.Open(sampleDir, opts)
 	defer tae.Close()
 
 	schema := catalog.MockSchemaAll(10)
@@ -15,6 +15,7 @@
 package main
 
 import (
+	"flag"
 	"os"
 	"runtime/pprof"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/panjf2000/ants/v2"
 )
 
@@ -38,6 +40,7 @@ var sampleDir = "/tmp/sample3"
 var dbName = "db"
 var cpuprofile = "/tmp/sample3/cpuprofile"
 var memprofile = "/tmp/sample3/memprofile"
+var metricsAddr = flag.String("metrics-addr", "", "address to expose the metrics at /metrics")
 
 func init() {
 	os.RemoveAll(sampleDir)
@@ -58,7 +61,10 @@ func stopProfile() {
 }
 
 func main() {
-	tae, _ := db.Open(sampleDir, nil)
+	flag.Parse()
+	opts := new(options.Options)
+	opts.MetricsCfg = &options.MetricsCfg{ListenAddr: *metricsAddr}
+	tae, _ := db.Open(sampleDir, opts)
 	defer tae.Close()
 	eng := moengine.NewEngine(tae)
 
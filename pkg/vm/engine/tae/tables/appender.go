@@ -17,6 +17,7 @@ package tables
 import (
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/access/acif"
 )
@@ -85,6 +86,9 @@ func (appender *blockAppender) OnReplayInsertNode(bat *gbat.Batch, offset, lengt
 	if err != nil {
 		panic(err)
 	}
+	if err = appender.indexAppender.BatchInsertColumns(bat, offset, int(length), from); err != nil {
+		panic(err)
+	}
 
 	return
 }
@@ -108,7 +112,12 @@ func (appender *blockAppender) ApplyAppend(bat *gbat.Batch, offset, length uint3
 	if err != nil {
 		panic(err)
 	}
-	node = appender.node.block.mvcc.AddAppendNodeLocked(txn, appender.node.rows)
+	if err = appender.indexAppender.BatchInsertColumns(bat, offset, int(length), from); err != nil {
+		panic(err)
+	}
+	an := appender.node.block.mvcc.AddAppendNodeLocked(txn, appender.node.rows)
+	an.SetChanges(length, compute.EstimateSize(bat, offset, length))
+	node = an
 	// appender.node.block.mvcc.SetMaxVisible(txn.GetCommitTS())
 
 	return
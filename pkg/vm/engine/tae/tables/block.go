@@ -17,6 +17,7 @@ package tables
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 
@@ -37,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -63,8 +66,16 @@ type dataBlock struct {
 
 func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
 	colCnt := len(meta.GetSchema().ColDefs)
+	// Every column has a zone map. The sort key has a bloom filter as well
+	// and the indexed columns have a bloom filter and a row index
 	indexCnt := make(map[int]int)
+	for i := 0; i < colCnt; i++ {
+		indexCnt[i] = 1
+	}
 	indexCnt[int(meta.GetSchema().PrimaryKey)] = 2
+	for _, idx := range meta.GetSchema().IndexedColumns() {
+		indexCnt[idx] = 3
+	}
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
@@ -74,6 +85,7 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 		if colBlk, err := file.OpenColumn(i); err != nil {
 			panic(err)
 		} else {
+			colBlk.SetCompression(meta.GetSchema().ColDefs[i].CompressAlgo())
 			colFiles[i], err = colBlk.OpenDataFile()
 			if err != nil {
 				panic(err)
@@ -104,9 +116,20 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 
 func (blk *dataBlock) ReplayData() (err error) {
 	if blk.meta.IsAppendable() {
-		w, _ := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
+		schema := blk.meta.GetSchema()
+		holder := blk.indexHolder.(acif.IAppendableBlockIndexHolder)
+		w, _ := blk.getVectorWrapper(int(schema.PrimaryKey))
 		defer common.GPool.Free(w.MNode)
-		err = blk.indexHolder.(acif.IAppendableBlockIndexHolder).BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false)
+		if err = holder.BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false); err != nil {
+			return
+		}
+		bat := gbat.New(true, schema.Attrs())
+		for _, idx := range schema.IndexedColumns() {
+			cw, _ := blk.getVectorWrapper(idx)
+			defer common.GPool.Free(cw.MNode)
+			bat.Vecs[idx] = &cw.Vector
+		}
+		err = holder.BatchInsertColumns(bat, 0, gvec.Length(&w.Vector), 0)
 		return
 	}
 	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).InitFromHost(blk, blk.meta.GetSchema(), idxCommon.MockIndexBufferManager /* TODO: use dedicated index buffer manager */)
@@ -153,6 +176,13 @@ func (blk *dataBlock) Destroy() (err error) {
 	return
 }
 
+func (blk *dataBlock) Prefetch() {
+	if blk.meta.IsAppendable() {
+		return
+	}
+	blk.file.Prefetch()
+}
+
 func (blk *dataBlock) GetBlockFile() file.Block {
 	return blk.file
 }
@@ -387,11 +417,54 @@ func (blk *dataBlock) GetPKColumnDataOptimized(ts uint64) (view *model.ColumnVie
 }
 
 func (blk *dataBlock) GetColumnDataByName(txn txnif.AsyncTxn, attr string, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
-	colIdx := blk.meta.GetSchema().GetColIdx(attr)
+	colIdx := blk.meta.GetSegment().GetTable().TxnGetSchema(txn).GetColIdx(attr)
 	return blk.GetColumnDataById(txn, colIdx, compressed, decompressed)
 }
 
+// mapColumn maps the column index in the schema visible to txn to the index
+// in the schema the block was created with by the stable column id. If the
+// column was added after the block was created, it returns -1 and the column
+// definition
+func (blk *dataBlock) mapColumn(txn txnif.AsyncTxn, colIdx int) (int, *catalog.ColDef) {
+	schema := blk.meta.GetSegment().GetTable().TxnGetSchema(txn)
+	blkSchema := blk.meta.GetSchema()
+	if schema == blkSchema {
+		return colIdx, nil
+	}
+	def := schema.ColDefs[colIdx]
+	return blkSchema.GetColIdxBySeqNum(def.SeqNum), def
+}
+
+// getDefaultColumnData up-converts the block by filling the default value
+// of a column added after the block was created
+func (blk *dataBlock) getDefaultColumnData(ts uint64, colIdx int, def *catalog.ColDef) (view *model.ColumnView, err error) {
+	rows := 0
+	if blk.meta.IsAppendable() {
+		blk.mvcc.RLock()
+		maxRow, visible := blk.mvcc.GetMaxVisibleRowLocked(ts)
+		blk.mvcc.RUnlock()
+		if !visible {
+			return
+		}
+		rows = int(maxRow)
+	} else {
+		rows = int(blk.file.ReadRows())
+	}
+	view = model.NewColumnView(ts, colIdx)
+	view.RawVec = compute.MakeConstVector(def.Type, rows, def.DefaultValue())
+	blk.mvcc.RLock()
+	blk.FillColumnDeletes(view)
+	blk.mvcc.RUnlock()
+	err = view.Eval(true)
+	return
+}
+
 func (blk *dataBlock) GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
+	if blkIdx, def := blk.mapColumn(txn, colIdx); blkIdx == -1 {
+		return blk.getDefaultColumnData(txn.GetStartTS(), colIdx, def)
+	} else {
+		colIdx = blkIdx
+	}
 	if blk.meta.IsAppendable() {
 		return blk.getVectorCopy(txn.GetStartTS(), colIdx, compressed, decompressed, false)
 	}
@@ -459,7 +532,12 @@ func (blk *dataBlock) getVectorCopy(ts uint64, colIdx int, compressed, decompres
 }
 
 func (blk *dataBlock) Update(txn txnif.AsyncTxn, row uint32, colIdx uint16, v interface{}) (node txnif.UpdateNode, err error) {
-	return blk.updateWithFineLock(txn, row, colIdx, v)
+	blkIdx, _ := blk.mapColumn(txn, int(colIdx))
+	if blkIdx == -1 {
+		err = data.ErrColumnNotMaterialized
+		return
+	}
+	return blk.updateWithFineLock(txn, row, uint16(blkIdx), v)
 }
 
 func (blk *dataBlock) OnReplayUpdate(row uint32, colIdx uint16, v interface{}) (err error) {
@@ -531,7 +609,28 @@ func (blk *dataBlock) RangeDelete(txn txnif.AsyncTxn, start, end uint32) (node t
 	return
 }
 
+// GetRowCommitTS returns the commit ts of the append of row. The rows of a
+// non-appendable block were all committed by the txn creating the block,
+// which is the only commit ts kept for them
+func (blk *dataBlock) GetRowCommitTS(row uint32) uint64 {
+	blk.mvcc.RLock()
+	ts, ok := blk.mvcc.GetRowCommitTSLocked(row)
+	blk.mvcc.RUnlock()
+	if ok {
+		return ts
+	}
+	blk.meta.RLock()
+	defer blk.meta.RUnlock()
+	return blk.meta.CreateAt
+}
+
 func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (v interface{}, err error) {
+	blkIdx, def := blk.mapColumn(txn, int(col))
+	if blkIdx == -1 {
+		v = def.DefaultValue()
+		return
+	}
+	col = uint16(blkIdx)
 	ts := txn.GetStartTS()
 	blk.mvcc.RLock()
 	deleteChain := blk.mvcc.GetDeleteChain()
@@ -647,6 +746,107 @@ func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (of
 	return blk.blkGetByFilter(txn.GetStartTS(), filter)
 }
 
+// GetRowsByIndex returns the rows visible to txn whose column colIdx
+// equals key. The rows are searched in the index of the column unless the
+// block is created before the index or the column has been updated since the
+// index was built, in which case the column is scanned
+func (blk *dataBlock) GetRowsByIndex(txn txnif.AsyncTxn, colIdx int, key interface{}) (rows []uint32, err error) {
+	blkIdx, _ := blk.mapColumn(txn, colIdx)
+	if blkIdx == -1 {
+		return
+	}
+	if s, ok := key.(string); ok {
+		key = []byte(s)
+	}
+	if blk.indexHolder != nil && blk.mvcc.GetColumnUpdateCnt(uint16(blkIdx)) == 0 {
+		var indexed bool
+		if rows, indexed, err = blk.searchIndex(txn.GetStartTS(), uint16(blkIdx), key); err != nil || indexed {
+			return
+		}
+	}
+	view, err := blk.GetColumnDataById(txn, colIdx, nil, nil)
+	if err != nil || view == nil {
+		return
+	}
+	defer view.Free()
+	data := view.AppliedVec
+	typ := blk.meta.GetSchema().ColDefs[blkIdx].Type
+	for row := 0; row < view.Length(); row++ {
+		if view.DeleteMask != nil && view.DeleteMask.Contains(uint32(row)) {
+			continue
+		}
+		if nulls.Contains(data.Nsp, uint64(row)) {
+			continue
+		}
+		v := compute.GetValue(data, uint32(row))
+		if s, ok := v.(string); ok {
+			v = []byte(s)
+		}
+		if common.CompareGeneric(v, key, typ) == 0 {
+			rows = append(rows, uint32(row))
+		}
+	}
+	return
+}
+
+// searchIndex returns the rows visible at ts whose column colIdx equals key
+// by the index of the column. indexed is false if the block has no index of
+// the column
+func (blk *dataBlock) searchIndex(ts uint64, colIdx uint16, key interface{}) (rows []uint32, indexed bool, err error) {
+	readLock := blk.mvcc.GetSharedLock()
+	defer readLock.Unlock()
+	found, indexed, err := blk.indexHolder.SearchColumn(colIdx, key)
+	if err != nil || !indexed {
+		return
+	}
+	// The rows appended after ts are not visible
+	maxRow := uint32(math.MaxUint32)
+	if blk.meta.IsAppendable() {
+		var visible bool
+		if maxRow, visible = blk.mvcc.GetMaxVisibleRowLocked(ts); !visible {
+			return
+		}
+	}
+	for _, row := range found {
+		if row >= maxRow {
+			break
+		}
+		if blk.mvcc.IsDeletedLocked(row, ts) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return
+}
+
+// MayMatch returns false if the zone maps of the block prove no row satisfies
+// all the filters. Appendable blocks and the columns updated since the zone
+// maps were built are never pruned
+func (blk *dataBlock) MayMatch(txn txnif.AsyncTxn, filters ...*handle.Filter) bool {
+	if blk.meta.IsAppendable() || blk.indexHolder == nil {
+		return true
+	}
+	holder := blk.indexHolder.(acif.INonAppendableBlockIndexHolder)
+	schema := blk.meta.GetSegment().GetTable().TxnGetSchema(txn)
+	for _, filter := range filters {
+		colIdx := schema.GetColIdx(filter.Attr)
+		if colIdx == -1 {
+			continue
+		}
+		blkIdx, _ := blk.mapColumn(txn, colIdx)
+		if blkIdx == -1 || blk.mvcc.GetColumnUpdateCnt(uint16(blkIdx)) != 0 {
+			continue
+		}
+		if s, ok := filter.Val.(string); ok {
+			filter = &handle.Filter{Op: filter.Op, Val: []byte(s), Attr: filter.Attr}
+		}
+		if !holder.MayMatch(uint16(blkIdx), filter) {
+			return false
+		}
+	}
+	return true
+}
+
 func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err error) {
 	if blk.meta.IsAppendable() {
 		readLock := blk.mvcc.GetSharedLock()
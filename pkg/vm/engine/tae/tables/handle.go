@@ -21,18 +21,21 @@ import (
 )
 
 type tableHandle struct {
-	table    *dataTable
-	block    *dataBlock
-	appender data.BlockAppender
+	table *dataTable
+	// blocks and appenders are the appendable blocks of the partitions
+	blocks    map[uint32]*dataBlock
+	appenders map[uint32]data.BlockAppender
 }
 
-func newHandle(table *dataTable, block *dataBlock) *tableHandle {
+func newHandle(table *dataTable, blocks map[uint32]*dataBlock) *tableHandle {
 	h := &tableHandle{
-		table: table,
-		block: block,
+		table:     table,
+		blocks:    make(map[uint32]*dataBlock),
+		appenders: make(map[uint32]data.BlockAppender),
 	}
-	if block != nil {
-		h.appender, _ = block.MakeAppender()
+	for partition, block := range blocks {
+		h.blocks[partition] = block
+		h.appenders[partition], _ = block.MakeAppender()
 	}
 	return h
 }
@@ -41,41 +44,46 @@ func (h *tableHandle) SetAppender(id *common.ID) (appender data.BlockAppender) {
 	tableMeta := h.table.meta
 	segMeta, _ := tableMeta.GetSegmentByID(id.SegmentID)
 	blkMeta, _ := segMeta.GetBlockEntryByID(id.BlockID)
-	h.block = blkMeta.GetBlockData().(*dataBlock)
-	h.appender, _ = h.block.MakeAppender()
+	partition := segMeta.GetPartition()
+	h.blocks[partition] = blkMeta.GetBlockData().(*dataBlock)
+	h.appenders[partition], _ = h.blocks[partition].MakeAppender()
 
-	return h.appender
+	return h.appenders[partition]
 }
 
 func (h *tableHandle) GetAppender() (appender data.BlockAppender, err error) {
+	return h.GetPartitionAppender(0)
+}
+
+func (h *tableHandle) GetPartitionAppender(partition uint32) (appender data.BlockAppender, err error) {
 	var segEntry *catalog.SegmentEntry
-	if h.appender == nil {
-		segEntry = h.table.meta.LastAppendableSegmemt()
+	if h.appenders[partition] == nil {
+		segEntry = h.table.meta.LastAppendableSegmentInPartition(partition)
 		if segEntry == nil {
 			err = data.ErrAppendableSegmentNotFound
 			return
 		}
 		blkEntry := segEntry.LastAppendableBlock()
-		h.block = blkEntry.GetBlockData().(*dataBlock)
-		h.appender, err = h.block.MakeAppender()
+		h.blocks[partition] = blkEntry.GetBlockData().(*dataBlock)
+		h.appenders[partition], err = h.blocks[partition].MakeAppender()
 		if err != nil {
 			panic(err)
 		}
 	}
-	if !h.appender.IsAppendable() {
-		id := h.appender.GetID()
+	if !h.appenders[partition].IsAppendable() {
+		id := h.appenders[partition].GetID()
 		segEntry, _ = h.table.meta.GetSegmentByID(id.SegmentID)
 		if segEntry.GetAppendableBlockCnt() >= int(segEntry.GetTable().GetSchema().SegmentMaxBlocks) {
 			err = data.ErrAppendableSegmentNotFound
 		} else {
 			err = data.ErrAppendableBlockNotFound
 
-			appender = h.appender
+			appender = h.appenders[partition]
 		}
-		h.block = nil
-		h.appender = nil
+		delete(h.blocks, partition)
+		delete(h.appenders, partition)
 		return
 	}
-	appender = h.appender
+	appender = h.appenders[partition]
 	return
 }
@@ -15,9 +15,12 @@
 package jobs
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
@@ -49,6 +52,7 @@ func NewCompactABlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.
 func (task *compactABlockTask) Scopes() []common.ID { return task.scopes }
 
 func (task *compactABlockTask) Execute() (err error) {
+	defer metrics.ObserveSince(metrics.CompactionDuration.WithLabelValues(metrics.CompactionABlock), time.Now())
 	dataBlock := task.meta.GetBlockData()
 	return dataBlock.ForceCompact()
 }
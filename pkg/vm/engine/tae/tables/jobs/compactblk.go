@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/txnentries"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
@@ -76,10 +77,13 @@ func NewCompactBlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.B
 func (task *compactBlockTask) Scopes() []common.ID { return task.scopes }
 
 func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
-	attrs := task.meta.GetSchema().Attrs()
+	// The compacted block is up-converted to the schema visible to the txn,
+	// which is also the one the created block is going to use
+	schema := task.meta.GetSegment().GetTable().TxnGetSchema(task.txn)
+	attrs := schema.Attrs()
 	bat = batch.New(true, attrs)
 
-	for i := range task.meta.GetSchema().ColDefs {
+	for i := range schema.ColDefs {
 		view, err := task.compacted.GetColumnDataById(i, nil, nil)
 		if err != nil {
 			return bat, err
@@ -87,7 +91,7 @@ func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
 		vec := view.ApplyDeletes()
 		bat.Vecs[i] = vec
 	}
-	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.meta.GetSchema().PrimaryKey)); err != nil {
+	if err = mergesort.SortBlockColumns(bat.Vecs, int(schema.PrimaryKey)); err != nil {
 		return
 	}
 	return
@@ -97,6 +101,7 @@ func (task *compactBlockTask) GetNewBlock() handle.Block { return task.created }
 
 func (task *compactBlockTask) Execute() (err error) {
 	now := time.Now()
+	defer metrics.ObserveSince(metrics.CompactionDuration.WithLabelValues(metrics.CompactionBlock), now)
 	data, err := task.PrepareData()
 	if err != nil {
 		return
@@ -15,10 +15,13 @@
 package jobs
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
@@ -44,8 +47,8 @@ func NewFlushBlkTask(ctx *tasks.Context, bf file.Block, ts uint64, meta *catalog
 func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() (err error) {
-	pkColumnData := task.data.Vecs[task.meta.GetSchema().PrimaryKey]
-	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData); err != nil {
+	defer metrics.ObserveSince(metrics.FlushDuration, time.Now())
+	if err = BuildAndFlushBlockIndex(task.file, task.meta, task.data.Vecs); err != nil {
 		return
 	}
 	if err = task.file.WriteBatch(task.data, task.ts); err != nil {
@@ -22,27 +22,46 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
 )
 
-func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector) (err error) {
+// BuildAndFlushBlockIndex builds the indexes of every column and writes the
+// index meta of the block. columns is indexed by the column position in the
+// block schema
+func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, columns []*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
+	metas := idxCommon.NewEmptyIndicesMeta()
+	for colIdx := range meta.GetSchema().ColDefs {
+		if err = BuildColumnIndex(file, meta, colIdx, columns[colIdx], metas); err != nil {
+			return
+		}
+	}
+	return FlushIndexMeta(file, metas)
+}
+
+// BuildColumnIndex writes a zone map of the column and, for the sort key and
+// the indexed columns, a bloom filter. An indexed column has a row index as
+// well. The metas of the written indexes are added to metas
+func BuildColumnIndex(file file.Block, meta *catalog.BlockEntry, colIdx int, data *vector.Vector, metas *idxCommon.IndicesMeta) (err error) {
+	if data == nil {
+		return
+	}
 	schema := meta.GetSchema()
-	pkColumn, err := file.OpenColumn(int(schema.PrimaryKey))
+	column, err := file.OpenColumn(colIdx)
 	if err != nil {
 		return
 	}
 	zmIdx := uint16(0)
 	sfIdx := uint16(1)
-	metas := idxCommon.NewEmptyIndicesMeta()
+	rowIdx := uint16(2)
 
 	zoneMapWriter := io.NewBlockZoneMapIndexWriter()
-	zmFile, err := pkColumn.OpenIndexFile(int(zmIdx))
+	zmFile, err := column.OpenIndexFile(int(zmIdx))
 	if err != nil {
 		return err
 	}
-	err = zoneMapWriter.Init(zmFile, idxCommon.Plain, uint16(schema.PrimaryKey), zmIdx)
+	err = zoneMapWriter.Init(zmFile, idxCommon.Plain, uint16(colIdx), zmIdx)
 	if err != nil {
 		return err
 	}
-	err = zoneMapWriter.AddValues(pkColumnData)
+	err = zoneMapWriter.AddValues(data)
 	if err != nil {
 		return err
 	}
@@ -52,16 +71,19 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 	}
 	metas.AddIndex(*zmMeta)
 
-	staticFilterWriter := io.NewStaticFilterIndexWriter()
-	sfFile, err := pkColumn.OpenIndexFile(int(sfIdx))
+	if colIdx != int(schema.PrimaryKey) && !schema.ColDefs[colIdx].HasIndex() {
+		return nil
+	}
+	staticFilterWriter := io.NewBloomFilterIndexWriter()
+	sfFile, err := column.OpenIndexFile(int(sfIdx))
 	if err != nil {
 		return err
 	}
-	err = staticFilterWriter.Init(sfFile, idxCommon.Plain, uint16(schema.PrimaryKey), sfIdx)
+	err = staticFilterWriter.Init(sfFile, idxCommon.Plain, uint16(colIdx), sfIdx)
 	if err != nil {
 		return err
 	}
-	err = staticFilterWriter.AddValues(pkColumnData)
+	err = staticFilterWriter.AddValues(data)
 	if err != nil {
 		return err
 	}
@@ -70,14 +92,35 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 		return err
 	}
 	metas.AddIndex(*sfMeta)
-	metaBuf, err := metas.Marshal()
+
+	if colIdx == int(schema.PrimaryKey) {
+		return nil
+	}
+	rowIndexWriter := io.NewRowIndexWriter()
+	riFile, err := column.OpenIndexFile(int(rowIdx))
 	if err != nil {
 		return err
 	}
-
-	err = file.WriteIndexMeta(metaBuf)
+	err = rowIndexWriter.Init(riFile, idxCommon.Plain, uint16(colIdx), rowIdx)
 	if err != nil {
 		return err
 	}
+	err = rowIndexWriter.AddValues(data)
+	if err != nil {
+		return err
+	}
+	riMeta, err := rowIndexWriter.Finalize()
+	if err != nil {
+		return err
+	}
+	metas.AddIndex(*riMeta)
 	return nil
 }
+
+func FlushIndexMeta(file file.Block, metas *idxCommon.IndicesMeta) (err error) {
+	metaBuf, err := metas.Marshal()
+	if err != nil {
+		return err
+	}
+	return file.WriteIndexMeta(metaBuf)
+}
@@ -16,6 +16,7 @@ package jobs
 
 import (
 	"fmt"
+	"time"
 	"unsafe"
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -24,7 +25,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/txnentries"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
@@ -110,6 +113,7 @@ func (task *mergeBlocksTask) mergeColumn(vecs []*vector.Vector, sortedIdx *[]uin
 }
 
 func (task *mergeBlocksTask) Execute() (err error) {
+	defer metrics.ObserveSince(metrics.CompactionDuration.WithLabelValues(metrics.CompactionMerge), time.Now())
 	segStr := ""
 	for _, seg := range task.mergedSegs {
 		segStr = fmt.Sprintf("%d,", seg.GetID())
@@ -126,6 +130,8 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			return err
 		}
 		task.toSegEntry = toSegEntry.GetMeta().(*catalog.SegmentEntry)
+		// Merged blocks stay in the partition they come from
+		task.toSegEntry.SetPartition(task.mergedSegs[0].GetPartition())
 		task.createdSegs = append(task.createdSegs, task.toSegEntry)
 	} else {
 		if toSegEntry, err = task.rel.GetSegment(task.toSegEntry.GetID()); err != nil {
@@ -133,7 +139,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
-	schema := task.mergedBlks[0].GetSchema()
+	schema := task.rel.Schema().(*catalog.Schema)
 	var view *model.ColumnView
 	vecs := make([]*vector.Vector, 0)
 	rows := make([]uint32, len(task.compacted))
@@ -176,7 +182,10 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	length = 0
 	var blk handle.Block
 	toAddr := make([]uint32, 0, len(vecs))
-	for _, vec := range vecs {
+	// metas[blk] collects the indexes built on the merged columns of a block
+	metas := make([]*idxCommon.IndicesMeta, len(vecs))
+	for pos, vec := range vecs {
+		metas[pos] = idxCommon.NewEmptyIndicesMeta()
 		toAddr = append(toAddr, uint32(length))
 		length += vector.Length(vec)
 		blk, err = toSegEntry.CreateNonAppendableBlock()
@@ -193,10 +202,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = flushTask.WaitDone(); err != nil {
 			return
 		}
-		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
-			return
-		}
-		if err = meta.GetBlockData().ReplayData(); err != nil {
+		if err = BuildColumnIndex(meta.GetBlockData().GetBlockFile(), meta, int(schema.PrimaryKey), vec, metas[pos]); err != nil {
 			return
 		}
 		// bf := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile()
@@ -220,6 +226,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
 		for pos, vec := range vecs {
 			blk := task.createdBlks[pos]
+			if err = BuildColumnIndex(blk.GetBlockData().GetBlockFile(), blk, i, vec, metas[pos]); err != nil {
+				return
+			}
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, i, vec, false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
 			if err != nil {
@@ -230,6 +239,14 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			}
 		}
 	}
+	for pos, blk := range task.createdBlks {
+		if err = FlushIndexMeta(blk.GetBlockData().GetBlockFile(), metas[pos]); err != nil {
+			return
+		}
+		if err = blk.GetBlockData().ReplayData(); err != nil {
+			return
+		}
+	}
 	for i, blk := range task.createdBlks {
 		closure := blk.GetBlockData().SyncBlockDataClosure(ts, rows[i])
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
@@ -41,6 +41,10 @@ func newSegment(meta *catalog.SegmentEntry,
 	dir string) *dataSegment {
 	filePath := fmt.Sprintf("%s/%d.seg", dir, meta.GetID())
 	segFile := factory(filePath, meta.GetID())
+	if !meta.IsAppendable() {
+		// The blocks of a non-appendable segment are written once
+		segFile.Seal()
+	}
 	seg := &dataSegment{
 		meta:      meta,
 		file:      segFile,
@@ -15,17 +15,23 @@
 package tables
 
 import (
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 )
 
 type dataTable struct {
+	sync.RWMutex
 	meta        *catalog.TableEntry
 	fileFactory file.SegmentFileFactory
 	bufMgr      base.INodeManager
-	aBlk        *dataBlock
+	// aBlks are the last appendable blocks of the partitions
+	aBlks map[uint32]*dataBlock
+	feed  *cdc.Feed
 }
 
 func newTable(meta *catalog.TableEntry, fileFactory file.SegmentFileFactory, bufMgr base.INodeManager) *dataTable {
@@ -33,14 +39,26 @@ func newTable(meta *catalog.TableEntry, fileFactory file.SegmentFileFactory, buf
 		meta:        meta,
 		fileFactory: fileFactory,
 		bufMgr:      bufMgr,
+		aBlks:       make(map[uint32]*dataBlock),
+		feed:        cdc.NewFeed(),
 	}
 }
 
 func (table *dataTable) GetHandle() data.TableHandle {
-	return newHandle(table, table.aBlk)
+	table.RLock()
+	defer table.RUnlock()
+	return newHandle(table, table.aBlks)
 }
 
 func (table *dataTable) ApplyHandle(h data.TableHandle) {
 	handle := h.(*tableHandle)
-	table.aBlk = handle.block
+	table.Lock()
+	defer table.Unlock()
+	for partition, blk := range handle.blocks {
+		table.aBlks[partition] = blk
+	}
+}
+
+func (table *dataTable) GetChangeFeed() *cdc.Feed {
+	return table.feed
 }
@@ -50,6 +50,12 @@ func (entry *compactBlockEntry) PrepareRollback() (err error) {
 }
 func (entry *compactBlockEntry) ApplyRollback() (err error) { return }
 func (entry *compactBlockEntry) ApplyCommit(index *wal.Index) (err error) {
+	// The rows of the dropped block are removed from the statistics when
+	// it is dropped. The rows deleted after the compaction started are
+	// moved to the new block by PrepareCommit and removed on commit
+	to := entry.to.GetMeta().(*catalog.BlockEntry)
+	rows := int64(to.GetBlockData().Rows(nil, true))
+	to.AddRowStats(rows, to.EstimateSize(rows))
 	if err = entry.scheduler.Checkpoint([]*wal.Index{index}); err != nil {
 		// TODO:
 		// Right now scheduler may be stopped before ApplyCommit and then it returns schedule error here.
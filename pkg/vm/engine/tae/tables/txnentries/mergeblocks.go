@@ -62,6 +62,10 @@ func (entry *mergeBlocksEntry) PrepareRollback() (err error) {
 }
 func (entry *mergeBlocksEntry) ApplyRollback() (err error) { return }
 func (entry *mergeBlocksEntry) ApplyCommit(index *wal.Index) (err error) {
+	for _, blk := range entry.createdBlks {
+		rows := int64(blk.GetBlockData().Rows(nil, true))
+		blk.AddRowStats(rows, blk.EstimateSize(rows))
+	}
 	if err = entry.scheduler.Checkpoint([]*wal.Index{index}); err != nil {
 		// TODO:
 		// Right now scheduler may be stopped before ApplyCommit and then it returns schedule error here.
@@ -34,6 +34,9 @@ type AppendNode struct {
 	maxRow     uint32
 	controller *MVCCHandle
 	id         *common.ID
+	// rows and size are the appended rows and their estimated size
+	rows uint32
+	size uint64
 }
 
 func MockAppendNode(ts uint64, maxRow uint32, controller *MVCCHandle) *AppendNode {
@@ -63,6 +66,13 @@ func (n *AppendNode) GetID() *common.ID {
 func (n *AppendNode) GetCommitTS() uint64 { return n.commitTs }
 func (n *AppendNode) GetMaxRow() uint32   { return n.maxRow }
 
+// SetChanges records the rows appended by the node and their size. They
+// are added to the statistics of the block on commit
+func (n *AppendNode) SetChanges(rows uint32, size uint64) {
+	n.rows = rows
+	n.size = size
+}
+
 func (n *AppendNode) PrepareCommit() error {
 	return nil
 }
@@ -78,6 +88,9 @@ func (n *AppendNode) ApplyCommit(index *wal.Index) error {
 	if n.controller != nil {
 		logutil.Debugf("Set MaxCommitTS=%d, MaxVisibleRow=%d", n.commitTs, n.maxRow)
 		n.controller.SetMaxVisible(n.commitTs)
+		if n.controller.meta != nil {
+			n.controller.meta.AddRowStats(int64(n.rows), int64(n.size))
+		}
 	}
 	// logutil.Infof("Apply1Index %s TS=%d", index.String(), n.commitTs)
 	return nil
@@ -255,6 +255,29 @@ func (node *ColumnNode) UpdateLocked(row uint32, v interface{}) error {
 	return nil
 }
 
+// SetStartTSLocked moves the uncommitted node to the new start ts of its txn
+func (node *ColumnNode) SetStartTSLocked(ts uint64) {
+	node.Lock()
+	node.startTs = ts
+	node.Unlock()
+	node.chain.UpdateLocked(node)
+}
+
+// RollbackToLocked restores the updates of the uncommitted node to mask and
+// vals. The rows updated since are removed from the column view
+func (node *ColumnNode) RollbackToLocked(mask *roaring.Bitmap, vals map[uint32]interface{}) {
+	it := node.txnMask.Iterator()
+	for it.HasNext() {
+		row := it.Next()
+		if !mask.Contains(row) {
+			_ = node.chain.view.Delete(row, node)
+		}
+	}
+	node.txnMask = mask
+	node.txnVals = vals
+	node.chain.SetUpdateCnt(uint32(node.chain.view.mask.GetCardinality()))
+}
+
 func (node *ColumnNode) MergeLocked(o *ColumnNode) {
 	for k, v := range o.txnVals {
 		if vv := node.txnVals[k]; vv == nil {
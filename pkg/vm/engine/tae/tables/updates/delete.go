@@ -154,6 +154,17 @@ func (node *DeleteNode) RangeDeleteLocked(start, end uint32) {
 }
 func (node *DeleteNode) GetCardinalityLocked() uint32 { return uint32(node.mask.GetCardinality()) }
 
+// SetStartTSLocked moves the uncommitted node to the new start ts of its txn
+func (node *DeleteNode) SetStartTSLocked(ts uint64) {
+	node.Lock()
+	node.startTs = ts
+	node.Unlock()
+	node.chain.UpdateLocked(node)
+}
+
+// RollbackToLocked restores the deletes of the uncommitted node to mask
+func (node *DeleteNode) RollbackToLocked(mask *roaring.Bitmap) { node.mask = mask }
+
 func (node *DeleteNode) PrepareCommit() (err error) {
 	node.chain.Lock()
 	defer node.chain.Unlock()
@@ -181,6 +192,9 @@ func (node *DeleteNode) ApplyCommit(index *wal.Index) (err error) {
 	}
 	node.chain.AddDeleteCnt(uint32(node.mask.GetCardinality()))
 	node.chain.controller.IncChangeNodeCnt()
+	if node.chain.controller.meta != nil {
+		node.chain.controller.meta.DeleteRows(int64(node.mask.GetCardinality()))
+	}
 	return
 }
 
@@ -16,6 +16,7 @@ package updates
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -234,6 +235,18 @@ func (n *MVCCHandle) CollectAppendLogIndexesLocked(startTs, endTs uint64) (index
 	return
 }
 
+// GetRowCommitTSLocked returns the commit ts of the append of row, and false
+// if the block has no append of the row, e.g. a non-appendable block
+func (n *MVCCHandle) GetRowCommitTSLocked(row uint32) (uint64, bool) {
+	i := sort.Search(len(n.appends), func(i int) bool {
+		return n.appends[i].GetMaxRow() > row
+	})
+	if i == len(n.appends) {
+		return 0, false
+	}
+	return n.appends[i].GetCommitTS(), true
+}
+
 func (n *MVCCHandle) GetMaxVisibleRowLocked(ts uint64) (uint32, bool) {
 	_, row, ok := n.getMaxVisibleRowLocked(ts)
 	return row, ok
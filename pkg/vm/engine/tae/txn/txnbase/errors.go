@@ -23,6 +23,18 @@ var (
 	ErrTxnNotActive        = errors.New("tae: txn not active")
 	ErrTxnCannotRollback   = errors.New("tae: txn cannot txn rollback")
 	ErrTxnDBNotSpecified   = errors.New("tae: database not specified")
+	ErrTxnTSTooOld         = errors.New("tae: txn snapshot ts too old")
+	ErrTxnTSTooNew         = errors.New("tae: txn snapshot ts not allocated")
+	ErrTxnSnapshotReadonly = errors.New("tae: txn snapshot is readonly")
+	ErrTxnReadonly         = errors.New("tae: txn in readonly mode")
+	ErrTxnBusy             = errors.New("tae: txn busy, retry later")
+	ErrTxnLockConflict     = errors.New("tae: txn row locked by another txn")
+	ErrTxnDeadlock         = errors.New("tae: txn deadlock detected")
+	ErrSavepointNotFound   = errors.New("tae: savepoint not found")
+	ErrTxnPrepared         = errors.New("tae: txn prepared, commit or rollback it as prepared")
+	ErrTxnNotPrepared      = errors.New("tae: txn not prepared")
+	ErrTxnTimeout          = errors.New("tae: txn killed on timeout")
+	ErrTxnIdleTimeout      = errors.New("tae: txn killed on idle timeout")
 
 	ErrNotFound   = errors.New("tae: not found")
 	ErrDuplicated = errors.New("tae: duplicated ")
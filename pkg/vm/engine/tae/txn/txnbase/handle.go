@@ -49,12 +49,18 @@ func (db *TxnDatabase) GetName() string
 func (db *TxnDatabase) String() string                                                  { return "" }
 func (db *TxnDatabase) Close() error                                                    { return nil }
 func (db *TxnDatabase) CreateRelation(def interface{}) (rel handle.Relation, err error) { return }
+func (db *TxnDatabase) CreateRelations(defs []interface{}) (rels []handle.Relation, err error) {
+	return
+}
 func (db *TxnDatabase) DropRelationByName(name string) (rel handle.Relation, err error) { return }
 func (db *TxnDatabase) GetRelationByName(name string) (rel handle.Relation, err error)  { return }
-func (db *TxnDatabase) RelationCnt() int64                                              { return 0 }
-func (db *TxnDatabase) Relations() (rels []handle.Relation)                             { return }
-func (db *TxnDatabase) MakeRelationIt() (it handle.RelationIt)                          { return }
-func (db *TxnDatabase) GetMeta() interface{}                                            { return nil }
+func (db *TxnDatabase) RenameRelation(name, newName string) (rel handle.Relation, err error) {
+	return
+}
+func (db *TxnDatabase) RelationCnt() int64                     { return 0 }
+func (db *TxnDatabase) Relations() (rels []handle.Relation)    { return }
+func (db *TxnDatabase) MakeRelationIt() (it handle.RelationIt) { return }
+func (db *TxnDatabase) GetMeta() interface{}                   { return nil }
 
 func (rel *TxnRelation) SimplePPString(_ common.PPLevel) string                               { return "" }
 func (rel *TxnRelation) String() string                                                       { return "" }
@@ -66,9 +72,16 @@ func (rel *TxnRelation) GetCardinality(attr string) int64
 func (rel *TxnRelation) Schema() interface{}                                                  { return nil }
 func (rel *TxnRelation) MakeSegmentIt() handle.SegmentIt                                      { return nil }
 func (rel *TxnRelation) MakeBlockIt() handle.BlockIt                                          { return nil }
+func (rel *TxnRelation) MakeBlockItWithFilters(...*handle.Filter) handle.BlockIt              { return nil }
 func (rel *TxnRelation) MakeReader() handle.Reader                                            { return nil }
+func (rel *TxnRelation) Partitions(...*handle.Filter) []uint32                                { return nil }
 func (rel *TxnRelation) BatchDedup(col *vector.Vector) error                                  { return nil }
 func (rel *TxnRelation) Append(data *batch.Batch) error                                       { return nil }
+func (rel *TxnRelation) AddColumn(def interface{}) error                                      { return nil }
+func (rel *TxnRelation) DropColumn(name string) error                                         { return nil }
+func (rel *TxnRelation) AddIndex(name, col string) error                                      { return nil }
+func (rel *TxnRelation) DropIndex(name string) error                                          { return nil }
+func (rel *TxnRelation) Analyze() error                                                       { return nil }
 func (rel *TxnRelation) GetMeta() interface{}                                                 { return nil }
 func (rel *TxnRelation) GetSegment(id uint64) (seg handle.Segment, err error)                 { return }
 func (rel *TxnRelation) SoftDeleteSegment(id uint64) (err error)                              { return }
@@ -78,16 +91,22 @@ func (rel *TxnRelation) GetValue(*common.ID, uint32, uint16) (v interface{}, err
 func (rel *TxnRelation) Update(*common.ID, uint32, uint16, interface{}) (err error)           { return }
 func (rel *TxnRelation) RangeDelete(*common.ID, uint32, uint32) (err error)                   { return }
 func (rel *TxnRelation) GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error) { return }
+func (rel *TxnRelation) GetByIndex(string, interface{}) (ids []*common.ID, offsets []uint32, err error) {
+	return
+}
 func (rel *TxnRelation) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return
 }
 
-func (seg *TxnSegment) GetMeta() interface{}               { return nil }
-func (seg *TxnSegment) String() string                     { return "" }
-func (seg *TxnSegment) Close() error                       { return nil }
-func (seg *TxnSegment) GetID() uint64                      { return 0 }
-func (seg *TxnSegment) MakeBlockIt() (it handle.BlockIt)   { return }
-func (seg *TxnSegment) MakeReader() (reader handle.Reader) { return }
+func (seg *TxnSegment) GetMeta() interface{}                                         { return nil }
+func (seg *TxnSegment) String() string                                               { return "" }
+func (seg *TxnSegment) Close() error                                                 { return nil }
+func (seg *TxnSegment) GetID() uint64                                                { return 0 }
+func (seg *TxnSegment) Rows() int64                                                  { return 0 }
+func (seg *TxnSegment) Size(string) int64                                            { return 0 }
+func (seg *TxnSegment) MakeBlockIt() (it handle.BlockIt)                             { return }
+func (seg *TxnSegment) MakeBlockItWithFilters(...*handle.Filter) (it handle.BlockIt) { return }
+func (seg *TxnSegment) MakeReader() (reader handle.Reader)                           { return }
 
 // func (seg *TxnSegment) GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error) {
 // 	return
@@ -111,11 +130,14 @@ func (blk *TxnBlock) GetTotalChanges() int                                 { ret
 func (blk *TxnBlock) IsAppendableBlock() bool                              { return true }
 func (blk *TxnBlock) Fingerprint() *common.ID                              { return &common.ID{} }
 func (blk *TxnBlock) Rows() int                                            { return 0 }
+func (blk *TxnBlock) CommittedRows() int64                                 { return 0 }
+func (blk *TxnBlock) Size(string) int64                                    { return 0 }
 func (blk *TxnBlock) ID() uint64                                           { return 0 }
 func (blk *TxnBlock) String() string                                       { return "" }
 func (blk *TxnBlock) Close() error                                         { return nil }
 func (blk *TxnBlock) GetMeta() interface{}                                 { return nil }
 func (blk *TxnBlock) GetByFilter(handle.Filter) (offset uint32, err error) { return }
+func (blk *TxnBlock) MayMatch(...*handle.Filter) bool                      { return true }
 
 func (blk *TxnBlock) GetColumnDataById(colIdx int, compressed, decompressed *bytes.Buffer) (vec *vector.Vector, deletes *roaring.Bitmap, err error) {
 	return
@@ -0,0 +1,118 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import "sync"
+
+// LockKey identifies a row by its table and primary key
+type LockKey struct {
+	TableID uint64
+	Key     interface{}
+}
+
+type rowLock struct {
+	holder uint64
+	// released is closed when the holder releases the lock
+	released chan struct{}
+}
+
+// LockTable holds the row locks of the txns in the pessimistic mode. A lock
+// is held until the txn is done. A txn waits for at most one lock at a
+// time, so the waits-for graph is a set of chains and a deadlock is found
+// by following the chain from the holder
+type LockTable struct {
+	sync.Mutex
+	wait     bool
+	locks    map[LockKey]*rowLock
+	held     map[uint64][]LockKey
+	waitsFor map[uint64]uint64
+}
+
+// NewLockTable returns a lock table. A txn waits for the lock held by
+// another txn if wait is true, otherwise it fails at once
+func NewLockTable(wait bool) *LockTable {
+	return &LockTable{
+		wait:     wait,
+		locks:    make(map[LockKey]*rowLock),
+		held:     make(map[uint64][]LockKey),
+		waitsFor: make(map[uint64]uint64),
+	}
+}
+
+// Acquire locks key for txn. It returns ErrTxnLockConflict if the lock is
+// held by another txn in the no-wait mode and ErrTxnDeadlock if waiting for
+// the lock would close a cycle in the waits-for graph
+func (t *LockTable) Acquire(txn uint64, key LockKey) error {
+	// The char and varchar keys are compared by value
+	if bs, ok := key.Key.([]byte); ok {
+		key.Key = string(bs)
+	}
+	t.Lock()
+	defer t.Unlock()
+	for {
+		lock := t.locks[key]
+		if lock == nil {
+			t.locks[key] = &rowLock{
+				holder:   txn,
+				released: make(chan struct{}),
+			}
+			t.held[txn] = append(t.held[txn], key)
+			return nil
+		}
+		if lock.holder == txn {
+			return nil
+		}
+		if !t.wait {
+			return ErrTxnLockConflict
+		}
+		if t.isWaitingLocked(lock.holder, txn) {
+			return ErrTxnDeadlock
+		}
+		t.waitsFor[txn] = lock.holder
+		t.Unlock()
+		<-lock.released
+		t.Lock()
+		delete(t.waitsFor, txn)
+	}
+}
+
+// isWaitingLocked returns true if txn waits for target directly or
+// indirectly
+func (t *LockTable) isWaitingLocked(txn, target uint64) bool {
+	for curr, ok := txn, true; ok; curr, ok = t.waitsFor[curr] {
+		if curr == target {
+			return true
+		}
+	}
+	return false
+}
+
+// ReleaseAll releases all the locks held by txn and wakes up the waiters
+func (t *LockTable) ReleaseAll(txn uint64) {
+	t.Lock()
+	defer t.Unlock()
+	for _, key := range t.held[txn] {
+		close(t.locks[key].released)
+		delete(t.locks, key)
+	}
+	delete(t.held, txn)
+}
+
+// HeldCnt returns the number of the locks held by txn
+func (t *LockTable) HeldCnt(txn uint64) int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.held[txn])
+}
@@ -26,30 +26,51 @@ var NoopStoreFactory = func() txnif.TxnStore { return new(NoopTxnStore) }
 
 type NoopTxnStore struct{}
 
-func (store *NoopTxnStore) BindTxn(txn txnif.AsyncTxn)                      {}
-func (store *NoopTxnStore) Close() error                                    { return nil }
-func (store *NoopTxnStore) Append(dbId, id uint64, data *batch.Batch) error { return nil }
-func (store *NoopTxnStore) PrepareRollback() error                          { return nil }
-func (store *NoopTxnStore) PreCommit() error                                { return nil }
-func (store *NoopTxnStore) PrepareCommit() error                            { return nil }
-func (store *NoopTxnStore) ApplyRollback() error                            { return nil }
-func (store *NoopTxnStore) ApplyCommit() error                              { return nil }
+func (store *NoopTxnStore) BindTxn(txn txnif.AsyncTxn)                       {}
+func (store *NoopTxnStore) Close() error                                     { return nil }
+func (store *NoopTxnStore) Append(dbId, id uint64, data *batch.Batch) error  { return nil }
+func (store *NoopTxnStore) AddColumn(dbId, id uint64, def interface{}) error { return nil }
+func (store *NoopTxnStore) DropColumn(dbId, id uint64, name string) error    { return nil }
+func (store *NoopTxnStore) AddIndex(dbId, id uint64, name, col string) error { return nil }
+func (store *NoopTxnStore) DropIndex(dbId, id uint64, name string) error     { return nil }
+func (store *NoopTxnStore) Analyze(dbId, id uint64) error                    { return nil }
+func (store *NoopTxnStore) PrepareRollback() error                           { return nil }
+func (store *NoopTxnStore) PreCommit() error                                 { return nil }
+func (store *NoopTxnStore) PrepareCommit() error                             { return nil }
+func (store *NoopTxnStore) ApplyRollback() error                             { return nil }
+func (store *NoopTxnStore) ApplyCommit() error                               { return nil }
+func (store *NoopTxnStore) Savepoint(name string) error                      { return nil }
+func (store *NoopTxnStore) RollbackTo(name string) error                     { return nil }
+func (store *NoopTxnStore) SetStartTS(ts uint64)                             {}
+func (store *NoopTxnStore) SetAsyncCommit()                                  {}
+func (store *NoopTxnStore) WaitDurable() error                               { return nil }
+func (store *NoopTxnStore) GetChanges() []txnif.TableChanges                 { return nil }
+func (store *NoopTxnStore) GetTxnRecord() []byte                             { return nil }
 
 func (store *NoopTxnStore) AddTxnEntry(t txnif.TxnEntryType, entry txnif.TxnEntry) {}
 
 func (store *NoopTxnStore) CreateRelation(dbId uint64, def interface{}) (rel handle.Relation, err error) {
 	return
 }
+func (store *NoopTxnStore) CreateRelations(dbId uint64, defs []interface{}) (rels []handle.Relation, err error) {
+	return
+}
 func (store *NoopTxnStore) DropRelationByName(dbId uint64, name string) (rel handle.Relation, err error) {
 	return
 }
+func (store *NoopTxnStore) RenameRelation(dbId uint64, name, newName string) (rel handle.Relation, err error) {
+	return
+}
 func (store *NoopTxnStore) GetRelationByName(dbId uint64, name string) (rel handle.Relation, err error) {
 	return
 }
 func (store *NoopTxnStore) CreateDatabase(name string) (db handle.Database, err error) { return }
 func (store *NoopTxnStore) DropDatabase(name string) (db handle.Database, err error)   { return }
-func (store *NoopTxnStore) GetDatabase(name string) (db handle.Database, err error)    { return }
-func (store *NoopTxnStore) DatabaseNames() (names []string)                            { return }
+func (store *NoopTxnStore) RenameDatabase(name, newName string) (db handle.Database, err error) {
+	return
+}
+func (store *NoopTxnStore) GetDatabase(name string) (db handle.Database, err error) { return }
+func (store *NoopTxnStore) DatabaseNames() (names []string)                         { return }
 func (store *NoopTxnStore) GetSegment(dbId uint64, id *common.ID) (seg handle.Segment, err error) {
 	return
 }
@@ -73,6 +94,9 @@ func (store *NoopTxnStore) RangeDelete(uint64, *common.ID, uint32, uint32) (err
 func (store *NoopTxnStore) GetByFilter(uint64, uint64, *handle.Filter) (id *common.ID, offset uint32, err error) {
 	return
 }
+func (store *NoopTxnStore) GetByIndex(uint64, uint64, string, interface{}) (ids []*common.ID, offsets []uint32, err error) {
+	return
+}
 func (store *NoopTxnStore) GetValue(uint64, *common.ID, uint32, uint16) (v interface{}, err error) {
 	return
 }
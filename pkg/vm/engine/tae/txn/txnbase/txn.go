@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 )
 
 type OpType int8
@@ -29,16 +30,21 @@ type OpType int8
 const (
 	OpCommit = iota
 	OpRollback
+	OpPrepare
 )
 
 type OpTxn struct {
 	Txn txnif.AsyncTxn
 	Op  OpType
+	// prepared receives the result of an OpPrepare
+	prepared chan error
 }
 
 func (txn *OpTxn) Repr() string {
 	if txn.Op == OpCommit {
 		return fmt.Sprintf("[Commit][Txn-%d]", txn.Txn.GetID())
+	} else if txn.Op == OpPrepare {
+		return fmt.Sprintf("[Prepare][Txn-%d]", txn.Txn.GetID())
 	} else {
 		return fmt.Sprintf("[Rollback][Txn-%d]", txn.Txn.GetID())
 	}
@@ -57,6 +63,10 @@ type Txn struct {
 	Err             error
 	DoneCond        sync.Cond
 	PrepareCommitFn func(interface{}) error
+	// killed is the reason the txn is killed by Kill
+	killed error
+	// ending is true once the owner commits, prepares or rolls back the txn
+	ending bool
 }
 
 func NewTxn(mgr *TxnManager, store txnif.TxnStore, txnId uint64, start uint64, info []byte) *Txn {
@@ -71,16 +81,106 @@ func NewTxn(mgr *TxnManager, store txnif.TxnStore, txnId uint64, start uint64, i
 
 func (txn *Txn) MockIncWriteCnt() int { return txn.Store.IncreateWriteCnt() }
 
+// CheckWritable returns an error if the txn cannot commit any write
+func (txn *Txn) CheckWritable() error {
+	if err := txn.getKilled(); err != nil {
+		return err
+	}
+	if txn.Mgr == nil {
+		return nil
+	}
+	return txn.Mgr.CheckWritable(txn.GetID())
+}
+
+func (txn *Txn) getKilled() error {
+	txn.RLock()
+	defer txn.RUnlock()
+	return txn.killed
+}
+
+// end marks the txn ended by its owner. It returns the reason the txn is
+// killed if it is killed before
+func (txn *Txn) end() error {
+	txn.Lock()
+	defer txn.Unlock()
+	if txn.killed != nil {
+		return txn.killed
+	}
+	txn.ending = true
+	return nil
+}
+
+// Kill rolls back the active txn on behalf of its owner, releasing its
+// buffers and row locks. The later commit, rollback and writes of the
+// owner return reason. It returns ErrTxnNotActive if the owner is already
+// ending the txn
+func (txn *Txn) Kill(reason error) error {
+	txn.Lock()
+	if txn.State != txnif.TxnStateActive || txn.killed != nil || txn.ending {
+		txn.Unlock()
+		return ErrTxnNotActive
+	}
+	txn.killed = reason
+	txn.Unlock()
+	return txn.rollback()
+}
+
+// Admit returns ErrTxnBusy if the appends should back off
+func (txn *Txn) Admit() error {
+	if txn.Mgr == nil {
+		return nil
+	}
+	return txn.Mgr.Admit()
+}
+
+// IsPessimistic returns true if the updates and deletes lock the rows
+func (txn *Txn) IsPessimistic() bool {
+	return txn.Mgr != nil && txn.Mgr.GetLockTable() != nil
+}
+
+// LockRows locks the rows of table by their primary keys until the txn is
+// done
+func (txn *Txn) LockRows(tableId uint64, keys ...interface{}) error {
+	locks := txn.Mgr.GetLockTable()
+	for _, key := range keys {
+		if err := locks.Acquire(txn.GetID(), LockKey{TableID: tableId, Key: key}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Savepoint records the changes of the txn so far under name
+func (txn *Txn) Savepoint(name string) error {
+	return txn.Store.Savepoint(name)
+}
+
+// RollbackTo undoes the appends, updates and deletes of the txn after the
+// savepoint of name. The DDLs and the row locks are kept
+func (txn *Txn) RollbackTo(name string) error {
+	return txn.Store.RollbackTo(name)
+}
+
 func (txn *Txn) SetError(err error) { txn.Err = err }
 func (txn *Txn) GetError() error    { return txn.Err }
 
 func (txn *Txn) SetPrepareCommitFn(fn func(interface{}) error) { txn.PrepareCommitFn = fn }
 
 func (txn *Txn) Commit() error {
+	if txn.Mgr.IsPrepared(txn.GetID()) {
+		return ErrTxnPrepared
+	}
+	if err := txn.end(); err != nil {
+		return err
+	}
 	if txn.Store.IsReadonly() {
 		txn.Mgr.DeleteTxn(txn.GetID())
 		return nil
 	}
+	if err := txn.Mgr.CheckWritable(txn.GetID()); err != nil {
+		_ = txn.Rollback()
+		return err
+	}
 	txn.Add(1)
 	txn.Mgr.OnOpTxn(&OpTxn{
 		Txn: txn,
@@ -91,11 +191,99 @@ func (txn *Txn) Commit() error {
 	return txn.GetError()
 }
 
+// CommitAsync commits the txn without waiting for the sync of its WAL
+// entries. The changes are visible once it returns and are durable after
+// the next group sync of the WAL. WaitDurable waits for the sync
+func (txn *Txn) CommitAsync() error {
+	txn.Store.SetAsyncCommit()
+	return txn.Commit()
+}
+
+// WaitDurable waits until the WAL entries of the committed txn are synced.
+// It returns at once for a txn not committed by CommitAsync
+func (txn *Txn) WaitDurable() error {
+	return txn.Store.WaitDurable()
+}
+
+// Prepare validates and persists the txn as the first phase of a two-phase
+// commit. The prepared txn is then committed by CommitPrepared or rolled
+// back by RollbackPrepared, and the readers of its changes wait for it. The
+// txn is rolled back if it fails to prepare
+func (txn *Txn) Prepare() error {
+	if err := txn.end(); err != nil {
+		return err
+	}
+	if err := txn.Mgr.CheckWritable(txn.GetID()); err != nil {
+		_ = txn.Rollback()
+		return err
+	}
+	op := &OpTxn{
+		Txn:      txn,
+		Op:       OpPrepare,
+		prepared: make(chan error, 1),
+	}
+	txn.Add(1)
+	txn.Mgr.OnOpTxn(op)
+	if err := <-op.prepared; err != nil {
+		txn.Wait()
+		txn.Mgr.DeleteTxn(txn.GetID())
+		return err
+	}
+	return nil
+}
+
+// CommitPrepared commits the prepared txn as the second phase of a
+// two-phase commit
+func (txn *Txn) CommitPrepared() error {
+	if err := txn.Mgr.decide(txn, true); err != nil {
+		return err
+	}
+	txn.Wait()
+	txn.Mgr.DeleteTxn(txn.GetID())
+	return txn.GetError()
+}
+
+// RollbackPrepared rolls back the prepared txn as the second phase of a
+// two-phase commit
+func (txn *Txn) RollbackPrepared() error {
+	if err := txn.Mgr.decide(txn, false); err != nil {
+		return err
+	}
+	txn.Wait()
+	txn.Mgr.DeleteTxn(txn.GetID())
+	return txn.Err
+}
+
+// StartStatement starts a new statement in the txn. A read committed txn
+// reads the changes committed before the statement, and its uncommitted
+// changes are moved to the new start ts
+func (txn *Txn) StartStatement() error {
+	if txn.GetIsolation() != txnif.IsolationRC || txn.Mgr.IsSnapshotTxn(txn.GetID()) {
+		return nil
+	}
+	ts, err := txn.Mgr.refreshStartTS(txn)
+	if err != nil {
+		return err
+	}
+	txn.Store.SetStartTS(ts)
+	return nil
+}
+
 func (txn *Txn) GetStore() txnif.TxnStore {
 	return txn.Store
 }
 
 func (txn *Txn) Rollback() error {
+	if txn.Mgr.IsPrepared(txn.GetID()) {
+		return ErrTxnPrepared
+	}
+	if err := txn.end(); err != nil {
+		return err
+	}
+	return txn.rollback()
+}
+
+func (txn *Txn) rollback() error {
 	if txn.Store.IsReadonly() {
 		txn.Mgr.DeleteTxn(txn.GetID())
 		return nil
@@ -116,10 +304,12 @@ func (txn *Txn) Done() {
 		if err := txn.ToCommittedLocked(); err != nil {
 			txn.SetError(err)
 		}
+		metrics.TxnCommitted.Inc()
 	} else {
 		if err := txn.ToRollbackedLocked(); err != nil {
 			txn.SetError(err)
 		}
+		metrics.TxnAborted.Inc()
 	}
 	txn.WaitGroup.Done()
 	txn.DoneCond.Broadcast()
@@ -215,6 +405,10 @@ func (txn *Txn) DropDatabase(name string) (db handle.Database, err error) {
 	return
 }
 
+func (txn *Txn) RenameDatabase(name, newName string) (db handle.Database, err error) {
+	return
+}
+
 func (txn *Txn) GetDatabase(name string) (db handle.Database, err error) {
 	return
 }
@@ -18,6 +18,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
@@ -39,19 +41,29 @@ type TxnCtx struct {
 	StartTS, CommitTS uint64
 	Info              []byte
 	State             int32
+	Isolation         txnif.IsolationLevel
+	StartAt           time.Time
+	// Deadline is the time the txn is killed if still active. It is zero
+	// if the txn has no deadline
+	Deadline time.Time
+	// lastActive is the unix nano time of the last operation
+	lastActive int64
 }
 
 func NewTxnCtx(rwlocker *sync.RWMutex, id, start uint64, info []byte) *TxnCtx {
 	if rwlocker == nil {
 		rwlocker = new(sync.RWMutex)
 	}
+	now := time.Now()
 	return &TxnCtx{
-		ID:       id,
-		IDCtx:    IDToIDCtx(id),
-		RWMutex:  rwlocker,
-		StartTS:  start,
-		CommitTS: txnif.UncommitTS,
-		Info:     info,
+		ID:         id,
+		IDCtx:      IDToIDCtx(id),
+		RWMutex:    rwlocker,
+		StartTS:    start,
+		CommitTS:   txnif.UncommitTS,
+		Info:       info,
+		StartAt:    now,
+		lastActive: now.UnixNano(),
 	}
 }
 
@@ -69,7 +81,37 @@ func (ctx *TxnCtx) Repr() string {
 func (ctx *TxnCtx) String() string     { return ctx.Repr() }
 func (ctx *TxnCtx) GetID() uint64      { return ctx.ID }
 func (ctx *TxnCtx) GetInfo() []byte    { return ctx.Info }
-func (ctx *TxnCtx) GetStartTS() uint64 { return ctx.StartTS }
+func (ctx *TxnCtx) GetStartTS() uint64 { return atomic.LoadUint64(&ctx.StartTS) }
+
+// SetStartTSLocked moves the start ts of an active read committed txn
+func (ctx *TxnCtx) SetStartTSLocked(ts uint64) { atomic.StoreUint64(&ctx.StartTS, ts) }
+
+func (ctx *TxnCtx) GetIsolation() txnif.IsolationLevel { return ctx.Isolation }
+
+// SetIsolation sets the isolation level of a txn before any statement
+func (ctx *TxnCtx) SetIsolation(level txnif.IsolationLevel) { ctx.Isolation = level }
+
+func (ctx *TxnCtx) GetStartAt() time.Time { return ctx.StartAt }
+
+func (ctx *TxnCtx) GetDeadline() time.Time {
+	ctx.RLock()
+	defer ctx.RUnlock()
+	return ctx.Deadline
+}
+
+// SetDeadline kills the txn if it is still active at deadline
+func (ctx *TxnCtx) SetDeadline(deadline time.Time) {
+	ctx.Lock()
+	defer ctx.Unlock()
+	ctx.Deadline = deadline
+}
+
+// Touch records an operation of the txn, which restarts its idle period
+func (ctx *TxnCtx) Touch() { atomic.StoreInt64(&ctx.lastActive, time.Now().UnixNano()) }
+
+func (ctx *TxnCtx) GetLastActive() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&ctx.lastActive))
+}
 func (ctx *TxnCtx) GetCommitTS() uint64 {
 	ctx.RLock()
 	defer ctx.RUnlock()
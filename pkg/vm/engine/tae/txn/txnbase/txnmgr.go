@@ -15,6 +15,7 @@
 package txnbase
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -23,11 +24,51 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/sm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 )
 
+// Admitter throttles the writes under load
+type Admitter interface {
+	// Admit returns ErrTxnBusy if the writes should back off
+	Admit() error
+	// Wait blocks until the writes are admitted. It returns ErrTxnBusy if
+	// ctx is done before
+	Wait(ctx context.Context) error
+}
+
+// PrepareLogger persists the records of the txns prepared for a two-phase
+// commit and of the decisions on them
+type PrepareLogger interface {
+	LogPrepare(txn txnif.AsyncTxn) error
+	LogDecision(txn txnif.AsyncTxn, commit bool) error
+}
+
+// TxnObserver is notified of the txns killed by the manager
+type TxnObserver interface {
+	OnTxnKilled(txn txnif.AsyncTxn, reason error)
+}
+
+// TxnListener is notified of the lifecycle of the write txns with the
+// summary of the rows they changed. It is called in the commit pipeline
+// and should not block
+type TxnListener interface {
+	// OnPreCommit is called once the txn is validated for the commit. The
+	// txn is rolled back if it returns an error
+	OnPreCommit(txn txnif.AsyncTxn, changes []txnif.TableChanges) error
+	// OnCommit is called once the changes of the txn are applied
+	OnCommit(txn txnif.AsyncTxn, changes []txnif.TableChanges)
+	OnRollback(txn txnif.AsyncTxn, changes []txnif.TableChanges)
+}
+
 type TxnStoreFactory = func() txnif.TxnStore
 type TxnFactory = func(*TxnManager, txnif.TxnStore, uint64, uint64, []byte) txnif.AsyncTxn
 
+// tsSample records the last allocated timestamp at a point of time
+type tsSample struct {
+	at time.Time
+	ts uint64
+}
+
 type TxnManager struct {
 	sync.RWMutex
 	common.ClosedState
@@ -37,6 +78,26 @@ type TxnManager struct {
 	TxnStoreFactory  TxnStoreFactory
 	TxnFactory       TxnFactory
 	ActiveMask       *roaring64.Bitmap
+	// Snapshots maps the id of a txn started by StartTxnAt to its start ts
+	Snapshots map[uint64]uint64
+	// Watermark is the max safe ts ever reported. The versions before it may
+	// be already garbage collected
+	Watermark uint64
+	retention time.Duration
+	samples   []tsSample
+	readonly  bool
+	admitter  Admitter
+	// locks is nil if the pessimistic row locks are disabled
+	locks *LockTable
+	// prepared maps the id of a prepared txn to its op waiting for the
+	// decision
+	prepared   map[uint64]*OpTxn
+	prepareLog PrepareLogger
+	// maxLifetime and maxIdle are not checked if zero
+	maxLifetime time.Duration
+	maxIdle     time.Duration
+	observer    TxnObserver
+	listeners   []TxnListener
 }
 
 func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnManager {
@@ -50,6 +111,8 @@ func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnM
 		TxnStoreFactory: txnStoreFactory,
 		TxnFactory:      txnFactory,
 		ActiveMask:      roaring64.New(),
+		Snapshots:       make(map[uint64]uint64),
+		prepared:        make(map[uint64]*OpTxn),
 	}
 	pqueue := sm.NewSafeQueue(20000, 1000, mgr.onPreparing)
 	cqueue := sm.NewSafeQueue(20000, 1000, mgr.onCommit)
@@ -60,26 +123,252 @@ func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnM
 func (mgr *TxnManager) Init(prevTxnId uint64, prevTs uint64) error {
 	mgr.IdAlloc.SetStart(prevTxnId)
 	mgr.TsAlloc.SetStart(prevTs)
+	// The versions before the restart may be already garbage collected
+	mgr.Watermark = prevTs
 	return nil
 }
 
-func (mgr *TxnManager) StatActiveTxnCnt() int {
+// SetSnapshotRetention keeps the versions readable by StartTxnAt for at
+// least retention
+func (mgr *TxnManager) SetSnapshotRetention(retention time.Duration) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.retention = retention
+}
+
+// SetReadonly rejects the commit of any write
+func (mgr *TxnManager) SetReadonly(readonly bool) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.readonly = readonly
+}
+
+// SetAdmitter throttles StartTxnWithContext and the appends with admitter
+func (mgr *TxnManager) SetAdmitter(admitter Admitter) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.admitter = admitter
+}
+
+// SetLockTable enables the pessimistic row locks held in locks
+func (mgr *TxnManager) SetLockTable(locks *LockTable) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.locks = locks
+}
+
+// GetLockTable returns nil if the pessimistic row locks are disabled
+func (mgr *TxnManager) GetLockTable() *LockTable {
 	mgr.RLock()
 	defer mgr.RUnlock()
-	return int(mgr.ActiveMask.GetCardinality())
+	return mgr.locks
 }
 
-func (mgr *TxnManager) StatSafeTS() (ts uint64) {
+// SetPrepareLogger persists the prepared txns and the decisions with logger
+func (mgr *TxnManager) SetPrepareLogger(logger PrepareLogger) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.prepareLog = logger
+}
+
+// SetTxnTimeouts kills by KillExpired the active txns started more than
+// maxLifetime ago or idle for more than maxIdle. A zero timeout is not
+// checked
+func (mgr *TxnManager) SetTxnTimeouts(maxLifetime, maxIdle time.Duration) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.maxLifetime = maxLifetime
+	mgr.maxIdle = maxIdle
+}
+
+// SetObserver notifies observer of the txns killed
+func (mgr *TxnManager) SetObserver(observer TxnObserver) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.observer = observer
+}
+
+// AddListener notifies listener of the lifecycle of the write txns
+func (mgr *TxnManager) AddListener(listener TxnListener) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	listeners := make([]TxnListener, len(mgr.listeners), len(mgr.listeners)+1)
+	copy(listeners, mgr.listeners)
+	mgr.listeners = append(listeners, listener)
+}
+
+func (mgr *TxnManager) getListeners() []TxnListener {
 	mgr.RLock()
-	if len(mgr.Active) > 0 {
-		ts = mgr.ActiveMask.Minimum() - 1
+	defer mgr.RUnlock()
+	return mgr.listeners
+}
+
+// KillExpired kills the active txns past their deadline, the max lifetime
+// or the max idle period at now. It returns the number of txns killed
+func (mgr *TxnManager) KillExpired(now time.Time) (killed int) {
+	expired := make(map[txnif.AsyncTxn]error)
+	mgr.RLock()
+	for _, txn := range mgr.Active {
+		if reason := mgr.expiredReasonLocked(txn, now); reason != nil {
+			expired[txn] = reason
+		}
+	}
+	observer := mgr.observer
+	mgr.RUnlock()
+	for txn, reason := range expired {
+		if err := txn.Kill(reason); err != nil {
+			continue
+		}
+		killed++
+		label := metrics.TxnKilledTimeout
+		if reason == ErrTxnIdleTimeout {
+			label = metrics.TxnKilledIdle
+		}
+		metrics.TxnKilled.WithLabelValues(label).Inc()
+		logutil.Infof("%s killed: %v", txn.String(), reason)
+		if observer != nil {
+			observer.OnTxnKilled(txn, reason)
+		}
+	}
+	return
+}
+
+// expiredReasonLocked returns nil if the txn is not expired at now
+func (mgr *TxnManager) expiredReasonLocked(txn txnif.AsyncTxn, now time.Time) error {
+	if mgr.prepared[txn.GetID()] != nil {
+		return nil
+	}
+	if deadline := txn.GetDeadline(); !deadline.IsZero() && now.After(deadline) {
+		return ErrTxnTimeout
+	}
+	if mgr.maxLifetime > 0 && now.Sub(txn.GetStartAt()) > mgr.maxLifetime {
+		return ErrTxnTimeout
+	}
+	if mgr.maxIdle > 0 && now.Sub(txn.GetLastActive()) > mgr.maxIdle {
+		return ErrTxnIdleTimeout
+	}
+	return nil
+}
+
+func (mgr *TxnManager) IsPrepared(id uint64) bool {
+	mgr.RLock()
+	defer mgr.RUnlock()
+	_, ok := mgr.prepared[id]
+	return ok
+}
+
+// onPrepared persists the prepare record of the txn of op and holds op
+// until the decision
+func (mgr *TxnManager) onPrepared(op *OpTxn) (err error) {
+	mgr.RLock()
+	logger := mgr.prepareLog
+	mgr.RUnlock()
+	if logger != nil {
+		if err = logger.LogPrepare(op.Txn); err != nil {
+			return
+		}
+	}
+	mgr.Lock()
+	mgr.prepared[op.Txn.GetID()] = op
+	mgr.Unlock()
+	op.prepared <- nil
+	op.prepared = nil
+	return
+}
+
+// decide persists the decision on the prepared txn and enqueues it to be
+// committed or rolled back
+func (mgr *TxnManager) decide(txn txnif.AsyncTxn, commit bool) (err error) {
+	mgr.Lock()
+	op := mgr.prepared[txn.GetID()]
+	delete(mgr.prepared, txn.GetID())
+	logger := mgr.prepareLog
+	mgr.Unlock()
+	if op == nil {
+		return ErrTxnNotPrepared
+	}
+	if logger != nil {
+		if err = logger.LogDecision(txn, commit); err != nil {
+			mgr.Lock()
+			mgr.prepared[txn.GetID()] = op
+			mgr.Unlock()
+			return
+		}
+	}
+	if commit {
+		op.Op = OpCommit
 	} else {
-		ts = mgr.TsAlloc.Get()
+		op.Op = OpRollback
+		ts := txn.GetCommitTS()
+		txn.Lock()
+		// Should not fail here
+		_ = txn.ToRollbackingLocked(ts)
+		txn.Unlock()
+		mgr.onPreparRollback(txn)
 	}
+	_, err = mgr.EnqueueCheckpoint(op)
+	return
+}
+
+// Admit returns ErrTxnBusy if the writes should back off
+func (mgr *TxnManager) Admit() error {
+	mgr.RLock()
+	admitter := mgr.admitter
 	mgr.RUnlock()
+	if admitter == nil {
+		return nil
+	}
+	return admitter.Admit()
+}
+
+func (mgr *TxnManager) StatActiveTxnCnt() int {
+	mgr.RLock()
+	defer mgr.RUnlock()
+	return int(mgr.ActiveMask.GetCardinality()) + len(mgr.Snapshots)
+}
+
+// StatSafeTS returns the max ts whose versions are not read by any active
+// txn and are older than the snapshot retention
+func (mgr *TxnManager) StatSafeTS() (ts uint64) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	ts = mgr.TsAlloc.Get()
+	if !mgr.ActiveMask.IsEmpty() {
+		ts = mgr.ActiveMask.Minimum() - 1
+	}
+	for _, start := range mgr.Snapshots {
+		if start-1 < ts {
+			ts = start - 1
+		}
+	}
+	if retained := mgr.retainedTSLocked(); retained < ts {
+		ts = retained
+	}
+	if ts > mgr.Watermark {
+		mgr.Watermark = ts
+	}
 	return
 }
 
+// retainedTSLocked samples the current ts and returns the ts allocated right
+// before now - retention
+func (mgr *TxnManager) retainedTSLocked() uint64 {
+	now := time.Now()
+	curr := mgr.TsAlloc.Get()
+	if mgr.retention <= 0 {
+		return curr
+	}
+	mgr.samples = append(mgr.samples, tsSample{at: now, ts: curr})
+	deadline := now.Add(-mgr.retention)
+	// Keep the newest sample older than the deadline at the head
+	i := 0
+	for i+1 < len(mgr.samples) && !mgr.samples[i+1].at.After(deadline) {
+		i++
+	}
+	mgr.samples = mgr.samples[i:]
+	return mgr.samples[0].ts
+}
+
 func (mgr *TxnManager) StartTxn(info []byte) txnif.AsyncTxn {
 	mgr.Lock()
 	defer mgr.Unlock()
@@ -94,11 +383,111 @@ func (mgr *TxnManager) StartTxn(info []byte) txnif.AsyncTxn {
 	return txn
 }
 
+// TxnOptions are the options to start a txn with
+type TxnOptions struct {
+	Info      []byte
+	Isolation txnif.IsolationLevel
+	// Timeout is the max lifetime of the txn. The txn has no deadline if
+	// it is zero
+	Timeout time.Duration
+}
+
+// StartTxnWithOptions starts a txn with the isolation level and the
+// timeout of opts
+func (mgr *TxnManager) StartTxnWithOptions(opts TxnOptions) txnif.AsyncTxn {
+	txn := mgr.StartTxn(opts.Info)
+	txn.SetIsolation(opts.Isolation)
+	if opts.Timeout > 0 {
+		txn.SetDeadline(txn.GetStartAt().Add(opts.Timeout))
+	}
+	return txn
+}
+
+// refreshStartTS moves the start ts of the active txn to a new ts
+func (mgr *TxnManager) refreshStartTS(txn *Txn) (ts uint64, err error) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	txn.Lock()
+	defer txn.Unlock()
+	if txn.State != txnif.TxnStateActive {
+		err = ErrTxnNotActive
+		return
+	}
+	ts = mgr.TsAlloc.Alloc()
+	mgr.ActiveMask.Remove(txn.GetStartTS())
+	mgr.ActiveMask.Add(ts)
+	txn.SetStartTSLocked(ts)
+	return
+}
+
+// StartTxnWithContext waits until the writes are admitted and starts a txn.
+// It returns ErrTxnBusy if ctx is done before
+func (mgr *TxnManager) StartTxnWithContext(ctx context.Context, info []byte) (txnif.AsyncTxn, error) {
+	mgr.RLock()
+	admitter := mgr.admitter
+	mgr.RUnlock()
+	if admitter != nil {
+		if err := admitter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return mgr.StartTxn(info), nil
+}
+
+// StartTxnAt starts a read-only txn reading the snapshot at ts. The ts
+// should be allocated already and newer than the Watermark
+func (mgr *TxnManager) StartTxnAt(info []byte, ts uint64) (txn txnif.AsyncTxn, err error) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	if ts > mgr.TsAlloc.Get() {
+		err = ErrTxnTSTooNew
+		return
+	}
+	if ts <= mgr.Watermark {
+		err = ErrTxnTSTooOld
+		return
+	}
+	txnId := mgr.IdAlloc.Alloc()
+	store := mgr.TxnStoreFactory()
+	txn = mgr.TxnFactory(mgr, store, txnId, ts, info)
+	store.BindTxn(txn)
+	mgr.Active[txnId] = txn
+	mgr.Snapshots[txnId] = ts
+	return
+}
+
+func (mgr *TxnManager) IsSnapshotTxn(id uint64) bool {
+	mgr.RLock()
+	defer mgr.RUnlock()
+	_, ok := mgr.Snapshots[id]
+	return ok
+}
+
+// CheckWritable returns an error if the txn cannot commit any write
+func (mgr *TxnManager) CheckWritable(id uint64) error {
+	mgr.RLock()
+	defer mgr.RUnlock()
+	if mgr.readonly {
+		return ErrTxnReadonly
+	}
+	if _, ok := mgr.Snapshots[id]; ok {
+		return ErrTxnSnapshotReadonly
+	}
+	return nil
+}
+
 func (mgr *TxnManager) DeleteTxn(id uint64) {
+	if locks := mgr.GetLockTable(); locks != nil {
+		locks.ReleaseAll(id)
+	}
 	mgr.Lock()
 	defer mgr.Unlock()
 	txn := mgr.Active[id]
 	delete(mgr.Active, id)
+	if _, ok := mgr.Snapshots[id]; ok {
+		delete(mgr.Snapshots, id)
+		return
+	}
 	mgr.ActiveMask.Remove(txn.GetStartTS())
 }
 
@@ -118,7 +507,16 @@ func (mgr *TxnManager) OnOpTxn(op *OpTxn) {
 
 func (mgr *TxnManager) onPreCommit(txn txnif.AsyncTxn) {
 	now := time.Now()
-	txn.SetError(txn.PreCommit())
+	err := txn.PreCommit()
+	if listeners := mgr.getListeners(); err == nil && len(listeners) != 0 {
+		changes := txn.GetStore().GetChanges()
+		for _, listener := range listeners {
+			if err = listener.OnPreCommit(txn, changes); err != nil {
+				break
+			}
+		}
+	}
+	txn.SetError(err)
 	logutil.Debugf("%s PreCommit Takes: %s", txn.String(), time.Since(now))
 }
 
@@ -135,7 +533,7 @@ func (mgr *TxnManager) onPreparing(items ...interface{}) {
 	now := time.Now()
 	for _, item := range items {
 		op := item.(*OpTxn)
-		if op.Op == OpCommit {
+		if op.Op == OpCommit || op.Op == OpPrepare {
 			mgr.onPreCommit(op.Txn)
 		}
 		mgr.Lock()
@@ -144,7 +542,7 @@ func (mgr *TxnManager) onPreparing(items ...interface{}) {
 		if op.Txn.GetError() != nil {
 			op.Op = OpRollback
 		}
-		if op.Op == OpCommit {
+		if op.Op == OpCommit || op.Op == OpPrepare {
 			// Should not fail here
 			_ = op.Txn.ToCommittingLocked(ts)
 		} else if op.Op == OpRollback {
@@ -153,8 +551,16 @@ func (mgr *TxnManager) onPreparing(items ...interface{}) {
 		}
 		op.Txn.Unlock()
 		mgr.Unlock()
-		if op.Op == OpCommit {
+		if op.Op == OpCommit || op.Op == OpPrepare {
 			mgr.onPreparCommit(op.Txn)
+			// A prepared txn waits for the decision out of the queues
+			if op.Op == OpPrepare && op.Txn.GetError() == nil {
+				err := mgr.onPrepared(op)
+				if err == nil {
+					continue
+				}
+				op.Txn.SetError(err)
+			}
 			if op.Txn.GetError() != nil {
 				op.Op = OpRollback
 				op.Txn.SetError(txnif.TxnRollbacked)
@@ -179,18 +585,32 @@ func (mgr *TxnManager) onCommit(items ...interface{}) {
 	now := time.Now()
 	for _, item := range items {
 		op := item.(*OpTxn)
+		listeners := mgr.getListeners()
+		var changes []txnif.TableChanges
+		if len(listeners) != 0 {
+			changes = op.Txn.GetStore().GetChanges()
+		}
 		switch op.Op {
 		case OpCommit:
 			if err := op.Txn.ApplyCommit(); err != nil {
 				panic(err)
 			}
+			for _, listener := range listeners {
+				listener.OnCommit(op.Txn, changes)
+			}
 		case OpRollback:
 			if err := op.Txn.ApplyRollback(); err != nil {
 				panic(err)
 			}
+			for _, listener := range listeners {
+				listener.OnRollback(op.Txn, changes)
+			}
 		}
 		// Here only wait the txn to be done. The err returned can be access via op.Txn.GetError()
 		_ = op.Txn.WaitDone()
+		if op.prepared != nil {
+			op.prepared <- op.Txn.GetError()
+		}
 		logutil.Debugf("%s Done", op.Repr())
 	}
 	logutil.Infof("Commit %d Txns Takes: %s", len(items), time.Since(now))
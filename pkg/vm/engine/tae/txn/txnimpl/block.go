@@ -35,9 +35,15 @@ type txnBlock struct {
 
 type blockIt struct {
 	sync.RWMutex
-	txn    txnif.AsyncTxn
-	linkIt *common.LinkIt
-	curr   *catalog.BlockEntry
+	txn     txnif.AsyncTxn
+	linkIt  *common.LinkIt
+	curr    *catalog.BlockEntry
+	filters []*handle.Filter
+	// pruned is true if the partition of the segment is pruned by filters
+	pruned bool
+	// next is the block readable after curr. Its data is prefetched while
+	// curr is scanned
+	next *catalog.BlockEntry
 }
 
 type relBlockIt struct {
@@ -45,48 +51,64 @@ type relBlockIt struct {
 	rel       handle.Relation
 	segmentIt handle.SegmentIt
 	blockIt   handle.BlockIt
+	filters   []*handle.Filter
 }
 
-func newBlockIt(txn txnif.AsyncTxn, meta *catalog.SegmentEntry) *blockIt {
+func newBlockIt(txn txnif.AsyncTxn, meta *catalog.SegmentEntry, filters ...*handle.Filter) *blockIt {
 	it := &blockIt{
-		txn:    txn,
-		linkIt: meta.MakeBlockIt(true),
+		txn:     txn,
+		linkIt:  meta.MakeBlockIt(true),
+		filters: filters,
+		pruned:  len(filters) > 0 && !meta.MayMatch(txn, filters...),
 	}
+	if it.curr = it.seek(); it.curr != nil {
+		it.seekNext()
+	}
+	return it
+}
+
+// seek returns the first block readable from the position of linkIt and
+// moves linkIt past it
+func (it *blockIt) seek() *catalog.BlockEntry {
 	for it.linkIt.Valid() {
-		curr := it.linkIt.Get().GetPayload().(*catalog.BlockEntry)
-		curr.RLock()
-		if curr.TxnCanRead(it.txn, curr.RWMutex) {
-			curr.RUnlock()
-			it.curr = curr
-			break
-		}
-		curr.RUnlock()
+		entry := it.linkIt.Get().GetPayload().(*catalog.BlockEntry)
 		it.linkIt.Next()
+		if it.canRead(entry) {
+			return entry
+		}
 	}
-	return it
+	return nil
+}
+
+// seekNext finds the block after curr and prefetches its data
+func (it *blockIt) seekNext() {
+	if it.next = it.seek(); it.next != nil && it.next.GetBlockData() != nil {
+		it.next.GetBlockData().Prefetch()
+	}
+}
+
+// canRead returns true if the block is visible to the txn and not pruned by
+// the filters of the iterator
+func (it *blockIt) canRead(entry *catalog.BlockEntry) bool {
+	if it.pruned {
+		return false
+	}
+	entry.RLock()
+	valid := entry.TxnCanRead(it.txn, entry.RWMutex)
+	entry.RUnlock()
+	if !valid || len(it.filters) == 0 {
+		return valid
+	}
+	return entry.GetBlockData().MayMatch(it.txn, it.filters...)
 }
 
 func (it *blockIt) Close() error { return nil }
 
-func (it *blockIt) Valid() bool { return it.linkIt.Valid() }
+func (it *blockIt) Valid() bool { return it.curr != nil }
 
 func (it *blockIt) Next() {
-	valid := true
-	for {
-		it.linkIt.Next()
-		node := it.linkIt.Get()
-		if node == nil {
-			it.curr = nil
-			break
-		}
-		entry := node.GetPayload().(*catalog.BlockEntry)
-		entry.RLock()
-		valid = entry.TxnCanRead(it.txn, entry.RWMutex)
-		entry.RUnlock()
-		if valid {
-			it.curr = entry
-			break
-		}
+	if it.curr = it.next; it.curr != nil {
+		it.seekNext()
 	}
 }
 
@@ -131,6 +153,9 @@ func (blk *txnBlock) GetTotalChanges() int {
 func (blk *txnBlock) IsAppendableBlock() bool { return blk.entry.IsAppendable() }
 func (blk *txnBlock) ID() uint64              { return blk.entry.GetID() }
 func (blk *txnBlock) Fingerprint() *common.ID { return blk.entry.AsCommonID() }
+func (blk *txnBlock) MayMatch(filters ...*handle.Filter) bool {
+	return blk.entry.GetBlockData().MayMatch(blk.Txn, filters...)
+}
 func (blk *txnBlock) BatchDedup(pks *gvec.Vector) (err error) {
 	blkData := blk.entry.GetBlockData()
 	blk.Txn.GetStore().LogBlockID(blk.getDBID(), blk.entry.GetSegment().GetTable().GetID(), blk.entry.GetID())
@@ -156,6 +181,12 @@ func (blk *txnBlock) Update(row uint32, col uint16, v interface{}) (err error) {
 // TODO: temp use coarse rows
 func (blk *txnBlock) Rows() int { return blk.entry.GetBlockData().Rows(blk.Txn, true) }
 
+func (blk *txnBlock) CommittedRows() int64 { return blk.entry.GetRowStats().Rows() }
+
+func (blk *txnBlock) Size(attr string) int64 {
+	return blk.entry.GetRowStats().ColumnSize(blk.entry.GetSchema(), attr)
+}
+
 func (blk *txnBlock) GetColumnDataById(colIdx int, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error) {
 	return blk.entry.GetBlockData().GetColumnDataById(blk.Txn, colIdx, compressed, decompressed)
 }
@@ -173,25 +204,26 @@ func (blk *txnBlock) GetSegment() (seg handle.Segment) {
 }
 
 // TODO: segmentit or tableit
-func newRelationBlockIt(rel handle.Relation) *relBlockIt {
+func newRelationBlockIt(rel handle.Relation, filters ...*handle.Filter) *relBlockIt {
 	segmentIt := rel.MakeSegmentIt()
 	if !segmentIt.Valid() {
 		return new(relBlockIt)
 	}
 	seg := segmentIt.GetSegment()
-	blockIt := seg.MakeBlockIt()
+	blockIt := seg.MakeBlockItWithFilters(filters...)
 	for !blockIt.Valid() {
 		segmentIt.Next()
 		if !segmentIt.Valid() {
 			return new(relBlockIt)
 		}
 		seg = segmentIt.GetSegment()
-		blockIt = seg.MakeBlockIt()
+		blockIt = seg.MakeBlockItWithFilters(filters...)
 	}
 	return &relBlockIt{
 		blockIt:   blockIt,
 		segmentIt: segmentIt,
 		rel:       rel,
+		filters:   filters,
 	}
 }
 
@@ -206,7 +238,7 @@ func (it *relBlockIt) Valid() bool {
 			return false
 		}
 		seg := it.segmentIt.GetSegment()
-		it.blockIt = seg.MakeBlockIt()
+		it.blockIt = seg.MakeBlockItWithFilters(it.filters...)
 		return it.blockIt.Valid()
 	}
 	return true
@@ -226,5 +258,5 @@ func (it *relBlockIt) Next() {
 		return
 	}
 	seg := it.segmentIt.GetSegment()
-	it.blockIt = seg.MakeBlockIt()
+	it.blockIt = seg.MakeBlockItWithFilters(it.filters...)
 }
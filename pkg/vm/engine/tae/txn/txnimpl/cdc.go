@@ -0,0 +1,183 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+// changeOp is a batch of the changes of the same type made in a row by a
+// txn. The batch of an update has the primary key and the column col
+type changeOp struct {
+	typ cdc.ChangeType
+	col uint16
+	bat *gbat.Batch
+}
+
+// changeCollector collects the rows changed by a txn on a table in the order
+// the txn changed them, to be published to the change feed of the table on
+// commit. The rows appended are placed at the last append of the txn, as a
+// committed row deleted before can be appended again but a row appended can
+// not be deleted or updated as a committed one
+type changeCollector struct {
+	schema *catalog.Schema
+	ops    []*changeOp
+	// insertAt is the number of the ops before the last append
+	insertAt int
+	inserts  []*gbat.Batch
+}
+
+func newChangeCollector(schema *catalog.Schema) *changeCollector {
+	return &changeCollector{
+		schema: schema,
+	}
+}
+
+func (collector *changeCollector) newBatch(cols ...int) *gbat.Batch {
+	attrs := make([]string, len(cols))
+	for i, col := range cols {
+		attrs[i] = collector.schema.ColDefs[col].Name
+	}
+	bat := gbat.New(true, attrs)
+	for i, col := range cols {
+		bat.Vecs[i] = gvec.New(collector.schema.ColDefs[col].Type)
+	}
+	return bat
+}
+
+// lastOp returns the last op if it is of typ and col and no append is made
+// after it
+func (collector *changeCollector) lastOp(typ cdc.ChangeType, col uint16) *changeOp {
+	if len(collector.ops) == 0 || len(collector.ops) == collector.insertAt {
+		return nil
+	}
+	op := collector.ops[len(collector.ops)-1]
+	if op.typ != typ || op.col != col {
+		return nil
+	}
+	return op
+}
+
+// OnAppend marks the position of the rows appended by the txn
+func (collector *changeCollector) OnAppend() {
+	collector.insertAt = len(collector.ops)
+}
+
+func (collector *changeCollector) AddInserts(bat *gbat.Batch) {
+	if gvec.Length(bat.Vecs[0]) == 0 {
+		return
+	}
+	collector.inserts = append(collector.inserts, bat)
+}
+
+func (collector *changeCollector) AddDeletes(keys []interface{}) {
+	pk := collector.schema.PrimaryKey
+	op := collector.lastOp(cdc.ChangeDelete, 0)
+	if op == nil {
+		op = &changeOp{
+			typ: cdc.ChangeDelete,
+			bat: collector.newBatch(int(pk)),
+		}
+		collector.ops = append(collector.ops, op)
+	}
+	for _, key := range keys {
+		compute.AppendValue(op.bat.Vecs[0], key)
+	}
+}
+
+func (collector *changeCollector) AddUpdate(col uint16, key, v interface{}) {
+	op := collector.lastOp(cdc.ChangeUpdate, col)
+	if op == nil {
+		op = &changeOp{
+			typ: cdc.ChangeUpdate,
+			col: col,
+			bat: collector.newBatch(int(collector.schema.PrimaryKey), int(col)),
+		}
+		collector.ops = append(collector.ops, op)
+	}
+	compute.AppendValue(op.bat.Vecs[0], key)
+	compute.AppendValue(op.bat.Vecs[1], v)
+}
+
+// Changes returns the changes in the order the txn made them
+func (collector *changeCollector) Changes(txnId, commitTs uint64) (changes []*cdc.Change) {
+	makeChange := func(typ cdc.ChangeType, bat *gbat.Batch) *cdc.Change {
+		return &cdc.Change{
+			Type:     typ,
+			TxnID:    txnId,
+			CommitTS: commitTs,
+			Data:     bat,
+		}
+	}
+	for _, op := range collector.ops[:collector.insertAt] {
+		changes = append(changes, makeChange(op.typ, op.bat))
+	}
+	for _, bat := range collector.inserts {
+		changes = append(changes, makeChange(cdc.ChangeInsert, bat))
+	}
+	for _, op := range collector.ops[collector.insertAt:] {
+		changes = append(changes, makeChange(op.typ, op.bat))
+	}
+	return
+}
+
+// changeMark is the number of the changes collected at a savepoint
+type changeMark struct {
+	ops      int
+	rows     int
+	insertAt int
+}
+
+func (collector *changeCollector) mark() (mark changeMark) {
+	mark.ops = len(collector.ops)
+	if mark.ops != 0 {
+		mark.rows = gvec.Length(collector.ops[mark.ops-1].bat.Vecs[0])
+	}
+	mark.insertAt = collector.insertAt
+	return
+}
+
+// rollbackTo drops the deletes and updates collected after mark
+func (collector *changeCollector) rollbackTo(mark changeMark) {
+	collector.ops = collector.ops[:mark.ops]
+	collector.insertAt = mark.insertAt
+	if mark.ops == 0 {
+		return
+	}
+	op := collector.ops[mark.ops-1]
+	if gvec.Length(op.bat.Vecs[0]) == mark.rows {
+		return
+	}
+	pk := int(collector.schema.PrimaryKey)
+	if op.typ == cdc.ChangeDelete {
+		op.bat = collector.truncate(op.bat, mark.rows, pk)
+	} else {
+		op.bat = collector.truncate(op.bat, mark.rows, pk, int(op.col))
+	}
+}
+
+func (collector *changeCollector) truncate(bat *gbat.Batch, rows int, cols ...int) *gbat.Batch {
+	truncated := collector.newBatch(cols...)
+	for i, vec := range bat.Vecs {
+		for row := 0; row < rows; row++ {
+			compute.AppendValue(truncated.Vecs[i], compute.GetValue(vec, uint32(row)))
+		}
+	}
+	return truncated
+}
@@ -27,6 +27,8 @@ type commandManager struct {
 	lsn    uint64
 	csn    uint32
 	driver wal.Driver
+	// record is the payload of the WAL entry of the txn
+	record []byte
 }
 
 func newCommandManager(driver wal.Driver) *commandManager {
@@ -61,6 +63,7 @@ func (mgr *commandManager) ApplyTxnRecord() (logEntry entry.Entry, err error) {
 	if buf, err = mgr.cmd.Marshal(); err != nil {
 		panic(err)
 	}
+	mgr.record = buf
 	logEntry = entry.GetBase()
 	logEntry.SetType(ETTxnRecord)
 	if err = logEntry.Unmarshal(buf); err != nil {
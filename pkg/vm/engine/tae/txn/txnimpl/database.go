@@ -92,17 +92,25 @@ func newDatabase(txn txnif.AsyncTxn, meta *catalog.DBEntry) *txnDatabase {
 
 }
 func (db *txnDatabase) GetID() uint64   { return db.entry.GetID() }
-func (db *txnDatabase) GetName() string { return db.entry.GetName() }
+func (db *txnDatabase) GetName() string { return db.entry.TxnGetName(db.Txn) }
 func (db *txnDatabase) String() string  { return db.entry.String() }
 
 func (db *txnDatabase) CreateRelation(def interface{}) (rel handle.Relation, err error) {
 	return db.Txn.GetStore().CreateRelation(db.entry.ID, def)
 }
 
+func (db *txnDatabase) CreateRelations(defs []interface{}) (rels []handle.Relation, err error) {
+	return db.Txn.GetStore().CreateRelations(db.entry.ID, defs)
+}
+
 func (db *txnDatabase) DropRelationByName(name string) (rel handle.Relation, err error) {
 	return db.Txn.GetStore().DropRelationByName(db.entry.ID, name)
 }
 
+func (db *txnDatabase) RenameRelation(name, newName string) (rel handle.Relation, err error) {
+	return db.Txn.GetStore().RenameRelation(db.entry.ID, name, newName)
+}
+
 func (db *txnDatabase) GetRelationByName(name string) (rel handle.Relation, err error) {
 	return db.Txn.GetStore().GetRelationByName(db.entry.ID, name)
 }
@@ -55,6 +55,8 @@ type InsertNode interface {
 	Append(data *gbat.Batch, offset uint32) (appended uint32, err error)
 	RangeDelete(start, end uint32) error
 	IsRowDeleted(row uint32) bool
+	CloneDeletes() *roaring.Bitmap
+	SetDeletes(deletes *roaring.Bitmap)
 	PrintDeletes() string
 	Window(start, end uint32) (*gbat.Batch, error)
 	GetSpace() uint32
@@ -66,6 +68,8 @@ type InsertNode interface {
 	RowsWithoutDeletes() uint32
 	LengthWithDeletes(appended, toAppend uint32) uint32
 	GetAppends() []*appendInfo
+	Spill()
+	IsSpilled() bool
 }
 
 type appendInfo struct {
@@ -187,6 +191,7 @@ type insertNode struct {
 	rows    uint32
 	table   Table
 	appends []*appendInfo
+	spilled bool
 }
 
 func NewInsertNode(tbl Table, mgr base.INodeManager, id common.ID, driver wal.Driver) *insertNode {
@@ -449,6 +454,34 @@ func (n *insertNode) RangeDelete(start, end uint32) error {
 	return nil
 }
 
+// CloneDeletes returns a copy of the deleted rows or nil if there is none
+func (n *insertNode) CloneDeletes() *roaring.Bitmap {
+	if n.deletes == nil {
+		return nil
+	}
+	return n.deletes.Clone()
+}
+
+func (n *insertNode) SetDeletes(deletes *roaring.Bitmap) { n.deletes = deletes }
+
+// Spill releases the data of the node whose rows are written to blocks. The
+// rows of the node are all deleted and it should not be pinned again
+func (n *insertNode) Spill() {
+	n.deletes = roaring.New()
+	n.deletes.AddRange(0, uint64(n.rows))
+	n.spilled = true
+	n.ToTransient()
+	n.Lock()
+	n.Unload()
+	n.Unlock()
+	if n.data != nil {
+		n.data.Close()
+		n.data = nil
+	}
+}
+
+func (n *insertNode) IsSpilled() bool { return n.spilled }
+
 func (n *insertNode) IsRowDeleted(row uint32) bool {
 	if n.deletes == nil {
 		return false
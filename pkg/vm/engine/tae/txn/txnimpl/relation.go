@@ -114,14 +114,18 @@ func (h *txnRelation) SimplePPString(level common.PPLevel) string {
 }
 
 func (h *txnRelation) GetMeta() interface{}   { return h.entry }
-func (h *txnRelation) GetSchema() interface{} { return h.entry.GetSchema() }
+func (h *txnRelation) GetSchema() interface{} { return h.entry.TxnGetSchema(h.Txn) }
+func (h *txnRelation) Schema() interface{}    { return h.entry.TxnGetSchema(h.Txn) }
 
 func (h *txnRelation) Close() error                     { return nil }
-func (h *txnRelation) Rows() int64                      { return 0 }
-func (h *txnRelation) Size(attr string) int64           { return 0 }
+func (h *txnRelation) Rows() int64                      { return h.entry.GetRowStats().Rows() }
 func (h *txnRelation) GetCardinality(attr string) int64 { return 0 }
 func (h *txnRelation) MakeReader() handle.Reader        { return nil }
 
+func (h *txnRelation) Size(attr string) int64 {
+	return h.entry.GetRowStats().ColumnSize(h.entry.TxnGetSchema(h.Txn), attr)
+}
+
 func (h *txnRelation) BatchDedup(col *vector.Vector) error {
 	return h.Txn.GetStore().BatchDedup(h.entry.GetDB().ID, h.entry.GetID(), col)
 }
@@ -130,6 +134,26 @@ func (h *txnRelation) Append(data *batch.Batch) error {
 	return h.Txn.GetStore().Append(h.entry.GetDB().ID, h.entry.GetID(), data)
 }
 
+func (h *txnRelation) AddColumn(def interface{}) error {
+	return h.Txn.GetStore().AddColumn(h.entry.GetDB().ID, h.entry.GetID(), def)
+}
+
+func (h *txnRelation) AddIndex(name, col string) error {
+	return h.Txn.GetStore().AddIndex(h.entry.GetDB().ID, h.entry.GetID(), name, col)
+}
+
+func (h *txnRelation) DropIndex(name string) error {
+	return h.Txn.GetStore().DropIndex(h.entry.GetDB().ID, h.entry.GetID(), name)
+}
+
+func (h *txnRelation) DropColumn(name string) error {
+	return h.Txn.GetStore().DropColumn(h.entry.GetDB().ID, h.entry.GetID(), name)
+}
+
+func (h *txnRelation) Analyze() error {
+	return h.Txn.GetStore().Analyze(h.entry.GetDB().ID, h.entry.GetID())
+}
+
 func (h *txnRelation) GetSegment(id uint64) (seg handle.Segment, err error) {
 	fp := h.entry.AsCommonID()
 	fp.SegmentID = id
@@ -158,10 +182,32 @@ func (h *txnRelation) MakeBlockIt() handle.BlockIt {
 	return newRelationBlockIt(h)
 }
 
+func (h *txnRelation) MakeBlockItWithFilters(filters ...*handle.Filter) handle.BlockIt {
+	return newRelationBlockIt(h, filters...)
+}
+
+func (h *txnRelation) Partitions(filters ...*handle.Filter) []uint32 {
+	schema := h.entry.TxnGetSchema(h.Txn)
+	if !schema.IsPartitioned() {
+		return nil
+	}
+	partitions := make([]uint32, 0, schema.Partition.Count())
+	for i := uint32(0); i < schema.Partition.Count(); i++ {
+		if schema.MayMatchPartition(i, filters...) {
+			partitions = append(partitions, i)
+		}
+	}
+	return partitions
+}
+
 func (h *txnRelation) GetByFilter(filter *handle.Filter) (*common.ID, uint32, error) {
 	return h.Txn.GetStore().GetByFilter(h.entry.GetDB().ID, h.entry.GetID(), filter)
 }
 
+func (h *txnRelation) GetByIndex(attr string, val interface{}) ([]*common.ID, []uint32, error) {
+	return h.Txn.GetStore().GetByIndex(h.entry.GetDB().ID, h.entry.GetID(), attr, val)
+}
+
 func (h *txnRelation) Update(id *common.ID, row uint32, col uint16, v interface{}) error {
 	return h.Txn.GetStore().Update(h.entry.GetDB().ID, id, row, col, v)
 }
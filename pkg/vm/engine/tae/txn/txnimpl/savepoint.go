@@ -0,0 +1,269 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+// savepoint records the changes of all the tables of a txn at a point.
+// The DDLs and the row locks are not undone by rolling back to it
+type savepoint struct {
+	name string
+	dbs  map[uint64]map[uint64]*tableSavepoint
+}
+
+// tableSavepoint records the changes of a table in the txn workspace. The
+// zero value is the table without any change
+type tableSavepoint struct {
+	rows         uint32
+	inodeDeletes []*roaring.Bitmap
+	deletes      map[common.ID]*roaring.Bitmap
+	updateMasks  map[common.ID]*roaring.Bitmap
+	updateVals   map[common.ID]map[uint32]interface{}
+	changes      changeMark
+	spilledRows  uint32
+}
+
+func (store *txnStore) Savepoint(name string) error {
+	sp := &savepoint{
+		name: name,
+		dbs:  make(map[uint64]map[uint64]*tableSavepoint),
+	}
+	for id, db := range store.dbs {
+		sp.dbs[id] = db.Savepoint()
+	}
+	// A savepoint replaces the one of the same name
+	for i, old := range store.savepoints {
+		if old.name == name {
+			store.savepoints = append(store.savepoints[:i], store.savepoints[i+1:]...)
+			break
+		}
+	}
+	store.savepoints = append(store.savepoints, sp)
+	return nil
+}
+
+// RollbackTo undoes the appends, updates and deletes after the savepoint.
+// The savepoint is kept and the later ones are released
+func (store *txnStore) RollbackTo(name string) (err error) {
+	pos := -1
+	for i, sp := range store.savepoints {
+		if sp.name == name {
+			pos = i
+		}
+	}
+	if pos == -1 {
+		return txnbase.ErrSavepointNotFound
+	}
+	sp := store.savepoints[pos]
+	for id, db := range store.dbs {
+		if err = db.RollbackTo(sp.dbs[id]); err != nil {
+			return
+		}
+	}
+	store.savepoints = store.savepoints[:pos+1]
+	return
+}
+
+func (db *txnDB) Savepoint() map[uint64]*tableSavepoint {
+	sps := make(map[uint64]*tableSavepoint)
+	for id, table := range db.tables {
+		sps[id] = table.Savepoint()
+	}
+	return sps
+}
+
+func (db *txnDB) RollbackTo(sps map[uint64]*tableSavepoint) (err error) {
+	for id, table := range db.tables {
+		sp := sps[id]
+		if sp == nil {
+			sp = new(tableSavepoint)
+		}
+		if err = table.RollbackTo(sp); err != nil {
+			break
+		}
+	}
+	return
+}
+
+func (tbl *txnTable) Savepoint() *tableSavepoint {
+	sp := &tableSavepoint{
+		rows:        tbl.rows,
+		spilledRows: tbl.spilledRows,
+		deletes:     make(map[common.ID]*roaring.Bitmap),
+		updateMasks: make(map[common.ID]*roaring.Bitmap),
+		updateVals:  make(map[common.ID]map[uint32]interface{}),
+	}
+	for _, n := range tbl.inodes {
+		sp.inodeDeletes = append(sp.inodeDeletes, n.CloneDeletes())
+	}
+	for id, node := range tbl.deleteNodes {
+		chain := node.GetChain()
+		chain.RLock()
+		sp.deletes[id] = node.(*updates.DeleteNode).GetDeleteMaskLocked().Clone()
+		chain.RUnlock()
+	}
+	for id, node := range tbl.updateNodes {
+		col := node.(*updates.ColumnNode)
+		chain := node.GetChain()
+		chain.RLock()
+		sp.updateMasks[id] = col.GetMask().Clone()
+		vals := make(map[uint32]interface{}, len(col.GetValues()))
+		for row, v := range col.GetValues() {
+			vals[row] = v
+		}
+		sp.updateVals[id] = vals
+		chain.RUnlock()
+	}
+	if tbl.changes != nil {
+		sp.changes = tbl.changes.mark()
+	}
+	return sp
+}
+
+func (tbl *txnTable) RollbackTo(sp *tableSavepoint) (err error) {
+	// The spilled blocks are not rolled back
+	if tbl.spilledRows > sp.spilledRows {
+		return ErrSavepointSpilled
+	}
+	if err = tbl.rollbackLocalTo(sp); err != nil {
+		return
+	}
+	removed := make(map[interface{}]bool)
+	for id, node := range tbl.deleteNodes {
+		mask, ok := sp.deletes[id]
+		if !ok {
+			if err = node.PrepareRollback(); err != nil {
+				return
+			}
+			delete(tbl.deleteNodes, id)
+			removed[node] = true
+			continue
+		}
+		chain := node.GetChain()
+		chain.Lock()
+		node.(*updates.DeleteNode).RollbackToLocked(mask.Clone())
+		chain.Unlock()
+	}
+	for id, node := range tbl.updateNodes {
+		mask, ok := sp.updateMasks[id]
+		if !ok {
+			if err = node.PrepareRollback(); err != nil {
+				return
+			}
+			delete(tbl.updateNodes, id)
+			removed[node] = true
+			continue
+		}
+		vals := make(map[uint32]interface{}, len(sp.updateVals[id]))
+		for row, v := range sp.updateVals[id] {
+			vals[row] = v
+		}
+		chain := node.GetChain()
+		chain.Lock()
+		node.(*updates.ColumnNode).RollbackToLocked(mask.Clone(), vals)
+		chain.Unlock()
+	}
+	if len(removed) > 0 {
+		entries := tbl.txnEntries[:0]
+		for _, entry := range tbl.txnEntries {
+			if !removed[entry] {
+				entries = append(entries, entry)
+			}
+		}
+		tbl.txnEntries = entries
+	}
+	if tbl.changes != nil {
+		tbl.changes.rollbackTo(sp.changes)
+	}
+	return
+}
+
+// rollbackLocalTo deletes the rows appended after the savepoint and restores
+// the rows deleted since. The keys of the deleted rows are removed from the
+// local index before the restored ones are inserted back, as a local update
+// deletes the row and appends it again with the same key
+func (tbl *txnTable) rollbackLocalTo(sp *tableSavepoint) (err error) {
+	for _, restore := range []bool{false, true} {
+		for i, n := range tbl.inodes {
+			if err = tbl.rollbackLocalIndex(sp, i, n, restore); err != nil {
+				return
+			}
+		}
+	}
+	for i, n := range tbl.inodes {
+		start := uint32(i) * txnbase.MaxNodeRows
+		deletes := roaring.New()
+		if i < len(sp.inodeDeletes) && sp.inodeDeletes[i] != nil {
+			deletes = sp.inodeDeletes[i].Clone()
+		}
+		if start+n.Rows() > sp.rows {
+			from := uint32(0)
+			if sp.rows > start {
+				from = sp.rows - start
+			}
+			deletes.AddRange(uint64(from), uint64(n.Rows()))
+		}
+		if deletes.IsEmpty() {
+			deletes = nil
+		}
+		n.SetDeletes(deletes)
+	}
+	return
+}
+
+// rollbackLocalIndex inserts the keys of the rows of the i-th insert node to
+// be restored if restore is true, or else deletes the keys of the rows to be
+// deleted
+func (tbl *txnTable) rollbackLocalIndex(sp *tableSavepoint, i int, n InsertNode, restore bool) (err error) {
+	if n.IsSpilled() {
+		return
+	}
+	var saved *roaring.Bitmap
+	if i < len(sp.inodeDeletes) {
+		saved = sp.inodeDeletes[i]
+	}
+	pk := int(tbl.GetSchema().PrimaryKey)
+	start := uint32(i) * txnbase.MaxNodeRows
+	h := tbl.store.nodesMgr.Pin(n)
+	defer h.Close()
+	for offset := uint32(0); offset < n.Rows(); offset++ {
+		row := start + offset
+		wasLive := row < sp.rows && (saved == nil || !saved.Contains(offset))
+		if wasLive != restore || wasLive != n.IsRowDeleted(offset) {
+			continue
+		}
+		var v interface{}
+		if v, err = n.GetValue(pk, offset); err != nil {
+			return
+		}
+		if restore {
+			if bs, ok := v.([]byte); ok {
+				v = string(bs)
+			}
+			err = tbl.index.Insert(v, row)
+		} else {
+			err = tbl.index.Delete(v)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
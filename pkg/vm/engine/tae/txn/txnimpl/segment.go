@@ -99,9 +99,16 @@ func (seg *txnSegment) GetMeta() interface{} { return seg.entry }
 func (seg *txnSegment) String() string       { return seg.entry.String() }
 func (seg *txnSegment) GetID() uint64        { return seg.entry.GetID() }
 func (seg *txnSegment) getDBID() uint64      { return seg.entry.GetTable().GetDB().ID }
+func (seg *txnSegment) Rows() int64          { return seg.entry.GetRowStats().Rows() }
+func (seg *txnSegment) Size(attr string) int64 {
+	return seg.entry.GetRowStats().ColumnSize(seg.entry.GetTable().TxnGetSchema(seg.Txn), attr)
+}
 func (seg *txnSegment) MakeBlockIt() (it handle.BlockIt) {
 	return newBlockIt(seg.Txn, seg.entry)
 }
+func (seg *txnSegment) MakeBlockItWithFilters(filters ...*handle.Filter) (it handle.BlockIt) {
+	return newBlockIt(seg.Txn, seg.entry, filters...)
+}
 
 func (seg *txnSegment) CreateNonAppendableBlock() (blk handle.Block, err error) {
 	return seg.Txn.GetStore().CreateNonAppendableBlock(seg.getDBID(), seg.entry.AsCommonID())
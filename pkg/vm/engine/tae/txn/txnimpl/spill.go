@@ -0,0 +1,175 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+)
+
+// needSpill returns true if the rows of the table kept in memory exceed the
+// spill threshold of the txn. The rows of a partitioned table are not
+// spilled as a spilled block is in a single partition
+func (tbl *txnTable) needSpill() bool {
+	spillRows := tbl.store.spillRows
+	if spillRows == 0 || tbl.GetSchema().IsPartitioned() {
+		return false
+	}
+	return tbl.rows-tbl.spilledRows >= spillRows
+}
+
+// spillLocal writes the rows of the full insert nodes to provisional blocks
+// created by the txn. The blocks become visible when the txn commits and are
+// removed when it rolls back. The keys of the spilled rows are moved from the
+// local index to the spill index to dedup the rows appended later
+func (tbl *txnTable) spillLocal() (err error) {
+	for _, node := range tbl.inodes[:len(tbl.inodes)-1] {
+		if node.IsSpilled() {
+			continue
+		}
+		if err = tbl.spillNode(node); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (tbl *txnTable) spillNode(node InsertNode) (err error) {
+	h := tbl.store.nodesMgr.Pin(node)
+	if h == nil {
+		panic("not expected")
+	}
+	defer h.Close()
+	pk := int(tbl.GetSchema().PrimaryKey)
+	maxRows := tbl.GetSchema().BlockMaxRows
+	rows := node.Rows()
+	for start := uint32(0); start < rows; {
+		// The window of at most maxRows live rows with the deleted ones
+		count, live := uint32(0), uint32(0)
+		for start+count < rows && live < maxRows {
+			if !node.IsRowDeleted(start + count) {
+				live++
+			}
+			count++
+		}
+		if live > 0 {
+			var bat *batch.Batch
+			if bat, err = node.Window(start, start+count-1); err != nil {
+				return
+			}
+			if err = tbl.spillBlock(bat); err != nil {
+				return
+			}
+		}
+		for row := start; row < start+count; row++ {
+			if node.IsRowDeleted(row) {
+				continue
+			}
+			var v interface{}
+			if v, err = node.GetValue(pk, row); err != nil {
+				return
+			}
+			if err = tbl.index.Delete(v); err != nil {
+				return
+			}
+		}
+		start += count
+	}
+	tbl.spilledRows += rows
+	node.Spill()
+	return
+}
+
+// spillBlock writes bat sorted by the primary key to a new non-appendable
+// block of the spill segment
+func (tbl *txnTable) spillBlock(bat *batch.Batch) (err error) {
+	schema := tbl.GetSchema()
+	pks := bat.Vecs[schema.PrimaryKey]
+	if err = tbl.spillIndex.BatchInsert(pks, 0, vector.Length(pks), 0, false); err != nil {
+		return
+	}
+	if collector := tbl.getChangeCollector(); collector != nil {
+		collector.AddInserts(bat)
+	}
+	if err = mergesort.SortBlockColumns(bat.Vecs, int(schema.PrimaryKey)); err != nil {
+		return
+	}
+	if tbl.spillSeg == nil || tbl.spillSegBlks >= int(schema.SegmentMaxBlocks) {
+		seg, err := tbl.CreateNonAppendableSegment()
+		if err != nil {
+			return err
+		}
+		tbl.spillSeg = seg.GetMeta().(*catalog.SegmentEntry)
+		tbl.spillSegBlks = 0
+	}
+	blk, err := tbl.CreateNonAppendableBlock(tbl.spillSeg.GetID())
+	if err != nil {
+		return
+	}
+	tbl.spillSegBlks++
+	meta := blk.GetMeta().(*catalog.BlockEntry)
+	blkData := meta.GetBlockData()
+	file := blkData.GetBlockFile()
+	if err = jobs.BuildAndFlushBlockIndex(file, meta, bat.Vecs); err != nil {
+		return
+	}
+	if err = file.WriteBatch(bat, tbl.store.txn.GetStartTS()); err != nil {
+		return
+	}
+	if err = file.Sync(); err != nil {
+		return
+	}
+	if err = blkData.ReplayData(); err != nil {
+		return
+	}
+	tbl.spilledBlks = append(tbl.spilledBlks, meta)
+	metrics.AppendRows.Add(float64(vector.Length(pks)))
+	logutil.Debugf("%s: spilled %d rows", meta.Repr(), vector.Length(pks))
+	return
+}
+
+// applySpill adds the rows of the spilled blocks to the statistics on commit
+func (tbl *txnTable) applySpill() {
+	for _, meta := range tbl.spilledBlks {
+		rows := int64(meta.GetBlockData().Rows(nil, true))
+		meta.AddRowStats(rows, meta.EstimateSize(rows))
+	}
+}
+
+// isSpilledSegment returns true if the segment is created by the spill. The
+// max segment deduped before commit does not count the spilled ones as the
+// segments committed by other txns may have smaller ids
+func (tbl *txnTable) isSpilledSegment(sid uint64) bool {
+	for _, meta := range tbl.spilledBlks {
+		if meta.GetSegment().GetID() == sid {
+			return true
+		}
+	}
+	return false
+}
+
+func (tbl *txnTable) isSpilledBlock(bid uint64) bool {
+	for _, meta := range tbl.spilledBlks {
+		if meta.GetID() == bid {
+			return true
+		}
+	}
+	return false
+}
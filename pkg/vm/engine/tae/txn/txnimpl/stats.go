@@ -0,0 +1,139 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	hll "github.com/axiomhq/hyperloglog"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+// columnStatsCollector estimates the NDV of a column with a HyperLogLog
+// sketch and tracks its null count and min/max
+type columnStatsCollector struct {
+	def      *catalog.ColDef
+	sketch   *hll.Sketch
+	nullCnt  uint64
+	min, max interface{}
+}
+
+func newColumnStatsCollector(def *catalog.ColDef) *columnStatsCollector {
+	return &columnStatsCollector{
+		def:    def,
+		sketch: hll.New(),
+	}
+}
+
+func (collector *columnStatsCollector) collect(vec *gvec.Vector) (err error) {
+	typ := collector.def.Type
+	for i := 0; i < gvec.Length(vec); i++ {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			collector.nullCnt++
+			continue
+		}
+		v := compute.GetValue(vec, uint32(i))
+		var hash uint64
+		if hash, err = common.Hash(v, typ); err != nil {
+			return
+		}
+		collector.sketch.InsertHash(hash)
+		if collector.min == nil || common.CompareGeneric(v, collector.min, typ) < 0 {
+			collector.min = copyValue(v)
+		}
+		if collector.max == nil || common.CompareGeneric(v, collector.max, typ) > 0 {
+			collector.max = copyValue(v)
+		}
+	}
+	return
+}
+
+func (collector *columnStatsCollector) stats() (stats *catalog.ColumnStats, err error) {
+	stats = &catalog.ColumnStats{
+		Name:    collector.def.Name,
+		NDV:     collector.sketch.Estimate(),
+		NullCnt: collector.nullCnt,
+	}
+	if collector.min == nil {
+		return
+	}
+	if stats.Min, err = common.EncodeKey(collector.min, collector.def.Type); err != nil {
+		return
+	}
+	stats.Max, err = common.EncodeKey(collector.max, collector.def.Type)
+	return
+}
+
+// copyValue detaches a varlen value from the buffer of the column view
+func copyValue(v interface{}) interface{} {
+	if buf, ok := v.([]byte); ok {
+		return append([]byte(nil), buf...)
+	}
+	return v
+}
+
+// collectStats scans the rows visible to the txn. The rows appended in the
+// txn are not counted
+func (tbl *txnTable) collectStats() (stats *catalog.TableStats, err error) {
+	schema := tbl.GetSchema()
+	collectors := make([]*columnStatsCollector, len(schema.ColDefs))
+	for i, def := range schema.ColDefs {
+		collectors[i] = newColumnStatsCollector(def)
+	}
+	stats = &catalog.TableStats{
+		TS: tbl.store.txn.GetStartTS(),
+	}
+	it := newRelationBlockIt(newRelation(tbl.store.txn, tbl.entry))
+	for it.Valid() {
+		blk := it.GetBlock()
+		for i, collector := range collectors {
+			view, err := blk.GetColumnDataById(i, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			vec := view.ApplyDeletes()
+			if i == 0 {
+				stats.Rows += uint64(gvec.Length(vec))
+			}
+			if err = collector.collect(vec); err != nil {
+				return nil, err
+			}
+		}
+		it.Next()
+	}
+	stats.Columns = make([]*catalog.ColumnStats, len(collectors))
+	for i, collector := range collectors {
+		if stats.Columns[i], err = collector.stats(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Analyze collects the statistics of the table, which replace the existing
+// ones when the txn commits
+func (tbl *txnTable) Analyze() (err error) {
+	stats, err := tbl.collectStats()
+	if err != nil {
+		return
+	}
+	if err = tbl.entry.UpdateStats(tbl.store.txn, stats); err != nil {
+		return
+	}
+	tbl.SetAlterEntry(tbl.entry)
+	return
+}
@@ -15,6 +15,7 @@
 package txnimpl
 
 import (
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -44,15 +46,26 @@ type txnStore struct {
 	warChecker  *warChecker
 	dataFactory *tables.DataFactory
 	writeOps    uint32
-}
-
-var TxnStoreFactory = func(catalog *catalog.Catalog, driver wal.Driver, txnBufMgr base.INodeManager, dataFactory *tables.DataFactory) txnbase.TxnStoreFactory {
+	savepoints  []*savepoint
+	spillRows   uint32
+	async       bool
+	// publisher publishes the changes of the subscribed tables once the
+	// WAL entry of the txn is committed
+	publisher *cdc.Publisher
+	// published is true if the changes of the txn are queued in publisher
+	published bool
+	// durable is closed once the WAL entries of the async commit are synced
+	durable    chan struct{}
+	durableErr error
+}
+
+var TxnStoreFactory = func(catalog *catalog.Catalog, driver wal.Driver, txnBufMgr base.INodeManager, dataFactory *tables.DataFactory, spillRows uint32, publisher *cdc.Publisher) txnbase.TxnStoreFactory {
 	return func() txnif.TxnStore {
-		return newStore(catalog, driver, txnBufMgr, dataFactory)
+		return newStore(catalog, driver, txnBufMgr, dataFactory, spillRows, publisher)
 	}
 }
 
-func newStore(catalog *catalog.Catalog, driver wal.Driver, txnBufMgr base.INodeManager, dataFactory *tables.DataFactory) *txnStore {
+func newStore(catalog *catalog.Catalog, driver wal.Driver, txnBufMgr base.INodeManager, dataFactory *tables.DataFactory, spillRows uint32, publisher *cdc.Publisher) *txnStore {
 	return &txnStore{
 		dbs:         make(map[uint64]*txnDB),
 		catalog:     catalog,
@@ -61,7 +74,37 @@ func newStore(catalog *catalog.Catalog, driver wal.Driver, txnBufMgr base.INodeM
 		logs:        make([]entry.Entry, 0),
 		dataFactory: dataFactory,
 		nodesMgr:    txnBufMgr,
+		spillRows:   spillRows,
+		publisher:   publisher,
+	}
+}
+
+// GetChanges returns the summary of the rows changed in each table ordered
+// by the database and table ids
+func (store *txnStore) GetChanges() (changes []txnif.TableChanges) {
+	for id, db := range store.dbs {
+		for _, table := range db.tables {
+			tableChanges := table.GetChanges()
+			if tableChanges.Appended+tableChanges.Deleted+tableChanges.Updated == 0 {
+				continue
+			}
+			tableChanges.DBId = id
+			changes = append(changes, tableChanges)
+		}
 	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].DBId != changes[j].DBId {
+			return changes[i].DBId < changes[j].DBId
+		}
+		return changes[i].TableId < changes[j].TableId
+	})
+	return
+}
+
+// GetTxnRecord returns the payload of the WAL entry of the txn prepared to
+// commit
+func (store *txnStore) GetTxnRecord() []byte {
+	return store.cmdMgr.record
 }
 
 func (store *txnStore) IsReadonly() bool {
@@ -72,6 +115,16 @@ func (store *txnStore) IncreateWriteCnt() int {
 	return int(atomic.AddUint32(&store.writeOps, uint32(1)))
 }
 
+// prepareWrite fails the write of a txn which cannot commit any write
+func (store *txnStore) prepareWrite() error {
+	store.txn.Touch()
+	if err := store.txn.CheckWritable(); err != nil {
+		return err
+	}
+	store.IncreateWriteCnt()
+	return nil
+}
+
 func (store *txnStore) LogTxnEntry(dbId uint64, tableId uint64, entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -101,6 +154,7 @@ func (store *txnStore) Close() error {
 	store.cmdMgr = nil
 	store.logs = nil
 	store.warChecker = nil
+	store.savepoints = nil
 	return err
 }
 
@@ -108,6 +162,13 @@ func (store *txnStore) BindTxn(txn txnif.AsyncTxn) {
 	store.txn = txn
 }
 
+// SetStartTS moves the uncommitted changes to the new start ts of the txn
+func (store *txnStore) SetStartTS(ts uint64) {
+	for _, db := range store.dbs {
+		db.SetStartTS(ts)
+	}
+}
+
 func (store *txnStore) BatchDedup(dbId, id uint64, pks *vector.Vector) (err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -121,7 +182,12 @@ func (store *txnStore) BatchDedup(dbId, id uint64, pks *vector.Vector) (err erro
 }
 
 func (store *txnStore) Append(dbId, id uint64, data *batch.Batch) error {
-	store.IncreateWriteCnt()
+	if err := store.prepareWrite(); err != nil {
+		return err
+	}
+	if err := store.txn.Admit(); err != nil {
+		return err
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return err
@@ -132,8 +198,65 @@ func (store *txnStore) Append(dbId, id uint64, data *batch.Batch) error {
 	return db.Append(id, data)
 }
 
+func (store *txnStore) AddColumn(dbId, id uint64, def interface{}) error {
+	if err := store.prepareWrite(); err != nil {
+		return err
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return err
+	}
+	return db.AddColumn(id, def.(*catalog.ColDef))
+}
+
+func (store *txnStore) AddIndex(dbId, id uint64, name, col string) error {
+	if err := store.prepareWrite(); err != nil {
+		return err
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return err
+	}
+	return db.AddIndex(id, name, col)
+}
+
+func (store *txnStore) DropIndex(dbId, id uint64, name string) error {
+	if err := store.prepareWrite(); err != nil {
+		return err
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return err
+	}
+	return db.DropIndex(id, name)
+}
+
+func (store *txnStore) DropColumn(dbId, id uint64, name string) error {
+	if err := store.prepareWrite(); err != nil {
+		return err
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return err
+	}
+	return db.DropColumn(id, name)
+}
+
+func (store *txnStore) Analyze(dbId, id uint64) error {
+	if err := store.prepareWrite(); err != nil {
+		return err
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return err
+	}
+	return db.Analyze(id)
+}
+
 func (store *txnStore) RangeDelete(dbId uint64, id *common.ID, start, end uint32) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return err
@@ -156,6 +279,14 @@ func (store *txnStore) GetByFilter(dbId, tid uint64, filter *handle.Filter) (id
 	return db.GetByFilter(tid, filter)
 }
 
+func (store *txnStore) GetByIndex(dbId, tid uint64, attr string, val interface{}) (ids []*common.ID, offsets []uint32, err error) {
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return
+	}
+	return db.GetByIndex(tid, attr, val)
+}
+
 func (store *txnStore) GetValue(dbId uint64, id *common.ID, row uint32, colIdx uint16) (v interface{}, err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -169,7 +300,9 @@ func (store *txnStore) GetValue(dbId uint64, id *common.ID, row uint32, colIdx u
 }
 
 func (store *txnStore) Update(dbId uint64, id *common.ID, row uint32, colIdx uint16, v interface{}) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return err
@@ -207,7 +340,9 @@ func (store *txnStore) GetDatabase(name string) (h handle.Database, err error) {
 }
 
 func (store *txnStore) CreateDatabase(name string) (h handle.Database, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	meta, err := store.catalog.CreateDBEntry(name, store.txn)
 	if err != nil {
 		return nil, err
@@ -223,7 +358,9 @@ func (store *txnStore) CreateDatabase(name string) (h handle.Database, err error
 }
 
 func (store *txnStore) DropDatabase(name string) (h handle.Database, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	meta, err := store.catalog.DropDBEntry(name, store.txn)
 	if err != nil {
 		return
@@ -239,8 +376,27 @@ func (store *txnStore) DropDatabase(name string) (h handle.Database, err error)
 	return
 }
 
+func (store *txnStore) RenameDatabase(name, newName string) (h handle.Database, err error) {
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
+	meta, err := store.catalog.RenameDBEntry(name, newName, store.txn)
+	if err != nil {
+		return
+	}
+	db, err := store.getOrSetDB(meta.GetID())
+	if err != nil {
+		return
+	}
+	db.SetAlterEntry(meta)
+	h = db.database
+	return
+}
+
 func (store *txnStore) CreateRelation(dbId uint64, def interface{}) (relation handle.Relation, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return
@@ -248,8 +404,21 @@ func (store *txnStore) CreateRelation(dbId uint64, def interface{}) (relation ha
 	return db.CreateRelation(def)
 }
 
+func (store *txnStore) CreateRelations(dbId uint64, defs []interface{}) (relations []handle.Relation, err error) {
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return
+	}
+	return db.CreateRelations(defs)
+}
+
 func (store *txnStore) DropRelationByName(dbId uint64, name string) (relation handle.Relation, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return nil, err
@@ -257,6 +426,17 @@ func (store *txnStore) DropRelationByName(dbId uint64, name string) (relation ha
 	return db.DropRelationByName(name)
 }
 
+func (store *txnStore) RenameRelation(dbId uint64, name, newName string) (relation handle.Relation, err error) {
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return nil, err
+	}
+	return db.RenameRelation(name, newName)
+}
+
 func (store *txnStore) GetRelationByName(dbId uint64, name string) (relation handle.Relation, err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -274,7 +454,9 @@ func (store *txnStore) GetSegment(dbId uint64, id *common.ID) (seg handle.Segmen
 }
 
 func (store *txnStore) CreateSegment(dbId, tid uint64) (seg handle.Segment, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -283,7 +465,9 @@ func (store *txnStore) CreateSegment(dbId, tid uint64) (seg handle.Segment, err
 }
 
 func (store *txnStore) CreateNonAppendableSegment(dbId, tid uint64) (seg handle.Segment, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -292,6 +476,12 @@ func (store *txnStore) CreateNonAppendableSegment(dbId, tid uint64) (seg handle.
 }
 
 func (store *txnStore) getOrSetDB(id uint64) (db *txnDB, err error) {
+	store.txn.Touch()
+	// The store is closed once the txn is killed
+	if store.dbs == nil {
+		err = txnbase.ErrTxnNotActive
+		return
+	}
 	db = store.dbs[id]
 	if db == nil {
 		var entry *catalog.DBEntry
@@ -306,7 +496,9 @@ func (store *txnStore) getOrSetDB(id uint64) (db *txnDB, err error) {
 }
 
 func (store *txnStore) CreateNonAppendableBlock(dbId uint64, id *common.ID) (blk handle.Block, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -323,7 +515,9 @@ func (store *txnStore) GetBlock(dbId uint64, id *common.ID) (blk handle.Block, e
 }
 
 func (store *txnStore) CreateBlock(dbId, tid, sid uint64) (blk handle.Block, err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -332,7 +526,9 @@ func (store *txnStore) CreateBlock(dbId, tid, sid uint64) (blk handle.Block, err
 }
 
 func (store *txnStore) SoftDeleteBlock(dbId uint64, id *common.ID) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -341,7 +537,9 @@ func (store *txnStore) SoftDeleteBlock(dbId uint64, id *common.ID) (err error) {
 }
 
 func (store *txnStore) SoftDeleteSegment(dbId uint64, id *common.ID) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.prepareWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -355,10 +553,53 @@ func (store *txnStore) ApplyRollback() (err error) {
 			break
 		}
 	}
+	if store.published {
+		store.publisher.Decide(store.cmdMgr.lsn, false)
+	}
 	return
 }
 
+// SetAsyncCommit makes the commit skip the wait for the sync of the WAL
+// entries
+func (store *txnStore) SetAsyncCommit() {
+	store.async = true
+}
+
+// WaitDurable waits until the WAL entries of the async commit are synced
+func (store *txnStore) WaitDurable() error {
+	if store.durable == nil {
+		return nil
+	}
+	<-store.durable
+	return store.durableErr
+}
+
+func (store *txnStore) waitDurableAsync() {
+	logs := store.logs
+	for _, db := range store.dbs {
+		for _, table := range db.tables {
+			for _, e := range table.TakeLogs() {
+				logs = append(logs, e)
+			}
+		}
+	}
+	store.logs = nil
+	store.durable = make(chan struct{})
+	go func() {
+		defer close(store.durable)
+		for _, e := range logs {
+			if err := e.WaitDone(); err != nil && store.durableErr == nil {
+				store.durableErr = err
+			}
+			e.Free()
+		}
+	}()
+}
+
 func (store *txnStore) ApplyCommit() (err error) {
+	if store.async {
+		store.waitDurableAsync()
+	}
 	for _, e := range store.logs {
 		if err = e.WaitDone(); err != nil {
 			return
@@ -370,6 +611,9 @@ func (store *txnStore) ApplyCommit() (err error) {
 			break
 		}
 	}
+	if err == nil && store.published {
+		store.publisher.Decide(store.cmdMgr.lsn, true)
+	}
 	return
 }
 
@@ -405,12 +649,35 @@ func (store *txnStore) PrepareCommit() (err error) {
 	}
 	if logEntry != nil {
 		store.logs = append(store.logs, logEntry)
+		store.prepareChanges()
 	}
 	logutil.Debugf("Txn-%d PrepareCommit Takes %s", store.txn.GetID(), time.Since(now))
 
 	return
 }
 
+// prepareChanges queues the changes of the subscribed tables in the
+// publisher, which publishes them once the WAL entry of the txn is committed
+// and the txn is committed
+func (store *txnStore) prepareChanges() {
+	if store.publisher == nil {
+		return
+	}
+	var changes []cdc.FeedChanges
+	for _, db := range store.dbs {
+		for _, table := range db.tables {
+			if tableChanges := table.CollectChanges(); len(tableChanges.Changes) != 0 {
+				changes = append(changes, tableChanges)
+			}
+		}
+	}
+	if len(changes) == 0 {
+		return
+	}
+	store.publisher.Prepare(store.cmdMgr.lsn, changes)
+	store.published = true
+}
+
 func (store *txnStore) CollectCmd() (err error) {
 	for _, db := range store.dbs {
 		if err = db.CollectCmd(store.cmdMgr); err != nil {
@@ -40,3 +40,11 @@ func (db *txnSysDB) DropRelationByName(name string) (rel handle.Relation, err er
 	}
 	return db.txnDatabase.DropRelationByName(name)
 }
+
+func (db *txnSysDB) RenameRelation(name, newName string) (rel handle.Relation, err error) {
+	if isSys := sysTableNames[name]; isSys {
+		err = catalog.ErrNotPermitted
+		return
+	}
+	return db.txnDatabase.RenameRelation(name, newName)
+}
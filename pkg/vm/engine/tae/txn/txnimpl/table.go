@@ -19,23 +19,29 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cdc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/metrics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 var (
-	ErrDuplicateNode = errors.New("tae: duplicate node")
+	ErrDuplicateNode    = errors.New("tae: duplicate node")
+	ErrSchemaChanged    = errors.New("tae: schema changed")
+	ErrAlterAfterAppend = errors.New("tae: cannot alter table after append in a txn")
+	ErrSavepointSpilled = errors.New("tae: rows appended after the savepoint are spilled")
 )
 
 type Table interface {
@@ -68,13 +74,23 @@ type Table interface {
 	LogBlockID(bid uint64)
 
 	WaitSynced()
+	TakeLogs() []wal.LogEntry
+	GetChanges() txnif.TableChanges
+	CollectChanges() cdc.FeedChanges
 
 	SetCreateEntry(txnif.TxnEntry)
 	SetDropEntry(txnif.TxnEntry) error
+	SetAlterEntry(txnif.TxnEntry)
+	AddColumn(def *catalog.ColDef) error
+	DropColumn(name string) error
+	AddIndex(name, col string) error
+	DropIndex(name string) error
+	Analyze() error
 	GetMeta() *catalog.TableEntry
 
 	GetValue(id *common.ID, row uint32, col uint16) (interface{}, error)
 	GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error)
+	GetByIndex(attr string, key interface{}) (ids []*common.ID, offsets []uint32, err error)
 	GetSegment(id uint64) (handle.Segment, error)
 	CreateSegment() (handle.Segment, error)
 	CreateNonAppendableSegment() (handle.Segment, error)
@@ -86,12 +102,17 @@ type Table interface {
 	CollectCmd(*commandManager) error
 
 	LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error)
+
+	Savepoint() *tableSavepoint
+	RollbackTo(*tableSavepoint) error
+	SetStartTS(ts uint64)
 }
 
 type txnTable struct {
 	store       *txnStore
 	createEntry txnif.TxnEntry
 	dropEntry   txnif.TxnEntry
+	alterEntry  txnif.TxnEntry
 	inodes      []InsertNode
 	appendable  base.INodeHandle
 	updateNodes map[common.ID]txnif.UpdateNode
@@ -102,12 +123,22 @@ type txnTable struct {
 	handle      handle.Relation
 	index       TableIndex
 	rows        uint32
-	logs        []wal.LogEntry
-	maxSegId    uint64
-	maxBlkId    uint64
+	// partitions are the partitions of the local rows of a partitioned table
+	partitions []uint32
+	logs       []wal.LogEntry
+	maxSegId   uint64
+	maxBlkId   uint64
+	// spillIndex is the index of the keys of the rows spilled to the
+	// provisional blocks created by the txn
+	spillIndex   TableIndex
+	spillSeg     *catalog.SegmentEntry
+	spillSegBlks int
+	spilledBlks  []*catalog.BlockEntry
+	spilledRows  uint32
 
 	txnEntries []txnif.TxnEntry
 	csnStart   uint32
+	changes    *changeCollector
 }
 
 func newTxnTable(store *txnStore, handle handle.Relation) *txnTable {
@@ -117,6 +148,7 @@ func newTxnTable(store *txnStore, handle handle.Relation) *txnTable {
 		handle:      handle,
 		entry:       handle.GetMeta().(*catalog.TableEntry),
 		index:       NewSimpleTableIndex(),
+		spillIndex:  NewSimpleTableIndex(),
 		updateNodes: make(map[common.ID]txnif.UpdateNode),
 		deleteNodes: make(map[common.ID]txnif.DeleteNode),
 		appends:     make([]*appendCtx, 0),
@@ -127,12 +159,18 @@ func newTxnTable(store *txnStore, handle handle.Relation) *txnTable {
 }
 
 func (tbl *txnTable) LogSegmentID(sid uint64) {
+	if tbl.isSpilledSegment(sid) {
+		return
+	}
 	if tbl.maxSegId < sid {
 		tbl.maxSegId = sid
 	}
 }
 
 func (tbl *txnTable) LogBlockID(bid uint64) {
+	if tbl.isSpilledBlock(bid) {
+		return
+	}
 	if tbl.maxBlkId < bid {
 		tbl.maxBlkId = bid
 	}
@@ -147,8 +185,18 @@ func (tbl *txnTable) WaitSynced() {
 	}
 }
 
+// TakeLogs returns the WAL entries of the table left to be synced
+func (tbl *txnTable) TakeLogs() []wal.LogEntry {
+	logs := tbl.logs
+	tbl.logs = nil
+	return logs
+}
+
 func (tbl *txnTable) CollectCmd(cmdMgr *commandManager) error {
 	for i, node := range tbl.inodes {
+		if node.IsSpilled() {
+			continue
+		}
 		h := tbl.store.nodesMgr.Pin(node)
 		if h == nil {
 			panic("not expected")
@@ -224,12 +272,16 @@ func (tbl *txnTable) CreateNonAppendableSegment() (seg handle.Segment, err error
 }
 
 func (tbl *txnTable) CreateSegment() (seg handle.Segment, err error) {
+	return tbl.createPartitionSegment(0)
+}
+
+func (tbl *txnTable) createPartitionSegment(partition uint32) (seg handle.Segment, err error) {
 	var meta *catalog.SegmentEntry
 	var factory catalog.SegmentDataFactory
 	if tbl.store.dataFactory != nil {
 		factory = tbl.store.dataFactory.MakeSegmentFactory()
 	}
-	if meta, err = tbl.entry.CreateSegment(tbl.store.txn, catalog.ES_Appendable, factory); err != nil {
+	if meta, err = tbl.entry.CreatePartitionSegment(tbl.store.txn, catalog.ES_Appendable, partition, factory); err != nil {
 		return
 	}
 	seg = newSegment(tbl.store.txn, meta)
@@ -321,17 +373,72 @@ func (tbl *txnTable) SetDropEntry(e txnif.TxnEntry) error {
 		return txnbase.ErrDDLDropCreated
 	}
 	tbl.dropEntry = e
-	tbl.txnEntries = append(tbl.txnEntries, e)
+	// The altered entry is the same one and was already registered
+	if tbl.alterEntry == nil {
+		tbl.txnEntries = append(tbl.txnEntries, e)
+	}
 	tbl.store.warChecker.ReadDB(tbl.entry.GetDB().GetID())
 	return nil
 }
 
+// AddColumn adds a column to the table schema in the txn. The rows already
+// appended in the txn were staged with the old schema, so it is not allowed
+// to alter the table after any append
+func (tbl *txnTable) AddColumn(def *catalog.ColDef) (err error) {
+	return tbl.alterSchema(func() error {
+		return tbl.entry.AddColumn(tbl.store.txn, def)
+	})
+}
+
+// DropColumn drops a column from the table schema in the txn
+func (tbl *txnTable) DropColumn(name string) (err error) {
+	return tbl.alterSchema(func() error {
+		return tbl.entry.DropColumn(tbl.store.txn, name)
+	})
+}
+
+// AddIndex adds the secondary index name on the column col in the txn
+func (tbl *txnTable) AddIndex(name, col string) (err error) {
+	return tbl.alterSchema(func() error {
+		return tbl.entry.AddIndex(tbl.store.txn, name, col)
+	})
+}
+
+// DropIndex drops the secondary index name in the txn
+func (tbl *txnTable) DropIndex(name string) (err error) {
+	return tbl.alterSchema(func() error {
+		return tbl.entry.DropIndex(tbl.store.txn, name)
+	})
+}
+
+func (tbl *txnTable) alterSchema(alter func() error) (err error) {
+	if len(tbl.inodes) > 0 {
+		return ErrAlterAfterAppend
+	}
+	if err = alter(); err != nil {
+		return
+	}
+	tbl.SetAlterEntry(tbl.entry)
+	return
+}
+
+// SetAlterEntry registers the table entry altered in the txn
+func (tbl *txnTable) SetAlterEntry(e txnif.TxnEntry) {
+	// A table created in the same txn is altered in place
+	if tbl.createEntry != nil || tbl.alterEntry != nil {
+		return
+	}
+	tbl.alterEntry = e
+	tbl.txnEntries = append(tbl.txnEntries, e)
+	tbl.store.warChecker.ReadDB(tbl.entry.GetDB().GetID())
+}
+
 func (tbl *txnTable) IsDeleted() bool {
 	return tbl.dropEntry != nil
 }
 
 func (tbl *txnTable) GetSchema() *catalog.Schema {
-	return tbl.entry.GetSchema()
+	return tbl.entry.TxnGetSchema(tbl.store.txn)
 }
 
 func (tbl *txnTable) GetMeta() *catalog.TableEntry {
@@ -356,8 +463,13 @@ func (tbl *txnTable) Close() error {
 	}
 	tbl.index.Close()
 	tbl.index = nil
+	tbl.spillIndex.Close()
+	tbl.spillIndex = nil
+	tbl.spillSeg = nil
+	tbl.spilledBlks = nil
 	tbl.appendable = nil
 	tbl.inodes = nil
+	tbl.partitions = nil
 	tbl.updateNodes = nil
 	tbl.deleteNodes = nil
 	tbl.tableHandle = nil
@@ -365,6 +477,23 @@ func (tbl *txnTable) Close() error {
 	return nil
 }
 
+// SetStartTS moves the uncommitted delete and update nodes to the new start
+// ts of the txn
+func (tbl *txnTable) SetStartTS(ts uint64) {
+	for _, node := range tbl.deleteNodes {
+		chain := node.GetChain()
+		chain.Lock()
+		node.(*updates.DeleteNode).SetStartTSLocked(ts)
+		chain.Unlock()
+	}
+	for _, node := range tbl.updateNodes {
+		chain := node.GetChain()
+		chain.Lock()
+		node.(*updates.ColumnNode).SetStartTSLocked(ts)
+		chain.Unlock()
+	}
+}
+
 func (tbl *txnTable) registerInsertNode() error {
 	if tbl.appendable != nil {
 		tbl.appendable.Close()
@@ -403,10 +532,36 @@ func (tbl *txnTable) AddUpdateNode(node txnif.UpdateNode) error {
 }
 
 func (tbl *txnTable) Append(data *batch.Batch) error {
-	err := tbl.BatchDedup(data.Vecs[tbl.entry.GetSchema().PrimaryKey])
+	schema := tbl.GetSchema()
+	data = schema.FillCompositeKey(data)
+	err := tbl.BatchDedup(data.Vecs[schema.PrimaryKey])
 	if err != nil {
 		return err
 	}
+	if collector := tbl.getChangeCollector(); collector != nil {
+		collector.OnAppend()
+	}
+	if !schema.IsPartitioned() {
+		return tbl.appendLocal(data)
+	}
+	// Group the rows by partition to append each partition in runs
+	bats := compute.PartitionBatch(data, schema.LocatePartitions(data), schema.Partition.Count())
+	for partition, bat := range bats {
+		if bat == nil {
+			continue
+		}
+		start := tbl.rows
+		if err = tbl.appendLocal(bat); err != nil {
+			return err
+		}
+		for i := start; i < tbl.rows; i++ {
+			tbl.partitions = append(tbl.partitions, uint32(partition))
+		}
+	}
+	return nil
+}
+
+func (tbl *txnTable) appendLocal(data *batch.Batch) (err error) {
 	if tbl.appendable == nil {
 		if err = tbl.registerInsertNode(); err != nil {
 			return err
@@ -441,6 +596,11 @@ func (tbl *txnTable) Append(data *batch.Batch) error {
 			if err = tbl.registerInsertNode(); err != nil {
 				break
 			}
+			if tbl.needSpill() {
+				if err = tbl.spillLocal(); err != nil {
+					break
+				}
+			}
 		}
 		if offset >= length {
 			break
@@ -461,7 +621,7 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 		err = node.RangeDelete(firstOffset, lastOffset)
 		if err == nil {
 			for i := firstOffset; i <= lastOffset; i++ {
-				v, _ := node.GetValue(int(tbl.entry.GetSchema().PrimaryKey), i)
+				v, _ := node.GetValue(int(tbl.GetSchema().PrimaryKey), i)
 				if err = tbl.index.Delete(v); err != nil {
 					break
 				}
@@ -473,7 +633,7 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 		node = tbl.inodes[last]
 		err = node.RangeDelete(0, lastOffset)
 		for i := uint32(0); i <= lastOffset; i++ {
-			v, _ := node.GetValue(int(tbl.entry.GetSchema().PrimaryKey), i)
+			v, _ := node.GetValue(int(tbl.GetSchema().PrimaryKey), i)
 			if err = tbl.index.Delete(v); err != nil {
 				break
 			}
@@ -485,7 +645,7 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 					break
 				}
 				for i := uint32(0); i <= txnbase.MaxNodeRows; i++ {
-					v, _ := node.GetValue(int(tbl.entry.GetSchema().PrimaryKey), i)
+					v, _ := node.GetValue(int(tbl.GetSchema().PrimaryKey), i)
 					if err = tbl.index.Delete(v); err != nil {
 						break
 					}
@@ -516,10 +676,72 @@ func (tbl *txnTable) GetLocalPhysicalAxis(row uint32) (int, uint32) {
 	return npos, noffset
 }
 
+// getChangeCollector returns nil if the changes of the table are not
+// subscribed
+func (tbl *txnTable) getChangeCollector() *changeCollector {
+	if tbl.changes == nil {
+		tableData := tbl.entry.GetTableData()
+		if tbl.store.publisher == nil || tableData == nil || !tableData.GetChangeFeed().HasSubscriber() {
+			return nil
+		}
+		tbl.changes = newChangeCollector(tbl.GetSchema())
+	}
+	return tbl.changes
+}
+
+// getCommittedKeys reads the primary keys of the committed rows [start, end]
+func (tbl *txnTable) getCommittedKeys(segmentId, blockId uint64, start, end uint32) (keys []interface{}, err error) {
+	seg, err := tbl.entry.GetSegmentByID(segmentId)
+	if err != nil {
+		return
+	}
+	blk, err := seg.GetBlockEntryByID(blockId)
+	if err != nil {
+		return
+	}
+	blkData := blk.GetBlockData()
+	for row := start; row <= end; row++ {
+		var key interface{}
+		if key, err = blkData.GetValue(tbl.store.txn, row, uint16(tbl.GetSchema().PrimaryKey)); err != nil {
+			return
+		}
+		keys = append(keys, key)
+	}
+	return
+}
+
+// lockRows locks the committed rows [start, end] by their primary keys if
+// the txn is pessimistic
+func (tbl *txnTable) lockRows(segmentId, blockId uint64, start, end uint32) (err error) {
+	if !tbl.store.txn.IsPessimistic() {
+		return
+	}
+	keys, err := tbl.getCommittedKeys(segmentId, blockId, start, end)
+	if err != nil {
+		return
+	}
+	return tbl.store.txn.LockRows(tbl.GetID(), keys...)
+}
+
 func (tbl *txnTable) RangeDelete(inode uint32, segmentId, blockId uint64, start, end uint32) (err error) {
 	if inode != 0 {
 		return tbl.RangeDeleteLocalRows(start, end)
 	}
+	if err = tbl.lockRows(segmentId, blockId, start, end); err != nil {
+		return
+	}
+	// The deleted rows cannot be read after the delete
+	if collector := tbl.getChangeCollector(); collector != nil {
+		var keys []interface{}
+		if keys, err = tbl.getCommittedKeys(segmentId, blockId, start, end); err != nil {
+			return
+		}
+		defer func() {
+			if err == nil {
+				collector.AddDeletes(keys)
+			}
+		}()
+	}
 	id := tbl.entry.AsCommonID()
 	id.SegmentID = segmentId
 	id.BlockID = blockId
@@ -563,7 +785,16 @@ func (tbl *txnTable) RangeDelete(inode uint32, segmentId, blockId uint64, start,
 }
 
 func (tbl *txnTable) GetByFilter(filter *handle.Filter) (id *common.ID, offset uint32, err error) {
-	offset, err = tbl.index.Find(filter.Val)
+	// The values of a composite key are filtered by the encoded key
+	if vals, ok := filter.Val.([]interface{}); ok && tbl.GetSchema().IsCompositeKey() {
+		filter = handle.NewEQFilter(tbl.GetSchema().EncodeCompositeKey(vals...))
+	}
+	// The local index is keyed by string for the char and varchar keys
+	key := filter.Val
+	if bs, ok := key.([]byte); ok {
+		key = string(bs)
+	}
+	offset, err = tbl.index.Find(key)
 	if err == nil {
 		id = &common.ID{}
 		id.PartID = 1
@@ -585,6 +816,56 @@ func (tbl *txnTable) GetByFilter(filter *handle.Filter) (id *common.ID, offset u
 	return
 }
 
+// GetByIndex returns the rows whose indexed column attr equals key,
+// the uncommitted rows of this txn included
+func (tbl *txnTable) GetByIndex(attr string, key interface{}) (ids []*common.ID, offsets []uint32, err error) {
+	schema := tbl.GetSchema()
+	colIdx := schema.GetColIdx(attr)
+	if colIdx == -1 {
+		err = catalog.ErrNotFound
+		return
+	}
+	def := schema.ColDefs[colIdx]
+	if !def.HasIndex() {
+		err = catalog.ErrNoIndex
+		return
+	}
+	if s, ok := key.(string); ok {
+		key = []byte(s)
+	}
+	for row := uint32(0); row < tbl.Rows(); row++ {
+		if tbl.IsLocalDeleted(row) {
+			continue
+		}
+		var v interface{}
+		if v, err = tbl.GetLocalValue(row, uint16(colIdx)); err != nil {
+			return
+		}
+		if s, ok := v.(string); ok {
+			v = []byte(s)
+		}
+		if common.CompareGeneric(v, key, def.Type) == 0 {
+			ids = append(ids, &common.ID{PartID: 1, TableID: tbl.entry.ID})
+			offsets = append(offsets, row)
+		}
+	}
+	blockIt := tbl.handle.MakeBlockIt()
+	for blockIt.Valid() {
+		h := blockIt.GetBlock()
+		block := h.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		var rows []uint32
+		if rows, err = block.GetRowsByIndex(tbl.store.txn, colIdx, key); err != nil {
+			return
+		}
+		for _, row := range rows {
+			ids = append(ids, h.Fingerprint())
+			offsets = append(offsets, row)
+		}
+		blockIt.Next()
+	}
+	return
+}
+
 func (tbl *txnTable) GetValue(id *common.ID, row uint32, col uint16) (v interface{}, err error) {
 	if id.PartID != 0 {
 		return tbl.GetLocalValue(row, col)
@@ -618,29 +899,46 @@ func (tbl *txnTable) Update(inode uint32, segmentId, blockId uint64, row uint32,
 	if inode != 0 {
 		return tbl.UpdateLocalValue(row, col, v)
 	}
+	if err = tbl.lockRows(segmentId, blockId, row, row); err != nil {
+		return
+	}
+	if collector := tbl.getChangeCollector(); collector != nil {
+		var keys []interface{}
+		if keys, err = tbl.getCommittedKeys(segmentId, blockId, row, row); err != nil {
+			return
+		}
+		defer func() {
+			if err == nil {
+				collector.AddUpdate(col, keys[0], v)
+			}
+		}()
+	}
+	seg, err := tbl.entry.GetSegmentByID(segmentId)
+	if err != nil {
+		return
+	}
+	blk, err := seg.GetBlockEntryByID(blockId)
+	if err != nil {
+		return
+	}
+	// The update nodes are indexed by the column index in the block schema
+	blkCol := blk.GetSchema().GetColIdxBySeqNum(tbl.GetSchema().ColDefs[col].SeqNum)
+	if blkCol == -1 {
+		return data.ErrColumnNotMaterialized
+	}
 	node := tbl.updateNodes[common.ID{
 		TableID:   tbl.GetID(),
 		SegmentID: segmentId,
 		BlockID:   blockId,
-		Idx:       col,
+		Idx:       uint16(blkCol),
 	}]
 	if node != nil {
 		err = tbl.updateWithFineLock(node, tbl.store.txn, row, v)
 		if err != nil {
-			seg, _ := tbl.entry.GetSegmentByID(segmentId)
-			blk, _ := seg.GetBlockEntryByID(blockId)
 			tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, blk.AsCommonID())
 		}
 		return
 	}
-	seg, err := tbl.entry.GetSegmentByID(segmentId)
-	if err != nil {
-		return
-	}
-	blk, err := seg.GetBlockEntryByID(blockId)
-	if err != nil {
-		return
-	}
 	blkData := blk.GetBlockData()
 	node2, err := blkData.Update(tbl.store.txn, row, col, v)
 	if err == nil {
@@ -667,7 +965,7 @@ func (tbl *txnTable) UpdateLocalValue(row uint32, col uint16, value interface{})
 	if err = n.RangeDelete(uint32(noffset), uint32(noffset)); err != nil {
 		return err
 	}
-	v, _ := n.GetValue(int(tbl.entry.GetSchema().PrimaryKey), row)
+	v, _ := n.GetValue(int(tbl.GetSchema().PrimaryKey), row)
 	if err = tbl.index.Delete(v); err != nil {
 		panic(err)
 	}
@@ -683,12 +981,56 @@ func (tbl *txnTable) Rows() uint32 {
 	return (uint32(cnt)-1)*txnbase.MaxNodeRows + tbl.inodes[cnt-1].Rows()
 }
 
+// GetChanges returns the rows appended, deleted and updated in the table.
+// A row updated in several columns is counted once
+func (tbl *txnTable) GetChanges() (changes txnif.TableChanges) {
+	changes.TableId = tbl.GetID()
+	for _, node := range tbl.inodes {
+		changes.Appended += node.RowsWithoutDeletes()
+	}
+	changes.Appended += tbl.spilledRows
+	for _, node := range tbl.deleteNodes {
+		chain := node.GetChain()
+		chain.RLock()
+		changes.Deleted += node.GetCardinalityLocked()
+		chain.RUnlock()
+	}
+	updated := make(map[common.ID]*roaring.Bitmap)
+	for id, node := range tbl.updateNodes {
+		blk := id
+		blk.Idx = 0
+		if updated[blk] == nil {
+			updated[blk] = roaring.New()
+		}
+		chain := node.GetChain()
+		chain.RLock()
+		updated[blk].Or(node.(*updates.ColumnNode).GetMask())
+		chain.RUnlock()
+	}
+	for _, mask := range updated {
+		changes.Updated += uint32(mask.GetCardinality())
+	}
+	return
+}
+
 func (tbl *txnTable) PreCommitDededup() (err error) {
-	if tbl.index == nil || tbl.index.Count() == 0 {
+	if tbl.index == nil {
 		return
 	}
-	schema := tbl.entry.GetSchema()
-	pks := tbl.index.KeyToVector(schema.ColDefs[schema.PrimaryKey].Type)
+	for _, index := range []TableIndex{tbl.index, tbl.spillIndex} {
+		if index.Count() == 0 {
+			continue
+		}
+		schema := tbl.GetSchema()
+		if err = tbl.dedupCommitted(index.KeyToVector(schema.ColDefs[schema.PrimaryKey].Type)); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// dedupCommitted checks pks against the rows committed after the txn started
+func (tbl *txnTable) dedupCommitted(pks *vector.Vector) (err error) {
 	segIt := tbl.entry.MakeSegmentIt(false)
 	for segIt.Valid() {
 		seg := segIt.Get().GetPayload().(*catalog.SegmentEntry)
@@ -784,7 +1126,10 @@ func (tbl *txnTable) BatchDedupLocalByCol(col *vector.Vector) error {
 	if err != nil {
 		return err
 	}
-	return tbl.index.BatchDedup(col)
+	if err = tbl.index.BatchDedup(col); err != nil {
+		return err
+	}
+	return tbl.spillIndex.BatchDedup(col)
 }
 
 func (tbl *txnTable) GetLocalValue(row uint32, col uint16) (interface{}, error) {
@@ -823,37 +1168,55 @@ func (tbl *txnTable) ApplyAppend() {
 			panic(err)
 		}
 		tbl.txnEntries = append(tbl.txnEntries, appendNode)
+		metrics.AppendRows.Add(float64(ctx.count))
+		if collector := tbl.getChangeCollector(); collector != nil {
+			collector.AddInserts(bat)
+		}
 	}
 	if tbl.tableHandle != nil {
 		tbl.entry.GetTableData().ApplyHandle(tbl.tableHandle)
 	}
 }
 
-func (tbl *txnTable) prepareAppend(node InsertNode) (err error) {
+// getAppender returns the appender of the last appendable block of
+// partition. A new block or segment is created if there is none
+func (tbl *txnTable) getAppender(partition uint32) (appender data.BlockAppender, err error) {
 	tableData := tbl.entry.GetTableData()
 	if tbl.tableHandle == nil {
 		tbl.tableHandle = tableData.GetHandle()
 	}
+	appender, err = tbl.tableHandle.GetPartitionAppender(partition)
+	if err == nil && appender.GetMeta().(*catalog.BlockEntry).GetSchema() != tbl.GetSchema() {
+		// The appendable block was created with another schema version
+		err = data.ErrAppendableSegmentNotFound
+	}
+	if err == data.ErrAppendableSegmentNotFound {
+		var seg handle.Segment
+		if seg, err = tbl.createPartitionSegment(partition); err != nil {
+			return
+		}
+		var blk handle.Block
+		if blk, err = seg.CreateBlock(); err != nil {
+			return
+		}
+		appender = tbl.tableHandle.SetAppender(blk.Fingerprint())
+	} else if err == data.ErrAppendableBlockNotFound {
+		id := appender.GetID()
+		var blk handle.Block
+		if blk, err = tbl.CreateBlock(id.SegmentID); err != nil {
+			return
+		}
+		appender = tbl.tableHandle.SetAppender(blk.Fingerprint())
+	}
+	return
+}
+
+func (tbl *txnTable) prepareAppend(node InsertNode) (err error) {
 	appended := uint32(0)
 	for appended < node.RowsWithoutDeletes() {
-		appender, err := tbl.tableHandle.GetAppender()
-		if err == data.ErrAppendableSegmentNotFound {
-			seg, err := tbl.CreateSegment()
-			if err != nil {
-				return err
-			}
-			blk, err := seg.CreateBlock()
-			if err != nil {
-				return err
-			}
-			appender = tbl.tableHandle.SetAppender(blk.Fingerprint())
-		} else if err == data.ErrAppendableBlockNotFound {
-			id := appender.GetID()
-			blk, err := tbl.CreateBlock(id.SegmentID)
-			if err != nil {
-				return err
-			}
-			appender = tbl.tableHandle.SetAppender(blk.Fingerprint())
+		appender, err := tbl.getAppender(0)
+		if err != nil {
+			return err
 		}
 		toAppend, err := appender.PrepareAppend(node.RowsWithoutDeletes() - appended)
 		toAppendWithDeletes := node.LengthWithDeletes(appended, toAppend)
@@ -875,9 +1238,68 @@ func (tbl *txnTable) prepareAppend(node InsertNode) (err error) {
 	return
 }
 
+// preparePartitionAppend appends the runs of the rows of node in the same
+// partition to the blocks of the partition
+func (tbl *txnTable) preparePartitionAppend(pos int, node InsertNode) (err error) {
+	base := uint32(pos) * txnbase.MaxNodeRows
+	rows := node.Rows()
+	for start := uint32(0); start < rows; {
+		partition := tbl.partitions[base+start]
+		end := start + 1
+		for end < rows && tbl.partitions[base+end] == partition {
+			end++
+		}
+		live := uint32(0)
+		for row := start; row < end; row++ {
+			if !node.IsRowDeleted(row) {
+				live++
+			}
+		}
+		for live > 0 {
+			var appender data.BlockAppender
+			if appender, err = tbl.getAppender(partition); err != nil {
+				return
+			}
+			var toAppend uint32
+			if toAppend, err = appender.PrepareAppend(live); err != nil {
+				return
+			}
+			// The window of toAppend live rows with the deleted ones
+			count := uint32(0)
+			for appended := uint32(0); appended < toAppend; count++ {
+				if !node.IsRowDeleted(start + count) {
+					appended++
+				}
+			}
+			ctx := &appendCtx{
+				driver: appender,
+				node:   node,
+				start:  start,
+				count:  count,
+			}
+			id := appender.GetID()
+			tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, id)
+			tbl.appends = append(tbl.appends, ctx)
+			start += count
+			live -= toAppend
+		}
+		start = end
+	}
+	return
+}
+
 func (tbl *txnTable) PreCommit() (err error) {
-	for _, node := range tbl.inodes {
-		if err = tbl.prepareAppend(node); err != nil {
+	// Rows staged with a stale schema version cannot be applied
+	if len(tbl.inodes) > 0 && tbl.GetSchema().Version != tbl.entry.LatestSchema(tbl.store.txn).Version {
+		return ErrSchemaChanged
+	}
+	for pos, node := range tbl.inodes {
+		if tbl.GetSchema().IsPartitioned() {
+			err = tbl.preparePartitionAppend(pos, node)
+		} else {
+			err = tbl.prepareAppend(node)
+		}
+		if err != nil {
 			break
 		}
 	}
@@ -901,6 +1323,21 @@ func (tbl *txnTable) ApplyCommit() (err error) {
 		}
 		csn++
 	}
+	if err == nil {
+		tbl.applySpill()
+	}
+	return
+}
+
+// CollectChanges returns the changes of the table to be published to its
+// change feed
+func (tbl *txnTable) CollectChanges() (changes cdc.FeedChanges) {
+	if tbl.changes == nil {
+		return
+	}
+	txn := tbl.store.txn
+	changes.Feed = tbl.entry.GetTableData().GetChangeFeed()
+	changes.Changes = tbl.changes.Changes(txn.GetID(), txn.GetCommitTS())
 	return
 }
 
@@ -917,6 +1354,10 @@ func (tbl *txnTable) buildCommitCmd(cmdSeq *uint32) (cmd txnif.TxnCmd, entries [
 	composedCmd := txnbase.NewComposedCmd()
 
 	for i, inode := range tbl.inodes {
+		if inode.IsSpilled() {
+			inode.Close()
+			continue
+		}
 		h := tbl.store.nodesMgr.Pin(inode)
 		if h == nil {
 			panic("not expected")
@@ -49,6 +49,10 @@ func (txn *txnImpl) DropDatabase(name string) (db handle.Database, err error) {
 	return txn.Store.DropDatabase(name)
 }
 
+func (txn *txnImpl) RenameDatabase(name, newName string) (db handle.Database, err error) {
+	return txn.Store.RenameDatabase(name, newName)
+}
+
 func (txn *txnImpl) GetDatabase(name string) (db handle.Database, err error) {
 	return txn.Store.GetDatabase(name)
 }
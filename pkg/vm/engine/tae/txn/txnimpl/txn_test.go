@@ -78,7 +78,7 @@ func makeTable(t *testing.T, dir string, colCnt int, bufSize uint64) *txnTable {
 	schema := catalog.MockSchemaAll(colCnt)
 	rel := mockTestRelation(id, schema)
 	txn := txnbase.NewTxn(nil, nil, common.NextGlobalSeqNum(), common.NextGlobalSeqNum(), nil)
-	store := newStore(nil, driver, mgr, nil)
+	store := newStore(nil, driver, mgr, nil, 0, nil)
 	store.BindTxn(txn)
 	return newTxnTable(store, rel)
 }
@@ -544,7 +544,7 @@ func TestApplyToColumn4(t *testing.T) {
 }
 
 func TestTxnManager1(t *testing.T) {
-	mgr := txnbase.NewTxnManager(TxnStoreFactory(nil, nil, nil, nil), TxnFactory(nil))
+	mgr := txnbase.NewTxnManager(TxnStoreFactory(nil, nil, nil, nil, 0, nil), TxnFactory(nil))
 	mgr.Start()
 	txn := mgr.StartTxn(nil)
 	txn.MockIncWriteCnt()
@@ -604,7 +604,7 @@ func initTestContext(t *testing.T, dir string) (*catalog.Catalog, *txnbase.TxnMa
 	mutBufMgr := buffer.NewNodeManager(common.G, nil)
 	factory := tables.NewDataFactory(mockio.SegmentFileMockFactory, mutBufMgr, nil, dir)
 	// factory := tables.NewDataFactory(dataio.SegmentFileMockFactory, mutBufMgr)
-	mgr := txnbase.NewTxnManager(TxnStoreFactory(c, driver, txnBufMgr, factory), TxnFactory(c))
+	mgr := txnbase.NewTxnManager(TxnStoreFactory(c, driver, txnBufMgr, factory, 0, nil), TxnFactory(c))
 	mgr.Start()
 	return c, mgr, driver
 }
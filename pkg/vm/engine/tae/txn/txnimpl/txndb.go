@@ -18,6 +18,7 @@ type txnDB struct {
 	tables      map[uint64]Table
 	database    handle.Database
 	createEntry txnif.TxnEntry
+	alterEntry  txnif.TxnEntry
 	dropEntry   txnif.TxnEntry
 	ddlCSN      uint32
 }
@@ -46,10 +47,21 @@ func (db *txnDB) SetDropEntry(e txnif.TxnEntry) error {
 	if db.createEntry != nil {
 		return txnbase.ErrDDLDropCreated
 	}
+	// The renamed entry is the same one and is handled as dropped
+	db.alterEntry = nil
 	db.dropEntry = e
 	return nil
 }
 
+// SetAlterEntry registers the database entry renamed in the txn
+func (db *txnDB) SetAlterEntry(e txnif.TxnEntry) {
+	// A database created in the same txn is renamed in place
+	if db.createEntry != nil || db.alterEntry != nil {
+		return
+	}
+	db.alterEntry = e
+}
+
 func (db *txnDB) LogTxnEntry(tableId uint64, entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	table, err := db.getOrSetTable(tableId)
 	if err != nil {
@@ -68,6 +80,12 @@ func (db *txnDB) LogBlockID(tid, bid uint64) {
 	table.LogBlockID(bid)
 }
 
+func (db *txnDB) SetStartTS(ts uint64) {
+	for _, table := range db.tables {
+		table.SetStartTS(ts)
+	}
+}
+
 func (db *txnDB) Close() error {
 	var err error
 	for _, table := range db.tables {
@@ -105,6 +123,61 @@ func (db *txnDB) Append(id uint64, data *batch.Batch) error {
 	return table.Append(data)
 }
 
+func (db *txnDB) AddColumn(id uint64, def *catalog.ColDef) error {
+	table, err := db.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.AddColumn(def)
+}
+
+func (db *txnDB) AddIndex(id uint64, name, col string) error {
+	table, err := db.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.AddIndex(name, col)
+}
+
+func (db *txnDB) DropIndex(id uint64, name string) error {
+	table, err := db.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.DropIndex(name)
+}
+
+func (db *txnDB) DropColumn(id uint64, name string) error {
+	table, err := db.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.DropColumn(name)
+}
+
+func (db *txnDB) Analyze(id uint64) error {
+	table, err := db.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.Analyze()
+}
+
 func (db *txnDB) RangeDelete(id *common.ID, start, end uint32) (err error) {
 	table, err := db.getOrSetTable(id.TableID)
 	if err != nil {
@@ -128,6 +201,18 @@ func (db *txnDB) GetByFilter(tid uint64, filter *handle.Filter) (id *common.ID,
 	return table.GetByFilter(filter)
 }
 
+func (db *txnDB) GetByIndex(tid uint64, attr string, val interface{}) (ids []*common.ID, offsets []uint32, err error) {
+	table, err := db.getOrSetTable(tid)
+	if err != nil {
+		return
+	}
+	if table.IsDeleted() {
+		err = txnbase.ErrNotFound
+		return
+	}
+	return table.GetByIndex(attr, val)
+}
+
 func (db *txnDB) GetValue(id *common.ID, row uint32, colIdx uint16) (v interface{}, err error) {
 	table, err := db.getOrSetTable(id.TableID)
 	if err != nil {
@@ -152,7 +237,9 @@ func (db *txnDB) Update(id *common.ID, row uint32, colIdx uint16, v interface{})
 }
 
 func (db *txnDB) CreateRelation(def interface{}) (relation handle.Relation, err error) {
-	db.store.IncreateWriteCnt()
+	if err = db.store.prepareWrite(); err != nil {
+		return
+	}
 	schema := def.(*catalog.Schema)
 	dbMeta := db.database.GetMeta().(*catalog.DBEntry)
 	var factory catalog.TableDataFactory
@@ -172,8 +259,56 @@ func (db *txnDB) CreateRelation(def interface{}) (relation handle.Relation, err
 	return
 }
 
+// CreateRelations creates all the relations of defs with a single catalog
+// operation. Either all of them are created or none of them is
+func (db *txnDB) CreateRelations(defs []interface{}) (relations []handle.Relation, err error) {
+	if err = db.store.prepareWrite(); err != nil {
+		return
+	}
+	schemas := make([]*catalog.Schema, len(defs))
+	for i, def := range defs {
+		schemas[i] = def.(*catalog.Schema)
+	}
+	dbMeta := db.database.GetMeta().(*catalog.DBEntry)
+	var factory catalog.TableDataFactory
+	if db.store.dataFactory != nil {
+		factory = db.store.dataFactory.MakeTableFactory()
+	}
+	metas, err := dbMeta.CreateTableEntries(schemas, db.store.txn, factory)
+	if err != nil {
+		return
+	}
+	relations = make([]handle.Relation, 0, len(metas))
+	for i, meta := range metas {
+		var table Table
+		if table, err = db.getOrSetTable(meta.GetID()); err != nil {
+			db.rollbackCreateRelations(metas, i)
+			return nil, err
+		}
+		relations = append(relations, newRelation(db.store.txn, meta))
+		table.SetCreateEntry(meta)
+	}
+	return
+}
+
+// rollbackCreateRelations removes the entries of metas from the catalog, and
+// the first set tables of them from the txn
+func (db *txnDB) rollbackCreateRelations(metas []*catalog.TableEntry, set int) {
+	dbMeta := db.database.GetMeta().(*catalog.DBEntry)
+	for i, meta := range metas {
+		if i < set {
+			delete(db.tables, meta.GetID())
+		}
+		if err := dbMeta.RemoveEntry(meta); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func (db *txnDB) DropRelationByName(name string) (relation handle.Relation, err error) {
-	db.store.IncreateWriteCnt()
+	if err = db.store.prepareWrite(); err != nil {
+		return
+	}
 	dbMeta := db.database.GetMeta().(*catalog.DBEntry)
 	meta, err := dbMeta.DropTableEntry(name, db.store.txn)
 	if err != nil {
@@ -188,6 +323,24 @@ func (db *txnDB) DropRelationByName(name string) (relation handle.Relation, err
 	return
 }
 
+func (db *txnDB) RenameRelation(name, newName string) (relation handle.Relation, err error) {
+	if err = db.store.prepareWrite(); err != nil {
+		return
+	}
+	dbMeta := db.database.GetMeta().(*catalog.DBEntry)
+	meta, err := dbMeta.RenameTableEntry(name, newName, db.store.txn)
+	if err != nil {
+		return nil, err
+	}
+	table, err := db.getOrSetTable(meta.GetID())
+	if err != nil {
+		return nil, err
+	}
+	relation = newRelation(db.store.txn, meta)
+	table.SetAlterEntry(meta)
+	return
+}
+
 func (db *txnDB) GetRelationByName(name string) (relation handle.Relation, err error) {
 	dbMeta := db.database.GetMeta().(*catalog.DBEntry)
 	meta, err := dbMeta.GetTableEntry(name, db.store.txn)
@@ -289,6 +442,11 @@ func (db *txnDB) ApplyRollback() (err error) {
 			return
 		}
 	}
+	if db.alterEntry != nil {
+		if err = db.alterEntry.ApplyRollback(); err != nil {
+			return
+		}
+	}
 	for _, table := range db.tables {
 		if err = table.ApplyRollback(); err != nil {
 			break
@@ -312,6 +470,11 @@ func (db *txnDB) ApplyCommit() (err error) {
 			return
 		}
 	}
+	if db.alterEntry != nil {
+		if err = db.alterEntry.ApplyCommit(db.store.cmdMgr.MakeLogIndex(db.ddlCSN)); err != nil {
+			return
+		}
+	}
 	for _, table := range db.tables {
 		if err = table.ApplyCommit(); err != nil {
 			break
@@ -347,6 +510,11 @@ func (db *txnDB) PrepareCommit() (err error) {
 			return
 		}
 	}
+	if db.alterEntry != nil {
+		if err = db.alterEntry.PrepareCommit(); err != nil {
+			return
+		}
+	}
 	for _, table := range db.tables {
 		if err = table.PrepareCommit(); err != nil {
 			break
@@ -376,6 +544,15 @@ func (db *txnDB) CollectCmd(cmdMgr *commandManager) (err error) {
 		cmdMgr.AddCmd(cmd)
 		db.ddlCSN = csn
 	}
+	if db.alterEntry != nil {
+		csn := cmdMgr.GetCSN()
+		cmd, err := db.alterEntry.MakeCommand(csn)
+		if err != nil {
+			panic(err)
+		}
+		cmdMgr.AddCmd(cmd)
+		db.ddlCSN = csn
+	}
 	for _, table := range db.tables {
 		if err = table.CollectCmd(cmdMgr); err != nil {
 			panic(err)
@@ -404,6 +581,11 @@ func (db *txnDB) PrepareRollback() error {
 			return err
 		}
 	}
+	if db.alterEntry != nil {
+		if err := db.alterEntry.PrepareRollback(); err != nil {
+			return err
+		}
+	}
 	for _, table := range db.tables {
 		if err = table.PrepareRollback(); err != nil {
 			break
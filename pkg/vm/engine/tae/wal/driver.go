@@ -116,6 +116,22 @@ func (driver *walDriver) AppendEntry(group uint32, e LogEntry) (uint64, error) {
 	return id, err
 }
 
+func (driver *walDriver) Flush() error {
+	return driver.impl.Flush()
+}
+
+func (driver *walDriver) SetSyncPolicy(group uint32, policy store.SyncPolicy) {
+	driver.impl.SetSyncPolicy(group, policy)
+}
+
+func (driver *walDriver) Archived() []store.ArchivedFile {
+	return driver.impl.Archived()
+}
+
+func (driver *walDriver) Subscribe(group uint32, from uint64) (*store.Subscription, error) {
+	return driver.impl.Subscribe(group, from)
+}
+
 func (driver *walDriver) Close() error {
 	if driver.own {
 		return driver.impl.Close()
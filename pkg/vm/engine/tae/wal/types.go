@@ -46,6 +46,14 @@ type Driver interface {
 	GetPenddingCnt() uint64
 	Compact() error
 	Replay(handle store.ApplyHandle) (err error)
+	// Flush makes the entries appended before durable
+	Flush() error
+	SetSyncPolicy(group uint32, policy store.SyncPolicy)
+	// Archived returns the compacted files archived
+	Archived() []store.ArchivedFile
+	// Subscribe returns a subscription to the entries of group committed
+	// from the LSN from
+	Subscribe(group uint32, from uint64) (*store.Subscription, error)
 	Close() error
 }
 
@@ -15,10 +15,14 @@
 package engine
 
 import (
+	"context"
+
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 )
 
@@ -155,10 +159,69 @@ type Relation interface {
 	NewReader(int, extend.Extend, []byte, Snapshot) []Reader
 }
 
+// HiddenRelation is implemented by the relations which can synthesize hidden
+// system columns, such as the physical address of a row, on read
+type HiddenRelation interface {
+	HiddenAttributes(Snapshot) []Attribute
+}
+
+// HiddenKeyRelation is implemented by the relations whose rows are deleted
+// and updated by their hidden keys, the values of the hidden column named by
+// HiddenKey read with the rows in the same transaction
+type HiddenKeyRelation interface {
+	HiddenKey(Snapshot) string
+	// DeleteByHiddenKeys deletes the rows of the keys of the vector
+	DeleteByHiddenKeys(*vector.Vector, Snapshot) error
+	// UpdateByHiddenKey sets the attributes of the row of the key to the
+	// values
+	UpdateByHiddenKey(key []byte, attrs []string, vals []interface{}, snap Snapshot) error
+}
+
+// PredicateRelation is implemented by the relations which skip the blocks
+// not matching the sargable predicates of a plan2 scan, comparisons of the
+// columns named as in the table to constants
+type PredicateRelation interface {
+	NewReaderWithPredicates(int, []*plan.Expr, Snapshot) []Reader
+}
+
+// BlockRelation is implemented by the relations listing their blocks, which
+// a plan2 scan splits into the ranges read by the readers of its workers
+type BlockRelation interface {
+	// Blocks returns the partitions and the blocks which may have the rows
+	// matching the sargable predicates, whose columns are the attributes at
+	// their positions
+	Blocks([]*plan.Expr, []string, Snapshot) ([]uint32, []*plan.BlockRef)
+	// NewBlockReader returns a reader of the blocks listed by Blocks in the
+	// same transaction
+	NewBlockReader([]*plan.BlockRef, Snapshot) Reader
+}
+
+// IndexRelation is implemented by the block relations keeping secondary
+// indexes of their columns
+type IndexRelation interface {
+	// Lookup returns the blocks with the rows whose attribute attr equals
+	// the constant key, which are found by the index of attr and read by
+	// NewBlockReader. It returns false if attr has no index
+	Lookup(attr string, key *plan.Expr, snap Snapshot) ([]*plan.BlockRef, bool)
+}
+
 type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
 
+// LimitedReader is implemented by the readers which stop pulling blocks once
+// the rows of the scan reach the limit
+type LimitedReader interface {
+	SetLimit(*ScanLimit)
+}
+
+// CancelableReader is implemented by the readers which stop pulling blocks
+// once the context of the query reading them is canceled, such as when the
+// query is killed
+type CancelableReader interface {
+	SetContext(context.Context)
+}
+
 type Filter interface {
 	Eq(string, interface{}) (*roaring.Bitmap, error)
 	Ne(string, interface{}) (*roaring.Bitmap, error)
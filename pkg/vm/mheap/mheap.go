@@ -1,6 +1,8 @@
 package mheap
 
 import (
+	"sync/atomic"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -20,12 +22,21 @@ func HostSize(m *Mheap) int64 {
 	return m.Gm.HostSize()
 }
 
+// InUse returns the memory allocated from m and not freed yet.
+func InUse(m *Mheap) int64 {
+	return atomic.LoadInt64(&m.inUse)
+}
+
 func Free(m *Mheap, data []byte) {
 	//m.Gm.Free(int64(cap(data)))
+	atomic.AddInt64(&m.inUse, -int64(cap(data)))
+	m.Ms.free(int64(cap(data)))
 }
 
 func Alloc(m *Mheap, size int64) ([]byte, error) {
 	data := mempool.Alloc(m.Mp, int(size))
+	atomic.AddInt64(&m.inUse, int64(cap(data)))
+	m.Ms.alloc(int64(cap(data)))
 	/*
 		if err := m.Gm.Alloc(int64(cap(data))); err != nil {
 			return nil, err
@@ -42,3 +53,37 @@ func Grow(m *Mheap, old []byte, size int64) ([]byte, error) {
 	copy(data, old)
 	return data[:size], nil
 }
+
+// Size returns the memory in use.
+func (ms *MemStats) Size() int64 {
+	return atomic.LoadInt64(&ms.size)
+}
+
+// Peak returns the maximum memory in use since the last reset.
+func (ms *MemStats) Peak() int64 {
+	return atomic.LoadInt64(&ms.peak)
+}
+
+// Reset resets the peak to the memory in use.
+func (ms *MemStats) Reset() {
+	atomic.StoreInt64(&ms.peak, atomic.LoadInt64(&ms.size))
+}
+
+func (ms *MemStats) alloc(size int64) {
+	if ms == nil {
+		return
+	}
+	v := atomic.AddInt64(&ms.size, size)
+	for peak := atomic.LoadInt64(&ms.peak); v > peak; peak = atomic.LoadInt64(&ms.peak) {
+		if atomic.CompareAndSwapInt64(&ms.peak, peak, v) {
+			break
+		}
+	}
+}
+
+func (ms *MemStats) free(size int64) {
+	if ms == nil {
+		return
+	}
+	atomic.AddInt64(&ms.size, -size)
+}
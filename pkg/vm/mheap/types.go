@@ -16,6 +16,18 @@ type Mheap interface {
 */
 
 type Mheap struct {
-	Gm *guest.Mmu
-	Mp *mempool.Mempool
+	// inUse, the memory allocated from the heap and not freed yet, it is
+	// the first field to be 64-bit aligned for the atomic operations.
+	inUse int64
+	Gm    *guest.Mmu
+	Mp    *mempool.Mempool
+	// Ms, memory statistics of the query, it is shared by all the heaps
+	// of the query and may be nil.
+	Ms *MemStats
+}
+
+// MemStats records the memory in use and the peak memory of a query.
+type MemStats struct {
+	size int64
+	peak int64
 }
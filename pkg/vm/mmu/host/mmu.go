@@ -25,6 +25,11 @@ func New(limit int64) *Mmu {
 	}
 }
 
+// Limit returns the memory of the host which can be used
+func (m *Mmu) Limit() int64 {
+	return m.limit
+}
+
 func (m *Mmu) Size() int64 {
 	return atomic.LoadInt64(&m.size)
 }
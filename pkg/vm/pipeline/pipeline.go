@@ -72,6 +72,9 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 		if bat, err = r.Read(p.refCnts, p.attrs); err != nil {
 			return false, err
 		}
+		if bat != nil {
+			proc.Stats.AddRowsExamined(int64(batch.Length(bat)))
+		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
 		if end, err = vm.Run(p.instructions, proc); err != nil || end { // end is true means pipeline successfully completed
@@ -15,10 +15,13 @@
 package process
 
 import (
+	"sync/atomic"
+
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
 
 // New creates a new Process.
@@ -29,6 +32,24 @@ func New(m *mheap.Mheap) *Process {
 	}
 }
 
+// NewWithStats creates a new Process with its own heap for a pipeline of the
+// query of parent. The statistics of parent are shared with the new Process.
+func NewWithStats(parent *Process) *Process {
+	m := mheap.New(guest.New(parent.Mp.Gm.Limit, parent.Mp.Gm.Mmu))
+	m.Ms = parent.Mp.Ms
+	proc := New(m)
+	proc.Stats = parent.Stats
+	return proc
+}
+
+// NewStatistics creates the statistics of proc and tracks the memory of
+// the heap of proc.
+func NewStatistics(proc *Process) *Statistics {
+	proc.Stats = new(Statistics)
+	proc.Mp.Ms = &proc.Stats.Mem
+	return proc.Stats
+}
+
 func GetSels(proc *Process) []int64 {
 	if len(proc.Reg.Ss) == 0 {
 		return make([]int64, 0, 16)
@@ -75,3 +96,21 @@ func FreeRegisters(proc *Process) {
 	}
 	proc.Reg.Vecs = proc.Reg.Vecs[:0]
 }
+
+// AddRowsExamined adds n rows read from the storage engine.
+func (s *Statistics) AddRowsExamined(n int64) {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.rowsExamined, n)
+}
+
+func (s *Statistics) RowsExamined() int64 {
+	return atomic.LoadInt64(&s.rowsExamined)
+}
+
+// Reset resets the statistics for a new statement.
+func (s *Statistics) Reset() {
+	s.Mem.Reset()
+	atomic.StoreInt64(&s.rowsExamined, 0)
+}
@@ -63,6 +63,17 @@ type Process struct {
 	Reg Register
 	Lim Limitation
 	Mp  *mheap.Mheap
+	// Stats, resource usage of the query, it is shared by all the
+	// processes of the query and may be nil.
+	Stats *Statistics
 
 	Cancel context.CancelFunc
 }
+
+// Statistics records the resource usage of a query.
+type Statistics struct {
+	// Mem, memory usage of the query.
+	Mem mheap.MemStats
+	// rowsExamined, number of rows read from the storage engine.
+	rowsExamined int64
+}
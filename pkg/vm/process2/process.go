@@ -15,18 +15,73 @@
 package process
 
 import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
 // New creates a new Process.
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
-	return &Process{
-		Mp: m,
+	return NewWithContext(context.Background(), m)
+}
+
+// NewWithContext creates a new Process canceled with ctx
+func NewWithContext(ctx context.Context, m *mheap.Mheap) *Process {
+	proc := &Process{
+		Mp:       m,
+		Settings: settings.Default(),
+	}
+	proc.Ctx, proc.Cancel = context.WithCancel(ctx)
+	return proc
+}
+
+// NewStatistics creates the statistics of proc and tracks the memory of
+// the heap of proc.
+func NewStatistics(proc *Process) *Statistics {
+	proc.Stats = new(Statistics)
+	proc.Mp.Ms = &proc.Stats.Mem
+	return proc.Stats
+}
+
+// BatchRows returns the max rows of the batches made by an operator merging
+// its inputs, which is the batch size of the settings of proc if set or def
+func BatchRows(proc *Process, def int) int {
+	if proc.Settings != nil && proc.Settings.BatchSize > 0 {
+		return proc.Settings.BatchSize
 	}
+	return def
+}
+
+// Receive returns the next batch of reg, it returns the error of proc.Ctx
+// if the process is canceled before
+func Receive(proc *Process, reg *WaitRegister) (*batch.Batch, error) {
+	if proc.Ctx == nil {
+		return <-reg.Ch, nil
+	}
+	select {
+	case <-proc.Ctx.Done():
+		return nil, proc.Ctx.Err()
+	case bat := <-reg.Ch:
+		return bat, nil
+	}
+}
+
+// Canceled returns the error of proc.Ctx once the process is canceled, the
+// long loops of the operators check it to stop early
+func Canceled(proc *Process) error {
+	if proc.Ctx == nil {
+		return nil
+	}
+	return proc.Ctx.Err()
 }
 
 func Get(proc *Process, size int64, typ types.Type) (*vector.Vector, error) {
@@ -62,3 +117,116 @@ func FreeRegisters(proc *Process) {
 	}
 	proc.Reg.Vecs = proc.Reg.Vecs[:0]
 }
+
+// AddRowsExamined adds n rows read from the storage engine.
+func (s *Statistics) AddRowsExamined(n int64) {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.rowsExamined, n)
+}
+
+// AddSpillBytes adds n bytes spilled to disk.
+func (s *Statistics) AddSpillBytes(n int64) {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.spillBytes, n)
+}
+
+func (s *Statistics) RowsExamined() int64 {
+	return atomic.LoadInt64(&s.rowsExamined)
+}
+
+func (s *Statistics) SpillBytes() int64 {
+	return atomic.LoadInt64(&s.spillBytes)
+}
+
+// NewAnalyzeInfos returns the AnalyzeInfos of the n nodes of a plan
+func NewAnalyzeInfos(n int) []*AnalyzeInfo {
+	infos := make([]*AnalyzeInfo, n)
+	for i := range infos {
+		infos[i] = new(AnalyzeInfo)
+	}
+	return infos
+}
+
+// FillAnalyzeInfo sets the statistics to the nodes of qry, the i-th
+// AnalyzeInfo is the one of the i-th node
+func FillAnalyzeInfo(qry *plan.Query, infos []*AnalyzeInfo) {
+	for i, info := range infos {
+		if i >= len(qry.Nodes) {
+			break
+		}
+		qry.Nodes[i].AnalyzeInfo = &plan.AnalyzeInfo{
+			InputRows:     atomic.LoadInt64(&info.InputRows),
+			OutputRows:    atomic.LoadInt64(&info.OutputRows),
+			InputBatches:  atomic.LoadInt64(&info.InputBatches),
+			OutputBatches: atomic.LoadInt64(&info.OutputBatches),
+			TimeConsumed:  atomic.LoadInt64(&info.TimeConsumed),
+			MemoryPeak:    atomic.LoadInt64(&info.MemoryPeak),
+			SpillBytes:    atomic.LoadInt64(&info.SpillBytes),
+			Calls:         atomic.LoadInt64(&info.Calls),
+		}
+	}
+}
+
+// GetAnalyze returns the Analyze of the operator of index idx. It only
+// adds the bytes spilled to the statistics of proc if the operators of proc
+// are not analyzed
+func GetAnalyze(proc *Process, idx int) Analyze {
+	if idx < 0 || idx >= len(proc.AnalInfos) {
+		return Analyze{stats: proc.Stats}
+	}
+	return Analyze{
+		info:  proc.AnalInfos[idx],
+		mp:    proc.Mp,
+		stats: proc.Stats,
+	}
+}
+
+// Start starts a call
+func (a *Analyze) Start() {
+	if a.info != nil {
+		a.start = time.Now()
+		atomic.AddInt64(&a.info.Calls, 1)
+	}
+}
+
+// Stop adds the time of the call and updates the memory peak
+func (a *Analyze) Stop() {
+	if a.info == nil {
+		return
+	}
+	atomic.AddInt64(&a.info.TimeConsumed, int64(time.Since(a.start)))
+	size := mheap.InUse(a.mp)
+	for {
+		peak := atomic.LoadInt64(&a.info.MemoryPeak)
+		if size <= peak || atomic.CompareAndSwapInt64(&a.info.MemoryPeak, peak, size) {
+			break
+		}
+	}
+}
+
+func (a *Analyze) Input(bat *batch.Batch) {
+	if a.info != nil && bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&a.info.InputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&a.info.InputBatches, 1)
+	}
+}
+
+func (a *Analyze) Output(bat *batch.Batch) {
+	if a.info != nil && bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&a.info.OutputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&a.info.OutputBatches, 1)
+	}
+}
+
+// Spill adds size bytes spilled to disk, to the statistics of the query
+// too
+func (a *Analyze) Spill(size int64) {
+	a.stats.AddSpillBytes(size)
+	if a.info != nil {
+		atomic.AddInt64(&a.info.SpillBytes, size)
+	}
+}
@@ -15,9 +15,12 @@
 package process
 
 import (
+	"context"
 	"testing"
 
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -34,3 +37,85 @@ func TestProcess(t *testing.T) {
 	Put(proc, vec)
 	FreeRegisters(proc)
 }
+
+func TestReceive(t *testing.T) {
+	proc := NewWithContext(context.Background(), mheap.New(guest.New(1<<30, host.New(1<<30))))
+	reg := &WaitRegister{
+		Ctx: proc.Ctx,
+		Ch:  make(chan *batch.Batch, 1),
+	}
+	reg.Ch <- nil
+	bat, err := Receive(proc, reg)
+	require.NoError(t, err)
+	require.Nil(t, bat)
+	proc.Cancel()
+	_, err = Receive(proc, reg)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestCanceled(t *testing.T) {
+	proc := New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	require.NoError(t, Canceled(proc))
+	proc.Cancel()
+	require.Equal(t, context.Canceled, Canceled(proc))
+	require.NoError(t, Canceled(&Process{}))
+}
+
+func TestBatchRows(t *testing.T) {
+	proc := New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	require.Equal(t, 8192, BatchRows(proc, 8192))
+	proc.Settings.BatchSize = 100
+	require.Equal(t, 100, BatchRows(proc, 8192))
+	require.Equal(t, 8192, BatchRows(&Process{}, 8192))
+}
+
+func TestAnalyze(t *testing.T) {
+	proc := New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	anal := GetAnalyze(proc, 0)
+	anal.Start()
+	anal.Input(&batch.Batch{Zs: []int64{1, 1}})
+	anal.Stop()
+
+	proc.AnalInfos = NewAnalyzeInfos(2)
+	for i := 0; i < 2; i++ {
+		anal = GetAnalyze(proc, 1)
+		anal.Start()
+		anal.Input(&batch.Batch{Zs: []int64{1, 1, 1}})
+		anal.Input(&batch.Batch{})
+		anal.Output(&batch.Batch{Zs: []int64{1}})
+		anal.Output(nil)
+		anal.Spill(10)
+		anal.Stop()
+	}
+	qry := &plan.Query{Nodes: []*plan.Node{{}, {}}}
+	FillAnalyzeInfo(qry, proc.AnalInfos)
+	require.Equal(t, int64(0), qry.Nodes[0].AnalyzeInfo.InputRows)
+	info := qry.Nodes[1].AnalyzeInfo
+	require.Equal(t, int64(6), info.InputRows)
+	require.Equal(t, int64(2), info.InputBatches)
+	require.Equal(t, int64(2), info.OutputRows)
+	require.Equal(t, int64(2), info.OutputBatches)
+	require.Equal(t, int64(20), info.SpillBytes)
+	require.Equal(t, int64(2), info.Calls)
+	require.True(t, info.TimeConsumed > 0)
+}
+
+func TestStatistics(t *testing.T) {
+	proc := New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	stats := NewStatistics(proc)
+	data, err := mheap.Alloc(proc.Mp, 1024)
+	require.NoError(t, err)
+	mheap.Free(proc.Mp, data)
+	require.True(t, stats.Mem.Peak() >= 1024)
+
+	stats.AddRowsExamined(10)
+	// The bytes spilled by the operators not analyzed are counted too
+	anal := GetAnalyze(proc, 0)
+	anal.Spill(10)
+	proc.AnalInfos = NewAnalyzeInfos(1)
+	anal = GetAnalyze(proc, 0)
+	anal.Spill(20)
+	require.Equal(t, int64(10), stats.RowsExamined())
+	require.Equal(t, int64(30), stats.SpillBytes())
+	require.Equal(t, int64(20), proc.AnalInfos[0].SpillBytes)
+}
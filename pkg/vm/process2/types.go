@@ -16,15 +16,20 @@ package process
 
 import (
 	"context"
+	"time"
 
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/settings"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 // WaitRegister channel
 type WaitRegister struct {
+	// Ctx, the context of the process receiving from Ch, the senders stop
+	// sending once it is done.
 	Ctx context.Context
 	Ch  chan *batch.Batch
 }
@@ -41,7 +46,7 @@ type Register struct {
 	MergeReceivers []*WaitRegister
 }
 
-//Limitation specifies the maximum resources that can be used in one query.
+// Limitation specifies the maximum resources that can be used in one query.
 type Limitation struct {
 	// Size, memory threshold.
 	Size int64
@@ -62,6 +67,14 @@ type Process struct {
 	Reg Register
 	Lim Limitation
 	Mp  *mheap.Mheap
+	// Spill, where the operators spill the data exceeding Lim.Size to,
+	// spilling is disabled if nil.
+	Spill *spill.Manager
+	// AnalInfos, the runtime statistics indexed by the Idx of the operators,
+	// the operators are not analyzed if nil.
+	AnalInfos []*AnalyzeInfo
+	// Stats, resource usage of the query, it may be nil.
+	Stats *Statistics
 
 	// unix timestamp
 	UnixTime int64
@@ -69,5 +82,48 @@ type Process struct {
 	// snapshot is transaction context
 	Snapshot engine.Snapshot
 
+	// Ctx, canceled by Cancel once the query is killed or the client
+	// disconnects, the operators blocked on the MergeReceivers are aborted.
+	Ctx    context.Context
 	Cancel context.CancelFunc
+
+	// Settings, the settings of the session issuing the query, the default
+	// ones of the node if it is run for another node.
+	Settings *settings.Settings
+}
+
+// Statistics records the resource usage of a query.
+type Statistics struct {
+	// Mem, memory usage of the query.
+	Mem mheap.MemStats
+	// rowsExamined, number of rows read from the storage engine.
+	rowsExamined int64
+	// spillBytes, number of bytes spilled to disk.
+	spillBytes int64
+}
+
+// AnalyzeInfo is the runtime statistics of the operators of a plan node.
+// It is shared by the operators of the node in all the pipelines, so the
+// counters are updated atomically
+type AnalyzeInfo struct {
+	InputRows     int64
+	OutputRows    int64
+	InputBatches  int64
+	OutputBatches int64
+	// TimeConsumed, wall time of the calls in nanoseconds.
+	TimeConsumed int64
+	// MemoryPeak, the max memory of the process at the end of a call.
+	MemoryPeak int64
+	// SpillBytes, bytes spilled to disk.
+	SpillBytes int64
+	// Calls, the number of the calls.
+	Calls int64
+}
+
+// Analyze updates the AnalyzeInfo of an operator during a call
+type Analyze struct {
+	start time.Time
+	info  *AnalyzeInfo
+	mp    *mheap.Mheap
+	stats *Statistics
 }
@@ -0,0 +1,250 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"errors"
+	"hash/maphash"
+	"io"
+	"os"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+var (
+	ErrCorrupted = errors.New("spill: corrupted file")
+)
+
+// New creates a manager spilling to dir, the temporary dir of the system
+// if dir is empty
+func New(dir string) *Manager {
+	return &Manager{dir: dir}
+}
+
+// Create creates an empty file
+func (m *Manager) Create() (*File, error) {
+	f, err := os.CreateTemp(m.dir, "spill-")
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		f: f,
+		w: bufio.NewWriter(f),
+	}, nil
+}
+
+// NewPartitions creates n empty partitions hashing the keys with seed. The
+// relations joined are partitioned with the same seed
+func (m *Manager) NewPartitions(n int, seed maphash.Seed) (*Partitions, error) {
+	ps := &Partitions{
+		sels:  make([][]int64, n),
+		files: make([]*File, n),
+	}
+	ps.h.SetSeed(seed)
+	for i := range ps.files {
+		f, err := m.Create()
+		if err != nil {
+			ps.Close()
+			return nil, err
+		}
+		ps.files[i] = f
+	}
+	return ps, nil
+}
+
+// Write appends bat to the file
+func (f *File) Write(bat *batch.Batch) error {
+	data := encoding.EncodeUint32(uint32(len(bat.Vecs)))
+	data = append(data, encoding.EncodeUint32(uint32(len(bat.Zs)))...)
+	data = append(data, encoding.EncodeInt64Slice(bat.Zs)...)
+	for _, vec := range bat.Vecs {
+		buf, err := vec.Show()
+		if err != nil {
+			return err
+		}
+		data = append(data, encoding.EncodeUint32(uint32(len(buf)))...)
+		data = append(data, buf...)
+	}
+	if _, err := f.w.Write(data); err != nil {
+		return err
+	}
+	f.size += int64(len(data))
+	return nil
+}
+
+// Rewind flushes the batches written and reads the file from the start
+func (f *File) Rewind() error {
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	f.r = bufio.NewReader(f.f)
+	return nil
+}
+
+// Read returns the next batch of the file, or nil at the end. The vectors
+// of the batch are allocated from m, their data is copied out of the
+// encoded one as the hash keys read the data of the fixed length vectors
+func (f *File) Read(m *mheap.Mheap) (*batch.Batch, error) {
+	if f.r == nil {
+		return nil, nil
+	}
+	var hdr [8]byte
+	if _, err := io.ReadFull(f.r, hdr[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, ErrCorrupted
+	}
+	bat := batch.New(int(encoding.DecodeUint32(hdr[:4])))
+	rows := int(encoding.DecodeUint32(hdr[4:]))
+	zs := make([]byte, rows*8)
+	if _, err := io.ReadFull(f.r, zs); err != nil {
+		return nil, ErrCorrupted
+	}
+	bat.Zs = append(make([]int64, 0, rows), encoding.DecodeInt64Slice(zs)...)
+	vecs := make([]*vector.Vector, len(bat.Vecs))
+	for i := range vecs {
+		if _, err := io.ReadFull(f.r, hdr[:4]); err != nil {
+			return nil, ErrCorrupted
+		}
+		data := make([]byte, encoding.DecodeUint32(hdr[:4]))
+		if _, err := io.ReadFull(f.r, data); err != nil {
+			return nil, ErrCorrupted
+		}
+		vecs[i] = new(vector.Vector)
+		vecs[i].Nsp = new(nulls.Nulls)
+		if err := vecs[i].Read(data); err != nil {
+			return nil, err
+		}
+	}
+	for i, vec := range vecs {
+		v, err := vector.Dup(vec, m)
+		if err != nil {
+			for _, w := range bat.Vecs[:i] {
+				vector.Clean(w, m)
+			}
+			return nil, err
+		}
+		bat.Vecs[i] = v
+	}
+	return bat, nil
+}
+
+// Size returns the bytes written
+func (f *File) Size() int64 {
+	return f.size
+}
+
+// Close closes and removes the file
+func (f *File) Close() error {
+	name := f.f.Name()
+	if err := f.f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
+
+// Partition returns the partition of the rows of key
+func (ps *Partitions) Partition(key []byte) int {
+	ps.h.Reset()
+	ps.h.Write(key)
+	return int(ps.h.Sum64() % uint64(len(ps.files)))
+}
+
+// Write appends the rows of bat to the partitions, row i to the partition
+// parts[i]. The rows of a negative partition are skipped
+func (ps *Partitions) Write(bat *batch.Batch, parts []int, m *mheap.Mheap) error {
+	for i := range ps.sels {
+		ps.sels[i] = ps.sels[i][:0]
+	}
+	for i, p := range parts {
+		if p < 0 {
+			continue
+		}
+		ps.sels[p] = append(ps.sels[p], int64(i))
+	}
+	for i, sels := range ps.sels {
+		if len(sels) == 0 {
+			continue
+		}
+		if err := ps.write(ps.files[i], bat, sels, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ps *Partitions) write(f *File, bat *batch.Batch, sels []int64, m *mheap.Mheap) error {
+	pbat := batch.New(len(bat.Vecs))
+	defer batch.Clean(pbat, m)
+	for i, vec := range bat.Vecs {
+		pbat.Vecs[i] = vector.New(vec.Typ)
+		for _, sel := range sels {
+			if err := vector.UnionOne(pbat.Vecs[i], vec, sel, m); err != nil {
+				return err
+			}
+		}
+	}
+	for _, sel := range sels {
+		pbat.Zs = append(pbat.Zs, bat.Zs[sel])
+	}
+	return f.Write(pbat)
+}
+
+// File returns the file of partition i
+func (ps *Partitions) File(i int) *File {
+	return ps.files[i]
+}
+
+// Size returns the bytes written to the partitions
+func (ps *Partitions) Size() int64 {
+	var size int64
+	for _, f := range ps.files {
+		size += f.Size()
+	}
+	return size
+}
+
+// Rewind rewinds all the partitions
+func (ps *Partitions) Rewind() error {
+	for _, f := range ps.files {
+		if err := f.Rewind(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes and removes the files of the partitions
+func (ps *Partitions) Close() error {
+	var err error
+	for _, f := range ps.files {
+		if f == nil {
+			continue
+		}
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"hash/maphash"
+	"os"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitions(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	m := mheap.New(gm)
+	dir := t.TempDir()
+	ps, err := New(dir).NewPartitions(4, maphash.MakeSeed())
+	require.NoError(t, err)
+
+	rows := 100
+	bat := batch.New(1)
+	bat.InitZsOne(rows)
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64})
+	vs := make([]int64, rows)
+	parts := make([]int, rows)
+	for i := range vs {
+		vs[i] = int64(i)
+		parts[i] = i % 4
+	}
+	bat.Vecs[0].Col = vs
+	nulls.Add(bat.Vecs[0].Nsp, 0)
+	require.NoError(t, ps.Write(bat, parts, m))
+	require.NoError(t, ps.Write(bat, parts, m))
+	require.Less(t, int64(0), ps.Size())
+	require.NoError(t, ps.Rewind())
+
+	for i := 0; i < 4; i++ {
+		cnt := 0
+		for {
+			pbat, err := ps.File(i).Read(m)
+			require.NoError(t, err)
+			if pbat == nil {
+				break
+			}
+			for k, v := range pbat.Vecs[0].Col.([]int64) {
+				require.Equal(t, i, int(v)%4)
+				require.Equal(t, v == 0, nulls.Contains(pbat.Vecs[0].Nsp, uint64(k)))
+			}
+			cnt += len(pbat.Zs)
+			batch.Clean(pbat, m)
+		}
+		require.Equal(t, 2*rows/4, cnt)
+	}
+	require.Equal(t, ps.Partition([]byte("key")), ps.Partition([]byte("key")))
+	require.Equal(t, int64(0), mheap.Size(m))
+
+	require.NoError(t, ps.Close())
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"hash/maphash"
+	"os"
+)
+
+// Manager creates the files the operators spill the data out of memory to
+type Manager struct {
+	dir string
+}
+
+// File is a temporary file of batches, written and then read back after
+// a Rewind. It is removed on Close
+type File struct {
+	f    *os.File
+	w    *bufio.Writer
+	r    *bufio.Reader
+	size int64
+}
+
+// Partitions are the files a relation is partitioned into by the hash of
+// the keys of its rows
+type Partitions struct {
+	h     maphash.Hash
+	sels  [][]int64
+	files []*File
+}